@@ -27,7 +27,12 @@ import (
 	uuid "github.com/satori/go.uuid"
 	"github.com/spf13/viper"
 	"github.com/topfreegames/extensions/jaeger"
+	"github.com/topfreegames/podium/config"
 	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/events"
+	"github.com/topfreegames/podium/leaderboard/v2/lifecycle"
+	"github.com/topfreegames/podium/leaderboard/v2/milestones"
+	"github.com/topfreegames/podium/leaderboard/v2/mutationlog"
 	"github.com/topfreegames/podium/leaderboard/v2/service"
 	lservice "github.com/topfreegames/podium/leaderboard/v2/service"
 	"github.com/topfreegames/podium/log"
@@ -67,6 +72,12 @@ type App struct {
 	NewRelic     newrelic.Application
 	DDStatsD     *extnethttpmiddleware.DogStatsD
 	ID           uuid.UUID
+	eventSink    *events.BufferedSink
+
+	milestoneNotifier *milestones.BufferedNotifier
+	lifecycleNotifier *lifecycle.BufferedNotifier
+	membersWatcher    *membersWatcher
+	signatureGames    *signatureGames
 }
 
 // New returns a new podium Application.
@@ -134,6 +145,12 @@ func (app *App) configure() error {
 		w.Header().Set("Content-Type", marshaler.ContentType())
 		st, s := app.getStatusCodeFromError(rpcErr)
 
+		if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+			if retryAfter := md.HeaderMD.Get(retryAfterMetadataKey); len(retryAfter) > 0 {
+				w.Header().Set("Retry-After", retryAfter[0])
+			}
+		}
+
 		w.WriteHeader(s)
 
 		type errorBody struct {
@@ -236,6 +253,20 @@ func (app *App) setConfigurationDefaults() {
 	app.Config.SetDefault("graceperiod.ms", 50)
 	app.Config.SetDefault("api.maxReturnedMembers", 2000)
 	app.Config.SetDefault("api.maxReadBufferSize", 32000)
+	app.Config.SetDefault("api.idempotencyWindow", "24h")
+	app.Config.SetDefault("api.watchInterval", "1s")
+	app.Config.SetDefault("api.watchMaxSubscriptions", 10000)
+	app.Config.SetDefault("api.watchWriteTimeout", "10s")
+	app.Config.SetDefault("api.watchAllowedOrigins", []string{})
+	app.Config.SetDefault("api.signature.maxClockSkew", "5m")
+	app.Config.SetDefault("api.signature.settingsCacheTTL", "10s")
+	app.Config.SetDefault("api.rateLimit.member.rate", 0)
+	app.Config.SetDefault("api.rateLimit.member.burst", 0)
+	app.Config.SetDefault("api.rateLimit.leaderboard.rate", 0)
+	app.Config.SetDefault("api.rateLimit.leaderboard.burst", 0)
+	app.Config.SetDefault("api.rateLimit.caller.rate", 0)
+	app.Config.SetDefault("api.rateLimit.caller.burst", 0)
+	app.Config.SetDefault("api.trustedProxies", []string{"127.0.0.0/8", "::1/128"})
 	app.Config.SetDefault("redis.host", "localhost")
 	app.Config.SetDefault("redis.port", 6379)
 	app.Config.SetDefault("redis.password", "")
@@ -244,6 +275,31 @@ func (app *App) setConfigurationDefaults() {
 	app.Config.SetDefault("jaeger.disabled", true)
 	app.Config.SetDefault("jaeger.samplingProbability", 0.001)
 	app.Config.SetDefault("redis.cluster.enabled", false)
+	app.Config.SetDefault("events.sink", "")
+	app.Config.SetDefault("events.bufferSize", 10000)
+	app.Config.SetDefault("events.batchSize", 100)
+	app.Config.SetDefault("events.retryInterval", "1s")
+	app.Config.SetDefault("events.flushTimeout", "5s")
+	app.Config.SetDefault("events.redis.stream", "podium:events")
+	app.Config.SetDefault("events.redis.maxLen", 1000000)
+	app.Config.SetDefault("events.webhook.timeout", "5s")
+	app.Config.SetDefault("events.webhook.maxRetries", 3)
+	app.Config.SetDefault("events.webhook.retryBackoff", "100ms")
+	app.Config.SetDefault("milestones.bufferSize", 10000)
+	app.Config.SetDefault("milestones.batchSize", 100)
+	app.Config.SetDefault("milestones.retryInterval", "1s")
+	app.Config.SetDefault("milestones.flushTimeout", "5s")
+	app.Config.SetDefault("milestones.webhook.timeout", "5s")
+	app.Config.SetDefault("milestones.webhook.maxRetries", 3)
+	app.Config.SetDefault("milestones.webhook.retryBackoff", "100ms")
+	app.Config.SetDefault("lifecycle.bufferSize", 10000)
+	app.Config.SetDefault("lifecycle.batchSize", 100)
+	app.Config.SetDefault("lifecycle.retryInterval", "1s")
+	app.Config.SetDefault("lifecycle.flushTimeout", "5s")
+	app.Config.SetDefault("lifecycle.webhook.timeout", "5s")
+	app.Config.SetDefault("lifecycle.webhook.maxRetries", 3)
+	app.Config.SetDefault("lifecycle.webhook.retryBackoff", "100ms")
+	config.SetMutationLogDefaults(app.Config)
 }
 
 func (app *App) loadConfiguration() error {
@@ -276,6 +332,15 @@ func (app *App) OnErrorHandler(err error, stack []byte) {
 	raven.CaptureError(err, tags)
 }
 
+// onAfterCommitError logs and counts errors of steps run after writes were applied, which are not returned
+func (app *App) onAfterCommitError(ctx context.Context, step string, err error) {
+	app.Logger.Error("Failed after write was applied.", zap.String("step", step), zap.Error(err))
+
+	if err := app.DDStatsD.Increment("after_commit_errors", fmt.Sprintf("step:%s", step)); err != nil {
+		app.Logger.Error("DDStatsD Increment", zap.Error(err))
+	}
+}
+
 func (app *App) configureApplication() error {
 	app.Errors = metrics.NewEWMA15()
 
@@ -289,14 +354,22 @@ func (app *App) configureApplication() error {
 		return err
 	}
 	app.Leaderboards = client
+	app.membersWatcher = newMembersWatcher(
+		app.Config.GetDuration("api.watchInterval"),
+		app.Config.GetInt("api.watchMaxSubscriptions"),
+	)
+	app.signatureGames = newSignatureGames(app.Config.GetDuration("api.signature.settingsCacheTTL"))
 
 	return nil
 }
 
 func (app *App) createAndConfigureLeaderboardClient() (lservice.Leaderboard, error) {
-	client := app.createLeaderboardClient()
-	err := client.Healthcheck(context.Background())
+	client, err := app.createLeaderboardClient()
+	if err != nil {
+		return nil, err
+	}
 
+	err = client.Healthcheck(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -305,7 +378,7 @@ func (app *App) createAndConfigureLeaderboardClient() (lservice.Leaderboard, err
 	return client, nil
 }
 
-func (app *App) createLeaderboardClient() lservice.Leaderboard {
+func (app *App) createLeaderboardClient() (lservice.Leaderboard, error) {
 	shouldRunOnCluster := app.Config.GetBool("redis.cluster.enabled")
 	addrs := app.Config.GetStringSlice("redis.addrs")
 	password := app.Config.GetString("redis.password")
@@ -320,18 +393,58 @@ func (app *App) createLeaderboardClient() lservice.Leaderboard {
 		zap.String("url", fmt.Sprintf("redis://:<REDACTED>@%s:%v/%v", host, port, db)),
 	)
 
-	leaderboardService := service.NewService(database.NewRedisDatabase(database.RedisOptions{
+	redisDatabase := database.NewRedisDatabase(database.RedisOptions{
 		ClusterEnabled: shouldRunOnCluster,
 		Addrs:          addrs,
 		Host:           host,
 		Password:       password,
 		Port:           port,
 		DB:             db,
-	}))
+	})
+
+	// writes are added to mutation outboxes shipped to the log by podium ship-mutations
+	mutationLogEnabled, err := config.MutationLogEnabled(app.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	var leaderboardDatabase database.Database = redisDatabase
+	if mutationLogEnabled {
+		leaderboardDatabase = mutationlog.NewDatabase(redisDatabase)
+	}
+	leaderboardService := service.NewService(leaderboardDatabase)
+	leaderboardService.OnAfterCommitError = app.onAfterCommitError
+
+	eventSink, err := app.createEventSink(redisDatabase.Client)
+	if err != nil {
+		return nil, err
+	}
+	if eventSink != nil {
+		app.eventSink = eventSink
+		leaderboardService.EventSink = eventSink
+	}
+
+	milestoneNotifier, err := app.createMilestoneNotifier()
+	if err != nil {
+		return nil, err
+	}
+	if milestoneNotifier != nil {
+		app.milestoneNotifier = milestoneNotifier
+		leaderboardService.MilestoneNotifier = milestoneNotifier
+	}
+
+	lifecycleNotifier, err := app.createLifecycleNotifier(redisDatabase)
+	if err != nil {
+		return nil, err
+	}
+	if lifecycleNotifier != nil {
+		app.lifecycleNotifier = lifecycleNotifier
+		leaderboardService.LifecycleNotifier = lifecycleNotifier
+	}
 
 	logger.Info("Creating leaderboard client.")
 
-	return leaderboardService
+	return leaderboardService, nil
 }
 
 //AddError rate statistics
@@ -419,16 +532,31 @@ func (app *App) startGRPCServer(lis net.Listener) error {
 		basicAuthInterceptor = grpc_auth.UnaryServerInterceptor(app.basicAuthMiddleware)
 	}
 
+	streamInterceptors := []grpc.StreamServerInterceptor{}
+	if basicAuthUser != "" {
+		streamInterceptors = append(streamInterceptors, grpc_auth.StreamServerInterceptor(app.basicAuthMiddleware))
+	}
+	streamInterceptors = append(streamInterceptors,
+		grpc.StreamServerInterceptor(app.streamSignatureMiddleware),
+		grpc.StreamServerInterceptor(app.streamRateLimitMiddleware),
+		grpc.StreamServerInterceptor(app.streamScoreChangeOriginMiddleware),
+		grpc.StreamServerInterceptor(app.streamLoggerMiddleware),
+		grpc.StreamServerInterceptor(app.streamRecoveryMiddleware),
+	)
+
 	app.grpcServer = grpc.NewServer(grpc.UnaryInterceptor(
 		grpc_middleware.ChainUnaryServer(
 			basicAuthInterceptor,
+			grpc.UnaryServerInterceptor(app.signatureMiddleware),
+			grpc.UnaryServerInterceptor(app.rateLimitMiddleware),
+			grpc.UnaryServerInterceptor(app.scoreChangeOriginMiddleware),
 			grpc.UnaryServerInterceptor(app.loggerMiddleware),
 			grpc.UnaryServerInterceptor(app.recoveryMiddleware),
 			grpc.UnaryServerInterceptor(app.responseTimeMetricsMiddleware),
 			grpc.UnaryServerInterceptor(app.sentryMiddleware),
 			grpc.UnaryServerInterceptor(app.newRelicMiddleware),
 		),
-	))
+	), grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streamInterceptors...)))
 	api.RegisterPodiumServer(app.grpcServer, app)
 
 	app.grpcReady <- true
@@ -441,7 +569,8 @@ func (app *App) startGRPCServer(lis net.Listener) error {
 
 func (app *App) startHTTPServer(ctx context.Context, lis net.Listener) error {
 	gatewayMux := runtime.NewServeMux(
-		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{EmitDefaults: true}))
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{EmitDefaults: true}),
+		runtime.WithIncomingHeaderMatcher(headerMatcher))
 	opts := []grpc.DialOption{grpc.WithInsecure()}
 
 	if err := api.RegisterPodiumHandlerFromEndpoint(ctx, gatewayMux, app.GRPCEndpoint, opts); err != nil {
@@ -452,6 +581,9 @@ func (app *App) startHTTPServer(ctx context.Context, lis net.Listener) error {
 	mux.Handle("/", removeTrailingSlashMiddleware{addVersionMiddleware{gatewayMux}})
 	mux.HandleFunc("/healthcheck", addVersionHandlerFunc(app.healthCheckHandler))
 	mux.HandleFunc("/status", addVersionHandlerFunc(app.statusHandler))
+	mux.HandleFunc("/sse/l/", addVersionHandlerFunc(app.httpBasicAuthMiddleware(app.sseHandler)))
+	mux.HandleFunc("/ws/l/", addVersionHandlerFunc(app.httpBasicAuthMiddleware(app.webSocketHandler)))
+	mux.HandleFunc(exportRoutePrefix, addVersionHandlerFunc(app.httpBasicAuthMiddleware(app.exportHandler)))
 
 	app.httpServer = &http.Server{
 		Addr:    app.HTTPEndpoint,
@@ -484,8 +616,12 @@ func (app *App) WaitForReady(d time.Duration) error {
 	return fmt.Errorf("timed out waiting for endpoints")
 }
 
-// GracefullStop attempts to stop the server.
+// GracefullStop attempts to stop the server, publishing buffered score events, milestones and lifecycle events
+// and closing the mutation log.
 func (app *App) GracefullStop() {
+	if app.membersWatcher != nil {
+		app.membersWatcher.close()
+	}
 	if app.grpcServer != nil {
 		app.grpcServer.GracefulStop()
 	}
@@ -494,4 +630,7 @@ func (app *App) GracefullStop() {
 			app.Logger.Error("HTTP server Shutdown.", zap.Error(err))
 		}
 	}
+	app.closeEventSink()
+	app.closeMilestoneNotifier()
+	app.closeLifecycleNotifier()
 }
@@ -37,6 +37,42 @@ var _ = Describe("App", func() {
 			Expect(app.Debug).To(BeTrue())
 			Expect(app.Config).NotTo(BeNil())
 		})
+
+		It("should fail if event sink is invalid", func() {
+			os.Setenv("PODIUM_EVENTS_SINK", "invalid")
+			defer os.Unsetenv("PODIUM_EVENTS_SINK")
+
+			app, err = api.New("127.0.0.1", 9999, 10000, "../config/test.yaml", false, logger)
+			Expect(app).To(BeNil())
+			Expect(err).To(MatchError("invalid events.sink invalid, it must be redis, webhook or file"))
+		})
+
+		It("should fail if milestones webhook has no secret", func() {
+			os.Setenv("PODIUM_MILESTONES_WEBHOOK_URL", "http://localhost/milestones")
+			defer os.Unsetenv("PODIUM_MILESTONES_WEBHOOK_URL")
+
+			app, err = api.New("127.0.0.1", 9999, 10000, "../config/test.yaml", false, logger)
+			Expect(app).To(BeNil())
+			Expect(err).To(MatchError("milestones.webhook.secret is required to sign milestones"))
+		})
+
+		It("should fail if lifecycle webhook has no secret", func() {
+			os.Setenv("PODIUM_LIFECYCLE_WEBHOOK_URL", "http://localhost/lifecycle")
+			defer os.Unsetenv("PODIUM_LIFECYCLE_WEBHOOK_URL")
+
+			app, err = api.New("127.0.0.1", 9999, 10000, "../config/test.yaml", false, logger)
+			Expect(app).To(BeNil())
+			Expect(err).To(MatchError("lifecycle.webhook.secret is required to sign lifecycle events"))
+		})
+
+		It("should fail if mutation log type is invalid", func() {
+			os.Setenv("PODIUM_MUTATIONLOG_TYPE", "invalid")
+			defer os.Unsetenv("PODIUM_MUTATIONLOG_TYPE")
+
+			app, err = api.New("127.0.0.1", 9999, 10000, "../config/test.yaml", false, logger)
+			Expect(app).To(BeNil())
+			Expect(err).To(MatchError("invalid mutation log type invalid, it must be segments or redis"))
+		})
 	})
 
 	Describe("App Load Configuration", func() {
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+	"github.com/topfreegames/podium/leaderboard/v2/events"
+	"go.uber.org/zap"
+)
+
+// createEventSink create the buffered sink publishing score events to the sink configured in
+// events.sink, or nil if it is empty
+func (app *App) createEventSink(redisClient redis.Client) (*events.BufferedSink, error) {
+	sinkName := app.Config.GetString("events.sink")
+	if sinkName == "" {
+		return nil, nil
+	}
+
+	l := app.Logger.With(
+		zap.String("source", "app"),
+		zap.String("operation", "createEventSink"),
+		zap.String("sink", sinkName),
+	)
+
+	var sink events.EventSink
+	switch sinkName {
+	case "redis":
+		sink = events.NewRedisStreamSink(
+			redisClient,
+			app.Config.GetString("events.redis.stream"),
+			app.Config.GetInt64("events.redis.maxLen"),
+		)
+	case "webhook":
+		sink = events.NewWebhookSink(
+			app.Config.GetString("events.webhook.url"),
+			app.Config.GetDuration("events.webhook.timeout"),
+			app.Config.GetInt("events.webhook.maxRetries"),
+			app.Config.GetDuration("events.webhook.retryBackoff"),
+		)
+	case "file":
+		fileSink, err := events.NewFileSink(app.Config.GetString("events.file.path"))
+		if err != nil {
+			return nil, err
+		}
+		sink = fileSink
+	default:
+		return nil, fmt.Errorf("invalid events.sink %s, it must be redis, webhook or file", sinkName)
+	}
+
+	bufferedSink := events.NewBufferedSink(
+		sink,
+		app.Config.GetInt("events.bufferSize"),
+		app.Config.GetInt("events.batchSize"),
+		app.Config.GetDuration("events.retryInterval"),
+		func(err error) {
+			l.Error("Failed to publish score events.", zap.Error(err))
+		},
+	)
+
+	l.Info("Created event sink.")
+	return bufferedSink, nil
+}
+
+// closeEventSink wait up to events.flushTimeout for buffered score events to be published
+func (app *App) closeEventSink() {
+	if app.eventSink == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), app.Config.GetDuration("events.flushTimeout"))
+	defer cancel()
+
+	if err := app.eventSink.Close(ctx); err != nil {
+		app.Logger.Error("Score events were dropped on shutdown.", zap.Error(err))
+	}
+}
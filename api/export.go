@@ -0,0 +1,137 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	lmodel "github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/transfer"
+)
+
+const exportRoutePrefix = "/export/l/"
+
+// exportFormats maps the formats a leaderboard can be downloaded in to their content types
+var exportFormats = map[string]string{
+	"csv":   "text/csv",
+	"jsonl": "application/x-ndjson",
+}
+
+// exportHandler download every member of the leaderboard at /export/l/<leaderboardID> as CSV or JSON Lines,
+// streaming chunks as they are read
+func (app *App) exportHandler(w http.ResponseWriter, r *http.Request) {
+	lg := app.Logger.With(
+		zap.String("handler", "exportHandler"),
+		zap.String("path", r.URL.Path),
+	)
+
+	if r.Method != http.MethodGet {
+		app.writeLiveFail(w, lg, http.StatusMethodNotAllowed, "Method Not Allowed")
+		return
+	}
+
+	leaderboard := strings.TrimPrefix(r.URL.Path, exportRoutePrefix)
+	if leaderboard == "" || strings.Contains(leaderboard, "/") {
+		app.writeLiveFail(w, lg, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	query := r.URL.Query()
+	format := query.Get("format")
+	if format == "" {
+		format = "csv"
+	}
+	contentType, ok := exportFormats[format]
+	if !ok {
+		app.writeLiveFail(w, lg, http.StatusBadRequest, fmt.Sprintf("Invalid format: %s, it must be csv or jsonl", format))
+		return
+	}
+
+	chunkSize := 0
+	if query.Get("chunkSize") != "" {
+		var err error
+		chunkSize, err = strconv.Atoi(query.Get("chunkSize"))
+		if err != nil || chunkSize < 0 {
+			app.writeLiveFail(w, lg, http.StatusBadRequest, fmt.Sprintf("Invalid chunkSize: %s", query.Get("chunkSize")))
+			return
+		}
+	}
+	if chunkSize > app.Config.GetInt("api.maxReturnedMembers") {
+		app.writeLiveFail(w, lg, http.StatusBadRequest, fmt.Sprintf(
+			"Max chunkSize allowed: %d. chunkSize requested: %d",
+			app.Config.GetInt("api.maxReturnedMembers"),
+			chunkSize,
+		))
+		return
+	}
+
+	output := &exportResponseWriter{
+		ResponseWriter: w,
+		contentType:    contentType,
+		filename:       fmt.Sprintf("%s.%s", leaderboard, format),
+	}
+	writer, err := transfer.NewWriter(output, format)
+	if err != nil {
+		app.writeLiveFail(w, lg, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	flusher, _ := w.(http.Flusher)
+	lg.Debug("Exporting leaderboard.")
+	err = app.Leaderboards.ExportLeaderboard(r.Context(), leaderboard, getOrder(query.Get("order")), chunkSize, func(members []*lmodel.Member) error {
+		err := writer.Write(members)
+		if err == nil {
+			err = writer.Flush()
+		}
+		if err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	})
+	if err != nil {
+		lg.Error("Exporting leaderboard failed.", zap.Error(err))
+		app.AddError()
+		if !output.started {
+			app.writeLiveFail(w, lg, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	// an empty leaderboard is still downloaded, with only the CSV header
+	output.start()
+	if err := writer.Flush(); err != nil {
+		lg.Debug("Writing export failed.", zap.Error(err))
+		return
+	}
+	lg.Debug("Exporting leaderboard succeeded.")
+}
+
+// exportResponseWriter writes the headers of the download with the first bytes written, so failures
+// before the first chunk still get an error status
+type exportResponseWriter struct {
+	http.ResponseWriter
+	contentType string
+	filename    string
+	started     bool
+}
+
+func (e *exportResponseWriter) start() {
+	if e.started {
+		return
+	}
+	e.started = true
+	e.Header().Set("Content-Type", e.contentType)
+	e.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", e.filename))
+	e.WriteHeader(http.StatusOK)
+}
+
+func (e *exportResponseWriter) Write(data []byte) (int, error) {
+	e.start()
+	return e.ResponseWriter.Write(data)
+}
@@ -12,9 +12,11 @@ package api
 import (
 	"context"
 	"fmt"
+	"io"
 	"math"
 	"strings"
 
+	"github.com/golang/protobuf/ptypes/wrappers"
 	lmodel "github.com/topfreegames/podium/leaderboard/v2/model"
 	"github.com/topfreegames/podium/leaderboard/v2/service"
 	"go.uber.org/zap"
@@ -38,6 +40,23 @@ func validateBulkUpsertScoresRequest(req *api.BulkUpsertScoresRequest) error {
 	return nil
 }
 
+// writeErrorStatus maps errors of leaderboards rejecting writes or imports to FailedPrecondition, scores
+// rejected by leaderboard rules to InvalidArgument, scores that kept changing while checked against them
+// to Aborted and writes of blocked members to PermissionDenied
+func writeErrorStatus(err error) error {
+	switch err.(type) {
+	case *service.LeaderboardClosedError, *service.LeaderboardFrozenError, *service.MemberNotParticipantError, *service.ImportNotAllowedError:
+		return status.Errorf(codes.FailedPrecondition, err.Error())
+	case *service.ScoreRejectedError, *service.IdempotencyKeyReusedError:
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	case *service.ScoreChangedError:
+		return status.Errorf(codes.Aborted, err.Error())
+	case *service.MemberBlockedError:
+		return status.Errorf(codes.PermissionDenied, err.Error())
+	}
+	return err
+}
+
 // BulkUpsertScores is the handler responsible for creating or updating members score.
 func (app *App) BulkUpsertScores(ctx context.Context, req *api.BulkUpsertScoresRequest) (*api.BulkUpsertScoresResponse, error) {
 	if err := validateBulkUpsertScoresRequest(req); err != nil {
@@ -57,14 +76,15 @@ func (app *App) BulkUpsertScores(ctx context.Context, req *api.BulkUpsertScoresR
 			members[i] = &lmodel.Member{Score: int64(ms.Score), PublicID: ms.PublicID}
 		}
 
-		if err := app.Leaderboards.SetMembersScore(ctx, req.LeaderboardId, members, req.PrevRank, getScoreTTL(req.ScoreTTL)); err != nil {
+		if err := app.Leaderboards.SetMembersScore(ctx, req.LeaderboardId, members, req.PrevRank, getScoreTTL(req.ScoreTTL),
+			app.getIdempotencyKey(req.IdempotencyKey)); err != nil {
 			lg.Error("Setting member scores failed.", zap.Error(err))
 			app.AddError()
 			//TODO: Turn all these LeaderboardExpiredError verifications into a middleware
 			if _, ok := err.(*service.LeaderboardExpiredError); ok {
 				return status.Errorf(codes.InvalidArgument, err.Error())
 			}
-			return err
+			return writeErrorStatus(err)
 		}
 		lg.Debug("Setting member scores succeeded.")
 		return nil
@@ -96,6 +116,33 @@ func getScoreTTL(scoreTTL int32) string {
 	return fmt.Sprint(scoreTTL)
 }
 
+func getScoreCondition(scoreChange *api.UpsertScoreRequest_ScoreChange) *lmodel.ScoreCondition {
+	if scoreChange.ExpectedScore == nil && scoreChange.ExpectedVersion == nil {
+		return nil
+	}
+
+	condition := &lmodel.ScoreCondition{}
+	if scoreChange.ExpectedScore != nil {
+		condition.ExpectedScore = &scoreChange.ExpectedScore.Value
+	}
+	if scoreChange.ExpectedVersion != nil {
+		condition.ExpectedVersion = &scoreChange.ExpectedVersion.Value
+	}
+
+	return condition
+}
+
+func (app *App) getIdempotencyKey(key string) *lmodel.IdempotencyKey {
+	if key == "" {
+		return nil
+	}
+
+	return &lmodel.IdempotencyKey{
+		Key:    key,
+		Window: app.Config.GetDuration("api.idempotencyWindow"),
+	}
+}
+
 // UpsertScore is the handler responsible for creating or updating the member score.
 func (app *App) UpsertScore(ctx context.Context, req *api.UpsertScoreRequest) (*api.UpsertScoreResponse, error) {
 	lg := app.Logger.With(
@@ -110,7 +157,8 @@ func (app *App) UpsertScore(ctx context.Context, req *api.UpsertScoreRequest) (*
 
 		var err error
 		member, err = app.Leaderboards.SetMemberScore(
-			ctx, req.LeaderboardId, req.MemberPublicId, int64(req.ScoreChange.Score), req.PrevRank, getScoreTTL(req.ScoreTTL))
+			ctx, req.LeaderboardId, req.MemberPublicId, int64(req.ScoreChange.Score), req.PrevRank, getScoreTTL(req.ScoreTTL),
+			app.getIdempotencyKey(req.IdempotencyKey), getScoreCondition(req.ScoreChange))
 
 		if err != nil {
 			lg.Error("Setting member score failed.", zap.Error(err))
@@ -118,8 +166,11 @@ func (app *App) UpsertScore(ctx context.Context, req *api.UpsertScoreRequest) (*
 			if _, ok := err.(*service.LeaderboardExpiredError); ok {
 				return status.Errorf(codes.InvalidArgument, err.Error())
 			}
+			if _, ok := err.(*service.ScoreConditionFailedError); ok {
+				return status.Errorf(codes.Aborted, err.Error())
+			}
 
-			return err
+			return writeErrorStatus(err)
 		}
 		lg.Debug("Setting member score succeeded.")
 		return nil
@@ -136,6 +187,7 @@ func (app *App) UpsertScore(ctx context.Context, req *api.UpsertScoreRequest) (*
 		Rank:         int32(member.Rank),
 		PreviousRank: int32(member.PreviousRank),
 		ExpireAt:     int32(member.ExpireAt),
+		Version:      member.Version,
 	}, nil
 }
 
@@ -155,8 +207,8 @@ func (app *App) IncrementScore(ctx context.Context, req *api.IncrementScoreReque
 	err := withSegment("Model", ctx, func() error {
 		var err error
 		lg.Debug("Incrementing member score.", zap.Int64("increment", int64(req.Body.Increment)))
-		member, err = app.Leaderboards.IncrementMemberScore(context.Background(), req.LeaderboardId, req.MemberPublicId,
-			int(req.Body.Increment), getScoreTTL(req.ScoreTTL))
+		member, err = app.Leaderboards.IncrementMemberScore(ctx, req.LeaderboardId, req.MemberPublicId,
+			int(req.Body.Increment), getScoreTTL(req.ScoreTTL), app.getIdempotencyKey(req.IdempotencyKey))
 
 		if err != nil {
 			lg.Error("Member score increment failed.", zap.Error(err))
@@ -165,7 +217,7 @@ func (app *App) IncrementScore(ctx context.Context, req *api.IncrementScoreReque
 				return status.Errorf(codes.InvalidArgument, err.Error())
 			}
 
-			return err
+			return writeErrorStatus(err)
 		}
 		lg.Debug("Member score increment succeeded.")
 		return nil
@@ -200,7 +252,7 @@ func (app *App) RemoveMember(ctx context.Context, req *api.RemoveMemberRequest)
 		if err := app.Leaderboards.RemoveMember(ctx, req.LeaderboardId, req.MemberPublicId); err != nil && !strings.HasPrefix(err.Error(), notFoundError) {
 			lg.Error("Member removal failed.", zap.Error(err))
 			app.AddError()
-			return err
+			return writeErrorStatus(err)
 		}
 		lg.Debug("Member removal succeeded.")
 		return nil
@@ -237,7 +289,7 @@ func (app *App) RemoveMembers(ctx context.Context, req *api.RemoveMembersRequest
 		if err := app.Leaderboards.RemoveMembers(ctx, req.LeaderboardId, idsInter); err != nil && !strings.HasPrefix(err.Error(), notFoundError) {
 			lg.Error("Members removal failed.", zap.Error(err))
 			app.AddError()
-			return err
+			return writeErrorStatus(err)
 		}
 		lg.Debug("Members removal succeeded.")
 		return nil
@@ -711,12 +763,13 @@ func (app *App) UpsertScoreMultiLeaderboards(ctx context.Context, req *api.Upser
 				zap.Int64("score", int64(req.ScoreMultiChange.Score)))
 
 			member, err := app.Leaderboards.SetMemberScore(ctx, leaderboardID, req.MemberPublicId,
-				int64(req.ScoreMultiChange.Score), req.PrevRank, getScoreTTL(req.ScoreTTL))
+				int64(req.ScoreMultiChange.Score), req.PrevRank, getScoreTTL(req.ScoreTTL),
+				app.getIdempotencyKey(req.IdempotencyKey), nil)
 
 			if err != nil {
 				lg.Error("Update score failed.", zap.Error(err))
 				app.AddError()
-				return err
+				return writeErrorStatus(err)
 			}
 			serializedScore := &api.UpsertScoreMultiLeaderboardsResponse_Member{
 				PublicID:      member.PublicID,
@@ -755,7 +808,7 @@ func (app *App) RemoveLeaderboard(ctx context.Context, req *api.RemoveLeaderboar
 		if err := app.Leaderboards.RemoveLeaderboard(ctx, leaderboardID); err != nil {
 			lg.Error("Remove leaderboard failed.", zap.Error(err))
 			app.AddError()
-			return err
+			return writeErrorStatus(err)
 		}
 		lg.Debug("Remove leaderboard succeeded.")
 		return nil
@@ -766,3 +819,910 @@ func (app *App) RemoveLeaderboard(ctx context.Context, req *api.RemoveLeaderboar
 
 	return &api.RemoveLeaderboardResponse{Success: true}, nil
 }
+
+func newResetProgressResponse(progress *lmodel.ResetProgress) *api.ResetProgress {
+	return &api.ResetProgress{
+		LeaderboardID: progress.Leaderboard,
+		Target:        progress.Target,
+		Strategy:      string(progress.Strategy),
+		Status:        progress.Status,
+		Processed:     int32(progress.Processed),
+		Total:         int32(progress.Total),
+		StartedAt:     progress.StartedAt,
+		FinishedAt:    progress.FinishedAt,
+	}
+}
+
+// ResetLeaderboard is the handler responsible for resetting a leaderboard for a new season.
+func (app *App) ResetLeaderboard(ctx context.Context, req *api.ResetLeaderboardRequest) (*api.ResetLeaderboardResponse, error) {
+	if req.ResetOptions == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "reset options are required")
+	}
+
+	lg := app.Logger.With(
+		zap.String("handler", "ResetLeaderboard"),
+		zap.String("leaderboard", req.LeaderboardId),
+		zap.String("strategy", req.ResetOptions.Strategy),
+		zap.String("target", req.ResetOptions.Target),
+	)
+
+	var progress *lmodel.ResetProgress
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Resetting leaderboard.")
+		progress, err = app.Leaderboards.ResetLeaderboard(ctx, req.LeaderboardId, &lmodel.ResetOptions{
+			Strategy:  lmodel.ResetStrategy(req.ResetOptions.Strategy),
+			Target:    req.ResetOptions.Target,
+			Base:      int64(req.ResetOptions.Base),
+			Factor:    req.ResetOptions.Factor,
+			TopK:      int(req.ResetOptions.TopK),
+			Order:     req.ResetOptions.Order,
+			ChunkSize: int(req.ResetOptions.ChunkSize),
+		})
+
+		if err != nil {
+			lg.Error("Reset leaderboard failed.", zap.Error(err))
+			app.AddError()
+			switch err.(type) {
+			case *service.InvalidResetOptionsError, *service.LeaderboardExpiredError:
+				return status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			return err
+		}
+		lg.Debug("Reset leaderboard succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ResetLeaderboardResponse{
+		Success:  true,
+		Progress: newResetProgressResponse(progress),
+	}, nil
+}
+
+// GetResetLeaderboardProgress is the handler responsible for retrieving the progress of a leaderboard reset.
+func (app *App) GetResetLeaderboardProgress(ctx context.Context, req *api.GetResetLeaderboardProgressRequest) (*api.GetResetLeaderboardProgressResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "GetResetLeaderboardProgress"),
+		zap.String("leaderboard", req.LeaderboardId),
+	)
+
+	var progress *lmodel.ResetProgress
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Getting reset progress.")
+		progress, err = app.Leaderboards.GetResetProgress(ctx, req.LeaderboardId)
+
+		if err != nil {
+			lg.Error("Getting reset progress failed.", zap.Error(err))
+			app.AddError()
+			if _, ok := err.(*service.ResetProgressNotFoundError); ok {
+				return status.Errorf(codes.NotFound, err.Error())
+			}
+			return err
+		}
+		lg.Debug("Getting reset progress succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.GetResetLeaderboardProgressResponse{
+		Success:  true,
+		Progress: newResetProgressResponse(progress),
+	}, nil
+}
+
+func newLeaderboardSettingsResponse(leaderboard string, settings *lmodel.LeaderboardSettings) *api.LeaderboardSettings {
+	response := &api.LeaderboardSettings{
+		LeaderboardID:     leaderboard,
+		DecayHalfLife:     int32(settings.DecayHalfLife),
+		DecayLandmark:     settings.DecayLandmark,
+		WriteStartAt:      settings.WriteStartAt,
+		WriteEndAt:        settings.WriteEndAt,
+		ParticipantsOnly:  settings.ParticipantsOnly,
+		Frozen:            settings.Frozen,
+		MaxIncrement:      settings.MaxIncrement,
+		MaxIncrease:       settings.MaxIncrease,
+		MaxIncreaseWindow: settings.MaxIncreaseWindow,
+		MonotonicOnly:     settings.MonotonicOnly,
+		SignatureGame:     settings.SignatureGame,
+		HistoryEnabled:    settings.HistoryEnabled,
+		HistoryInterval:   settings.HistoryInterval,
+		SnapshotInterval:  settings.SnapshotInterval,
+		SnapshotRetention: settings.SnapshotRetention,
+		LedgerRetention:   settings.LedgerRetention,
+		LedgerMaxEntries:  settings.LedgerMaxEntries,
+	}
+	if settings.MinScore != nil {
+		response.MinScore = &wrappers.Int64Value{Value: *settings.MinScore}
+	}
+	if settings.MaxScore != nil {
+		response.MaxScore = &wrappers.Int64Value{Value: *settings.MaxScore}
+	}
+	for _, rule := range settings.Milestones {
+		response.Milestones = append(response.Milestones, &api.MilestoneRule{
+			Id:     rule.ID,
+			Type:   rule.Type,
+			Rank:   int32(rule.Rank),
+			Member: rule.Member,
+		})
+	}
+
+	return response
+}
+
+func getLeaderboardSettings(settings *api.UpdateLeaderboardSettingsRequest_Settings) *lmodel.LeaderboardSettings {
+	leaderboardSettings := &lmodel.LeaderboardSettings{
+		DecayHalfLife:     int64(settings.DecayHalfLife),
+		MaxIncrement:      settings.MaxIncrement,
+		MaxIncrease:       settings.MaxIncrease,
+		MaxIncreaseWindow: settings.MaxIncreaseWindow,
+		MonotonicOnly:     settings.MonotonicOnly,
+		SignatureGame:     settings.SignatureGame,
+		HistoryEnabled:    settings.HistoryEnabled,
+		HistoryInterval:   settings.HistoryInterval,
+		SnapshotInterval:  settings.SnapshotInterval,
+		SnapshotRetention: settings.SnapshotRetention,
+		LedgerRetention:   settings.LedgerRetention,
+		LedgerMaxEntries:  settings.LedgerMaxEntries,
+	}
+	if settings.MinScore != nil {
+		leaderboardSettings.MinScore = &settings.MinScore.Value
+	}
+	if settings.MaxScore != nil {
+		leaderboardSettings.MaxScore = &settings.MaxScore.Value
+	}
+	for _, rule := range settings.Milestones {
+		leaderboardSettings.Milestones = append(leaderboardSettings.Milestones, &lmodel.MilestoneRule{
+			ID:     rule.Id,
+			Type:   rule.Type,
+			Rank:   int(rule.Rank),
+			Member: rule.Member,
+		})
+	}
+
+	return leaderboardSettings
+}
+
+// GetLeaderboardSettings is the handler responsible for retrieving leaderboard settings.
+func (app *App) GetLeaderboardSettings(ctx context.Context, req *api.GetLeaderboardSettingsRequest) (*api.LeaderboardSettingsResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "GetLeaderboardSettings"),
+		zap.String("leaderboard", req.LeaderboardId),
+	)
+
+	var settings *lmodel.LeaderboardSettings
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Getting leaderboard settings.")
+		settings, err = app.Leaderboards.GetLeaderboardSettings(ctx, req.LeaderboardId)
+
+		if err != nil {
+			lg.Error("Getting leaderboard settings failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Getting leaderboard settings succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.LeaderboardSettingsResponse{
+		Success:  true,
+		Settings: newLeaderboardSettingsResponse(req.LeaderboardId, settings),
+	}, nil
+}
+
+// UpdateLeaderboardSettings is the handler responsible for replacing leaderboard settings.
+func (app *App) UpdateLeaderboardSettings(ctx context.Context, req *api.UpdateLeaderboardSettingsRequest) (*api.LeaderboardSettingsResponse, error) {
+	if req.Settings == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "settings are required")
+	}
+
+	lg := app.Logger.With(
+		zap.String("handler", "UpdateLeaderboardSettings"),
+		zap.String("leaderboard", req.LeaderboardId),
+		zap.Int32("decayHalfLife", req.Settings.DecayHalfLife),
+	)
+
+	var settings *lmodel.LeaderboardSettings
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Updating leaderboard settings.")
+		settings, err = app.Leaderboards.UpdateLeaderboardSettings(ctx, req.LeaderboardId, getLeaderboardSettings(req.Settings))
+
+		if err != nil {
+			lg.Error("Update leaderboard settings failed.", zap.Error(err))
+			app.AddError()
+			if _, ok := err.(*service.InvalidLeaderboardSettingsError); ok {
+				return status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			return err
+		}
+		lg.Debug("Update leaderboard settings succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	app.signatureGames.invalidate(req.LeaderboardId)
+
+	return &api.LeaderboardSettingsResponse{
+		Success:  true,
+		Settings: newLeaderboardSettingsResponse(req.LeaderboardId, settings),
+	}, nil
+}
+
+// FreezeLeaderboard is the handler responsible for rejecting writes to a leaderboard.
+func (app *App) FreezeLeaderboard(ctx context.Context, req *api.FreezeLeaderboardRequest) (*api.LeaderboardSettingsResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "FreezeLeaderboard"),
+		zap.String("leaderboard", req.LeaderboardId),
+	)
+
+	var settings *lmodel.LeaderboardSettings
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Freezing leaderboard.")
+		settings, err = app.Leaderboards.FreezeLeaderboard(ctx, req.LeaderboardId)
+
+		if err != nil {
+			lg.Error("Freeze leaderboard failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Freeze leaderboard succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.LeaderboardSettingsResponse{
+		Success:  true,
+		Settings: newLeaderboardSettingsResponse(req.LeaderboardId, settings),
+	}, nil
+}
+
+// UnfreezeLeaderboard is the handler responsible for accepting writes to a frozen leaderboard again.
+func (app *App) UnfreezeLeaderboard(ctx context.Context, req *api.UnfreezeLeaderboardRequest) (*api.LeaderboardSettingsResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "UnfreezeLeaderboard"),
+		zap.String("leaderboard", req.LeaderboardId),
+	)
+
+	var settings *lmodel.LeaderboardSettings
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Unfreezing leaderboard.")
+		settings, err = app.Leaderboards.UnfreezeLeaderboard(ctx, req.LeaderboardId)
+
+		if err != nil {
+			lg.Error("Unfreeze leaderboard failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Unfreeze leaderboard succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.LeaderboardSettingsResponse{
+		Success:  true,
+		Settings: newLeaderboardSettingsResponse(req.LeaderboardId, settings),
+	}, nil
+}
+
+// GetRejectedScores is the handler responsible for retrieving the scores rejected by leaderboard rules.
+func (app *App) GetRejectedScores(ctx context.Context, req *api.GetRejectedScoresRequest) (*api.GetRejectedScoresResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "GetRejectedScores"),
+		zap.String("leaderboard", req.LeaderboardId),
+	)
+
+	page := int(math.Max(float64(req.Page), 1))
+
+	pageSize := getPageSize(int(req.PageSize))
+	if pageSize > app.Config.GetInt("api.maxReturnedMembers") {
+		msg := fmt.Sprintf(
+			"Max pageSize allowed: %d. pageSize requested: %d",
+			app.Config.GetInt("api.maxReturnedMembers"),
+			pageSize,
+		)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+
+	var rejectedScores []*lmodel.RejectedScore
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Getting rejected scores.")
+		rejectedScores, err = app.Leaderboards.GetRejectedScores(ctx, req.LeaderboardId, pageSize, page)
+
+		if err != nil {
+			lg.Error("Getting rejected scores failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Getting rejected scores succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &api.GetRejectedScoresResponse{
+		Success:        true,
+		RejectedScores: make([]*api.GetRejectedScoresResponse_RejectedScore, len(rejectedScores)),
+	}
+	for i, rejectedScore := range rejectedScores {
+		response.RejectedScores[i] = &api.GetRejectedScoresResponse_RejectedScore{
+			PublicID:      rejectedScore.PublicID,
+			Score:         rejectedScore.Score,
+			PreviousScore: rejectedScore.PreviousScore,
+			Rule:          rejectedScore.Rule,
+			RejectedAt:    rejectedScore.RejectedAt,
+		}
+	}
+
+	return response, nil
+}
+
+// BlockMember is the handler responsible for blocking a member in a leaderboard.
+func (app *App) BlockMember(ctx context.Context, req *api.BlockMemberRequest) (*api.BlocklistResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "BlockMember"),
+		zap.String("leaderboard", req.LeaderboardId),
+		zap.String("memberPublicID", req.MemberPublicId),
+		zap.String("mode", req.Mode),
+	)
+
+	return app.blockMember(ctx, lg, req.LeaderboardId, req.MemberPublicId, req.Mode)
+}
+
+// UnblockMember is the handler responsible for unblocking a member in a leaderboard.
+func (app *App) UnblockMember(ctx context.Context, req *api.UnblockMemberRequest) (*api.BlocklistResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "UnblockMember"),
+		zap.String("leaderboard", req.LeaderboardId),
+		zap.String("memberPublicID", req.MemberPublicId),
+	)
+
+	return app.unblockMember(ctx, lg, req.LeaderboardId, req.MemberPublicId)
+}
+
+// BlockMemberGlobally is the handler responsible for blocking a member in every leaderboard.
+func (app *App) BlockMemberGlobally(ctx context.Context, req *api.BlockMemberGloballyRequest) (*api.BlocklistResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "BlockMemberGlobally"),
+		zap.String("memberPublicID", req.MemberPublicId),
+		zap.String("mode", req.Mode),
+	)
+
+	return app.blockMember(ctx, lg, "", req.MemberPublicId, req.Mode)
+}
+
+// UnblockMemberGlobally is the handler responsible for unblocking a member in every leaderboard.
+func (app *App) UnblockMemberGlobally(ctx context.Context, req *api.UnblockMemberGloballyRequest) (*api.BlocklistResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "UnblockMemberGlobally"),
+		zap.String("memberPublicID", req.MemberPublicId),
+	)
+
+	return app.unblockMember(ctx, lg, "", req.MemberPublicId)
+}
+
+func (app *App) blockMember(ctx context.Context, lg *zap.Logger, leaderboard, member, mode string) (*api.BlocklistResponse, error) {
+	err := withSegment("Model", ctx, func() error {
+		lg.Debug("Blocking member.")
+		err := app.Leaderboards.BlockMember(ctx, leaderboard, member, mode)
+
+		if err != nil {
+			if _, ok := err.(*service.InvalidBlockModeError); ok {
+				return status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			lg.Error("Block member failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Block member succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.BlocklistResponse{Success: true}, nil
+}
+
+func (app *App) unblockMember(ctx context.Context, lg *zap.Logger, leaderboard, member string) (*api.BlocklistResponse, error) {
+	err := withSegment("Model", ctx, func() error {
+		lg.Debug("Unblocking member.")
+		err := app.Leaderboards.UnblockMember(ctx, leaderboard, member)
+
+		if err != nil {
+			lg.Error("Unblock member failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Unblock member succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.BlocklistResponse{Success: true}, nil
+}
+
+// GetMemberLedger is the handler responsible for retrieving the changes of a member score.
+func (app *App) GetMemberLedger(ctx context.Context, req *api.GetMemberLedgerRequest) (*api.GetMemberLedgerResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "GetMemberLedger"),
+		zap.String("leaderboard", req.LeaderboardId),
+		zap.String("member", req.MemberPublicId),
+	)
+
+	page := int(math.Max(float64(req.Page), 1))
+
+	pageSize := getPageSize(int(req.PageSize))
+	if pageSize > app.Config.GetInt("api.maxReturnedMembers") {
+		msg := fmt.Sprintf(
+			"Max pageSize allowed: %d. pageSize requested: %d",
+			app.Config.GetInt("api.maxReturnedMembers"),
+			pageSize,
+		)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+
+	var entries []*lmodel.LedgerEntry
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Getting member ledger.")
+		entries, err = app.Leaderboards.GetMemberLedger(ctx, req.LeaderboardId, req.MemberPublicId, req.Since, pageSize, page)
+
+		if err != nil {
+			lg.Error("Getting member ledger failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Getting member ledger succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &api.GetMemberLedgerResponse{
+		Success: true,
+		Entries: make([]*api.LedgerEntry, len(entries)),
+	}
+	for i, entry := range entries {
+		response.Entries[i] = newLedgerEntryResponse(entry)
+	}
+
+	return response, nil
+}
+
+// RollbackMember is the handler responsible for reverting the changes of a member score.
+func (app *App) RollbackMember(ctx context.Context, req *api.RollbackMemberRequest) (*api.RollbackMemberResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "RollbackMember"),
+		zap.String("leaderboard", req.LeaderboardId),
+		zap.String("member", req.MemberPublicId),
+		zap.Int64("since", req.Since),
+	)
+
+	var entry *lmodel.LedgerEntry
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Rolling back member.")
+		entry, err = app.Leaderboards.RollbackMember(ctx, req.LeaderboardId, req.MemberPublicId, req.Since)
+
+		if err != nil {
+			if _, ok := err.(*service.ScoreChangesNotFoundError); ok {
+				return status.Errorf(codes.NotFound, err.Error())
+			}
+			lg.Error("Rollback member failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Rollback member succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.RollbackMemberResponse{
+		Success: true,
+		Entry:   newLedgerEntryResponse(entry),
+	}, nil
+}
+
+func newLedgerEntryResponse(entry *lmodel.LedgerEntry) *api.LedgerEntry {
+	response := &api.LedgerEntry{
+		LeaderboardId: entry.Leaderboard,
+		PublicID:      entry.PublicID,
+		Delta:         entry.Delta,
+		Reason:        entry.Reason,
+		Caller:        entry.Caller,
+		ChangedAt:     entry.ChangedAt,
+	}
+	if entry.OldScore != nil {
+		response.OldScore = &wrappers.Int64Value{Value: *entry.OldScore}
+	}
+	if entry.NewScore != nil {
+		response.NewScore = &wrappers.Int64Value{Value: *entry.NewScore}
+	}
+
+	return response
+}
+
+// GetMemberHistory is the handler responsible for retrieving the score and rank samples of a member.
+func (app *App) GetMemberHistory(ctx context.Context, req *api.GetMemberHistoryRequest) (*api.GetMemberHistoryResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "GetMemberHistory"),
+		zap.String("leaderboard", req.LeaderboardId),
+		zap.String("member", req.MemberPublicId),
+		zap.Int64("from", req.From),
+		zap.Int64("to", req.To),
+	)
+
+	if req.To != 0 && req.To < req.From {
+		return nil, status.Errorf(codes.InvalidArgument, "to %d must not be before from %d", req.To, req.From)
+	}
+
+	var samples []*lmodel.HistorySample
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Getting member history.")
+		samples, err = app.Leaderboards.GetMemberHistory(ctx, req.LeaderboardId, req.MemberPublicId, req.From, req.To)
+
+		if err != nil {
+			lg.Error("Getting member history failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Getting member history succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &api.GetMemberHistoryResponse{
+		Success: true,
+		Samples: make([]*api.HistorySample, len(samples)),
+	}
+	for i, sample := range samples {
+		response.Samples[i] = &api.HistorySample{
+			Score:     sample.Score,
+			Rank:      int32(sample.Rank),
+			SampledAt: sample.SampledAt,
+		}
+	}
+
+	return response, nil
+}
+
+// GetRankMovers is the handler responsible for retrieving the members whose ranks changed the most between rank snapshots.
+func (app *App) GetRankMovers(ctx context.Context, req *api.GetRankMoversRequest) (*api.GetRankMoversResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "GetRankMovers"),
+		zap.String("leaderboard", req.LeaderboardId),
+		zap.Int64("from", req.From),
+		zap.Int64("to", req.To),
+	)
+
+	limit := getPageSize(int(req.Limit))
+	if limit > app.Config.GetInt("api.maxReturnedMembers") {
+		msg := fmt.Sprintf(
+			"Max limit allowed: %d. limit requested: %d",
+			app.Config.GetInt("api.maxReturnedMembers"),
+			limit,
+		)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+
+	if req.To != 0 && req.To < req.From {
+		return nil, status.Errorf(codes.InvalidArgument, "to %d must not be before from %d", req.To, req.From)
+	}
+
+	var movers *lmodel.RankMovers
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Getting rank movers.")
+		movers, err = app.Leaderboards.GetRankMovers(ctx, req.LeaderboardId, req.From, req.To, limit, getOrder(req.Order))
+
+		if err != nil {
+			if _, ok := err.(*service.RankSnapshotNotFoundError); ok {
+				return status.Errorf(codes.NotFound, err.Error())
+			}
+			lg.Error("Getting rank movers failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Getting rank movers succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.GetRankMoversResponse{
+		Success:  true,
+		From:     movers.From,
+		To:       movers.To,
+		Climbers: newRankMovesResponse(movers.Climbers),
+		Fallers:  newRankMovesResponse(movers.Fallers),
+	}, nil
+}
+
+func newRankMovesResponse(moves []*lmodel.RankMove) []*api.RankMove {
+	response := make([]*api.RankMove, len(moves))
+	for i, move := range moves {
+		response[i] = &api.RankMove{
+			PublicID:     move.PublicID,
+			PreviousRank: int32(move.PreviousRank),
+			Rank:         int32(move.Rank),
+			RankDelta:    int32(move.RankDelta),
+		}
+	}
+
+	return response
+}
+
+// DiffLeaderboard is the handler responsible for streaming the members that changed in a leaderboard between rank snapshots.
+func (app *App) DiffLeaderboard(req *api.DiffLeaderboardRequest, stream api.Podium_DiffLeaderboardServer) error {
+	ctx := stream.Context()
+	lg := app.Logger.With(
+		zap.String("handler", "DiffLeaderboard"),
+		zap.String("leaderboard", req.LeaderboardId),
+		zap.Int64("from", req.From),
+		zap.Int64("to", req.To),
+	)
+
+	if req.To != 0 && req.To < req.From {
+		return status.Errorf(codes.InvalidArgument, "to %d must not be before from %d", req.To, req.From)
+	}
+
+	return withSegment("Model", ctx, func() error {
+		lg.Debug("Diffing leaderboard.")
+		err := app.Leaderboards.DiffLeaderboard(ctx, req.LeaderboardId, req.From, req.To, getOrder(req.Order), func(diff *lmodel.MemberDiff) error {
+			return stream.Send(newMemberDiffResponse(diff))
+		})
+
+		if err != nil {
+			if _, ok := err.(*service.RankSnapshotNotFoundError); ok {
+				return status.Errorf(codes.NotFound, err.Error())
+			}
+			lg.Error("Diffing leaderboard failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Diffing leaderboard succeeded.")
+		return nil
+	})
+}
+
+func newMemberDiffResponse(diff *lmodel.MemberDiff) *api.MemberDiff {
+	response := &api.MemberDiff{
+		PublicID:     diff.PublicID,
+		Change:       diff.Change,
+		PreviousRank: int32(diff.PreviousRank),
+		Rank:         int32(diff.Rank),
+		ScoreDelta:   diff.ScoreDelta,
+		RankDelta:    int32(diff.RankDelta),
+	}
+	if diff.PreviousScore != nil {
+		response.PreviousScore = &wrappers.Int64Value{Value: *diff.PreviousScore}
+	}
+	if diff.Score != nil {
+		response.Score = &wrappers.Int64Value{Value: *diff.Score}
+	}
+
+	return response
+}
+
+// WatchTopMembers streams the top members of a leaderboard whenever they change, starting with the current ones.
+func (app *App) WatchTopMembers(req *api.WatchTopMembersRequest, stream api.Podium_WatchTopMembersServer) error {
+	ctx := stream.Context()
+	lg := app.Logger.With(
+		zap.String("handler", "WatchTopMembers"),
+		zap.String("leaderboard", req.LeaderboardId),
+	)
+
+	pageSize := getPageSize(int(req.PageSize))
+	if pageSize > app.Config.GetInt("api.maxReturnedMembers") {
+		msg := fmt.Sprintf(
+			"Max pageSize allowed: %d. pageSize requested: %d",
+			app.Config.GetInt("api.maxReturnedMembers"),
+			pageSize,
+		)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+
+	lg.Debug("Watching top members.")
+	subscription, err := app.subscribeTopMembers(req.LeaderboardId, getOrder(req.Order), pageSize)
+	if err != nil {
+		app.AddError()
+		return status.Errorf(codes.ResourceExhausted, err.Error())
+	}
+	defer app.membersWatcher.unsubscribe(subscription)
+
+	for {
+		select {
+		case update := <-subscription.updates:
+			if update.err != nil {
+				lg.Error("Watch top members failed.", zap.Error(update.err))
+				continue
+			}
+
+			err := stream.Send(&api.GetTopMembersResponse{
+				Success: true,
+				Members: newMemberRankResponseList(update.members),
+			})
+			if err != nil {
+				lg.Debug("Sending top members failed.", zap.Error(err))
+				return err
+			}
+		case <-ctx.Done():
+			lg.Debug("Watching top members ended.")
+			return nil
+		case <-app.membersWatcher.done:
+			lg.Debug("Watching top members ended by shutdown.")
+			return nil
+		}
+	}
+}
+
+// ImportScores imports the scores sent in chunks, responding with the acknowledgement of each chunk after the last one.
+func (app *App) ImportScores(stream api.Podium_ImportScoresServer) error {
+	ctx := stream.Context()
+
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Errorf(codes.InvalidArgument, "at least one chunk is required")
+	}
+	if err != nil {
+		return err
+	}
+	if first.LeaderboardId == "" {
+		return status.Errorf(codes.InvalidArgument, "leaderboardID is required")
+	}
+
+	lg := app.Logger.With(
+		zap.String("handler", "ImportScores"),
+		zap.String("leaderboard", first.LeaderboardId),
+		zap.Bool("replace", first.Replace),
+	)
+
+	pending := first
+	next := func() ([]*lmodel.Member, error) {
+		req := pending
+		pending = nil
+		if req == nil {
+			var err error
+			req, err = stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if req.LeaderboardId != "" && req.LeaderboardId != first.LeaderboardId {
+			return nil, status.Errorf(codes.InvalidArgument, "chunk of leaderboard %s sent to import of %s", req.LeaderboardId, first.LeaderboardId)
+		}
+
+		members := make([]*lmodel.Member, len(req.Members))
+		for i, ms := range req.Members {
+			members[i] = &lmodel.Member{Score: int64(ms.Score), PublicID: ms.PublicID}
+		}
+		return members, nil
+	}
+
+	var result *lmodel.ImportResult
+	err = withSegment("Model", ctx, func() error {
+		lg.Debug("Importing scores.")
+		result, err = app.Leaderboards.ImportMembers(ctx, first.LeaderboardId, first.Replace, next)
+		if err != nil {
+			lg.Error("Importing scores failed.", zap.Error(err))
+			app.AddError()
+			if _, ok := err.(*service.LeaderboardExpiredError); ok {
+				return status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			return writeErrorStatus(err)
+		}
+		lg.Debug("Importing scores succeeded.", zap.Int("imported", result.Imported), zap.Int("failed", result.Failed))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(newImportScoresResponse(result))
+}
+
+func newImportScoresResponse(result *lmodel.ImportResult) *api.ImportScoresResponse {
+	chunks := make([]*api.ImportScoresResponse_Chunk, len(result.Chunks))
+	for i, chunk := range result.Chunks {
+		failures := make([]*api.ImportScoresResponse_Failure, len(chunk.Failures))
+		for j, failure := range chunk.Failures {
+			failures[j] = &api.ImportScoresResponse_Failure{
+				PublicID: failure.PublicID,
+				Reason:   failure.Reason,
+			}
+		}
+
+		chunks[i] = &api.ImportScoresResponse_Chunk{
+			Chunk:    int32(chunk.Chunk),
+			Imported: int32(chunk.Imported),
+			Failures: failures,
+		}
+	}
+
+	return &api.ImportScoresResponse{
+		Success:  result.Failed == 0,
+		Chunks:   chunks,
+		Imported: int64(result.Imported),
+		Failed:   int64(result.Failed),
+		Replaced: result.Replaced,
+	}
+}
+
+// ExportLeaderboard streams every member of a leaderboard in chunks
+func (app *App) ExportLeaderboard(req *api.ExportLeaderboardRequest, stream api.Podium_ExportLeaderboardServer) error {
+	ctx := stream.Context()
+	lg := app.Logger.With(
+		zap.String("handler", "ExportLeaderboard"),
+		zap.String("leaderboard", req.LeaderboardId),
+		zap.Int32("chunkSize", req.ChunkSize),
+	)
+
+	if int(req.ChunkSize) > app.Config.GetInt("api.maxReturnedMembers") {
+		msg := fmt.Sprintf(
+			"Max chunkSize allowed: %d. chunkSize requested: %d",
+			app.Config.GetInt("api.maxReturnedMembers"),
+			req.ChunkSize,
+		)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+
+	return withSegment("Model", ctx, func() error {
+		lg.Debug("Exporting leaderboard.")
+		err := app.Leaderboards.ExportLeaderboard(ctx, req.LeaderboardId, getOrder(req.Order), int(req.ChunkSize), func(members []*lmodel.Member) error {
+			return stream.Send(newExportLeaderboardResponse(members))
+		})
+
+		if err != nil {
+			lg.Error("Exporting leaderboard failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Exporting leaderboard succeeded.")
+		return nil
+	})
+}
+
+func newExportLeaderboardResponse(members []*lmodel.Member) *api.ExportLeaderboardResponse {
+	response := &api.ExportLeaderboardResponse{
+		Members: make([]*api.ExportLeaderboardResponse_Member, 0, len(members)),
+	}
+	for _, member := range members {
+		response.Members = append(response.Members, &api.ExportLeaderboardResponse_Member{
+			PublicID: member.PublicID,
+			Score:    float64(member.Score),
+			Rank:     int32(member.Rank),
+			ExpireAt: int32(member.ExpireAt),
+		})
+	}
+
+	return response
+}
@@ -10,18 +10,33 @@
 package api_test
 
 import (
+	"bufio"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
+	"os"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/topfreegames/podium/api"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
 	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+	lmodel "github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/log"
 	"github.com/topfreegames/podium/testing"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	. "github.com/onsi/ginkgo"
@@ -30,6 +45,7 @@ import (
 
 	uuid "github.com/satori/go.uuid"
 	pb "github.com/topfreegames/podium/proto/podium/api/v1"
+	"golang.org/x/net/websocket"
 )
 
 var _ = Describe("Leaderboard Handler", func() {
@@ -49,6 +65,7 @@ var _ = Describe("Leaderboard Handler", func() {
 	AfterEach(func() {
 		redisClient.Del(context.Background(), "testkey")
 		redisClient.Del(context.Background(), "testkey:ttl")
+		redisClient.Del(context.Background(), "{testkey}:versions")
 		redisClient.Del(context.Background(), "testkey1")
 		redisClient.Del(context.Background(), "testkey2")
 		redisClient.Del(context.Background(), "testkey3")
@@ -453,6 +470,81 @@ var _ = Describe("Leaderboard Handler", func() {
 			})
 		})
 
+		It("Should return the first response when retried with the same idempotencyKey (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				req := &pb.UpsertScoreRequest{
+					LeaderboardId:  testLeaderboardID,
+					MemberPublicId: "memberpublicid",
+					ScoreChange:    &pb.UpsertScoreRequest_ScoreChange{Score: 100},
+					IdempotencyKey: uuid.NewV4().String(),
+				}
+
+				resp, err := cli.UpsertScore(context.Background(), req)
+				Expect(err).NotTo(HaveOccurred())
+
+				retriedResp, err := cli.UpsertScore(context.Background(), req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(retriedResp.Score).To(Equal(resp.Score))
+				Expect(retriedResp.Rank).To(Equal(resp.Rank))
+				Expect(retriedResp.Version).To(Equal(resp.Version))
+
+				req.ScoreChange.Score = 200
+				_, err = cli.UpsertScore(context.Background(), req)
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+				member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(member.Score).To(Equal(int64(100)))
+			})
+		})
+
+		It("Should set member score only if it has the expected version (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				req := &pb.UpsertScoreRequest{
+					LeaderboardId:  testLeaderboardID,
+					MemberPublicId: "memberpublicid",
+					ScoreChange: &pb.UpsertScoreRequest_ScoreChange{
+						Score:           100,
+						ExpectedVersion: &wrappers.Int64Value{Value: 0},
+					},
+				}
+
+				resp, err := cli.UpsertScore(context.Background(), req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Version).To(Equal(int64(1)))
+
+				req.ScoreChange.Score = 200
+				_, err = cli.UpsertScore(context.Background(), req)
+				Expect(status.Code(err)).To(Equal(codes.Aborted))
+
+				req.ScoreChange.ExpectedVersion = &wrappers.Int64Value{Value: resp.Version}
+				resp, err = cli.UpsertScore(context.Background(), req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Score).To(Equal(float64(200)))
+				Expect(resp.Version).To(Equal(int64(2)))
+			})
+		})
+
+		It("Should return status code 409 if member does not have the expected score (http)", func() {
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			payload := map[string]interface{}{
+				"score":         int64(200),
+				"expectedScore": int64(50),
+			}
+			status, body := PutJSON(app, "/l/testkey/members/memberpublicid/score", payload)
+			Expect(status).To(Equal(http.StatusConflict), body)
+
+			payload["expectedScore"] = int64(100)
+			status, body = PutJSON(app, "/l/testkey/members/memberpublicid/score", payload)
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(200)))
+		})
+
 		It("Should set correct member score in redis and respond with the correct values if bigger than int", func() {
 			bigScore := int64(15584657100001)
 			payload := map[string]interface{}{
@@ -632,7 +724,7 @@ var _ = Describe("Leaderboard Handler", func() {
 				"increment": 10,
 			}
 
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			status, body := PatchJSON(app, "/l/testkey/members/memberpublicid/score", payload)
@@ -660,7 +752,7 @@ var _ = Describe("Leaderboard Handler", func() {
 					Body:           &pb.IncrementScoreRequest_Body{Increment: 10},
 				}
 
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 
 				resp, err := cli.IncrementScore(context.Background(), req)
@@ -701,6 +793,32 @@ var _ = Describe("Leaderboard Handler", func() {
 			Expect(member.PublicID).To(Equal("memberpublicid"))
 		})
 
+		It("Should increment member score only once when retried with the same idempotencyKey", func() {
+			payload := map[string]interface{}{
+				"increment": 10,
+			}
+			url := fmt.Sprintf("/l/testkey/members/memberpublicid/score?idempotencyKey=%s", uuid.NewV4().String())
+
+			status, body := PatchJSON(app, url, payload)
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			status, retriedBody := PatchJSON(app, url, payload)
+			Expect(status).To(Equal(http.StatusOK), retriedBody)
+			Expect(retriedBody).To(Equal(body))
+
+			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(10)))
+
+			url = fmt.Sprintf("/l/testkey/members/memberpublicid/score?idempotencyKey=%s", uuid.NewV4().String())
+			status, body = PatchJSON(app, url, payload)
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			member, err = app.Leaderboards.GetMember(NewEmptyCtx(), testLeaderboardID, "memberpublicid", "desc", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(20)))
+		})
+
 		It("Should not work when incrementing by 0", func() {
 			payload := map[string]interface{}{
 				"increment": 0,
@@ -746,6 +864,49 @@ var _ = Describe("Leaderboard Handler", func() {
 			Expect(body).To(ContainSubstring("connection refused"))
 		})
 
+		It("should stop waiting for room in a full score events buffer when the request ends", func() {
+			release := make(chan struct{})
+			webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				<-release
+			}))
+			defer webhook.Close()
+			defer close(release)
+
+			for key, value := range map[string]string{
+				"PODIUM_EVENTS_SINK":        "webhook",
+				"PODIUM_EVENTS_WEBHOOK_URL": webhook.URL,
+				"PODIUM_EVENTS_BUFFERSIZE":  "1",
+				"PODIUM_EVENTS_BATCHSIZE":   "1",
+			} {
+				os.Setenv(key, value)
+				defer os.Unsetenv(key)
+			}
+			logger := log.CreateLoggerWithLevel(zapcore.FatalLevel, log.LoggerOptions{WriteSyncer: os.Stdout, RemoveTimestamp: true})
+			eventsApp, err := api.New("127.0.0.1", 0, 0, "../config/test.yaml", false, logger)
+			Expect(err).NotTo(HaveOccurred())
+
+			increment := func(ctx context.Context) error {
+				_, err := eventsApp.IncrementScore(ctx, &pb.IncrementScoreRequest{
+					LeaderboardId:  uuid.NewV4().String(),
+					MemberPublicId: "member1",
+					Body:           &pb.IncrementScoreRequest_Body{Increment: 10},
+				})
+				return err
+			}
+
+			// the first event is held by the webhook and the second one fills the buffer
+			Expect(increment(context.Background())).To(Succeed())
+			Expect(increment(context.Background())).To(Succeed())
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			done := make(chan error, 1)
+			go func() {
+				done <- increment(ctx)
+			}()
+			Eventually(done, time.Second).Should(Receive())
+		})
+
 		HTTPMeasure("it should update member score", func(ctx map[string]interface{}) {
 			payload := map[string]interface{}{
 				"increment": 100,
@@ -763,7 +924,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 	Describe("Remove Member Score", func() {
 		It("Should delete member score from redis if score exists (http)", func() {
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			status, body := Delete(app, "/l/testkey/members?ids=memberpublicid")
@@ -779,7 +940,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should delete member score from redis if score exists (grpc)", func() {
 			SetupGRPC(app, func(cli pb.PodiumClient) {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 
 				req := &pb.RemoveMemberRequest{
@@ -797,8 +958,8 @@ var _ = Describe("Leaderboard Handler", func() {
 		})
 
 		It("Should delete many member score from redis if they exists", func() {
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "")
-			_, err = app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid2", 100, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "", nil, nil)
+			_, err = app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid2", 100, false, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			status, body := Delete(app, "/l/testkey/members?ids=memberpublicid,memberpublicid2")
@@ -816,7 +977,7 @@ var _ = Describe("Leaderboard Handler", func() {
 		})
 
 		It("Should fail if error removing score", func() {
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			app := GetDefaultTestAppWithFaultyRedis()
@@ -851,7 +1012,7 @@ var _ = Describe("Leaderboard Handler", func() {
 		HTTPMeasure("it should remove member score", func(ctx map[string]interface{}) {
 			lbID := uuid.NewV4().String()
 			memberID := uuid.NewV4().String()
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lbID, memberID, 100, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lbID, memberID, 100, false, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 			ctx["lead"] = lbID
 			ctx["memberID"] = memberID
@@ -868,7 +1029,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 	Describe("Get Member", func() {
 		It("Should get member score from redis if score exists (http)", func() {
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			status, body := Get(app, "/l/testkey/members/memberpublicid")
@@ -889,7 +1050,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get member score from redis if score exists (grpc)", func() {
 			SetupGRPC(app, func(cli pb.PodiumClient) {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 
 				req := &pb.GetMemberRequest{
@@ -914,7 +1075,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get member score from redis if greater than int", func() {
 			bigScore := int64(15584657100001)
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", bigScore, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", bigScore, false, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			status, body := Get(app, "/l/testkey/members/memberpublicid")
@@ -934,7 +1095,7 @@ var _ = Describe("Leaderboard Handler", func() {
 		})
 
 		It("Should get member score from redis if score exists including expiration timestamp", func() {
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "15")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "15", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			status, body := Get(app, "/l/testkey/members/memberpublicid?scoreTTL=true")
@@ -955,7 +1116,7 @@ var _ = Describe("Leaderboard Handler", func() {
 		})
 
 		It("Should get member score from redis if score exists including expiration timestamp if no ttl", func() {
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicidnottl", 100, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicidnottl", 100, false, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			status, body := Get(app, "/l/testkey/members/memberpublicidnottl?scoreTTL=true")
@@ -989,7 +1150,7 @@ var _ = Describe("Leaderboard Handler", func() {
 		HTTPMeasure("it should get member", func(ctx map[string]interface{}) {
 			lbID := uuid.NewV4().String()
 			memberID := uuid.NewV4().String()
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lbID, memberID, 500, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lbID, memberID, 500, false, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			ctx["lead"] = lbID
@@ -1006,7 +1167,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 	Describe("Get Member Rank", func() {
 		It("Should get member score from redis if score exists (http)", func() {
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			status, body := Get(app, "/l/testkey/members/memberpublicid/rank")
@@ -1026,7 +1187,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get member score from redis if score exists (grpc)", func() {
 			SetupGRPC(app, func(cli pb.PodiumClient) {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 
 				req := &pb.GetRankRequest{
@@ -1048,7 +1209,7 @@ var _ = Describe("Leaderboard Handler", func() {
 		})
 
 		It("Should get member score from redis if score exists and order is asc", func() {
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "memberpublicid", 100, false, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			status, body := Get(app, "/l/testkey/members/memberpublicid/rank?order=asc")
@@ -1086,11 +1247,11 @@ var _ = Describe("Leaderboard Handler", func() {
 		HTTPMeasure("it should get member rank", func(ctx map[string]interface{}) {
 			lbID := uuid.NewV4().String()
 			memberID := uuid.NewV4().String()
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lbID, memberID, 500, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lbID, memberID, 500, false, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			for i := 0; i < 10; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lbID, fmt.Sprintf("member-%d", i), 500, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lbID, fmt.Sprintf("member-%d", i), 500, false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1109,7 +1270,7 @@ var _ = Describe("Leaderboard Handler", func() {
 	Describe("Get Around Member Handler", func() {
 		It("Should get member score and neighbours from redis if member score exists (http)", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1139,7 +1300,7 @@ var _ = Describe("Leaderboard Handler", func() {
 		It("Should get member score and neighbours from redis if member score exists (grpc)", func() {
 			SetupGRPC(app, func(cli pb.PodiumClient) {
 				for i := 1; i <= 100; i++ {
-					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "", nil, nil)
 					Expect(err).NotTo(HaveOccurred())
 				}
 
@@ -1171,7 +1332,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get member score and neighbours from redis in reverse order if member score exists", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1192,7 +1353,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get one page of top members from redis if leaderboard exists but less than pageSize neighbours exist", func() {
 			for i := 1; i <= 15; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(16-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(16-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1219,7 +1380,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get member score and default limit neighbours from redis if member score and less than limit neighbours exist", func() {
 			for i := 1; i <= 15; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(16-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(16-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1247,7 +1408,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get member score and limit neighbours from redis if member score exists and custom limit", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1276,7 +1437,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get member score and limit neighbours from redis if member score exists and repeated scores", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1304,7 +1465,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get last positions if not in ranking", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(100-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(100-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1362,7 +1523,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get one page of top members from redis if leaderboard exists and member in ranking bottom", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(100-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(100-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1389,7 +1550,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get one page of top members from redis if leaderboard exists and member in ranking top", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(100-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(100-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1433,11 +1594,11 @@ var _ = Describe("Leaderboard Handler", func() {
 		HTTPMeasure("it should get around member", func(ctx map[string]interface{}) {
 			lead := uuid.NewV4().String()
 			memberID := uuid.NewV4().String()
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, memberID, 500, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, memberID, 500, false, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			for i := 0; i < 10; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, fmt.Sprintf("member-%d", i), 500, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, fmt.Sprintf("member-%d", i), 500, false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1456,7 +1617,7 @@ var _ = Describe("Leaderboard Handler", func() {
 	Describe("Get Around Score Handler", func() {
 		It("Should get score neighbours from redis if score is sent (http)", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1488,7 +1649,7 @@ var _ = Describe("Leaderboard Handler", func() {
 		It("Should get score neighbours from redis if score is sent (grpc)", func() {
 			SetupGRPC(app, func(cli pb.PodiumClient) {
 				for i := 1; i <= 100; i++ {
-					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "", nil, nil)
 					Expect(err).NotTo(HaveOccurred())
 				}
 
@@ -1520,7 +1681,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get rank neighbours from redis in reverse order if score is sent", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1543,7 +1704,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get one page of top members from redis if leaderboard exists but less than pageSize neighbours exist", func() {
 			for i := 1; i <= 15; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(16-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(16-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1571,7 +1732,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should limit neighbours from redis if score is sent and custom limit", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1631,7 +1792,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get one page of top members from redis if leaderboard exists and score <= 0", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(100-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(100-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1665,7 +1826,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get one page of top members from redis if leaderboard exists and score in ranking top", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(100-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(100-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1707,7 +1868,7 @@ var _ = Describe("Leaderboard Handler", func() {
 	Describe("Get Total Members Handler", func() {
 		It("Should get the number of members in a leaderboard it exists (http)", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1720,7 +1881,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get the number of members in a leaderboard it exists (grpc)", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1753,11 +1914,11 @@ var _ = Describe("Leaderboard Handler", func() {
 		HTTPMeasure("it should get total members", func(ctx map[string]interface{}) {
 			lead := uuid.NewV4().String()
 			memberID := uuid.NewV4().String()
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, memberID, 500, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, memberID, 500, false, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			for i := 0; i < 10; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, fmt.Sprintf("member-%d", i), 500, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, fmt.Sprintf("member-%d", i), 500, false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1774,7 +1935,7 @@ var _ = Describe("Leaderboard Handler", func() {
 	Describe("Get Top Members Handler", func() {
 		It("Should get one page of top members from redis if leaderboard exists (http)", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1802,7 +1963,7 @@ var _ = Describe("Leaderboard Handler", func() {
 		It("Should get one page of top members from redis if leaderboard exists (grpc)", func() {
 			SetupGRPC(app, func(cli pb.PodiumClient) {
 				for i := 1; i <= 100; i++ {
-					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "", nil, nil)
 					Expect(err).NotTo(HaveOccurred())
 				}
 
@@ -1830,7 +1991,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get one page of top members in reverse order from redis if leaderboard exists", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1851,7 +2012,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get one page of top members from redis if leaderboard exists", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1880,7 +2041,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get top members from redis if leaderboard exists with custom pageSize", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1907,7 +2068,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get empty list if page does not exist", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(101-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1922,7 +2083,7 @@ var _ = Describe("Leaderboard Handler", func() {
 
 		It("Should get only one page of top members from redis if leaderboard exists and repeated scores", func() {
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -1949,7 +2110,7 @@ var _ = Describe("Leaderboard Handler", func() {
 		It("Should not fail is page number 0 is sent", func() {
 			SetupGRPC(app, func(cli pb.PodiumClient) {
 				for i := 1; i <= 100; i++ {
-					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "")
+					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "", nil, nil)
 					Expect(err).NotTo(HaveOccurred())
 				}
 
@@ -1997,11 +2158,11 @@ var _ = Describe("Leaderboard Handler", func() {
 		HTTPMeasure("it should get top members", func(ctx map[string]interface{}) {
 			lead := uuid.NewV4().String()
 			memberID := uuid.NewV4().String()
-			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, memberID, 500, false, "")
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, memberID, 500, false, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			for i := 0; i < 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, fmt.Sprintf("member-%d", i), 500, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, fmt.Sprintf("member-%d", i), 500, false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -2020,7 +2181,7 @@ var _ = Describe("Leaderboard Handler", func() {
 			leaderboardID := uuid.NewV4().String()
 
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), int64(101-i), false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), int64(101-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -2047,7 +2208,7 @@ var _ = Describe("Leaderboard Handler", func() {
 				leaderboardID := uuid.NewV4().String()
 
 				for i := 1; i <= 100; i++ {
-					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), int64(101-i), false, "")
+					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), int64(101-i), false, "", nil, nil)
 					Expect(err).NotTo(HaveOccurred())
 				}
 
@@ -2073,7 +2234,7 @@ var _ = Describe("Leaderboard Handler", func() {
 			leaderboardID := uuid.NewV4().String()
 
 			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), 100, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), 100, false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -2127,7 +2288,7 @@ var _ = Describe("Leaderboard Handler", func() {
 			lead := uuid.NewV4().String()
 
 			for i := 0; i < 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, fmt.Sprintf("member-%d", i), 500, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), lead, fmt.Sprintf("member-%d", i), 500, false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -2348,7 +2509,7 @@ var _ = Describe("Leaderboard Handler", func() {
 			leaderboardID := uuid.NewV4().String()
 
 			for i := 0; i < 10; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member-%d", i), 500, false, "")
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member-%d", i), 500, false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -2376,164 +2537,1496 @@ var _ = Describe("Leaderboard Handler", func() {
 		})
 	})
 
-	Describe("Get Members Handler", func() {
-		It("should get several members from leaderboard (http)", func() {
+	Describe("Reset Leaderboard", func() {
+		It("should compress leaderboard scores", func() {
 			leaderboardID := uuid.NewV4().String()
 
-			for i := 1; i <= 100; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), int64(101-i), false, "")
+			for i := 0; i < 10; i++ {
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member-%d", i), int64(1000+100*i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
-			status, body := Get(
-				app,
-				fmt.Sprintf("/l/%s/members?ids=member_10,member_20,member_30", leaderboardID),
-			)
+			payload := map[string]interface{}{
+				"strategy":  "compress",
+				"base":      1000,
+				"factor":    0.5,
+				"chunkSize": 4,
+			}
+			status, body := PostJSON(app, fmt.Sprintf("/l/%s/reset", leaderboardID), payload)
 			Expect(status).To(Equal(http.StatusOK), body)
 
 			var result map[string]interface{}
 			json.Unmarshal([]byte(body), &result)
-
 			Expect(result["success"]).To(BeTrue())
-			Expect(result["notFound"]).To(BeEmpty())
-			members := result["members"].([]interface{})
-			Expect(members).To(HaveLen(3))
-
-			for i := 0; i < 3; i++ {
-				By(fmt.Sprintf("Member %d", i))
-				member := members[i].(map[string]interface{})
-				Expect(member["publicID"]).To(Equal(fmt.Sprintf("member_%d", (i+1)*10)))
-				Expect(member["rank"]).To(BeEquivalentTo((i + 1) * 10))
-				Expect(member["score"]).To(BeEquivalentTo(101 - (i+1)*10))
-				Expect(member["position"]).To(BeEquivalentTo(i))
-			}
+			progress := result["progress"].(map[string]interface{})
+			Expect(progress["leaderboardID"]).To(Equal(leaderboardID))
+			Expect(progress["target"]).To(Equal(leaderboardID))
+			Expect(progress["status"]).To(Equal("done"))
+			Expect(progress["processed"]).To(BeEquivalentTo(10))
+			Expect(progress["total"]).To(BeEquivalentTo(10))
+
+			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), leaderboardID, "member-9", "desc", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(1450)))
 		})
 
-		It("should get several members from leaderboard (grpc)", func() {
+		It("should carry top members into next season leaderboard (grpc)", func() {
 			SetupGRPC(app, func(cli pb.PodiumClient) {
 				leaderboardID := uuid.NewV4().String()
+				nextSeasonID := uuid.NewV4().String()
 
-				for i := 1; i <= 100; i++ {
-					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), int64(101-i), false, "")
+				for i := 0; i < 10; i++ {
+					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member-%d", i), int64(100-i), false, "", nil, nil)
 					Expect(err).NotTo(HaveOccurred())
 				}
 
-				req := &pb.GetMembersRequest{
+				resp, err := cli.ResetLeaderboard(context.Background(), &pb.ResetLeaderboardRequest{
 					LeaderboardId: leaderboardID,
-					Ids:           "member_10,member_20,member_30",
-				}
-				resp, err := cli.GetMembers(context.Background(), req)
+					ResetOptions: &pb.ResetLeaderboardRequest_ResetOptions{
+						Strategy: "topK",
+						Target:   nextSeasonID,
+						TopK:     3,
+					},
+				})
 				Expect(err).NotTo(HaveOccurred())
-
 				Expect(resp.Success).To(BeTrue())
-				Expect(resp.NotFound).To(BeEmpty())
-				members := resp.Members
-				Expect(members).To(HaveLen(3))
+				Expect(resp.Progress.Processed).To(Equal(int32(10)))
 
-				for i := 0; i < 3; i++ {
-					By(fmt.Sprintf("Member %d", i))
-					Expect(members[i].PublicID).To(Equal(fmt.Sprintf("member_%d", (i+1)*10)))
-					Expect(members[i].Rank).To(BeEquivalentTo((i + 1) * 10))
-					Expect(members[i].Score).To(BeEquivalentTo(101 - (i+1)*10))
-					Expect(members[i].Position).To(BeEquivalentTo(i))
-				}
+				count, err := app.Leaderboards.TotalMembers(NewEmptyCtx(), nextSeasonID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(count).To(Equal(3))
+
+				progress, err := cli.GetResetLeaderboardProgress(context.Background(), &pb.GetResetLeaderboardProgressRequest{
+					LeaderboardId: leaderboardID,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(progress.Progress).To(Equal(resp.Progress))
 			})
 		})
 
-		It("should return empty list if invalid leaderboard", func() {
-			status, body := Get(
-				app,
-				"/l/invalid-leaderboard/members/?ids=member_10,member_20,member_30",
-			)
-			Expect(status).To(Equal(http.StatusOK), body)
+		It("should fail if strategy is invalid", func() {
+			payload := map[string]interface{}{"strategy": "invalid"}
+			status, body := PostJSON(app, fmt.Sprintf("/l/%s/reset", uuid.NewV4().String()), payload)
+			Expect(status).To(Equal(http.StatusBadRequest), body)
 
 			var result map[string]interface{}
 			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeFalse())
+			Expect(result["reason"]).To(Equal("invalid reset options: unknown strategy invalid"))
+		})
 
-			Expect(result["success"]).To(BeTrue())
-			Expect(result["members"]).To(HaveLen(0))
-			Expect(result["notFound"]).To(HaveLen(3))
+		It("should return not found if leaderboard was never reset", func() {
+			status, body := Get(app, fmt.Sprintf("/l/%s/reset", uuid.NewV4().String()))
+			Expect(status).To(Equal(http.StatusNotFound), body)
+		})
 
-			members := result["notFound"].([]interface{})
-			Expect(members).To(HaveLen(3))
-			Expect(members[0]).To(Equal("member_10"))
-			Expect(members[1]).To(Equal("member_20"))
-			Expect(members[2]).To(Equal("member_30"))
+		It("Should fail if error in Redis", func() {
+			faultyRedisApp := GetDefaultTestAppWithFaultyRedis()
+
+			payload := map[string]interface{}{"strategy": "zero"}
+			status, body := PostJSON(faultyRedisApp, fmt.Sprintf("/l/%s/reset", uuid.NewV4().String()), payload)
+			Expect(status).To(Equal(500), body)
+			Expect(body).To(ContainSubstring("connection refused"))
 		})
+	})
 
-		It("should return not found members", func() {
+	Describe("Leaderboard Settings", func() {
+		It("should enable decay and return decayed scores", func() {
 			leaderboardID := uuid.NewV4().String()
 
-			for i := 1; i <= 10; i++ {
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), int64(101-i), false, "")
-				Expect(err).NotTo(HaveOccurred())
+			payload := map[string]interface{}{
+				"decayHalfLife": 3600,
 			}
-
-			status, body := Get(
-				app,
-				fmt.Sprintf("/l/%s/members?ids=member_1,invalid_member", leaderboardID),
-			)
+			status, body := PutJSON(app, fmt.Sprintf("/l/%s/settings", leaderboardID), payload)
 			Expect(status).To(Equal(http.StatusOK), body)
 
 			var result map[string]interface{}
 			json.Unmarshal([]byte(body), &result)
-
 			Expect(result["success"]).To(BeTrue())
-			members := result["members"].([]interface{})
-			Expect(members).To(HaveLen(1))
+			settings := result["settings"].(map[string]interface{})
+			Expect(settings["leaderboardID"]).To(Equal(leaderboardID))
+			Expect(settings["decayHalfLife"]).To(BeEquivalentTo(3600))
 
-			member := members[0].(map[string]interface{})
-			Expect(member["publicID"]).To(Equal("member_1"))
-			Expect(member["rank"]).To(BeEquivalentTo(1))
-			Expect(member["score"]).To(BeEquivalentTo(100))
+			status, body = Get(app, fmt.Sprintf("/l/%s/settings", leaderboardID))
+			Expect(status).To(Equal(http.StatusOK), body)
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["settings"]).To(Equal(settings))
 
-			members = result["notFound"].([]interface{})
-			Expect(members).To(HaveLen(1))
-			Expect(members[0]).To(Equal("invalid_member"))
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member", 100, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			member, err := app.Leaderboards.GetMember(NewEmptyCtx(), leaderboardID, "member", "desc", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(100)))
 		})
 
-		It("should fail if no public ids sent", func() {
+		It("should get default settings (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				leaderboardID := uuid.NewV4().String()
+
+				resp, err := cli.GetLeaderboardSettings(context.Background(), &pb.GetLeaderboardSettingsRequest{
+					LeaderboardId: leaderboardID,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Success).To(BeTrue())
+				Expect(resp.Settings.DecayHalfLife).To(Equal(int32(0)))
+				Expect(resp.Settings.DecayLandmark).To(Equal(int64(0)))
+			})
+		})
+
+		It("should store milestone rules (http)", func() {
 			leaderboardID := uuid.NewV4().String()
 
-			status, body := Get(app, fmt.Sprintf("/l/%s/members/", leaderboardID))
-			Expect(status).To(Equal(http.StatusBadRequest), body)
+			payload := map[string]interface{}{
+				"milestones": []map[string]interface{}{
+					{"id": "top100", "type": "rankAtMost", "rank": 100},
+					{"id": "passRival", "type": "passedMember", "member": "rival"},
+				},
+			}
+			status, body := PutJSON(app, fmt.Sprintf("/l/%s/settings", leaderboardID), payload)
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			status, body = Get(app, fmt.Sprintf("/l/%s/settings", leaderboardID))
+			Expect(status).To(Equal(http.StatusOK), body)
 
 			var result map[string]interface{}
 			json.Unmarshal([]byte(body), &result)
+			milestones := result["settings"].(map[string]interface{})["milestones"].([]interface{})
+			Expect(milestones).To(HaveLen(2))
+			Expect(milestones[0].(map[string]interface{})["id"]).To(Equal("top100"))
+			Expect(milestones[0].(map[string]interface{})["rank"]).To(BeEquivalentTo(100))
+			Expect(milestones[1].(map[string]interface{})["type"]).To(Equal("passedMember"))
+			Expect(milestones[1].(map[string]interface{})["member"]).To(Equal("rival"))
+		})
+
+		It("should fail if a milestone rule is invalid", func() {
+			payload := map[string]interface{}{
+				"milestones": []map[string]interface{}{{"id": "top", "type": "rankBelow", "rank": 100}},
+			}
+			status, body := PutJSON(app, fmt.Sprintf("/l/%s/settings", uuid.NewV4().String()), payload)
+			Expect(status).To(Equal(http.StatusBadRequest), body)
+			Expect(body).To(ContainSubstring("milestone top type rankBelow must be rankAtMost or passedMember"))
+		})
+
+		It("should fail if half-life is negative", func() {
+			payload := map[string]interface{}{"decayHalfLife": -1}
+			status, body := PutJSON(app, fmt.Sprintf("/l/%s/settings", uuid.NewV4().String()), payload)
+			Expect(status).To(Equal(http.StatusBadRequest), body)
 
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
 			Expect(result["success"]).To(BeFalse())
-			Expect(result["reason"]).To(Equal("Member IDs are required using the 'ids' querystring parameter"))
+			Expect(result["reason"]).To(Equal("invalid leaderboard settings: decayHalfLife -1 must be positive"))
 		})
 
 		It("Should fail if error in Redis", func() {
 			faultyRedisApp := GetDefaultTestAppWithFaultyRedis()
 
-			status, body := Get(
-				faultyRedisApp,
-				"/l/invalid-redis/members?ids=member_10,member_20,member_30",
-			)
-
+			status, body := Get(faultyRedisApp, fmt.Sprintf("/l/%s/settings", uuid.NewV4().String()))
 			Expect(status).To(Equal(500), body)
 			Expect(body).To(ContainSubstring("connection refused"))
 		})
+	})
 
-		HTTPMeasure("it should get members", func(ctx map[string]interface{}) {
-			app := ctx["app"].(*api.App)
+	Describe("Freeze Leaderboard", func() {
+		It("should reject writes and removals while frozen (http)", func() {
+			leaderboardID := uuid.NewV4().String()
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member", 100, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
 
-			memberIDs := []string{}
-			for i := 1; i <= 1000; i++ {
-				memberID := fmt.Sprintf("member_%d", i)
-				memberIDs = append(memberIDs, memberID)
-				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, memberID, int64(101-i), false, "")
+			status, body := Post(app, fmt.Sprintf("/l/%s/freeze", leaderboardID), "")
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeTrue())
+			Expect(result["settings"].(map[string]interface{})["frozen"]).To(BeTrue())
+
+			status, body = PutJSON(app, fmt.Sprintf("/l/%s/members/member/score", leaderboardID), map[string]interface{}{"score": 10})
+			Expect(status).To(Equal(http.StatusPreconditionFailed), body)
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["reason"]).To(Equal(fmt.Sprintf("leaderboard %s is frozen", leaderboardID)))
+
+			status, body = Delete(app, fmt.Sprintf("/l/%s/members/member", leaderboardID))
+			Expect(status).To(Equal(http.StatusPreconditionFailed), body)
+
+			status, body = Delete(app, fmt.Sprintf("/l/%s", leaderboardID))
+			Expect(status).To(Equal(http.StatusPreconditionFailed), body)
+
+			status, body = Get(app, fmt.Sprintf("/l/%s/members/member", leaderboardID))
+			Expect(status).To(Equal(http.StatusOK), body)
+		})
+
+		It("should accept writes again when unfrozen (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				leaderboardID := uuid.NewV4().String()
+
+				_, err := cli.FreezeLeaderboard(context.Background(), &pb.FreezeLeaderboardRequest{LeaderboardId: leaderboardID})
 				Expect(err).NotTo(HaveOccurred())
-			}
 
-			ctx["mIDs"] = strings.Join(memberIDs, ",")
-		}, func(httpEndPoint string, ctx map[string]interface{}) {
-			url := testing.GetRoute(httpEndPoint, fmt.Sprintf("/l/%s/members?ids=%s", testLeaderboardID, ctx["mIDs"].(string)))
-			status, body, err := testing.FastGet(url)
-			Expect(err).NotTo(HaveOccurred())
-			Expect(status).To(Equal(http.StatusOK), string(body))
-		}, 0.9)
+				_, err = cli.IncrementScore(context.Background(), &pb.IncrementScoreRequest{
+					LeaderboardId:  leaderboardID,
+					MemberPublicId: "member",
+					Body:           &pb.IncrementScoreRequest_Body{Increment: 10},
+				})
+				Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+
+				resp, err := cli.UnfreezeLeaderboard(context.Background(), &pb.UnfreezeLeaderboardRequest{LeaderboardId: leaderboardID})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Settings.Frozen).To(BeFalse())
+
+				_, err = cli.IncrementScore(context.Background(), &pb.IncrementScoreRequest{
+					LeaderboardId:  leaderboardID,
+					MemberPublicId: "member",
+					Body:           &pb.IncrementScoreRequest_Body{Increment: 10},
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Score Rules", func() {
+		It("should reject scores that break leaderboard rules and list them (http)", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			status, body := PutJSON(app, fmt.Sprintf("/l/%s/settings", leaderboardID), map[string]interface{}{
+				"maxScore":      1000,
+				"maxIncrement":  100,
+				"monotonicOnly": true,
+			})
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			settings := result["settings"].(map[string]interface{})
+			Expect(settings["maxScore"]).To(Equal("1000"))
+			Expect(settings["maxIncrement"]).To(Equal("100"))
+			Expect(settings["monotonicOnly"]).To(BeTrue())
+			Expect(settings["minScore"]).To(BeNil())
+
+			status, body = PutJSON(app, fmt.Sprintf("/l/%s/members/member/score", leaderboardID), map[string]interface{}{"score": 5000})
+			Expect(status).To(Equal(http.StatusBadRequest), body)
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeFalse())
+			Expect(result["reason"]).To(Equal(fmt.Sprintf("score of member member rejected by rule maxScore of leaderboard %s", leaderboardID)))
+
+			status, body = Get(app, fmt.Sprintf("/l/%s/rejected-scores", leaderboardID))
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			result = map[string]interface{}{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeTrue())
+			rejectedScores := result["rejectedScores"].([]interface{})
+			Expect(rejectedScores).To(HaveLen(1))
+			rejectedScore := rejectedScores[0].(map[string]interface{})
+			Expect(rejectedScore["publicID"]).To(Equal("member"))
+			Expect(rejectedScore["score"]).To(Equal("5000"))
+			Expect(rejectedScore["rule"]).To(Equal("maxScore"))
+		})
+
+		It("should fail if pageSize is greater than max returned members (http)", func() {
+			status, body := Get(app, fmt.Sprintf("/l/%s/rejected-scores?pageSize=3000", uuid.NewV4().String()))
+			Expect(status).To(Equal(http.StatusBadRequest), body)
+		})
+
+		It("should reject decreasing score of monotonic leaderboard (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				leaderboardID := uuid.NewV4().String()
+
+				resp, err := cli.UpdateLeaderboardSettings(context.Background(), &pb.UpdateLeaderboardSettingsRequest{
+					LeaderboardId: leaderboardID,
+					Settings: &pb.UpdateLeaderboardSettingsRequest_Settings{
+						MinScore:      &wrappers.Int64Value{Value: 0},
+						MonotonicOnly: true,
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Settings.MinScore.Value).To(Equal(int64(0)))
+				Expect(resp.Settings.MaxScore).To(BeNil())
+
+				_, err = cli.IncrementScore(context.Background(), &pb.IncrementScoreRequest{
+					LeaderboardId:  leaderboardID,
+					MemberPublicId: "member",
+					Body:           &pb.IncrementScoreRequest_Body{Increment: 10},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = cli.IncrementScore(context.Background(), &pb.IncrementScoreRequest{
+					LeaderboardId:  leaderboardID,
+					MemberPublicId: "member",
+					Body:           &pb.IncrementScoreRequest_Body{Increment: -5},
+				})
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+				rejected, err := cli.GetRejectedScores(context.Background(), &pb.GetRejectedScoresRequest{LeaderboardId: leaderboardID})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rejected.RejectedScores).To(HaveLen(1))
+				Expect(rejected.RejectedScores[0].Score).To(Equal(int64(5)))
+				Expect(rejected.RejectedScores[0].PreviousScore).To(Equal(int64(10)))
+				Expect(rejected.RejectedScores[0].Rule).To(Equal("monotonicOnly"))
+			})
+		})
+	})
+
+	Describe("Signed Writes", func() {
+		sign := func(secret, payload string) string {
+			mac := hmac.New(sha256.New, []byte(secret))
+			mac.Write([]byte(payload))
+			return hex.EncodeToString(mac.Sum(nil))
+		}
+
+		signedContext := func(signature string, timestamp int64, nonce string) context.Context {
+			return metadata.AppendToOutgoingContext(context.Background(),
+				"x-podium-signature", signature,
+				"x-podium-timestamp", fmt.Sprint(timestamp),
+				"x-podium-nonce", nonce,
+			)
+		}
+
+		It("should only accept signed writes with unused nonces (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				leaderboardID := uuid.NewV4().String()
+
+				resp, err := cli.UpdateLeaderboardSettings(context.Background(), &pb.UpdateLeaderboardSettingsRequest{
+					LeaderboardId: leaderboardID,
+					Settings:      &pb.UpdateLeaderboardSettingsRequest_Settings{SignatureGame: "game1"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Settings.SignatureGame).To(Equal("game1"))
+
+				req := &pb.UpsertScoreRequest{
+					LeaderboardId:  leaderboardID,
+					MemberPublicId: "member",
+					ScoreChange:    &pb.UpsertScoreRequest_ScoreChange{Score: 100},
+				}
+				_, err = cli.UpsertScore(context.Background(), req)
+				Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+
+				now := time.Now().Unix()
+				nonce := uuid.NewV4().String()
+				payload := fmt.Sprintf("/podium.api.v1.Podium/UpsertScore\n%s\nmember\n100\n%d\n%s", leaderboardID, now, nonce)
+
+				_, err = cli.UpsertScore(signedContext(sign("wrong secret", payload), now, nonce), req)
+				Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+
+				incrementPayload := fmt.Sprintf("/podium.api.v1.Podium/IncrementScore\n%s\nmember\n100\n%d\n%s", leaderboardID, now, nonce)
+				_, err = cli.UpsertScore(signedContext(sign("secret1", incrementPayload), now, nonce), req)
+				Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+
+				upsertResp, err := cli.UpsertScore(signedContext(sign("secret1", payload), now, nonce), req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(upsertResp.Score).To(Equal(float64(100)))
+
+				_, err = cli.UpsertScore(signedContext(sign("secret1", payload), now, nonce), req)
+				Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+				Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("nonce %s was already used", nonce)))
+
+				old := time.Now().Add(-time.Hour).Unix()
+				oldPayload := fmt.Sprintf("/podium.api.v1.Podium/UpsertScore\n%s\nmember\n100\n%d\n%s", leaderboardID, old, nonce)
+				_, err = cli.UpsertScore(signedContext(sign("secret1", oldPayload), old, uuid.NewV4().String()), req)
+				Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+			})
+		})
+
+		It("should accept unsigned writes to leaderboards that do not require signing (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				_, err := cli.IncrementScore(context.Background(), &pb.IncrementScoreRequest{
+					LeaderboardId:  uuid.NewV4().String(),
+					MemberPublicId: "member",
+					Body:           &pb.IncrementScoreRequest_Body{Increment: 10},
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		It("should require signed writes as soon as signing is enabled (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				req := &pb.UpsertScoreRequest{
+					LeaderboardId:  uuid.NewV4().String(),
+					MemberPublicId: "member",
+					ScoreChange:    &pb.UpsertScoreRequest_ScoreChange{Score: 100},
+				}
+				_, err := cli.UpsertScore(context.Background(), req)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = cli.UpdateLeaderboardSettings(context.Background(), &pb.UpdateLeaderboardSettingsRequest{
+					LeaderboardId: req.LeaderboardId,
+					Settings:      &pb.UpdateLeaderboardSettingsRequest_Settings{SignatureGame: "game1"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = cli.UpsertScore(context.Background(), req)
+				Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+			})
+		})
+
+		It("should verify the signature of imports over the leaderboard they write to (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				leaderboardID := uuid.NewV4().String()
+				_, err := cli.UpdateLeaderboardSettings(context.Background(), &pb.UpdateLeaderboardSettingsRequest{
+					LeaderboardId: leaderboardID,
+					Settings:      &pb.UpdateLeaderboardSettingsRequest_Settings{SignatureGame: "game1"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				chunk := &pb.ImportScoresRequest{
+					LeaderboardId: leaderboardID,
+					Members:       []*pb.BulkUpsertScoresRequest_MemberScore{{PublicID: "member1", Score: 100}},
+				}
+				stream, err := cli.ImportScores(context.Background())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stream.Send(chunk)).To(Succeed())
+				_, err = stream.CloseAndRecv()
+				Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+
+				now := time.Now().Unix()
+				nonce := uuid.NewV4().String()
+				payload := fmt.Sprintf("/podium.api.v1.Podium/ImportScores\n%s\n%d\n%s", leaderboardID, now, nonce)
+				stream, err = cli.ImportScores(signedContext(sign("secret1", payload), now, nonce))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stream.Send(chunk)).To(Succeed())
+				Expect(stream.Send(&pb.ImportScoresRequest{
+					Members: []*pb.BulkUpsertScoresRequest_MemberScore{{PublicID: "member2", Score: 200}},
+				})).To(Succeed())
+				response, err := stream.CloseAndRecv()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Imported).To(BeEquivalentTo(2))
+			})
+		})
+
+		It("should verify signature headers of bulk writes (http)", func() {
+			leaderboardID := uuid.NewV4().String()
+			_, err := app.Leaderboards.UpdateLeaderboardSettings(NewEmptyCtx(), leaderboardID, &lmodel.LeaderboardSettings{SignatureGame: "game1"})
+			Expect(err).NotTo(HaveOccurred())
+
+			url := fmt.Sprintf("/l/%s/scores", leaderboardID)
+			payload := map[string]interface{}{
+				"members": []map[string]interface{}{
+					{"publicID": "member1", "score": 10},
+					{"publicID": "member2", "score": 20},
+				},
+			}
+
+			status, body := PutJSON(app, url, payload)
+			Expect(status).To(Equal(http.StatusUnauthorized), body)
+
+			now := time.Now().Unix()
+			nonce := uuid.NewV4().String()
+			signature := sign("secret1", fmt.Sprintf("/podium.api.v1.Podium/BulkUpsertScores\n%s\nmember1\n10\n%s\nmember2\n20\n%d\n%s", leaderboardID, leaderboardID, now, nonce))
+			status, body = PutJSONWithHeaders(app, url, payload, map[string]string{
+				"X-Podium-Signature": signature,
+				"X-Podium-Timestamp": fmt.Sprint(now),
+				"X-Podium-Nonce":     nonce,
+			})
+			Expect(status).To(Equal(http.StatusOK), body)
+		})
+	})
+
+	Describe("Rate Limits", func() {
+		AfterEach(func() {
+			app.Config.Set("api.rateLimit.member.rate", 0)
+			app.Config.Set("api.rateLimit.member.burst", 0)
+		})
+
+		It("should return ResourceExhausted with retry-after when member exceeds its limit (grpc)", func() {
+			app.Config.Set("api.rateLimit.member.rate", 0.1)
+			app.Config.Set("api.rateLimit.member.burst", 2)
+
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				req := &pb.UpsertScoreRequest{
+					LeaderboardId:  uuid.NewV4().String(),
+					MemberPublicId: "member",
+					ScoreChange:    &pb.UpsertScoreRequest_ScoreChange{Score: 100},
+				}
+
+				for i := 0; i < 2; i++ {
+					_, err := cli.UpsertScore(context.Background(), req)
+					Expect(err).NotTo(HaveOccurred())
+				}
+
+				var md metadata.MD
+				_, err := cli.UpsertScore(context.Background(), req, grpc.Header(&md))
+				Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+				Expect(md.Get("retry-after")).To(Equal([]string{"10"}))
+			})
+		})
+
+		It("should not limit reads (grpc)", func() {
+			app.Config.Set("api.rateLimit.member.rate", 0.1)
+			app.Config.Set("api.rateLimit.member.burst", 1)
+
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				leaderboardID := uuid.NewV4().String()
+				_, err := cli.UpsertScore(context.Background(), &pb.UpsertScoreRequest{
+					LeaderboardId:  leaderboardID,
+					MemberPublicId: "member",
+					ScoreChange:    &pb.UpsertScoreRequest_ScoreChange{Score: 100},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				for i := 0; i < 2; i++ {
+					_, err = cli.GetMember(context.Background(), &pb.GetMemberRequest{LeaderboardId: leaderboardID, MemberPublicId: "member"})
+					Expect(err).NotTo(HaveOccurred())
+				}
+			})
+		})
+
+		It("should return 429 with Retry-After when member exceeds its limit (http)", func() {
+			app.Config.Set("api.rateLimit.member.rate", 0.5)
+			app.Config.Set("api.rateLimit.member.burst", 1)
+
+			url := fmt.Sprintf("/l/%s/members/member/score", uuid.NewV4().String())
+			payload := map[string]interface{}{"score": 100}
+
+			status, body := PutJSON(app, url, payload)
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			status, body, headers := PutJSONWithResponseHeaders(app, url, payload)
+			Expect(status).To(Equal(http.StatusTooManyRequests), body)
+			Expect(headers.Get("Retry-After")).To(Equal("2"))
+
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeFalse())
+			Expect(result["reason"]).To(ContainSubstring("rate limit"))
+		})
+
+		It("should take tokens of each chunk of imports (grpc)", func() {
+			app.Config.Set("api.rateLimit.member.rate", 0.1)
+			app.Config.Set("api.rateLimit.member.burst", 1)
+
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				chunk := &pb.ImportScoresRequest{
+					LeaderboardId: uuid.NewV4().String(),
+					Members:       []*pb.BulkUpsertScoresRequest_MemberScore{{PublicID: "member", Score: 100}},
+				}
+
+				stream, err := cli.ImportScores(context.Background())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stream.Send(chunk)).To(Succeed())
+				Expect(stream.Send(chunk)).To(Succeed())
+
+				_, err = stream.CloseAndRecv()
+				Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+				Expect(stream.Header()).To(HaveKeyWithValue("retry-after", []string{"10"}))
+			})
+		})
+
+		It("should not take tokens of writes without a valid signature (grpc)", func() {
+			app.Config.Set("api.rateLimit.member.rate", 0.1)
+			app.Config.Set("api.rateLimit.member.burst", 1)
+
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				leaderboardID := uuid.NewV4().String()
+				_, err := cli.UpdateLeaderboardSettings(context.Background(), &pb.UpdateLeaderboardSettingsRequest{
+					LeaderboardId: leaderboardID,
+					Settings:      &pb.UpdateLeaderboardSettingsRequest_Settings{SignatureGame: "game1"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				req := &pb.UpsertScoreRequest{
+					LeaderboardId:  leaderboardID,
+					MemberPublicId: "member",
+					ScoreChange:    &pb.UpsertScoreRequest_ScoreChange{Score: 100},
+				}
+				for i := 0; i < 2; i++ {
+					_, err = cli.UpsertScore(context.Background(), req)
+					Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+				}
+			})
+		})
+	})
+
+	Describe("Blocklist", func() {
+		It("should reject writes of blocked members and exclude them from top members (http)", func() {
+			leaderboardID := uuid.NewV4().String()
+			for i, member := range []string{"cheater", "member1"} {
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, member, int64(200-i*100), false, "", nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			status, body := PutJSON(app, fmt.Sprintf("/l/%s/blocked/cheater", leaderboardID), map[string]interface{}{"mode": "invalid"})
+			Expect(status).To(Equal(http.StatusBadRequest), body)
+
+			status, body = PutJSON(app, fmt.Sprintf("/l/%s/blocked/cheater", leaderboardID), map[string]interface{}{"mode": "reject"})
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			status, body = PutJSON(app, fmt.Sprintf("/l/%s/members/cheater/score", leaderboardID), map[string]interface{}{"score": 1000})
+			Expect(status).To(Equal(http.StatusForbidden), body)
+
+			status, body = Get(app, fmt.Sprintf("/l/%s/top/1", leaderboardID))
+			Expect(status).To(Equal(http.StatusOK), body)
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			members := result["members"].([]interface{})
+			Expect(members).To(HaveLen(1))
+			Expect(members[0].(map[string]interface{})["publicID"]).To(Equal("member1"))
+			Expect(members[0].(map[string]interface{})["rank"]).To(Equal(float64(1)))
+
+			status, body = Delete(app, fmt.Sprintf("/l/%s/blocked/cheater", leaderboardID))
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			status, body = Get(app, fmt.Sprintf("/l/%s/members/cheater", leaderboardID))
+			Expect(status).To(Equal(http.StatusOK), body)
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["rank"]).To(Equal(float64(1)))
+		})
+
+		It("should shadow writes of members blocked in every leaderboard (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				leaderboardID := uuid.NewV4().String()
+				cheater := uuid.NewV4().String()
+
+				_, err := cli.BlockMemberGlobally(context.Background(), &pb.BlockMemberGloballyRequest{MemberPublicId: cheater, Mode: "shadow"})
+				Expect(err).NotTo(HaveOccurred())
+
+				resp, err := cli.UpsertScore(context.Background(), &pb.UpsertScoreRequest{
+					LeaderboardId:  leaderboardID,
+					MemberPublicId: cheater,
+					ScoreChange:    &pb.UpsertScoreRequest_ScoreChange{Score: 100},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Rank).To(Equal(int32(1)))
+
+				member, err := cli.GetMember(context.Background(), &pb.GetMemberRequest{LeaderboardId: leaderboardID, MemberPublicId: cheater})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(member.Score).To(Equal(float64(100)))
+
+				count, err := cli.TotalMembers(context.Background(), &pb.TotalMembersRequest{LeaderboardId: leaderboardID})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(count.Count).To(Equal(int32(0)))
+
+				_, err = cli.UnblockMemberGlobally(context.Background(), &pb.UnblockMemberGloballyRequest{MemberPublicId: cheater})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Ledger", func() {
+		It("should record score changes with reason and caller and rollback them (http)", func() {
+			leaderboardID := uuid.NewV4().String()
+			headers := map[string]string{"X-Podium-Reason": "match", "X-Forwarded-For": "203.0.113.7"}
+
+			status, body := PutJSONWithHeaders(app, fmt.Sprintf("/l/%s/members/member1/score", leaderboardID), map[string]interface{}{"score": 100}, headers)
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			status, body = PutJSONWithHeaders(app, fmt.Sprintf("/l/%s/members/member1/score", leaderboardID), map[string]interface{}{"score": 150}, headers)
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			status, body = Get(app, fmt.Sprintf("/l/%s/members/member1/ledger", leaderboardID))
+			Expect(status).To(Equal(http.StatusOK), body)
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			entries := result["entries"].([]interface{})
+			Expect(entries).To(HaveLen(2))
+			entry := entries[1].(map[string]interface{})
+			Expect(entry["oldScore"]).To(Equal("100"))
+			Expect(entry["newScore"]).To(Equal("150"))
+			Expect(entry["delta"]).To(Equal("50"))
+			Expect(entry["reason"]).To(Equal("match"))
+			Expect(entry["caller"]).To(Equal("203.0.113.7"))
+
+			status, body = PostJSON(app, fmt.Sprintf("/l/%s/members/member1/rollback", leaderboardID), map[string]interface{}{"since": 0})
+			Expect(status).To(Equal(http.StatusOK), body)
+			json.Unmarshal([]byte(body), &result)
+			entry = result["entry"].(map[string]interface{})
+			Expect(entry["oldScore"]).To(Equal("150"))
+			Expect(entry["newScore"]).To(BeNil())
+			Expect(entry["reason"]).To(Equal("rollback"))
+
+			status, body = Get(app, fmt.Sprintf("/l/%s/members/member1", leaderboardID))
+			Expect(status).To(Equal(http.StatusNotFound), body)
+		})
+
+		It("should record increments with the reason of the request (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				leaderboardID := uuid.NewV4().String()
+				ctx := metadata.AppendToOutgoingContext(context.Background(), "x-podium-reason", "quest")
+
+				_, err := cli.IncrementScore(ctx, &pb.IncrementScoreRequest{
+					LeaderboardId:  leaderboardID,
+					MemberPublicId: "member1",
+					Body:           &pb.IncrementScoreRequest_Body{Increment: 10},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				ledger, err := cli.GetMemberLedger(context.Background(), &pb.GetMemberLedgerRequest{LeaderboardId: leaderboardID, MemberPublicId: "member1"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ledger.Entries).To(HaveLen(1))
+				Expect(ledger.Entries[0].Reason).To(Equal("quest"))
+			})
+		})
+
+		It("should not record addresses forwarded by untrusted proxies as caller (http)", func() {
+			app.Config.Set("api.trustedProxies", []string{"192.0.2.0/24"})
+			defer app.Config.Set("api.trustedProxies", []string{"127.0.0.0/8", "::1/128"})
+
+			leaderboardID := uuid.NewV4().String()
+			headers := map[string]string{"X-Forwarded-For": "203.0.113.7"}
+			status, body := PutJSONWithHeaders(app, fmt.Sprintf("/l/%s/members/member1/score", leaderboardID), map[string]interface{}{"score": 100}, headers)
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			status, body = Get(app, fmt.Sprintf("/l/%s/members/member1/ledger", leaderboardID))
+			Expect(status).To(Equal(http.StatusOK), body)
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			entries := result["entries"].([]interface{})
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].(map[string]interface{})["caller"]).To(Equal("127.0.0.1"))
+		})
+
+		It("should fail to rollback a member without score changes (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				leaderboardID := uuid.NewV4().String()
+
+				_, err := cli.RollbackMember(context.Background(), &pb.RollbackMemberRequest{LeaderboardId: leaderboardID, MemberPublicId: "member1"})
+				Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+				ledger, err := cli.GetMemberLedger(context.Background(), &pb.GetMemberLedgerRequest{LeaderboardId: leaderboardID, MemberPublicId: "member1"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ledger.Entries).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("History", func() {
+		It("should sample member score and rank when history is enabled (http)", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			status, body := PutJSON(app, fmt.Sprintf("/l/%s/settings", leaderboardID), map[string]interface{}{"historyEnabled": true})
+			Expect(status).To(Equal(http.StatusOK), body)
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			settings := result["settings"].(map[string]interface{})
+			Expect(settings["historyEnabled"]).To(BeTrue())
+
+			status, body = PutJSON(app, fmt.Sprintf("/l/%s/members/member1/score", leaderboardID), map[string]interface{}{"score": 100})
+			Expect(status).To(Equal(http.StatusOK), body)
+			status, body = PutJSON(app, fmt.Sprintf("/l/%s/members/member2/score", leaderboardID), map[string]interface{}{"score": 200})
+			Expect(status).To(Equal(http.StatusOK), body)
+			status, body = PutJSON(app, fmt.Sprintf("/l/%s/members/member1/score", leaderboardID), map[string]interface{}{"score": 300})
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			status, body = Get(app, fmt.Sprintf("/l/%s/members/member1/history", leaderboardID))
+			Expect(status).To(Equal(http.StatusOK), body)
+			json.Unmarshal([]byte(body), &result)
+			samples := result["samples"].([]interface{})
+			Expect(samples).To(HaveLen(2))
+			sample := samples[0].(map[string]interface{})
+			Expect(sample["score"]).To(Equal("100"))
+			Expect(sample["rank"]).To(BeEquivalentTo(1))
+			sample = samples[1].(map[string]interface{})
+			Expect(sample["score"]).To(Equal("300"))
+			Expect(sample["rank"]).To(BeEquivalentTo(1))
+		})
+
+		It("should fail if to is before from (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				leaderboardID := uuid.NewV4().String()
+
+				_, err := cli.GetMemberHistory(context.Background(), &pb.GetMemberHistoryRequest{LeaderboardId: leaderboardID, MemberPublicId: "member1", From: 200, To: 100})
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+				history, err := cli.GetMemberHistory(context.Background(), &pb.GetMemberHistoryRequest{LeaderboardId: leaderboardID, MemberPublicId: "member1"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(history.Samples).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("Rank Movers", func() {
+		It("should get members that climbed and fell the most since a snapshot (http)", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			status, body := PutJSON(app, fmt.Sprintf("/l/%s/settings", leaderboardID), map[string]interface{}{"snapshotInterval": 86400})
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			for i, member := range []string{"member1", "member2", "member3"} {
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, member, int64(300-100*i), false, "", nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			_, err := app.Leaderboards.TakeRankSnapshot(NewEmptyCtx(), leaderboardID)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member3", 1000, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			status, body = Get(app, fmt.Sprintf("/l/%s/movers?from=%d&limit=1", leaderboardID, time.Now().Unix()+1))
+			Expect(status).To(Equal(http.StatusOK), body)
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["to"]).To(Equal("0"))
+			climbers := result["climbers"].([]interface{})
+			Expect(climbers).To(HaveLen(1))
+			climber := climbers[0].(map[string]interface{})
+			Expect(climber["publicID"]).To(Equal("member3"))
+			Expect(climber["previousRank"]).To(BeEquivalentTo(3))
+			Expect(climber["rank"]).To(BeEquivalentTo(1))
+			Expect(climber["rankDelta"]).To(BeEquivalentTo(2))
+			fallers := result["fallers"].([]interface{})
+			Expect(fallers).To(HaveLen(1))
+			Expect(fallers[0].(map[string]interface{})["publicID"]).To(Equal("member1"))
+		})
+
+		It("should fail if no snapshot was taken (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				leaderboardID := uuid.NewV4().String()
+
+				_, err := cli.GetRankMovers(context.Background(), &pb.GetRankMoversRequest{LeaderboardId: leaderboardID, From: time.Now().Unix()})
+				Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+				_, err = cli.GetRankMovers(context.Background(), &pb.GetRankMoversRequest{LeaderboardId: leaderboardID, From: 200, To: 100})
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+	})
+
+	Describe("Diff Leaderboard", func() {
+		It("should stream members that changed since a snapshot (http)", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			for i, member := range []string{"member1", "member2"} {
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, member, int64(200-100*i), false, "", nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			_, err := app.Leaderboards.UpdateLeaderboardSettings(NewEmptyCtx(), leaderboardID, &lmodel.LeaderboardSettings{SnapshotInterval: 86400})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = app.Leaderboards.TakeRankSnapshot(NewEmptyCtx(), leaderboardID)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member2", 300, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member3", 50, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			status, body := Get(app, fmt.Sprintf("/l/%s/diff?from=%d", leaderboardID, time.Now().Unix()+1))
+			Expect(status).To(Equal(http.StatusOK), body)
+			lines := strings.Split(strings.TrimSpace(body), "\n")
+			Expect(lines).To(HaveLen(3))
+
+			diffs := make([]map[string]interface{}, len(lines))
+			for i, line := range lines {
+				var chunk map[string]map[string]interface{}
+				Expect(json.Unmarshal([]byte(line), &chunk)).To(Succeed())
+				diffs[i] = chunk["result"]
+			}
+			Expect(diffs[0]["publicID"]).To(Equal("member2"))
+			Expect(diffs[0]["change"]).To(Equal("changed"))
+			Expect(diffs[0]["previousScore"]).To(Equal("100"))
+			Expect(diffs[0]["score"]).To(Equal("300"))
+			Expect(diffs[0]["scoreDelta"]).To(Equal("200"))
+			Expect(diffs[0]["rankDelta"]).To(BeEquivalentTo(1))
+			Expect(diffs[1]["publicID"]).To(Equal("member1"))
+			Expect(diffs[1]["rankDelta"]).To(BeEquivalentTo(-1))
+			Expect(diffs[2]["publicID"]).To(Equal("member3"))
+			Expect(diffs[2]["change"]).To(Equal("entered"))
+			Expect(diffs[2]["previousScore"]).To(BeNil())
+		})
+
+		It("should fail if no snapshot was taken (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				leaderboardID := uuid.NewV4().String()
+
+				stream, err := cli.DiffLeaderboard(context.Background(), &pb.DiffLeaderboardRequest{LeaderboardId: leaderboardID, From: time.Now().Unix()})
+				Expect(err).NotTo(HaveOccurred())
+				_, err = stream.Recv()
+				Expect(status.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+	})
+
+	Describe("Watch Top Members", func() {
+		It("should stream top members whenever they change (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				leaderboardID := uuid.NewV4().String()
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 100, false, "", nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+				stream, err := cli.WatchTopMembers(ctx, &pb.WatchTopMembersRequest{LeaderboardId: leaderboardID, PageSize: 2})
+				Expect(err).NotTo(HaveOccurred())
+
+				response, err := stream.Recv()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Success).To(BeTrue())
+				Expect(response.Members).To(HaveLen(1))
+				Expect(response.Members[0].PublicID).To(Equal("member1"))
+
+				_, err = app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member2", 200, false, "", nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				response, err = stream.Recv()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Members).To(HaveLen(2))
+				Expect(response.Members[0].PublicID).To(Equal("member2"))
+				Expect(response.Members[0].Rank).To(BeEquivalentTo(1))
+				Expect(response.Members[1].PublicID).To(Equal("member1"))
+				Expect(response.Members[1].Rank).To(BeEquivalentTo(2))
+			})
+		})
+
+		It("should fail if pageSize is greater than max returned members (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				stream, err := cli.WatchTopMembers(context.Background(), &pb.WatchTopMembersRequest{LeaderboardId: uuid.NewV4().String(), PageSize: 2001})
+				Expect(err).NotTo(HaveOccurred())
+				_, err = stream.Recv()
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+	})
+
+	Describe("Import Scores", func() {
+		It("should import scores sent in chunks (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				leaderboardID := uuid.NewV4().String()
+
+				stream, err := cli.ImportScores(context.Background())
+				Expect(err).NotTo(HaveOccurred())
+				err = stream.Send(&pb.ImportScoresRequest{
+					LeaderboardId: leaderboardID,
+					Members: []*pb.BulkUpsertScoresRequest_MemberScore{
+						{PublicID: "member1", Score: 100},
+						{PublicID: "member2", Score: 200},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				err = stream.Send(&pb.ImportScoresRequest{
+					Members: []*pb.BulkUpsertScoresRequest_MemberScore{
+						{PublicID: "member3", Score: 300},
+						{PublicID: "", Score: 400},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				response, err := stream.CloseAndRecv()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Success).To(BeFalse())
+				Expect(response.Imported).To(BeEquivalentTo(3))
+				Expect(response.Failed).To(BeEquivalentTo(1))
+				Expect(response.Chunks).To(HaveLen(2))
+				Expect(response.Chunks[0].Imported).To(BeEquivalentTo(2))
+				Expect(response.Chunks[1].Chunk).To(BeEquivalentTo(1))
+				Expect(response.Chunks[1].Failures[0].Reason).To(Equal("publicID is required"))
+
+				members, err := app.Leaderboards.GetLeaders(NewEmptyCtx(), leaderboardID, 10, 1, "desc")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(HaveLen(3))
+				Expect(members[0].PublicID).To(Equal("member3"))
+			})
+		})
+
+		It("should replace leaderboard with the scores imported (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				leaderboardID := uuid.NewV4().String()
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 100, false, "", nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				stream, err := cli.ImportScores(context.Background())
+				Expect(err).NotTo(HaveOccurred())
+				err = stream.Send(&pb.ImportScoresRequest{
+					LeaderboardId: leaderboardID,
+					Replace:       true,
+					Members:       []*pb.BulkUpsertScoresRequest_MemberScore{{PublicID: "member2", Score: 200}},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				response, err := stream.CloseAndRecv()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Success).To(BeTrue())
+				Expect(response.Replaced).To(BeTrue())
+
+				members, err := app.Leaderboards.GetLeaders(NewEmptyCtx(), leaderboardID, 10, 1, "desc")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(members).To(HaveLen(1))
+				Expect(members[0].PublicID).To(Equal("member2"))
+			})
+		})
+
+		It("should fail if chunks are of another leaderboard (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				stream, err := cli.ImportScores(context.Background())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stream.Send(&pb.ImportScoresRequest{LeaderboardId: uuid.NewV4().String()})).To(Succeed())
+				Expect(stream.Send(&pb.ImportScoresRequest{LeaderboardId: uuid.NewV4().String()})).To(Succeed())
+
+				_, err = stream.CloseAndRecv()
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+
+		It("should fail if no chunk is sent (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				stream, err := cli.ImportScores(context.Background())
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = stream.CloseAndRecv()
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+	})
+
+	Describe("Export Leaderboard", func() {
+		It("should stream members in chunks (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				leaderboardID := uuid.NewV4().String()
+				for i := 1; i <= 5; i++ {
+					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member%d", i), int64(100*i), false, "", nil, nil)
+					Expect(err).NotTo(HaveOccurred())
+				}
+
+				stream, err := cli.ExportLeaderboard(context.Background(), &pb.ExportLeaderboardRequest{
+					LeaderboardId: leaderboardID,
+					ChunkSize:     2,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				members := []*pb.ExportLeaderboardResponse_Member{}
+				chunks := 0
+				for {
+					response, err := stream.Recv()
+					if err == io.EOF {
+						break
+					}
+					Expect(err).NotTo(HaveOccurred())
+					Expect(len(response.Members)).To(BeNumerically("<=", 2))
+					members = append(members, response.Members...)
+					chunks++
+				}
+				Expect(chunks).To(Equal(3))
+				Expect(members).To(HaveLen(5))
+				for i, member := range members {
+					Expect(member.PublicID).To(Equal(fmt.Sprintf("member%d", 5-i)))
+					Expect(member.Rank).To(BeEquivalentTo(i + 1))
+				}
+			})
+		})
+
+		It("should fail if chunk size is above max returned members (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				stream, err := cli.ExportLeaderboard(context.Background(), &pb.ExportLeaderboardRequest{
+					LeaderboardId: uuid.NewV4().String(),
+					ChunkSize:     int32(app.Config.GetInt("api.maxReturnedMembers") + 1),
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = stream.Recv()
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+
+		It("should download members as CSV (http)", func() {
+			leaderboardID := uuid.NewV4().String()
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 100, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member2", 200, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			res, err := http.Get(GetRoute(app.HTTPEndpoint, fmt.Sprintf("/export/l/%s?order=asc", leaderboardID)))
+			Expect(err).NotTo(HaveOccurred())
+			defer res.Body.Close()
+			Expect(res.StatusCode).To(Equal(http.StatusOK))
+			Expect(res.Header.Get("Content-Type")).To(Equal("text/csv"))
+			Expect(res.Header.Get("Content-Disposition")).To(Equal(fmt.Sprintf("attachment; filename=\"%s.csv\"", leaderboardID)))
+
+			body, err := ioutil.ReadAll(res.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(Equal("publicID,score,rank,expireAt\nmember1,100,1,0\nmember2,200,2,0\n"))
+		})
+
+		It("should download members as JSON Lines (http)", func() {
+			leaderboardID := uuid.NewV4().String()
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 100, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			res, err := http.Get(GetRoute(app.HTTPEndpoint, fmt.Sprintf("/export/l/%s?format=jsonl", leaderboardID)))
+			Expect(err).NotTo(HaveOccurred())
+			defer res.Body.Close()
+			Expect(res.StatusCode).To(Equal(http.StatusOK))
+			Expect(res.Header.Get("Content-Type")).To(Equal("application/x-ndjson"))
+
+			var member map[string]interface{}
+			Expect(json.NewDecoder(res.Body).Decode(&member)).To(Succeed())
+			Expect(member["publicID"]).To(Equal("member1"))
+			Expect(member["score"]).To(BeEquivalentTo(100))
+			Expect(member["rank"]).To(BeEquivalentTo(1))
+		})
+
+		It("should download only the CSV header of an empty leaderboard (http)", func() {
+			res, err := http.Get(GetRoute(app.HTTPEndpoint, fmt.Sprintf("/export/l/%s", uuid.NewV4().String())))
+			Expect(err).NotTo(HaveOccurred())
+			defer res.Body.Close()
+			Expect(res.StatusCode).To(Equal(http.StatusOK))
+
+			body, err := ioutil.ReadAll(res.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(Equal("publicID,score,rank,expireAt\n"))
+		})
+
+		It("should fail if format is invalid (http)", func() {
+			res, err := http.Get(GetRoute(app.HTTPEndpoint, fmt.Sprintf("/export/l/%s?format=xml", uuid.NewV4().String())))
+			Expect(err).NotTo(HaveOccurred())
+			defer res.Body.Close()
+			Expect(res.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("Live Updates", func() {
+		readEvent := func(reader *bufio.Reader) (string, map[string]interface{}) {
+			var event string
+			var result map[string]interface{}
+			for {
+				line, err := reader.ReadString('\n')
+				Expect(err).NotTo(HaveOccurred())
+				line = strings.TrimSpace(line)
+				if line == "" && event != "" {
+					return event, result
+				}
+				if strings.HasPrefix(line, "event: ") {
+					event = strings.TrimPrefix(line, "event: ")
+				}
+				if strings.HasPrefix(line, "data: ") {
+					Expect(json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &result)).To(Succeed())
+				}
+			}
+		}
+
+		It("should stream top members whenever they change (sse)", func() {
+			leaderboardID := uuid.NewV4().String()
+			_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 100, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			res, err := http.Get(GetRoute(app.HTTPEndpoint, fmt.Sprintf("/sse/l/%s/top?pageSize=2", leaderboardID)))
+			Expect(err).NotTo(HaveOccurred())
+			defer res.Body.Close()
+			Expect(res.StatusCode).To(Equal(http.StatusOK))
+			Expect(res.Header.Get("Content-Type")).To(Equal("text/event-stream"))
+
+			reader := bufio.NewReader(res.Body)
+			event, result := readEvent(reader)
+			Expect(event).To(Equal("members"))
+			Expect(result["success"]).To(BeTrue())
+			members := result["members"].([]interface{})
+			Expect(members).To(HaveLen(1))
+			Expect(members[0].(map[string]interface{})["publicID"]).To(Equal("member1"))
+
+			_, err = app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member2", 200, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			event, result = readEvent(reader)
+			Expect(event).To(Equal("members"))
+			members = result["members"].([]interface{})
+			Expect(members).To(HaveLen(2))
+			Expect(members[0].(map[string]interface{})["publicID"]).To(Equal("member2"))
+			Expect(members[1].(map[string]interface{})["publicID"]).To(Equal("member1"))
+		})
+
+		It("should send an error event if the member is not found (sse)", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			res, err := http.Get(GetRoute(app.HTTPEndpoint, fmt.Sprintf("/sse/l/%s/members/member1/around", leaderboardID)))
+			Expect(err).NotTo(HaveOccurred())
+			defer res.Body.Close()
+			Expect(res.StatusCode).To(Equal(http.StatusOK))
+
+			event, result := readEvent(bufio.NewReader(res.Body))
+			Expect(event).To(Equal("error"))
+			Expect(result["success"]).To(BeFalse())
+			Expect(result["reason"]).To(Equal("Member not found."))
+		})
+
+		It("should stream members around a member whenever they change (websocket)", func() {
+			leaderboardID := uuid.NewV4().String()
+			for i := 1; i <= 5; i++ {
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member%d", i), int64(100-i), false, "", nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			conn, err := websocket.Dial(
+				fmt.Sprintf("ws://%s/ws/l/%s/members/member3/around?pageSize=3", app.HTTPEndpoint, leaderboardID),
+				"",
+				fmt.Sprintf("http://%s", app.HTTPEndpoint),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			memberRank := func(result map[string]interface{}, publicID string) interface{} {
+				for _, member := range result["members"].([]interface{}) {
+					if member.(map[string]interface{})["publicID"] == publicID {
+						return member.(map[string]interface{})["rank"]
+					}
+				}
+				return nil
+			}
+
+			var result map[string]interface{}
+			Expect(websocket.JSON.Receive(conn, &result)).To(Succeed())
+			Expect(result["success"]).To(BeTrue())
+			Expect(result["members"]).To(HaveLen(3))
+			Expect(memberRank(result, "member3")).To(BeEquivalentTo(3))
+
+			_, err = app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member5", 200, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(websocket.JSON.Receive(conn, &result)).To(Succeed())
+			Expect(result["members"]).To(HaveLen(3))
+			Expect(memberRank(result, "member3")).To(BeEquivalentTo(4))
+		})
+
+		It("should accept websockets only from allowed origins", func() {
+			url := fmt.Sprintf("ws://%s/ws/l/%s/top?pageSize=3", app.HTTPEndpoint, uuid.NewV4().String())
+
+			_, err := websocket.Dial(url, "", "https://game.example.com")
+			Expect(err).To(HaveOccurred())
+
+			app.Config.Set("api.watchAllowedOrigins", []string{"https://game.example.com"})
+			defer app.Config.Set("api.watchAllowedOrigins", []string{})
+
+			conn, err := websocket.Dial(url, "", "https://game.example.com")
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			var result map[string]interface{}
+			Expect(websocket.JSON.Receive(conn, &result)).To(Succeed())
+			Expect(result["success"]).To(BeTrue())
+		})
+
+		It("should fail if pageSize is greater than max returned members", func() {
+			status, body := Get(app, fmt.Sprintf("/sse/l/%s/top?pageSize=2001", uuid.NewV4().String()))
+			Expect(status).To(Equal(http.StatusBadRequest), body)
+			Expect(body).To(ContainSubstring("Max pageSize allowed: 2000. pageSize requested: 2001"))
+		})
+
+		It("should fail if the route is unknown", func() {
+			status, _ := Get(app, fmt.Sprintf("/ws/l/%s/bottom", uuid.NewV4().String()))
+			Expect(status).To(Equal(http.StatusNotFound))
+		})
+
+		It("should require basic auth if it is configured", func() {
+			app.Config.Set("basicauth.username", "user")
+			app.Config.Set("basicauth.password", "pass")
+			defer app.Config.Set("basicauth.username", "")
+			defer app.Config.Set("basicauth.password", "")
+
+			route := GetRoute(app.HTTPEndpoint, fmt.Sprintf("/sse/l/%s/top", uuid.NewV4().String()))
+			res, err := http.Get(route)
+			Expect(err).NotTo(HaveOccurred())
+			res.Body.Close()
+			Expect(res.StatusCode).To(Equal(http.StatusUnauthorized))
+			Expect(res.Header.Get("WWW-Authenticate")).To(ContainSubstring("Basic"))
+
+			req, err := http.NewRequest("GET", route, nil)
+			Expect(err).NotTo(HaveOccurred())
+			req.SetBasicAuth("user", "pass")
+			res, err = http.DefaultClient.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+			res.Body.Close()
+			Expect(res.StatusCode).To(Equal(http.StatusOK))
+		})
+	})
+
+	Describe("Get Members Handler", func() {
+		It("should get several members from leaderboard (http)", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			for i := 1; i <= 100; i++ {
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), int64(101-i), false, "", nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			status, body := Get(
+				app,
+				fmt.Sprintf("/l/%s/members?ids=member_10,member_20,member_30", leaderboardID),
+			)
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+
+			Expect(result["success"]).To(BeTrue())
+			Expect(result["notFound"]).To(BeEmpty())
+			members := result["members"].([]interface{})
+			Expect(members).To(HaveLen(3))
+
+			for i := 0; i < 3; i++ {
+				By(fmt.Sprintf("Member %d", i))
+				member := members[i].(map[string]interface{})
+				Expect(member["publicID"]).To(Equal(fmt.Sprintf("member_%d", (i+1)*10)))
+				Expect(member["rank"]).To(BeEquivalentTo((i + 1) * 10))
+				Expect(member["score"]).To(BeEquivalentTo(101 - (i+1)*10))
+				Expect(member["position"]).To(BeEquivalentTo(i))
+			}
+		})
+
+		It("should get several members from leaderboard (grpc)", func() {
+			SetupGRPC(app, func(cli pb.PodiumClient) {
+				leaderboardID := uuid.NewV4().String()
+
+				for i := 1; i <= 100; i++ {
+					_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), int64(101-i), false, "", nil, nil)
+					Expect(err).NotTo(HaveOccurred())
+				}
+
+				req := &pb.GetMembersRequest{
+					LeaderboardId: leaderboardID,
+					Ids:           "member_10,member_20,member_30",
+				}
+				resp, err := cli.GetMembers(context.Background(), req)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(resp.Success).To(BeTrue())
+				Expect(resp.NotFound).To(BeEmpty())
+				members := resp.Members
+				Expect(members).To(HaveLen(3))
+
+				for i := 0; i < 3; i++ {
+					By(fmt.Sprintf("Member %d", i))
+					Expect(members[i].PublicID).To(Equal(fmt.Sprintf("member_%d", (i+1)*10)))
+					Expect(members[i].Rank).To(BeEquivalentTo((i + 1) * 10))
+					Expect(members[i].Score).To(BeEquivalentTo(101 - (i+1)*10))
+					Expect(members[i].Position).To(BeEquivalentTo(i))
+				}
+			})
+		})
+
+		It("should return empty list if invalid leaderboard", func() {
+			status, body := Get(
+				app,
+				"/l/invalid-leaderboard/members/?ids=member_10,member_20,member_30",
+			)
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+
+			Expect(result["success"]).To(BeTrue())
+			Expect(result["members"]).To(HaveLen(0))
+			Expect(result["notFound"]).To(HaveLen(3))
+
+			members := result["notFound"].([]interface{})
+			Expect(members).To(HaveLen(3))
+			Expect(members[0]).To(Equal("member_10"))
+			Expect(members[1]).To(Equal("member_20"))
+			Expect(members[2]).To(Equal("member_30"))
+		})
+
+		It("should return not found members", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			for i := 1; i <= 10; i++ {
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("member_%d", i), int64(101-i), false, "", nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			status, body := Get(
+				app,
+				fmt.Sprintf("/l/%s/members?ids=member_1,invalid_member", leaderboardID),
+			)
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+
+			Expect(result["success"]).To(BeTrue())
+			members := result["members"].([]interface{})
+			Expect(members).To(HaveLen(1))
+
+			member := members[0].(map[string]interface{})
+			Expect(member["publicID"]).To(Equal("member_1"))
+			Expect(member["rank"]).To(BeEquivalentTo(1))
+			Expect(member["score"]).To(BeEquivalentTo(100))
+
+			members = result["notFound"].([]interface{})
+			Expect(members).To(HaveLen(1))
+			Expect(members[0]).To(Equal("invalid_member"))
+		})
+
+		It("should fail if no public ids sent", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			status, body := Get(app, fmt.Sprintf("/l/%s/members/", leaderboardID))
+			Expect(status).To(Equal(http.StatusBadRequest), body)
+
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+
+			Expect(result["success"]).To(BeFalse())
+			Expect(result["reason"]).To(Equal("Member IDs are required using the 'ids' querystring parameter"))
+		})
+
+		It("Should fail if error in Redis", func() {
+			faultyRedisApp := GetDefaultTestAppWithFaultyRedis()
+
+			status, body := Get(
+				faultyRedisApp,
+				"/l/invalid-redis/members?ids=member_10,member_20,member_30",
+			)
+
+			Expect(status).To(Equal(500), body)
+			Expect(body).To(ContainSubstring("connection refused"))
+		})
+
+		HTTPMeasure("it should get members", func(ctx map[string]interface{}) {
+			app := ctx["app"].(*api.App)
+
+			memberIDs := []string{}
+			for i := 1; i <= 1000; i++ {
+				memberID := fmt.Sprintf("member_%d", i)
+				memberIDs = append(memberIDs, memberID)
+				_, err := app.Leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, memberID, int64(101-i), false, "", nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			ctx["mIDs"] = strings.Join(memberIDs, ",")
+		}, func(httpEndPoint string, ctx map[string]interface{}) {
+			url := testing.GetRoute(httpEndPoint, fmt.Sprintf("/l/%s/members?ids=%s", testLeaderboardID, ctx["mIDs"].(string)))
+			status, body, err := testing.FastGet(url)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(Equal(http.StatusOK), string(body))
+		}, 0.9)
+	})
+
+	Describe("Webhook Deliveries", func() {
+		BeforeEach(func() {
+			redisClient.Del(context.Background(), database.WebhookDeliveriesKey)
+		})
+
+		AfterEach(func() {
+			redisClient.Del(context.Background(), database.WebhookDeliveriesKey)
+		})
+
+		It("should get the last webhook deliveries", func() {
+			redisDatabase := &database.Redis{Client: redisClient}
+			for attempt := 1; attempt <= 2; attempt++ {
+				err := redisDatabase.AddWebhookDelivery(context.Background(), &database.WebhookDelivery{
+					ID:          "delivery1",
+					URL:         "http://localhost/lifecycle",
+					EventIDs:    []string{"event1"},
+					Attempt:     attempt,
+					StatusCode:  http.StatusInternalServerError,
+					Error:       "unexpected status 500",
+					AttemptedAt: time.Unix(1600000000+int64(attempt), 0),
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			status, body := Get(app, "/webhooks/deliveries?pageSize=1")
+			Expect(status).To(Equal(http.StatusOK), body)
+
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeTrue())
+			deliveries := result["deliveries"].([]interface{})
+			Expect(deliveries).To(HaveLen(1))
+			delivery := deliveries[0].(map[string]interface{})
+			Expect(delivery["id"]).To(Equal("delivery1"))
+			Expect(delivery["eventIds"]).To(Equal([]interface{}{"event1"}))
+			Expect(delivery["attempt"]).To(Equal(float64(2)))
+			Expect(delivery["statusCode"]).To(Equal(float64(http.StatusInternalServerError)))
+			Expect(delivery["attemptedAt"]).To(Equal("1600000002"))
+		})
+
+		It("should fail if pageSize is greater than max returned members", func() {
+			status, body := Get(app, "/webhooks/deliveries?pageSize=3000")
+			Expect(status).To(Equal(http.StatusBadRequest), body)
+		})
 	})
 })
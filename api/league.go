@@ -0,0 +1,230 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api
+
+import (
+	"context"
+
+	lmodel "github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "github.com/topfreegames/podium/proto/podium/api/v1"
+)
+
+func newLeagueResponse(league *lmodel.League) *api.League {
+	return &api.League{
+		LeagueID:        league.ID,
+		Season:          int32(league.Season),
+		Tiers:           int32(league.Tiers),
+		DivisionSize:    int32(league.DivisionSize),
+		PromotionCount:  int32(league.PromotionCount),
+		RelegationCount: int32(league.RelegationCount),
+		Order:           league.Order,
+	}
+}
+
+func newLeagueDivisionResponse(division *lmodel.LeagueDivision) *api.LeagueDivision {
+	return &api.LeagueDivision{
+		LeagueID:      division.League,
+		Season:        int32(division.Season),
+		Tier:          int32(division.Tier),
+		Division:      int32(division.Division),
+		LeaderboardID: division.Leaderboard,
+		Members:       newMemberRankResponseList(division.Members),
+	}
+}
+
+func leagueErrorStatus(err error) error {
+	switch err.(type) {
+	case *service.InvalidLeagueError:
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	case *service.LeagueAlreadyExistsError:
+		return status.Errorf(codes.AlreadyExists, err.Error())
+	case *service.LeagueNotFoundError, *service.LeagueMemberNotFoundError:
+		return status.Errorf(codes.NotFound, err.Error())
+	}
+	return err
+}
+
+// CreateLeague is the handler responsible for creating a league.
+func (app *App) CreateLeague(ctx context.Context, req *api.CreateLeagueRequest) (*api.LeagueResponse, error) {
+	if req.League == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "league is required")
+	}
+
+	lg := app.Logger.With(
+		zap.String("handler", "CreateLeague"),
+		zap.String("league", req.LeagueId),
+	)
+
+	var league *lmodel.League
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Creating league.")
+		league, err = app.Leaderboards.CreateLeague(ctx, &lmodel.League{
+			ID:              req.LeagueId,
+			Tiers:           int(req.League.Tiers),
+			DivisionSize:    int(req.League.DivisionSize),
+			PromotionCount:  int(req.League.PromotionCount),
+			RelegationCount: int(req.League.RelegationCount),
+			Order:           req.League.Order,
+		})
+
+		if err != nil {
+			lg.Error("Create league failed.", zap.Error(err))
+			app.AddError()
+			return leagueErrorStatus(err)
+		}
+		lg.Debug("Create league succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.LeagueResponse{
+		Success: true,
+		League:  newLeagueResponse(league),
+	}, nil
+}
+
+// GetLeague is the handler responsible for retrieving a league.
+func (app *App) GetLeague(ctx context.Context, req *api.GetLeagueRequest) (*api.LeagueResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "GetLeague"),
+		zap.String("league", req.LeagueId),
+	)
+
+	var league *lmodel.League
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Getting league.")
+		league, err = app.Leaderboards.GetLeague(ctx, req.LeagueId)
+
+		if err != nil {
+			lg.Error("Getting league failed.", zap.Error(err))
+			app.AddError()
+			return leagueErrorStatus(err)
+		}
+		lg.Debug("Getting league succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.LeagueResponse{
+		Success: true,
+		League:  newLeagueResponse(league),
+	}, nil
+}
+
+// JoinLeague is the handler responsible for placing a member in a league division.
+func (app *App) JoinLeague(ctx context.Context, req *api.JoinLeagueRequest) (*api.LeagueDivisionResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "JoinLeague"),
+		zap.String("league", req.LeagueId),
+		zap.String("memberPublicID", req.MemberPublicId),
+		zap.Int32("tier", req.Tier),
+	)
+
+	var division *lmodel.LeagueDivision
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Joining league.")
+		division, err = app.Leaderboards.JoinLeague(ctx, req.LeagueId, req.MemberPublicId, int(req.Tier))
+
+		if err != nil {
+			lg.Error("Join league failed.", zap.Error(err))
+			app.AddError()
+			return leagueErrorStatus(err)
+		}
+		lg.Debug("Join league succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.LeagueDivisionResponse{
+		Success:  true,
+		Division: newLeagueDivisionResponse(division),
+	}, nil
+}
+
+// GetLeagueDivision is the handler responsible for retrieving the division standings of a member.
+func (app *App) GetLeagueDivision(ctx context.Context, req *api.GetLeagueDivisionRequest) (*api.LeagueDivisionResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "GetLeagueDivision"),
+		zap.String("league", req.LeagueId),
+		zap.String("memberPublicID", req.MemberPublicId),
+	)
+
+	var division *lmodel.LeagueDivision
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Getting league division.")
+		division, err = app.Leaderboards.GetLeagueDivision(ctx, req.LeagueId, req.MemberPublicId)
+
+		if err != nil {
+			lg.Error("Getting league division failed.", zap.Error(err))
+			app.AddError()
+			return leagueErrorStatus(err)
+		}
+		lg.Debug("Getting league division succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.LeagueDivisionResponse{
+		Success:  true,
+		Division: newLeagueDivisionResponse(division),
+	}, nil
+}
+
+// EndLeagueSeason is the handler responsible for promoting and relegating league members and starting the next season.
+func (app *App) EndLeagueSeason(ctx context.Context, req *api.EndLeagueSeasonRequest) (*api.EndLeagueSeasonResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "EndLeagueSeason"),
+		zap.String("league", req.LeagueId),
+	)
+
+	var result *lmodel.LeagueSeasonResult
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Ending league season.")
+		result, err = app.Leaderboards.EndLeagueSeason(ctx, req.LeagueId, int(req.Season))
+
+		if err != nil {
+			lg.Error("End league season failed.", zap.Error(err))
+			app.AddError()
+			return leagueErrorStatus(err)
+		}
+		lg.Debug("End league season succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.EndLeagueSeasonResponse{
+		Success:   true,
+		LeagueID:  result.League,
+		Season:    int32(result.Season),
+		Promoted:  int32(result.Promoted),
+		Relegated: int32(result.Relegated),
+		Stayed:    int32(result.Stayed),
+	}, nil
+}
@@ -0,0 +1,185 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/topfreegames/podium/api"
+	"github.com/topfreegames/podium/testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	uuid "github.com/satori/go.uuid"
+	pb "github.com/topfreegames/podium/proto/podium/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("League Handler", func() {
+	var app *api.App
+
+	BeforeEach(func() {
+		app = testing.GetDefaultTestApp()
+		testing.InitializeTestServer(app)
+	})
+
+	createLeague := func(leagueID string) {
+		payload := map[string]interface{}{
+			"tiers":           2,
+			"divisionSize":    2,
+			"promotionCount":  1,
+			"relegationCount": 1,
+		}
+		status, body := testing.PostJSON(app, fmt.Sprintf("/leagues/%s", leagueID), payload)
+		Expect(status).To(Equal(http.StatusOK), body)
+	}
+
+	It("should create and get a league (http)", func() {
+		leagueID := uuid.NewV4().String()
+		createLeague(leagueID)
+
+		status, body := testing.Get(app, fmt.Sprintf("/leagues/%s", leagueID))
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		var result map[string]interface{}
+		json.Unmarshal([]byte(body), &result)
+		Expect(result["success"]).To(BeTrue())
+		league := result["league"].(map[string]interface{})
+		Expect(league["leagueID"]).To(Equal(leagueID))
+		Expect(league["season"]).To(BeEquivalentTo(1))
+		Expect(league["tiers"]).To(BeEquivalentTo(2))
+		Expect(league["divisionSize"]).To(BeEquivalentTo(2))
+		Expect(league["order"]).To(Equal("desc"))
+	})
+
+	It("should fail to create a league twice", func() {
+		leagueID := uuid.NewV4().String()
+		createLeague(leagueID)
+
+		payload := map[string]interface{}{"tiers": 1, "divisionSize": 10}
+		status, body := testing.PostJSON(app, fmt.Sprintf("/leagues/%s", leagueID), payload)
+		Expect(status).To(Equal(http.StatusConflict), body)
+	})
+
+	It("should fail to create a league with invalid configuration", func() {
+		payload := map[string]interface{}{"tiers": 0, "divisionSize": 10}
+		status, body := testing.PostJSON(app, fmt.Sprintf("/leagues/%s", uuid.NewV4().String()), payload)
+		Expect(status).To(Equal(http.StatusBadRequest), body)
+
+		var result map[string]interface{}
+		json.Unmarshal([]byte(body), &result)
+		Expect(result["success"]).To(BeFalse())
+		Expect(result["reason"]).To(Equal("invalid league: tiers 0 must be greater than zero"))
+	})
+
+	It("should return not found if league does not exist", func() {
+		status, body := testing.Get(app, fmt.Sprintf("/leagues/%s", uuid.NewV4().String()))
+		Expect(status).To(Equal(http.StatusNotFound), body)
+	})
+
+	It("should join league and get member division (http)", func() {
+		leagueID := uuid.NewV4().String()
+		createLeague(leagueID)
+
+		status, body := testing.Post(app, fmt.Sprintf("/leagues/%s/members/member1", leagueID), "")
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		var result map[string]interface{}
+		json.Unmarshal([]byte(body), &result)
+		division := result["division"].(map[string]interface{})
+		Expect(division["tier"]).To(BeEquivalentTo(2))
+		Expect(division["division"]).To(BeEquivalentTo(1))
+		leaderboardID := division["leaderboardID"].(string)
+
+		status, body = testing.PutJSON(app, fmt.Sprintf("/l/%s/members/member1/score", leaderboardID), map[string]interface{}{"score": 10})
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		status, body = testing.Get(app, fmt.Sprintf("/leagues/%s/members/member1/division", leagueID))
+		Expect(status).To(Equal(http.StatusOK), body)
+		json.Unmarshal([]byte(body), &result)
+		division = result["division"].(map[string]interface{})
+		Expect(division["leaderboardID"]).To(Equal(leaderboardID))
+		members := division["members"].([]interface{})
+		Expect(members).To(HaveLen(1))
+		Expect(members[0].(map[string]interface{})["score"]).To(BeEquivalentTo(10))
+	})
+
+	It("should fail to join a tier that does not exist", func() {
+		leagueID := uuid.NewV4().String()
+		createLeague(leagueID)
+
+		status, body := testing.Post(app, fmt.Sprintf("/leagues/%s/members/member1?tier=3", leagueID), "")
+		Expect(status).To(Equal(http.StatusBadRequest), body)
+	})
+
+	It("should return not found if member did not join league", func() {
+		leagueID := uuid.NewV4().String()
+		createLeague(leagueID)
+
+		status, body := testing.Get(app, fmt.Sprintf("/leagues/%s/members/member1/division", leagueID))
+		Expect(status).To(Equal(http.StatusNotFound), body)
+	})
+
+	It("should end season promoting and relegating members (grpc)", func() {
+		testing.SetupGRPC(app, func(cli pb.PodiumClient) {
+			leagueID := uuid.NewV4().String()
+			createLeague(leagueID)
+
+			for i, tier := range []int32{1, 1, 2, 2} {
+				member := fmt.Sprintf("member%d", i)
+				resp, err := cli.JoinLeague(context.Background(), &pb.JoinLeagueRequest{
+					LeagueId:       leagueID,
+					MemberPublicId: member,
+					Tier:           tier,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				_, err = app.Leaderboards.SetMemberScore(context.Background(), resp.Division.LeaderboardID, member, int64(100-i), false, "", nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			resp, err := cli.EndLeagueSeason(context.Background(), &pb.EndLeagueSeasonRequest{LeagueId: leagueID})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Success).To(BeTrue())
+			Expect(resp.Season).To(Equal(int32(2)))
+			Expect(resp.Promoted).To(Equal(int32(1)))
+			Expect(resp.Relegated).To(Equal(int32(1)))
+			Expect(resp.Stayed).To(Equal(int32(2)))
+
+			retried, err := cli.EndLeagueSeason(context.Background(), &pb.EndLeagueSeasonRequest{LeagueId: leagueID, Season: 1})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retried.Season).To(Equal(int32(2)))
+			Expect(retried.Promoted).To(Equal(int32(1)))
+
+			_, err = cli.EndLeagueSeason(context.Background(), &pb.EndLeagueSeasonRequest{LeagueId: leagueID, Season: 3})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+			division, err := cli.GetLeagueDivision(context.Background(), &pb.GetLeagueDivisionRequest{
+				LeagueId:       leagueID,
+				MemberPublicId: "member2",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(division.Division.Season).To(Equal(int32(2)))
+			Expect(division.Division.Tier).To(Equal(int32(1)))
+		})
+	})
+
+	It("Should fail if error in Redis", func() {
+		faultyRedisApp := testing.GetDefaultTestAppWithFaultyRedis()
+
+		status, body := testing.Get(faultyRedisApp, fmt.Sprintf("/leagues/%s", uuid.NewV4().String()))
+		Expect(status).To(Equal(500), body)
+		Expect(body).To(ContainSubstring("connection refused"))
+	})
+})
@@ -0,0 +1,130 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/topfreegames/podium/leaderboard/v2/lifecycle"
+	lmodel "github.com/topfreegames/podium/leaderboard/v2/model"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "github.com/topfreegames/podium/proto/podium/api/v1"
+)
+
+// createLifecycleNotifier create the buffered notifier delivering lifecycle events to the webhook configured
+// in lifecycle.webhook.url, recording deliveries in store, or nil if it is empty
+func (app *App) createLifecycleNotifier(store lifecycle.DeliveryStore) (*lifecycle.BufferedNotifier, error) {
+	url := app.Config.GetString("lifecycle.webhook.url")
+	if url == "" {
+		return nil, nil
+	}
+
+	secret := app.Config.GetString("lifecycle.webhook.secret")
+	if secret == "" {
+		return nil, fmt.Errorf("lifecycle.webhook.secret is required to sign lifecycle events")
+	}
+
+	l := app.Logger.With(
+		zap.String("source", "app"),
+		zap.String("operation", "createLifecycleNotifier"),
+	)
+
+	notifier := lifecycle.NewWebhookNotifier(
+		url,
+		secret,
+		app.Config.GetStringSlice("lifecycle.webhook.events"),
+		app.Config.GetDuration("lifecycle.webhook.timeout"),
+		app.Config.GetInt("lifecycle.webhook.maxRetries"),
+		app.Config.GetDuration("lifecycle.webhook.retryBackoff"),
+		store,
+		func(err error) {
+			l.Error("Failed to record lifecycle webhook delivery.", zap.Error(err))
+		},
+	)
+
+	bufferedNotifier := lifecycle.NewBufferedNotifier(
+		notifier,
+		app.Config.GetInt("lifecycle.bufferSize"),
+		app.Config.GetInt("lifecycle.batchSize"),
+		app.Config.GetDuration("lifecycle.retryInterval"),
+		func(err error) {
+			l.Error("Failed to deliver lifecycle events.", zap.Error(err))
+		},
+	)
+
+	l.Info("Created lifecycle notifier.")
+	return bufferedNotifier, nil
+}
+
+// closeLifecycleNotifier wait up to lifecycle.flushTimeout for buffered lifecycle events to be delivered
+func (app *App) closeLifecycleNotifier() {
+	if app.lifecycleNotifier == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), app.Config.GetDuration("lifecycle.flushTimeout"))
+	defer cancel()
+
+	if err := app.lifecycleNotifier.Close(ctx); err != nil {
+		app.Logger.Error("Lifecycle events were dropped on shutdown.", zap.Error(err))
+	}
+}
+
+// GetWebhookDeliveries is the handler responsible for retrieving the last attempts to deliver lifecycle events.
+func (app *App) GetWebhookDeliveries(ctx context.Context, req *api.GetWebhookDeliveriesRequest) (*api.GetWebhookDeliveriesResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "GetWebhookDeliveries"),
+	)
+
+	page := int(math.Max(float64(req.Page), 1))
+
+	pageSize := getPageSize(int(req.PageSize))
+	if pageSize > app.Config.GetInt("api.maxReturnedMembers") {
+		msg := fmt.Sprintf(
+			"Max pageSize allowed: %d. pageSize requested: %d",
+			app.Config.GetInt("api.maxReturnedMembers"),
+			pageSize,
+		)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+
+	var deliveries []*lmodel.WebhookDelivery
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Getting webhook deliveries.")
+		deliveries, err = app.Leaderboards.GetWebhookDeliveries(ctx, pageSize, page)
+
+		if err != nil {
+			lg.Error("Getting webhook deliveries failed.", zap.Error(err))
+			app.AddError()
+			return err
+		}
+		lg.Debug("Getting webhook deliveries succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &api.GetWebhookDeliveriesResponse{
+		Success:    true,
+		Deliveries: make([]*api.GetWebhookDeliveriesResponse_WebhookDelivery, len(deliveries)),
+	}
+	for i, delivery := range deliveries {
+		response.Deliveries[i] = &api.GetWebhookDeliveriesResponse_WebhookDelivery{
+			Id:           delivery.ID,
+			Url:          delivery.URL,
+			EventIds:     delivery.EventIDs,
+			Attempt:      int32(delivery.Attempt),
+			StatusCode:   int32(delivery.StatusCode),
+			Error:        delivery.Error,
+			DeadLettered: delivery.DeadLettered,
+			AttemptedAt:  delivery.AttemptedAt,
+		}
+	}
+
+	return response, nil
+}
@@ -0,0 +1,271 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+	"golang.org/x/net/websocket"
+
+	api "github.com/topfreegames/podium/proto/podium/api/v1"
+)
+
+const (
+	sseRoutePrefix       = "/sse/l/"
+	webSocketRoutePrefix = "/ws/l/"
+)
+
+// liveRequest is a request for live updates of the top members of a leaderboard, or of the members around
+// member when it is set
+type liveRequest struct {
+	leaderboard       string
+	member            string
+	order             string
+	pageSize          int
+	getLastIfNotFound bool
+}
+
+// liveSendFunc send an event with its JSON payload to a live updates connection
+type liveSendFunc func(event string, data []byte) error
+
+// sseHandler stream live leaderboard updates as server-sent events
+func (app *App) sseHandler(w http.ResponseWriter, r *http.Request) {
+	lg := app.Logger.With(
+		zap.String("handler", "sseHandler"),
+		zap.String("path", r.URL.Path),
+	)
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		app.writeLiveFail(w, lg, http.StatusInternalServerError, "Streaming is not supported.")
+		return
+	}
+
+	req, subscription, ok := app.subscribeLive(w, r, lg, sseRoutePrefix)
+	if !ok {
+		return
+	}
+	defer app.membersWatcher.unsubscribe(subscription)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	app.streamLive(r.Context(), lg, req, subscription, func(event string, data []byte) error {
+		if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	})
+}
+
+// webSocketHandler stream live leaderboard updates as WebSocket text messages
+func (app *App) webSocketHandler(w http.ResponseWriter, r *http.Request) {
+	lg := app.Logger.With(
+		zap.String("handler", "webSocketHandler"),
+		zap.String("path", r.URL.Path),
+	)
+
+	req, subscription, ok := app.subscribeLive(w, r, lg, webSocketRoutePrefix)
+	if !ok {
+		return
+	}
+	defer app.membersWatcher.unsubscribe(subscription)
+
+	writeTimeout := app.Config.GetDuration("api.watchWriteTimeout")
+	server := websocket.Server{Handshake: app.checkWebSocketOrigin, Handler: func(conn *websocket.Conn) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+
+		// clients don't send messages, reading only detects when they close the connection
+		go func() {
+			var message []byte
+			for {
+				if err := websocket.Message.Receive(conn, &message); err != nil {
+					cancel()
+					return
+				}
+			}
+		}()
+
+		app.streamLive(ctx, lg, req, subscription, func(event string, data []byte) error {
+			if err := conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+				return err
+			}
+			return websocket.Message.Send(conn, string(data))
+		})
+	}}
+	server.ServeHTTP(w, r)
+}
+
+// checkWebSocketOrigin reject WebSocket handshakes from browsers of origins other than the host of the request
+// and the ones listed in api.watchAllowedOrigins, where "*" allows any. Requests without an Origin header are not
+// sent by browsers and are accepted
+func (app *App) checkWebSocketOrigin(config *websocket.Config, r *http.Request) error {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return nil
+	}
+
+	for _, allowed := range app.Config.GetStringSlice("api.watchAllowedOrigins") {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return nil
+		}
+	}
+
+	originURL, err := url.ParseRequestURI(origin)
+	if err == nil && strings.EqualFold(originURL.Host, r.Host) {
+		return nil
+	}
+
+	return fmt.Errorf("origin %s is not allowed", origin)
+}
+
+// subscribeLive parse the live updates request at the route under prefix and subscribe to it, writing the
+// failure response and returning false if it is invalid or there are too many subscriptions
+func (app *App) subscribeLive(w http.ResponseWriter, r *http.Request, lg *zap.Logger, prefix string) (*liveRequest, *membersSubscription, bool) {
+	req, err := app.parseLiveRequest(r, prefix)
+	if err != nil {
+		app.writeLiveFail(w, lg, http.StatusBadRequest, err.Error())
+		return nil, nil, false
+	}
+	if req == nil {
+		app.writeLiveFail(w, lg, http.StatusNotFound, "Not Found")
+		return nil, nil, false
+	}
+
+	var subscription *membersSubscription
+	if req.member == "" {
+		subscription, err = app.subscribeTopMembers(req.leaderboard, req.order, req.pageSize)
+	} else {
+		subscription, err = app.subscribeMembersAround(req.leaderboard, req.member, req.order, req.pageSize, req.getLastIfNotFound)
+	}
+	if err != nil {
+		lg.Error("Subscribing to live updates failed.", zap.Error(err))
+		app.AddError()
+		app.writeLiveFail(w, lg, http.StatusServiceUnavailable, err.Error())
+		return nil, nil, false
+	}
+
+	lg.Debug("Watching live updates.")
+	return req, subscription, true
+}
+
+// parseLiveRequest parse routes like <prefix><leaderboardID>/top and
+// <prefix><leaderboardID>/members/<memberPublicID>/around, returning nil if the route doesn't match
+func (app *App) parseLiveRequest(r *http.Request, prefix string) (*liveRequest, error) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, prefix), "/")
+
+	req := &liveRequest{}
+	switch {
+	case len(parts) == 2 && parts[0] != "" && parts[1] == "top":
+		req.leaderboard = parts[0]
+	case len(parts) == 4 && parts[0] != "" && parts[1] == "members" && parts[2] != "" && parts[3] == "around":
+		req.leaderboard = parts[0]
+		req.member = parts[2]
+	default:
+		return nil, nil
+	}
+
+	query := r.URL.Query()
+	req.order = getOrder(query.Get("order"))
+
+	pageSize := 0
+	if query.Get("pageSize") != "" {
+		var err error
+		pageSize, err = strconv.Atoi(query.Get("pageSize"))
+		if err != nil || pageSize < 0 {
+			return nil, fmt.Errorf("Invalid pageSize: %s", query.Get("pageSize"))
+		}
+	}
+	req.pageSize = getPageSize(pageSize)
+	if req.pageSize > app.Config.GetInt("api.maxReturnedMembers") {
+		return nil, fmt.Errorf(
+			"Max pageSize allowed: %d. pageSize requested: %d",
+			app.Config.GetInt("api.maxReturnedMembers"),
+			req.pageSize,
+		)
+	}
+
+	if query.Get("getLastIfNotFound") != "" {
+		getLastIfNotFound, err := strconv.ParseBool(query.Get("getLastIfNotFound"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid getLastIfNotFound: %s", query.Get("getLastIfNotFound"))
+		}
+		req.getLastIfNotFound = getLastIfNotFound
+	}
+
+	return req, nil
+}
+
+// streamLive send the members of subscription as "members" events until ctx is done, the app shuts down
+// or the watched member is not found, which is sent as an "error" event
+func (app *App) streamLive(ctx context.Context, lg *zap.Logger, req *liveRequest, subscription *membersSubscription, send liveSendFunc) {
+	marshaler := &jsonpb.Marshaler{EmitDefaults: true}
+
+	for {
+		select {
+		case update := <-subscription.updates:
+			if update.err != nil && strings.HasPrefix(update.err.Error(), notFoundError) {
+				lg.Debug("Member not found.", zap.Error(update.err))
+				if err := send("error", []byte(newFailMsg("Member not found."))); err != nil {
+					lg.Debug("Sending live update failed.", zap.Error(err))
+				}
+				return
+			} else if update.err != nil {
+				lg.Error("Watching live updates failed.", zap.Error(update.err))
+				app.AddError()
+				continue
+			}
+
+			var response proto.Message
+			if req.member == "" {
+				response = &api.GetTopMembersResponse{
+					Success: true,
+					Members: newMemberRankResponseList(update.members),
+				}
+			} else {
+				response = &api.GetAroundMemberResponse{
+					Success: true,
+					Members: newMemberRankResponseList(update.members),
+				}
+			}
+
+			data, err := marshaler.MarshalToString(response)
+			if err != nil {
+				lg.Error("Marshaling live update failed.", zap.Error(err))
+				app.AddError()
+				return
+			}
+
+			if err := send("members", []byte(data)); err != nil {
+				lg.Debug("Sending live update failed.", zap.Error(err))
+				return
+			}
+		case <-ctx.Done():
+			lg.Debug("Watching live updates ended.")
+			return
+		case <-app.membersWatcher.done:
+			lg.Debug("Watching live updates ended by shutdown.")
+			return
+		}
+	}
+}
+
+func (app *App) writeLiveFail(w http.ResponseWriter, lg *zap.Logger, statusCode int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if _, err := w.Write([]byte(newFailMsg(msg))); err != nil {
+		lg.Error("Error writing live updates response", zap.Error(err))
+	}
+}
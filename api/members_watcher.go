@@ -0,0 +1,247 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	lmodel "github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// errTooManySubscriptions is returned when the watcher already has its maximum of open subscriptions
+var errTooManySubscriptions = errors.New("too many subscriptions")
+
+// membersWatcher polls views of leaderboards, like the top members or the members around one, and pushes
+// their members to subscribers when they change. Subscribers of the same view share a single poll, of the
+// largest number of members any of them watches, so the load on redis does not grow with the number of
+// subscribers. At most maxSubscriptions subscriptions are open at once
+type membersWatcher struct {
+	interval         time.Duration
+	maxSubscriptions int
+
+	mutex         sync.Mutex
+	subscriptions int
+	watches       map[membersWatchKey]*membersWatch
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// membersWatchKey identifies a view, views whose members depend on the page size must include it
+type membersWatchKey struct {
+	view              string
+	leaderboard       string
+	member            string
+	order             string
+	pageSize          int
+	getLastIfNotFound bool
+}
+
+// fetchMembersFunc return the first pageSize members of a view
+type fetchMembersFunc func(ctx context.Context, pageSize int) ([]*lmodel.Member, error)
+
+type membersWatch struct {
+	fetch         fetchMembersFunc
+	subscriptions map[*membersSubscription]struct{}
+	stop          chan struct{}
+}
+
+// membersUpdate is sent to subscriptions with the members of their view or the error polling it
+type membersUpdate struct {
+	members []*lmodel.Member
+	err     error
+}
+
+// membersSubscription receives the first pageSize members of a view in updates whenever they change. Only
+// the latest update is kept for subscribers that fall behind, so slow subscribers skip intermediate changes
+// instead of buffering them
+type membersSubscription struct {
+	key       membersWatchKey
+	pageSize  int
+	updates   chan *membersUpdate
+	delivered bool
+	sent      []*lmodel.Member
+}
+
+func newMembersWatcher(interval time.Duration, maxSubscriptions int) *membersWatcher {
+	return &membersWatcher{
+		interval:         interval,
+		maxSubscriptions: maxSubscriptions,
+		watches:          map[membersWatchKey]*membersWatch{},
+		done:             make(chan struct{}),
+	}
+}
+
+// close signal subscribers to stop watching through done, so streams don't hold the servers on shutdown
+func (w *membersWatcher) close() {
+	w.closeOnce.Do(func() {
+		close(w.done)
+	})
+}
+
+// subscribe start watching the first pageSize members of the view identified by key, fetched with fetch
+// if the view is not watched yet. The current members are sent on the next poll
+func (w *membersWatcher) subscribe(key membersWatchKey, pageSize int, fetch fetchMembersFunc) (*membersSubscription, error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.maxSubscriptions > 0 && w.subscriptions >= w.maxSubscriptions {
+		return nil, errTooManySubscriptions
+	}
+
+	watch, ok := w.watches[key]
+	if !ok {
+		watch = &membersWatch{
+			fetch:         fetch,
+			subscriptions: map[*membersSubscription]struct{}{},
+			stop:          make(chan struct{}),
+		}
+		w.watches[key] = watch
+		go w.poll(watch)
+	}
+
+	subscription := &membersSubscription{
+		key:      key,
+		pageSize: pageSize,
+		updates:  make(chan *membersUpdate, 1),
+	}
+	watch.subscriptions[subscription] = struct{}{}
+	w.subscriptions++
+
+	return subscription, nil
+}
+
+// unsubscribe stop sending updates to subscription, views without subscriptions are no longer polled
+func (w *membersWatcher) unsubscribe(subscription *membersSubscription) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	watch, ok := w.watches[subscription.key]
+	if !ok {
+		return
+	}
+
+	if _, ok := watch.subscriptions[subscription]; !ok {
+		return
+	}
+
+	delete(watch.subscriptions, subscription)
+	w.subscriptions--
+	if len(watch.subscriptions) == 0 {
+		close(watch.stop)
+		delete(w.watches, subscription.key)
+	}
+}
+
+func (w *membersWatcher) poll(watch *membersWatch) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.update(watch)
+
+		select {
+		case <-ticker.C:
+		case <-watch.stop:
+			return
+		}
+	}
+}
+
+func (w *membersWatcher) update(watch *membersWatch) {
+	w.mutex.Lock()
+	pageSize := 0
+	for subscription := range watch.subscriptions {
+		if subscription.pageSize > pageSize {
+			pageSize = subscription.pageSize
+		}
+	}
+	w.mutex.Unlock()
+
+	if pageSize == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), w.interval)
+	defer cancel()
+
+	members, err := watch.fetch(ctx, pageSize)
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	for subscription := range watch.subscriptions {
+		if err != nil {
+			subscription.send(&membersUpdate{err: err})
+			continue
+		}
+
+		subscriptionMembers := members
+		if len(subscriptionMembers) > subscription.pageSize {
+			subscriptionMembers = subscriptionMembers[:subscription.pageSize]
+		}
+
+		if subscription.delivered && equalMembers(subscription.sent, subscriptionMembers) {
+			continue
+		}
+		subscription.delivered = true
+		subscription.sent = subscriptionMembers
+
+		subscription.send(&membersUpdate{members: subscriptionMembers})
+	}
+}
+
+// send replace the update pending in subscription with update, it must be called holding the watcher lock
+// so there is room for update after discarding the pending one
+func (s *membersSubscription) send(update *membersUpdate) {
+	select {
+	case <-s.updates:
+	default:
+	}
+	s.updates <- update
+}
+
+func equalMembers(members, otherMembers []*lmodel.Member) bool {
+	if len(members) != len(otherMembers) {
+		return false
+	}
+
+	for i, member := range members {
+		otherMember := otherMembers[i]
+		if member.PublicID != otherMember.PublicID || member.Score != otherMember.Score || member.Rank != otherMember.Rank {
+			return false
+		}
+	}
+
+	return true
+}
+
+// subscribeTopMembers start watching the top pageSize members of leaderboard
+func (app *App) subscribeTopMembers(leaderboard, order string, pageSize int) (*membersSubscription, error) {
+	key := membersWatchKey{
+		view:        "top",
+		leaderboard: leaderboard,
+		order:       order,
+	}
+
+	return app.membersWatcher.subscribe(key, pageSize, func(ctx context.Context, pageSize int) ([]*lmodel.Member, error) {
+		return app.Leaderboards.GetLeaders(ctx, leaderboard, pageSize, 1, order)
+	})
+}
+
+// subscribeMembersAround start watching the pageSize members around member in leaderboard
+func (app *App) subscribeMembersAround(leaderboard, member, order string, pageSize int, getLastIfNotFound bool) (*membersSubscription, error) {
+	key := membersWatchKey{
+		view:              "around",
+		leaderboard:       leaderboard,
+		member:            member,
+		order:             order,
+		pageSize:          pageSize,
+		getLastIfNotFound: getLastIfNotFound,
+	}
+
+	return app.membersWatcher.subscribe(key, pageSize, func(ctx context.Context, pageSize int) ([]*lmodel.Member, error) {
+		return app.Leaderboards.GetAroundMe(ctx, leaderboard, pageSize, member, order, getLastIfNotFound)
+	})
+}
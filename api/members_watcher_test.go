@@ -0,0 +1,124 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	lmodel "github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+func TestMembersWatcherSharesPolls(t *testing.T) {
+	var mutex sync.Mutex
+	polls := 0
+	score := int64(100)
+	getLeaders := func(ctx context.Context, pageSize int) ([]*lmodel.Member, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		polls++
+		members := []*lmodel.Member{
+			{PublicID: "member1", Score: score, Rank: 1},
+			{PublicID: "member2", Score: 50, Rank: 2},
+		}
+		if pageSize < len(members) {
+			members = members[:pageSize]
+		}
+		return members, nil
+	}
+
+	watcher := newMembersWatcher(10*time.Millisecond, 0)
+	key := membersWatchKey{view: "top", leaderboard: "leaderboard", order: "desc"}
+	top1, err := watcher.subscribe(key, 1, getLeaders)
+	if err != nil {
+		t.Fatal(err)
+	}
+	top2, err := watcher.subscribe(key, 2, getLeaders)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if members := receiveMembers(t, top1); len(members) != 1 {
+		t.Fatalf("subscription of 1 member received %d members", len(members))
+	}
+	if members := receiveMembers(t, top2); len(members) != 2 {
+		t.Fatalf("subscription of 2 members received %d members", len(members))
+	}
+
+	mutex.Lock()
+	pollsBefore := polls
+	mutex.Unlock()
+	time.Sleep(50 * time.Millisecond)
+	mutex.Lock()
+	if polls-pollsBefore > 6 {
+		t.Errorf("watcher polled %d times in 5 intervals, subscriptions are not sharing polls", polls-pollsBefore)
+	}
+	mutex.Unlock()
+
+	select {
+	case <-top1.updates:
+		t.Fatal("subscription received an update without changes")
+	default:
+	}
+
+	mutex.Lock()
+	score = 200
+	mutex.Unlock()
+	if members := receiveMembers(t, top1); members[0].Score != 200 {
+		t.Errorf("subscription received score %d, want 200", members[0].Score)
+	}
+
+	watcher.unsubscribe(top1)
+	watcher.unsubscribe(top2)
+	if len(watcher.watches) != 0 {
+		t.Errorf("watcher has %d watches after unsubscribing all, want 0", len(watcher.watches))
+	}
+
+	mutex.Lock()
+	pollsAfterUnsubscribe := polls
+	mutex.Unlock()
+	time.Sleep(50 * time.Millisecond)
+	mutex.Lock()
+	defer mutex.Unlock()
+	if polls > pollsAfterUnsubscribe+1 {
+		t.Errorf("watcher polled %d times after unsubscribing all", polls-pollsAfterUnsubscribe)
+	}
+}
+
+func TestMembersWatcherLimitsSubscriptions(t *testing.T) {
+	fetch := func(ctx context.Context, pageSize int) ([]*lmodel.Member, error) {
+		return nil, nil
+	}
+
+	watcher := newMembersWatcher(10*time.Millisecond, 1)
+	key := membersWatchKey{view: "top", leaderboard: "leaderboard", order: "desc"}
+	subscription, err := watcher.subscribe(key, 1, fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherKey := membersWatchKey{view: "around", leaderboard: "leaderboard", member: "member", order: "desc", pageSize: 1}
+	if _, err := watcher.subscribe(otherKey, 1, fetch); err != errTooManySubscriptions {
+		t.Fatalf("subscribing over the limit returned %v, want %v", err, errTooManySubscriptions)
+	}
+
+	watcher.unsubscribe(subscription)
+	otherSubscription, err := watcher.subscribe(otherKey, 1, fetch)
+	if err != nil {
+		t.Fatalf("subscribing after unsubscribing returned %v", err)
+	}
+	watcher.unsubscribe(otherSubscription)
+}
+
+func receiveMembers(t *testing.T, subscription *membersSubscription) []*lmodel.Member {
+	select {
+	case update := <-subscription.updates:
+		if update.err != nil {
+			t.Fatal(update.err)
+		}
+		return update.members
+	case <-time.After(time.Second):
+		t.Fatal("subscription received no update")
+		return nil
+	}
+}
@@ -11,20 +11,34 @@ package api
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"math"
+	"net"
 	"net/http"
 	"runtime/debug"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/getsentry/raven-go"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
 	"github.com/topfreegames/podium/log"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	api "github.com/topfreegames/podium/proto/podium/api/v1"
 )
 
 type newRelicContextKey struct {
@@ -49,6 +63,419 @@ func (app *App) basicAuthMiddleware(ctx context.Context) (context.Context, error
 	return ctx, nil
 }
 
+// httpBasicAuthMiddleware apply the basic auth of the grpc servers to http handlers served outside the gateway
+func (app *App) httpBasicAuthMiddleware(f func(http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		basicAuthUser := app.Config.GetString("basicauth.username")
+		if basicAuthUser != "" {
+			user, password, ok := r.BasicAuth()
+			if !ok || user != basicAuthUser || password != app.Config.GetString("basicauth.password") {
+				w.Header().Set("WWW-Authenticate", `Basic realm="podium"`)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusUnauthorized)
+				if _, err := w.Write([]byte(newFailMsg("invalid auth token"))); err != nil {
+					app.Logger.Error("Error writing unauthorized response", zap.Error(err))
+				}
+				return
+			}
+		}
+
+		f(w, r)
+	}
+}
+
+const (
+	signatureMetadataKey = "x-podium-signature"
+	timestampMetadataKey = "x-podium-timestamp"
+	nonceMetadataKey     = "x-podium-nonce"
+)
+
+// scoreWrite is a score written by a request
+type scoreWrite struct {
+	leaderboard string
+	member      string
+	score       int64
+}
+
+func getScoreWrites(req interface{}) []*scoreWrite {
+	switch r := req.(type) {
+	case *api.UpsertScoreRequest:
+		return []*scoreWrite{{r.LeaderboardId, r.MemberPublicId, int64(r.GetScoreChange().GetScore())}}
+	case *api.IncrementScoreRequest:
+		return []*scoreWrite{{r.LeaderboardId, r.MemberPublicId, int64(r.GetBody().GetIncrement())}}
+	case *api.BulkUpsertScoresRequest:
+		writes := []*scoreWrite{}
+		for _, member := range r.GetMemberScores().GetMembers() {
+			writes = append(writes, &scoreWrite{r.LeaderboardId, member.PublicID, int64(member.Score)})
+		}
+		return writes
+	case *api.UpsertScoreMultiLeaderboardsRequest:
+		writes := []*scoreWrite{}
+		for _, leaderboard := range r.GetScoreMultiChange().GetLeaderboards() {
+			writes = append(writes, &scoreWrite{leaderboard, r.MemberPublicId, int64(r.GetScoreMultiChange().GetScore())})
+		}
+		return writes
+	case *api.ImportScoresRequest:
+		writes := []*scoreWrite{}
+		for _, member := range r.GetMembers() {
+			writes = append(writes, &scoreWrite{r.LeaderboardId, member.PublicID, int64(member.Score)})
+		}
+		return writes
+	}
+	return nil
+}
+
+// scoreWriteStream is a server stream that checks the score writes of each message it receives
+type scoreWriteStream struct {
+	grpc.ServerStream
+	check func(writes []*scoreWrite) error
+	// leaderboard is the leaderboard of the first message, written by later messages that leave it empty
+	leaderboard string
+}
+
+func (s *scoreWriteStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err != nil {
+		return err
+	}
+
+	if r, ok := m.(interface{ GetLeaderboardId() string }); ok && s.leaderboard == "" {
+		s.leaderboard = r.GetLeaderboardId()
+	}
+
+	writes := getScoreWrites(m)
+	if len(writes) == 0 {
+		return nil
+	}
+	for _, write := range writes {
+		if write.leaderboard == "" {
+			write.leaderboard = s.leaderboard
+		}
+	}
+
+	return s.check(writes)
+}
+
+// getSignaturePayload return the message signed by a request: the gRPC method called, leaderboard,
+// member and score of each write in order followed by timestamp and nonce, separated by new lines
+func getSignaturePayload(method string, writes []*scoreWrite, timestamp, nonce string) string {
+	var payload strings.Builder
+	fmt.Fprintf(&payload, "%s\n", method)
+	for _, write := range writes {
+		fmt.Fprintf(&payload, "%s\n%s\n%d\n", write.leaderboard, write.member, write.score)
+	}
+	fmt.Fprintf(&payload, "%s\n%s", timestamp, nonce)
+	return payload.String()
+}
+
+// getStreamSignaturePayload return the message signed by a stream, whose scores are not known when it
+// starts: the gRPC method called and the leaderboards it writes to followed by timestamp and nonce,
+// separated by new lines
+func getStreamSignaturePayload(method string, leaderboards []string, timestamp, nonce string) string {
+	var payload strings.Builder
+	fmt.Fprintf(&payload, "%s\n", method)
+	for _, leaderboard := range leaderboards {
+		fmt.Fprintf(&payload, "%s\n", leaderboard)
+	}
+	fmt.Fprintf(&payload, "%s\n%s", timestamp, nonce)
+	return payload.String()
+}
+
+func getMetadataValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+const (
+	reasonMetadataKey       = "x-podium-reason"
+	forwardedForMetadataKey = "x-forwarded-for"
+	retryAfterMetadataKey   = "retry-after"
+)
+
+// getRateLimit return the token bucket configured at key, or nil if its rate is not positive
+func (app *App) getRateLimit(key string) *model.RateLimit {
+	rate := app.Config.GetFloat64(key + ".rate")
+	if rate <= 0 {
+		return nil
+	}
+
+	burst := app.Config.GetInt64(key + ".burst")
+	if burst < 1 {
+		burst = int64(math.Max(1, math.Ceil(rate)))
+	}
+
+	return &model.RateLimit{Rate: rate, Burst: burst}
+}
+
+// isTrustedProxy return whether address is in one of the networks of api.trustedProxies
+func (app *App) isTrustedProxy(address string) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+
+	for _, proxy := range app.Config.GetStringSlice("api.trustedProxies") {
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			if proxyIP := net.ParseIP(proxy); proxyIP != nil && proxyIP.Equal(ip) {
+				return true
+			}
+			continue
+		}
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getCaller return the identity of who made the request: its peer address or, while the address is a
+// trusted proxy, the address the proxy forwarded it for. HTTP requests are forwarded by the gateway,
+// whose address is trusted by default
+func (app *App) getCaller(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	caller, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		caller = p.Addr.String()
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	forwardedFor := strings.Split(strings.Join(md.Get(forwardedForMetadataKey), ","), ",")
+	for i := len(forwardedFor) - 1; i >= 0 && app.isTrustedProxy(caller); i-- {
+		forwarded := strings.TrimSpace(forwardedFor[i])
+		if forwarded == "" {
+			break
+		}
+		caller = forwarded
+	}
+
+	return caller
+}
+
+// rateLimitMiddleware rejects score writes when the caller, a leaderboard or one of its members
+// exceeded its token bucket, telling in retry-after metadata how many seconds to wait
+func (app *App) rateLimitMiddleware(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	err := app.takeWriteTokens(ctx, getScoreWrites(req), func(md metadata.MD) error {
+		return grpc.SetHeader(ctx, md)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// streamRateLimitMiddleware applies the rate limits of rateLimitMiddleware to each message received by streams
+func (app *App) streamRateLimitMiddleware(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &scoreWriteStream{
+		ServerStream: stream,
+		check: func(writes []*scoreWrite) error {
+			return app.takeWriteTokens(stream.Context(), writes, stream.SetHeader)
+		},
+	})
+}
+
+// takeWriteTokens take the tokens of writes from the rate limits configured, returning ResourceExhausted
+// and setting retry-after metadata with setHeader if a rate limit does not have a token
+func (app *App) takeWriteTokens(ctx context.Context, writes []*scoreWrite, setHeader func(md metadata.MD) error) error {
+	if len(writes) == 0 {
+		return nil
+	}
+
+	limits := &model.RateLimits{
+		Member:      app.getRateLimit("api.rateLimit.member"),
+		Leaderboard: app.getRateLimit("api.rateLimit.leaderboard"),
+		Caller:      app.getRateLimit("api.rateLimit.caller"),
+	}
+	if limits.Member == nil && limits.Leaderboard == nil && limits.Caller == nil {
+		return nil
+	}
+
+	members := map[string][]string{}
+	for _, write := range writes {
+		members[write.leaderboard] = append(members[write.leaderboard], write.member)
+	}
+
+	err := app.Leaderboards.TakeWriteTokens(ctx, app.getCaller(ctx), members, limits)
+	if err != nil {
+		if rateLimitErr, ok := err.(*service.RateLimitExceededError); ok {
+			retryAfter := int64(math.Ceil(rateLimitErr.RetryAfter().Seconds()))
+			if retryAfter < 1 {
+				retryAfter = 1
+			}
+			if err := setHeader(metadata.Pairs(retryAfterMetadataKey, strconv.FormatInt(retryAfter, 10))); err != nil {
+				app.Logger.Error("Failed to set retry-after header.", zap.Error(err))
+			}
+			return status.Errorf(codes.ResourceExhausted, err.Error())
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (app *App) getSignatureGame(ctx context.Context, leaderboard string) (string, error) {
+	settings, err := app.Leaderboards.GetLeaderboardSettings(ctx, leaderboard)
+	if err != nil {
+		return "", err
+	}
+	return settings.SignatureGame, nil
+}
+
+// signatureMiddleware rejects score writes to leaderboards that require signing unless they carry a
+// valid HMAC-SHA256 signature of the game secret, a recent timestamp and a nonce not used before.
+// The signature game of leaderboards is cached for api.signature.settingsCacheTTL
+func (app *App) signatureMiddleware(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	writes := getScoreWrites(req)
+	err := app.verifySignature(ctx, writes, func(leaderboards []string, timestamp, nonce string) string {
+		return getSignaturePayload(info.FullMethod, writes, timestamp, nonce)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// streamSignatureMiddleware applies signatureMiddleware to each message received by streams, which are
+// signed once for each leaderboard they write to with the payload of getStreamSignaturePayload
+func (app *App) streamSignatureMiddleware(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	verified := map[string]bool{}
+	return handler(srv, &scoreWriteStream{
+		ServerStream: stream,
+		check: func(writes []*scoreWrite) error {
+			unverified := []*scoreWrite{}
+			for _, write := range writes {
+				if !verified[write.leaderboard] {
+					unverified = append(unverified, write)
+				}
+			}
+
+			err := app.verifySignature(stream.Context(), unverified, func(leaderboards []string, timestamp, nonce string) string {
+				return getStreamSignaturePayload(info.FullMethod, leaderboards, timestamp, nonce)
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, write := range unverified {
+				verified[write.leaderboard] = true
+			}
+			return nil
+		},
+	})
+}
+
+// verifySignature return Unauthenticated unless the signature metadata of ctx signs the message built by
+// payload with the secret of the game of each leaderboard written that requires signing, using its nonce
+// in them. payload is called with the leaderboards that require signing
+func (app *App) verifySignature(ctx context.Context, writes []*scoreWrite, payload func(leaderboards []string, timestamp, nonce string) string) error {
+	leaderboards := []string{}
+	games := map[string]string{}
+	for _, write := range writes {
+		if _, ok := games[write.leaderboard]; ok {
+			continue
+		}
+
+		game, err := app.signatureGames.get(ctx, write.leaderboard, app.getSignatureGame)
+		if err != nil {
+			return err
+		}
+		games[write.leaderboard] = game
+		if game != "" {
+			leaderboards = append(leaderboards, write.leaderboard)
+		}
+	}
+
+	if len(leaderboards) == 0 {
+		return nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	signature := getMetadataValue(md, signatureMetadataKey)
+	timestamp := getMetadataValue(md, timestampMetadataKey)
+	nonce := getMetadataValue(md, nonceMetadataKey)
+	if signature == "" || timestamp == "" || nonce == "" {
+		return status.Errorf(codes.Unauthenticated, "leaderboard %s requires signed writes", leaderboards[0])
+	}
+
+	signedAt, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "invalid signature timestamp %s", timestamp)
+	}
+	maxClockSkew := app.Config.GetDuration("api.signature.maxClockSkew")
+	if skew := time.Since(time.Unix(signedAt, 0)); skew > maxClockSkew || skew < -maxClockSkew {
+		return status.Errorf(codes.Unauthenticated, "signature timestamp %s is out of the accepted window", timestamp)
+	}
+
+	decodedSignature, err := hex.DecodeString(signature)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "invalid signature")
+	}
+
+	signed := payload(leaderboards, timestamp, nonce)
+	secrets := app.Config.GetStringMapString("api.signature.secrets")
+	for _, leaderboard := range leaderboards {
+		secret, ok := secrets[strings.ToLower(games[leaderboard])]
+		if !ok {
+			return status.Errorf(codes.Unauthenticated, "no secret for game %s of leaderboard %s", games[leaderboard], leaderboard)
+		}
+
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(signed))
+		if !hmac.Equal(decodedSignature, mac.Sum(nil)) {
+			return status.Errorf(codes.Unauthenticated, "invalid signature")
+		}
+	}
+
+	for _, leaderboard := range leaderboards {
+		err = app.Leaderboards.UseNonce(ctx, leaderboard, nonce, 2*maxClockSkew)
+		if err != nil {
+			if _, ok := err.(*service.NonceAlreadyUsedError); ok {
+				return status.Errorf(codes.Unauthenticated, err.Error())
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scoreChangeOriginMiddleware sets the reason metadata and the caller of the request as the origin of
+// score changes recorded in the ledger
+func (app *App) scoreChangeOriginMiddleware(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = service.WithScoreChangeOrigin(ctx, getMetadataValue(md, reasonMetadataKey), app.getCaller(ctx))
+
+	return handler(ctx, req)
+}
+
+// streamScoreChangeOriginMiddleware sets the origin of scoreChangeOriginMiddleware in the context of streams
+func (app *App) streamScoreChangeOriginMiddleware(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := stream.Context()
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	wrapped := grpc_middleware.WrapServerStream(stream)
+	wrapped.WrappedContext = service.WithScoreChangeOrigin(ctx, getMetadataValue(md, reasonMetadataKey), app.getCaller(ctx))
+	return handler(srv, wrapped)
+}
+
+// headerMatcher forwards podium headers of HTTP requests to gRPC metadata
+func headerMatcher(key string) (string, bool) {
+	if strings.HasPrefix(strings.ToLower(key), "x-podium-") {
+		return strings.ToLower(key), true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 func (app *App) loggerMiddleware(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	l := app.Logger.With(
 		zap.String("source", "request"),
@@ -94,6 +521,31 @@ func (app *App) loggerMiddleware(ctx context.Context, req interface{}, info *grp
 	return h, err
 }
 
+// streamLoggerMiddleware logs streams when they end, with how long they lasted
+func (app *App) streamLoggerMiddleware(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	startTime := time.Now()
+
+	err := handler(srv, stream)
+
+	endTime := time.Now()
+	_, statusCode := app.getStatusCodeFromError(err)
+	reqLog := app.Logger.With(
+		zap.String("source", "request"),
+		zap.String("method", info.FullMethod),
+		zap.Time("endTime", endTime),
+		zap.Int("statusCode", statusCode),
+		zap.Duration("duration", endTime.Sub(startTime)),
+	)
+
+	if statusCode > 399 {
+		log.D(reqLog, "Stream failed.")
+		return err
+	}
+
+	log.D(reqLog, "Stream ended.")
+	return err
+}
+
 //Serve executes on error handler when errors happen
 func (app *App) recoveryMiddleware(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	defer func() {
@@ -108,6 +560,19 @@ func (app *App) recoveryMiddleware(ctx context.Context, req interface{}, info *g
 	return handler(ctx, req)
 }
 
+func (app *App) streamRecoveryMiddleware(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	defer func() {
+		if err := recover(); err != nil {
+			eError, ok := err.(error)
+			if !ok {
+				eError = fmt.Errorf(fmt.Sprintf("%v", err))
+			}
+			app.OnErrorHandler(eError, debug.Stack())
+		}
+	}()
+	return handler(srv, stream)
+}
+
 func (app *App) responseTimeMetricsMiddleware(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	startTime := time.Now()
 	h, err := handler(ctx, req)
@@ -0,0 +1,63 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/topfreegames/podium/leaderboard/v2/milestones"
+	"go.uber.org/zap"
+)
+
+// createMilestoneNotifier create the buffered notifier delivering milestones to the webhook configured in
+// milestones.webhook.url, or nil if it is empty
+func (app *App) createMilestoneNotifier() (*milestones.BufferedNotifier, error) {
+	url := app.Config.GetString("milestones.webhook.url")
+	if url == "" {
+		return nil, nil
+	}
+
+	secret := app.Config.GetString("milestones.webhook.secret")
+	if secret == "" {
+		return nil, fmt.Errorf("milestones.webhook.secret is required to sign milestones")
+	}
+
+	l := app.Logger.With(
+		zap.String("source", "app"),
+		zap.String("operation", "createMilestoneNotifier"),
+	)
+
+	notifier := milestones.NewWebhookNotifier(
+		url,
+		secret,
+		app.Config.GetDuration("milestones.webhook.timeout"),
+		app.Config.GetInt("milestones.webhook.maxRetries"),
+		app.Config.GetDuration("milestones.webhook.retryBackoff"),
+	)
+
+	bufferedNotifier := milestones.NewBufferedNotifier(
+		notifier,
+		app.Config.GetInt("milestones.bufferSize"),
+		app.Config.GetInt("milestones.batchSize"),
+		app.Config.GetDuration("milestones.retryInterval"),
+		func(err error) {
+			l.Error("Failed to deliver milestones.", zap.Error(err))
+		},
+	)
+
+	l.Info("Created milestone notifier.")
+	return bufferedNotifier, nil
+}
+
+// closeMilestoneNotifier wait up to milestones.flushTimeout for buffered milestones to be delivered
+func (app *App) closeMilestoneNotifier() {
+	if app.milestoneNotifier == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), app.Config.GetDuration("milestones.flushTimeout"))
+	defer cancel()
+
+	if err := app.milestoneNotifier.Close(ctx); err != nil {
+		app.Logger.Error("Milestones were dropped on shutdown.", zap.Error(err))
+	}
+}
@@ -0,0 +1,79 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type cachedSignatureGame struct {
+	game      string
+	expiresAt time.Time
+}
+
+// signatureGames caches the signature game of leaderboards for ttl, so signed writes don't read the
+// leaderboard settings on every request
+type signatureGames struct {
+	mutex     sync.Mutex
+	ttl       time.Duration
+	games     map[string]*cachedSignatureGame
+	lastSweep time.Time
+}
+
+func newSignatureGames(ttl time.Duration) *signatureGames {
+	return &signatureGames{
+		ttl:       ttl,
+		games:     map[string]*cachedSignatureGame{},
+		lastSweep: time.Now(),
+	}
+}
+
+// get return the signature game of leaderboard, calling load if it is not cached or expired
+func (s *signatureGames) get(ctx context.Context, leaderboard string, load func(context.Context, string) (string, error)) (string, error) {
+	if s.ttl <= 0 {
+		return load(ctx, leaderboard)
+	}
+
+	now := time.Now()
+	s.mutex.Lock()
+	cached, ok := s.games[leaderboard]
+	s.mutex.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.game, nil
+	}
+
+	game, err := load(ctx, leaderboard)
+	if err != nil {
+		return "", err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if now.Sub(s.lastSweep) > s.ttl {
+		for key, cached := range s.games {
+			if !now.Before(cached.expiresAt) {
+				delete(s.games, key)
+			}
+		}
+		s.lastSweep = now
+	}
+	s.games[leaderboard] = &cachedSignatureGame{game: game, expiresAt: now.Add(s.ttl)}
+
+	return game, nil
+}
+
+// invalidate drops the cached signature game of leaderboard
+func (s *signatureGames) invalidate(leaderboard string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.games, leaderboard)
+}
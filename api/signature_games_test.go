@@ -0,0 +1,45 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSignatureGamesCachesSettings(t *testing.T) {
+	loads := 0
+	game := "game1"
+	load := func(ctx context.Context, leaderboard string) (string, error) {
+		loads++
+		return game, nil
+	}
+
+	games := newSignatureGames(20 * time.Millisecond)
+	for i := 0; i < 3; i++ {
+		got, err := games.get(context.Background(), "leaderboard", load)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != "game1" {
+			t.Fatalf("got game %q, want game1", got)
+		}
+	}
+	if loads != 1 {
+		t.Fatalf("settings were loaded %d times, want 1", loads)
+	}
+
+	game = "game2"
+	games.invalidate("leaderboard")
+	if got, _ := games.get(context.Background(), "leaderboard", load); got != "game2" {
+		t.Fatalf("got game %q after invalidate, want game2", got)
+	}
+
+	game = "game3"
+	time.Sleep(30 * time.Millisecond)
+	if got, _ := games.get(context.Background(), "leaderboard", load); got != "game3" {
+		t.Fatalf("got game %q after ttl, want game3", got)
+	}
+	if loads != 3 {
+		t.Fatalf("settings were loaded %d times, want 3", loads)
+	}
+}
@@ -0,0 +1,215 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api
+
+import (
+	"context"
+
+	lmodel "github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "github.com/topfreegames/podium/proto/podium/api/v1"
+)
+
+func newTournamentResponse(tournament *lmodel.Tournament) *api.Tournament {
+	prizes := make([]*api.TournamentPrize, 0, len(tournament.Prizes))
+	for _, prize := range tournament.Prizes {
+		prizes = append(prizes, &api.TournamentPrize{
+			FromRank: int32(prize.FromRank),
+			ToRank:   int32(prize.ToRank),
+			RewardID: prize.RewardID,
+		})
+	}
+
+	return &api.Tournament{
+		TournamentID: tournament.ID,
+		StartAt:      tournament.StartAt,
+		EndAt:        tournament.EndAt,
+		Order:        tournament.Order,
+		Prizes:       prizes,
+		Status:       tournament.Status,
+		FinalizedAt:  tournament.FinalizedAt,
+	}
+}
+
+func newTournamentWinnersResponse(winners []*lmodel.TournamentWinner) []*api.TournamentWinner {
+	response := make([]*api.TournamentWinner, 0, len(winners))
+	for _, winner := range winners {
+		response = append(response, &api.TournamentWinner{
+			PublicID: winner.PublicID,
+			Score:    float64(winner.Score),
+			Rank:     int32(winner.Rank),
+			RewardID: winner.RewardID,
+		})
+	}
+	return response
+}
+
+func tournamentErrorStatus(err error) error {
+	switch err.(type) {
+	case *service.InvalidTournamentError:
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	case *service.TournamentAlreadyExistsError:
+		return status.Errorf(codes.AlreadyExists, err.Error())
+	case *service.TournamentNotFoundError:
+		return status.Errorf(codes.NotFound, err.Error())
+	case *service.TournamentNotEndedError, *service.LeaderboardClosedError:
+		return status.Errorf(codes.FailedPrecondition, err.Error())
+	}
+	return err
+}
+
+// CreateTournament is the handler responsible for creating a tournament.
+func (app *App) CreateTournament(ctx context.Context, req *api.CreateTournamentRequest) (*api.TournamentResponse, error) {
+	if req.Tournament == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "tournament is required")
+	}
+
+	lg := app.Logger.With(
+		zap.String("handler", "CreateTournament"),
+		zap.String("tournament", req.TournamentId),
+	)
+
+	prizes := make([]*lmodel.TournamentPrize, 0, len(req.Tournament.Prizes))
+	for _, prize := range req.Tournament.Prizes {
+		prizes = append(prizes, &lmodel.TournamentPrize{
+			FromRank: int(prize.FromRank),
+			ToRank:   int(prize.ToRank),
+			RewardID: prize.RewardID,
+		})
+	}
+
+	var tournament *lmodel.Tournament
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Creating tournament.")
+		tournament, err = app.Leaderboards.CreateTournament(ctx, &lmodel.Tournament{
+			ID:      req.TournamentId,
+			StartAt: req.Tournament.StartAt,
+			EndAt:   req.Tournament.EndAt,
+			Order:   req.Tournament.Order,
+			Prizes:  prizes,
+		})
+
+		if err != nil {
+			lg.Error("Create tournament failed.", zap.Error(err))
+			app.AddError()
+			return tournamentErrorStatus(err)
+		}
+		lg.Debug("Create tournament succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.TournamentResponse{
+		Success:    true,
+		Tournament: newTournamentResponse(tournament),
+	}, nil
+}
+
+// GetTournament is the handler responsible for retrieving a tournament.
+func (app *App) GetTournament(ctx context.Context, req *api.GetTournamentRequest) (*api.TournamentResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "GetTournament"),
+		zap.String("tournament", req.TournamentId),
+	)
+
+	var tournament *lmodel.Tournament
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Getting tournament.")
+		tournament, err = app.Leaderboards.GetTournament(ctx, req.TournamentId)
+
+		if err != nil {
+			lg.Error("Getting tournament failed.", zap.Error(err))
+			app.AddError()
+			return tournamentErrorStatus(err)
+		}
+		lg.Debug("Getting tournament succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.TournamentResponse{
+		Success:    true,
+		Tournament: newTournamentResponse(tournament),
+	}, nil
+}
+
+// JoinTournament is the handler responsible for adding a member to the tournament participants.
+func (app *App) JoinTournament(ctx context.Context, req *api.JoinTournamentRequest) (*api.TournamentResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "JoinTournament"),
+		zap.String("tournament", req.TournamentId),
+		zap.String("memberPublicID", req.MemberPublicId),
+	)
+
+	var tournament *lmodel.Tournament
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Joining tournament.")
+		tournament, err = app.Leaderboards.JoinTournament(ctx, req.TournamentId, req.MemberPublicId)
+
+		if err != nil {
+			lg.Error("Join tournament failed.", zap.Error(err))
+			app.AddError()
+			return tournamentErrorStatus(err)
+		}
+		lg.Debug("Join tournament succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.TournamentResponse{
+		Success:    true,
+		Tournament: newTournamentResponse(tournament),
+	}, nil
+}
+
+// FinalizeTournament is the handler responsible for freezing an ended tournament and resolving its winners.
+func (app *App) FinalizeTournament(ctx context.Context, req *api.FinalizeTournamentRequest) (*api.FinalizeTournamentResponse, error) {
+	lg := app.Logger.With(
+		zap.String("handler", "FinalizeTournament"),
+		zap.String("tournament", req.TournamentId),
+	)
+
+	var result *lmodel.TournamentResult
+	err := withSegment("Model", ctx, func() error {
+		var err error
+		lg.Debug("Finalizing tournament.")
+		result, err = app.Leaderboards.FinalizeTournament(ctx, req.TournamentId)
+
+		if err != nil {
+			lg.Error("Finalize tournament failed.", zap.Error(err))
+			app.AddError()
+			return tournamentErrorStatus(err)
+		}
+		lg.Debug("Finalize tournament succeeded.")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.FinalizeTournamentResponse{
+		Success:    true,
+		Tournament: newTournamentResponse(result.Tournament),
+		Winners:    newTournamentWinnersResponse(result.Winners),
+	}, nil
+}
@@ -0,0 +1,177 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/topfreegames/podium/api"
+	"github.com/topfreegames/podium/testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	uuid "github.com/satori/go.uuid"
+	pb "github.com/topfreegames/podium/proto/podium/api/v1"
+)
+
+var _ = Describe("Tournament Handler", func() {
+	var app *api.App
+
+	BeforeEach(func() {
+		app = testing.GetDefaultTestApp()
+		testing.InitializeTestServer(app)
+	})
+
+	createTournament := func(tournamentID string, startAt, endAt int64) {
+		payload := map[string]interface{}{
+			"startAt": startAt,
+			"endAt":   endAt,
+			"prizes": []map[string]interface{}{
+				{"fromRank": 1, "toRank": 1, "rewardID": "gold"},
+				{"fromRank": 2, "toRank": 3, "rewardID": "silver"},
+			},
+		}
+		status, body := testing.PostJSON(app, fmt.Sprintf("/tournaments/%s", tournamentID), payload)
+		Expect(status).To(Equal(http.StatusOK), body)
+	}
+
+	It("should create and get a tournament (http)", func() {
+		tournamentID := uuid.NewV4().String()
+		now := time.Now().Unix()
+		createTournament(tournamentID, now+3600, now+7200)
+
+		status, body := testing.Get(app, fmt.Sprintf("/tournaments/%s", tournamentID))
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		var result map[string]interface{}
+		json.Unmarshal([]byte(body), &result)
+		Expect(result["success"]).To(BeTrue())
+		tournament := result["tournament"].(map[string]interface{})
+		Expect(tournament["tournamentID"]).To(Equal(tournamentID))
+		Expect(tournament["startAt"]).To(Equal(fmt.Sprint(now + 3600)))
+		Expect(tournament["status"]).To(Equal("scheduled"))
+		Expect(tournament["order"]).To(Equal("desc"))
+		Expect(tournament["prizes"]).To(HaveLen(2))
+	})
+
+	It("should fail to create a tournament twice", func() {
+		tournamentID := uuid.NewV4().String()
+		now := time.Now().Unix()
+		createTournament(tournamentID, now, now+3600)
+
+		payload := map[string]interface{}{"startAt": now, "endAt": now + 3600}
+		status, body := testing.PostJSON(app, fmt.Sprintf("/tournaments/%s", tournamentID), payload)
+		Expect(status).To(Equal(http.StatusConflict), body)
+	})
+
+	It("should fail to create a tournament with invalid configuration", func() {
+		payload := map[string]interface{}{"startAt": 1600003600, "endAt": 1600000000}
+		status, body := testing.PostJSON(app, fmt.Sprintf("/tournaments/%s", uuid.NewV4().String()), payload)
+		Expect(status).To(Equal(http.StatusBadRequest), body)
+
+		var result map[string]interface{}
+		json.Unmarshal([]byte(body), &result)
+		Expect(result["success"]).To(BeFalse())
+		Expect(result["reason"]).To(Equal("invalid tournament: endAt 1600000000 must be greater than startAt 1600003600"))
+	})
+
+	It("should return not found if tournament does not exist", func() {
+		status, body := testing.Get(app, fmt.Sprintf("/tournaments/%s", uuid.NewV4().String()))
+		Expect(status).To(Equal(http.StatusNotFound), body)
+	})
+
+	It("should reject scores of members that did not join (http)", func() {
+		tournamentID := uuid.NewV4().String()
+		now := time.Now().Unix()
+		createTournament(tournamentID, now-60, now+3600)
+
+		status, body := testing.PutJSON(app, fmt.Sprintf("/l/%s/members/member1/score", tournamentID), map[string]interface{}{"score": 10})
+		Expect(status).To(Equal(http.StatusPreconditionFailed), body)
+
+		var result map[string]interface{}
+		json.Unmarshal([]byte(body), &result)
+		Expect(result["reason"]).To(Equal(fmt.Sprintf("member member1 did not join leaderboard %s", tournamentID)))
+
+		status, body = testing.Post(app, fmt.Sprintf("/tournaments/%s/members/member1", tournamentID), "")
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		status, body = testing.PutJSON(app, fmt.Sprintf("/l/%s/members/member1/score", tournamentID), map[string]interface{}{"score": 10})
+		Expect(status).To(Equal(http.StatusOK), body)
+	})
+
+	It("should reject scores before tournament starts", func() {
+		tournamentID := uuid.NewV4().String()
+		now := time.Now().Unix()
+		createTournament(tournamentID, now+3600, now+7200)
+
+		status, body := testing.Post(app, fmt.Sprintf("/tournaments/%s/members/member1", tournamentID), "")
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		status, body = testing.PutJSON(app, fmt.Sprintf("/l/%s/members/member1/score", tournamentID), map[string]interface{}{"score": 10})
+		Expect(status).To(Equal(http.StatusPreconditionFailed), body)
+	})
+
+	It("should fail to finalize a running tournament", func() {
+		tournamentID := uuid.NewV4().String()
+		now := time.Now().Unix()
+		createTournament(tournamentID, now-60, now+3600)
+
+		status, body := testing.Post(app, fmt.Sprintf("/tournaments/%s/finalize", tournamentID), "")
+		Expect(status).To(Equal(http.StatusPreconditionFailed), body)
+	})
+
+	It("should finalize an ended tournament resolving its winners (grpc)", func() {
+		testing.SetupGRPC(app, func(cli pb.PodiumClient) {
+			tournamentID := uuid.NewV4().String()
+			now := time.Now().Unix()
+			createTournament(tournamentID, now-60, now+1)
+
+			for i, member := range []string{"member1", "member2", "member3", "member4"} {
+				_, err := cli.JoinTournament(context.Background(), &pb.JoinTournamentRequest{
+					TournamentId:   tournamentID,
+					MemberPublicId: member,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				_, err = app.Leaderboards.SetMemberScore(context.Background(), tournamentID, member, int64(100-i), false, "", nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			time.Sleep(time.Until(time.Unix(now+1, 0)))
+
+			resp, err := cli.FinalizeTournament(context.Background(), &pb.FinalizeTournamentRequest{TournamentId: tournamentID})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Success).To(BeTrue())
+			Expect(resp.Tournament.Status).To(Equal("finalized"))
+			Expect(resp.Winners).To(HaveLen(3))
+			Expect(resp.Winners[0].PublicID).To(Equal("member1"))
+			Expect(resp.Winners[0].RewardID).To(Equal("gold"))
+			Expect(resp.Winners[2].PublicID).To(Equal("member3"))
+			Expect(resp.Winners[2].Rank).To(Equal(int32(3)))
+			Expect(resp.Winners[2].RewardID).To(Equal("silver"))
+
+			settings, err := cli.GetLeaderboardSettings(context.Background(), &pb.GetLeaderboardSettingsRequest{LeaderboardId: tournamentID})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(settings.Settings.Frozen).To(BeTrue())
+		})
+	})
+
+	It("Should fail if error in Redis", func() {
+		faultyRedisApp := testing.GetDefaultTestAppWithFaultyRedis()
+
+		status, body := testing.Get(faultyRedisApp, fmt.Sprintf("/tournaments/%s", uuid.NewV4().String()))
+		Expect(status).To(Equal(500), body)
+		Expect(body).To(ContainSubstring("connection refused"))
+	})
+})
@@ -125,7 +125,7 @@ func generateNMembers(amount int) string {
 	lbID := "leaderboard-0"
 
 	for i := 0; i < amount; i++ {
-		client.SetMemberScore(context.Background(), lbID, fmt.Sprintf("bench-member-%d", i), int64(100+i), false, "inf")
+		client.SetMemberScore(context.Background(), lbID, fmt.Sprintf("bench-member-%d", i), int64(100+i), false, "inf", nil, nil)
 	}
 
 	return lbID
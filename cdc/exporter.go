@@ -0,0 +1,183 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+// Package cdc exports the score events published to a redis stream to files for data warehouses.
+//
+// Events are read in batches from the stream and written to files partitioned by the hour they happened,
+// <prefix>dt=YYYY-MM-DD/hour=HH/<first stream ID>_<last stream ID>.<format>, in each configured format.
+// After all files of a batch are written, the ID of its last stream entry is saved to the checkpoint file,
+// _checkpoint.json, where the next batch starts, so a restarted exporter resumes after the last batch it
+// finished. A batch interrupted before its checkpoint is exported again, so events can be exported more
+// than once and must be deduplicated by their ID. When the checkpoint entry was trimmed from the stream,
+// events after it may have been trimmed too, and the exporter fails instead of skipping them.
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/events"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// CheckpointKey is the key of the checkpoint file in the target
+const CheckpointKey string = "_checkpoint.json"
+
+// Source reads events in the order they were published, events.RedisStreamReader implements it
+type Source interface {
+	Read(ctx context.Context, afterID string, count int) ([]*events.StreamEvent, error)
+}
+
+// Checkpoint is the position of the exporter in the stream
+type Checkpoint struct {
+	// StreamID is the ID of the last stream entry exported
+	StreamID  string `json:"streamID"`
+	UpdatedAt int64  `json:"updatedAt"`
+}
+
+// BatchResult is the result of exporting a batch of events
+type BatchResult struct {
+	Events   int
+	Files    []string
+	StreamID string
+}
+
+func (r *BatchResult) String() string {
+	return fmt.Sprintf("(Events: %d, Files: %v, StreamID: %s)", r.Events, r.Files, r.StreamID)
+}
+
+// Exporter exports batches of up to BatchSize events read from Source to files of Target in Formats
+type Exporter struct {
+	Source    Source
+	Target    Target
+	Formats   []Format
+	BatchSize int
+
+	checkpoint *Checkpoint
+}
+
+// NewExporter create a new Exporter
+func NewExporter(source Source, target Target, formats []Format, batchSize int) *Exporter {
+	return &Exporter{
+		Source:    source,
+		Target:    target,
+		Formats:   formats,
+		BatchSize: batchSize,
+	}
+}
+
+// Checkpoint return the checkpoint of the exporter, loading it from the target the first time
+func (e *Exporter) Checkpoint(ctx context.Context) (*Checkpoint, error) {
+	if e.checkpoint != nil {
+		return e.checkpoint, nil
+	}
+
+	data, err := e.Target.Get(ctx, CheckpointKey)
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint := &Checkpoint{}
+	if data != nil {
+		err = json.Unmarshal(data, checkpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid checkpoint: %s", err.Error())
+		}
+	}
+
+	e.checkpoint = checkpoint
+	return checkpoint, nil
+}
+
+// ExportBatch export the next batch of events after the checkpoint and move the checkpoint to its last
+// event, returning nil if there are no new events
+func (e *Exporter) ExportBatch(ctx context.Context) (*BatchResult, error) {
+	checkpoint, err := e.Checkpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	streamEvents, err := e.Source.Read(ctx, checkpoint.StreamID, e.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	if len(streamEvents) == 0 {
+		return nil, nil
+	}
+
+	firstID := streamEvents[0].StreamID
+	lastID := streamEvents[len(streamEvents)-1].StreamID
+
+	partitions := map[string][]*model.ScoreEvent{}
+	for _, streamEvent := range streamEvents {
+		partition := partitionOf(streamEvent.Event)
+		partitions[partition] = append(partitions[partition], streamEvent.Event)
+	}
+
+	result := &BatchResult{
+		Events:   len(streamEvents),
+		Files:    []string{},
+		StreamID: lastID,
+	}
+	for _, partition := range sortedPartitions(partitions) {
+		for _, format := range e.Formats {
+			data, err := format.Encode(partitions[partition])
+			if err != nil {
+				return nil, err
+			}
+
+			key := fmt.Sprintf("%s/%s_%s.%s", partition, firstID, lastID, format.Extension())
+			err = e.Target.Put(ctx, key, data)
+			if err != nil {
+				return nil, err
+			}
+			result.Files = append(result.Files, key)
+		}
+	}
+
+	err = e.saveCheckpoint(ctx, &Checkpoint{StreamID: lastID, UpdatedAt: time.Now().Unix()})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (e *Exporter) saveCheckpoint(ctx context.Context, checkpoint *Checkpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	err = e.Target.Put(ctx, CheckpointKey, data)
+	if err != nil {
+		return err
+	}
+
+	e.checkpoint = checkpoint
+	return nil
+}
+
+// partitionOf return the partition of the hour event happened, in UTC
+func partitionOf(event *model.ScoreEvent) string {
+	changedAt := time.Unix(event.ChangedAt, 0).UTC()
+	return fmt.Sprintf("dt=%s/hour=%02d", changedAt.Format("2006-01-02"), changedAt.Hour())
+}
+
+func sortedPartitions(partitions map[string][]*model.ScoreEvent) []string {
+	names := make([]string, 0, len(partitions))
+	for name := range partitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
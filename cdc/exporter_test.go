@@ -0,0 +1,130 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package cdc_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/topfreegames/podium/cdc"
+	"github.com/topfreegames/podium/leaderboard/v2/events"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/reader"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// sliceSource is a cdc.Source reading events from a slice, with stream IDs being their positions
+type sliceSource struct {
+	events []*model.ScoreEvent
+}
+
+func (s *sliceSource) Read(ctx context.Context, afterID string, count int) ([]*events.StreamEvent, error) {
+	start := 0
+	if afterID != "" {
+		fmt.Sscanf(afterID, "%d-0", &start)
+	}
+
+	streamEvents := []*events.StreamEvent{}
+	for i := start; i < len(s.events) && len(streamEvents) < count; i++ {
+		streamEvents = append(streamEvents, &events.StreamEvent{StreamID: fmt.Sprintf("%d-0", i+1), Event: s.events[i]})
+	}
+	return streamEvents, nil
+}
+
+var _ = Describe("Exporter", func() {
+	var dir string
+	var target *cdc.LocalTarget
+	var source *sliceSource
+
+	changedAt := time.Date(2021, 6, 1, 13, 30, 0, 0, time.UTC).Unix()
+	newScore := int64(100)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "podium-cdc")
+		Expect(err).NotTo(HaveOccurred())
+
+		target = cdc.NewLocalTarget(dir)
+		source = &sliceSource{events: []*model.ScoreEvent{
+			{ID: "event1", Leaderboard: "lb", PublicID: "member1", NewScore: &newScore, OldRank: -1, NewRank: 1, ChangedAt: changedAt},
+			{ID: "event2", Leaderboard: "lb", PublicID: "member2", OldScore: &newScore, OldRank: 2, NewRank: -1, ChangedAt: changedAt + 3600},
+			{ID: "event3", Leaderboard: "lb", PublicID: "member3", NewScore: &newScore, OldRank: -1, NewRank: 1, ChangedAt: changedAt + 3600},
+		}}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("should write batches partitioned by hour in each format", func() {
+		exporter := cdc.NewExporter(source, target, []cdc.Format{&cdc.JSONLinesFormat{}, &cdc.ParquetFormat{}}, 2)
+
+		result, err := exporter.ExportBatch(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Events).To(Equal(2))
+		Expect(result.StreamID).To(Equal("2-0"))
+		Expect(result.Files).To(Equal([]string{
+			"dt=2021-06-01/hour=13/1-0_2-0.jsonl",
+			"dt=2021-06-01/hour=13/1-0_2-0.parquet",
+			"dt=2021-06-01/hour=14/1-0_2-0.jsonl",
+			"dt=2021-06-01/hour=14/1-0_2-0.parquet",
+		}))
+
+		data, err := target.Get(context.Background(), "dt=2021-06-01/hour=14/1-0_2-0.jsonl")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(Equal(`{"id":"event2","leaderboard":"lb","publicID":"member2","oldScore":100,"newScore":null,"oldRank":2,"newRank":-1,"reason":"","caller":"","changedAt":1622557800}` + "\n"))
+
+		data, err = target.Get(context.Background(), "dt=2021-06-01/hour=14/1-0_2-0.parquet")
+		Expect(err).NotTo(HaveOccurred())
+		file, err := buffer.NewBufferFile(data)
+		Expect(err).NotTo(HaveOccurred())
+		parquetReader, err := reader.NewParquetReader(file, new(cdc.ParquetEvent), 1)
+		Expect(err).NotTo(HaveOccurred())
+		rows := make([]cdc.ParquetEvent, parquetReader.GetNumRows())
+		Expect(parquetReader.Read(&rows)).To(Succeed())
+		parquetReader.ReadStop()
+		Expect(rows).To(HaveLen(1))
+		Expect(rows[0].ID).To(Equal("event2"))
+		Expect(*rows[0].OldScore).To(Equal(int64(100)))
+		Expect(rows[0].NewScore).To(BeNil())
+		Expect(rows[0].NewRank).To(Equal(int64(-1)))
+	})
+
+	It("should resume after the checkpoint", func() {
+		exporter := cdc.NewExporter(source, target, []cdc.Format{&cdc.JSONLinesFormat{}}, 2)
+		_, err := exporter.ExportBatch(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+
+		exporter = cdc.NewExporter(source, target, []cdc.Format{&cdc.JSONLinesFormat{}}, 2)
+		checkpoint, err := exporter.Checkpoint(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checkpoint.StreamID).To(Equal("2-0"))
+
+		result, err := exporter.ExportBatch(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Events).To(Equal(1))
+		Expect(result.Files).To(Equal([]string{"dt=2021-06-01/hour=14/3-0_3-0.jsonl"}))
+
+		result, err = exporter.ExportBatch(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(BeNil())
+	})
+
+	It("should fail with invalid format", func() {
+		_, err := cdc.GetFormat("csv")
+		Expect(err).To(MatchError("invalid format csv, it must be jsonl or parquet"))
+	})
+})
@@ -0,0 +1,117 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package cdc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// Format encodes a batch of events into the content of a file
+type Format interface {
+	// Extension is the extension of the files, without the dot
+	Extension() string
+	Encode(events []*model.ScoreEvent) ([]byte, error)
+}
+
+// GetFormat return the format named name, jsonl or parquet
+func GetFormat(name string) (Format, error) {
+	switch name {
+	case "jsonl":
+		return &JSONLinesFormat{}, nil
+	case "parquet":
+		return &ParquetFormat{}, nil
+	default:
+		return nil, fmt.Errorf("invalid format %s, it must be jsonl or parquet", name)
+	}
+}
+
+// JSONLinesFormat encodes events as JSON lines, like events.FileSink
+type JSONLinesFormat struct{}
+
+// Extension is jsonl
+func (f *JSONLinesFormat) Extension() string {
+	return "jsonl"
+}
+
+// Encode encode each event as a JSON line
+func (f *JSONLinesFormat) Encode(events []*model.ScoreEvent) ([]byte, error) {
+	var lines bytes.Buffer
+	encoder := json.NewEncoder(&lines)
+	for _, event := range events {
+		err := encoder.Encode(event)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return lines.Bytes(), nil
+}
+
+// ParquetFormat encodes events as a snappy compressed Parquet file with the columns of ParquetEvent
+type ParquetFormat struct{}
+
+// ParquetEvent is the row of a score event in Parquet files, with columns named as the JSON fields
+type ParquetEvent struct {
+	ID          string `parquet:"name=id, type=UTF8, encoding=PLAIN"`
+	Leaderboard string `parquet:"name=leaderboard, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	PublicID    string `parquet:"name=publicID, type=UTF8, encoding=PLAIN"`
+	OldScore    *int64 `parquet:"name=oldScore, type=INT64, repetitiontype=OPTIONAL"`
+	NewScore    *int64 `parquet:"name=newScore, type=INT64, repetitiontype=OPTIONAL"`
+	OldRank     int64  `parquet:"name=oldRank, type=INT64"`
+	NewRank     int64  `parquet:"name=newRank, type=INT64"`
+	Reason      string `parquet:"name=reason, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	Caller      string `parquet:"name=caller, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	ChangedAt   int64  `parquet:"name=changedAt, type=INT64"`
+}
+
+// Extension is parquet
+func (f *ParquetFormat) Extension() string {
+	return "parquet"
+}
+
+// Encode write events as the rows of a single row group
+func (f *ParquetFormat) Encode(events []*model.ScoreEvent) ([]byte, error) {
+	var file bytes.Buffer
+	parquetWriter, err := writer.NewParquetWriterFromWriter(&file, new(ParquetEvent), 1)
+	if err != nil {
+		return nil, err
+	}
+	parquetWriter.CompressionType = parquet.CompressionCodec_SNAPPY
+
+	for _, event := range events {
+		err = parquetWriter.Write(ParquetEvent{
+			ID:          event.ID,
+			Leaderboard: event.Leaderboard,
+			PublicID:    event.PublicID,
+			OldScore:    event.OldScore,
+			NewScore:    event.NewScore,
+			OldRank:     int64(event.OldRank),
+			NewRank:     int64(event.NewRank),
+			Reason:      event.Reason,
+			Caller:      event.Caller,
+			ChangedAt:   event.ChangedAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = parquetWriter.WriteStop()
+	if err != nil {
+		return nil, err
+	}
+
+	return file.Bytes(), nil
+}
@@ -0,0 +1,84 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package cdc
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// S3Target stores files in a bucket of S3 or any storage compatible with its API, like MinIO, with keys
+// prefixed by Prefix. Objects are addressed in path style, <endpoint>/<Bucket>/<Prefix><key>
+type S3Target struct {
+	Bucket string
+	Prefix string
+	Client *s3.S3
+}
+
+// NewS3Target create a new S3Target whose requests timeout after timeout. Requests are signed with accessKey
+// and secretKey, or with the credentials of the AWS environment when they are empty
+func NewS3Target(endpoint, bucket, prefix, region, accessKey, secretKey string, timeout time.Duration) (*S3Target, error) {
+	config := &aws.Config{
+		Endpoint:         aws.String(endpoint),
+		Region:           aws.String(region),
+		S3ForcePathStyle: aws.Bool(true),
+		HTTPClient:       &http.Client{Timeout: timeout},
+	}
+	if accessKey != "" || secretKey != "" {
+		config.Credentials = credentials.NewStaticCredentials(accessKey, secretKey, "")
+	}
+
+	awsSession, err := session.NewSession(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &S3Target{
+		Bucket: bucket,
+		Prefix: prefix,
+		Client: s3.New(awsSession),
+	}, nil
+}
+
+// Get return the content of the object in key, nil if it does not exist
+func (s *S3Target) Get(ctx context.Context, key string) ([]byte, error) {
+	output, err := s.Client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.Bucket),
+		Key:    aws.String(s.Prefix + key),
+	})
+	if err != nil {
+		if requestErr, ok := err.(awserr.RequestFailure); ok && requestErr.StatusCode() == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer output.Body.Close()
+
+	return ioutil.ReadAll(output.Body)
+}
+
+// Put upload data to the object in key
+func (s *S3Target) Put(ctx context.Context, key string, data []byte) error {
+	_, err := s.Client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.Bucket),
+		Key:    aws.String(s.Prefix + key),
+		Body:   bytes.NewReader(data),
+	})
+	return err
+}
@@ -0,0 +1,59 @@
+package cdc
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestS3Target(t *testing.T) {
+	objects := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := ioutil.ReadAll(r.Body)
+			objects[r.URL.EscapedPath()] = string(body)
+		case http.MethodGet:
+			object, ok := objects[r.URL.EscapedPath()]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte(object))
+		}
+	}))
+	defer server.Close()
+
+	target, err := NewS3Target(server.URL, "bucket", "podium/", "us-east-1", "access", "secret", time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := target.Get(context.Background(), "dt=2021-06-01/hour=13/events.jsonl")
+	if err != nil || data != nil {
+		t.Fatalf("expected missing object, got %q and error %v", data, err)
+	}
+
+	err = target.Put(context.Background(), "dt=2021-06-01/hour=13/events.jsonl", []byte("events"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := objects["/bucket/podium/dt%3D2021-06-01/hour%3D13/events.jsonl"]; !ok {
+		t.Errorf("expected object to be put in escaped path, got %v", objects)
+	}
+
+	data, err = target.Get(context.Background(), "dt=2021-06-01/hour=13/events.jsonl")
+	if err != nil || string(data) != "events" {
+		t.Errorf("expected object events, got %q and error %v", data, err)
+	}
+}
@@ -0,0 +1,76 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package cdc
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// Target stores the files written by the exporter, with keys being slash separated paths
+type Target interface {
+	// Get return the content of the file in key, nil if it does not exist
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Put replace the content of the file in key with data, so a reader never sees it partially written
+	Put(ctx context.Context, key string, data []byte) error
+}
+
+// LocalTarget stores files in a local directory
+type LocalTarget struct {
+	Dir string
+}
+
+// NewLocalTarget create a new LocalTarget storing files in dir
+func NewLocalTarget(dir string) *LocalTarget {
+	return &LocalTarget{Dir: dir}
+}
+
+// Get return the content of the file in key, nil if it does not exist
+func (l *LocalTarget) Get(ctx context.Context, key string) ([]byte, error) {
+	data, err := ioutil.ReadFile(l.path(key))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return data, err
+}
+
+// Put write data to a temporary file in the directory of key and rename it to key
+func (l *LocalTarget) Put(ctx context.Context, key string, data []byte) error {
+	path := l.path(key)
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	file, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	_, err = file.Write(data)
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
+func (l *LocalTarget) path(key string) string {
+	return filepath.Join(l.Dir, filepath.FromSlash(key))
+}
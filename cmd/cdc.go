@@ -0,0 +1,74 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/topfreegames/podium/cdc"
+	"github.com/topfreegames/podium/log"
+	"github.com/topfreegames/podium/worker"
+	"go.uber.org/zap"
+)
+
+// cdcCmd represents the cdc command
+var cdcCmd = &cobra.Command{
+	Use:   "cdc",
+	Short: "starts the podium score events exporter",
+	Long: `starts the podium change data capture worker, that tails the score events published to the redis stream
+and writes them in batches to files partitioned by hour, in a local directory or an S3 compatible bucket.
+	you can use environment variables to override configuration keys`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ll := zap.InfoLevel
+		if debug {
+			ll = zap.DebugLevel
+		}
+		if quiet {
+			ll = zap.WarnLevel
+		}
+		logger := log.CreateLoggerWithLevel(ll, log.LoggerOptions{WriteSyncer: os.Stdout})
+		logger = logger.With(
+			zap.String("source", "cdc"),
+		)
+
+		defer logger.Sync()
+
+		logger.Info("Starting podium score events exporter...")
+
+		w, err := worker.GetCDCWorker(ConfigFile)
+
+		if err != nil {
+			logger.Fatal("Could not get podium cdc worker.", zap.Error(err))
+		}
+
+		resultsChan := make(chan []*cdc.BatchResult)
+		errChan := make(chan error)
+
+		go func() {
+			for {
+				select {
+				case results := <-resultsChan:
+					logger.Info("exported batches", zap.Any("result", results))
+				case err := <-errChan:
+					logger.Error("error from cdc worker", zap.Error(err))
+				}
+			}
+		}()
+
+		w.Run(resultsChan, errChan)
+	},
+}
+
+func init() {
+	cdcCmd.Flags().BoolVarP(&debug, "debug", "d", false, "Debug mode (log=debug)")
+	cdcCmd.Flags().BoolVarP(&quiet, "quiet", "q", false, "Quiet mode (log=warn)")
+	RootCmd.AddCommand(cdcCmd)
+}
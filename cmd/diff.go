@@ -0,0 +1,78 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/topfreegames/podium/config"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	lservice "github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var diffFrom, diffTo int64
+var diffOrder string
+
+// diffCmd represents the diff command
+var diffCmd = &cobra.Command{
+	Use:   "diff LEADERBOARD",
+	Short: "diffs a leaderboard against a rank snapshot",
+	Long: `Prints as JSON lines the members that entered, changed and left a leaderboard between the newest
+rank snapshot taken until --from and the newest taken until --to, or the current leaderboard if --to is not set.
+It reads the leaderboard straight from the redis configured, so the diff is not limited by api.maxReturnedMembers.
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		leaderboards, err := getLeaderboardService(ConfigFile)
+		if err != nil {
+			log.Fatalf("Could not connect to podium database. Error: %s", err.Error())
+		}
+
+		encoder := json.NewEncoder(os.Stdout)
+		err = leaderboards.DiffLeaderboard(context.Background(), args[0], diffFrom, diffTo, diffOrder, func(diff *model.MemberDiff) error {
+			return encoder.Encode(diff)
+		})
+		if err != nil {
+			log.Fatalf("Could not diff leaderboard %s. Error: %s", args[0], err.Error())
+		}
+	},
+}
+
+func getLeaderboardService(configFile string) (lservice.Leaderboard, error) {
+	config, err := config.GetDefaultConfig(configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	database := database.NewRedisDatabase(database.RedisOptions{
+		ClusterEnabled: config.GetBool("redis.cluster.enabled"),
+		Addrs:          config.GetStringSlice("redis.addrs"),
+		Host:           config.GetString("redis.host"),
+		Port:           config.GetInt("redis.port"),
+		Password:       config.GetString("redis.password"),
+		DB:             config.GetInt("redis.db"),
+	})
+
+	return lservice.NewService(database), nil
+}
+
+func init() {
+	RootCmd.AddCommand(diffCmd)
+
+	diffCmd.Flags().Int64VarP(&diffFrom, "from", "f", 0, "Unix timestamp until which the newest rank snapshot is compared")
+	diffCmd.Flags().Int64VarP(&diffTo, "to", "t", 0, "Unix timestamp until which the newest rank snapshot is compared with the first one, the current leaderboard if not set")
+	diffCmd.Flags().StringVarP(&diffOrder, "order", "o", "desc", "Order of ranks, asc or desc")
+	diffCmd.MarkFlagRequired("from")
+}
@@ -0,0 +1,93 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sync/atomic"
+
+	"github.com/spf13/cobra"
+	"github.com/topfreegames/podium/transfer"
+)
+
+var exportDir, exportOrder, exportFormat, exportGRPC, exportCheckpoint string
+var exportGzip bool
+var exportChunkSize, exportWorkers int
+
+// exportCmd represents the export command
+var exportCmd = &cobra.Command{
+	Use:   "export LEADERBOARD...",
+	Short: "exports leaderboards to files",
+	Long: `Exports the publicID, score, rank and expireAt of every member of each leaderboard to a CSV or JSON Lines file,
+gzipped or not, named after the leaderboard in --dir, with parallel workers exporting a leaderboard each. It reads
+straight from the redis configured, or through the gRPC API of the podium at --grpc. Each leaderboard is read from
+a copy taken when its export starts, so its ranks are consistent. With --checkpoint, the leaderboards exported are
+saved and an interrupted export resumes with the ones left.
+`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		err := os.MkdirAll(exportDir, 0755)
+		if err != nil {
+			log.Fatalf("Could not create %s. Error: %s", exportDir, err.Error())
+		}
+
+		leaderboards, closeLeaderboards, err := getTransferLeaderboards(exportGRPC)
+		if err != nil {
+			log.Fatalf("Could not connect to podium. Error: %s", err.Error())
+		}
+		defer closeLeaderboards()
+
+		exporter := transfer.NewExporter(leaderboards, exportDir, exportFormat, exportGzip, exportChunkSize, exportWorkers)
+		exporter.Order = exportOrder
+		exporter.CheckpointPath = exportCheckpoint
+
+		var members, exported int64
+		progress := newTransferProgress(len(args), func() string {
+			return fmt.Sprintf("%d/%d leaderboards, %d members", atomic.LoadInt64(&exported), len(args), atomic.LoadInt64(&members))
+		})
+		exporter.OnChunk = func(leaderboard string, count int) {
+			atomic.AddInt64(&members, int64(count))
+		}
+		exporter.OnLeaderboard = func(result *transfer.ExportResult) {
+			atomic.AddInt64(&exported, 1)
+			progress.incr()
+		}
+
+		results, err := exporter.Export(context.Background(), args)
+		progress.stop()
+		for _, result := range results {
+			resumed := ""
+			if result.Resumed {
+				resumed = ", resumed from checkpoint"
+			}
+			fmt.Printf("Exported %d members of %s to %s%s.\n", result.Members, result.Leaderboard, result.Path, resumed)
+		}
+		if err != nil {
+			log.Fatalf("Could not export leaderboards. Error: %s", err.Error())
+		}
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(exportCmd)
+
+	exportCmd.Flags().StringVarP(&exportDir, "dir", "d", ".", "Directory the files are written to")
+	exportCmd.Flags().StringVarP(&exportFormat, "format", "f", "csv", "Format of the files, csv or jsonl")
+	exportCmd.Flags().BoolVarP(&exportGzip, "gzip", "z", false, "Gzip the files")
+	exportCmd.Flags().StringVarP(&exportOrder, "order", "o", "desc", "Order of ranks, asc or desc")
+	exportCmd.Flags().StringVarP(&exportGRPC, "grpc", "g", "", "Address of the gRPC API of a running podium to export through, straight from redis if not set")
+	exportCmd.Flags().IntVarP(&exportChunkSize, "chunk-size", "s", 1000, "Number of members read at once")
+	exportCmd.Flags().IntVarP(&exportWorkers, "workers", "w", 4, "Number of leaderboards exported in parallel")
+	exportCmd.Flags().StringVar(&exportCheckpoint, "checkpoint", "", "File the leaderboards exported are saved to and resumed from, removed once the export ends")
+	exportCmd.Flags().BoolVarP(&transferQuiet, "quiet", "q", false, "Do not show progress")
+}
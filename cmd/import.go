@@ -0,0 +1,209 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package cmd
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/gosuri/uiprogress"
+	"github.com/gosuri/uiprogress/util/strutil"
+	"github.com/spf13/cobra"
+	"github.com/topfreegames/podium/config"
+	api "github.com/topfreegames/podium/proto/podium/api/v1"
+	"github.com/topfreegames/podium/transfer"
+)
+
+var importFormat, importGRPC, importCheckpoint string
+var importGzip, importDryRun, transferQuiet bool
+var importChunkSize, importWorkers int
+
+// importCmd represents the import command
+var importCmd = &cobra.Command{
+	Use:   "import LEADERBOARD FILE",
+	Short: "imports members from a file into a leaderboard",
+	Long: `Imports the publicID and score of members from a CSV or JSON Lines file, gzipped or not, into a leaderboard,
+in chunks written by parallel workers. It writes straight to the redis configured, or through the gRPC API of the
+podium at --grpc. Members that could not be imported are printed as JSON lines to stderr without stopping the import.
+With --checkpoint, progress is saved after each chunk and an interrupted import resumes where it stopped.
+With --dry-run, the file is only validated.
+`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		leaderboard, path := args[0], args[1]
+
+		format, gzipped := transfer.FormatOf(path)
+		if importFormat != "" {
+			format = importFormat
+		}
+		if cmd.Flags().Changed("gzip") {
+			gzipped = importGzip
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			log.Fatalf("Could not open %s. Error: %s", path, err.Error())
+		}
+		defer file.Close()
+		info, err := file.Stat()
+		if err != nil {
+			log.Fatalf("Could not open %s. Error: %s", path, err.Error())
+		}
+
+		counter := &countingReader{reader: file}
+		var input io.Reader = counter
+		if gzipped {
+			input, err = gzip.NewReader(counter)
+			if err != nil {
+				log.Fatalf("Could not read %s. Error: %s", path, err.Error())
+			}
+		}
+		reader, err := transfer.NewReader(input, format)
+		if err != nil {
+			log.Fatalf("Could not read %s. Error: %s", path, err.Error())
+		}
+
+		importer := transfer.NewImporter(nil, importChunkSize, importWorkers)
+		importer.DryRun = importDryRun
+		importer.CheckpointPath = importCheckpoint
+		if !importDryRun {
+			leaderboards, closeLeaderboards, err := getTransferLeaderboards(importGRPC)
+			if err != nil {
+				log.Fatalf("Could not connect to podium. Error: %s", err.Error())
+			}
+			defer closeLeaderboards()
+			importer.Leaderboards = leaderboards
+		}
+
+		var imported, failed int64
+		encoder := json.NewEncoder(os.Stderr)
+		progress := newTransferProgress(int(info.Size()), func() string {
+			return fmt.Sprintf("%d imported, %d failed", atomic.LoadInt64(&imported), atomic.LoadInt64(&failed))
+		})
+		importer.OnChunk = func(chunk *transfer.ChunkResult) {
+			atomic.AddInt64(&imported, int64(chunk.Imported))
+			atomic.AddInt64(&failed, int64(len(chunk.Failures)))
+			for _, failure := range chunk.Failures {
+				encoder.Encode(failure)
+			}
+			progress.set(int(atomic.LoadInt64(&counter.read)))
+		}
+
+		result, err := importer.Import(context.Background(), leaderboard, reader)
+		progress.stop()
+		if err != nil {
+			log.Fatalf("Could not import %s into leaderboard %s. Error: %s", path, leaderboard, err.Error())
+		}
+
+		verb := "Imported"
+		if importDryRun {
+			verb = "Validated"
+		}
+		fmt.Printf("%s %d members into %s, %d failed, %d resumed from checkpoint.\n", verb, result.Imported, leaderboard, result.Failed, result.Resumed)
+	},
+}
+
+// getTransferLeaderboards return the leaderboards to import into and export from, through the gRPC API of
+// the podium at grpcAddress if it is set or else straight through the redis configured
+func getTransferLeaderboards(grpcAddress string) (transfer.Leaderboards, func(), error) {
+	if grpcAddress == "" {
+		leaderboards, err := getLeaderboardService(ConfigFile)
+		return leaderboards, func() {}, err
+	}
+
+	podiumConfig, err := config.GetDefaultConfig(ConfigFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conn, err := transfer.DialGRPC(grpcAddress, podiumConfig.GetString("basicauth.username"), podiumConfig.GetString("basicauth.password"))
+	if err != nil {
+		return nil, nil, err
+	}
+	return transfer.NewGRPCLeaderboards(api.NewPodiumClient(conn)), func() { conn.Close() }, nil
+}
+
+// countingReader counts the bytes read from reader, to show the progress of reading a file
+type countingReader struct {
+	reader io.Reader
+	read   int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.reader.Read(p)
+	atomic.AddInt64(&c.read, int64(n))
+	return n, err
+}
+
+// transferProgress renders a progress bar like the one of bench/seed, unless --quiet is set
+type transferProgress struct {
+	progress *uiprogress.Progress
+	bar      *uiprogress.Bar
+}
+
+func newTransferProgress(total int, describe func() string) *transferProgress {
+	if transferQuiet || total <= 0 {
+		return &transferProgress{}
+	}
+
+	start := time.Now()
+	progress := uiprogress.New()
+	progress.Start()
+	bar := progress.AddBar(total)
+	bar.AppendCompleted()
+	bar.AppendFunc(func(b *uiprogress.Bar) string {
+		return time.Since(start).Round(time.Second).String()
+	})
+	bar.PrependFunc(func(b *uiprogress.Bar) string {
+		text := describe()
+		return strutil.Resize(text, uint(len(text)))
+	})
+	return &transferProgress{progress: progress, bar: bar}
+}
+
+func (t *transferProgress) set(current int) {
+	if t.bar != nil {
+		t.bar.Set(current)
+	}
+}
+
+func (t *transferProgress) incr() {
+	if t.bar != nil {
+		t.bar.Incr()
+	}
+}
+
+// stop render the last progress and stop rendering
+func (t *transferProgress) stop() {
+	if t.progress != nil {
+		time.Sleep(t.progress.RefreshInterval)
+		t.progress.Stop()
+	}
+}
+
+func init() {
+	RootCmd.AddCommand(importCmd)
+
+	importCmd.Flags().StringVarP(&importFormat, "format", "f", "", "Format of the file, csv or jsonl, from its extension if not set")
+	importCmd.Flags().BoolVarP(&importGzip, "gzip", "z", false, "Whether the file is gzipped, from its extension if not set")
+	importCmd.Flags().StringVarP(&importGRPC, "grpc", "g", "", "Address of the gRPC API of a running podium to import through, straight to redis if not set")
+	importCmd.Flags().IntVarP(&importChunkSize, "chunk-size", "s", 1000, "Number of members imported at once by each worker")
+	importCmd.Flags().IntVarP(&importWorkers, "workers", "w", 4, "Number of chunks imported in parallel")
+	importCmd.Flags().StringVar(&importCheckpoint, "checkpoint", "", "File the progress is saved to and resumed from, removed once the import ends")
+	importCmd.Flags().BoolVar(&importDryRun, "dry-run", false, "Only validate the file, without connecting to podium")
+	importCmd.Flags().BoolVarP(&transferQuiet, "quiet", "q", false, "Do not show progress")
+}
@@ -0,0 +1,57 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+	"github.com/topfreegames/podium/config"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+)
+
+// migrateKeysCmd represents the migrate-keys command
+var migrateKeysCmd = &cobra.Command{
+	Use:   "migrate-keys",
+	Short: "moves keys written by older versions to their hash tagged names",
+	Long: `Moves the keys older versions of podium wrote next to leaderboards without a hash tag, like the members
+versions in "leaderboard:versions", to their hash tagged names, like "{leaderboard}:versions", in the redis configured,
+so redis cluster places them in the slot of their leaderboards. Keys written since the update are merged with them.
+Run it once after every instance was updated.
+	you can use environment variables to override configuration keys`,
+	Run: func(cmd *cobra.Command, args []string) {
+		podiumConfig, err := config.GetDefaultConfig(ConfigFile)
+		if err != nil {
+			log.Fatalf("Could not load config. Error: %s", err.Error())
+		}
+
+		database := database.NewRedisDatabase(database.RedisOptions{
+			ClusterEnabled: podiumConfig.GetBool("redis.cluster.enabled"),
+			Addrs:          podiumConfig.GetStringSlice("redis.addrs"),
+			Host:           podiumConfig.GetString("redis.host"),
+			Port:           podiumConfig.GetInt("redis.port"),
+			Password:       podiumConfig.GetString("redis.password"),
+			DB:             podiumConfig.GetInt("redis.db"),
+		})
+
+		migrated, err := database.MigrateKeys(context.Background())
+		if err != nil {
+			log.Fatalf("Could not migrate keys after %d keys. Error: %s", migrated, err.Error())
+		}
+
+		fmt.Printf("Migrated %d keys.\n", migrated)
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(migrateKeysCmd)
+}
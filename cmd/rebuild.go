@@ -0,0 +1,77 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/topfreegames/podium/config"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/mutationlog"
+)
+
+var rebuildUntil int64
+
+// rebuildCmd represents the rebuild command
+var rebuildCmd = &cobra.Command{
+	Use:   "rebuild",
+	Short: "rebuilds leaderboards from the mutation log",
+	Long: `Replays the writes appended to the mutation log configured in mutationLog into the redis configured,
+up to --until if it is set, so leaderboards can be recovered to a point in time after redis lost data.
+The redis must be empty, as replayed increments add to the scores already there.
+	you can use environment variables to override configuration keys`,
+	Run: func(cmd *cobra.Command, args []string) {
+		podiumConfig, err := config.GetDefaultConfig(ConfigFile)
+		if err != nil {
+			log.Fatalf("Could not load config. Error: %s", err.Error())
+		}
+		config.SetMutationLogDefaults(podiumConfig)
+
+		mutationLog, err := config.NewMutationLog(podiumConfig)
+		if err != nil {
+			log.Fatalf("Could not open mutation log. Error: %s", err.Error())
+		}
+		if mutationLog == nil {
+			log.Fatalf("Could not rebuild leaderboards. Error: mutationLog.type is not set")
+		}
+		defer mutationLog.Close()
+
+		database := database.NewRedisDatabase(database.RedisOptions{
+			ClusterEnabled: podiumConfig.GetBool("redis.cluster.enabled"),
+			Addrs:          podiumConfig.GetStringSlice("redis.addrs"),
+			Host:           podiumConfig.GetString("redis.host"),
+			Port:           podiumConfig.GetInt("redis.port"),
+			Password:       podiumConfig.GetString("redis.password"),
+			DB:             podiumConfig.GetInt("redis.db"),
+		})
+
+		var until time.Time
+		if rebuildUntil > 0 {
+			until = time.Unix(rebuildUntil, 0)
+		}
+
+		applied, err := mutationlog.Replay(context.Background(), mutationLog, database, until)
+		if err != nil {
+			log.Fatalf("Could not rebuild leaderboards after %d mutations. Error: %s", applied, err.Error())
+		}
+
+		fmt.Printf("Replayed %d mutations.\n", applied)
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(rebuildCmd)
+
+	rebuildCmd.Flags().Int64VarP(&rebuildUntil, "until", "u", 0, "Unix timestamp until which mutations are replayed, all of them if not set")
+}
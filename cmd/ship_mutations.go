@@ -0,0 +1,73 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/topfreegames/podium/log"
+	"github.com/topfreegames/podium/worker"
+	"go.uber.org/zap"
+)
+
+// shipMutationsCmd represents the ship-mutations command
+var shipMutationsCmd = &cobra.Command{
+	Use:   "ship-mutations",
+	Short: "starts the podium mutation log shipper",
+	Long: `starts the podium mutation log shipper, that appends the writes added to the mutation outboxes in redis
+to the mutation log configured in mutationLog and removes them from the outboxes. Run a single shipper.
+	you can use environment variables to override configuration keys`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ll := zap.InfoLevel
+		if debug {
+			ll = zap.DebugLevel
+		}
+		if quiet {
+			ll = zap.WarnLevel
+		}
+		logger := log.CreateLoggerWithLevel(ll, log.LoggerOptions{WriteSyncer: os.Stdout})
+		logger = logger.With(
+			zap.String("source", "ship-mutations"),
+		)
+
+		defer logger.Sync()
+
+		logger.Info("Starting podium mutation log shipper...")
+
+		w, err := worker.GetMutationLogWorker(ConfigFile)
+
+		if err != nil {
+			logger.Fatal("Could not get podium mutation log worker.", zap.Error(err))
+		}
+
+		shippedChan := make(chan int)
+		errChan := make(chan error)
+
+		go func() {
+			for {
+				select {
+				case shipped := <-shippedChan:
+					logger.Debug("shipped mutations", zap.Int("shipped", shipped))
+				case err := <-errChan:
+					logger.Error("error from mutation log worker", zap.Error(err))
+				}
+			}
+		}()
+
+		w.Run(shippedChan, errChan)
+	},
+}
+
+func init() {
+	shipMutationsCmd.Flags().BoolVarP(&debug, "debug", "d", false, "Debug mode (log=debug)")
+	shipMutationsCmd.Flags().BoolVarP(&quiet, "quiet", "q", false, "Quiet mode (log=warn)")
+	RootCmd.AddCommand(shipMutationsCmd)
+}
@@ -21,9 +21,9 @@ import (
 // workerCmd represents the worker command
 var workerCmd = &cobra.Command{
 	Use:   "worker",
-	Short: "starts the podium scores expirer worker",
-	Long: `starts the podium worker that expires scores with the specified arguments. you can use environment variables to override
-	configuration keys`,
+	Short: "starts the podium scores expirer, decay, snapshot and block worker",
+	Long: `starts the podium worker that expires scores, renormalizes decaying leaderboards, takes rank snapshots and moves scores of members blocked in every leaderboard with the specified arguments.
+	you can use environment variables to override configuration keys`,
 	Run: func(cmd *cobra.Command, args []string) {
 		ll := zap.InfoLevel
 		if debug {
@@ -47,7 +47,28 @@ var workerCmd = &cobra.Command{
 			logger.Fatal("Could not get podium worker.", zap.Error(err))
 		}
 
+		dw, err := worker.GetDecayWorker(ConfigFile)
+
+		if err != nil {
+			logger.Fatal("Could not get podium decay worker.", zap.Error(err))
+		}
+
+		sw, err := worker.GetSnapshotWorker(ConfigFile)
+
+		if err != nil {
+			logger.Fatal("Could not get podium snapshot worker.", zap.Error(err))
+		}
+
+		bw, err := worker.GetBlockWorker(ConfigFile)
+
+		if err != nil {
+			logger.Fatal("Could not get podium block worker.", zap.Error(err))
+		}
+
 		expirationsChan := make(chan []*worker.ExpirationResult)
+		decaysChan := make(chan []*worker.DecayResult)
+		snapshotsChan := make(chan []*worker.SnapshotResult)
+		blocksChan := make(chan []*worker.BlockResult)
 		errChan := make(chan error)
 
 		go func() {
@@ -55,12 +76,21 @@ var workerCmd = &cobra.Command{
 				select {
 				case expirations := <-expirationsChan:
 					logger.Debug("expiration results", zap.Any("result", expirations))
+				case decays := <-decaysChan:
+					logger.Debug("decay results", zap.Any("result", decays))
+				case snapshots := <-snapshotsChan:
+					logger.Debug("snapshot results", zap.Any("result", snapshots))
+				case blocks := <-blocksChan:
+					logger.Debug("block results", zap.Any("result", blocks))
 				case err := <-errChan:
 					logger.Error("error from worker", zap.Error(err))
 				}
 			}
 		}()
 
+		go dw.Run(decaysChan, errChan)
+		go sw.Run(snapshotsChan, errChan)
+		go bw.Run(blocksChan, errChan)
 		w.Run(expirationsChan, errChan)
 	},
 }
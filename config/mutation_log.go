@@ -0,0 +1,54 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/mutationlog"
+)
+
+// SetMutationLogDefaults set the defaults of the mutationLog keys
+func SetMutationLogDefaults(config *viper.Viper) {
+	config.SetDefault("mutationLog.type", "")
+	config.SetDefault("mutationLog.checkInterval", "1s")
+	config.SetDefault("mutationLog.batchSize", 1000)
+	config.SetDefault("mutationLog.idleTime", "1m")
+	config.SetDefault("mutationLog.segments.dir", "./mutations")
+	config.SetDefault("mutationLog.segments.segmentSize", 64*1024*1024)
+	config.SetDefault("mutationLog.redis.host", "localhost")
+	config.SetDefault("mutationLog.redis.port", 6379)
+	config.SetDefault("mutationLog.redis.password", "")
+	config.SetDefault("mutationLog.redis.db", 0)
+	config.SetDefault("mutationLog.redis.stream", "podium:mutations")
+}
+
+// MutationLogEnabled return if writes must be added to mutation outboxes, when mutationLog.type is set,
+// or an error if it is not a valid type
+func MutationLogEnabled(config *viper.Viper) (bool, error) {
+	logType := config.GetString("mutationLog.type")
+	if logType == "" {
+		return false, nil
+	}
+
+	return true, mutationlog.ValidateType(logType)
+}
+
+// NewMutationLog create the mutation log configured in mutationLog.type, or nil if it is empty
+func NewMutationLog(config *viper.Viper) (mutationlog.Log, error) {
+	logType := config.GetString("mutationLog.type")
+	if logType == "" {
+		return nil, nil
+	}
+
+	return mutationlog.New(mutationlog.Options{
+		Type:        logType,
+		Dir:         config.GetString("mutationLog.segments.dir"),
+		SegmentSize: config.GetInt64("mutationLog.segments.segmentSize"),
+		Redis: database.RedisOptions{
+			Host:     config.GetString("mutationLog.redis.host"),
+			Port:     config.GetInt("mutationLog.redis.port"),
+			Password: config.GetString("mutationLog.redis.password"),
+			DB:       config.GetInt("mutationLog.redis.db"),
+		},
+		Stream: config.GetString("mutationLog.redis.stream"),
+	})
+}
@@ -0,0 +1,289 @@
+package leaderboard_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// crossSlotClient fails commands with keys in different slots with CROSSSLOT like redis cluster does,
+// since the redis used by tests does not check it
+type crossSlotClient struct {
+	redis.Client
+}
+
+func (c *crossSlotClient) Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+	err := checkSameSlot(keys...)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Eval(ctx, script, keys, args...)
+}
+
+func (c *crossSlotClient) Rename(ctx context.Context, key, newKey string) error {
+	err := checkSameSlot(key, newKey)
+	if err != nil {
+		return err
+	}
+	return c.Client.Rename(ctx, key, newKey)
+}
+
+func checkSameSlot(keys ...string) error {
+	for _, key := range keys {
+		if clusterSlot(key) != clusterSlot(keys[0]) {
+			return redis.NewGeneralError(fmt.Sprintf("CROSSSLOT Keys in request don't hash to the same slot: %s and %s", keys[0], key))
+		}
+	}
+	return nil
+}
+
+// clusterSlot return the redis cluster slot of key, the CRC16 of its hash tag or of the whole key modulo 16384
+func clusterSlot(key string) uint16 {
+	if start := strings.Index(key, "{"); start >= 0 {
+		if end := strings.Index(key[start+1:], "}"); end > 0 {
+			key = key[start+1 : start+1+end]
+		}
+	}
+
+	var crc uint16
+	for i := 0; i < len(key); i++ {
+		crc ^= uint16(key[i]) << 8
+		for bit := 0; bit < 8; bit++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc % 16384
+}
+
+var _ = Describe("Leaderboard on redis cluster", func() {
+	var redisDatabase *database.Redis
+	var leaderboards service.Leaderboard
+
+	BeforeEach(func() {
+		var err error
+		redisDatabase, err = GetDefaultRedis()
+		Expect(err).NotTo(HaveOccurred())
+
+		leaderboards = service.NewService(&database.Redis{Client: &crossSlotClient{redisDatabase.Client}})
+	})
+
+	It("should compute cluster slots", func() {
+		Expect(clusterSlot("foo")).To(Equal(uint16(12182)))
+		Expect(clusterSlot("{user1000}.following")).To(Equal(clusterSlot("{user1000}.followers")))
+	})
+
+	It("should write scores and versions of members", func() {
+		lbID := uuid.NewV4().String()
+
+		member, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "member-1", 10, false, "", nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(member.Version).To(Equal(int64(1)))
+
+		err = leaderboards.SetMembersScore(NewEmptyCtx(), lbID, []*model.Member{{PublicID: "member-2", Score: 20}}, false, "", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		member, err = leaderboards.IncrementMemberScore(NewEmptyCtx(), lbID, "member-1", 5, "", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(member.Score).To(Equal(int64(15)))
+
+		version := int64(2)
+		member, err = leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "member-1", 30, false, "", nil, &model.ScoreCondition{ExpectedVersion: &version})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(member.Version).To(Equal(int64(3)))
+	})
+
+	It("should write scores with an idempotency key", func() {
+		lbID := uuid.NewV4().String()
+		idempotency := &model.IdempotencyKey{Key: uuid.NewV4().String(), Window: time.Minute}
+
+		member, err := leaderboards.IncrementMemberScore(NewEmptyCtx(), lbID, "member-1", 5, "", idempotency)
+		Expect(err).NotTo(HaveOccurred())
+
+		retried, err := leaderboards.IncrementMemberScore(NewEmptyCtx(), lbID, "member-1", 5, "", idempotency)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(retried).To(Equal(member))
+
+		idempotency = &model.IdempotencyKey{Key: uuid.NewV4().String(), Window: time.Minute}
+		err = leaderboards.SetMembersScore(NewEmptyCtx(), lbID, []*model.Member{{PublicID: "member-2", Score: 20}}, false, "", idempotency)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should record score changes of many members in their ledgers", func() {
+		lbID := uuid.NewV4().String()
+
+		err := leaderboards.SetMembersScore(NewEmptyCtx(), lbID, []*model.Member{{PublicID: "member-1", Score: 10}, {PublicID: "member-2", Score: 20}}, false, "", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		entries, err := leaderboards.GetMemberLedger(NewEmptyCtx(), lbID, "member-2", 0, 10, 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+	})
+
+	It("should take rank snapshots removing old ones", func() {
+		lbID := uuid.NewV4().String()
+		clusterDatabase := &database.Redis{Client: &crossSlotClient{redisDatabase.Client}}
+		takenAt := time.Now().Truncate(time.Second)
+
+		_, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "member-1", 10, false, "", nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		err = clusterDatabase.AddRankSnapshot(NewEmptyCtx(), lbID, 1, takenAt.Add(-time.Hour), time.Time{}, time.Time{})
+		Expect(err).NotTo(HaveOccurred())
+		err = clusterDatabase.AddRankSnapshot(NewEmptyCtx(), lbID, 1, takenAt, takenAt.Add(-time.Minute), time.Time{})
+		Expect(err).NotTo(HaveOccurred())
+
+		oldTakenAt, err := clusterDatabase.GetRankSnapshotTime(NewEmptyCtx(), lbID, takenAt.Add(-time.Minute))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(oldTakenAt.IsZero()).To(BeTrue())
+
+		movers, err := leaderboards.GetRankMovers(NewEmptyCtx(), lbID, takenAt.Unix(), 0, 10, "desc")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(movers.Climbers).To(BeEmpty())
+	})
+
+	It("should renormalize decaying leaderboards", func() {
+		lbID := uuid.NewV4().String()
+
+		_, err := leaderboards.UpdateLeaderboardSettings(NewEmptyCtx(), lbID, &model.LeaderboardSettings{DecayHalfLife: 3600})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "member-1", 100, false, "", nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		renormalized, err := leaderboards.RenormalizeLeaderboard(NewEmptyCtx(), lbID, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(renormalized).To(BeTrue())
+
+		member, err := leaderboards.GetMember(NewEmptyCtx(), lbID, "member-1", "desc", false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(member.Score).To(Equal(int64(100)))
+	})
+
+	It("should take write tokens of a leaderboard and its members", func() {
+		lbID := uuid.NewV4().String()
+		limits := &model.RateLimits{
+			Member:      &model.RateLimit{Rate: 10, Burst: 10},
+			Leaderboard: &model.RateLimit{Rate: 10, Burst: 10},
+		}
+
+		err := leaderboards.TakeWriteTokens(NewEmptyCtx(), "caller1", map[string][]string{lbID: {"member-1", "member-2"}}, limits)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should block and unblock members in a leaderboard and in every leaderboard", func() {
+		lbID := uuid.NewV4().String()
+		cheater := uuid.NewV4().String()
+
+		_, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, cheater, 10, false, "", nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = leaderboards.BlockMember(NewEmptyCtx(), lbID, cheater, model.BlockModeShadow)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = leaderboards.BlockMember(NewEmptyCtx(), "", cheater, model.BlockModeReject)
+		Expect(err).NotTo(HaveOccurred())
+
+		member, err := leaderboards.IncrementMemberScore(NewEmptyCtx(), lbID, cheater, 5, "", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(member.Score).To(Equal(int64(15)))
+
+		err = leaderboards.UnblockMember(NewEmptyCtx(), lbID, cheater)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = leaderboards.UnblockMember(NewEmptyCtx(), "", cheater)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = leaderboards.ApplyGlobalBlock(NewEmptyCtx(), cheater)
+		Expect(err).NotTo(HaveOccurred())
+
+		member, err = leaderboards.GetMember(NewEmptyCtx(), lbID, cheater, "desc", false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(member.Score).To(Equal(int64(15)))
+	})
+
+	It("should reset a leaderboard", func() {
+		lbID := uuid.NewV4().String()
+		nextSeason := uuid.NewV4().String()
+
+		for i := 0; i < 10; i++ {
+			err := redisDatabase.ZAdd(NewEmptyCtx(), lbID, &redis.Member{Member: fmt.Sprintf("member-%d", i), Score: float64(100 - i)})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		_, err := leaderboards.ResetLeaderboard(NewEmptyCtx(), lbID, &model.ResetOptions{
+			Strategy: model.ResetStrategyTopK,
+			Target:   nextSeason,
+			TopK:     3,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = leaderboards.ResetLeaderboard(NewEmptyCtx(), lbID, &model.ResetOptions{Strategy: model.ResetStrategyZero})
+		Expect(err).NotTo(HaveOccurred())
+
+		members, err := leaderboards.GetLeaders(NewEmptyCtx(), nextSeason, 10, 1, "desc")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(members).To(HaveLen(3))
+		Expect(members[2].PublicID).To(Equal("member-2"))
+
+		member, err := leaderboards.GetMember(NewEmptyCtx(), lbID, "member-9", "desc", false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(member.Score).To(Equal(int64(0)))
+	})
+
+	It("should export a leaderboard from its copies", func() {
+		lbID := uuid.NewV4().String()
+
+		err := leaderboards.SetMembersScore(NewEmptyCtx(), lbID, []*model.Member{{PublicID: "member-1", Score: 10}, {PublicID: "member-2", Score: 20}}, false, "100", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		exported := []*model.Member{}
+		err = leaderboards.ExportLeaderboard(NewEmptyCtx(), lbID, "desc", 1, func(members []*model.Member) error {
+			exported = append(exported, members...)
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exported).To(HaveLen(2))
+		Expect(exported[0].PublicID).To(Equal("member-2"))
+		Expect(exported[1].ExpireAt).NotTo(BeZero())
+	})
+
+	It("should join a league and end its season", func() {
+		leagueID := uuid.NewV4().String()
+
+		_, err := leaderboards.CreateLeague(NewEmptyCtx(), &model.League{
+			ID:              leagueID,
+			Tiers:           2,
+			DivisionSize:    2,
+			PromotionCount:  1,
+			RelegationCount: 1,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		for i := 0; i < 5; i++ {
+			division, err := leaderboards.JoinLeague(NewEmptyCtx(), leagueID, fmt.Sprintf("member-%d", i), 2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(division.Division).To(Equal(i/2 + 1))
+		}
+
+		result, err := leaderboards.EndLeagueSeason(NewEmptyCtx(), leagueID, 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Promoted).To(Equal(3))
+
+		division, err := leaderboards.GetLeagueDivision(NewEmptyCtx(), leagueID, "member-4")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(division.Season).To(Equal(2))
+		Expect(division.Tier).To(Equal(1))
+	})
+})
@@ -0,0 +1,17 @@
+package database
+
+import "context"
+
+// Blocklist interface standardize database calls of the worker applying blocks in every leaderboard
+type Blocklist interface {
+	GetQueuedGlobalBlocks(ctx context.Context) ([]*QueuedGlobalBlock, error)
+	RemoveQueuedGlobalBlock(ctx context.Context, block *QueuedGlobalBlock) (bool, error)
+}
+
+// QueuedGlobalBlock is a member blocked or unblocked in every leaderboard whose scores were not moved yet.
+// Times is how many times it was queued, so it is kept queued if it is blocked or unblocked again while its
+// scores are moved
+type QueuedGlobalBlock struct {
+	Member string
+	Times  int64
+}
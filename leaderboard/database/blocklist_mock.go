@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: leaderboard/database/blocklist.go
+
+// Package database is a generated GoMock package.
+package database
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBlocklist is a mock of Blocklist interface.
+type MockBlocklist struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlocklistMockRecorder
+}
+
+// MockBlocklistMockRecorder is the mock recorder for MockBlocklist.
+type MockBlocklistMockRecorder struct {
+	mock *MockBlocklist
+}
+
+// NewMockBlocklist creates a new mock instance.
+func NewMockBlocklist(ctrl *gomock.Controller) *MockBlocklist {
+	mock := &MockBlocklist{ctrl: ctrl}
+	mock.recorder = &MockBlocklistMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlocklist) EXPECT() *MockBlocklistMockRecorder {
+	return m.recorder
+}
+
+// GetQueuedGlobalBlocks mocks base method.
+func (m *MockBlocklist) GetQueuedGlobalBlocks(ctx context.Context) ([]*QueuedGlobalBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueuedGlobalBlocks", ctx)
+	ret0, _ := ret[0].([]*QueuedGlobalBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueuedGlobalBlocks indicates an expected call of GetQueuedGlobalBlocks.
+func (mr *MockBlocklistMockRecorder) GetQueuedGlobalBlocks(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueuedGlobalBlocks", reflect.TypeOf((*MockBlocklist)(nil).GetQueuedGlobalBlocks), ctx)
+}
+
+// RemoveQueuedGlobalBlock mocks base method.
+func (m *MockBlocklist) RemoveQueuedGlobalBlock(ctx context.Context, block *QueuedGlobalBlock) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveQueuedGlobalBlock", ctx, block)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveQueuedGlobalBlock indicates an expected call of RemoveQueuedGlobalBlock.
+func (mr *MockBlocklistMockRecorder) RemoveQueuedGlobalBlock(ctx, block interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveQueuedGlobalBlock", reflect.TypeOf((*MockBlocklist)(nil).RemoveQueuedGlobalBlock), ctx, block)
+}
@@ -7,25 +7,214 @@ import (
 
 // Database interface standardize database calls
 type Database interface {
+	AddHistorySamples(ctx context.Context, leaderboard string, samples []*HistorySample, interval time.Duration, expireAt time.Time) error
+	AddLeaderboardParticipants(ctx context.Context, leaderboard string, joinedAt time.Time, members ...string) error
+	AddLeaderboardToDecayList(ctx context.Context, leaderboard string) error
+	AddLeaderboardToSnapshotList(ctx context.Context, leaderboard string) error
+	AddLedgerEntries(ctx context.Context, leaderboard string, entries []*LedgerEntry, removeBefore, expireAt time.Time, maxEntries int) error
+	AddNonce(ctx context.Context, leaderboard, nonce string, expiration time.Duration) (bool, error)
+	AddRankSnapshot(ctx context.Context, leaderboard string, weight float64, takenAt, removeBefore, expireAt time.Time) error
+	AddRejectedScore(ctx context.Context, leaderboard string, rejectedScore *RejectedScore) error
+	AddWebhookDeadLetter(ctx context.Context, deadLetter *WebhookDeadLetter) error
+	AddWebhookDelivery(ctx context.Context, delivery *WebhookDelivery) error
+	ApplyGlobalBlock(ctx context.Context, leaderboard, mode string, members ...string) error
+	BlockMembers(ctx context.Context, leaderboard, mode string, members ...string) error
+	CreateTournament(ctx context.Context, tournament string, config *Tournament) (bool, error)
+	CreateExportCopy(ctx context.Context, leaderboard, export string, expireAt time.Time) (int, error)
+	EndLeagueSeason(ctx context.Context, league string, season int, result *LeagueSeasonResult) (bool, error)
+	GetBlockedMembers(ctx context.Context, leaderboard string, members ...string) ([]*BlockedMember, error)
+	GetExportMembers(ctx context.Context, leaderboard, export string, start, stop int, order string) ([]*Member, error)
+	GetHistorySamples(ctx context.Context, leaderboard, member string, from, to time.Time) ([]*HistorySample, error)
+	GetIdempotentWrite(ctx context.Context, leaderboard string, databaseMembers []*Member, idempotency *Idempotency) (bool, error)
 	GetLeaderboardExpiration(ctx context.Context, leaderboard string) (int64, error)
+	GetLeaderboardNonParticipants(ctx context.Context, leaderboard string, members ...string) ([]string, error)
+	GetLeaderboardSettings(ctx context.Context, leaderboard string) (map[string]string, error)
+	GetLeague(ctx context.Context, league string) (*League, error)
+	GetLeagueDivisionCounts(ctx context.Context, league string, season int) (map[int]int, error)
+	GetLeagueMemberDivision(ctx context.Context, league string, season int, member string) (*LeagueDivision, error)
+	GetLeagueSeasonResult(ctx context.Context, league string, season int) (*LeagueSeasonResult, error)
+	GetLedgerEntries(ctx context.Context, leaderboard, member string, since time.Time, offset, count int) ([]*LedgerEntry, error)
 	GetMemberIDsWithScoreInsideRange(ctx context.Context, leaderboard string, min, max string, offset, count int) ([]string, error)
 	GetMembers(ctx context.Context, leaderboard, order string, includeTTL bool, members ...string) ([]*Member, error)
+	GetMembersByID(ctx context.Context, leaderboard, order string, members ...string) ([]*Member, error)
+	GetMembersIncreases(ctx context.Context, leaderboard string, window time.Duration, members ...string) ([]int64, error)
 	GetOrderedMembers(ctx context.Context, leaderboard string, start, stop int, order string) ([]*Member, error)
 	GetRank(ctx context.Context, leaderboard, member, order string) (int, error)
+	GetRankSnapshotMembers(ctx context.Context, leaderboard string, takenAt time.Time, start, stop int, order string) ([]*Member, error)
+	GetRankSnapshotMembersByID(ctx context.Context, leaderboard string, takenAt time.Time, order string, members ...string) ([]*Member, error)
+	GetRankSnapshotTime(ctx context.Context, leaderboard string, at time.Time) (time.Time, error)
+	GetRejectedScores(ctx context.Context, leaderboard string, start, stop int) ([]*RejectedScore, error)
+	GetResetProgress(ctx context.Context, leaderboard string) (*ResetProgress, error)
+	GetShadowMember(ctx context.Context, leaderboard, member, order string) (*Member, error)
 	GetTotalMembers(ctx context.Context, leaderboard string) (int, error)
+	GetTournament(ctx context.Context, tournament string) (*Tournament, error)
+	GetWebhookDeliveries(ctx context.Context, start, stop int) ([]*WebhookDelivery, error)
 	Healthcheck(ctx context.Context) error
-	IncrementMemberScore(ctx context.Context, leaderboard, member string, increment float64) error
+	ImportMembers(ctx context.Context, leaderboard, target string, batches ...[]*Member) []error
+	IncrementMemberScore(ctx context.Context, leaderboard string, databaseMember *Member, increment float64) error
+	IncrementMemberScoreIdempotent(ctx context.Context, leaderboard string, databaseMember *Member, increment float64, idempotency *Idempotency) (bool, error)
+	JoinLeagueDivisions(ctx context.Context, league string, season, tier, divisionSize int, members ...string) ([]*LeagueDivision, error)
+	MarkLeaderboardCreated(ctx context.Context, leaderboard string, expireAt time.Time) (bool, error)
+	QueueGlobalBlock(ctx context.Context, member string) error
+	RemoveExportCopy(ctx context.Context, leaderboard, export string) error
 	RemoveLeaderboard(ctx context.Context, leaderboard string) error
 	RemoveMembers(ctx context.Context, leaderboard string, members ...string) error
+	RenameLeaderboard(ctx context.Context, leaderboard, newLeaderboard string) error
+	ScaleLeaderboard(ctx context.Context, leaderboard string, factor float64, settings map[string]string) error
+	ScanLeaderboards(ctx context.Context, fn func(leaderboards []string) error) error
+	ScanMembers(ctx context.Context, leaderboard string, cursor uint64, count int) ([]*Member, uint64, error)
 	SetLeaderboardExpiration(ctx context.Context, leaderboard string, expireAt time.Time) error
+	SetLeaderboardSettings(ctx context.Context, leaderboard string, settings map[string]string) error
+	SetLeague(ctx context.Context, league string, config *League) error
 	SetMembers(ctx context.Context, leaderboard string, databaseMembers []*Member) error
+	SetMemberIfMatch(ctx context.Context, leaderboard string, member *Member, condition *Condition) error
+	SetMemberIfMatchIdempotent(ctx context.Context, leaderboard string, databaseMember *Member, condition *Condition, idempotency *Idempotency) (bool, error)
+	SetMembersIdempotent(ctx context.Context, leaderboard string, databaseMembers []*Member, idempotency *Idempotency) (bool, error)
 	SetMembersTTL(ctx context.Context, leaderboard string, databaseMembers []*Member) error
+	SetResetProgress(ctx context.Context, leaderboard string, progress *ResetProgress) error
+	SetTournament(ctx context.Context, tournament string, config *Tournament) error
+	TakeCallerToken(ctx context.Context, caller string, limit *RateLimit) (time.Duration, error)
+	TakeLeaderboardTokens(ctx context.Context, leaderboard string, leaderboardLimit *RateLimit, members []string, memberLimit *RateLimit) (time.Duration, error)
+	TrimLeaderboard(ctx context.Context, leaderboard string, size int, order string) error
+	UnblockMembers(ctx context.Context, leaderboard string, members ...string) error
 }
 
 // Member is a struct to be used by users operations
 type Member struct {
-	Member string
-	Score  float64
-	Rank   int64
-	TTL    time.Time
+	Member  string
+	Score   float64
+	Rank    int64
+	TTL     time.Time
+	Version int64
+	// PreviousScore is filled by writes with the score member had before them, nil if it did not exist
+	PreviousScore *float64
+	// PreviousRank is filled by idempotent writes with the rank member had before them, -1 if it did not exist
+	PreviousRank int64
+	// Increase is added by writes to the total increase of member in the current window, when set
+	Increase *Increase
+	// Guard is the stored score the write of member was checked against, when set
+	Guard *ScoreGuard
+}
+
+// Increase is a score increase of a member counted in its total increase within windows of Window.
+// When Max is set, writes fail with ScoreChangedError instead of taking the total increase over it
+type Increase struct {
+	Value  int64
+	Window time.Duration
+	Max    int64
+}
+
+// ScoreGuard is the stored score of a member, nil if it did not exist, that score rules were checked
+// against before writing it. Writes fail with ScoreChangedError if the member score is not Score anymore
+type ScoreGuard struct {
+	Score *float64
+}
+
+// Idempotency identifies a write that is applied only once within Window. Fingerprint is a hash of
+// the write payload, a write reusing Key with another Fingerprint is rejected
+type Idempotency struct {
+	Key         string
+	Fingerprint string
+	Window      time.Duration
+}
+
+// Condition is a struct to be used by conditional writes, nil fields are not checked
+type Condition struct {
+	Score *float64
+	// Tolerance is how far from Score the stored score can be to match it
+	Tolerance float64
+	Version   *int64
+}
+
+// BlockedMember tells how a member is blocked in a leaderboard and in every leaderboard, empty if it is not
+type BlockedMember struct {
+	Mode       string
+	GlobalMode string
+}
+
+// RateLimit is a token bucket that holds up to Burst tokens and is refilled with Rate tokens per second
+type RateLimit struct {
+	Rate  float64
+	Burst int64
+}
+
+// HistorySample is a struct to keep the score and rank of a member sampled when its score was written
+type HistorySample struct {
+	Member    string    `json:"member"`
+	Score     int64     `json:"score"`
+	Rank      int       `json:"rank"`
+	SampledAt time.Time `json:"sampledAt"`
+}
+
+// LedgerEntry is a struct to keep a change of a member score in the leaderboard ledger
+type LedgerEntry struct {
+	Member string `json:"member"`
+	// OldScore is nil if member did not exist before the change
+	OldScore *int64 `json:"oldScore"`
+	// NewScore is nil if member was removed by the change
+	NewScore  *int64    `json:"newScore"`
+	Delta     int64     `json:"delta"`
+	Reason    string    `json:"reason"`
+	Caller    string    `json:"caller"`
+	ChangedAt time.Time `json:"changedAt"`
+}
+
+// RejectedScore is a struct to keep a score submission rejected by leaderboard rules
+type RejectedScore struct {
+	Member        string    `json:"member"`
+	Score         int64     `json:"score"`
+	PreviousScore int64     `json:"previousScore"`
+	Rule          string    `json:"rule"`
+	RejectedAt    time.Time `json:"rejectedAt"`
+}
+
+// ResetProgress is a struct to keep track of a leaderboard reset execution
+type ResetProgress struct {
+	Target     string
+	Strategy   string
+	Status     string
+	Processed  int64
+	Total      int64
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+// League is a struct to keep the configuration of a leagues system
+type League struct {
+	Season          int
+	Tiers           int
+	DivisionSize    int
+	PromotionCount  int
+	RelegationCount int
+	Order           string
+}
+
+// LeagueDivision identifies a division leaderboard inside a league season
+type LeagueDivision struct {
+	Tier     int
+	Division int
+}
+
+// LeagueSeasonResult counts how many members were promoted, relegated or stayed in their tier when a
+// league season ended
+type LeagueSeasonResult struct {
+	Promoted  int `json:"promoted"`
+	Relegated int `json:"relegated"`
+	Stayed    int `json:"stayed"`
+}
+
+// Tournament is a struct to keep the configuration of a tournament
+type Tournament struct {
+	StartAt     time.Time
+	EndAt       time.Time
+	FinalizedAt time.Time
+	Order       string
+	Prizes      []*TournamentPrize
+}
+
+// TournamentPrize is a reward given to members finishing a tournament inside a rank range
+type TournamentPrize struct {
+	FromRank int    `json:"fromRank"`
+	ToRank   int    `json:"toRank"`
+	RewardID string `json:"rewardID"`
 }
@@ -0,0 +1,9 @@
+package database
+
+import "context"
+
+// Decay interface standardize decay database calls
+type Decay interface {
+	GetDecayLeaderboards(ctx context.Context) ([]string, error)
+	RemoveLeaderboardFromDecayList(ctx context.Context, leaderboard string) error
+}
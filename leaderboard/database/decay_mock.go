@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: leaderboard/database/decay.go
+
+// Package database is a generated GoMock package.
+package database
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDecay is a mock of Decay interface.
+type MockDecay struct {
+	ctrl     *gomock.Controller
+	recorder *MockDecayMockRecorder
+}
+
+// MockDecayMockRecorder is the mock recorder for MockDecay.
+type MockDecayMockRecorder struct {
+	mock *MockDecay
+}
+
+// NewMockDecay creates a new mock instance.
+func NewMockDecay(ctrl *gomock.Controller) *MockDecay {
+	mock := &MockDecay{ctrl: ctrl}
+	mock.recorder = &MockDecayMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDecay) EXPECT() *MockDecayMockRecorder {
+	return m.recorder
+}
+
+// GetDecayLeaderboards mocks base method.
+func (m *MockDecay) GetDecayLeaderboards(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDecayLeaderboards", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDecayLeaderboards indicates an expected call of GetDecayLeaderboards.
+func (mr *MockDecayMockRecorder) GetDecayLeaderboards(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDecayLeaderboards", reflect.TypeOf((*MockDecay)(nil).GetDecayLeaderboards), ctx)
+}
+
+// RemoveLeaderboardFromDecayList mocks base method.
+func (m *MockDecay) RemoveLeaderboardFromDecayList(ctx context.Context, leaderboard string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveLeaderboardFromDecayList", ctx, leaderboard)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveLeaderboardFromDecayList indicates an expected call of RemoveLeaderboardFromDecayList.
+func (mr *MockDecayMockRecorder) RemoveLeaderboardFromDecayList(ctx, leaderboard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLeaderboardFromDecayList", reflect.TypeOf((*MockDecay)(nil).RemoveLeaderboardFromDecayList), ctx, leaderboard)
+}
@@ -81,3 +81,126 @@ func NewLeaderboardWithoutMemberToExpireError(leaderboard string) *LeaderboardWi
 func (lwmtee *LeaderboardWithoutMemberToExpireError) Error() string {
 	return fmt.Sprintf("leaderboard %s without member to expire", lwmtee.leaderboard)
 }
+
+// ResetProgressNotFoundError is an error throw when leaderboard was never reset
+type ResetProgressNotFoundError struct {
+	leaderboard string
+}
+
+// NewResetProgressNotFoundError create a new ResetProgressNotFoundError
+func NewResetProgressNotFoundError(leaderboard string) *ResetProgressNotFoundError {
+	return &ResetProgressNotFoundError{
+		leaderboard: leaderboard,
+	}
+}
+
+func (rpnfe *ResetProgressNotFoundError) Error() string {
+	return fmt.Sprintf("reset progress to leaderboard %s not found", rpnfe.leaderboard)
+}
+
+// LeagueNotFoundError is an error throw when league was never created
+type LeagueNotFoundError struct {
+	league string
+}
+
+// NewLeagueNotFoundError create a new LeagueNotFoundError
+func NewLeagueNotFoundError(league string) *LeagueNotFoundError {
+	return &LeagueNotFoundError{
+		league: league,
+	}
+}
+
+func (lnfe *LeagueNotFoundError) Error() string {
+	return fmt.Sprintf("league %s not found", lnfe.league)
+}
+
+// LeagueMemberNotFoundError is an error throw when member did not join league in a season
+type LeagueMemberNotFoundError struct {
+	league string
+	season int
+	member string
+}
+
+// NewLeagueMemberNotFoundError create a new LeagueMemberNotFoundError
+func NewLeagueMemberNotFoundError(league string, season int, member string) *LeagueMemberNotFoundError {
+	return &LeagueMemberNotFoundError{
+		league: league,
+		season: season,
+		member: member,
+	}
+}
+
+func (lmnfe *LeagueMemberNotFoundError) Error() string {
+	return fmt.Sprintf("member %s not found in league %s season %d", lmnfe.member, lmnfe.league, lmnfe.season)
+}
+
+// TournamentNotFoundError is an error throw when tournament was never created
+type TournamentNotFoundError struct {
+	tournament string
+}
+
+// NewTournamentNotFoundError create a new TournamentNotFoundError
+func NewTournamentNotFoundError(tournament string) *TournamentNotFoundError {
+	return &TournamentNotFoundError{
+		tournament: tournament,
+	}
+}
+
+func (tnfe *TournamentNotFoundError) Error() string {
+	return fmt.Sprintf("tournament %s not found", tnfe.tournament)
+}
+
+// IdempotencyKeyReusedError is an error throw when an idempotency key is used by writes with different payloads
+type IdempotencyKeyReusedError struct {
+	leaderboard string
+	key         string
+}
+
+// NewIdempotencyKeyReusedError create a new IdempotencyKeyReusedError
+func NewIdempotencyKeyReusedError(leaderboard, key string) *IdempotencyKeyReusedError {
+	return &IdempotencyKeyReusedError{
+		leaderboard: leaderboard,
+		key:         key,
+	}
+}
+
+func (ikre *IdempotencyKeyReusedError) Error() string {
+	return fmt.Sprintf("idempotency key %s of leaderboard %s was used by a different write", ikre.key, ikre.leaderboard)
+}
+
+// ScoreChangedError is an error throw when the guarded score or total increase of a member written changed
+// since score rules were checked against them
+type ScoreChangedError struct {
+	leaderboard string
+}
+
+// NewScoreChangedError create a new ScoreChangedError
+func NewScoreChangedError(leaderboard string) *ScoreChangedError {
+	return &ScoreChangedError{
+		leaderboard: leaderboard,
+	}
+}
+
+func (sce *ScoreChangedError) Error() string {
+	return fmt.Sprintf("scores written to leaderboard %s changed since they were checked", sce.leaderboard)
+}
+
+// ConditionFailedError is an error throw when a conditional write does not match the stored member
+type ConditionFailedError struct {
+	leaderboard string
+	member      string
+	version     int64
+}
+
+// NewConditionFailedError create a new ConditionFailedError
+func NewConditionFailedError(leaderboard, member string, version int64) *ConditionFailedError {
+	return &ConditionFailedError{
+		leaderboard: leaderboard,
+		member:      member,
+		version:     version,
+	}
+}
+
+func (cfe *ConditionFailedError) Error() string {
+	return fmt.Sprintf("member %s of leaderboard %s does not match condition, current version is %d", cfe.member, cfe.leaderboard, cfe.version)
+}
@@ -7,7 +7,9 @@ import (
 
 // Expiration interface standardize expiration database calls
 type Expiration interface {
+	AckExpiredLeaderboards(ctx context.Context, leaderboards ...string) error
 	GetExpirationLeaderboards(ctx context.Context) ([]string, error)
+	GetExpiredLeaderboards(ctx context.Context, maxTime time.Time, amount int) ([]string, error)
 	GetMembersToExpire(ctx context.Context, leaderboard string, amount int, maxTime time.Time) ([]string, error)
 	RemoveLeaderboardFromExpireList(ctx context.Context, leaderboard string) error
 	ExpireMembers(ctx context.Context, leaderboard string, members []string) error
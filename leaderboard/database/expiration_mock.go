@@ -35,6 +35,25 @@ func (m *MockExpiration) EXPECT() *MockExpirationMockRecorder {
 	return m.recorder
 }
 
+// AckExpiredLeaderboards mocks base method.
+func (m *MockExpiration) AckExpiredLeaderboards(ctx context.Context, leaderboards ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range leaderboards {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AckExpiredLeaderboards", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AckExpiredLeaderboards indicates an expected call of AckExpiredLeaderboards.
+func (mr *MockExpirationMockRecorder) AckExpiredLeaderboards(ctx interface{}, leaderboards ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, leaderboards...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AckExpiredLeaderboards", reflect.TypeOf((*MockExpiration)(nil).AckExpiredLeaderboards), varargs...)
+}
+
 // ExpireMembers mocks base method.
 func (m *MockExpiration) ExpireMembers(ctx context.Context, leaderboard string, members []string) error {
 	m.ctrl.T.Helper()
@@ -49,19 +68,34 @@ func (mr *MockExpirationMockRecorder) ExpireMembers(ctx, leaderboard, members in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireMembers", reflect.TypeOf((*MockExpiration)(nil).ExpireMembers), ctx, leaderboard, members)
 }
 
-// GetEpirationLeaderboards mocks base method.
-func (m *MockExpiration) GetEpirationLeaderboards(ctx context.Context) ([]string, error) {
+// GetExpirationLeaderboards mocks base method.
+func (m *MockExpiration) GetExpirationLeaderboards(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpirationLeaderboards", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpirationLeaderboards indicates an expected call of GetExpirationLeaderboards.
+func (mr *MockExpirationMockRecorder) GetExpirationLeaderboards(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpirationLeaderboards", reflect.TypeOf((*MockExpiration)(nil).GetExpirationLeaderboards), ctx)
+}
+
+// GetExpiredLeaderboards mocks base method.
+func (m *MockExpiration) GetExpiredLeaderboards(ctx context.Context, maxTime time.Time, amount int) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEpirationLeaderboards", ctx)
+	ret := m.ctrl.Call(m, "GetExpiredLeaderboards", ctx, maxTime, amount)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetEpirationLeaderboards indicates an expected call of GetEpirationLeaderboards.
-func (mr *MockExpirationMockRecorder) GetEpirationLeaderboards(ctx interface{}) *gomock.Call {
+// GetExpiredLeaderboards indicates an expected call of GetExpiredLeaderboards.
+func (mr *MockExpirationMockRecorder) GetExpiredLeaderboards(ctx, maxTime, amount interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEpirationLeaderboards", reflect.TypeOf((*MockExpiration)(nil).GetEpirationLeaderboards), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredLeaderboards", reflect.TypeOf((*MockExpiration)(nil).GetExpiredLeaderboards), ctx, maxTime, amount)
 }
 
 // GetMembersToExpire mocks base method.
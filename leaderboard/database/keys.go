@@ -0,0 +1,52 @@
+package database
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LeaderboardKey return the key of the data named suffix of leaderboard, like "{leaderboard}:versions".
+// Redis cluster places a key in the slot of the hash tag between its first braces, or of the whole key when
+// it has none, so the keys returned are in the slot of leaderboard and can be used with it in a script or a
+// RENAME. A leaderboard named with a hash tag, like "{ladder}:season1", already shares it with its keys.
+// Note: on redis cluster a leaderboard with braces that are not a hash tag, like "a{}b", is not supported
+func LeaderboardKey(leaderboard, suffix string) string {
+	if hasHashTag(leaderboard) {
+		return fmt.Sprintf("%s:%s", leaderboard, suffix)
+	}
+
+	return fmt.Sprintf("{%s}:%s", leaderboard, suffix)
+}
+
+// hasHashTag tells if key has a hash tag, a text that is not empty between its first "{" and the next "}"
+func hasHashTag(key string) bool {
+	start := strings.Index(key, "{")
+	if start < 0 {
+		return false
+	}
+
+	return strings.Index(key[start+1:], "}") > 0
+}
+
+// hashSlotKey return the part of key redis hashes to find its slot, its hash tag or the whole key when it has none
+func hashSlotKey(key string) string {
+	if !hasHashTag(key) {
+		return key
+	}
+
+	start := strings.Index(key, "{")
+	end := start + 1 + strings.Index(key[start+1:], "}")
+	return key[start+1 : end]
+}
+
+// leaderboardOfVersionsKey return the leaderboard whose members versions are key, the versions of its
+// shadow leaderboard included. A leaderboard named only with a hash tag, like "{leaderboard}", has the
+// same keys as the leaderboard without braces, which is returned
+func leaderboardOfVersionsKey(key string) string {
+	leaderboard := strings.TrimSuffix(strings.TrimSuffix(key, ":versions"), ":shadow")
+	if strings.HasPrefix(leaderboard, "{") && strings.Index(leaderboard, "}") == len(leaderboard)-1 && len(leaderboard) > 2 {
+		return leaderboard[1 : len(leaderboard)-1]
+	}
+
+	return leaderboard
+}
@@ -0,0 +1,24 @@
+package database_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+)
+
+var _ = Describe("Leaderboard keys", func() {
+	Describe("LeaderboardKey", func() {
+		It("Should hash tag leaderboard", func() {
+			Expect(database.LeaderboardKey("leaderboard", "versions")).To(Equal("{leaderboard}:versions"))
+		})
+
+		It("Should keep the hash tag of leaderboard", func() {
+			Expect(database.LeaderboardKey("{ladder}:season1", "versions")).To(Equal("{ladder}:season1:versions"))
+		})
+
+		It("Should keep the hash tag of a key of leaderboard", func() {
+			key := database.LeaderboardKey("leaderboard", "reset:tmp")
+			Expect(database.LeaderboardKey(key, "versions")).To(Equal("{leaderboard}:reset:tmp:versions"))
+		})
+	})
+})
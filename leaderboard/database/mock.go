@@ -35,6 +35,300 @@ func (m *MockDatabase) EXPECT() *MockDatabaseMockRecorder {
 	return m.recorder
 }
 
+// AddHistorySamples mocks base method.
+func (m *MockDatabase) AddHistorySamples(ctx context.Context, leaderboard string, samples []*HistorySample, interval time.Duration, expireAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddHistorySamples", ctx, leaderboard, samples, interval, expireAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddHistorySamples indicates an expected call of AddHistorySamples.
+func (mr *MockDatabaseMockRecorder) AddHistorySamples(ctx, leaderboard, samples, interval, expireAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHistorySamples", reflect.TypeOf((*MockDatabase)(nil).AddHistorySamples), ctx, leaderboard, samples, interval, expireAt)
+}
+
+// AddLeaderboardParticipants mocks base method.
+func (m *MockDatabase) AddLeaderboardParticipants(ctx context.Context, leaderboard string, joinedAt time.Time, members ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, leaderboard, joinedAt}
+	for _, a := range members {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddLeaderboardParticipants", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddLeaderboardParticipants indicates an expected call of AddLeaderboardParticipants.
+func (mr *MockDatabaseMockRecorder) AddLeaderboardParticipants(ctx, leaderboard, joinedAt interface{}, members ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, leaderboard, joinedAt}, members...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLeaderboardParticipants", reflect.TypeOf((*MockDatabase)(nil).AddLeaderboardParticipants), varargs...)
+}
+
+// AddLeaderboardToDecayList mocks base method.
+func (m *MockDatabase) AddLeaderboardToDecayList(ctx context.Context, leaderboard string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddLeaderboardToDecayList", ctx, leaderboard)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddLeaderboardToDecayList indicates an expected call of AddLeaderboardToDecayList.
+func (mr *MockDatabaseMockRecorder) AddLeaderboardToDecayList(ctx, leaderboard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLeaderboardToDecayList", reflect.TypeOf((*MockDatabase)(nil).AddLeaderboardToDecayList), ctx, leaderboard)
+}
+
+// AddLeaderboardToSnapshotList mocks base method.
+func (m *MockDatabase) AddLeaderboardToSnapshotList(ctx context.Context, leaderboard string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddLeaderboardToSnapshotList", ctx, leaderboard)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddLeaderboardToSnapshotList indicates an expected call of AddLeaderboardToSnapshotList.
+func (mr *MockDatabaseMockRecorder) AddLeaderboardToSnapshotList(ctx, leaderboard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLeaderboardToSnapshotList", reflect.TypeOf((*MockDatabase)(nil).AddLeaderboardToSnapshotList), ctx, leaderboard)
+}
+
+// AddLedgerEntries mocks base method.
+func (m *MockDatabase) AddLedgerEntries(ctx context.Context, leaderboard string, entries []*LedgerEntry, removeBefore, expireAt time.Time, maxEntries int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddLedgerEntries", ctx, leaderboard, entries, removeBefore, expireAt, maxEntries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddLedgerEntries indicates an expected call of AddLedgerEntries.
+func (mr *MockDatabaseMockRecorder) AddLedgerEntries(ctx, leaderboard, entries, removeBefore, expireAt, maxEntries interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLedgerEntries", reflect.TypeOf((*MockDatabase)(nil).AddLedgerEntries), ctx, leaderboard, entries, removeBefore, expireAt, maxEntries)
+}
+
+// AddNonce mocks base method.
+func (m *MockDatabase) AddNonce(ctx context.Context, leaderboard, nonce string, expiration time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNonce", ctx, leaderboard, nonce, expiration)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddNonce indicates an expected call of AddNonce.
+func (mr *MockDatabaseMockRecorder) AddNonce(ctx, leaderboard, nonce, expiration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNonce", reflect.TypeOf((*MockDatabase)(nil).AddNonce), ctx, leaderboard, nonce, expiration)
+}
+
+// AddRankSnapshot mocks base method.
+func (m *MockDatabase) AddRankSnapshot(ctx context.Context, leaderboard string, weight float64, takenAt, removeBefore, expireAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRankSnapshot", ctx, leaderboard, weight, takenAt, removeBefore, expireAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRankSnapshot indicates an expected call of AddRankSnapshot.
+func (mr *MockDatabaseMockRecorder) AddRankSnapshot(ctx, leaderboard, weight, takenAt, removeBefore, expireAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRankSnapshot", reflect.TypeOf((*MockDatabase)(nil).AddRankSnapshot), ctx, leaderboard, weight, takenAt, removeBefore, expireAt)
+}
+
+// AddRejectedScore mocks base method.
+func (m *MockDatabase) AddRejectedScore(ctx context.Context, leaderboard string, rejectedScore *RejectedScore) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRejectedScore", ctx, leaderboard, rejectedScore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRejectedScore indicates an expected call of AddRejectedScore.
+func (mr *MockDatabaseMockRecorder) AddRejectedScore(ctx, leaderboard, rejectedScore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRejectedScore", reflect.TypeOf((*MockDatabase)(nil).AddRejectedScore), ctx, leaderboard, rejectedScore)
+}
+
+// AddWebhookDeadLetter mocks base method.
+func (m *MockDatabase) AddWebhookDeadLetter(ctx context.Context, deadLetter *WebhookDeadLetter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWebhookDeadLetter", ctx, deadLetter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddWebhookDeadLetter indicates an expected call of AddWebhookDeadLetter.
+func (mr *MockDatabaseMockRecorder) AddWebhookDeadLetter(ctx, deadLetter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWebhookDeadLetter", reflect.TypeOf((*MockDatabase)(nil).AddWebhookDeadLetter), ctx, deadLetter)
+}
+
+// AddWebhookDelivery mocks base method.
+func (m *MockDatabase) AddWebhookDelivery(ctx context.Context, delivery *WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWebhookDelivery", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddWebhookDelivery indicates an expected call of AddWebhookDelivery.
+func (mr *MockDatabaseMockRecorder) AddWebhookDelivery(ctx, delivery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWebhookDelivery", reflect.TypeOf((*MockDatabase)(nil).AddWebhookDelivery), ctx, delivery)
+}
+
+// ApplyGlobalBlock mocks base method.
+func (m *MockDatabase) ApplyGlobalBlock(ctx context.Context, leaderboard, mode string, members ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, leaderboard, mode}
+	for _, a := range members {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ApplyGlobalBlock", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyGlobalBlock indicates an expected call of ApplyGlobalBlock.
+func (mr *MockDatabaseMockRecorder) ApplyGlobalBlock(ctx, leaderboard, mode interface{}, members ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, leaderboard, mode}, members...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyGlobalBlock", reflect.TypeOf((*MockDatabase)(nil).ApplyGlobalBlock), varargs...)
+}
+
+// BlockMembers mocks base method.
+func (m *MockDatabase) BlockMembers(ctx context.Context, leaderboard, mode string, members ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, leaderboard, mode}
+	for _, a := range members {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BlockMembers", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockMembers indicates an expected call of BlockMembers.
+func (mr *MockDatabaseMockRecorder) BlockMembers(ctx, leaderboard, mode interface{}, members ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, leaderboard, mode}, members...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockMembers", reflect.TypeOf((*MockDatabase)(nil).BlockMembers), varargs...)
+}
+
+// CreateExportCopy mocks base method.
+func (m *MockDatabase) CreateExportCopy(ctx context.Context, leaderboard, export string, expireAt time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExportCopy", ctx, leaderboard, export, expireAt)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExportCopy indicates an expected call of CreateExportCopy.
+func (mr *MockDatabaseMockRecorder) CreateExportCopy(ctx, leaderboard, export, expireAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExportCopy", reflect.TypeOf((*MockDatabase)(nil).CreateExportCopy), ctx, leaderboard, export, expireAt)
+}
+
+// CreateTournament mocks base method.
+func (m *MockDatabase) CreateTournament(ctx context.Context, tournament string, config *Tournament) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTournament", ctx, tournament, config)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTournament indicates an expected call of CreateTournament.
+func (mr *MockDatabaseMockRecorder) CreateTournament(ctx, tournament, config interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTournament", reflect.TypeOf((*MockDatabase)(nil).CreateTournament), ctx, tournament, config)
+}
+
+// EndLeagueSeason mocks base method.
+func (m *MockDatabase) EndLeagueSeason(ctx context.Context, league string, season int, result *LeagueSeasonResult) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndLeagueSeason", ctx, league, season, result)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EndLeagueSeason indicates an expected call of EndLeagueSeason.
+func (mr *MockDatabaseMockRecorder) EndLeagueSeason(ctx, league, season, result interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndLeagueSeason", reflect.TypeOf((*MockDatabase)(nil).EndLeagueSeason), ctx, league, season, result)
+}
+
+// GetBlockedMembers mocks base method.
+func (m *MockDatabase) GetBlockedMembers(ctx context.Context, leaderboard string, members ...string) ([]*BlockedMember, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, leaderboard}
+	for _, a := range members {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBlockedMembers", varargs...)
+	ret0, _ := ret[0].([]*BlockedMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedMembers indicates an expected call of GetBlockedMembers.
+func (mr *MockDatabaseMockRecorder) GetBlockedMembers(ctx, leaderboard interface{}, members ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, leaderboard}, members...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedMembers", reflect.TypeOf((*MockDatabase)(nil).GetBlockedMembers), varargs...)
+}
+
+// GetExportMembers mocks base method.
+func (m *MockDatabase) GetExportMembers(ctx context.Context, leaderboard, export string, start, stop int, order string) ([]*Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExportMembers", ctx, leaderboard, export, start, stop, order)
+	ret0, _ := ret[0].([]*Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExportMembers indicates an expected call of GetExportMembers.
+func (mr *MockDatabaseMockRecorder) GetExportMembers(ctx, leaderboard, export, start, stop, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExportMembers", reflect.TypeOf((*MockDatabase)(nil).GetExportMembers), ctx, leaderboard, export, start, stop, order)
+}
+
+// GetHistorySamples mocks base method.
+func (m *MockDatabase) GetHistorySamples(ctx context.Context, leaderboard, member string, from, to time.Time) ([]*HistorySample, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistorySamples", ctx, leaderboard, member, from, to)
+	ret0, _ := ret[0].([]*HistorySample)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistorySamples indicates an expected call of GetHistorySamples.
+func (mr *MockDatabaseMockRecorder) GetHistorySamples(ctx, leaderboard, member, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistorySamples", reflect.TypeOf((*MockDatabase)(nil).GetHistorySamples), ctx, leaderboard, member, from, to)
+}
+
+// GetIdempotentWrite mocks base method.
+func (m *MockDatabase) GetIdempotentWrite(ctx context.Context, leaderboard string, databaseMembers []*Member, idempotency *Idempotency) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdempotentWrite", ctx, leaderboard, databaseMembers, idempotency)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIdempotentWrite indicates an expected call of GetIdempotentWrite.
+func (mr *MockDatabaseMockRecorder) GetIdempotentWrite(ctx, leaderboard, databaseMembers, idempotency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdempotentWrite", reflect.TypeOf((*MockDatabase)(nil).GetIdempotentWrite), ctx, leaderboard, databaseMembers, idempotency)
+}
+
 // GetLeaderboardExpiration mocks base method.
 func (m *MockDatabase) GetLeaderboardExpiration(ctx context.Context, leaderboard string) (int64, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +344,116 @@ func (mr *MockDatabaseMockRecorder) GetLeaderboardExpiration(ctx, leaderboard in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderboardExpiration", reflect.TypeOf((*MockDatabase)(nil).GetLeaderboardExpiration), ctx, leaderboard)
 }
 
+// GetLeaderboardNonParticipants mocks base method.
+func (m *MockDatabase) GetLeaderboardNonParticipants(ctx context.Context, leaderboard string, members ...string) ([]string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, leaderboard}
+	for _, a := range members {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLeaderboardNonParticipants", varargs...)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeaderboardNonParticipants indicates an expected call of GetLeaderboardNonParticipants.
+func (mr *MockDatabaseMockRecorder) GetLeaderboardNonParticipants(ctx, leaderboard interface{}, members ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, leaderboard}, members...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderboardNonParticipants", reflect.TypeOf((*MockDatabase)(nil).GetLeaderboardNonParticipants), varargs...)
+}
+
+// GetLeaderboardSettings mocks base method.
+func (m *MockDatabase) GetLeaderboardSettings(ctx context.Context, leaderboard string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaderboardSettings", ctx, leaderboard)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeaderboardSettings indicates an expected call of GetLeaderboardSettings.
+func (mr *MockDatabaseMockRecorder) GetLeaderboardSettings(ctx, leaderboard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderboardSettings", reflect.TypeOf((*MockDatabase)(nil).GetLeaderboardSettings), ctx, leaderboard)
+}
+
+// GetLeague mocks base method.
+func (m *MockDatabase) GetLeague(ctx context.Context, league string) (*League, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeague", ctx, league)
+	ret0, _ := ret[0].(*League)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeague indicates an expected call of GetLeague.
+func (mr *MockDatabaseMockRecorder) GetLeague(ctx, league interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeague", reflect.TypeOf((*MockDatabase)(nil).GetLeague), ctx, league)
+}
+
+// GetLeagueDivisionCounts mocks base method.
+func (m *MockDatabase) GetLeagueDivisionCounts(ctx context.Context, league string, season int) (map[int]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeagueDivisionCounts", ctx, league, season)
+	ret0, _ := ret[0].(map[int]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeagueDivisionCounts indicates an expected call of GetLeagueDivisionCounts.
+func (mr *MockDatabaseMockRecorder) GetLeagueDivisionCounts(ctx, league, season interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeagueDivisionCounts", reflect.TypeOf((*MockDatabase)(nil).GetLeagueDivisionCounts), ctx, league, season)
+}
+
+// GetLeagueMemberDivision mocks base method.
+func (m *MockDatabase) GetLeagueMemberDivision(ctx context.Context, league string, season int, member string) (*LeagueDivision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeagueMemberDivision", ctx, league, season, member)
+	ret0, _ := ret[0].(*LeagueDivision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeagueMemberDivision indicates an expected call of GetLeagueMemberDivision.
+func (mr *MockDatabaseMockRecorder) GetLeagueMemberDivision(ctx, league, season, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeagueMemberDivision", reflect.TypeOf((*MockDatabase)(nil).GetLeagueMemberDivision), ctx, league, season, member)
+}
+
+// GetLeagueSeasonResult mocks base method.
+func (m *MockDatabase) GetLeagueSeasonResult(ctx context.Context, league string, season int) (*LeagueSeasonResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeagueSeasonResult", ctx, league, season)
+	ret0, _ := ret[0].(*LeagueSeasonResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeagueSeasonResult indicates an expected call of GetLeagueSeasonResult.
+func (mr *MockDatabaseMockRecorder) GetLeagueSeasonResult(ctx, league, season interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeagueSeasonResult", reflect.TypeOf((*MockDatabase)(nil).GetLeagueSeasonResult), ctx, league, season)
+}
+
+// GetLedgerEntries mocks base method.
+func (m *MockDatabase) GetLedgerEntries(ctx context.Context, leaderboard, member string, since time.Time, offset, count int) ([]*LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLedgerEntries", ctx, leaderboard, member, since, offset, count)
+	ret0, _ := ret[0].([]*LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLedgerEntries indicates an expected call of GetLedgerEntries.
+func (mr *MockDatabaseMockRecorder) GetLedgerEntries(ctx, leaderboard, member, since, offset, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLedgerEntries", reflect.TypeOf((*MockDatabase)(nil).GetLedgerEntries), ctx, leaderboard, member, since, offset, count)
+}
+
 // GetMemberIDsWithScoreInsideRange mocks base method.
 func (m *MockDatabase) GetMemberIDsWithScoreInsideRange(ctx context.Context, leaderboard, min, max string, offset, count int) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -85,6 +489,46 @@ func (mr *MockDatabaseMockRecorder) GetMembers(ctx, leaderboard, order, includeT
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembers", reflect.TypeOf((*MockDatabase)(nil).GetMembers), varargs...)
 }
 
+// GetMembersByID mocks base method.
+func (m *MockDatabase) GetMembersByID(ctx context.Context, leaderboard, order string, members ...string) ([]*Member, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, leaderboard, order}
+	for _, a := range members {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMembersByID", varargs...)
+	ret0, _ := ret[0].([]*Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMembersByID indicates an expected call of GetMembersByID.
+func (mr *MockDatabaseMockRecorder) GetMembersByID(ctx, leaderboard, order interface{}, members ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, leaderboard, order}, members...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembersByID", reflect.TypeOf((*MockDatabase)(nil).GetMembersByID), varargs...)
+}
+
+// GetMembersIncreases mocks base method.
+func (m *MockDatabase) GetMembersIncreases(ctx context.Context, leaderboard string, window time.Duration, members ...string) ([]int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, leaderboard, window}
+	for _, a := range members {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMembersIncreases", varargs...)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMembersIncreases indicates an expected call of GetMembersIncreases.
+func (mr *MockDatabaseMockRecorder) GetMembersIncreases(ctx, leaderboard, window interface{}, members ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, leaderboard, window}, members...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembersIncreases", reflect.TypeOf((*MockDatabase)(nil).GetMembersIncreases), varargs...)
+}
+
 // GetOrderedMembers mocks base method.
 func (m *MockDatabase) GetOrderedMembers(ctx context.Context, leaderboard string, start, stop int, order string) ([]*Member, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +559,101 @@ func (mr *MockDatabaseMockRecorder) GetRank(ctx, leaderboard, member, order inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRank", reflect.TypeOf((*MockDatabase)(nil).GetRank), ctx, leaderboard, member, order)
 }
 
+// GetRankSnapshotMembers mocks base method.
+func (m *MockDatabase) GetRankSnapshotMembers(ctx context.Context, leaderboard string, takenAt time.Time, start, stop int, order string) ([]*Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRankSnapshotMembers", ctx, leaderboard, takenAt, start, stop, order)
+	ret0, _ := ret[0].([]*Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRankSnapshotMembers indicates an expected call of GetRankSnapshotMembers.
+func (mr *MockDatabaseMockRecorder) GetRankSnapshotMembers(ctx, leaderboard, takenAt, start, stop, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRankSnapshotMembers", reflect.TypeOf((*MockDatabase)(nil).GetRankSnapshotMembers), ctx, leaderboard, takenAt, start, stop, order)
+}
+
+// GetRankSnapshotMembersByID mocks base method.
+func (m *MockDatabase) GetRankSnapshotMembersByID(ctx context.Context, leaderboard string, takenAt time.Time, order string, members ...string) ([]*Member, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, leaderboard, takenAt, order}
+	for _, a := range members {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRankSnapshotMembersByID", varargs...)
+	ret0, _ := ret[0].([]*Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRankSnapshotMembersByID indicates an expected call of GetRankSnapshotMembersByID.
+func (mr *MockDatabaseMockRecorder) GetRankSnapshotMembersByID(ctx, leaderboard, takenAt, order interface{}, members ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, leaderboard, takenAt, order}, members...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRankSnapshotMembersByID", reflect.TypeOf((*MockDatabase)(nil).GetRankSnapshotMembersByID), varargs...)
+}
+
+// GetRankSnapshotTime mocks base method.
+func (m *MockDatabase) GetRankSnapshotTime(ctx context.Context, leaderboard string, at time.Time) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRankSnapshotTime", ctx, leaderboard, at)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRankSnapshotTime indicates an expected call of GetRankSnapshotTime.
+func (mr *MockDatabaseMockRecorder) GetRankSnapshotTime(ctx, leaderboard, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRankSnapshotTime", reflect.TypeOf((*MockDatabase)(nil).GetRankSnapshotTime), ctx, leaderboard, at)
+}
+
+// GetRejectedScores mocks base method.
+func (m *MockDatabase) GetRejectedScores(ctx context.Context, leaderboard string, start, stop int) ([]*RejectedScore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRejectedScores", ctx, leaderboard, start, stop)
+	ret0, _ := ret[0].([]*RejectedScore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRejectedScores indicates an expected call of GetRejectedScores.
+func (mr *MockDatabaseMockRecorder) GetRejectedScores(ctx, leaderboard, start, stop interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRejectedScores", reflect.TypeOf((*MockDatabase)(nil).GetRejectedScores), ctx, leaderboard, start, stop)
+}
+
+// GetResetProgress mocks base method.
+func (m *MockDatabase) GetResetProgress(ctx context.Context, leaderboard string) (*ResetProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResetProgress", ctx, leaderboard)
+	ret0, _ := ret[0].(*ResetProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResetProgress indicates an expected call of GetResetProgress.
+func (mr *MockDatabaseMockRecorder) GetResetProgress(ctx, leaderboard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResetProgress", reflect.TypeOf((*MockDatabase)(nil).GetResetProgress), ctx, leaderboard)
+}
+
+// GetShadowMember mocks base method.
+func (m *MockDatabase) GetShadowMember(ctx context.Context, leaderboard, member, order string) (*Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShadowMember", ctx, leaderboard, member, order)
+	ret0, _ := ret[0].(*Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShadowMember indicates an expected call of GetShadowMember.
+func (mr *MockDatabaseMockRecorder) GetShadowMember(ctx, leaderboard, member, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShadowMember", reflect.TypeOf((*MockDatabase)(nil).GetShadowMember), ctx, leaderboard, member, order)
+}
+
 // GetTotalMembers mocks base method.
 func (m *MockDatabase) GetTotalMembers(ctx context.Context, leaderboard string) (int, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +669,36 @@ func (mr *MockDatabaseMockRecorder) GetTotalMembers(ctx, leaderboard interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalMembers", reflect.TypeOf((*MockDatabase)(nil).GetTotalMembers), ctx, leaderboard)
 }
 
+// GetTournament mocks base method.
+func (m *MockDatabase) GetTournament(ctx context.Context, tournament string) (*Tournament, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTournament", ctx, tournament)
+	ret0, _ := ret[0].(*Tournament)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTournament indicates an expected call of GetTournament.
+func (mr *MockDatabaseMockRecorder) GetTournament(ctx, tournament interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTournament", reflect.TypeOf((*MockDatabase)(nil).GetTournament), ctx, tournament)
+}
+
+// GetWebhookDeliveries mocks base method.
+func (m *MockDatabase) GetWebhookDeliveries(ctx context.Context, start, stop int) ([]*WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookDeliveries", ctx, start, stop)
+	ret0, _ := ret[0].([]*WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookDeliveries indicates an expected call of GetWebhookDeliveries.
+func (mr *MockDatabaseMockRecorder) GetWebhookDeliveries(ctx, start, stop interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDeliveries", reflect.TypeOf((*MockDatabase)(nil).GetWebhookDeliveries), ctx, start, stop)
+}
+
 // Healthcheck mocks base method.
 func (m *MockDatabase) Healthcheck(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -144,18 +713,115 @@ func (mr *MockDatabaseMockRecorder) Healthcheck(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Healthcheck", reflect.TypeOf((*MockDatabase)(nil).Healthcheck), ctx)
 }
 
+// ImportMembers mocks base method.
+func (m *MockDatabase) ImportMembers(ctx context.Context, leaderboard, target string, batches ...[]*Member) []error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, leaderboard, target}
+	for _, a := range batches {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportMembers", varargs...)
+	ret0, _ := ret[0].([]error)
+	return ret0
+}
+
+// ImportMembers indicates an expected call of ImportMembers.
+func (mr *MockDatabaseMockRecorder) ImportMembers(ctx, leaderboard, target interface{}, batches ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, leaderboard, target}, batches...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportMembers", reflect.TypeOf((*MockDatabase)(nil).ImportMembers), varargs...)
+}
+
 // IncrementMemberScore mocks base method.
-func (m *MockDatabase) IncrementMemberScore(ctx context.Context, leaderboard, member string, increment float64) error {
+func (m *MockDatabase) IncrementMemberScore(ctx context.Context, leaderboard string, databaseMember *Member, increment float64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IncrementMemberScore", ctx, leaderboard, member, increment)
+	ret := m.ctrl.Call(m, "IncrementMemberScore", ctx, leaderboard, databaseMember, increment)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // IncrementMemberScore indicates an expected call of IncrementMemberScore.
-func (mr *MockDatabaseMockRecorder) IncrementMemberScore(ctx, leaderboard, member, increment interface{}) *gomock.Call {
+func (mr *MockDatabaseMockRecorder) IncrementMemberScore(ctx, leaderboard, databaseMember, increment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementMemberScore", reflect.TypeOf((*MockDatabase)(nil).IncrementMemberScore), ctx, leaderboard, databaseMember, increment)
+}
+
+// IncrementMemberScoreIdempotent mocks base method.
+func (m *MockDatabase) IncrementMemberScoreIdempotent(ctx context.Context, leaderboard string, databaseMember *Member, increment float64, idempotency *Idempotency) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementMemberScoreIdempotent", ctx, leaderboard, databaseMember, increment, idempotency)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementMemberScoreIdempotent indicates an expected call of IncrementMemberScoreIdempotent.
+func (mr *MockDatabaseMockRecorder) IncrementMemberScoreIdempotent(ctx, leaderboard, databaseMember, increment, idempotency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementMemberScoreIdempotent", reflect.TypeOf((*MockDatabase)(nil).IncrementMemberScoreIdempotent), ctx, leaderboard, databaseMember, increment, idempotency)
+}
+
+// JoinLeagueDivisions mocks base method.
+func (m *MockDatabase) JoinLeagueDivisions(ctx context.Context, league string, season, tier, divisionSize int, members ...string) ([]*LeagueDivision, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, league, season, tier, divisionSize}
+	for _, a := range members {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "JoinLeagueDivisions", varargs...)
+	ret0, _ := ret[0].([]*LeagueDivision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinLeagueDivisions indicates an expected call of JoinLeagueDivisions.
+func (mr *MockDatabaseMockRecorder) JoinLeagueDivisions(ctx, league, season, tier, divisionSize interface{}, members ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, league, season, tier, divisionSize}, members...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinLeagueDivisions", reflect.TypeOf((*MockDatabase)(nil).JoinLeagueDivisions), varargs...)
+}
+
+// MarkLeaderboardCreated mocks base method.
+func (m *MockDatabase) MarkLeaderboardCreated(ctx context.Context, leaderboard string, expireAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkLeaderboardCreated", ctx, leaderboard, expireAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkLeaderboardCreated indicates an expected call of MarkLeaderboardCreated.
+func (mr *MockDatabaseMockRecorder) MarkLeaderboardCreated(ctx, leaderboard, expireAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkLeaderboardCreated", reflect.TypeOf((*MockDatabase)(nil).MarkLeaderboardCreated), ctx, leaderboard, expireAt)
+}
+
+// QueueGlobalBlock mocks base method.
+func (m *MockDatabase) QueueGlobalBlock(ctx context.Context, member string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueGlobalBlock", ctx, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// QueueGlobalBlock indicates an expected call of QueueGlobalBlock.
+func (mr *MockDatabaseMockRecorder) QueueGlobalBlock(ctx, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueGlobalBlock", reflect.TypeOf((*MockDatabase)(nil).QueueGlobalBlock), ctx, member)
+}
+
+// RemoveExportCopy mocks base method.
+func (m *MockDatabase) RemoveExportCopy(ctx context.Context, leaderboard, export string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveExportCopy", ctx, leaderboard, export)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveExportCopy indicates an expected call of RemoveExportCopy.
+func (mr *MockDatabaseMockRecorder) RemoveExportCopy(ctx, leaderboard, export interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementMemberScore", reflect.TypeOf((*MockDatabase)(nil).IncrementMemberScore), ctx, leaderboard, member, increment)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveExportCopy", reflect.TypeOf((*MockDatabase)(nil).RemoveExportCopy), ctx, leaderboard, export)
 }
 
 // RemoveLeaderboard mocks base method.
@@ -191,6 +857,64 @@ func (mr *MockDatabaseMockRecorder) RemoveMembers(ctx, leaderboard interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMembers", reflect.TypeOf((*MockDatabase)(nil).RemoveMembers), varargs...)
 }
 
+// RenameLeaderboard mocks base method.
+func (m *MockDatabase) RenameLeaderboard(ctx context.Context, leaderboard, newLeaderboard string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameLeaderboard", ctx, leaderboard, newLeaderboard)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameLeaderboard indicates an expected call of RenameLeaderboard.
+func (mr *MockDatabaseMockRecorder) RenameLeaderboard(ctx, leaderboard, newLeaderboard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameLeaderboard", reflect.TypeOf((*MockDatabase)(nil).RenameLeaderboard), ctx, leaderboard, newLeaderboard)
+}
+
+// ScaleLeaderboard mocks base method.
+func (m *MockDatabase) ScaleLeaderboard(ctx context.Context, leaderboard string, factor float64, settings map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScaleLeaderboard", ctx, leaderboard, factor, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScaleLeaderboard indicates an expected call of ScaleLeaderboard.
+func (mr *MockDatabaseMockRecorder) ScaleLeaderboard(ctx, leaderboard, factor, settings interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleLeaderboard", reflect.TypeOf((*MockDatabase)(nil).ScaleLeaderboard), ctx, leaderboard, factor, settings)
+}
+
+// ScanLeaderboards mocks base method.
+func (m *MockDatabase) ScanLeaderboards(ctx context.Context, fn func([]string) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScanLeaderboards", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScanLeaderboards indicates an expected call of ScanLeaderboards.
+func (mr *MockDatabaseMockRecorder) ScanLeaderboards(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanLeaderboards", reflect.TypeOf((*MockDatabase)(nil).ScanLeaderboards), ctx, fn)
+}
+
+// ScanMembers mocks base method.
+func (m *MockDatabase) ScanMembers(ctx context.Context, leaderboard string, cursor uint64, count int) ([]*Member, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScanMembers", ctx, leaderboard, cursor, count)
+	ret0, _ := ret[0].([]*Member)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ScanMembers indicates an expected call of ScanMembers.
+func (mr *MockDatabaseMockRecorder) ScanMembers(ctx, leaderboard, cursor, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanMembers", reflect.TypeOf((*MockDatabase)(nil).ScanMembers), ctx, leaderboard, cursor, count)
+}
+
 // SetLeaderboardExpiration mocks base method.
 func (m *MockDatabase) SetLeaderboardExpiration(ctx context.Context, leaderboard string, expireAt time.Time) error {
 	m.ctrl.T.Helper()
@@ -205,6 +929,63 @@ func (mr *MockDatabaseMockRecorder) SetLeaderboardExpiration(ctx, leaderboard, e
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLeaderboardExpiration", reflect.TypeOf((*MockDatabase)(nil).SetLeaderboardExpiration), ctx, leaderboard, expireAt)
 }
 
+// SetLeaderboardSettings mocks base method.
+func (m *MockDatabase) SetLeaderboardSettings(ctx context.Context, leaderboard string, settings map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLeaderboardSettings", ctx, leaderboard, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLeaderboardSettings indicates an expected call of SetLeaderboardSettings.
+func (mr *MockDatabaseMockRecorder) SetLeaderboardSettings(ctx, leaderboard, settings interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLeaderboardSettings", reflect.TypeOf((*MockDatabase)(nil).SetLeaderboardSettings), ctx, leaderboard, settings)
+}
+
+// SetLeague mocks base method.
+func (m *MockDatabase) SetLeague(ctx context.Context, league string, config *League) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLeague", ctx, league, config)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLeague indicates an expected call of SetLeague.
+func (mr *MockDatabaseMockRecorder) SetLeague(ctx, league, config interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLeague", reflect.TypeOf((*MockDatabase)(nil).SetLeague), ctx, league, config)
+}
+
+// SetMemberIfMatch mocks base method.
+func (m *MockDatabase) SetMemberIfMatch(ctx context.Context, leaderboard string, member *Member, condition *Condition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMemberIfMatch", ctx, leaderboard, member, condition)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMemberIfMatch indicates an expected call of SetMemberIfMatch.
+func (mr *MockDatabaseMockRecorder) SetMemberIfMatch(ctx, leaderboard, member, condition interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMemberIfMatch", reflect.TypeOf((*MockDatabase)(nil).SetMemberIfMatch), ctx, leaderboard, member, condition)
+}
+
+// SetMemberIfMatchIdempotent mocks base method.
+func (m *MockDatabase) SetMemberIfMatchIdempotent(ctx context.Context, leaderboard string, databaseMember *Member, condition *Condition, idempotency *Idempotency) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMemberIfMatchIdempotent", ctx, leaderboard, databaseMember, condition, idempotency)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetMemberIfMatchIdempotent indicates an expected call of SetMemberIfMatchIdempotent.
+func (mr *MockDatabaseMockRecorder) SetMemberIfMatchIdempotent(ctx, leaderboard, databaseMember, condition, idempotency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMemberIfMatchIdempotent", reflect.TypeOf((*MockDatabase)(nil).SetMemberIfMatchIdempotent), ctx, leaderboard, databaseMember, condition, idempotency)
+}
+
 // SetMembers mocks base method.
 func (m *MockDatabase) SetMembers(ctx context.Context, leaderboard string, databaseMembers []*Member) error {
 	m.ctrl.T.Helper()
@@ -219,6 +1000,21 @@ func (mr *MockDatabaseMockRecorder) SetMembers(ctx, leaderboard, databaseMembers
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMembers", reflect.TypeOf((*MockDatabase)(nil).SetMembers), ctx, leaderboard, databaseMembers)
 }
 
+// SetMembersIdempotent mocks base method.
+func (m *MockDatabase) SetMembersIdempotent(ctx context.Context, leaderboard string, databaseMembers []*Member, idempotency *Idempotency) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMembersIdempotent", ctx, leaderboard, databaseMembers, idempotency)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetMembersIdempotent indicates an expected call of SetMembersIdempotent.
+func (mr *MockDatabaseMockRecorder) SetMembersIdempotent(ctx, leaderboard, databaseMembers, idempotency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMembersIdempotent", reflect.TypeOf((*MockDatabase)(nil).SetMembersIdempotent), ctx, leaderboard, databaseMembers, idempotency)
+}
+
 // SetMembersTTL mocks base method.
 func (m *MockDatabase) SetMembersTTL(ctx context.Context, leaderboard string, databaseMembers []*Member) error {
 	m.ctrl.T.Helper()
@@ -232,3 +1028,94 @@ func (mr *MockDatabaseMockRecorder) SetMembersTTL(ctx, leaderboard, databaseMemb
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMembersTTL", reflect.TypeOf((*MockDatabase)(nil).SetMembersTTL), ctx, leaderboard, databaseMembers)
 }
+
+// SetResetProgress mocks base method.
+func (m *MockDatabase) SetResetProgress(ctx context.Context, leaderboard string, progress *ResetProgress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetResetProgress", ctx, leaderboard, progress)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetResetProgress indicates an expected call of SetResetProgress.
+func (mr *MockDatabaseMockRecorder) SetResetProgress(ctx, leaderboard, progress interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetResetProgress", reflect.TypeOf((*MockDatabase)(nil).SetResetProgress), ctx, leaderboard, progress)
+}
+
+// SetTournament mocks base method.
+func (m *MockDatabase) SetTournament(ctx context.Context, tournament string, config *Tournament) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTournament", ctx, tournament, config)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTournament indicates an expected call of SetTournament.
+func (mr *MockDatabaseMockRecorder) SetTournament(ctx, tournament, config interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTournament", reflect.TypeOf((*MockDatabase)(nil).SetTournament), ctx, tournament, config)
+}
+
+// TakeCallerToken mocks base method.
+func (m *MockDatabase) TakeCallerToken(ctx context.Context, caller string, limit *RateLimit) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeCallerToken", ctx, caller, limit)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeCallerToken indicates an expected call of TakeCallerToken.
+func (mr *MockDatabaseMockRecorder) TakeCallerToken(ctx, caller, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeCallerToken", reflect.TypeOf((*MockDatabase)(nil).TakeCallerToken), ctx, caller, limit)
+}
+
+// TakeLeaderboardTokens mocks base method.
+func (m *MockDatabase) TakeLeaderboardTokens(ctx context.Context, leaderboard string, leaderboardLimit *RateLimit, members []string, memberLimit *RateLimit) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeLeaderboardTokens", ctx, leaderboard, leaderboardLimit, members, memberLimit)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeLeaderboardTokens indicates an expected call of TakeLeaderboardTokens.
+func (mr *MockDatabaseMockRecorder) TakeLeaderboardTokens(ctx, leaderboard, leaderboardLimit, members, memberLimit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeLeaderboardTokens", reflect.TypeOf((*MockDatabase)(nil).TakeLeaderboardTokens), ctx, leaderboard, leaderboardLimit, members, memberLimit)
+}
+
+// TrimLeaderboard mocks base method.
+func (m *MockDatabase) TrimLeaderboard(ctx context.Context, leaderboard string, size int, order string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrimLeaderboard", ctx, leaderboard, size, order)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TrimLeaderboard indicates an expected call of TrimLeaderboard.
+func (mr *MockDatabaseMockRecorder) TrimLeaderboard(ctx, leaderboard, size, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrimLeaderboard", reflect.TypeOf((*MockDatabase)(nil).TrimLeaderboard), ctx, leaderboard, size, order)
+}
+
+// UnblockMembers mocks base method.
+func (m *MockDatabase) UnblockMembers(ctx context.Context, leaderboard string, members ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, leaderboard}
+	for _, a := range members {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnblockMembers", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnblockMembers indicates an expected call of UnblockMembers.
+func (mr *MockDatabaseMockRecorder) UnblockMembers(ctx, leaderboard interface{}, members ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, leaderboard}, members...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockMembers", reflect.TypeOf((*MockDatabase)(nil).UnblockMembers), varargs...)
+}
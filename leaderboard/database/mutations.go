@@ -0,0 +1,14 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+// Mutations interface standardize mutation outbox database calls
+type Mutations interface {
+	GetMutationOutboxes(ctx context.Context) ([]string, error)
+	GetMutations(ctx context.Context, outbox string, amount int) ([]*OutboxMutation, error)
+	RemoveMutations(ctx context.Context, outbox string, streamIDs ...string) error
+	RemoveIdleMutationOutbox(ctx context.Context, outbox string, idleSince time.Time) (bool, error)
+}
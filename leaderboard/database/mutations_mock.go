@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: leaderboard/database/mutations.go
+
+// Package database is a generated GoMock package.
+package database
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockMutations is a mock of Mutations interface.
+type MockMutations struct {
+	ctrl     *gomock.Controller
+	recorder *MockMutationsMockRecorder
+}
+
+// MockMutationsMockRecorder is the mock recorder for MockMutations.
+type MockMutationsMockRecorder struct {
+	mock *MockMutations
+}
+
+// NewMockMutations creates a new mock instance.
+func NewMockMutations(ctrl *gomock.Controller) *MockMutations {
+	mock := &MockMutations{ctrl: ctrl}
+	mock.recorder = &MockMutationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMutations) EXPECT() *MockMutationsMockRecorder {
+	return m.recorder
+}
+
+// GetMutationOutboxes mocks base method.
+func (m *MockMutations) GetMutationOutboxes(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMutationOutboxes", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMutationOutboxes indicates an expected call of GetMutationOutboxes.
+func (mr *MockMutationsMockRecorder) GetMutationOutboxes(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMutationOutboxes", reflect.TypeOf((*MockMutations)(nil).GetMutationOutboxes), ctx)
+}
+
+// GetMutations mocks base method.
+func (m *MockMutations) GetMutations(ctx context.Context, outbox string, amount int) ([]*OutboxMutation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMutations", ctx, outbox, amount)
+	ret0, _ := ret[0].([]*OutboxMutation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMutations indicates an expected call of GetMutations.
+func (mr *MockMutationsMockRecorder) GetMutations(ctx, outbox, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMutations", reflect.TypeOf((*MockMutations)(nil).GetMutations), ctx, outbox, amount)
+}
+
+// RemoveIdleMutationOutbox mocks base method.
+func (m *MockMutations) RemoveIdleMutationOutbox(ctx context.Context, outbox string, idleSince time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveIdleMutationOutbox", ctx, outbox, idleSince)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveIdleMutationOutbox indicates an expected call of RemoveIdleMutationOutbox.
+func (mr *MockMutationsMockRecorder) RemoveIdleMutationOutbox(ctx, outbox, idleSince interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveIdleMutationOutbox", reflect.TypeOf((*MockMutations)(nil).RemoveIdleMutationOutbox), ctx, outbox, idleSince)
+}
+
+// RemoveMutations mocks base method.
+func (m *MockMutations) RemoveMutations(ctx context.Context, outbox string, streamIDs ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, outbox}
+	for _, a := range streamIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveMutations", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMutations indicates an expected call of RemoveMutations.
+func (mr *MockMutationsMockRecorder) RemoveMutations(ctx, outbox interface{}, streamIDs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, outbox}, streamIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMutations", reflect.TypeOf((*MockMutations)(nil).RemoveMutations), varargs...)
+}
@@ -3,6 +3,7 @@ package database
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
@@ -16,6 +17,38 @@ type Redis struct {
 // ExpirationSet is used to list expirations set that worker will use to remove members
 const ExpirationSet string = "expiration-sets"
 
+// trimLeaderboardScript keeps only the first ARGV[2] members of KEYS[1] in order ARGV[1]
+const trimLeaderboardScript = `
+if ARGV[1] == 'asc' then
+	return redis.call('ZREMRANGEBYRANK', KEYS[1], ARGV[2], -1)
+end
+return redis.call('ZREMRANGEBYRANK', KEYS[1], 0, -tonumber(ARGV[2]) - 1)
+`
+
+// removeLeaderboardScript deletes the leaderboard KEYS[1] and the keys kept next to it in KEYS
+const removeLeaderboardScript = `
+return redis.call('DEL', unpack(KEYS))
+`
+
+// renameLeaderboardScript renames KEYS[1] to KEYS[2], returning 0 if KEYS[1] does not exist and 1 otherwise
+const renameLeaderboardScript = `
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('RENAME', KEYS[1], KEYS[2])
+return 1
+`
+
+// setLeaderboardExpirationScript expires KEYS[1] and its members versions KEYS[2] at unix time ARGV[1],
+// returning 0 if KEYS[1] does not exist and 1 otherwise
+const setLeaderboardExpirationScript = `
+if redis.call('EXPIREAT', KEYS[1], ARGV[1]) == 0 then
+	return 0
+end
+redis.call('EXPIREAT', KEYS[2], ARGV[1])
+return 1
+`
+
 // RedisOptions is a struct to create a new redis client
 type RedisOptions struct {
 	ClusterEnabled bool
@@ -56,6 +89,17 @@ func (r *Redis) GetLeaderboardExpiration(ctx context.Context, leaderboard string
 	return int64(duration), nil
 }
 
+// GetLeaderboardSettings return leaderboard settings stored in a hash with key being
+// leaderboard name hash tagged and suffix ":settings"
+func (r *Redis) GetLeaderboardSettings(ctx context.Context, leaderboard string) (map[string]string, error) {
+	settings, err := r.Client.HGetAll(ctx, settingsKey(leaderboard))
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	return settings, nil
+}
+
 // GetMembers return members from leaderboard
 func (r *Redis) GetMembers(ctx context.Context, leaderboard, order string, includeTTL bool, members ...string) ([]*Member, error) {
 	if order != "asc" && order != "desc" {
@@ -109,6 +153,71 @@ func (r *Redis) GetMembers(ctx context.Context, leaderboard, order string, inclu
 	return membersToReturn, nil
 }
 
+// getMembersByIDScript return the score and rank of members ARGV[2..] in KEYS[1], ascending if ARGV[1] is
+// asc and descending otherwise, or false for both if the member is not in it
+const getMembersByIDScript = `
+local command = 'ZREVRANK'
+if ARGV[1] == 'asc' then
+	command = 'ZRANK'
+end
+local values = {}
+for i = 2, #ARGV do
+	values[2 * i - 3] = redis.call('ZSCORE', KEYS[1], ARGV[i])
+	values[2 * i - 2] = redis.call(command, KEYS[1], ARGV[i])
+end
+return values
+`
+
+// GetMembersByID return members of leaderboard with their scores and ranks in the order requested in a
+// single call, or nil for members that are not in it. Unlike GetMembers it does not return their TTL
+func (r *Redis) GetMembersByID(ctx context.Context, leaderboard, order string, members ...string) ([]*Member, error) {
+	return r.getMembersByID(ctx, leaderboard, order, members...)
+}
+
+func (r *Redis) getMembersByID(ctx context.Context, key, order string, members ...string) ([]*Member, error) {
+	if len(members) == 0 {
+		return []*Member{}, nil
+	}
+
+	args := make([]interface{}, 0, len(members)+1)
+	args = append(args, order)
+	for _, member := range members {
+		args = append(args, member)
+	}
+
+	result, err := r.Client.Eval(ctx, getMembersByIDScript, []string{key}, args...)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	values, ok := result.([]interface{})
+	if !ok || len(values) != 2*len(members) {
+		return nil, NewGeneralError(fmt.Sprintf("unexpected members %v of %s", result, key))
+	}
+
+	membersToReturn := make([]*Member, 0, len(members))
+	for i, member := range members {
+		if values[2*i] == nil {
+			membersToReturn = append(membersToReturn, nil)
+			continue
+		}
+
+		score, err := strconv.ParseFloat(fmt.Sprint(values[2*i]), 64)
+		if err != nil {
+			return nil, NewGeneralError(err.Error())
+		}
+
+		rank, ok := values[2*i+1].(int64)
+		if !ok {
+			return nil, NewGeneralError(fmt.Sprintf("unexpected rank %v of %s in %s", values[2*i+1], member, key))
+		}
+
+		membersToReturn = append(membersToReturn, &Member{Member: member, Score: score, Rank: rank})
+	}
+
+	return membersToReturn, nil
+}
+
 func (r *Redis) getMemberTTL(ctx context.Context, leaderboard, member string) (time.Time, error) {
 	leaderboardTTL := fmt.Sprintf("%s:ttl", leaderboard)
 	ttl, err := r.Client.ZScore(ctx, leaderboardTTL, member)
@@ -187,6 +296,68 @@ func (r *Redis) GetRank(ctx context.Context, leaderboard, member, order string)
 	return int(rank), nil
 }
 
+// GetResetProgress return the progress of the last reset executed on leaderboard
+func (r *Redis) GetResetProgress(ctx context.Context, leaderboard string) (*ResetProgress, error) {
+	resetKey := fmt.Sprintf("%s:reset", leaderboard)
+	fields, err := r.Client.HGetAll(ctx, resetKey)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	if len(fields) == 0 {
+		return nil, NewResetProgressNotFoundError(leaderboard)
+	}
+
+	progress := &ResetProgress{
+		Target:   fields["target"],
+		Strategy: fields["strategy"],
+		Status:   fields["status"],
+	}
+
+	progress.Processed, err = strconv.ParseInt(fields["processed"], 10, 64)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	progress.Total, err = strconv.ParseInt(fields["total"], 10, 64)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	progress.StartedAt, err = parseUnixTime(fields["startedAt"])
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	progress.FinishedAt, err = parseUnixTime(fields["finishedAt"])
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	return progress, nil
+}
+
+func parseUnixTime(value string) (time.Time, error) {
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if seconds == 0 {
+		return time.Time{}, nil
+	}
+
+	return time.Unix(seconds, 0), nil
+}
+
+func formatUnixTime(value time.Time) string {
+	if value.IsZero() {
+		return "0"
+	}
+
+	return strconv.FormatInt(value.Unix(), 10)
+}
+
 // GetTotalMembers return total members in a leaderboard
 func (r *Redis) GetTotalMembers(ctx context.Context, leaderboard string) (int, error) {
 	totalMembers, err := r.Client.ZCard(ctx, leaderboard)
@@ -209,18 +380,107 @@ func (r *Redis) Healthcheck(ctx context.Context) error {
 	return nil
 }
 
-// IncrementMemberScore add to member score the value in parameter
-func (r *Redis) IncrementMemberScore(ctx context.Context, leaderboard, member string, increment float64) error {
-	err := r.ZIncrBy(ctx, leaderboard, member, increment)
+// ImportMembers set the score of each batch of members into target, leaderboard or a key in its slot
+// replacing it later, with a script incrementing their versions in leaderboard, so the versions of members
+// never go back once target replaces leaderboard, and filling their PreviousScore in leaderboard. Batches
+// are sent in a single pipeline, or one at a time when ctx has a mutation so each is added to the outbox of
+// leaderboard. It returns the error of each batch, nil if it was set
+func (r *Redis) ImportMembers(ctx context.Context, leaderboard, target string, batches ...[]*Member) []error {
+	keys := []string{target, versionsKey(leaderboard), leaderboard}
+	batchesArgs := make([][]interface{}, 0, len(batches))
+	for _, batch := range batches {
+		args := make([]interface{}, 0, 2*len(batch))
+		for _, member := range batch {
+			args = append(args, formatScore(member.Score), member.Member)
+		}
+		batchesArgs = append(batchesArgs, args)
+	}
+
+	results := make([]interface{}, len(batches))
+	errs := make([]error, len(batches))
+	if _, ok := ctx.Value(mutationContextKey{}).(string); ok {
+		for i, args := range batchesArgs {
+			results[i], errs[i] = r.evalWrite(ctx, leaderboard, importMembersScript, "true", keys, args...)
+		}
+	} else {
+		results, errs = r.Client.EvalPipelined(ctx, importMembersScript, keys, batchesArgs...)
+	}
+
+	for i, err := range errs {
+		if err != nil {
+			errs[i] = NewGeneralError(err.Error())
+			continue
+		}
+
+		previousScores, ok := results[i].([]interface{})
+		if !ok {
+			errs[i] = NewGeneralError(fmt.Sprintf("unexpected import members result %v", results[i]))
+			continue
+		}
+		err = setMembersPreviousScores(batches[i], previousScores)
+		if err != nil {
+			errs[i] = NewGeneralError(err.Error())
+		}
+	}
+	return errs
+}
+
+// IncrementMemberScore add to the score of databaseMember the value in parameter incrementing member version
+// and adding its Increase to its total increase, filling its Version and Score with the new ones and
+// PreviousScore with the score it had before. It returns ScoreChangedError if its Guard or Increase cap do not hold
+func (r *Redis) IncrementMemberScore(ctx context.Context, leaderboard string, databaseMember *Member, increment float64) error {
+	keys, increasesExpireAt := withIncreasesKey(leaderboard, []string{leaderboard, versionsKey(leaderboard)}, databaseMember)
+	guardScore, maxIncrease := formatGuard(databaseMember)
+	result, err := r.evalWrite(
+		ctx, leaderboard, incrementMemberScoreScript, "result[1] ~= -1", keys,
+		formatScore(increment), databaseMember.Member, formatIncrease(databaseMember), increasesExpireAt, guardScore, maxIncrease,
+	)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	values, ok := result.([]interface{})
+	if ok && len(values) == 1 && fmt.Sprint(values[0]) == "-1" {
+		return NewScoreChangedError(leaderboard)
+	}
+	if !ok || len(values) != 3 {
+		return NewGeneralError(fmt.Sprintf("unexpected increment result %v", result))
+	}
+
+	err = setMembersVersions([]*Member{databaseMember}, values[:1])
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	databaseMember.Score, err = strconv.ParseFloat(fmt.Sprint(values[1]), 64)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	err = setMembersPreviousScores([]*Member{databaseMember}, values[2:])
 	if err != nil {
 		return NewGeneralError(err.Error())
 	}
+
 	return nil
 }
 
-// RemoveLeaderboard delete leaderboard key from redis
+// RemoveLeaderboard delete leaderboard, its members versions keys and its creation mark from redis
 func (r *Redis) RemoveLeaderboard(ctx context.Context, leaderboard string) error {
-	err := r.Client.Del(ctx, leaderboard)
+	_, err := r.evalWrite(ctx, leaderboard, removeLeaderboardScript, "true", []string{
+		leaderboard, versionsKey(leaderboard), ShadowLeaderboard(leaderboard),
+		versionsKey(ShadowLeaderboard(leaderboard)), blockedScoresKey(leaderboard),
+	})
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	err = r.Client.Del(ctx, createdKey(leaderboard))
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	err = r.Client.ZRem(ctx, ExpiringLeaderboardsSet, leaderboard)
 	if err != nil {
 		return NewGeneralError(err.Error())
 	}
@@ -229,35 +489,130 @@ func (r *Redis) RemoveLeaderboard(ctx context.Context, leaderboard string) error
 
 // RemoveMembers delete from redis members
 func (r *Redis) RemoveMembers(ctx context.Context, leaderboard string, members ...string) error {
-	err := r.Client.ZRem(ctx, leaderboard, members...)
+	return r.writeCommand(ctx, leaderboard, "ZREM", leaderboard, stringArgs(members)...)
+}
+
+// RenameLeaderboard move leaderboard members to newLeaderboard, replacing it
+func (r *Redis) RenameLeaderboard(ctx context.Context, leaderboard, newLeaderboard string) error {
+	result, err := r.evalWrite(ctx, leaderboard, renameLeaderboardScript, "result == 1", []string{leaderboard, newLeaderboard})
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	if result != int64(1) {
+		return NewGeneralError(redis.NewKeyNotFoundError(leaderboard).Error())
+	}
+	return nil
+}
+
+// ScanMembers return a page of about count members of leaderboard, in no order, and the cursor of the next
+// page, zero after the last one. Unlike ranges, a scan starting at cursor zero returns every member that is
+// in leaderboard from its start to its end despite concurrent writes, though a member may be returned twice
+func (r *Redis) ScanMembers(ctx context.Context, leaderboard string, cursor uint64, count int) ([]*Member, uint64, error) {
+	redisMembers, next, err := r.Client.ZScan(ctx, leaderboard, cursor, int64(count))
+	if err != nil {
+		return nil, 0, NewGeneralError(err.Error())
+	}
+
+	members := make([]*Member, 0, len(redisMembers))
+	for _, member := range redisMembers {
+		members = append(members, &Member{
+			Member: member.Member,
+			Score:  member.Score,
+		})
+	}
+
+	return members, next, nil
+}
+
+// scanLeaderboardsCount is how many keys are asked to each SCAN while looking for leaderboards
+const scanLeaderboardsCount = 1000
+
+// ScanLeaderboards call fn with pages of the leaderboards that have members versions, every leaderboard
+// podium wrote scores to, until fn returns an error. It scans the whole keyspace, so it is meant for
+// operations on every leaderboard, and a leaderboard may be found twice
+func (r *Redis) ScanLeaderboards(ctx context.Context, fn func(leaderboards []string) error) error {
+	err := r.Client.Scan(ctx, "*:versions", scanLeaderboardsCount, func(keys []string) error {
+		leaderboards := make([]string, 0, len(keys))
+		found := map[string]bool{}
+		for _, key := range keys {
+			leaderboard := leaderboardOfVersionsKey(key)
+			if leaderboard == "" || found[leaderboard] {
+				continue
+			}
+			found[leaderboard] = true
+			leaderboards = append(leaderboards, leaderboard)
+		}
+		return fn(leaderboards)
+	})
 	if err != nil {
 		return NewGeneralError(err.Error())
 	}
+
 	return nil
 }
 
-// SetLeaderboardExpiration will set leaderboard expiration time
+// SetLeaderboardExpiration will set leaderboard and its members versions expiration time
 func (r *Redis) SetLeaderboardExpiration(ctx context.Context, leaderboard string, expireAt time.Time) error {
-	err := r.Client.ExpireAt(ctx, leaderboard, expireAt)
+	result, err := r.evalWrite(
+		ctx, leaderboard, setLeaderboardExpirationScript, "result == 1", []string{leaderboard, versionsKey(leaderboard)},
+		strconv.FormatInt(expireAt.Unix(), 10),
+	)
 	if err != nil {
 		return NewGeneralError(err.Error())
 	}
+
+	if result != int64(1) {
+		return NewGeneralError(redis.NewKeyNotFoundError(leaderboard).Error())
+	}
 	return nil
 }
 
-// SetMembers will set member score and ttl
+// SetLeaderboardSettings will set leaderboard settings fields
+func (r *Redis) SetLeaderboardSettings(ctx context.Context, leaderboard string, settings map[string]string) error {
+	return r.writeCommand(ctx, leaderboard, "HSET", settingsKey(leaderboard), hashArgs(settings)...)
+}
+
+func settingsKey(leaderboard string) string {
+	return LeaderboardKey(leaderboard, "settings")
+}
+
+// SetMembers will set members score incrementing their versions and adding their Increase to their total
+// increases, filling members Version with the new ones and PreviousScore with the scores they had before.
+// Nothing is written and ScoreChangedError is returned if the Guard or Increase cap of a member do not hold
 func (r *Redis) SetMembers(ctx context.Context, leaderboard string, databaseMembers []*Member) error {
-	redisMembers := make([]*redis.Member, 0, len(databaseMembers))
+	keys, increasesExpireAt := withIncreasesKey(leaderboard, []string{leaderboard, versionsKey(leaderboard)}, databaseMembers...)
+	args := make([]interface{}, 0, 5*len(databaseMembers)+1)
+	args = append(args, increasesExpireAt)
 	for _, member := range databaseMembers {
-		redisMembers = append(redisMembers, &redis.Member{
-			Member: member.Member,
-			Score:  member.Score,
-		})
+		guardScore, maxIncrease := formatGuard(member)
+		args = append(args, formatScore(member.Score), member.Member, formatIncrease(member), guardScore, maxIncrease)
 	}
-	err := r.Client.ZAdd(ctx, leaderboard, redisMembers...)
+
+	result, err := r.evalWrite(ctx, leaderboard, setMembersScript, "result[1] ~= -1", keys, args...)
 	if err != nil {
 		return NewGeneralError(err.Error())
 	}
+
+	versions, ok := result.([]interface{})
+	if !ok {
+		return NewGeneralError(fmt.Sprintf("unexpected set members result %v", result))
+	}
+	if len(versions) == 1 && fmt.Sprint(versions[0]) == "-1" {
+		return NewScoreChangedError(leaderboard)
+	}
+
+	err = setMembersVersions(databaseMembers, versions)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	if len(versions) > len(databaseMembers) {
+		err = setMembersPreviousScores(databaseMembers, versions[len(databaseMembers):])
+		if err != nil {
+			return NewGeneralError(err.Error())
+		}
+	}
 	return nil
 }
 
@@ -268,18 +623,15 @@ func (r *Redis) SetMembers(ctx context.Context, leaderboard string, databaseMemb
 //
 //		Note: the worker expiration set is expiration_set
 func (r *Redis) SetMembersTTL(ctx context.Context, leaderboard string, databaseMembers []*Member) error {
-	redisMembers := make([]*redis.Member, 0, len(databaseMembers))
+	args := make([]interface{}, 0, 2*len(databaseMembers))
 	for _, member := range databaseMembers {
-		redisMembers = append(redisMembers, &redis.Member{
-			Member: member.Member,
-			Score:  float64(member.TTL.Unix()),
-		})
+		args = append(args, strconv.FormatInt(member.TTL.Unix(), 10), member.Member)
 	}
 
 	expirationKey := fmt.Sprintf("%s:ttl", leaderboard)
-	err := r.Client.ZAdd(ctx, expirationKey, redisMembers...)
+	err := r.writeCommand(ctx, leaderboard, "ZADD", expirationKey, args...)
 	if err != nil {
-		return NewGeneralError(err.Error())
+		return err
 	}
 
 	err = r.Client.SAdd(ctx, ExpirationSet, expirationKey)
@@ -289,3 +641,37 @@ func (r *Redis) SetMembersTTL(ctx context.Context, leaderboard string, databaseM
 
 	return nil
 }
+
+// SetResetProgress persist the progress of a leaderboard reset in a hash with key
+// being leaderboard name and suffix ":reset"
+func (r *Redis) SetResetProgress(ctx context.Context, leaderboard string, progress *ResetProgress) error {
+	resetKey := fmt.Sprintf("%s:reset", leaderboard)
+	err := r.Client.HSet(ctx, resetKey, map[string]string{
+		"target":     progress.Target,
+		"strategy":   progress.Strategy,
+		"status":     progress.Status,
+		"processed":  strconv.FormatInt(progress.Processed, 10),
+		"total":      strconv.FormatInt(progress.Total, 10),
+		"startedAt":  formatUnixTime(progress.StartedAt),
+		"finishedAt": formatUnixTime(progress.FinishedAt),
+	})
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
+// TrimLeaderboard remove the members of leaderboard ranked after the first size ones in order
+func (r *Redis) TrimLeaderboard(ctx context.Context, leaderboard string, size int, order string) error {
+	if order != "asc" && order != "desc" {
+		return NewInvalidOrderError(order)
+	}
+
+	_, err := r.evalWrite(ctx, leaderboard, trimLeaderboardScript, "true", []string{leaderboard}, order, size)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
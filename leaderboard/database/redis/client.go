@@ -15,12 +15,19 @@ const (
 // Client interface define wich redis methods will be used by leaderboard module
 type Client interface {
 	Del(ctx context.Context, key string) error
+	Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error)
+	EvalPipelined(ctx context.Context, script string, keys []string, batches ...[]interface{}) ([]interface{}, []error)
 	Exists(ctx context.Context, key string) error
 	ExpireAt(ctx context.Context, key string, time time.Time) error
+	HGet(ctx context.Context, key, field string) (string, error)
+	HGetAll(ctx context.Context, key string) (map[string]string, error)
+	HSet(ctx context.Context, key string, values map[string]string) error
 	Ping(ctx context.Context) (string, error)
+	Rename(ctx context.Context, key, newKey string) error
 	SAdd(ctx context.Context, key, member string) error
 	SMembers(ctx context.Context, key string) ([]string, error)
 	SRem(ctx context.Context, key string, members ...string) error
+	Scan(ctx context.Context, match string, count int64, fn func(keys []string) error) error
 	TTL(ctx context.Context, key string) (time.Duration, error)
 	ZAdd(ctx context.Context, key string, members ...*Member) error
 	ZCard(ctx context.Context, key string) (int64, error)
@@ -32,6 +39,7 @@ type Client interface {
 	ZRevRange(ctx context.Context, key string, start, stop int64) ([]*Member, error)
 	ZRevRangeByScore(ctx context.Context, key string, min, max string, offset, count int64) ([]string, error)
 	ZRevRank(ctx context.Context, key, member string) (int64, error)
+	ZScan(ctx context.Context, key string, cursor uint64, count int64) ([]*Member, uint64, error)
 	ZScore(ctx context.Context, key, member string) (float64, error)
 }
 
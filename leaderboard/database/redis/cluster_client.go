@@ -3,6 +3,8 @@ package redis
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"sync"
 	"time"
 
 	goredis "github.com/go-redis/redis/v8"
@@ -39,6 +41,43 @@ func (cc *clusterClient) Del(ctx context.Context, key string) error {
 	return nil
 }
 
+// Eval call redis EVAL function, a nil reply is returned as nil
+func (cc *clusterClient) Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+	result, err := cc.ClusterClient.Eval(ctx, script, keys, args...).Result()
+	if err != nil {
+		if err.Error() == "redis: nil" {
+			return nil, nil
+		}
+
+		return nil, NewGeneralError(err.Error())
+	}
+	return result, nil
+}
+
+// EvalPipelined call the script on keys once for each batch of args in a single pipeline, returning the
+// result and error of each batch, a nil error if the script succeeded
+func (cc *clusterClient) EvalPipelined(ctx context.Context, script string, keys []string, batches ...[]interface{}) ([]interface{}, []error) {
+	cmds := make([]*goredis.Cmd, 0, len(batches))
+	cc.ClusterClient.Pipelined(ctx, func(pipe goredis.Pipeliner) error {
+		for _, args := range batches {
+			cmds = append(cmds, pipe.Eval(ctx, script, keys, args...))
+		}
+		return nil
+	})
+
+	results := make([]interface{}, len(cmds))
+	errs := make([]error, len(cmds))
+	for i, cmd := range cmds {
+		result, err := cmd.Result()
+		if err != nil && err.Error() != "redis: nil" {
+			errs[i] = NewGeneralError(err.Error())
+			continue
+		}
+		results[i] = result
+	}
+	return results, errs
+}
+
 func (cc *clusterClient) Exists(ctx context.Context, key string) error {
 	value, err := cc.ClusterClient.Exists(ctx, key).Result()
 	if err != nil {
@@ -66,6 +105,42 @@ func (cc *clusterClient) ExpireAt(ctx context.Context, key string, time time.Tim
 	return nil
 }
 
+// HGet call redis HGET function
+func (cc *clusterClient) HGet(ctx context.Context, key, field string) (string, error) {
+	result, err := cc.ClusterClient.HGet(ctx, key, field).Result()
+	if err != nil {
+		if err.Error() == "redis: nil" {
+			return "", NewMemberNotFoundError(key, field)
+		}
+
+		return "", NewGeneralError(err.Error())
+	}
+	return result, nil
+}
+
+// HGetAll call redis HGETALL function
+func (cc *clusterClient) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	result, err := cc.ClusterClient.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+	return result, nil
+}
+
+// HSet call redis HSET function
+func (cc *clusterClient) HSet(ctx context.Context, key string, values map[string]string) error {
+	fields := make(map[string]interface{}, len(values))
+	for field, value := range values {
+		fields[field] = value
+	}
+
+	err := cc.ClusterClient.HSet(ctx, key, fields).Err()
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+	return nil
+}
+
 // Ping call redis PING function
 func (cc *clusterClient) Ping(ctx context.Context) (string, error) {
 	result, err := cc.ClusterClient.Ping(ctx).Result()
@@ -75,6 +150,19 @@ func (cc *clusterClient) Ping(ctx context.Context) (string, error) {
 	return result, nil
 }
 
+// Rename call redis RENAME function
+func (cc *clusterClient) Rename(ctx context.Context, key, newKey string) error {
+	err := cc.ClusterClient.Rename(ctx, key, newKey).Err()
+	if err != nil {
+		if err.Error() == "ERR no such key" {
+			return NewKeyNotFoundError(key)
+		}
+
+		return NewGeneralError(err.Error())
+	}
+	return nil
+}
+
 // SAdd call redis SADD function
 func (cc *clusterClient) SAdd(ctx context.Context, key, member string) error {
 	err := cc.ClusterClient.SAdd(ctx, key, member).Err()
@@ -102,6 +190,19 @@ func (cc *clusterClient) SRem(ctx context.Context, key string, members ...string
 	return nil
 }
 
+// Scan call redis SCAN function with MATCH on every master until their whole keyspaces are iterated, calling
+// fn with the keys of each page. A key may be passed more than once
+func (cc *clusterClient) Scan(ctx context.Context, match string, count int64, fn func(keys []string) error) error {
+	var mutex sync.Mutex
+	return cc.ClusterClient.ForEachMaster(ctx, func(ctx context.Context, client *goredis.Client) error {
+		return scanKeys(ctx, client, match, count, func(keys []string) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			return fn(keys)
+		})
+	})
+}
+
 // TTL call redis TTL function
 func (cc *clusterClient) TTL(ctx context.Context, key string) (time.Duration, error) {
 	result, err := cc.ClusterClient.TTL(ctx, key).Result()
@@ -251,6 +352,29 @@ func (cc *clusterClient) ZRevRank(ctx context.Context, key, member string) (int6
 	return result, nil
 }
 
+// ZScan call redis ZSCAN function, returning the members of a page and the cursor of the next one, zero
+// after the last page
+func (cc *clusterClient) ZScan(ctx context.Context, key string, cursor uint64, count int64) ([]*Member, uint64, error) {
+	result, next, err := cc.ClusterClient.ZScan(ctx, key, cursor, "", count).Result()
+	if err != nil {
+		return nil, 0, NewGeneralError(err.Error())
+	}
+
+	members := make([]*Member, 0, len(result)/2)
+	for i := 0; i+1 < len(result); i += 2 {
+		score, err := strconv.ParseFloat(result[i+1], 64)
+		if err != nil {
+			return nil, 0, NewGeneralError(err.Error())
+		}
+		members = append(members, &Member{
+			Member: result[i],
+			Score:  score,
+		})
+	}
+
+	return members, next, nil
+}
+
 // ZScore call redis ZScore function
 func (cc *clusterClient) ZScore(ctx context.Context, key, member string) (float64, error) {
 	result, err := cc.ClusterClient.ZScore(ctx, key, member).Result()
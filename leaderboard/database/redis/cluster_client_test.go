@@ -2,6 +2,7 @@ package redis_test
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	goredis "github.com/go-redis/redis/v8"
@@ -59,6 +60,57 @@ var _ = Describe("Cluster Client", func() {
 		})
 	})
 
+	Describe("Eval", func() {
+		It("Should return script result", func() {
+			result, err := clusterClient.Eval(context.Background(), "return redis.call('ZADD', KEYS[1], ARGV[1], ARGV[2])", []string{testKey}, 1.0, member)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(int64(1)))
+
+			score, err := goRedis.ZScore(context.Background(), testKey, member).Result()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(score).To(Equal(1.0))
+		})
+
+		It("Should return nil if script return nil", func() {
+			result, err := clusterClient.Eval(context.Background(), "return redis.call('GET', KEYS[1])", []string{testKey})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(BeNil())
+		})
+
+		It("Should return GeneralError if script fails", func() {
+			_, err := clusterClient.Eval(context.Background(), "return redis.call('INVALID')", []string{testKey})
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(BeAssignableToTypeOf(redis.NewGeneralError("")))
+		})
+	})
+
+	Describe("EvalPipelined", func() {
+		script := "return redis.call('ZADD', KEYS[1], unpack(ARGV))"
+
+		It("Should call script once for every batch of args", func() {
+			results, errs := clusterClient.EvalPipelined(context.Background(), script, []string{testKey},
+				[]interface{}{"1", member},
+				[]interface{}{"2", "member2"},
+			)
+			Expect(errs).To(Equal([]error{nil, nil}))
+			Expect(results).To(Equal([]interface{}{int64(1), int64(1)}))
+
+			returnedScore, err := goRedis.ZScore(context.Background(), testKey, "member2").Result()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(returnedScore).To(Equal(2.0))
+		})
+
+		It("Should return error of batches that failed", func() {
+			err := goRedis.Set(context.Background(), testKey, "value", 0).Err()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, errs := clusterClient.EvalPipelined(context.Background(), script, []string{testKey}, []interface{}{"1", member})
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0]).To(HaveOccurred())
+		})
+	})
+
 	Describe("Exists", func() {
 		It("Should return nil if key exists", func() {
 			err := goRedis.Set(context.Background(), testKey, "testValue", 0).Err()
@@ -101,6 +153,54 @@ var _ = Describe("Cluster Client", func() {
 		})
 	})
 
+	Describe("HGet", func() {
+		It("Should return field value in a hash", func() {
+			err := goRedis.HSet(context.Background(), testKey, "field1", "value1").Err()
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := clusterClient.HGet(context.Background(), testKey, "field1")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result).To(Equal("value1"))
+		})
+
+		It("Should return MemberNotFoundError if field doesnt exists", func() {
+			_, err := clusterClient.HGet(context.Background(), testKey, "field1")
+			Expect(err).To(Equal(redis.NewMemberNotFoundError(testKey, "field1")))
+		})
+	})
+
+	Describe("HGetAll", func() {
+		It("Should return all fields in a hash", func() {
+			err := goRedis.HSet(context.Background(), testKey, "field1", "value1", "field2", "value2").Err()
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := clusterClient.HGetAll(context.Background(), testKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result).To(Equal(map[string]string{"field1": "value1", "field2": "value2"}))
+		})
+
+		It("Should return empty map if hash doesnt exists", func() {
+			result, err := clusterClient.HGetAll(context.Background(), testKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result).To(BeEmpty())
+		})
+	})
+
+	Describe("HSet", func() {
+		It("Should return nil if fields are set", func() {
+			err := clusterClient.HSet(context.Background(), testKey, map[string]string{"field1": "value1", "field2": "value2"})
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := goRedis.HGetAll(context.Background(), testKey).Result()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result).To(Equal(map[string]string{"field1": "value1", "field2": "value2"}))
+		})
+	})
+
 	Describe("Ping", func() {
 		It("Should return nil if redis is OK", func() {
 			result, err := clusterClient.Ping(context.Background())
@@ -110,6 +210,36 @@ var _ = Describe("Cluster Client", func() {
 		})
 	})
 
+	Describe("Rename", func() {
+		newKey := "{testKey}:renamed"
+
+		AfterEach(func() {
+			err := goRedis.Del(context.Background(), newKey).Err()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return nil if key is renamed", func() {
+			err := goRedis.ZAdd(context.Background(), testKey, &goredis.Z{Member: member, Score: 1.0}).Err()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = clusterClient.Rename(context.Background(), testKey, newKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			score, err := goRedis.ZScore(context.Background(), newKey, member).Result()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(score).To(Equal(1.0))
+
+			exists, err := goRedis.Exists(context.Background(), testKey).Result()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(Equal(int64(0)))
+		})
+
+		It("Should return KeyNotFoundError if key doesn't exists", func() {
+			err := clusterClient.Rename(context.Background(), testKey, newKey)
+			Expect(err).To(Equal(redis.NewKeyNotFoundError(testKey)))
+		})
+	})
+
 	Describe("SAdd", func() {
 		It("Should return nil if member is add to set", func() {
 			err := clusterClient.SAdd(context.Background(), testKey, member)
@@ -161,6 +291,32 @@ var _ = Describe("Cluster Client", func() {
 		})
 	})
 
+	Describe("Scan", func() {
+		It("Should call fn with every key matching", func() {
+			err := goRedis.Set(context.Background(), testKey, "testValue", 0).Err()
+			Expect(err).NotTo(HaveOccurred())
+
+			keys := []string{}
+			err = clusterClient.Scan(context.Background(), testKey+"*", 10, func(page []string) error {
+				keys = append(keys, page...)
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(keys).To(ConsistOf(testKey))
+		})
+
+		It("Should return error returned by fn", func() {
+			err := goRedis.Set(context.Background(), testKey, "testValue", 0).Err()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = clusterClient.Scan(context.Background(), testKey, 10, func(page []string) error {
+				return fmt.Errorf("fn error")
+			})
+			Expect(err).To(MatchError("fn error"))
+		})
+	})
+
 	Describe("TTL", func() {
 		It("Should return time.Duration if key has TTL set", func() {
 			err := goRedis.Set(context.Background(), testKey, "testValue", 10*time.Minute).Err()
@@ -418,6 +574,29 @@ var _ = Describe("Cluster Client", func() {
 		})
 	})
 
+	Describe("ZScan", func() {
+		It("Should return every member with its score until cursor is zero", func() {
+			err := goRedis.ZAdd(context.Background(), testKey, &goredis.Z{Member: member, Score: 1.0}, &goredis.Z{Member: "member2", Score: 2.0}).Err()
+			Expect(err).NotTo(HaveOccurred())
+
+			scores := map[string]float64{}
+			cursor := uint64(0)
+			for {
+				var members []*redis.Member
+				members, cursor, err = clusterClient.ZScan(context.Background(), testKey, cursor, 1)
+				Expect(err).NotTo(HaveOccurred())
+				for _, m := range members {
+					scores[m.Member] = m.Score
+				}
+				if cursor == 0 {
+					break
+				}
+			}
+
+			Expect(scores).To(Equal(map[string]float64{member: 1.0, "member2": 2.0}))
+		})
+	})
+
 	Describe("ZScore", func() {
 		It("Should return score if member is in set", func() {
 			score := 1.0
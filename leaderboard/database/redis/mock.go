@@ -49,6 +49,46 @@ func (mr *MockRedisMockRecorder) Del(ctx, key interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Del", reflect.TypeOf((*MockRedis)(nil).Del), ctx, key)
 }
 
+// Eval mocks base method.
+func (m *MockRedis) Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, script, keys}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Eval", varargs...)
+	ret0, _ := ret[0].(interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Eval indicates an expected call of Eval.
+func (mr *MockRedisMockRecorder) Eval(ctx, script, keys interface{}, args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, script, keys}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eval", reflect.TypeOf((*MockRedis)(nil).Eval), varargs...)
+}
+
+// EvalPipelined mocks base method.
+func (m *MockRedis) EvalPipelined(ctx context.Context, script string, keys []string, batches ...[]interface{}) ([]interface{}, []error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, script, keys}
+	for _, a := range batches {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EvalPipelined", varargs...)
+	ret0, _ := ret[0].([]interface{})
+	ret1, _ := ret[1].([]error)
+	return ret0, ret1
+}
+
+// EvalPipelined indicates an expected call of EvalPipelined.
+func (mr *MockRedisMockRecorder) EvalPipelined(ctx, script, keys interface{}, batches ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, script, keys}, batches...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvalPipelined", reflect.TypeOf((*MockRedis)(nil).EvalPipelined), varargs...)
+}
+
 // Exists mocks base method.
 func (m *MockRedis) Exists(ctx context.Context, key string) error {
 	m.ctrl.T.Helper()
@@ -77,6 +117,50 @@ func (mr *MockRedisMockRecorder) ExpireAt(ctx, key, time interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireAt", reflect.TypeOf((*MockRedis)(nil).ExpireAt), ctx, key, time)
 }
 
+// HGet mocks base method.
+func (m *MockRedis) HGet(ctx context.Context, key, field string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HGet", ctx, key, field)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HGet indicates an expected call of HGet.
+func (mr *MockRedisMockRecorder) HGet(ctx, key, field interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HGet", reflect.TypeOf((*MockRedis)(nil).HGet), ctx, key, field)
+}
+
+// HGetAll mocks base method.
+func (m *MockRedis) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HGetAll", ctx, key)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HGetAll indicates an expected call of HGetAll.
+func (mr *MockRedisMockRecorder) HGetAll(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HGetAll", reflect.TypeOf((*MockRedis)(nil).HGetAll), ctx, key)
+}
+
+// HSet mocks base method.
+func (m *MockRedis) HSet(ctx context.Context, key string, values map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HSet", ctx, key, values)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HSet indicates an expected call of HSet.
+func (mr *MockRedisMockRecorder) HSet(ctx, key, values interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HSet", reflect.TypeOf((*MockRedis)(nil).HSet), ctx, key, values)
+}
+
 // Ping mocks base method.
 func (m *MockRedis) Ping(ctx context.Context) (string, error) {
 	m.ctrl.T.Helper()
@@ -92,6 +176,20 @@ func (mr *MockRedisMockRecorder) Ping(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockRedis)(nil).Ping), ctx)
 }
 
+// Rename mocks base method.
+func (m *MockRedis) Rename(ctx context.Context, key, newKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rename", ctx, key, newKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rename indicates an expected call of Rename.
+func (mr *MockRedisMockRecorder) Rename(ctx, key, newKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rename", reflect.TypeOf((*MockRedis)(nil).Rename), ctx, key, newKey)
+}
+
 // SAdd mocks base method.
 func (m *MockRedis) SAdd(ctx context.Context, key, member string) error {
 	m.ctrl.T.Helper()
@@ -140,6 +238,20 @@ func (mr *MockRedisMockRecorder) SRem(ctx, key interface{}, members ...interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SRem", reflect.TypeOf((*MockRedis)(nil).SRem), varargs...)
 }
 
+// Scan mocks base method.
+func (m *MockRedis) Scan(ctx context.Context, match string, count int64, fn func([]string) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scan", ctx, match, count, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockRedisMockRecorder) Scan(ctx, match, count, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockRedis)(nil).Scan), ctx, match, count, fn)
+}
+
 // TTL mocks base method.
 func (m *MockRedis) TTL(ctx context.Context, key string) (time.Duration, error) {
 	m.ctrl.T.Helper()
@@ -312,6 +424,22 @@ func (mr *MockRedisMockRecorder) ZRevRank(ctx, key, member interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRevRank", reflect.TypeOf((*MockRedis)(nil).ZRevRank), ctx, key, member)
 }
 
+// ZScan mocks base method.
+func (m *MockRedis) ZScan(ctx context.Context, key string, cursor uint64, count int64) ([]*Member, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZScan", ctx, key, cursor, count)
+	ret0, _ := ret[0].([]*Member)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ZScan indicates an expected call of ZScan.
+func (mr *MockRedisMockRecorder) ZScan(ctx, key, cursor, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZScan", reflect.TypeOf((*MockRedis)(nil).ZScan), ctx, key, cursor, count)
+}
+
 // ZScore mocks base method.
 func (m *MockRedis) ZScore(ctx context.Context, key, member string) (float64, error) {
 	m.ctrl.T.Helper()
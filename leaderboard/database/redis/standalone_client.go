@@ -3,6 +3,7 @@ package redis
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	goredis "github.com/go-redis/redis/v8"
@@ -43,6 +44,43 @@ func (c *standaloneClient) Del(ctx context.Context, key string) error {
 	return nil
 }
 
+// Eval call redis EVAL function, a nil reply is returned as nil
+func (c *standaloneClient) Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+	result, err := c.Client.Eval(ctx, script, keys, args...).Result()
+	if err != nil {
+		if err.Error() == "redis: nil" {
+			return nil, nil
+		}
+
+		return nil, NewGeneralError(err.Error())
+	}
+	return result, nil
+}
+
+// EvalPipelined call the script on keys once for each batch of args in a single pipeline, returning the
+// result and error of each batch, a nil error if the script succeeded
+func (c *standaloneClient) EvalPipelined(ctx context.Context, script string, keys []string, batches ...[]interface{}) ([]interface{}, []error) {
+	cmds := make([]*goredis.Cmd, 0, len(batches))
+	c.Client.Pipelined(ctx, func(pipe goredis.Pipeliner) error {
+		for _, args := range batches {
+			cmds = append(cmds, pipe.Eval(ctx, script, keys, args...))
+		}
+		return nil
+	})
+
+	results := make([]interface{}, len(cmds))
+	errs := make([]error, len(cmds))
+	for i, cmd := range cmds {
+		result, err := cmd.Result()
+		if err != nil && err.Error() != "redis: nil" {
+			errs[i] = NewGeneralError(err.Error())
+			continue
+		}
+		results[i] = result
+	}
+	return results, errs
+}
+
 // Exists return if a key exists on redis
 func (c *standaloneClient) Exists(ctx context.Context, key string) error {
 	value, err := c.Client.Exists(ctx, key).Result()
@@ -69,6 +107,42 @@ func (c *standaloneClient) ExpireAt(ctx context.Context, key string, time time.T
 	return nil
 }
 
+// HGet call redis HGET function
+func (c *standaloneClient) HGet(ctx context.Context, key, field string) (string, error) {
+	result, err := c.Client.HGet(ctx, key, field).Result()
+	if err != nil {
+		if err.Error() == "redis: nil" {
+			return "", NewMemberNotFoundError(key, field)
+		}
+
+		return "", NewGeneralError(err.Error())
+	}
+	return result, nil
+}
+
+// HGetAll call redis HGETALL function
+func (c *standaloneClient) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	result, err := c.Client.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+	return result, nil
+}
+
+// HSet call redis HSET function
+func (c *standaloneClient) HSet(ctx context.Context, key string, values map[string]string) error {
+	fields := make(map[string]interface{}, len(values))
+	for field, value := range values {
+		fields[field] = value
+	}
+
+	err := c.Client.HSet(ctx, key, fields).Err()
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+	return nil
+}
+
 // Ping call redis PING function
 func (c *standaloneClient) Ping(ctx context.Context) (string, error) {
 	result, err := c.Client.Ping(ctx).Result()
@@ -78,6 +152,19 @@ func (c *standaloneClient) Ping(ctx context.Context) (string, error) {
 	return result, nil
 }
 
+// Rename call redis RENAME function
+func (c *standaloneClient) Rename(ctx context.Context, key, newKey string) error {
+	err := c.Client.Rename(ctx, key, newKey).Err()
+	if err != nil {
+		if err.Error() == "ERR no such key" {
+			return NewKeyNotFoundError(key)
+		}
+
+		return NewGeneralError(err.Error())
+	}
+	return nil
+}
+
 // SAdd call redis SADD function
 func (c *standaloneClient) SAdd(ctx context.Context, key, member string) error {
 	err := c.Client.SAdd(ctx, key, member).Err()
@@ -105,6 +192,12 @@ func (c *standaloneClient) SRem(ctx context.Context, key string, members ...stri
 	return nil
 }
 
+// Scan call redis SCAN function with MATCH until the whole keyspace is iterated, calling fn with the keys of
+// each page. A key may be passed more than once
+func (c *standaloneClient) Scan(ctx context.Context, match string, count int64, fn func(keys []string) error) error {
+	return scanKeys(ctx, c.Client, match, count, fn)
+}
+
 // TTL call redis TTL function
 func (c *standaloneClient) TTL(ctx context.Context, key string) (time.Duration, error) {
 	result, err := c.Client.TTL(ctx, key).Result()
@@ -253,6 +346,29 @@ func (c *standaloneClient) ZRevRank(ctx context.Context, key, member string) (in
 	return result, nil
 }
 
+// ZScan call redis ZSCAN function, returning the members of a page and the cursor of the next one, zero
+// after the last page
+func (c *standaloneClient) ZScan(ctx context.Context, key string, cursor uint64, count int64) ([]*Member, uint64, error) {
+	result, next, err := c.Client.ZScan(ctx, key, cursor, "", count).Result()
+	if err != nil {
+		return nil, 0, NewGeneralError(err.Error())
+	}
+
+	members := make([]*Member, 0, len(result)/2)
+	for i := 0; i+1 < len(result); i += 2 {
+		score, err := strconv.ParseFloat(result[i+1], 64)
+		if err != nil {
+			return nil, 0, NewGeneralError(err.Error())
+		}
+		members = append(members, &Member{
+			Member: result[i],
+			Score:  score,
+		})
+	}
+
+	return members, next, nil
+}
+
 // ZScore call redis ZScore function
 func (c *standaloneClient) ZScore(ctx context.Context, key, member string) (float64, error) {
 	result, err := c.Client.ZScore(ctx, key, member).Result()
@@ -266,3 +382,26 @@ func (c *standaloneClient) ZScore(ctx context.Context, key, member string) (floa
 
 	return result, nil
 }
+
+// scanKeys iterate the keyspace of client with SCAN, calling fn with the keys of each page
+func scanKeys(ctx context.Context, client *goredis.Client, match string, count int64, fn func(keys []string) error) error {
+	cursor := uint64(0)
+	for {
+		keys, next, err := client.Scan(ctx, cursor, match, count).Result()
+		if err != nil {
+			return NewGeneralError(err.Error())
+		}
+
+		if len(keys) > 0 {
+			err = fn(keys)
+			if err != nil {
+				return err
+			}
+		}
+
+		if next == 0 {
+			return nil
+		}
+		cursor = next
+	}
+}
@@ -0,0 +1,351 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+var _ Blocklist = &Redis{}
+
+// globalBlockedMembersKey is the hash of members blocked in every leaderboard
+const globalBlockedMembersKey = "blocked"
+
+// GlobalBlocksSet is used to queue members blocked or unblocked in every leaderboard whose scores the
+// worker will move
+const GlobalBlocksSet string = "global-blocks"
+
+// legacyGlobalBlockMode marked members blocked in a leaderboard because they were blocked in every
+// leaderboard, before global blocks were applied to leaderboards without marking them
+const legacyGlobalBlockMode = "global"
+
+// blocklistFunctions are used by blocklist scripts whose KEYS are the hash of members blocked in a
+// leaderboard, the leaderboard, its shadow leaderboard and the scores of its members blocked in reject
+// mode. hideMember moves the score of member to the shadow leaderboard if mode is shadow, otherwise to
+// the blocked scores, and showMember moves it back to the leaderboard, preferring the shadow score, unless
+// member was written to the leaderboard since it was unblocked
+const blocklistFunctions = `
+local function hideMember(member, mode)
+	local store = KEYS[4]
+	if mode == 'shadow' then
+		store = KEYS[3]
+	end
+	for i = 2, 4 do
+		if KEYS[i] ~= store then
+			local score = redis.call('ZSCORE', KEYS[i], member)
+			if score then
+				redis.call('ZADD', store, score, member)
+				redis.call('ZREM', KEYS[i], member)
+			end
+		end
+	end
+end
+local function showMember(member)
+	local written = redis.call('ZSCORE', KEYS[2], member)
+	for i = 4, 3, -1 do
+		local score = redis.call('ZSCORE', KEYS[i], member)
+		if score then
+			if not written then
+				redis.call('ZADD', KEYS[2], score, member)
+			end
+			redis.call('ZREM', KEYS[i], member)
+		end
+	end
+end
+`
+
+// blockMembersScript sets mode ARGV[1] of members ARGV[2..] in hash KEYS[1]. When the other blocklist keys
+// are given, scores of members are hidden from leaderboard KEYS[2] as hideMember does
+const blockMembersScript = blocklistFunctions + `
+for i = 2, #ARGV do
+	redis.call('HSET', KEYS[1], ARGV[i], ARGV[1])
+	if #KEYS > 1 then
+		hideMember(ARGV[i], ARGV[1])
+	end
+end
+return 1
+`
+
+// unblockMembersScript deletes members ARGV from hash KEYS[1]. When the other blocklist keys are given,
+// scores of members are moved back to leaderboard KEYS[2] as showMember does
+const unblockMembersScript = blocklistFunctions + `
+for i = 1, #ARGV do
+	redis.call('HDEL', KEYS[1], ARGV[i])
+	if #KEYS > 1 then
+		showMember(ARGV[i])
+	end
+end
+return 1
+`
+
+// applyGlobalBlockScript hides scores of members ARGV[2..] that are not blocked in hash KEYS[1] with mode
+// ARGV[1] as hideMember does, or moves them back as showMember does if ARGV[1] is empty. Nothing is done
+// if a key is not a sorted set, as leaderboards are found by the names of their versions
+const applyGlobalBlockScript = blocklistFunctions + `
+for i = 2, 4 do
+	local keyType = redis.call('TYPE', KEYS[i]).ok
+	if keyType ~= 'zset' and keyType ~= 'none' then
+		return 0
+	end
+end
+for i = 2, #ARGV do
+	local mode = redis.call('HGET', KEYS[1], ARGV[i])
+	if not mode or mode == '` + legacyGlobalBlockMode + `' then
+		if ARGV[1] == '' then
+			redis.call('HDEL', KEYS[1], ARGV[i])
+			showMember(ARGV[i])
+		else
+			hideMember(ARGV[i], ARGV[1])
+		end
+	end
+end
+return 1
+`
+
+// removeQueuedGlobalBlockScript removes member ARGV[1] from sorted set KEYS[1] if its score is ARGV[2]
+const removeQueuedGlobalBlockScript = `
+if redis.call('ZSCORE', KEYS[1], ARGV[1]) ~= ARGV[2] then
+	return 0
+end
+redis.call('ZREM', KEYS[1], ARGV[1])
+return 1
+`
+
+// getBlockModesScript returns the values of fields ARGV of hash KEYS[1], empty strings for missing ones
+const getBlockModesScript = `
+local modes = redis.call('HMGET', KEYS[1], unpack(ARGV))
+for i = 1, #modes do
+	if not modes[i] then
+		modes[i] = ''
+	end
+end
+return modes
+`
+
+// getShadowMemberScript returns score of member ARGV[1] in shadow leaderboard KEYS[1] and how many
+// members of leaderboard KEYS[2] are ranked before it in order ARGV[2]
+const getShadowMemberScript = `
+local score = redis.call('ZSCORE', KEYS[1], ARGV[1])
+if not score then
+	return false
+end
+local rank
+if ARGV[2] == 'asc' then
+	rank = redis.call('ZCOUNT', KEYS[2], '-inf', '(' .. score)
+else
+	rank = redis.call('ZCOUNT', KEYS[2], '(' .. score, '+inf')
+end
+return {score, rank}
+`
+
+// BlockMembers block members in leaderboard with mode, moving their scores to the shadow leaderboard in
+// shadow mode or to the blocked scores of leaderboard in reject mode. An empty leaderboard blocks members
+// in every leaderboard, without moving their scores, see ApplyGlobalBlock
+func (r *Redis) BlockMembers(ctx context.Context, leaderboard, mode string, members ...string) error {
+	args := make([]interface{}, 0, len(members)+1)
+	args = append(args, mode)
+	for _, member := range members {
+		args = append(args, member)
+	}
+
+	keys := blocklistKeys(leaderboard)
+	_, err := r.evalWrite(ctx, keys[0], blockMembersScript, "true", keys, args...)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
+// UnblockMembers unblock members in leaderboard, moving their scores back to it. An empty leaderboard
+// unblocks members in every leaderboard, without moving their scores, see ApplyGlobalBlock
+func (r *Redis) UnblockMembers(ctx context.Context, leaderboard string, members ...string) error {
+	args := make([]interface{}, 0, len(members))
+	for _, member := range members {
+		args = append(args, member)
+	}
+
+	keys := blocklistKeys(leaderboard)
+	_, err := r.evalWrite(ctx, keys[0], unblockMembersScript, "true", keys, args...)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
+// ApplyGlobalBlock move scores of members blocked in every leaderboard with mode out of leaderboard, like
+// BlockMembers does, or back to it if mode is empty. Members blocked in leaderboard itself are kept as
+// they are
+func (r *Redis) ApplyGlobalBlock(ctx context.Context, leaderboard, mode string, members ...string) error {
+	args := make([]interface{}, 0, len(members)+1)
+	args = append(args, mode)
+	for _, member := range members {
+		args = append(args, member)
+	}
+
+	_, err := r.Client.Eval(ctx, applyGlobalBlockScript, blocklistKeys(leaderboard), args...)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
+// QueueGlobalBlock queue member, blocked or unblocked in every leaderboard, for the worker to move its
+// scores with ApplyGlobalBlock
+func (r *Redis) QueueGlobalBlock(ctx context.Context, member string) error {
+	err := r.Client.ZIncrBy(ctx, GlobalBlocksSet, member, 1)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
+// GetQueuedGlobalBlocks return members queued by QueueGlobalBlock
+func (r *Redis) GetQueuedGlobalBlocks(ctx context.Context) ([]*QueuedGlobalBlock, error) {
+	members, err := r.Client.ZRange(ctx, GlobalBlocksSet, 0, -1)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	blocks := make([]*QueuedGlobalBlock, 0, len(members))
+	for _, member := range members {
+		blocks = append(blocks, &QueuedGlobalBlock{
+			Member: member.Member,
+			Times:  int64(member.Score),
+		})
+	}
+
+	return blocks, nil
+}
+
+// RemoveQueuedGlobalBlock remove block from the queue unless its member was queued again since it was
+// read, returning if it was removed
+func (r *Redis) RemoveQueuedGlobalBlock(ctx context.Context, block *QueuedGlobalBlock) (bool, error) {
+	result, err := r.Client.Eval(
+		ctx, removeQueuedGlobalBlockScript, []string{GlobalBlocksSet}, block.Member, strconv.FormatInt(block.Times, 10),
+	)
+	if err != nil {
+		return false, NewGeneralError(err.Error())
+	}
+
+	return result == int64(1), nil
+}
+
+// GetBlockedMembers return how each of members is blocked in leaderboard and in every leaderboard, an
+// empty mode if it is not
+func (r *Redis) GetBlockedMembers(ctx context.Context, leaderboard string, members ...string) ([]*BlockedMember, error) {
+	if len(members) == 0 {
+		return []*BlockedMember{}, nil
+	}
+
+	modes, err := r.getBlockModes(ctx, blockedMembersKey(leaderboard), members)
+	if err != nil {
+		return nil, err
+	}
+
+	globalModes, err := r.getBlockModes(ctx, globalBlockedMembersKey, members)
+	if err != nil {
+		return nil, err
+	}
+
+	blockedMembers := make([]*BlockedMember, 0, len(members))
+	for i := range members {
+		if modes[i] == legacyGlobalBlockMode {
+			modes[i] = ""
+		}
+		blockedMembers = append(blockedMembers, &BlockedMember{
+			Mode:       modes[i],
+			GlobalMode: globalModes[i],
+		})
+	}
+
+	return blockedMembers, nil
+}
+
+func (r *Redis) getBlockModes(ctx context.Context, key string, members []string) ([]string, error) {
+	args := make([]interface{}, 0, len(members))
+	for _, member := range members {
+		args = append(args, member)
+	}
+
+	result, err := r.Client.Eval(ctx, getBlockModesScript, []string{key}, args...)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	values, ok := result.([]interface{})
+	if !ok || len(values) != len(members) {
+		return nil, NewGeneralError(fmt.Sprintf("unexpected block modes result %v", result))
+	}
+
+	modes := make([]string, 0, len(values))
+	for _, value := range values {
+		modes = append(modes, fmt.Sprint(value))
+	}
+
+	return modes, nil
+}
+
+// GetShadowMember return member of the shadow leaderboard of leaderboard, ranked as if it was in
+// leaderboard, or nil if it has no shadow score
+func (r *Redis) GetShadowMember(ctx context.Context, leaderboard, member, order string) (*Member, error) {
+	if order != "asc" && order != "desc" {
+		return nil, NewInvalidOrderError(order)
+	}
+
+	result, err := r.Client.Eval(
+		ctx, getShadowMemberScript, []string{ShadowLeaderboard(leaderboard), leaderboard}, member, order,
+	)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+	if result == nil {
+		return nil, nil
+	}
+
+	values, ok := result.([]interface{})
+	if !ok || len(values) != 2 {
+		return nil, NewGeneralError(fmt.Sprintf("unexpected shadow member result %v", result))
+	}
+
+	score, err := strconv.ParseFloat(fmt.Sprint(values[0]), 64)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	rank, err := strconv.ParseInt(fmt.Sprint(values[1]), 10, 64)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	return &Member{
+		Member: member,
+		Score:  score,
+		Rank:   rank,
+	}, nil
+}
+
+// ShadowLeaderboard return the leaderboard keeping scores of members blocked in leaderboard in shadow mode
+func ShadowLeaderboard(leaderboard string) string {
+	return LeaderboardKey(leaderboard, "shadow")
+}
+
+func blockedMembersKey(leaderboard string) string {
+	return LeaderboardKey(leaderboard, "blocked")
+}
+
+// blockedScoresKey return the sorted set keeping scores of members blocked in leaderboard in reject mode
+func blockedScoresKey(leaderboard string) string {
+	return LeaderboardKey(leaderboard, "blocked:scores")
+}
+
+func blocklistKeys(leaderboard string) []string {
+	if leaderboard == "" {
+		return []string{globalBlockedMembersKey}
+	}
+
+	return []string{blockedMembersKey(leaderboard), leaderboard, ShadowLeaderboard(leaderboard), blockedScoresKey(leaderboard)}
+}
@@ -0,0 +1,229 @@
+package database_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+var _ = Describe("Redis Blocklist Database", func() {
+	var ctrl *gomock.Controller
+	var mock *redis.MockRedis
+	var redisDatabase *database.Redis
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = redis.NewMockRedis(ctrl)
+
+		redisDatabase = &database.Redis{mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("BlockMembers", func() {
+		It("Should block members moving their scores to the shadow leaderboard", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{"{leaderboardTest}:blocked", leaderboard, "{leaderboardTest}:shadow", "{leaderboardTest}:blocked:scores"}),
+				gomock.Eq("shadow"),
+				gomock.Eq("member1"),
+				gomock.Eq("member2"),
+			).Return(int64(1), nil)
+
+			err := redisDatabase.BlockMembers(context.Background(), leaderboard, "shadow", "member1", "member2")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should block members in every leaderboard if leaderboard is empty", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{"blocked"}),
+				gomock.Eq("reject"),
+				gomock.Eq("member1"),
+			).Return(int64(1), nil)
+
+			err := redisDatabase.BlockMembers(context.Background(), "", "reject", "member1")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			err := redisDatabase.BlockMembers(context.Background(), leaderboard, "shadow", "member1")
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("UnblockMembers", func() {
+		It("Should unblock members moving their scores back to the leaderboard", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{"{leaderboardTest}:blocked", leaderboard, "{leaderboardTest}:shadow", "{leaderboardTest}:blocked:scores"}),
+				gomock.Eq("member1"),
+			).Return(int64(1), nil)
+
+			err := redisDatabase.UnblockMembers(context.Background(), leaderboard, "member1")
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("ApplyGlobalBlock", func() {
+		It("Should move scores of members blocked in every leaderboard out of leaderboard", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{"{leaderboardTest}:blocked", leaderboard, "{leaderboardTest}:shadow", "{leaderboardTest}:blocked:scores"}),
+				gomock.Eq("reject"),
+				gomock.Eq("member1"),
+			).Return(int64(1), nil)
+
+			err := redisDatabase.ApplyGlobalBlock(context.Background(), leaderboard, "reject", "member1")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			err := redisDatabase.ApplyGlobalBlock(context.Background(), leaderboard, "", "member1")
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("QueueGlobalBlock", func() {
+		It("Should count member in the queue of global blocks", func() {
+			mock.EXPECT().ZIncrBy(gomock.Any(), gomock.Eq(database.GlobalBlocksSet), gomock.Eq("member1"), gomock.Eq(float64(1))).Return(nil)
+
+			err := redisDatabase.QueueGlobalBlock(context.Background(), "member1")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().ZIncrBy(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("redis error"))
+
+			err := redisDatabase.QueueGlobalBlock(context.Background(), "member1")
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("GetQueuedGlobalBlocks", func() {
+		It("Should return queued members with how many times they were queued", func() {
+			mock.EXPECT().ZRange(gomock.Any(), gomock.Eq(database.GlobalBlocksSet), gomock.Eq(int64(0)), gomock.Eq(int64(-1))).Return([]*redis.Member{
+				{Member: "member1", Score: 2},
+			}, nil)
+
+			blocks, err := redisDatabase.GetQueuedGlobalBlocks(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(blocks).To(Equal([]*database.QueuedGlobalBlock{{Member: "member1", Times: 2}}))
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().ZRange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.GetQueuedGlobalBlocks(context.Background())
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("RemoveQueuedGlobalBlock", func() {
+		It("Should remove block if its member was not queued again", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(), gomock.Any(), gomock.Eq([]string{database.GlobalBlocksSet}), gomock.Eq("member1"), gomock.Eq("2"),
+			).Return(int64(1), nil)
+
+			removed, err := redisDatabase.RemoveQueuedGlobalBlock(context.Background(), &database.QueuedGlobalBlock{Member: "member1", Times: 2})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(BeTrue())
+		})
+
+		It("Should keep block if its member was queued again", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(0), nil)
+
+			removed, err := redisDatabase.RemoveQueuedGlobalBlock(context.Background(), &database.QueuedGlobalBlock{Member: "member1", Times: 1})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(BeFalse())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.RemoveQueuedGlobalBlock(context.Background(), &database.QueuedGlobalBlock{Member: "member1", Times: 1})
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("GetBlockedMembers", func() {
+		It("Should return block modes of members in leaderboard and in every leaderboard", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(), gomock.Any(), gomock.Eq([]string{"{leaderboardTest}:blocked"}), gomock.Eq("member1"), gomock.Eq("member2"),
+			).Return([]interface{}{"shadow", ""}, nil)
+			mock.EXPECT().Eval(
+				gomock.Any(), gomock.Any(), gomock.Eq([]string{"blocked"}), gomock.Eq("member1"), gomock.Eq("member2"),
+			).Return([]interface{}{"", "reject"}, nil)
+
+			blockedMembers, err := redisDatabase.GetBlockedMembers(context.Background(), leaderboard, "member1", "member2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(blockedMembers).To(Equal([]*database.BlockedMember{
+				{Mode: "shadow", GlobalMode: ""},
+				{Mode: "", GlobalMode: "reject"},
+			}))
+		})
+
+		It("Should not return the mode marking members blocked in every leaderboard by older versions", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"{leaderboardTest}:blocked"}), gomock.Eq("member1")).Return([]interface{}{"global"}, nil)
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"blocked"}), gomock.Eq("member1")).Return([]interface{}{"shadow"}, nil)
+
+			blockedMembers, err := redisDatabase.GetBlockedMembers(context.Background(), leaderboard, "member1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(blockedMembers).To(Equal([]*database.BlockedMember{{Mode: "", GlobalMode: "shadow"}}))
+		})
+
+		It("Should not call redis without members", func() {
+			blockedMembers, err := redisDatabase.GetBlockedMembers(context.Background(), leaderboard)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(blockedMembers).To(BeEmpty())
+		})
+
+		It("Should return GeneralError if redis return an unexpected result", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]interface{}{}, nil)
+
+			_, err := redisDatabase.GetBlockedMembers(context.Background(), leaderboard, "member1")
+			Expect(err).To(BeAssignableToTypeOf(&database.GeneralError{}))
+		})
+	})
+
+	Describe("GetShadowMember", func() {
+		It("Should return shadow member ranked in leaderboard", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(), gomock.Any(), gomock.Eq([]string{"{leaderboardTest}:shadow", leaderboard}), gomock.Eq("member1"), gomock.Eq("desc"),
+			).Return([]interface{}{"150", int64(3)}, nil)
+
+			member, err := redisDatabase.GetShadowMember(context.Background(), leaderboard, "member1", "desc")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member).To(Equal(&database.Member{Member: "member1", Score: 150, Rank: 3}))
+		})
+
+		It("Should return nil if member has no shadow score", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+
+			member, err := redisDatabase.GetShadowMember(context.Background(), leaderboard, "member1", "asc")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member).To(BeNil())
+		})
+
+		It("Should return InvalidOrderError if order is invalid", func() {
+			_, err := redisDatabase.GetShadowMember(context.Background(), leaderboard, "member1", "invalid")
+			Expect(err).To(Equal(database.NewInvalidOrderError("invalid")))
+		})
+	})
+})
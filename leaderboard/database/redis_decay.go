@@ -0,0 +1,68 @@
+package database
+
+import (
+	"context"
+	"strconv"
+)
+
+var _ Decay = &Redis{}
+
+// DecaySet is used to list leaderboards with score decay that worker will renormalize
+const DecaySet string = "decay-sets"
+
+// scaleLeaderboardScript multiplies all scores of KEYS[1] by ARGV[1] and writes the remaining
+// ARGV field/value pairs into the settings hash KEYS[2], keeping leaderboard expiration
+const scaleLeaderboardScript = `
+local ttl = redis.call('PTTL', KEYS[1])
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	redis.call('ZUNIONSTORE', KEYS[1], 1, KEYS[1], 'WEIGHTS', ARGV[1])
+	if ttl > 0 then
+		redis.call('PEXPIRE', KEYS[1], ttl)
+	end
+end
+if #ARGV > 1 then
+	redis.call('HSET', KEYS[2], unpack(ARGV, 2))
+end
+return 1
+`
+
+// AddLeaderboardToDecayList add leaderboard to the list of leaderboards with score decay
+func (r *Redis) AddLeaderboardToDecayList(ctx context.Context, leaderboard string) error {
+	return r.writeCommand(ctx, leaderboard, "SADD", DecaySet, leaderboard)
+}
+
+// GetDecayLeaderboards return leaderboards registered with score decay
+func (r *Redis) GetDecayLeaderboards(ctx context.Context) ([]string, error) {
+	leaderboards, err := r.Client.SMembers(ctx, DecaySet)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	return leaderboards, nil
+}
+
+// RemoveLeaderboardFromDecayList remove leaderboard from the list of leaderboards with score decay
+func (r *Redis) RemoveLeaderboardFromDecayList(ctx context.Context, leaderboard string) error {
+	err := r.Client.SRem(ctx, DecaySet, leaderboard)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
+// ScaleLeaderboard multiply all leaderboard scores by factor and update leaderboard settings atomically
+func (r *Redis) ScaleLeaderboard(ctx context.Context, leaderboard string, factor float64, settings map[string]string) error {
+	args := make([]interface{}, 0, 2*len(settings)+1)
+	args = append(args, strconv.FormatFloat(factor, 'g', -1, 64))
+	for field, value := range settings {
+		args = append(args, field, value)
+	}
+
+	_, err := r.evalWrite(ctx, leaderboard, scaleLeaderboardScript, "true", []string{leaderboard, settingsKey(leaderboard)}, args...)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package database_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+var _ = Describe("Redis Decay Database", func() {
+	var ctrl *gomock.Controller
+	var mock *redis.MockRedis
+	var redisDatabase *database.Redis
+	var leaderboard string = "leaderboardTest"
+	var leaderboardSettings string = "{leaderboardTest}:settings"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = redis.NewMockRedis(ctrl)
+
+		redisDatabase = &database.Redis{mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("AddLeaderboardToDecayList", func() {
+		It("Should return nil if all is OK", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{database.DecaySet}), gomock.Eq("SADD"), gomock.Eq(leaderboard)).Return(int64(1), nil)
+
+			err := redisDatabase.AddLeaderboardToDecayList(context.Background(), leaderboard)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{database.DecaySet}), gomock.Eq("SADD"), gomock.Eq(leaderboard)).Return(nil, fmt.Errorf("redis error"))
+
+			err := redisDatabase.AddLeaderboardToDecayList(context.Background(), leaderboard)
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("GetDecayLeaderboards", func() {
+		It("Should return leaderboards with decay if all is OK", func() {
+			mock.EXPECT().SMembers(gomock.Any(), gomock.Eq(database.DecaySet)).Return([]string{leaderboard}, nil)
+
+			leaderboards, err := redisDatabase.GetDecayLeaderboards(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(leaderboards).To(Equal([]string{leaderboard}))
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().SMembers(gomock.Any(), gomock.Eq(database.DecaySet)).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.GetDecayLeaderboards(context.Background())
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("RemoveLeaderboardFromDecayList", func() {
+		It("Should return nil if all is OK", func() {
+			mock.EXPECT().SRem(gomock.Any(), gomock.Eq(database.DecaySet), gomock.Eq(leaderboard)).Return(nil)
+
+			err := redisDatabase.RemoveLeaderboardFromDecayList(context.Background(), leaderboard)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().SRem(gomock.Any(), gomock.Eq(database.DecaySet), gomock.Eq(leaderboard)).Return(fmt.Errorf("redis error"))
+
+			err := redisDatabase.RemoveLeaderboardFromDecayList(context.Background(), leaderboard)
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("ScaleLeaderboard", func() {
+		settings := map[string]string{"decayLandmark": "1600000000"}
+
+		It("Should return nil if all is OK", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{leaderboard, leaderboardSettings}),
+				gomock.Eq("0.25"),
+				gomock.Eq("decayLandmark"),
+				gomock.Eq("1600000000"),
+			).Return(int64(1), nil)
+
+			err := redisDatabase.ScaleLeaderboard(context.Background(), leaderboard, 0.25, settings)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{leaderboard, leaderboardSettings}),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+			).Return(nil, fmt.Errorf("redis error"))
+
+			err := redisDatabase.ScaleLeaderboard(context.Background(), leaderboard, 0.25, settings)
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+})
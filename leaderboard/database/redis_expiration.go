@@ -65,9 +65,9 @@ func (r *Redis) RemoveLeaderboardFromExpireList(ctx context.Context, leaderboard
 func (r *Redis) ExpireMembers(ctx context.Context, leaderboard string, members []string) error {
 	leaderboardExpirationKey := fmt.Sprintf("%s:ttl", leaderboard)
 
-	err := r.Client.ZRem(ctx, leaderboard, members...)
+	err := r.writeCommand(ctx, leaderboard, "ZREM", leaderboard, stringArgs(members)...)
 	if err != nil {
-		return NewGeneralError(err.Error())
+		return err
 	}
 
 	err = r.Client.ZRem(ctx, leaderboardExpirationKey, members...)
@@ -101,7 +101,7 @@ var _ = Describe("Redis Expiration Database", func() {
 		It("Should return nil if all is ok", func() {
 			member2 := "member2"
 
-			mock.EXPECT().ZRem(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(member2)).Return(nil)
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{leaderboard}), gomock.Eq("ZREM"), gomock.Eq(member), gomock.Eq(member2)).Return(int64(2), nil)
 			mock.EXPECT().ZRem(gomock.Any(), gomock.Eq(leaderboardTTL), gomock.Eq(member), gomock.Eq(member2)).Return(nil)
 
 			err := redisExpiration.ExpireMembers(context.Background(), leaderboard, []string{member, member2})
@@ -111,7 +111,7 @@ var _ = Describe("Redis Expiration Database", func() {
 		It("Should return GeneralError if redis return in error on remove member from leaderboard", func() {
 			member2 := "member2"
 
-			mock.EXPECT().ZRem(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(member2)).Return(fmt.Errorf("New redis error"))
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{leaderboard}), gomock.Eq("ZREM"), gomock.Eq(member), gomock.Eq(member2)).Return(nil, fmt.Errorf("New redis error"))
 
 			err := redisExpiration.ExpireMembers(context.Background(), leaderboard, []string{member, member2})
 			Expect(err).To(MatchError(database.NewGeneralError("New redis error")))
@@ -120,7 +120,7 @@ var _ = Describe("Redis Expiration Database", func() {
 		It("Should return GeneralError if redis return in error on remove member from expiration set", func() {
 			member2 := "member2"
 
-			mock.EXPECT().ZRem(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(member2)).Return(nil)
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{leaderboard}), gomock.Eq("ZREM"), gomock.Eq(member), gomock.Eq(member2)).Return(int64(2), nil)
 			mock.EXPECT().ZRem(gomock.Any(), gomock.Eq(leaderboardTTL), gomock.Eq(member), gomock.Eq(member2)).Return(fmt.Errorf("New redis error"))
 
 			err := redisExpiration.ExpireMembers(context.Background(), leaderboard, []string{member, member2})
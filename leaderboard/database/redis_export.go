@@ -0,0 +1,129 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+// copyExportScript copies sorted set KEYS[1] into KEYS[2], expiring at unix milliseconds ARGV[1], and returns
+// how many members were copied
+const copyExportScript = `
+local total = redis.call('ZUNIONSTORE', KEYS[2], 1, KEYS[1])
+redis.call('PEXPIREAT', KEYS[2], ARGV[1])
+return total
+`
+
+// getExpirationsScript returns the expiration in KEYS[1] of each member in ARGV, empty if member has none
+const getExpirationsScript = `
+local expirations = {}
+for i = 1, #ARGV do
+	expirations[i] = redis.call('ZSCORE', KEYS[1], ARGV[i]) or ''
+end
+return expirations
+`
+
+// CreateExportCopy copy leaderboard and its members expirations into the copies named export, expiring at
+// expireAt, so they can be read in pages that are not changed by writes. Each copy is taken at once in the
+// slot of what it copies, the expirations right after the members. It returns how many members were copied
+func (r *Redis) CreateExportCopy(ctx context.Context, leaderboard, export string, expireAt time.Time) (int, error) {
+	expireAtMilli := strconv.FormatInt(expireAt.UnixNano()/int64(time.Millisecond), 10)
+	result, err := r.Client.Eval(ctx, copyExportScript, []string{leaderboard, exportKey(leaderboard, export)}, expireAtMilli)
+	if err != nil {
+		return 0, NewGeneralError(err.Error())
+	}
+
+	total, ok := result.(int64)
+	if !ok {
+		return 0, NewGeneralError(fmt.Sprintf("unexpected export copy result %v", result))
+	}
+
+	expirationKey := fmt.Sprintf("%s:ttl", leaderboard)
+	_, err = r.Client.Eval(ctx, copyExportScript, []string{expirationKey, exportKey(expirationKey, export)}, expireAtMilli)
+	if err != nil {
+		return 0, NewGeneralError(err.Error())
+	}
+
+	return int(total), nil
+}
+
+// GetExportMembers return members from start to stop of the export copy of leaderboard in order with their
+// score, rank and expiration
+func (r *Redis) GetExportMembers(ctx context.Context, leaderboard, export string, start, stop int, order string) ([]*Member, error) {
+	var redisMembers []*redis.Member
+	var err error
+	switch order {
+	case "asc":
+		redisMembers, err = r.Client.ZRange(ctx, exportKey(leaderboard, export), int64(start), int64(stop))
+	case "desc":
+		redisMembers, err = r.Client.ZRevRange(ctx, exportKey(leaderboard, export), int64(start), int64(stop))
+	default:
+		return nil, NewInvalidOrderError(order)
+	}
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	members := make([]*Member, 0, len(redisMembers))
+	if len(redisMembers) == 0 {
+		return members, nil
+	}
+
+	ids := make([]interface{}, 0, len(redisMembers))
+	for _, member := range redisMembers {
+		ids = append(ids, member.Member)
+	}
+
+	expirationKey := exportKey(fmt.Sprintf("%s:ttl", leaderboard), export)
+	result, err := r.Client.Eval(ctx, getExpirationsScript, []string{expirationKey}, ids...)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	expirations, ok := result.([]interface{})
+	if !ok || len(expirations) != len(redisMembers) {
+		return nil, NewGeneralError(fmt.Sprintf("unexpected export expirations result %v", result))
+	}
+
+	for i, redisMember := range redisMembers {
+		member := &Member{
+			Member: redisMember.Member,
+			Score:  redisMember.Score,
+			Rank:   int64(start + i),
+		}
+
+		if ttl := fmt.Sprint(expirations[i]); ttl != "" {
+			expireAt, err := strconv.ParseFloat(ttl, 64)
+			if err != nil {
+				return nil, NewGeneralError(err.Error())
+			}
+			member.TTL = time.Unix(int64(expireAt), 0)
+		}
+
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+// RemoveExportCopy remove the export copies of leaderboard and its members expirations
+func (r *Redis) RemoveExportCopy(ctx context.Context, leaderboard, export string) error {
+	err := r.Client.Del(ctx, exportKey(leaderboard, export))
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	err = r.Client.Del(ctx, exportKey(fmt.Sprintf("%s:ttl", leaderboard), export))
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+	return nil
+}
+
+// exportKey return the key of the export copy of key, in the slot of key
+func exportKey(key, export string) string {
+	return LeaderboardKey(key, "export:"+export)
+}
@@ -0,0 +1,123 @@
+package database_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+var _ = Describe("Redis Export Database", func() {
+	var ctrl *gomock.Controller
+	var mock *redis.MockRedis
+	var redisDatabase *database.Redis
+	var leaderboard string = "leaderboardTest"
+	var export string = "1"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = redis.NewMockRedis(ctrl)
+
+		redisDatabase = &database.Redis{mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("CreateExportCopy", func() {
+		It("Should copy leaderboard and its expirations each in their slot and return how many members were copied", func() {
+			gomock.InOrder(
+				mock.EXPECT().Eval(gomock.Any(), gomock.Any(),
+					gomock.Eq([]string{leaderboard, "{leaderboardTest}:export:1"}),
+					gomock.Eq("1600000000000"),
+				).Return(int64(3), nil),
+				mock.EXPECT().Eval(gomock.Any(), gomock.Any(),
+					gomock.Eq([]string{"leaderboardTest:ttl", "{leaderboardTest:ttl}:export:1"}),
+					gomock.Eq("1600000000000"),
+				).Return(int64(1), nil),
+			)
+
+			total, err := redisDatabase.CreateExportCopy(context.Background(), leaderboard, export, time.Unix(1600000000, 0))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(total).To(Equal(3))
+		})
+
+		It("Should keep the hash tag of leaderboard in its copies", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"{ladder}:season1", "{ladder}:season1:export:1"}), gomock.Any()).Return(int64(0), nil)
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"{ladder}:season1:ttl", "{ladder}:season1:ttl:export:1"}), gomock.Any()).Return(int64(0), nil)
+
+			_, err := redisDatabase.CreateExportCopy(context.Background(), "{ladder}:season1", export, time.Unix(1600000000, 0))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.CreateExportCopy(context.Background(), leaderboard, export, time.Unix(1600000000, 0))
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("GetExportMembers", func() {
+		It("Should return a page of the copy with members rank and copied expiration", func() {
+			mock.EXPECT().ZRevRange(gomock.Any(), gomock.Eq("{leaderboardTest}:export:1"), gomock.Eq(int64(2)), gomock.Eq(int64(3))).Return([]*redis.Member{
+				{Member: "member3", Score: 30},
+				{Member: "member4", Score: 20},
+			}, nil)
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(),
+				gomock.Eq([]string{"{leaderboardTest:ttl}:export:1"}),
+				gomock.Eq("member3"), gomock.Eq("member4"),
+			).Return([]interface{}{"1600000000", ""}, nil)
+
+			members, err := redisDatabase.GetExportMembers(context.Background(), leaderboard, export, 2, 3, "desc")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(members).To(Equal([]*database.Member{
+				{Member: "member3", Score: 30, Rank: 2, TTL: time.Unix(1600000000, 0)},
+				{Member: "member4", Score: 20, Rank: 3},
+			}))
+		})
+
+		It("Should not read expirations if page is empty", func() {
+			mock.EXPECT().ZRange(gomock.Any(), gomock.Eq("{leaderboardTest}:export:1"), gomock.Eq(int64(0)), gomock.Eq(int64(1))).Return([]*redis.Member{}, nil)
+
+			members, err := redisDatabase.GetExportMembers(context.Background(), leaderboard, export, 0, 1, "asc")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(members).To(BeEmpty())
+		})
+
+		It("Should return InvalidOrderError if order is invalid", func() {
+			_, err := redisDatabase.GetExportMembers(context.Background(), leaderboard, export, 0, 1, "invalid")
+			Expect(err).To(Equal(database.NewInvalidOrderError("invalid")))
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().ZRange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.GetExportMembers(context.Background(), leaderboard, export, 0, 1, "asc")
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("RemoveExportCopy", func() {
+		It("Should remove the copies of leaderboard and its expirations", func() {
+			mock.EXPECT().Del(gomock.Any(), gomock.Eq("{leaderboardTest}:export:1")).Return(nil)
+			mock.EXPECT().Del(gomock.Any(), gomock.Eq("{leaderboardTest:ttl}:export:1")).Return(nil)
+
+			err := redisDatabase.RemoveExportCopy(context.Background(), leaderboard, export)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Del(gomock.Any(), gomock.Eq("{leaderboardTest}:export:1")).Return(fmt.Errorf("redis error"))
+
+			err := redisDatabase.RemoveExportCopy(context.Background(), leaderboard, export)
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+})
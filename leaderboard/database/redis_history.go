@@ -0,0 +1,91 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// addHistorySamplesScript adds to each history of KEYS the sample ARGV[2i + 2] with score ARGV[2i + 1],
+// first removing samples of the same interval of ARGV[2] milliseconds when it is positive, and expiring
+// the histories at unix milliseconds ARGV[1] if it is not empty
+const addHistorySamplesScript = `
+local interval = tonumber(ARGV[2])
+for i, key in ipairs(KEYS) do
+	local sampledAt = tonumber(ARGV[2 * i + 1])
+	if interval > 0 then
+		redis.call('ZREMRANGEBYSCORE', key, sampledAt - sampledAt % interval, '+inf')
+	end
+	redis.call('ZADD', key, sampledAt, ARGV[2 * i + 2])
+	if ARGV[1] ~= '' then
+		redis.call('PEXPIREAT', key, ARGV[1])
+	end
+end
+return 1
+`
+
+// AddHistorySamples add samples to the histories of their members in leaderboard. When interval is positive
+// only the last sample of each member in each interval is kept, intervals are aligned to the unix epoch.
+// Histories expire at expireAt unless it is zero
+func (r *Redis) AddHistorySamples(ctx context.Context, leaderboard string, samples []*HistorySample, interval time.Duration, expireAt time.Time) error {
+	if len(samples) == 0 {
+		return nil
+	}
+
+	expireAtArg := ""
+	if !expireAt.IsZero() {
+		expireAtArg = strconv.FormatInt(expireAt.UnixNano()/int64(time.Millisecond), 10)
+	}
+
+	keys := make([]string, 0, len(samples))
+	args := make([]interface{}, 0, 2*len(samples)+2)
+	args = append(args, expireAtArg, strconv.FormatInt(int64(interval/time.Millisecond), 10))
+	for _, sample := range samples {
+		value, err := json.Marshal(sample)
+		if err != nil {
+			return NewGeneralError(err.Error())
+		}
+
+		keys = append(keys, historyKey(leaderboard, sample.Member))
+		args = append(args, strconv.FormatInt(sample.SampledAt.UnixNano()/int64(time.Millisecond), 10), string(value))
+	}
+
+	_, err := r.Client.Eval(ctx, addHistorySamplesScript, keys, args...)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
+// GetHistorySamples return the samples of member history in leaderboard taken from from to to, the oldest
+// first. A zero to does not limit the newest sample
+func (r *Redis) GetHistorySamples(ctx context.Context, leaderboard, member string, from, to time.Time) ([]*HistorySample, error) {
+	min := strconv.FormatInt(from.UnixNano()/int64(time.Millisecond), 10)
+	max := "+inf"
+	if !to.IsZero() {
+		max = strconv.FormatInt(to.UnixNano()/int64(time.Millisecond), 10)
+	}
+
+	values, err := r.Client.ZRangeByScore(ctx, historyKey(leaderboard, member), min, max, 0, 0)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	samples := make([]*HistorySample, 0, len(values))
+	for _, value := range values {
+		sample := &HistorySample{}
+		err = json.Unmarshal([]byte(value), sample)
+		if err != nil {
+			return nil, NewGeneralError(err.Error())
+		}
+		samples = append(samples, sample)
+	}
+
+	return samples, nil
+}
+
+func historyKey(leaderboard, member string) string {
+	return LeaderboardKey(leaderboard, "history:"+member)
+}
@@ -0,0 +1,109 @@
+package database_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+var _ = Describe("Redis History Database", func() {
+	var ctrl *gomock.Controller
+	var mock *redis.MockRedis
+	var redisDatabase *database.Redis
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = redis.NewMockRedis(ctrl)
+
+		redisDatabase = &database.Redis{mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("AddHistorySamples", func() {
+		var sample *database.HistorySample
+
+		BeforeEach(func() {
+			sample = &database.HistorySample{
+				Member:    "member1",
+				Score:     100,
+				Rank:      2,
+				SampledAt: time.Unix(1600000000, 0),
+			}
+		})
+
+		It("Should add samples to member histories keeping one per interval", func() {
+			value, err := json.Marshal(sample)
+			Expect(err).NotTo(HaveOccurred())
+
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{"{leaderboardTest}:history:member1"}),
+				gomock.Eq("1700000000000"),
+				gomock.Eq("60000"),
+				gomock.Eq("1600000000000"),
+				gomock.Eq(string(value)),
+			).Return(int64(1), nil)
+
+			err = redisDatabase.AddHistorySamples(context.Background(), leaderboard, []*database.HistorySample{sample}, time.Minute, time.Unix(1700000000, 0))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should not expire member histories if expireAt is zero", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(""), gomock.Eq("0"), gomock.Any(), gomock.Any()).Return(int64(1), nil)
+
+			err := redisDatabase.AddHistorySamples(context.Background(), leaderboard, []*database.HistorySample{sample}, 0, time.Time{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			err := redisDatabase.AddHistorySamples(context.Background(), leaderboard, []*database.HistorySample{sample}, 0, time.Time{})
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("GetHistorySamples", func() {
+		It("Should return samples taken inside time range", func() {
+			mock.EXPECT().ZRangeByScore(
+				gomock.Any(), gomock.Eq("{leaderboardTest}:history:member1"), gomock.Eq("1600000000000"), gomock.Eq("1600003600000"), gomock.Eq(int64(0)), gomock.Eq(int64(0)),
+			).Return([]string{
+				`{"member":"member1","score":100,"rank":2,"sampledAt":"2020-09-13T12:26:40Z"}`,
+			}, nil)
+
+			samples, err := redisDatabase.GetHistorySamples(context.Background(), leaderboard, "member1", time.Unix(1600000000, 0), time.Unix(1600003600, 0))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(samples).To(HaveLen(1))
+			Expect(samples[0].Score).To(Equal(int64(100)))
+			Expect(samples[0].Rank).To(Equal(2))
+			Expect(samples[0].SampledAt.Unix()).To(Equal(int64(1600000000)))
+		})
+
+		It("Should not limit newest sample if to is zero", func() {
+			mock.EXPECT().ZRangeByScore(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq("+inf"), gomock.Any(), gomock.Any()).Return([]string{}, nil)
+
+			samples, err := redisDatabase.GetHistorySamples(context.Background(), leaderboard, "member1", time.Unix(0, 0), time.Time{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(samples).To(BeEmpty())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().ZRangeByScore(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.GetHistorySamples(context.Background(), leaderboard, "member1", time.Unix(0, 0), time.Time{})
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+})
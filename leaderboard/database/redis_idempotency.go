@@ -0,0 +1,287 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// checkIdempotencyScript starts the idempotent write scripts. When idempotency key KEYS[2] exists it returns
+// {2} if its fingerprint is not ARGV[2], or {1, values...} with the values stored by the first write
+const checkIdempotencyScript = `
+local fingerprint = redis.call('HGET', KEYS[2], 'fingerprint')
+if fingerprint then
+	if fingerprint ~= ARGV[2] then
+		return {2}
+	end
+	local stored = cjson.decode(redis.call('HGET', KEYS[2], 'result'))
+	table.insert(stored, 1, 1)
+	return stored
+end
+`
+
+// storeIdempotencyScript ends the idempotent write scripts, storing fingerprint ARGV[2] and the values of the
+// write in idempotency key KEYS[2] expiring in ARGV[1] milliseconds and returning {0, values...}.
+// For count members written values are, in groups of count: versions, scores, ranks, previous scores,
+// empty for members that did not exist, previous ranks, -1 for members that did not exist, and the
+// unix times their scores expire at, 0 for scores that do not expire. Ranks are in descending order
+const storeIdempotencyScript = `
+redis.call('HSET', KEYS[2], 'fingerprint', ARGV[2], 'result', cjson.encode(values))
+redis.call('PEXPIRE', KEYS[2], ARGV[1])
+table.insert(values, 1, 0)
+return values
+`
+
+// setMembersIdempotentScript applies ZADD of ARGV[4..] score, member, expiration time and increase quadruples
+// to KEYS[1], incrementing their versions in KEYS[3] and adding their increases to the total increases KEYS[4]
+// expiring at ARGV[3] as addIncrease does, only if idempotency key KEYS[2] does not exist. Each quadruple is
+// followed by the expected score and increase cap of the member, and {3} is returned without writing if
+// scoreChanged holds for a member
+const setMembersIdempotentScript = addIncreaseFunction + scoreChangedFunction + checkIdempotencyScript + `
+local count = (#ARGV - 3) / 6
+for i = 0, count - 1 do
+	if scoreChanged(KEYS[1], KEYS[4], ARGV[5 + 6 * i], ARGV[8 + 6 * i], ARGV[7 + 6 * i], ARGV[9 + 6 * i]) then
+		return {3}
+	end
+end
+local values = {}
+for i = 0, count - 1 do
+	local member = ARGV[5 + 6 * i]
+	values[3 * count + i + 1] = redis.call('ZSCORE', KEYS[1], member) or ''
+	values[4 * count + i + 1] = tostring(redis.call('ZREVRANK', KEYS[1], member) or -1)
+	redis.call('ZADD', KEYS[1], ARGV[4 + 6 * i], member)
+	values[i + 1] = tostring(redis.call('HINCRBY', KEYS[3], member, 1))
+	values[5 * count + i + 1] = ARGV[6 + 6 * i]
+	addIncrease(KEYS[4], member, ARGV[7 + 6 * i], ARGV[3])
+end
+for i = 0, count - 1 do
+	local member = ARGV[5 + 6 * i]
+	values[count + i + 1] = redis.call('ZSCORE', KEYS[1], member)
+	values[2 * count + i + 1] = tostring(redis.call('ZREVRANK', KEYS[1], member))
+end
+` + storeIdempotencyScript
+
+// incrementMemberScoreIdempotentScript applies ZINCRBY of ARGV[4] to member ARGV[5] of KEYS[1], whose score
+// expires at ARGV[6], whose increase is ARGV[7] and whose expected score and increase cap are ARGV[8] and
+// ARGV[9], only if idempotency key KEYS[2] does not exist, the same way setMembersIdempotentScript does
+const incrementMemberScoreIdempotentScript = addIncreaseFunction + scoreChangedFunction + checkIdempotencyScript + `
+if scoreChanged(KEYS[1], KEYS[4], ARGV[5], ARGV[8], ARGV[7], ARGV[9]) then
+	return {3}
+end
+local values = {}
+values[4] = redis.call('ZSCORE', KEYS[1], ARGV[5]) or ''
+values[5] = tostring(redis.call('ZREVRANK', KEYS[1], ARGV[5]) or -1)
+values[2] = redis.call('ZINCRBY', KEYS[1], ARGV[4], ARGV[5])
+values[1] = tostring(redis.call('HINCRBY', KEYS[3], ARGV[5], 1))
+values[3] = tostring(redis.call('ZREVRANK', KEYS[1], ARGV[5]))
+values[6] = ARGV[6]
+addIncrease(KEYS[4], ARGV[5], ARGV[7], ARGV[3])
+` + storeIdempotencyScript
+
+// setMemberIfMatchIdempotentScript applies ZADD of ARGV[4] to member ARGV[5] of KEYS[1], whose score expires
+// at ARGV[6], whose increase is ARGV[7] and whose expected score and increase cap are ARGV[8] and ARGV[9], only
+// if idempotency key KEYS[2] does not exist and conditionMatches holds for expected score ARGV[10] within ARGV[11]
+// and version ARGV[12], the same way setMembersIdempotentScript does. It returns {4, version} without writing
+// or storing the key when the condition does not match
+const setMemberIfMatchIdempotentScript = addIncreaseFunction + scoreChangedFunction + conditionMatchesFunction + checkIdempotencyScript + `
+local matches, version = conditionMatches(KEYS[1], KEYS[3], ARGV[5], ARGV[10], ARGV[11], ARGV[12])
+if not matches then
+	return {4, version}
+end
+if scoreChanged(KEYS[1], KEYS[4], ARGV[5], ARGV[8], ARGV[7], ARGV[9]) then
+	return {3}
+end
+local values = {}
+values[4] = redis.call('ZSCORE', KEYS[1], ARGV[5]) or ''
+values[5] = tostring(redis.call('ZREVRANK', KEYS[1], ARGV[5]) or -1)
+redis.call('ZADD', KEYS[1], ARGV[4], ARGV[5])
+values[1] = tostring(redis.call('HINCRBY', KEYS[3], ARGV[5], 1))
+values[2] = redis.call('ZSCORE', KEYS[1], ARGV[5])
+values[3] = tostring(redis.call('ZREVRANK', KEYS[1], ARGV[5]))
+values[6] = ARGV[6]
+addIncrease(KEYS[4], ARGV[5], ARGV[7], ARGV[3])
+` + storeIdempotencyScript
+
+// getIdempotentWriteScript returns the values stored by an idempotent write like checkIdempotencyScript,
+// or {0} if idempotency key KEYS[2] does not exist
+const getIdempotentWriteScript = checkIdempotencyScript + `
+return {0}
+`
+
+func idempotencyKey(leaderboard, key string) string {
+	return LeaderboardKey(leaderboard, fmt.Sprintf("idempotency:%s", key))
+}
+
+// IncrementMemberScoreIdempotent increment the score of databaseMember by increment only if idempotency.Key
+// was not used in leaderboard within idempotency.Window, the same way SetMembersIdempotent does
+func (r *Redis) IncrementMemberScoreIdempotent(ctx context.Context, leaderboard string, databaseMember *Member, increment float64, idempotency *Idempotency) (bool, error) {
+	guardScore, maxIncrease := formatGuard(databaseMember)
+	args := []interface{}{
+		formatScore(increment), databaseMember.Member, formatExpireAt(databaseMember.TTL), formatIncrease(databaseMember),
+		guardScore, maxIncrease,
+	}
+
+	return r.evalIdempotent(ctx, incrementMemberScoreIdempotentScript, leaderboard, []*Member{databaseMember}, idempotency, args)
+}
+
+// SetMembersIdempotent add members to leaderboard only if idempotency.Key was not used in leaderboard
+// within idempotency.Window, storing the values of the write with the key and adding members Increase to
+// their total increases. It fills members Score, Rank, Version, PreviousScore, PreviousRank and TTL with
+// the values of the write, or of the first write with the key and returns true if it was already used.
+// IdempotencyKeyReusedError is returned if the key was used by a write with another fingerprint and
+// ScoreChangedError if the Guard or Increase cap of a member do not hold
+func (r *Redis) SetMembersIdempotent(ctx context.Context, leaderboard string, databaseMembers []*Member, idempotency *Idempotency) (bool, error) {
+	args := make([]interface{}, 0, 6*len(databaseMembers))
+	for _, member := range databaseMembers {
+		guardScore, maxIncrease := formatGuard(member)
+		args = append(args, formatScore(member.Score), member.Member, formatExpireAt(member.TTL), formatIncrease(member), guardScore, maxIncrease)
+	}
+
+	return r.evalIdempotent(ctx, setMembersIdempotentScript, leaderboard, databaseMembers, idempotency, args)
+}
+
+// SetMemberIfMatchIdempotent set the score of databaseMember only if its stored score and version match
+// condition and idempotency.Key was not used in leaderboard within idempotency.Window, the same way
+// SetMembersIdempotent does. A retry of an applied write returns its values even if the condition no longer
+// matches, and ConditionFailedError is returned without using the key if it does not match
+func (r *Redis) SetMemberIfMatchIdempotent(ctx context.Context, leaderboard string, databaseMember *Member, condition *Condition, idempotency *Idempotency) (bool, error) {
+	guardScore, maxIncrease := formatGuard(databaseMember)
+	expectedScore, tolerance, expectedVersion := formatCondition(condition)
+	args := []interface{}{
+		formatScore(databaseMember.Score), databaseMember.Member, formatExpireAt(databaseMember.TTL), formatIncrease(databaseMember),
+		guardScore, maxIncrease, expectedScore, tolerance, expectedVersion,
+	}
+
+	return r.evalIdempotent(ctx, setMemberIfMatchIdempotentScript, leaderboard, []*Member{databaseMember}, idempotency, args)
+}
+
+// GetIdempotentWrite fill databaseMembers with the values of the write with idempotency.Key in leaderboard,
+// like SetMembersIdempotent does, and return true if the key was used, without writing anything.
+// IdempotencyKeyReusedError is returned if the key was used by a write with another fingerprint
+func (r *Redis) GetIdempotentWrite(ctx context.Context, leaderboard string, databaseMembers []*Member, idempotency *Idempotency) (bool, error) {
+	result, err := r.Client.Eval(
+		ctx, getIdempotentWriteScript, []string{leaderboard, idempotencyKey(leaderboard, idempotency.Key)},
+		strconv.FormatInt(idempotency.Window.Milliseconds(), 10), idempotency.Fingerprint,
+	)
+	if err != nil {
+		return false, NewGeneralError(err.Error())
+	}
+
+	values, ok := result.([]interface{})
+	if !ok || len(values) == 0 {
+		return false, NewGeneralError(fmt.Sprintf("unexpected idempotent write result %v", result))
+	}
+
+	switch fmt.Sprint(values[0]) {
+	case "0":
+		return false, nil
+	case "2":
+		return false, NewIdempotencyKeyReusedError(leaderboard, idempotency.Key)
+	}
+
+	if len(values) != 1+6*len(databaseMembers) {
+		return false, NewGeneralError(fmt.Sprintf("unexpected idempotent write result %v", result))
+	}
+
+	err = setIdempotentMembersValues(databaseMembers, values[1:])
+	if err != nil {
+		return false, NewGeneralError(err.Error())
+	}
+
+	return true, nil
+}
+
+func (r *Redis) evalIdempotent(ctx context.Context, script, leaderboard string, databaseMembers []*Member, idempotency *Idempotency, args []interface{}) (bool, error) {
+	keys, increasesExpireAt := withIncreasesKey(leaderboard, []string{leaderboard, idempotencyKey(leaderboard, idempotency.Key), versionsKey(leaderboard)}, databaseMembers...)
+	args = append([]interface{}{strconv.FormatInt(idempotency.Window.Milliseconds(), 10), idempotency.Fingerprint, increasesExpireAt}, args...)
+	result, err := r.evalWrite(ctx, leaderboard, script, "result[1] == 0", keys, args...)
+	if err != nil {
+		return false, NewGeneralError(err.Error())
+	}
+
+	values, ok := result.([]interface{})
+	if !ok || len(values) == 0 {
+		return false, NewGeneralError(fmt.Sprintf("unexpected idempotent write result %v", result))
+	}
+
+	code := fmt.Sprint(values[0])
+	switch code {
+	case "2":
+		return false, NewIdempotencyKeyReusedError(leaderboard, idempotency.Key)
+	case "3":
+		return false, NewScoreChangedError(leaderboard)
+	case "4":
+		if len(values) != 2 {
+			return false, NewGeneralError(fmt.Sprintf("unexpected idempotent write result %v", result))
+		}
+
+		version, err := strconv.ParseInt(fmt.Sprint(values[1]), 10, 64)
+		if err != nil {
+			return false, NewGeneralError(err.Error())
+		}
+		return false, NewConditionFailedError(leaderboard, databaseMembers[0].Member, version)
+	}
+
+	if len(values) != 1+6*len(databaseMembers) {
+		return false, NewGeneralError(fmt.Sprintf("unexpected idempotent write result %v", result))
+	}
+
+	err = setIdempotentMembersValues(databaseMembers, values[1:])
+	if err != nil {
+		return false, NewGeneralError(err.Error())
+	}
+
+	return code == "1", nil
+}
+
+// setIdempotentMembersValues fill databaseMembers with values in the layout stored by storeIdempotencyScript
+func setIdempotentMembersValues(databaseMembers []*Member, values []interface{}) error {
+	count := len(databaseMembers)
+	err := setMembersVersions(databaseMembers, values[:count])
+	if err != nil {
+		return err
+	}
+
+	err = setMembersPreviousScores(databaseMembers, values[3*count:4*count])
+	if err != nil {
+		return err
+	}
+
+	for i, member := range databaseMembers {
+		member.Score, err = strconv.ParseFloat(fmt.Sprint(values[count+i]), 64)
+		if err != nil {
+			return err
+		}
+
+		member.Rank, err = strconv.ParseInt(fmt.Sprint(values[2*count+i]), 10, 64)
+		if err != nil {
+			return err
+		}
+
+		member.PreviousRank, err = strconv.ParseInt(fmt.Sprint(values[4*count+i]), 10, 64)
+		if err != nil {
+			return err
+		}
+
+		expireAt, err := strconv.ParseInt(fmt.Sprint(values[5*count+i]), 10, 64)
+		if err != nil {
+			return err
+		}
+
+		member.TTL = time.Time{}
+		if expireAt > 0 {
+			member.TTL = time.Unix(expireAt, 0).UTC()
+		}
+	}
+
+	return nil
+}
+
+func formatExpireAt(ttl time.Time) string {
+	if ttl.IsZero() {
+		return "0"
+	}
+
+	return strconv.FormatInt(ttl.Unix(), 10)
+}
@@ -0,0 +1,296 @@
+package database_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+var _ = Describe("Redis Idempotency Database", func() {
+	var ctrl *gomock.Controller
+	var mock *redis.MockRedis
+	var redisDatabase *database.Redis
+	var leaderboard string = "leaderboardTest"
+	var idempotencyKey string = "{leaderboardTest}:idempotency:request1"
+	var versionsKey string = "{leaderboardTest}:versions"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = redis.NewMockRedis(ctrl)
+
+		redisDatabase = &database.Redis{mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("SetMembersIdempotent", func() {
+		var databaseMembers []*database.Member
+		idempotency := &database.Idempotency{Key: "request1", Fingerprint: "fingerprint", Window: time.Minute}
+
+		BeforeEach(func() {
+			databaseMembers = []*database.Member{
+				{Member: "member1", Score: 1},
+				{Member: "member2", Score: 2.5, TTL: time.Unix(1700000000, 0)},
+			}
+		})
+
+		It("Should return false and fill members values if the write was applied", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{leaderboard, idempotencyKey, versionsKey}),
+				gomock.Eq("60000"),
+				gomock.Eq("fingerprint"),
+				gomock.Eq(""),
+				gomock.Eq("1"),
+				gomock.Eq("member1"),
+				gomock.Eq("0"),
+				gomock.Eq("0"),
+				gomock.Eq(""),
+				gomock.Eq("0"),
+				gomock.Eq("2.5"),
+				gomock.Eq("member2"),
+				gomock.Eq("1700000000"),
+				gomock.Eq("0"),
+				gomock.Eq(""),
+				gomock.Eq("0"),
+			).Return([]interface{}{
+				int64(0),
+				"1", "3",
+				"1", "2.5",
+				"1", "0",
+				"", "4",
+				"-1", "0",
+				"0", "1700000000",
+			}, nil)
+
+			duplicate, err := redisDatabase.SetMembersIdempotent(context.Background(), leaderboard, databaseMembers, idempotency)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(duplicate).To(BeFalse())
+			Expect(databaseMembers[0].Version).To(Equal(int64(1)))
+			Expect(databaseMembers[0].Rank).To(Equal(int64(1)))
+			Expect(databaseMembers[0].PreviousScore).To(BeNil())
+			Expect(databaseMembers[0].PreviousRank).To(Equal(int64(-1)))
+			Expect(databaseMembers[0].TTL.IsZero()).To(BeTrue())
+			Expect(databaseMembers[1].Version).To(Equal(int64(3)))
+			Expect(databaseMembers[1].Score).To(Equal(2.5))
+			Expect(*databaseMembers[1].PreviousScore).To(Equal(float64(4)))
+			Expect(databaseMembers[1].PreviousRank).To(Equal(int64(0)))
+			Expect(databaseMembers[1].TTL.Unix()).To(Equal(int64(1700000000)))
+		})
+
+		It("Should return true and fill members with the stored values if idempotency key was already used", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{leaderboard, idempotencyKey, versionsKey}),
+				gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+			).Return([]interface{}{
+				int64(1),
+				"2", "5",
+				"10", "20",
+				"1", "0",
+				"", "",
+				"-1", "-1",
+				"0", "0",
+			}, nil)
+
+			duplicate, err := redisDatabase.SetMembersIdempotent(context.Background(), leaderboard, databaseMembers, idempotency)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(duplicate).To(BeTrue())
+			Expect(databaseMembers[0].Score).To(Equal(float64(10)))
+			Expect(databaseMembers[1].Version).To(Equal(int64(5)))
+			Expect(databaseMembers[1].TTL.IsZero()).To(BeTrue())
+		})
+
+		It("Should return IdempotencyKeyReusedError if idempotency key was used by another write", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{leaderboard, idempotencyKey, versionsKey}),
+				gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+			).Return([]interface{}{int64(2)}, nil)
+
+			_, err := redisDatabase.SetMembersIdempotent(context.Background(), leaderboard, databaseMembers, idempotency)
+			Expect(err).To(Equal(database.NewIdempotencyKeyReusedError(leaderboard, "request1")))
+		})
+
+		It("Should return ScoreChangedError if the guarded score of a member changed", func() {
+			previousScore := float64(4)
+			databaseMembers[1].Guard = &database.ScoreGuard{Score: &previousScore}
+			databaseMembers[1].Increase = &database.Increase{Value: 1, Window: time.Hour, Max: 10}
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(""), gomock.Eq("0"),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq("1"), gomock.Eq("4"), gomock.Eq("10"),
+			).Return([]interface{}{int64(3)}, nil)
+
+			_, err := redisDatabase.SetMembersIdempotent(context.Background(), leaderboard, databaseMembers, idempotency)
+			Expect(err).To(Equal(database.NewScoreChangedError(leaderboard)))
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{leaderboard, idempotencyKey, versionsKey}),
+				gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+			).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.SetMembersIdempotent(context.Background(), leaderboard, databaseMembers, idempotency)
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("IncrementMemberScoreIdempotent", func() {
+		idempotency := &database.Idempotency{Key: "request1", Fingerprint: "fingerprint", Window: time.Minute}
+
+		It("Should return false and fill member values if the increment was applied", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{leaderboard, idempotencyKey, versionsKey}),
+				gomock.Eq("60000"),
+				gomock.Eq("fingerprint"),
+				gomock.Eq(""),
+				gomock.Eq("10"),
+				gomock.Eq("member1"),
+				gomock.Eq("0"),
+				gomock.Eq("0"),
+				gomock.Eq(""),
+				gomock.Eq("0"),
+			).Return([]interface{}{int64(0), "2", "15", "0", "5", "3", "0"}, nil)
+
+			databaseMember := &database.Member{Member: "member1"}
+			duplicate, err := redisDatabase.IncrementMemberScoreIdempotent(context.Background(), leaderboard, databaseMember, 10, idempotency)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(duplicate).To(BeFalse())
+			Expect(databaseMember.Score).To(Equal(float64(15)))
+			Expect(*databaseMember.PreviousScore).To(Equal(float64(5)))
+			Expect(databaseMember.PreviousRank).To(Equal(int64(3)))
+			Expect(databaseMember.Version).To(Equal(int64(2)))
+		})
+
+		It("Should return GeneralError if redis return an unexpected result", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{leaderboard, idempotencyKey, versionsKey}),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+			).Return(int64(1), nil)
+
+			_, err := redisDatabase.IncrementMemberScoreIdempotent(context.Background(), leaderboard, &database.Member{Member: "member1"}, 10, idempotency)
+			Expect(err).To(Equal(database.NewGeneralError("unexpected idempotent write result 1")))
+		})
+	})
+
+	Describe("SetMemberIfMatchIdempotent", func() {
+		idempotency := &database.Idempotency{Key: "request1", Fingerprint: "fingerprint", Window: time.Minute}
+		expectedVersion := int64(3)
+
+		It("Should return false and fill member values if the condition matches", func() {
+			member := &database.Member{Member: "member1", Score: 150, TTL: time.Unix(1700000000, 0)}
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{leaderboard, idempotencyKey, versionsKey}),
+				gomock.Eq("60000"),
+				gomock.Eq("fingerprint"),
+				gomock.Eq(""),
+				gomock.Eq("150"),
+				gomock.Eq("member1"),
+				gomock.Eq("1700000000"),
+				gomock.Eq("0"),
+				gomock.Eq(""),
+				gomock.Eq("0"),
+				gomock.Eq(""),
+				gomock.Eq("0"),
+				gomock.Eq("3"),
+			).Return([]interface{}{int64(0), "4", "150", "0", "100", "2", "1700000000"}, nil)
+
+			duplicate, err := redisDatabase.SetMemberIfMatchIdempotent(context.Background(), leaderboard, member, &database.Condition{Version: &expectedVersion}, idempotency)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(duplicate).To(BeFalse())
+			Expect(member.Version).To(Equal(int64(4)))
+			Expect(*member.PreviousScore).To(Equal(float64(100)))
+			Expect(member.TTL.Unix()).To(Equal(int64(1700000000)))
+		})
+
+		It("Should return ConditionFailedError if condition does not match", func() {
+			member := &database.Member{Member: "member1", Score: 150}
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{leaderboard, idempotencyKey, versionsKey}),
+				gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(),
+			).Return([]interface{}{int64(4), int64(5)}, nil)
+
+			_, err := redisDatabase.SetMemberIfMatchIdempotent(context.Background(), leaderboard, member, &database.Condition{Version: &expectedVersion}, idempotency)
+			Expect(err).To(Equal(database.NewConditionFailedError(leaderboard, "member1", 5)))
+		})
+	})
+
+	Describe("GetIdempotentWrite", func() {
+		idempotency := &database.Idempotency{Key: "request1", Fingerprint: "fingerprint", Window: time.Minute}
+
+		It("Should return false if idempotency key was not used", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{leaderboard, idempotencyKey}),
+				gomock.Eq("60000"),
+				gomock.Eq("fingerprint"),
+			).Return([]interface{}{int64(0)}, nil)
+
+			databaseMember := &database.Member{Member: "member1", Score: 10}
+			duplicate, err := redisDatabase.GetIdempotentWrite(context.Background(), leaderboard, []*database.Member{databaseMember}, idempotency)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(duplicate).To(BeFalse())
+			Expect(databaseMember.Score).To(Equal(float64(10)))
+		})
+
+		It("Should return true and fill members with the stored values if idempotency key was already used", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{leaderboard, idempotencyKey}),
+				gomock.Eq("60000"),
+				gomock.Eq("fingerprint"),
+			).Return([]interface{}{int64(1), "2", "15", "0", "5", "3", "0"}, nil)
+
+			databaseMember := &database.Member{Member: "member1", Score: 10}
+			duplicate, err := redisDatabase.GetIdempotentWrite(context.Background(), leaderboard, []*database.Member{databaseMember}, idempotency)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(duplicate).To(BeTrue())
+			Expect(databaseMember.Score).To(Equal(float64(15)))
+			Expect(*databaseMember.PreviousScore).To(Equal(float64(5)))
+			Expect(databaseMember.Version).To(Equal(int64(2)))
+		})
+
+		It("Should return IdempotencyKeyReusedError if idempotency key was used by another write", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]interface{}{int64(2)}, nil)
+
+			_, err := redisDatabase.GetIdempotentWrite(context.Background(), leaderboard, []*database.Member{{Member: "member1"}}, idempotency)
+			Expect(err).To(Equal(database.NewIdempotencyKeyReusedError(leaderboard, "request1")))
+		})
+	})
+})
@@ -0,0 +1,292 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+// joinLeagueDivisionsScript adds ARGV[4..] members to the last division of tier ARGV[1],
+// opening a new division every time it reaches ARGV[2] members. KEYS[1] maps members to
+// "tier:division", KEYS[2] maps tiers to their number of divisions and KEYS[3..] are the division
+// leaderboards of the tier from the last one, or the first if there is none, on. ARGV[3] is the number
+// of divisions the tier had when KEYS were built and if it changed nothing is written and false is
+// returned, so the caller can build KEYS again. Members already in the season keep their division
+const joinLeagueDivisionsScript = `
+local divisions = tonumber(redis.call('HGET', KEYS[2], ARGV[1]) or 0)
+if divisions ~= tonumber(ARGV[3]) then
+	return false
+end
+local first = math.max(divisions, 1)
+local size = 0
+if divisions > 0 then
+	size = redis.call('ZCARD', KEYS[3])
+end
+local result = {}
+for i = 4, #ARGV do
+	local division = redis.call('HGET', KEYS[1], ARGV[i])
+	if not division then
+		if divisions == 0 or size >= tonumber(ARGV[2]) then
+			divisions = divisions + 1
+			size = 0
+			redis.call('HSET', KEYS[2], ARGV[1], divisions)
+		end
+		redis.call('ZADD', KEYS[3 + divisions - first], 0, ARGV[i])
+		size = size + 1
+		division = ARGV[1] .. ':' .. divisions
+		redis.call('HSET', KEYS[1], ARGV[i], division)
+	end
+	result[#result + 1] = division
+end
+return result
+`
+
+// endLeagueSeasonScript makes ARGV[1] + 1 the season of league configuration KEYS[1] and stores the
+// result ARGV[2] of season ARGV[1] in field "result:" followed by it, only if ARGV[1] is the current season.
+// It returns 1 if the season was ended and 0 if it was not the current season
+const endLeagueSeasonScript = `
+if redis.call('HGET', KEYS[1], 'season') ~= ARGV[1] then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'season', tonumber(ARGV[1]) + 1, 'result:' .. ARGV[1], ARGV[2])
+return 1
+`
+
+// joinLeagueDivisionsAttempts is how many times JoinLeagueDivisions builds the division keys again
+// when the tier gets new divisions concurrently
+const joinLeagueDivisionsAttempts = 5
+
+// LeagueDivisionLeaderboard return the name of the leaderboard that holds a league division, hash tagged
+// by league so every key of the league is in the same slot
+func LeagueDivisionLeaderboard(league string, season, tier, division int) string {
+	return LeaderboardKey(league, fmt.Sprintf("s%d:t%d:d%d", season, tier, division))
+}
+
+func leagueKey(league string) string {
+	return LeaderboardKey(league, "league")
+}
+
+func leagueMembersKey(league string, season int) string {
+	return LeaderboardKey(league, fmt.Sprintf("s%d:members", season))
+}
+
+func leagueDivisionsKey(league string, season int) string {
+	return LeaderboardKey(league, fmt.Sprintf("s%d:divisions", season))
+}
+
+// EndLeagueSeason makes season + 1 the current season of league and stores result as the result of
+// season, only if season is the current one. It returns false if season was not the current season,
+// so a season is ended only once
+func (r *Redis) EndLeagueSeason(ctx context.Context, league string, season int, result *LeagueSeasonResult) (bool, error) {
+	value, err := json.Marshal(result)
+	if err != nil {
+		return false, NewGeneralError(err.Error())
+	}
+
+	ended, err := r.evalWrite(ctx, league, endLeagueSeasonScript, "result == 1", []string{leagueKey(league)}, strconv.Itoa(season), string(value))
+	if err != nil {
+		return false, NewGeneralError(err.Error())
+	}
+
+	return ended == int64(1), nil
+}
+
+// GetLeague return league configuration
+func (r *Redis) GetLeague(ctx context.Context, league string) (*League, error) {
+	fields, err := r.Client.HGetAll(ctx, leagueKey(league))
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	if len(fields) == 0 {
+		return nil, NewLeagueNotFoundError(league)
+	}
+
+	config := &League{
+		Order: fields["order"],
+	}
+
+	for field, value := range map[string]*int{
+		"season":          &config.Season,
+		"tiers":           &config.Tiers,
+		"divisionSize":    &config.DivisionSize,
+		"promotionCount":  &config.PromotionCount,
+		"relegationCount": &config.RelegationCount,
+	} {
+		*value, err = strconv.Atoi(fields[field])
+		if err != nil {
+			return nil, NewGeneralError(err.Error())
+		}
+	}
+
+	return config, nil
+}
+
+// GetLeagueDivisionCounts return how many divisions each tier of a league season has
+func (r *Redis) GetLeagueDivisionCounts(ctx context.Context, league string, season int) (map[int]int, error) {
+	fields, err := r.Client.HGetAll(ctx, leagueDivisionsKey(league, season))
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	counts := make(map[int]int, len(fields))
+	for field, value := range fields {
+		tier, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, NewGeneralError(err.Error())
+		}
+
+		counts[tier], err = strconv.Atoi(value)
+		if err != nil {
+			return nil, NewGeneralError(err.Error())
+		}
+	}
+
+	return counts, nil
+}
+
+// GetLeagueSeasonResult return the result stored when season of league ended, nil if it did not end
+func (r *Redis) GetLeagueSeasonResult(ctx context.Context, league string, season int) (*LeagueSeasonResult, error) {
+	value, err := r.Client.HGet(ctx, leagueKey(league), fmt.Sprintf("result:%d", season))
+	if err != nil {
+		if _, ok := err.(*redis.MemberNotFoundError); ok {
+			return nil, nil
+		}
+		return nil, NewGeneralError(err.Error())
+	}
+
+	result := &LeagueSeasonResult{}
+	err = json.Unmarshal([]byte(value), result)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	return result, nil
+}
+
+// GetLeagueMemberDivision return the division member was placed in a league season
+func (r *Redis) GetLeagueMemberDivision(ctx context.Context, league string, season int, member string) (*LeagueDivision, error) {
+	value, err := r.Client.HGet(ctx, leagueMembersKey(league, season), member)
+	if err != nil {
+		if _, ok := err.(*redis.MemberNotFoundError); ok {
+			return nil, NewLeagueMemberNotFoundError(league, season, member)
+		}
+		return nil, NewGeneralError(err.Error())
+	}
+
+	division, err := parseLeagueDivision(value)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	return division, nil
+}
+
+// JoinLeagueDivisions place members in divisions of a tier in a league season, filling the last
+// division of the tier before opening a new one. It returns the division of each member
+func (r *Redis) JoinLeagueDivisions(ctx context.Context, league string, season, tier, divisionSize int, members ...string) ([]*LeagueDivision, error) {
+	membersKey := leagueMembersKey(league, season)
+	divisionsKey := leagueDivisionsKey(league, season)
+
+	for attempt := 0; attempt < joinLeagueDivisionsAttempts; attempt++ {
+		divisionCount, err := r.getLeagueTierDivisionCount(ctx, divisionsKey, tier)
+		if err != nil {
+			return nil, NewGeneralError(err.Error())
+		}
+
+		first := divisionCount
+		if first < 1 {
+			first = 1
+		}
+		last := divisionCount + (len(members)+divisionSize-1)/divisionSize
+
+		keys := make([]string, 0, last-first+3)
+		keys = append(keys, membersKey, divisionsKey)
+		for division := first; division <= last; division++ {
+			keys = append(keys, LeagueDivisionLeaderboard(league, season, tier, division))
+		}
+
+		args := make([]interface{}, 0, len(members)+3)
+		args = append(args, strconv.Itoa(tier), strconv.Itoa(divisionSize), strconv.Itoa(divisionCount))
+		for _, member := range members {
+			args = append(args, member)
+		}
+
+		result, err := r.evalWrite(ctx, league, joinLeagueDivisionsScript, "result", keys, args...)
+		if err != nil {
+			return nil, NewGeneralError(err.Error())
+		}
+
+		if result == nil {
+			continue
+		}
+
+		values, ok := result.([]interface{})
+		if !ok {
+			return nil, NewGeneralError(fmt.Sprintf("unexpected join league result %v", result))
+		}
+
+		divisions := make([]*LeagueDivision, 0, len(values))
+		for _, value := range values {
+			division, err := parseLeagueDivision(fmt.Sprint(value))
+			if err != nil {
+				return nil, NewGeneralError(err.Error())
+			}
+			divisions = append(divisions, division)
+		}
+
+		return divisions, nil
+	}
+
+	return nil, NewGeneralError(fmt.Sprintf("divisions of tier %d changed in every join attempt", tier))
+}
+
+func (r *Redis) getLeagueTierDivisionCount(ctx context.Context, divisionsKey string, tier int) (int, error) {
+	value, err := r.Client.HGet(ctx, divisionsKey, strconv.Itoa(tier))
+	if err != nil {
+		if _, ok := err.(*redis.MemberNotFoundError); ok {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return strconv.Atoi(value)
+}
+
+func parseLeagueDivision(value string) (*LeagueDivision, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid league division %s", value)
+	}
+
+	tier, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	division, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return &LeagueDivision{
+		Tier:     tier,
+		Division: division,
+	}, nil
+}
+
+// SetLeague persist league configuration in a hash with key being league name hash tagged and suffix ":league"
+func (r *Redis) SetLeague(ctx context.Context, league string, config *League) error {
+	return r.writeCommand(ctx, league, "HSET", leagueKey(league), hashArgs(map[string]string{
+		"season":          strconv.Itoa(config.Season),
+		"tiers":           strconv.Itoa(config.Tiers),
+		"divisionSize":    strconv.Itoa(config.DivisionSize),
+		"promotionCount":  strconv.Itoa(config.PromotionCount),
+		"relegationCount": strconv.Itoa(config.RelegationCount),
+		"order":           config.Order,
+	})...)
+}
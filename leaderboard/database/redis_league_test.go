@@ -0,0 +1,291 @@
+package database_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+var _ = Describe("Redis League Database", func() {
+	var ctrl *gomock.Controller
+	var mock *redis.MockRedis
+	var redisDatabase *database.Redis
+	var league string = "leagueTest"
+	var leagueKey string = "{leagueTest}:league"
+	var membersKey string = "{leagueTest}:s2:members"
+	var divisionsKey string = "{leagueTest}:s2:divisions"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = redis.NewMockRedis(ctrl)
+
+		redisDatabase = &database.Redis{mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("LeagueDivisionLeaderboard", func() {
+		It("Should return division leaderboard name", func() {
+			Expect(database.LeagueDivisionLeaderboard(league, 2, 3, 4)).To(Equal("{leagueTest}:s2:t3:d4"))
+		})
+	})
+
+	Describe("GetLeague", func() {
+		It("Should return league if all is OK", func() {
+			mock.EXPECT().HGetAll(gomock.Any(), gomock.Eq(leagueKey)).Return(map[string]string{
+				"season":          "2",
+				"tiers":           "3",
+				"divisionSize":    "50",
+				"promotionCount":  "5",
+				"relegationCount": "10",
+				"order":           "desc",
+			}, nil)
+
+			config, err := redisDatabase.GetLeague(context.Background(), league)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(config).To(Equal(&database.League{
+				Season:          2,
+				Tiers:           3,
+				DivisionSize:    50,
+				PromotionCount:  5,
+				RelegationCount: 10,
+				Order:           "desc",
+			}))
+		})
+
+		It("Should return LeagueNotFoundError if league does not exist", func() {
+			mock.EXPECT().HGetAll(gomock.Any(), gomock.Eq(leagueKey)).Return(map[string]string{}, nil)
+
+			_, err := redisDatabase.GetLeague(context.Background(), league)
+			Expect(err).To(Equal(database.NewLeagueNotFoundError(league)))
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().HGetAll(gomock.Any(), gomock.Eq(leagueKey)).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.GetLeague(context.Background(), league)
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("GetLeagueDivisionCounts", func() {
+		It("Should return number of divisions by tier if all is OK", func() {
+			mock.EXPECT().HGetAll(gomock.Any(), gomock.Eq(divisionsKey)).Return(map[string]string{
+				"1": "1",
+				"2": "4",
+			}, nil)
+
+			counts, err := redisDatabase.GetLeagueDivisionCounts(context.Background(), league, 2)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(counts).To(Equal(map[int]int{1: 1, 2: 4}))
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().HGetAll(gomock.Any(), gomock.Eq(divisionsKey)).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.GetLeagueDivisionCounts(context.Background(), league, 2)
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("GetLeagueMemberDivision", func() {
+		It("Should return member division if all is OK", func() {
+			mock.EXPECT().HGet(gomock.Any(), gomock.Eq(membersKey), gomock.Eq("member")).Return("2:3", nil)
+
+			division, err := redisDatabase.GetLeagueMemberDivision(context.Background(), league, 2, "member")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(division).To(Equal(&database.LeagueDivision{Tier: 2, Division: 3}))
+		})
+
+		It("Should return LeagueMemberNotFoundError if member did not join season", func() {
+			mock.EXPECT().HGet(gomock.Any(), gomock.Eq(membersKey), gomock.Eq("member")).Return("", redis.NewMemberNotFoundError(membersKey, "member"))
+
+			_, err := redisDatabase.GetLeagueMemberDivision(context.Background(), league, 2, "member")
+			Expect(err).To(Equal(database.NewLeagueMemberNotFoundError(league, 2, "member")))
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().HGet(gomock.Any(), gomock.Eq(membersKey), gomock.Eq("member")).Return("", fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.GetLeagueMemberDivision(context.Background(), league, 2, "member")
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("EndLeagueSeason", func() {
+		It("Should return true if season ended", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{leagueKey}),
+				gomock.Eq("2"),
+				gomock.Eq(`{"promoted":1,"relegated":2,"stayed":3}`),
+			).Return(int64(1), nil)
+
+			ended, err := redisDatabase.EndLeagueSeason(context.Background(), league, 2, &database.LeagueSeasonResult{
+				Promoted:  1,
+				Relegated: 2,
+				Stayed:    3,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ended).To(BeTrue())
+		})
+
+		It("Should return false if season is not the current season", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{leagueKey}), gomock.Any(), gomock.Any()).Return(int64(0), nil)
+
+			ended, err := redisDatabase.EndLeagueSeason(context.Background(), league, 2, &database.LeagueSeasonResult{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ended).To(BeFalse())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{leagueKey}), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.EndLeagueSeason(context.Background(), league, 2, &database.LeagueSeasonResult{})
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("GetLeagueSeasonResult", func() {
+		It("Should return season result if all is OK", func() {
+			mock.EXPECT().HGet(gomock.Any(), gomock.Eq(leagueKey), gomock.Eq("result:2")).Return(`{"promoted":1,"relegated":2,"stayed":3}`, nil)
+
+			result, err := redisDatabase.GetLeagueSeasonResult(context.Background(), league, 2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(&database.LeagueSeasonResult{
+				Promoted:  1,
+				Relegated: 2,
+				Stayed:    3,
+			}))
+		})
+
+		It("Should return nil if season did not end", func() {
+			mock.EXPECT().HGet(gomock.Any(), gomock.Eq(leagueKey), gomock.Eq("result:2")).Return("", redis.NewMemberNotFoundError(leagueKey, "result:2"))
+
+			result, err := redisDatabase.GetLeagueSeasonResult(context.Background(), league, 2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(BeNil())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().HGet(gomock.Any(), gomock.Eq(leagueKey), gomock.Eq("result:2")).Return("", fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.GetLeagueSeasonResult(context.Background(), league, 2)
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("JoinLeagueDivisions", func() {
+		It("Should return members divisions if all is OK", func() {
+			mock.EXPECT().HGet(gomock.Any(), gomock.Eq(divisionsKey), gomock.Eq("3")).Return("4", nil)
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{membersKey, divisionsKey, "{leagueTest}:s2:t3:d4", "{leagueTest}:s2:t3:d5"}),
+				gomock.Eq("3"),
+				gomock.Eq("50"),
+				gomock.Eq("4"),
+				gomock.Eq("member1"),
+				gomock.Eq("member2"),
+			).Return([]interface{}{"3:4", "3:5"}, nil)
+
+			divisions, err := redisDatabase.JoinLeagueDivisions(context.Background(), league, 2, 3, 50, "member1", "member2")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(divisions).To(Equal([]*database.LeagueDivision{
+				{Tier: 3, Division: 4},
+				{Tier: 3, Division: 5},
+			}))
+		})
+
+		It("Should declare the divisions needed from the first if tier has none", func() {
+			mock.EXPECT().HGet(gomock.Any(), gomock.Eq(divisionsKey), gomock.Eq("3")).Return("", redis.NewMemberNotFoundError(divisionsKey, "3"))
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{membersKey, divisionsKey, "{leagueTest}:s2:t3:d1", "{leagueTest}:s2:t3:d2"}),
+				gomock.Eq("3"),
+				gomock.Eq("2"),
+				gomock.Eq("0"),
+				gomock.Eq("member1"),
+				gomock.Eq("member2"),
+				gomock.Eq("member3"),
+			).Return([]interface{}{"3:1", "3:1", "3:2"}, nil)
+
+			divisions, err := redisDatabase.JoinLeagueDivisions(context.Background(), league, 2, 3, 2, "member1", "member2", "member3")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(divisions).To(HaveLen(3))
+		})
+
+		It("Should declare the divisions again if tier got new divisions", func() {
+			gomock.InOrder(
+				mock.EXPECT().HGet(gomock.Any(), gomock.Eq(divisionsKey), gomock.Eq("3")).Return("4", nil),
+				mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{membersKey, divisionsKey, "{leagueTest}:s2:t3:d4", "{leagueTest}:s2:t3:d5"}), gomock.Any(), gomock.Any(), gomock.Eq("4"), gomock.Any()).Return(nil, nil),
+				mock.EXPECT().HGet(gomock.Any(), gomock.Eq(divisionsKey), gomock.Eq("3")).Return("5", nil),
+				mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{membersKey, divisionsKey, "{leagueTest}:s2:t3:d5", "{leagueTest}:s2:t3:d6"}), gomock.Any(), gomock.Any(), gomock.Eq("5"), gomock.Any()).Return([]interface{}{"3:5"}, nil),
+			)
+
+			divisions, err := redisDatabase.JoinLeagueDivisions(context.Background(), league, 2, 3, 50, "member1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(divisions).To(Equal([]*database.LeagueDivision{{Tier: 3, Division: 5}}))
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().HGet(gomock.Any(), gomock.Eq(divisionsKey), gomock.Eq("3")).Return("1", nil)
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+			).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.JoinLeagueDivisions(context.Background(), league, 2, 3, 50, "member1")
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("SetLeague", func() {
+		It("Should return nil if all is OK", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(), gomock.Any(), gomock.Eq([]string{leagueKey}), gomock.Eq("HSET"),
+				gomock.Eq("divisionSize"), gomock.Eq("50"),
+				gomock.Eq("order"), gomock.Eq("desc"),
+				gomock.Eq("promotionCount"), gomock.Eq("5"),
+				gomock.Eq("relegationCount"), gomock.Eq("10"),
+				gomock.Eq("season"), gomock.Eq("2"),
+				gomock.Eq("tiers"), gomock.Eq("3"),
+			).Return(int64(6), nil)
+
+			err := redisDatabase.SetLeague(context.Background(), league, &database.League{
+				Season:          2,
+				Tiers:           3,
+				DivisionSize:    50,
+				PromotionCount:  5,
+				RelegationCount: 10,
+				Order:           "desc",
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{leagueKey}), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			err := redisDatabase.SetLeague(context.Background(), league, &database.League{})
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+})
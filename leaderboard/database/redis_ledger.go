@@ -0,0 +1,101 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// addLedgerEntriesScript adds to each ledger of KEYS the entry ARGV[2i + 3] with score ARGV[2i + 2],
+// expiring the ledgers at unix milliseconds ARGV[1] if it is not empty. Entries with scores before
+// ARGV[2] are removed if it is not empty, and only the last ARGV[3] entries of each ledger are kept if
+// it is greater than zero
+const addLedgerEntriesScript = `
+local maxEntries = tonumber(ARGV[3])
+for i, key in ipairs(KEYS) do
+	redis.call('ZADD', key, ARGV[2 * i + 2], ARGV[2 * i + 3])
+	if ARGV[2] ~= '' then
+		redis.call('ZREMRANGEBYSCORE', key, '-inf', '(' .. ARGV[2])
+	end
+	if maxEntries > 0 then
+		redis.call('ZREMRANGEBYRANK', key, 0, -maxEntries - 1)
+	end
+	if ARGV[1] ~= '' then
+		redis.call('PEXPIREAT', key, ARGV[1])
+	end
+end
+return 1
+`
+
+// AddLedgerEntries add entries to the ledgers of their members in leaderboard, removing entries changed
+// before removeBefore unless it is zero and keeping only the last maxEntries entries of each ledger if it
+// is greater than zero. Ledgers expire at expireAt unless it is zero
+func (r *Redis) AddLedgerEntries(ctx context.Context, leaderboard string, entries []*LedgerEntry, removeBefore, expireAt time.Time, maxEntries int) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	expireAtArg := ""
+	if !expireAt.IsZero() {
+		expireAtArg = formatLedgerTime(expireAt)
+	}
+
+	removeBeforeArg := ""
+	if !removeBefore.IsZero() {
+		removeBeforeArg = formatLedgerTime(removeBefore)
+	}
+
+	keys := make([]string, 0, len(entries))
+	args := make([]interface{}, 0, 2*len(entries)+3)
+	args = append(args, expireAtArg, removeBeforeArg, strconv.Itoa(maxEntries))
+	for _, entry := range entries {
+		value, err := json.Marshal(entry)
+		if err != nil {
+			return NewGeneralError(err.Error())
+		}
+
+		keys = append(keys, ledgerKey(leaderboard, entry.Member))
+		args = append(args, formatLedgerTime(entry.ChangedAt), string(value))
+	}
+
+	_, err := r.Client.Eval(ctx, addLedgerEntriesScript, keys, args...)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
+// GetLedgerEntries return count entries of member ledger in leaderboard changed at since or after, skipping
+// the first offset ones. Entries are returned oldest first
+func (r *Redis) GetLedgerEntries(ctx context.Context, leaderboard, member string, since time.Time, offset, count int) ([]*LedgerEntry, error) {
+	min := formatLedgerTime(since)
+	values, err := r.Client.ZRangeByScore(ctx, ledgerKey(leaderboard, member), min, "+inf", int64(offset), int64(count))
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	entries := make([]*LedgerEntry, 0, len(values))
+	for _, value := range values {
+		entry := &LedgerEntry{}
+		err = json.Unmarshal([]byte(value), entry)
+		if err != nil {
+			return nil, NewGeneralError(err.Error())
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// ledgerKey return the ledger of member in leaderboard, in the slot of leaderboard so the ledgers of the
+// members of a write are written by a single script on redis cluster
+func ledgerKey(leaderboard, member string) string {
+	return LeaderboardKey(leaderboard, "ledger:"+member)
+}
+
+// formatLedgerTime format t as the unix milliseconds ledger entries are scored with
+func formatLedgerTime(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10)
+}
@@ -0,0 +1,119 @@
+package database_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+var _ = Describe("Redis Ledger Database", func() {
+	var ctrl *gomock.Controller
+	var mock *redis.MockRedis
+	var redisDatabase *database.Redis
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = redis.NewMockRedis(ctrl)
+
+		redisDatabase = &database.Redis{mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("AddLedgerEntries", func() {
+		var oldScore int64 = 10
+		var newScore int64 = 20
+		var entry *database.LedgerEntry
+
+		BeforeEach(func() {
+			entry = &database.LedgerEntry{
+				Member:    "member1",
+				OldScore:  &oldScore,
+				NewScore:  &newScore,
+				Delta:     10,
+				Reason:    "match",
+				Caller:    "game-server",
+				ChangedAt: time.Unix(1600000000, 0),
+			}
+		})
+
+		It("Should add entries to member ledgers trimming them and expiring them at expireAt", func() {
+			value, err := json.Marshal(entry)
+			Expect(err).NotTo(HaveOccurred())
+
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{"{leaderboardTest}:ledger:member1", "{leaderboardTest}:ledger:member2"}),
+				gomock.Eq("1700000000000"),
+				gomock.Eq("1500000000000"),
+				gomock.Eq("100"),
+				gomock.Eq("1600000000000"),
+				gomock.Eq(string(value)),
+				gomock.Any(),
+				gomock.Any(),
+			).Return(int64(1), nil)
+
+			otherEntry := *entry
+			otherEntry.Member = "member2"
+			err = redisDatabase.AddLedgerEntries(context.Background(), leaderboard, []*database.LedgerEntry{entry, &otherEntry}, time.Unix(1500000000, 0), time.Unix(1700000000, 0), 100)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should not trim nor expire member ledgers if removeBefore, expireAt and maxEntries are zero", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(""), gomock.Eq(""), gomock.Eq("0"), gomock.Any(), gomock.Any()).Return(int64(1), nil)
+
+			err := redisDatabase.AddLedgerEntries(context.Background(), leaderboard, []*database.LedgerEntry{entry}, time.Time{}, time.Time{}, 0)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should not call redis if there are no entries", func() {
+			err := redisDatabase.AddLedgerEntries(context.Background(), leaderboard, []*database.LedgerEntry{}, time.Time{}, time.Time{}, 0)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			err := redisDatabase.AddLedgerEntries(context.Background(), leaderboard, []*database.LedgerEntry{entry}, time.Time{}, time.Time{}, 0)
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("GetLedgerEntries", func() {
+		It("Should return entries changed since time", func() {
+			mock.EXPECT().ZRangeByScore(
+				gomock.Any(), gomock.Eq("{leaderboardTest}:ledger:member1"), gomock.Eq("1600000000000"), gomock.Eq("+inf"), gomock.Eq(int64(10)), gomock.Eq(int64(5)),
+			).Return([]string{
+				`{"member":"member1","oldScore":null,"newScore":20,"delta":20,"reason":"match","caller":"game-server","changedAt":"2020-09-13T12:26:40Z"}`,
+			}, nil)
+
+			entries, err := redisDatabase.GetLedgerEntries(context.Background(), leaderboard, "member1", time.Unix(1600000000, 0), 10, 5)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].OldScore).To(BeNil())
+			Expect(*entries[0].NewScore).To(Equal(int64(20)))
+			Expect(entries[0].Delta).To(Equal(int64(20)))
+			Expect(entries[0].Reason).To(Equal("match"))
+			Expect(entries[0].Caller).To(Equal("game-server"))
+			Expect(entries[0].ChangedAt.Unix()).To(Equal(int64(1600000000)))
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().ZRangeByScore(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.GetLedgerEntries(context.Background(), leaderboard, "member1", time.Time{}, 0, 10)
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+})
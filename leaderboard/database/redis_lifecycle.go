@@ -0,0 +1,168 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+// ExpiringLeaderboardsSet is used to list leaderboards with an expiration that worker will notify when they expire
+const ExpiringLeaderboardsSet string = "expiring-leaderboards"
+
+// WebhookDeliveriesKey and WebhookDeadLettersKey keep the last attempts to deliver lifecycle events to
+// the webhook and the events it failed to receive
+const (
+	WebhookDeliveriesKey  string = "webhooks:deliveries"
+	WebhookDeadLettersKey string = "webhooks:dead-letters"
+)
+
+// WebhookDeliveriesLimit and WebhookDeadLettersLimit are how many of the last deliveries and dead letters are kept
+const (
+	WebhookDeliveriesLimit  = 1000
+	WebhookDeadLettersLimit = 10000
+)
+
+// markLeaderboardCreatedScript sets KEYS[1] to ARGV[1] only if it does not exist, expiring it at unix
+// milliseconds ARGV[2] when it is not zero. It returns 1 if set
+const markLeaderboardCreatedScript = `
+if redis.call('SET', KEYS[1], ARGV[1], 'NX') then
+	if tonumber(ARGV[2]) > 0 then
+		redis.call('PEXPIREAT', KEYS[1], ARGV[2])
+	end
+	return 1
+end
+return 0
+`
+
+// WebhookDelivery is a struct to keep an attempt to deliver lifecycle events to the webhook
+type WebhookDelivery struct {
+	ID           string    `json:"id"`
+	URL          string    `json:"url"`
+	EventIDs     []string  `json:"eventIDs"`
+	Attempt      int       `json:"attempt"`
+	StatusCode   int       `json:"statusCode"`
+	Error        string    `json:"error,omitempty"`
+	DeadLettered bool      `json:"deadLettered"`
+	AttemptedAt  time.Time `json:"attemptedAt"`
+}
+
+// WebhookDeadLetter is a struct to keep lifecycle events the webhook failed to receive, so they can be replayed.
+// Payload is the body of the webhook request
+type WebhookDeadLetter struct {
+	DeliveryID     string    `json:"deliveryID"`
+	URL            string    `json:"url"`
+	Payload        string    `json:"payload"`
+	Error          string    `json:"error"`
+	DeadLetteredAt time.Time `json:"deadLetteredAt"`
+}
+
+// AddWebhookDeadLetter add deadLetter to the dead-letter store, keeping the last WebhookDeadLettersLimit ones
+func (r *Redis) AddWebhookDeadLetter(ctx context.Context, deadLetter *WebhookDeadLetter) error {
+	return r.addCapped(ctx, WebhookDeadLettersKey, deadLetter.DeadLetteredAt, deadLetter, WebhookDeadLettersLimit)
+}
+
+// AddWebhookDelivery add delivery to the webhook deliveries, keeping the last WebhookDeliveriesLimit ones
+func (r *Redis) AddWebhookDelivery(ctx context.Context, delivery *WebhookDelivery) error {
+	return r.addCapped(ctx, WebhookDeliveriesKey, delivery.AttemptedAt, delivery, WebhookDeliveriesLimit)
+}
+
+// GetWebhookDeliveries return webhook deliveries from start to stop, the last attempted first
+func (r *Redis) GetWebhookDeliveries(ctx context.Context, start, stop int) ([]*WebhookDelivery, error) {
+	members, err := r.Client.ZRevRange(ctx, WebhookDeliveriesKey, int64(start), int64(stop))
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	deliveries := make([]*WebhookDelivery, 0, len(members))
+	for _, member := range members {
+		delivery := &WebhookDelivery{}
+		err = json.Unmarshal([]byte(member.Member), delivery)
+		if err != nil {
+			return nil, NewGeneralError(err.Error())
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
+
+// MarkLeaderboardCreated mark leaderboard as created until it is removed or expires at expireAt, unless
+// it is zero, listing it to be notified when it expires. It returns false if leaderboard was already marked
+func (r *Redis) MarkLeaderboardCreated(ctx context.Context, leaderboard string, expireAt time.Time) (bool, error) {
+	var expireAtMilliseconds int64
+	if !expireAt.IsZero() {
+		expireAtMilliseconds = expireAt.UnixNano() / int64(time.Millisecond)
+	}
+
+	result, err := r.Client.Eval(
+		ctx, markLeaderboardCreatedScript, []string{createdKey(leaderboard)},
+		strconv.FormatInt(time.Now().Unix(), 10), strconv.FormatInt(expireAtMilliseconds, 10),
+	)
+	if err != nil {
+		return false, NewGeneralError(err.Error())
+	}
+
+	if fmt.Sprint(result) != "1" {
+		return false, nil
+	}
+
+	if !expireAt.IsZero() {
+		err = r.Client.ZAdd(ctx, ExpiringLeaderboardsSet, &redis.Member{Member: leaderboard, Score: float64(expireAt.Unix())})
+		if err != nil {
+			return false, NewGeneralError(err.Error())
+		}
+	}
+
+	return true, nil
+}
+
+// GetExpiredLeaderboards return up to amount leaderboards listed to be notified that expired until maxTime,
+// they stay listed until acknowledged by AckExpiredLeaderboards
+func (r *Redis) GetExpiredLeaderboards(ctx context.Context, maxTime time.Time, amount int) ([]string, error) {
+	leaderboards, err := r.Client.ZRangeByScore(ctx, ExpiringLeaderboardsSet, "-inf", strconv.FormatInt(maxTime.Unix(), 10), 0, int64(amount))
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	return leaderboards, nil
+}
+
+// AckExpiredLeaderboards remove leaderboards from the ones listed to be notified that expired
+func (r *Redis) AckExpiredLeaderboards(ctx context.Context, leaderboards ...string) error {
+	if len(leaderboards) == 0 {
+		return nil
+	}
+
+	err := r.Client.ZRem(ctx, ExpiringLeaderboardsSet, leaderboards...)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
+// addCapped add the JSON of value to the sorted set key scored by at, keeping only the last limit members
+func (r *Redis) addCapped(ctx context.Context, key string, at time.Time, value interface{}, limit int) error {
+	member, err := json.Marshal(value)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	_, err = r.Client.Eval(
+		ctx, addCappedScript, []string{key},
+		strconv.FormatInt(at.UnixNano()/int64(time.Millisecond), 10), string(member), strconv.Itoa(limit),
+	)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
+func createdKey(leaderboard string) string {
+	return fmt.Sprintf("%s:created", leaderboard)
+}
@@ -0,0 +1,149 @@
+package database_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+var _ = Describe("Redis Lifecycle Database", func() {
+	var ctrl *gomock.Controller
+	var mock *redis.MockRedis
+	var redisDatabase *database.Redis
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = redis.NewMockRedis(ctrl)
+
+		redisDatabase = &database.Redis{mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("MarkLeaderboardCreated", func() {
+		It("Should return true and list leaderboard to expire if it was marked", func() {
+			expireAt := time.Unix(1600000000, 0)
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"leaderboardTest:created"}), gomock.Any(), gomock.Eq("1600000000000")).Return(int64(1), nil)
+			mock.EXPECT().ZAdd(gomock.Any(), gomock.Eq(database.ExpiringLeaderboardsSet), gomock.Eq(&redis.Member{Member: leaderboard, Score: 1600000000})).Return(nil)
+
+			created, err := redisDatabase.MarkLeaderboardCreated(context.Background(), leaderboard, expireAt)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created).To(BeTrue())
+		})
+
+		It("Should not list leaderboard to expire if it does not expire", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"leaderboardTest:created"}), gomock.Any(), gomock.Eq("0")).Return(int64(1), nil)
+
+			created, err := redisDatabase.MarkLeaderboardCreated(context.Background(), leaderboard, time.Time{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created).To(BeTrue())
+		})
+
+		It("Should return false if leaderboard was already marked", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"leaderboardTest:created"}), gomock.Any(), gomock.Any()).Return(int64(0), nil)
+
+			created, err := redisDatabase.MarkLeaderboardCreated(context.Background(), leaderboard, time.Unix(1600000000, 0))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created).To(BeFalse())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.MarkLeaderboardCreated(context.Background(), leaderboard, time.Time{})
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("GetExpiredLeaderboards", func() {
+		It("Should return expired leaderboards", func() {
+			mock.EXPECT().ZRangeByScore(gomock.Any(), gomock.Eq(database.ExpiringLeaderboardsSet), gomock.Eq("-inf"), gomock.Eq("1600000000"), gomock.Eq(int64(0)), gomock.Eq(int64(10))).
+				Return([]string{"leaderboard1", "leaderboard2"}, nil)
+
+			leaderboards, err := redisDatabase.GetExpiredLeaderboards(context.Background(), time.Unix(1600000000, 0), 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaderboards).To(Equal([]string{"leaderboard1", "leaderboard2"}))
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().ZRangeByScore(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.GetExpiredLeaderboards(context.Background(), time.Unix(1600000000, 0), 10)
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("AckExpiredLeaderboards", func() {
+		It("Should remove acknowledged leaderboards", func() {
+			mock.EXPECT().ZRem(gomock.Any(), gomock.Eq(database.ExpiringLeaderboardsSet), gomock.Eq("leaderboard1"), gomock.Eq("leaderboard2")).Return(nil)
+
+			err := redisDatabase.AckExpiredLeaderboards(context.Background(), "leaderboard1", "leaderboard2")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().ZRem(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("redis error"))
+
+			err := redisDatabase.AckExpiredLeaderboards(context.Background(), "leaderboard1")
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("AddWebhookDelivery", func() {
+		It("Should add delivery keeping the last ones", func() {
+			delivery := &database.WebhookDelivery{ID: "delivery1", Attempt: 1, AttemptedAt: time.Unix(1600000000, 0)}
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{database.WebhookDeliveriesKey}), gomock.Eq("1600000000000"), gomock.Any(), gomock.Eq("1000")).Return(int64(1), nil)
+
+			err := redisDatabase.AddWebhookDelivery(context.Background(), delivery)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			err := redisDatabase.AddWebhookDelivery(context.Background(), &database.WebhookDelivery{})
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("AddWebhookDeadLetter", func() {
+		It("Should add dead letter keeping the last ones", func() {
+			deadLetter := &database.WebhookDeadLetter{DeliveryID: "delivery1", Payload: `{"events":[]}`, DeadLetteredAt: time.Unix(1600000000, 0)}
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{database.WebhookDeadLettersKey}), gomock.Eq("1600000000000"), gomock.Any(), gomock.Eq("10000")).Return(int64(1), nil)
+
+			err := redisDatabase.AddWebhookDeadLetter(context.Background(), deadLetter)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("GetWebhookDeliveries", func() {
+		It("Should return deliveries from start to stop", func() {
+			delivery := &database.WebhookDelivery{ID: "delivery1", EventIDs: []string{"event1"}, Attempt: 1, StatusCode: 200, AttemptedAt: time.Unix(1600000000, 0).UTC()}
+			value, err := json.Marshal(delivery)
+			Expect(err).NotTo(HaveOccurred())
+			mock.EXPECT().ZRevRange(gomock.Any(), gomock.Eq(database.WebhookDeliveriesKey), gomock.Eq(int64(0)), gomock.Eq(int64(9))).
+				Return([]*redis.Member{{Member: string(value), Score: 1600000000000}}, nil)
+
+			deliveries, err := redisDatabase.GetWebhookDeliveries(context.Background(), 0, 9)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deliveries).To(Equal([]*database.WebhookDelivery{delivery}))
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().ZRevRange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.GetWebhookDeliveries(context.Background(), 0, 9)
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+})
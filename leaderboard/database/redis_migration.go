@@ -0,0 +1,356 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// legacyVersionsSuffix ends the versions keys named "leaderboard:versions" before they were hash tagged
+const legacyVersionsSuffix = ":versions"
+
+// legacyBlockedSuffix ends the hashes of blocked members named "leaderboard:blocked" before they were hash
+// tagged
+const legacyBlockedSuffix = ":blocked"
+
+// legacySettingsSuffix ends the hashes of leaderboard settings named "leaderboard:settings" before they were
+// hash tagged
+const legacySettingsSuffix = ":settings"
+
+// legacyShadowSuffix ends the shadow leaderboards named "leaderboard:shadow" before they were hash tagged,
+// which also kept the scores of members blocked in reject mode
+const legacyShadowSuffix = ":shadow"
+
+// legacyLedgerInfix separates leaderboards and members in the ledgers named "leaderboard:ledger:member"
+// before they were hash tagged
+const legacyLedgerInfix = ":ledger:"
+
+// legacyHistoryInfix separates leaderboards and members in the histories named "leaderboard:history:member"
+// before they were hash tagged
+const legacyHistoryInfix = ":history:"
+
+// legacySnapshotsSuffix ends the indexes of rank snapshots named "leaderboard:snapshots" before they were
+// hash tagged
+const legacySnapshotsSuffix = ":snapshots"
+
+// legacySnapshotInfix separates leaderboards and times in the rank snapshots named
+// "leaderboard:snapshot:takenAt" before they were hash tagged
+const legacySnapshotInfix = ":snapshot:"
+
+// migrateKeysScanCount is how many keys are asked to each SCAN while looking for keys to migrate
+const migrateKeysScanCount = 1000
+
+// migrateBatchSize is how many versions, blocked members or scores are merged by each script, so big keys
+// do not block redis
+const migrateBatchSize = 1000
+
+// readLegacyHashScript return the fields and values of hash KEYS[1] followed by its time to live in
+// milliseconds, or false if it is not a hash
+const readLegacyHashScript = `
+if redis.call('TYPE', KEYS[1]).ok ~= 'hash' then
+	return false
+end
+local result = redis.call('HGETALL', KEYS[1])
+result[#result + 1] = redis.call('PTTL', KEYS[1])
+return result
+`
+
+// mergeVersionsScript increments the versions in hash KEYS[1] by ARGV[2..] member and version pairs, so
+// they stay greater than every version they had, and makes it expire in ARGV[1] milliseconds if it is
+// positive and the hash does not expire yet
+const mergeVersionsScript = `
+for i = 2, #ARGV, 2 do
+	redis.call('HINCRBY', KEYS[1], ARGV[i], ARGV[i + 1])
+end
+if tonumber(ARGV[1]) > 0 and redis.call('PTTL', KEYS[1]) == -1 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return 1
+`
+
+// mergeMissingFieldsScript sets ARGV[2..] field and value pairs in hash KEYS[1], like the modes of blocked
+// members or leaderboard settings, only for fields that are not in it yet, keeping values set since
+const mergeMissingFieldsScript = `
+for i = 2, #ARGV, 2 do
+	redis.call('HSETNX', KEYS[1], ARGV[i], ARGV[i + 1])
+end
+return 1
+`
+
+// readLegacySortedSetScript return the members and scores of sorted set KEYS[1] from rank ARGV[1] to
+// ARGV[2] followed by its time to live in milliseconds, or false if it is not a sorted set
+const readLegacySortedSetScript = `
+if redis.call('TYPE', KEYS[1]).ok ~= 'zset' then
+	return false
+end
+local result = redis.call('ZRANGE', KEYS[1], ARGV[1], ARGV[2], 'WITHSCORES')
+result[#result + 1] = redis.call('PTTL', KEYS[1])
+return result
+`
+
+// mergeShadowScript adds ARGV key index, score and member triples to the leaderboard KEYS[1], its shadow
+// leaderboard KEYS[2] or the scores of its members blocked in reject mode KEYS[3], skipping members that
+// have a score in any of them, written since
+const mergeShadowScript = `
+for i = 1, #ARGV, 3 do
+	local found = false
+	for k = 1, 3 do
+		if redis.call('ZSCORE', KEYS[k], ARGV[i + 2]) then
+			found = true
+		end
+	end
+	if not found then
+		redis.call('ZADD', KEYS[tonumber(ARGV[i])], ARGV[i + 1], ARGV[i + 2])
+	end
+end
+return 1
+`
+
+// mergeSortedSetScript adds ARGV[2..] member and score pairs to sorted set KEYS[1], like a ledger, a
+// history or a rank snapshot, and makes it expire in ARGV[1] milliseconds if it is positive and it does not expire yet
+const mergeSortedSetScript = `
+for i = 2, #ARGV, 2 do
+	redis.call('ZADD', KEYS[1], ARGV[i + 1], ARGV[i])
+end
+if tonumber(ARGV[1]) > 0 and redis.call('PTTL', KEYS[1]) == -1 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return 1
+`
+
+// MigrateKeys move the keys podium wrote before they were hash tagged, the members versions named
+// "leaderboard:versions", the blocked members named "leaderboard:blocked", the leaderboard settings named
+// "leaderboard:settings" and the shadow leaderboards named "leaderboard:shadow", to their hash tagged names,
+// like "{leaderboard}:versions", merging them with the ones written since, and the member ledgers and
+// histories named "leaderboard:ledger:member" and "leaderboard:history:member" and the rank snapshots named
+// "leaderboard:snapshots" and "leaderboard:snapshot:takenAt". Settings written since are kept. Scores of old
+// shadow leaderboards are moved to the shadow leaderboard, to the scores of members blocked in reject mode
+// or back to the leaderboard, as members are blocked now. It returns how many keys were moved. Redis cluster
+// places the old keys in other slots than their leaderboards, so they are read and merged by separate
+// commands: run it after every podium instance was updated, as writes of old instances to moved keys would
+// be lost
+func (r *Redis) MigrateKeys(ctx context.Context) (int, error) {
+	migrated := 0
+	migrations := []struct {
+		match   string
+		migrate func(ctx context.Context, key string) (bool, error)
+	}{
+		{"*" + legacyVersionsSuffix, r.migrateVersions},
+		{"*" + legacyBlockedSuffix, r.migrateBlocked},
+		{"*" + legacySettingsSuffix, r.migrateSettings},
+		// ledgers and histories go before shadow leaderboards so the ones of a member named shadow are not
+		// taken for one
+		{"*" + legacyLedgerInfix + "*", r.migrateLedger},
+		{"*" + legacyHistoryInfix + "*", r.migrateHistory},
+		{"*" + legacySnapshotsSuffix, r.migrateSnapshots},
+		{"*" + legacySnapshotInfix + "*", r.migrateSnapshot},
+		{"*" + legacyShadowSuffix, r.migrateShadow},
+	}
+
+	for _, migration := range migrations {
+		err := r.Client.Scan(ctx, migration.match, migrateKeysScanCount, func(keys []string) error {
+			for _, key := range keys {
+				if hasHashTag(key) {
+					continue
+				}
+
+				moved, err := migration.migrate(ctx, key)
+				if err != nil {
+					return err
+				}
+				if moved {
+					migrated++
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return migrated, NewGeneralError(err.Error())
+		}
+	}
+
+	return migrated, nil
+}
+
+// migrateVersions merge the versions of hash key into its hash tagged key and delete it, returning false
+// if key is not a hash. Versions of old shadow leaderboards, named "leaderboard:shadow:versions", are
+// merged into the versions of the hash tagged shadow leaderboard
+func (r *Redis) migrateVersions(ctx context.Context, key string) (bool, error) {
+	leaderboard := strings.TrimSuffix(key, legacyVersionsSuffix)
+	if strings.HasSuffix(leaderboard, legacyShadowSuffix) {
+		leaderboard = ShadowLeaderboard(strings.TrimSuffix(leaderboard, legacyShadowSuffix))
+	}
+
+	return r.migrateHash(ctx, key, versionsKey(leaderboard), mergeVersionsScript)
+}
+
+// migrateBlocked merge the blocked members of hash key into its hash tagged key and delete it, returning
+// false if key is not a hash
+func (r *Redis) migrateBlocked(ctx context.Context, key string) (bool, error) {
+	return r.migrateHash(ctx, key, blockedMembersKey(strings.TrimSuffix(key, legacyBlockedSuffix)), mergeMissingFieldsScript)
+}
+
+// migrateSettings merge the settings of hash key into its hash tagged key and delete it, returning false
+// if key is not a hash
+func (r *Redis) migrateSettings(ctx context.Context, key string) (bool, error) {
+	return r.migrateHash(ctx, key, settingsKey(strings.TrimSuffix(key, legacySettingsSuffix)), mergeMissingFieldsScript)
+}
+
+// migrateHash merge hash key into newKey with mergeScript, called with the time to live of key and pages
+// of its fields and values, and delete it, returning false if key is not a hash
+func (r *Redis) migrateHash(ctx context.Context, key, newKey, mergeScript string) (bool, error) {
+	result, err := r.Client.Eval(ctx, readLegacyHashScript, []string{key})
+	if err != nil {
+		return false, err
+	}
+	if result == nil {
+		return false, nil
+	}
+
+	values, ok := result.([]interface{})
+	if !ok || len(values)%2 != 1 {
+		return false, fmt.Errorf("unexpected hash %v of %s", result, key)
+	}
+
+	pairs := values[:len(values)-1]
+	ttl := values[len(values)-1]
+	for start := 0; start < len(pairs); start += 2 * migrateBatchSize {
+		end := start + 2*migrateBatchSize
+		if end > len(pairs) {
+			end = len(pairs)
+		}
+
+		args := make([]interface{}, 0, end-start+1)
+		args = append(args, ttl)
+		args = append(args, pairs[start:end]...)
+		_, err = r.Client.Eval(ctx, mergeScript, []string{newKey}, args...)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	err = r.Client.Del(ctx, key)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// migrateShadow move the scores of sorted set key, the old shadow leaderboard of a leaderboard, to where
+// they are kept now by how each member is blocked, and delete it, returning false if key is not a sorted
+// set. Blocked members are migrated first, so their modes are found in the hash tagged keys
+func (r *Redis) migrateShadow(ctx context.Context, key string) (bool, error) {
+	leaderboard := strings.TrimSuffix(key, legacyShadowSuffix)
+	keys := []string{leaderboard, ShadowLeaderboard(leaderboard), blockedScoresKey(leaderboard)}
+	return r.migrateSortedSet(ctx, key, func(values []interface{}, ttl interface{}) error {
+		members := make([]string, 0, len(values)/2)
+		for i := 0; i < len(values); i += 2 {
+			members = append(members, fmt.Sprint(values[i]))
+		}
+
+		blockedMembers, err := r.GetBlockedMembers(ctx, leaderboard, members...)
+		if err != nil {
+			return err
+		}
+
+		args := make([]interface{}, 0, 3*len(members))
+		for i, blockedMember := range blockedMembers {
+			mode := blockedMember.Mode
+			if mode == "" {
+				mode = blockedMember.GlobalMode
+			}
+
+			index := 1
+			switch mode {
+			case "shadow":
+				index = 2
+			case "reject":
+				index = 3
+			}
+			args = append(args, index, values[2*i+1], members[i])
+		}
+
+		_, err = r.Client.Eval(ctx, mergeShadowScript, keys, args...)
+		return err
+	})
+}
+
+// migrateLedger add the entries of sorted set key, the old ledger of a member, to its hash tagged ledger
+// and delete it, returning false if key is not a sorted set
+func (r *Redis) migrateLedger(ctx context.Context, key string) (bool, error) {
+	separator := strings.Index(key, legacyLedgerInfix)
+	return r.mergeSortedSet(ctx, key, ledgerKey(key[:separator], key[separator+len(legacyLedgerInfix):]))
+}
+
+// migrateHistory add the samples of sorted set key, the old history of a member, to its hash tagged
+// history and delete it, returning false if key is not a sorted set
+func (r *Redis) migrateHistory(ctx context.Context, key string) (bool, error) {
+	separator := strings.Index(key, legacyHistoryInfix)
+	return r.mergeSortedSet(ctx, key, historyKey(key[:separator], key[separator+len(legacyHistoryInfix):]))
+}
+
+// migrateSnapshots add the times of sorted set key, the old index of the rank snapshots of a leaderboard,
+// to its hash tagged index and delete it, returning false if key is not a sorted set
+func (r *Redis) migrateSnapshots(ctx context.Context, key string) (bool, error) {
+	return r.mergeSortedSet(ctx, key, snapshotsKey(strings.TrimSuffix(key, legacySnapshotsSuffix)))
+}
+
+// migrateSnapshot add the scores of sorted set key, an old rank snapshot, to its hash tagged snapshot and
+// delete it, returning false if key is not a sorted set
+func (r *Redis) migrateSnapshot(ctx context.Context, key string) (bool, error) {
+	separator := strings.LastIndex(key, legacySnapshotInfix)
+	return r.mergeSortedSet(ctx, key, snapshotKey(key[:separator], key[separator+len(legacySnapshotInfix):]))
+}
+
+// mergeSortedSet add the members of sorted set key to sorted set newKey and delete it, returning false if
+// key is not a sorted set
+func (r *Redis) mergeSortedSet(ctx context.Context, key, newKey string) (bool, error) {
+	return r.migrateSortedSet(ctx, key, func(values []interface{}, ttl interface{}) error {
+		args := make([]interface{}, 0, len(values)+1)
+		args = append(args, ttl)
+		args = append(args, values...)
+		_, err := r.Client.Eval(ctx, mergeSortedSetScript, []string{newKey}, args...)
+		return err
+	})
+}
+
+// migrateSortedSet call merge with pages of the members and scores of sorted set key and its time to live
+// and delete it, returning false if key is not a sorted set
+func (r *Redis) migrateSortedSet(ctx context.Context, key string, merge func(values []interface{}, ttl interface{}) error) (bool, error) {
+	for start := 0; ; start += migrateBatchSize {
+		result, err := r.Client.Eval(ctx, readLegacySortedSetScript, []string{key}, start, start+migrateBatchSize-1)
+		if err != nil {
+			return false, err
+		}
+		if result == nil {
+			return false, nil
+		}
+
+		values, ok := result.([]interface{})
+		if !ok || len(values)%2 != 1 {
+			return false, fmt.Errorf("unexpected sorted set %v of %s", result, key)
+		}
+
+		pairs := values[:len(values)-1]
+		if len(pairs) == 0 {
+			break
+		}
+
+		err = merge(pairs, values[len(values)-1])
+		if err != nil {
+			return false, err
+		}
+
+		if len(pairs) < 2*migrateBatchSize {
+			break
+		}
+	}
+
+	err := r.Client.Del(ctx, key)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,246 @@
+package database_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+var _ = Describe("Redis Migration Database", func() {
+	var ctrl *gomock.Controller
+	var mock *redis.MockRedis
+	var redisDatabase *database.Redis
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = redis.NewMockRedis(ctrl)
+
+		redisDatabase = &database.Redis{mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	scanKeys := func(match string, keys ...string) {
+		mock.EXPECT().Scan(gomock.Any(), gomock.Eq(match), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, match string, count int64, fn func(keys []string) error) error {
+				return fn(keys)
+			},
+		)
+	}
+
+	Describe("MigrateKeys", func() {
+		It("Should merge old versions into hash tagged versions", func() {
+			scanKeys("*:versions", "leaderboardTest:versions", "{leaderboardTest}:versions")
+			scanKeys("*:blocked")
+			scanKeys("*:settings")
+			scanKeys("*:ledger:*")
+			scanKeys("*:history:*")
+			scanKeys("*:snapshots")
+			scanKeys("*:snapshot:*")
+			scanKeys("*:shadow")
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"leaderboardTest:versions"})).Return([]interface{}{"member1", "3", "member2", "1", int64(-1)}, nil)
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"{leaderboardTest}:versions"}), gomock.Eq(int64(-1)), gomock.Eq("member1"), gomock.Eq("3"), gomock.Eq("member2"), gomock.Eq("1")).Return(int64(1), nil)
+			mock.EXPECT().Del(gomock.Any(), gomock.Eq("leaderboardTest:versions")).Return(nil)
+
+			migrated, err := redisDatabase.MigrateKeys(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migrated).To(Equal(1))
+		})
+
+		It("Should skip keys that are not versions", func() {
+			scanKeys("*:versions", "leaderboardTest:versions")
+			scanKeys("*:blocked")
+			scanKeys("*:settings")
+			scanKeys("*:ledger:*")
+			scanKeys("*:history:*")
+			scanKeys("*:snapshots")
+			scanKeys("*:snapshot:*")
+			scanKeys("*:shadow")
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"leaderboardTest:versions"})).Return(nil, nil)
+
+			migrated, err := redisDatabase.MigrateKeys(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migrated).To(Equal(0))
+		})
+
+		It("Should merge old versions of shadow leaderboards into versions of hash tagged shadow leaderboards", func() {
+			scanKeys("*:versions", "leaderboardTest:shadow:versions")
+			scanKeys("*:blocked")
+			scanKeys("*:settings")
+			scanKeys("*:ledger:*")
+			scanKeys("*:history:*")
+			scanKeys("*:snapshots")
+			scanKeys("*:snapshot:*")
+			scanKeys("*:shadow")
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"leaderboardTest:shadow:versions"})).Return([]interface{}{"member1", "3", int64(-1)}, nil)
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"{leaderboardTest}:shadow:versions"}), gomock.Eq(int64(-1)), gomock.Eq("member1"), gomock.Eq("3")).Return(int64(1), nil)
+			mock.EXPECT().Del(gomock.Any(), gomock.Eq("leaderboardTest:shadow:versions")).Return(nil)
+
+			migrated, err := redisDatabase.MigrateKeys(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migrated).To(Equal(1))
+		})
+
+		It("Should merge old blocked members into hash tagged blocked members", func() {
+			scanKeys("*:versions")
+			scanKeys("*:blocked", "leaderboardTest:blocked", "{leaderboardTest}:blocked")
+			scanKeys("*:settings")
+			scanKeys("*:ledger:*")
+			scanKeys("*:history:*")
+			scanKeys("*:snapshots")
+			scanKeys("*:snapshot:*")
+			scanKeys("*:shadow")
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"leaderboardTest:blocked"})).Return([]interface{}{"member1", "shadow", int64(-1)}, nil)
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"{leaderboardTest}:blocked"}), gomock.Eq(int64(-1)), gomock.Eq("member1"), gomock.Eq("shadow")).Return(int64(1), nil)
+			mock.EXPECT().Del(gomock.Any(), gomock.Eq("leaderboardTest:blocked")).Return(nil)
+
+			migrated, err := redisDatabase.MigrateKeys(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migrated).To(Equal(1))
+		})
+
+		It("Should merge old settings into hash tagged settings", func() {
+			scanKeys("*:versions")
+			scanKeys("*:blocked")
+			scanKeys("*:settings", "leaderboardTest:settings")
+			scanKeys("*:ledger:*")
+			scanKeys("*:history:*")
+			scanKeys("*:snapshots")
+			scanKeys("*:snapshot:*")
+			scanKeys("*:shadow")
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"leaderboardTest:settings"})).Return([]interface{}{"decayHalfLife", "3600", int64(-1)}, nil)
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"{leaderboardTest}:settings"}), gomock.Eq(int64(-1)), gomock.Eq("decayHalfLife"), gomock.Eq("3600")).Return(int64(1), nil)
+			mock.EXPECT().Del(gomock.Any(), gomock.Eq("leaderboardTest:settings")).Return(nil)
+
+			migrated, err := redisDatabase.MigrateKeys(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migrated).To(Equal(1))
+		})
+
+		It("Should move scores of old shadow leaderboards by how members are blocked", func() {
+			scanKeys("*:versions")
+			scanKeys("*:blocked")
+			scanKeys("*:settings")
+			scanKeys("*:ledger:*")
+			scanKeys("*:history:*")
+			scanKeys("*:snapshots")
+			scanKeys("*:snapshot:*")
+			scanKeys("*:shadow", "leaderboardTest:shadow")
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"leaderboardTest:shadow"}), gomock.Eq(0), gomock.Eq(999)).
+				Return([]interface{}{"member1", "10", "member2", "20", "member3", "30", int64(-1)}, nil)
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"{leaderboardTest}:blocked"}), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]interface{}{"shadow", "global", ""}, nil)
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"blocked"}), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]interface{}{"", "reject", ""}, nil)
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{"leaderboardTest", "{leaderboardTest}:shadow", "{leaderboardTest}:blocked:scores"}),
+				gomock.Eq(2), gomock.Eq("10"), gomock.Eq("member1"),
+				gomock.Eq(3), gomock.Eq("20"), gomock.Eq("member2"),
+				gomock.Eq(1), gomock.Eq("30"), gomock.Eq("member3"),
+			).Return(int64(1), nil)
+			mock.EXPECT().Del(gomock.Any(), gomock.Eq("leaderboardTest:shadow")).Return(nil)
+
+			migrated, err := redisDatabase.MigrateKeys(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migrated).To(Equal(1))
+		})
+
+		It("Should add entries of old ledgers to hash tagged ledgers", func() {
+			scanKeys("*:versions")
+			scanKeys("*:blocked")
+			scanKeys("*:settings")
+			scanKeys("*:ledger:*", "leaderboardTest:ledger:member1", "{leaderboardTest}:ledger:member1")
+			scanKeys("*:history:*")
+			scanKeys("*:snapshots")
+			scanKeys("*:snapshot:*")
+			scanKeys("*:shadow")
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"leaderboardTest:ledger:member1"}), gomock.Eq(0), gomock.Eq(999)).
+				Return([]interface{}{"entry1", "1000", "entry2", "2000", int64(60000)}, nil)
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{"{leaderboardTest}:ledger:member1"}),
+				gomock.Eq(int64(60000)), gomock.Eq("entry1"), gomock.Eq("1000"), gomock.Eq("entry2"), gomock.Eq("2000"),
+			).Return(int64(1), nil)
+			mock.EXPECT().Del(gomock.Any(), gomock.Eq("leaderboardTest:ledger:member1")).Return(nil)
+
+			migrated, err := redisDatabase.MigrateKeys(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migrated).To(Equal(1))
+		})
+
+		It("Should add samples of old histories to hash tagged histories", func() {
+			scanKeys("*:versions")
+			scanKeys("*:blocked")
+			scanKeys("*:settings")
+			scanKeys("*:ledger:*")
+			scanKeys("*:history:*", "leaderboardTest:history:member1", "{leaderboardTest}:history:member1")
+			scanKeys("*:snapshots")
+			scanKeys("*:snapshot:*")
+			scanKeys("*:shadow")
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"leaderboardTest:history:member1"}), gomock.Eq(0), gomock.Eq(999)).
+				Return([]interface{}{"sample1", "1000", int64(-1)}, nil)
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{"{leaderboardTest}:history:member1"}),
+				gomock.Eq(int64(-1)), gomock.Eq("sample1"), gomock.Eq("1000"),
+			).Return(int64(1), nil)
+			mock.EXPECT().Del(gomock.Any(), gomock.Eq("leaderboardTest:history:member1")).Return(nil)
+
+			migrated, err := redisDatabase.MigrateKeys(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migrated).To(Equal(1))
+		})
+
+		It("Should add old rank snapshots and their index to hash tagged ones", func() {
+			scanKeys("*:versions")
+			scanKeys("*:blocked")
+			scanKeys("*:settings")
+			scanKeys("*:ledger:*")
+			scanKeys("*:history:*")
+			scanKeys("*:snapshots", "leaderboardTest:snapshots")
+			scanKeys("*:snapshot:*", "leaderboardTest:snapshot:1600000000000")
+			scanKeys("*:shadow")
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"leaderboardTest:snapshots"}), gomock.Eq(0), gomock.Eq(999)).
+				Return([]interface{}{"1600000000000", "1600000000000", int64(-1)}, nil)
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{"{leaderboardTest}:snapshots"}),
+				gomock.Eq(int64(-1)), gomock.Eq("1600000000000"), gomock.Eq("1600000000000"),
+			).Return(int64(1), nil)
+			mock.EXPECT().Del(gomock.Any(), gomock.Eq("leaderboardTest:snapshots")).Return(nil)
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"leaderboardTest:snapshot:1600000000000"}), gomock.Eq(0), gomock.Eq(999)).
+				Return([]interface{}{"member1", "10", int64(-1)}, nil)
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{"{leaderboardTest}:snapshot:1600000000000"}),
+				gomock.Eq(int64(-1)), gomock.Eq("member1"), gomock.Eq("10"),
+			).Return(int64(1), nil)
+			mock.EXPECT().Del(gomock.Any(), gomock.Eq("leaderboardTest:snapshot:1600000000000")).Return(nil)
+
+			migrated, err := redisDatabase.MigrateKeys(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migrated).To(Equal(2))
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			scanKeys("*:versions", "leaderboardTest:versions")
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"leaderboardTest:versions"})).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.MigrateKeys(context.Background())
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+})
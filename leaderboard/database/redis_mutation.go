@@ -0,0 +1,314 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+// MutationOutboxesSet lists the outboxes mutations were added to, scored by the unix time of their last write
+const MutationOutboxesSet string = "mutation-outboxes"
+
+// mutationOutboxRelistAfter is how old the oldest mutation of an outbox must be for writes adding to it to list
+// it again, so an outbox whose listing failed is listed by a later write
+const mutationOutboxRelistAfter = 10 * time.Second
+
+// addMutationFunction adds mutation to stream outbox, in field "mutation", returning 1 if outbox must be listed
+// in MutationOutboxesSet, as the mutation is the first of outbox or its oldest one was added until relistBefore
+// unix milliseconds, or 0 otherwise
+const addMutationFunction = `
+local function addMutation(outbox, mutation, relistBefore)
+	local id = redis.call('XADD', outbox, '*', 'mutation', mutation)
+	local first = redis.call('XRANGE', outbox, '-', '+', 'COUNT', 1)[1][1]
+	if first == id or tonumber(string.sub(first, 1, string.find(first, '-', 1, true) - 1)) <= tonumber(relistBefore) then
+		return 1
+	end
+	return 0
+end
+`
+
+// mutationWriteScript runs the write script in the function below after taking from KEYS and ARGV the outbox,
+// the mutation and the relist time put first by evalWrite, so the write sees the keys and arguments it was given.
+// The mutation is added to the outbox in the same script, unless the write returned an error or the condition
+// %s of its result tells it did not write. It returns if outbox must be listed followed by the write result
+const mutationWriteScript = addMutationFunction + `
+local outbox = table.remove(KEYS, 1)
+local mutation = table.remove(ARGV, 1)
+local relistBefore = table.remove(ARGV, 1)
+local result = (function()
+%s
+end)()
+if type(result) == 'table' and result.err then
+	return result
+end
+local list = 0
+if %s then
+	list = addMutation(outbox, mutation, relistBefore)
+end
+return {list, result}
+`
+
+// outsideOutboxSlotWriteScript runs the write script in the function below, returning 1 if the condition %s of
+// its result tells it wrote or 0 otherwise, followed by the write result
+const outsideOutboxSlotWriteScript = `
+local result = (function()
+%s
+end)()
+if type(result) == 'table' and result.err then
+	return result
+end
+local written = 0
+if %s then
+	written = 1
+end
+return {written, result}
+`
+
+// addMutationScript adds mutation ARGV[1] to outbox KEYS[1] as addMutation does with relist time ARGV[2]
+const addMutationScript = addMutationFunction + `
+return addMutation(KEYS[1], ARGV[1], ARGV[2])
+`
+
+// removeIdleMutationOutboxScript removes ARGV[1] from KEYS[1] if its score is at most ARGV[2]
+const removeIdleMutationOutboxScript = `
+local score = redis.call('ZSCORE', KEYS[1], ARGV[1])
+if score and tonumber(score) <= tonumber(ARGV[2]) then
+	return redis.call('ZREM', KEYS[1], ARGV[1])
+end
+return 0
+`
+
+// readMutationsScript return up to ARGV[1] entries of stream KEYS[1]
+const readMutationsScript = `
+return redis.call('XRANGE', KEYS[1], '-', '+', 'COUNT', ARGV[1])
+`
+
+// removeMutationsScript removes the entries with IDs ARGV of stream KEYS[1]
+const removeMutationsScript = `
+return redis.call('XDEL', KEYS[1], unpack(ARGV))
+`
+
+// writeCommandScript calls the redis command ARGV[1] on KEYS[1] with the other arguments
+const writeCommandScript = `
+return redis.call(ARGV[1], KEYS[1], unpack(ARGV, 2))
+`
+
+type mutationContextKey struct{}
+
+// OutboxMutation is a mutation added to an outbox, with the ID of its entry in the stream
+type OutboxMutation struct {
+	StreamID string
+	Mutation string
+}
+
+// WithMutation return a copy of ctx whose write through Redis also adds mutation to the outbox of the slot
+// of the keys written, in the same script, so mutations of a slot are added in the order they are applied.
+// Writes that end up not writing, like an idempotent write replayed, don't add it
+func WithMutation(ctx context.Context, mutation string) context.Context {
+	return context.WithValue(ctx, mutationContextKey{}, mutation)
+}
+
+// MutationOutbox return the stream mutations of writes to leaderboard are added to, named by its hash tag, or
+// the whole leaderboard when it has none, so it's in the slot of leaderboard, like "{leaderboard}:mutations"
+func MutationOutbox(leaderboard string) string {
+	return fmt.Sprintf("{%s}:mutations", hashSlotKey(leaderboard))
+}
+
+// GetMutationOutboxes return the outboxes listed in MutationOutboxesSet
+func (r *Redis) GetMutationOutboxes(ctx context.Context) ([]string, error) {
+	members, err := r.Client.ZRange(ctx, MutationOutboxesSet, 0, -1)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	outboxes := make([]string, 0, len(members))
+	for _, member := range members {
+		outboxes = append(outboxes, member.Member)
+	}
+
+	return outboxes, nil
+}
+
+// GetMutations return up to amount of the first mutations of outbox
+func (r *Redis) GetMutations(ctx context.Context, outbox string, amount int) ([]*OutboxMutation, error) {
+	result, err := r.Client.Eval(ctx, readMutationsScript, []string{outbox}, strconv.Itoa(amount))
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	entries, _ := result.([]interface{})
+	mutations := make([]*OutboxMutation, 0, len(entries))
+	for _, entry := range entries {
+		values, ok := entry.([]interface{})
+		if !ok || len(values) != 2 {
+			return nil, NewGeneralError(fmt.Sprintf("invalid stream entry %v", entry))
+		}
+
+		mutation := &OutboxMutation{StreamID: fmt.Sprint(values[0])}
+		fields, _ := values[1].([]interface{})
+		for i := 0; i+1 < len(fields); i += 2 {
+			if fmt.Sprint(fields[i]) == "mutation" {
+				mutation.Mutation = fmt.Sprint(fields[i+1])
+			}
+		}
+		if mutation.Mutation == "" {
+			return nil, NewGeneralError(fmt.Sprintf("stream entry %s of %s has no mutation", mutation.StreamID, outbox))
+		}
+		mutations = append(mutations, mutation)
+	}
+
+	return mutations, nil
+}
+
+// RemoveMutations remove from outbox the mutations with streamIDs
+func (r *Redis) RemoveMutations(ctx context.Context, outbox string, streamIDs ...string) error {
+	if len(streamIDs) == 0 {
+		return nil
+	}
+
+	_, err := r.Client.Eval(ctx, removeMutationsScript, []string{outbox}, stringArgs(streamIDs)...)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
+// RemoveIdleMutationOutbox remove outbox from MutationOutboxesSet if it was not listed after idleSince,
+// returning true if removed. Writes list an outbox right after adding its first mutation, so an outbox read
+// empty and idle since much longer than a write takes is listed again by its next write
+func (r *Redis) RemoveIdleMutationOutbox(ctx context.Context, outbox string, idleSince time.Time) (bool, error) {
+	result, err := r.Client.Eval(
+		ctx, removeIdleMutationOutboxScript, []string{MutationOutboxesSet},
+		outbox, strconv.FormatInt(idleSince.Unix(), 10),
+	)
+	if err != nil {
+		return false, NewGeneralError(err.Error())
+	}
+
+	return result == int64(1), nil
+}
+
+// evalWrite eval the write script with keys and args, also adding the mutation of ctx, if any, to the
+// outbox of leaderboard, the leaderboard written, when the Lua condition written on its result holds. It is
+// added in the same script when keys are in the slot of the outbox, otherwise right after the write, like
+// writes to the expirations of a leaderboard without hash tag. Outboxes are listed in MutationOutboxesSet
+// only when writes add their first mutation, not on every write
+func (r *Redis) evalWrite(ctx context.Context, leaderboard, script, written string, keys []string, args ...interface{}) (interface{}, error) {
+	mutation, ok := ctx.Value(mutationContextKey{}).(string)
+	if !ok {
+		return r.Client.Eval(ctx, script, keys, args...)
+	}
+
+	outbox := MutationOutbox(leaderboard)
+	relistBefore := strconv.FormatInt(time.Now().Add(-mutationOutboxRelistAfter).UnixNano()/int64(time.Millisecond), 10)
+	for _, key := range keys {
+		if MutationOutbox(key) != outbox {
+			return r.evalWriteOutsideOutboxSlot(ctx, outbox, mutation, relistBefore, script, written, keys, args...)
+		}
+	}
+
+	result, err := r.Client.Eval(
+		ctx, fmt.Sprintf(mutationWriteScript, script, written),
+		append([]string{outbox}, keys...), append([]interface{}{mutation, relistBefore}, args...)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	list, result, err := splitWriteResult(result)
+	if err != nil {
+		return nil, err
+	}
+
+	r.listMutationOutbox(ctx, outbox, list)
+	return result, nil
+}
+
+// evalWriteOutsideOutboxSlot eval the write script with keys and args, adding mutation to outbox after it
+// when the condition written on its result holds
+func (r *Redis) evalWriteOutsideOutboxSlot(ctx context.Context, outbox, mutation, relistBefore, script, written string, keys []string, args ...interface{}) (interface{}, error) {
+	result, err := r.Client.Eval(ctx, fmt.Sprintf(outsideOutboxSlotWriteScript, script, written), keys, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	wrote, result, err := splitWriteResult(result)
+	if err != nil {
+		return nil, err
+	}
+	if wrote != int64(1) {
+		return result, nil
+	}
+
+	list, err := r.Client.Eval(ctx, addMutationScript, []string{outbox}, mutation, relistBefore)
+	if err != nil {
+		return nil, err
+	}
+
+	r.listMutationOutbox(ctx, outbox, list)
+	return result, nil
+}
+
+// splitWriteResult split the result of a wrapped write script into its flag and the result of the write,
+// which Redis drops from the reply when nil
+func splitWriteResult(result interface{}) (interface{}, interface{}, error) {
+	values, ok := result.([]interface{})
+	if !ok || len(values) == 0 {
+		return nil, nil, fmt.Errorf("unexpected mutation write result %v", result)
+	}
+
+	if len(values) == 1 {
+		return values[0], nil, nil
+	}
+	return values[0], values[1], nil
+}
+
+// listMutationOutbox add outbox to MutationOutboxesSet if list, the result of addMutation, tells it must be.
+// A failure is not returned, as the write was applied, and the outbox is listed by a later write once its
+// oldest mutation is older than mutationOutboxRelistAfter
+func (r *Redis) listMutationOutbox(ctx context.Context, outbox string, list interface{}) {
+	if list != int64(1) {
+		return
+	}
+
+	_ = r.Client.ZAdd(ctx, MutationOutboxesSet, &redis.Member{Member: outbox, Score: float64(time.Now().Unix())})
+}
+
+// writeCommand call the redis command on key with args in a script, so the mutation of ctx, if any, is
+// added to the outbox of leaderboard with it
+func (r *Redis) writeCommand(ctx context.Context, leaderboard, command, key string, args ...interface{}) error {
+	_, err := r.evalWrite(ctx, leaderboard, writeCommandScript, "true", []string{key}, append([]interface{}{command}, args...)...)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
+// hashArgs return the fields and values of hash as arguments of HSET, ordered by field
+func hashArgs(hash map[string]string) []interface{} {
+	fields := make([]string, 0, len(hash))
+	for field := range hash {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	args := make([]interface{}, 0, 2*len(hash))
+	for _, field := range fields {
+		args = append(args, field, hash[field])
+	}
+	return args
+}
+
+func stringArgs(values []string) []interface{} {
+	args := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		args = append(args, value)
+	}
+	return args
+}
@@ -0,0 +1,182 @@
+package database_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+var _ = Describe("Redis Mutation Database", func() {
+	var ctrl *gomock.Controller
+	var mock *redis.MockRedis
+	var redisDatabase *database.Redis
+	var leaderboard string = "leaderboardTest"
+	var outbox string = "{leaderboardTest}:mutations"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = redis.NewMockRedis(ctrl)
+
+		redisDatabase = &database.Redis{mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("MutationOutbox", func() {
+		It("Should name outbox by the hash tag of key", func() {
+			Expect(database.MutationOutbox("{ladder}:season1:versions")).To(Equal("{ladder}:mutations"))
+		})
+
+		It("Should name outbox by key if it has no hash tag", func() {
+			Expect(database.MutationOutbox(leaderboard)).To(Equal(outbox))
+		})
+	})
+
+	Describe("WithMutation", func() {
+		It("Should add mutation in the write script and list outbox if it is its first mutation", func() {
+			ctx := database.WithMutation(context.Background(), `{"op":"removeMembers"}`)
+			mock.EXPECT().Eval(
+				gomock.Any(), gomock.Any(), gomock.Eq([]string{outbox, leaderboard}),
+				gomock.Eq(`{"op":"removeMembers"}`), gomock.Any(), gomock.Eq("ZREM"), gomock.Eq("member1"),
+			).Return([]interface{}{int64(1), int64(1)}, nil)
+			mock.EXPECT().ZAdd(gomock.Any(), gomock.Eq(database.MutationOutboxesSet), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, key string, members ...*redis.Member) error {
+					Expect(members).To(HaveLen(1))
+					Expect(members[0].Member).To(Equal(outbox))
+					return nil
+				},
+			)
+
+			err := redisDatabase.RemoveMembers(ctx, leaderboard, "member1")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should not list outbox if it already has mutations", func() {
+			ctx := database.WithMutation(context.Background(), `{"op":"removeMembers"}`)
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]interface{}{int64(0), int64(1)}, nil)
+
+			err := redisDatabase.RemoveMembers(ctx, leaderboard, "member1")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should not fail a write applied if outbox could not be listed", func() {
+			ctx := database.WithMutation(context.Background(), `{"op":"removeMembers"}`)
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]interface{}{int64(1), int64(1)}, nil)
+			mock.EXPECT().ZAdd(gomock.Any(), gomock.Eq(database.MutationOutboxesSet), gomock.Any()).Return(fmt.Errorf("redis error"))
+
+			err := redisDatabase.RemoveMembers(ctx, leaderboard, "member1")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should add mutation to the outbox of leaderboard after writing keys outside its slot", func() {
+			ctx := database.WithMutation(context.Background(), `{"op":"setMembersTTL"}`)
+			gomock.InOrder(
+				mock.EXPECT().Eval(
+					gomock.Any(), gomock.Any(), gomock.Eq([]string{"leaderboardTest:ttl"}),
+					gomock.Eq("ZADD"), gomock.Eq("1600000000"), gomock.Eq("member1"),
+				).Return([]interface{}{int64(1), int64(1)}, nil),
+				mock.EXPECT().Eval(
+					gomock.Any(), gomock.Any(), gomock.Eq([]string{outbox}), gomock.Eq(`{"op":"setMembersTTL"}`), gomock.Any(),
+				).Return(int64(0), nil),
+				mock.EXPECT().SAdd(gomock.Any(), gomock.Eq(database.ExpirationSet), gomock.Eq("leaderboardTest:ttl")).Return(nil),
+			)
+
+			err := redisDatabase.SetMembersTTL(ctx, leaderboard, []*database.Member{{Member: "member1", TTL: time.Unix(1600000000, 0)}})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should not add mutation if a write outside the slot of the outbox did not write", func() {
+			ctx := database.WithMutation(context.Background(), `{"op":"setTournament"}`)
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"tournamentTest:tournament"}), gomock.Any()).
+				Return([]interface{}{int64(0), int64(0)}, nil)
+
+			created, err := redisDatabase.CreateTournament(ctx, "tournamentTest", &database.Tournament{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created).To(BeFalse())
+		})
+
+		It("Should not add mutation if a write outside the slot of the outbox fails", func() {
+			ctx := database.WithMutation(context.Background(), `{"op":"setMembersTTL"}`)
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"leaderboardTest:ttl"}), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, fmt.Errorf("redis error"))
+
+			err := redisDatabase.SetMembersTTL(ctx, leaderboard, []*database.Member{{Member: "member1", TTL: time.Unix(1600000000, 0)}})
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("GetMutationOutboxes", func() {
+		It("Should return listed outboxes", func() {
+			mock.EXPECT().ZRange(gomock.Any(), gomock.Eq(database.MutationOutboxesSet), gomock.Eq(int64(0)), gomock.Eq(int64(-1))).
+				Return([]*redis.Member{{Member: outbox, Score: 1600000000}}, nil)
+
+			outboxes, err := redisDatabase.GetMutationOutboxes(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(outboxes).To(Equal([]string{outbox}))
+		})
+	})
+
+	Describe("GetMutations", func() {
+		It("Should return mutations with their stream IDs", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{outbox}), gomock.Eq("10")).Return([]interface{}{
+				[]interface{}{"1-0", []interface{}{"mutation", "first"}},
+				[]interface{}{"2-0", []interface{}{"mutation", "second"}},
+			}, nil)
+
+			mutations, err := redisDatabase.GetMutations(context.Background(), outbox, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mutations).To(Equal([]*database.OutboxMutation{
+				{StreamID: "1-0", Mutation: "first"},
+				{StreamID: "2-0", Mutation: "second"},
+			}))
+		})
+
+		It("Should return GeneralError if entry has no mutation", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{outbox}), gomock.Eq("10")).Return([]interface{}{
+				[]interface{}{"1-0", []interface{}{}},
+			}, nil)
+
+			_, err := redisDatabase.GetMutations(context.Background(), outbox, 10)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("RemoveMutations", func() {
+		It("Should remove mutations by their stream IDs", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{outbox}), gomock.Eq("1-0"), gomock.Eq("2-0")).Return(int64(2), nil)
+
+			err := redisDatabase.RemoveMutations(context.Background(), outbox, "1-0", "2-0")
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("RemoveIdleMutationOutbox", func() {
+		It("Should return true if outbox was removed", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(), gomock.Any(), gomock.Eq([]string{database.MutationOutboxesSet}), gomock.Eq(outbox), gomock.Eq("1600000000"),
+			).Return(int64(1), nil)
+
+			removed, err := redisDatabase.RemoveIdleMutationOutbox(context.Background(), outbox, time.Unix(1600000000, 0))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(BeTrue())
+		})
+
+		It("Should return false if outbox was written after idleSince", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(0), nil)
+
+			removed, err := redisDatabase.RemoveIdleMutationOutbox(context.Background(), outbox, time.Unix(1600000000, 0))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(BeFalse())
+		})
+	})
+})
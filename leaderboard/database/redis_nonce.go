@@ -0,0 +1,33 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// addNonceScript sets KEYS[1] expiring in ARGV[1] milliseconds only if it does not exist. It returns 1 if set
+const addNonceScript = `
+if redis.call('SET', KEYS[1], 1, 'NX', 'PX', ARGV[1]) then
+	return 1
+end
+return 0
+`
+
+// AddNonce store nonce of a signed write to leaderboard for expiration. It returns false if nonce was already stored
+func (r *Redis) AddNonce(ctx context.Context, leaderboard, nonce string, expiration time.Duration) (bool, error) {
+	result, err := r.Client.Eval(
+		ctx, addNonceScript, []string{nonceKey(leaderboard, nonce)},
+		strconv.FormatInt(int64(expiration/time.Millisecond), 10),
+	)
+	if err != nil {
+		return false, NewGeneralError(err.Error())
+	}
+
+	return fmt.Sprint(result) == "1", nil
+}
+
+func nonceKey(leaderboard, nonce string) string {
+	return fmt.Sprintf("%s:nonces:%s", leaderboard, nonce)
+}
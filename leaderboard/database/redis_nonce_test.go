@@ -0,0 +1,57 @@
+package database_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+var _ = Describe("Redis Nonce Database", func() {
+	var ctrl *gomock.Controller
+	var mock *redis.MockRedis
+	var redisDatabase *database.Redis
+	var leaderboard string = "leaderboardTest"
+	var nonceKey string = "leaderboardTest:nonces:nonce1"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = redis.NewMockRedis(ctrl)
+
+		redisDatabase = &database.Redis{mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("AddNonce", func() {
+		It("Should return true if nonce was added", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{nonceKey}), gomock.Eq("60000")).Return(int64(1), nil)
+
+			added, err := redisDatabase.AddNonce(context.Background(), leaderboard, "nonce1", time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(added).To(BeTrue())
+		})
+
+		It("Should return false if nonce already exists", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{nonceKey}), gomock.Eq("60000")).Return(int64(0), nil)
+
+			added, err := redisDatabase.AddNonce(context.Background(), leaderboard, "nonce1", time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(added).To(BeFalse())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{nonceKey}), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.AddNonce(context.Background(), leaderboard, "nonce1", time.Minute)
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+})
@@ -0,0 +1,93 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// takeTokensScript takes a token from each token bucket of KEYS at unix milliseconds ARGV[1] only if all
+// of them have one, the rate and burst of each bucket follow in ARGV. Buckets are hashes of their tokens
+// and last update, expired when they would be full. It returns 0 if tokens were taken or milliseconds
+// until all buckets have a token
+const takeTokensScript = `
+local now = tonumber(ARGV[1])
+local wait = 0
+local tokens = {}
+for i, key in ipairs(KEYS) do
+	local rate = tonumber(ARGV[2 * i])
+	local burst = tonumber(ARGV[2 * i + 1])
+	local bucket = redis.call('HMGET', key, 'tokens', 'updatedAt')
+	local available = tonumber(bucket[1]) or burst
+	local updatedAt = tonumber(bucket[2]) or now
+	available = math.min(burst, available + math.max(0, now - updatedAt) * rate / 1000)
+	if available < 1 then
+		wait = math.max(wait, math.ceil((1 - available) * 1000 / rate))
+	end
+	tokens[i] = available
+end
+if wait > 0 then
+	return wait
+end
+for i, key in ipairs(KEYS) do
+	local rate = tonumber(ARGV[2 * i])
+	local burst = tonumber(ARGV[2 * i + 1])
+	redis.call('HSET', key, 'tokens', tokens[i] - 1, 'updatedAt', now)
+	redis.call('PEXPIRE', key, math.ceil(burst * 1000 / rate))
+end
+return 0
+`
+
+// TakeCallerToken take a token from the rate limit of caller. It returns zero if the token was taken
+// or how long until the rate limit has a token
+func (r *Redis) TakeCallerToken(ctx context.Context, caller string, limit *RateLimit) (time.Duration, error) {
+	return r.takeTokens(ctx, []string{fmt.Sprintf("ratelimit:callers:%s", caller)}, []*RateLimit{limit})
+}
+
+// TakeLeaderboardTokens take a token from the rate limit of leaderboard and from the rate limit of each of
+// members, only if all of them have one. Nil limits are not applied. It returns zero if the tokens were taken
+// or how long until all rate limits have a token. Rate limits of leaderboard are hash tagged like its other
+// keys, so they can be taken by one script on redis cluster
+func (r *Redis) TakeLeaderboardTokens(ctx context.Context, leaderboard string, leaderboardLimit *RateLimit, members []string, memberLimit *RateLimit) (time.Duration, error) {
+	keys := []string{}
+	limits := []*RateLimit{}
+
+	if leaderboardLimit != nil {
+		keys = append(keys, LeaderboardKey(leaderboard, "ratelimit"))
+		limits = append(limits, leaderboardLimit)
+	}
+
+	if memberLimit != nil {
+		for _, member := range members {
+			keys = append(keys, LeaderboardKey(leaderboard, fmt.Sprintf("ratelimit:members:%s", member)))
+			limits = append(limits, memberLimit)
+		}
+	}
+
+	if len(keys) == 0 {
+		return 0, nil
+	}
+
+	return r.takeTokens(ctx, keys, limits)
+}
+
+func (r *Redis) takeTokens(ctx context.Context, keys []string, limits []*RateLimit) (time.Duration, error) {
+	args := make([]interface{}, 0, 1+2*len(limits))
+	args = append(args, strconv.FormatInt(time.Now().UnixNano()/int64(time.Millisecond), 10))
+	for _, limit := range limits {
+		args = append(args, strconv.FormatFloat(limit.Rate, 'f', -1, 64), strconv.FormatInt(limit.Burst, 10))
+	}
+
+	result, err := r.Client.Eval(ctx, takeTokensScript, keys, args...)
+	if err != nil {
+		return 0, NewGeneralError(err.Error())
+	}
+
+	wait, err := strconv.ParseInt(fmt.Sprint(result), 10, 64)
+	if err != nil {
+		return 0, NewGeneralError(err.Error())
+	}
+
+	return time.Duration(wait) * time.Millisecond, nil
+}
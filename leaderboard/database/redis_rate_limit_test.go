@@ -0,0 +1,102 @@
+package database_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+var _ = Describe("Redis Rate Limit Database", func() {
+	var ctrl *gomock.Controller
+	var mock *redis.MockRedis
+	var redisDatabase *database.Redis
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = redis.NewMockRedis(ctrl)
+
+		redisDatabase = &database.Redis{mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("TakeLeaderboardTokens", func() {
+		It("Should take tokens of leaderboard and members", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{"{leaderboardTest}:ratelimit", "{leaderboardTest}:ratelimit:members:member1", "{leaderboardTest}:ratelimit:members:member2"}),
+				gomock.Any(),
+				gomock.Eq("100"),
+				gomock.Eq("200"),
+				gomock.Eq("0.5"),
+				gomock.Eq("5"),
+				gomock.Eq("0.5"),
+				gomock.Eq("5"),
+			).Return(int64(0), nil)
+
+			retryAfter, err := redisDatabase.TakeLeaderboardTokens(
+				context.Background(), leaderboard, &database.RateLimit{Rate: 100, Burst: 200},
+				[]string{"member1", "member2"}, &database.RateLimit{Rate: 0.5, Burst: 5},
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retryAfter).To(Equal(time.Duration(0)))
+		})
+
+		It("Should return how long until tokens are available", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{"{leaderboardTest}:ratelimit:members:member1"}),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+			).Return(int64(1500), nil)
+
+			retryAfter, err := redisDatabase.TakeLeaderboardTokens(
+				context.Background(), leaderboard, nil, []string{"member1"}, &database.RateLimit{Rate: 1, Burst: 5},
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retryAfter).To(Equal(1500 * time.Millisecond))
+		})
+
+		It("Should not call redis if no limit is set", func() {
+			retryAfter, err := redisDatabase.TakeLeaderboardTokens(context.Background(), leaderboard, nil, []string{"member1"}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retryAfter).To(Equal(time.Duration(0)))
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.TakeLeaderboardTokens(context.Background(), leaderboard, &database.RateLimit{Rate: 1, Burst: 1}, nil, nil)
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("TakeCallerToken", func() {
+		It("Should take token of caller", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{"ratelimit:callers:caller1"}),
+				gomock.Any(),
+				gomock.Eq("10"),
+				gomock.Eq("20"),
+			).Return(int64(0), nil)
+
+			retryAfter, err := redisDatabase.TakeCallerToken(context.Background(), "caller1", &database.RateLimit{Rate: 10, Burst: 20})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retryAfter).To(Equal(time.Duration(0)))
+		})
+	})
+})
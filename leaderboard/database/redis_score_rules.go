@@ -0,0 +1,183 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// RejectedScoresLimit is how many of the last rejected scores are kept for review in each leaderboard
+const RejectedScoresLimit = 1000
+
+// addIncreaseFunction defines addIncrease, used by write scripts to add increase of member to its total
+// increase in hash increases, expiring it at unix milliseconds expireAt. Nothing is added when increases
+// is nil or increase is "0"
+const addIncreaseFunction = `
+local function addIncrease(increases, member, increase, expireAt)
+	if increases and increase ~= '0' then
+		redis.call('HINCRBY', increases, member, increase)
+		redis.call('PEXPIREAT', increases, expireAt)
+	end
+end
+`
+
+// scoreChangedFunction defines scoreChanged, used by write scripts to check, before writing anything, that
+// member of leaderboard still has the stored score expected, '-' if it did not exist and empty to not
+// check it, and that increase does not take its total in hash increases over maxIncrease, '0' for no cap
+const scoreChangedFunction = `
+local function scoreChanged(leaderboard, increases, member, expected, increase, maxIncrease)
+	if expected ~= '' then
+		local score = redis.call('ZSCORE', leaderboard, member)
+		if expected == '-' then
+			if score then
+				return true
+			end
+		elseif not score or tonumber(score) ~= tonumber(expected) then
+			return true
+		end
+	end
+	if increases and maxIncrease ~= '0' and increase ~= '0' then
+		return tonumber(redis.call('HGET', increases, member) or '0') + tonumber(increase) > tonumber(maxIncrease)
+	end
+	return false
+end
+`
+
+// getMembersIncreasesScript return the total increases of members ARGV in KEYS[1], 0 for members without one
+const getMembersIncreasesScript = `
+local increases = redis.call('HMGET', KEYS[1], unpack(ARGV))
+for i = 1, #increases do
+	increases[i] = increases[i] or '0'
+end
+return increases
+`
+
+// addCappedScript adds ARGV[2] with score ARGV[1] to KEYS[1] keeping only the last ARGV[3] members
+const addCappedScript = `
+redis.call('ZADD', KEYS[1], ARGV[1], ARGV[2])
+redis.call('ZREMRANGEBYRANK', KEYS[1], 0, -tonumber(ARGV[3]) - 1)
+return 1
+`
+
+// GetMembersIncreases return the total increases of members in the current window of leaderboard, in
+// the same order, added by the writes of members with an Increase
+func (r *Redis) GetMembersIncreases(ctx context.Context, leaderboard string, window time.Duration, members ...string) ([]int64, error) {
+	key, _ := increasesKey(leaderboard, window)
+	args := make([]interface{}, 0, len(members))
+	for _, member := range members {
+		args = append(args, member)
+	}
+
+	result, err := r.Client.Eval(ctx, getMembersIncreasesScript, []string{key}, args...)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	values, ok := result.([]interface{})
+	if !ok || len(values) != len(members) {
+		return nil, NewGeneralError(fmt.Sprintf("unexpected members increases result %v", result))
+	}
+
+	increases := make([]int64, 0, len(values))
+	for _, value := range values {
+		increase, err := strconv.ParseInt(fmt.Sprint(value), 10, 64)
+		if err != nil {
+			return nil, NewGeneralError(err.Error())
+		}
+		increases = append(increases, increase)
+	}
+
+	return increases, nil
+}
+
+// AddRejectedScore add rejectedScore to the review queue of leaderboard, keeping the last RejectedScoresLimit ones
+func (r *Redis) AddRejectedScore(ctx context.Context, leaderboard string, rejectedScore *RejectedScore) error {
+	value, err := json.Marshal(rejectedScore)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	_, err = r.Client.Eval(
+		ctx, addCappedScript, []string{rejectedScoresKey(leaderboard)},
+		strconv.FormatInt(rejectedScore.RejectedAt.Unix(), 10), string(value), strconv.Itoa(RejectedScoresLimit),
+	)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
+// GetRejectedScores return rejected scores of leaderboard from start to stop, the last rejected first
+func (r *Redis) GetRejectedScores(ctx context.Context, leaderboard string, start, stop int) ([]*RejectedScore, error) {
+	members, err := r.Client.ZRevRange(ctx, rejectedScoresKey(leaderboard), int64(start), int64(stop))
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	rejectedScores := make([]*RejectedScore, 0, len(members))
+	for _, member := range members {
+		rejectedScore := &RejectedScore{}
+		err = json.Unmarshal([]byte(member.Member), rejectedScore)
+		if err != nil {
+			return nil, NewGeneralError(err.Error())
+		}
+		rejectedScores = append(rejectedScores, rejectedScore)
+	}
+
+	return rejectedScores, nil
+}
+
+// increasesKey return the key of the total increases of members of leaderboard in the current window
+// and when it expires in unix milliseconds. Windows are aligned to the unix epoch, so a window of one
+// hour starts at every full hour
+func increasesKey(leaderboard string, window time.Duration) (string, int64) {
+	windowStart := time.Now().Truncate(window)
+	key := LeaderboardKey(leaderboard, fmt.Sprintf("increases:%d", windowStart.Unix()))
+	return key, windowStart.Add(window).UnixNano() / int64(time.Millisecond)
+}
+
+// withIncreasesKey return keys followed by the key of the total increases of leaderboard if a member
+// of databaseMembers has an Increase, and when it expires to be passed to addIncrease
+func withIncreasesKey(leaderboard string, keys []string, databaseMembers ...*Member) ([]string, string) {
+	for _, member := range databaseMembers {
+		if member.Increase != nil {
+			key, expireAt := increasesKey(leaderboard, member.Increase.Window)
+			return append(keys, key), strconv.FormatInt(expireAt, 10)
+		}
+	}
+
+	return keys, ""
+}
+
+func formatIncrease(member *Member) string {
+	if member.Increase == nil {
+		return "0"
+	}
+
+	return strconv.FormatInt(member.Increase.Value, 10)
+}
+
+// formatGuard return the expected score and the cap of the total increase of member passed to scoreChanged
+func formatGuard(member *Member) (string, string) {
+	expected := ""
+	if member.Guard != nil {
+		expected = "-"
+		if member.Guard.Score != nil {
+			expected = formatScore(*member.Guard.Score)
+		}
+	}
+
+	maxIncrease := "0"
+	if member.Increase != nil {
+		maxIncrease = strconv.FormatInt(member.Increase.Max, 10)
+	}
+
+	return expected, maxIncrease
+}
+
+func rejectedScoresKey(leaderboard string) string {
+	return fmt.Sprintf("%s:rejected", leaderboard)
+}
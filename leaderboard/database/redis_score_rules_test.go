@@ -0,0 +1,124 @@
+package database_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+var _ = Describe("Redis Score Rules Database", func() {
+	var ctrl *gomock.Controller
+	var mock *redis.MockRedis
+	var redisDatabase *database.Redis
+	var leaderboard string = "leaderboardTest"
+	var rejectedKey string = "leaderboardTest:rejected"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = redis.NewMockRedis(ctrl)
+
+		redisDatabase = &database.Redis{mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("GetMembersIncreases", func() {
+		It("Should return members total increases in the current window", func() {
+			windowStart := time.Now().Truncate(time.Hour)
+			increasesKey := fmt.Sprintf("{leaderboardTest}:increases:%d", windowStart.Unix())
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{increasesKey}),
+				gomock.Eq("member1"),
+				gomock.Eq("member2"),
+			).Return([]interface{}{"100", "0"}, nil)
+
+			increases, err := redisDatabase.GetMembersIncreases(context.Background(), leaderboard, time.Hour, "member1", "member2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(increases).To(Equal([]int64{100, 0}))
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+			).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.GetMembersIncreases(context.Background(), leaderboard, time.Hour, "member1")
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("AddRejectedScore", func() {
+		rejectedScore := &database.RejectedScore{
+			Member:        "member1",
+			Score:         500,
+			PreviousScore: 100,
+			Rule:          "maxIncrement",
+			RejectedAt:    time.Unix(1600000000, 0),
+		}
+
+		It("Should return nil if all is OK", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{rejectedKey}),
+				gomock.Eq("1600000000"),
+				gomock.Any(),
+				gomock.Eq(fmt.Sprint(database.RejectedScoresLimit)),
+			).Return(int64(1), nil)
+
+			err := redisDatabase.AddRejectedScore(context.Background(), leaderboard, rejectedScore)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{rejectedKey}),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+			).Return(nil, fmt.Errorf("redis error"))
+
+			err := redisDatabase.AddRejectedScore(context.Background(), leaderboard, rejectedScore)
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("GetRejectedScores", func() {
+		It("Should return rejected scores if all is OK", func() {
+			mock.EXPECT().ZRevRange(gomock.Any(), gomock.Eq(rejectedKey), gomock.Eq(int64(0)), gomock.Eq(int64(9))).Return([]*redis.Member{
+				{Member: `{"member":"member1","score":500,"previousScore":100,"rule":"maxIncrement","rejectedAt":"2020-09-13T12:26:40Z"}`, Score: 1600000000},
+			}, nil)
+
+			rejectedScores, err := redisDatabase.GetRejectedScores(context.Background(), leaderboard, 0, 9)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rejectedScores).To(HaveLen(1))
+			Expect(rejectedScores[0].Member).To(Equal("member1"))
+			Expect(rejectedScores[0].Score).To(Equal(int64(500)))
+			Expect(rejectedScores[0].PreviousScore).To(Equal(int64(100)))
+			Expect(rejectedScores[0].Rule).To(Equal("maxIncrement"))
+			Expect(rejectedScores[0].RejectedAt.Unix()).To(Equal(int64(1600000000)))
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().ZRevRange(gomock.Any(), gomock.Eq(rejectedKey), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.GetRejectedScores(context.Background(), leaderboard, 0, 9)
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+})
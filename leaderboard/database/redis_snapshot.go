@@ -0,0 +1,129 @@
+package database
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+var _ Snapshot = &Redis{}
+
+// SnapshotSet is used to list leaderboards with rank snapshots that worker will take
+const SnapshotSet string = "snapshot-sets"
+
+// addRankSnapshotScript copies the scores of KEYS[1] multiplied by ARGV[3] into snapshot KEYS[3] and adds
+// it to the snapshots index KEYS[2] taken at unix milliseconds ARGV[1]. Snapshots KEYS[4..] taken at unix
+// milliseconds ARGV[4..] are removed and the new one expires at unix milliseconds ARGV[2] unless it is empty
+const addRankSnapshotScript = `
+for i = 4, #KEYS do
+	redis.call('DEL', KEYS[i])
+	redis.call('ZREM', KEYS[2], ARGV[i])
+end
+redis.call('ZUNIONSTORE', KEYS[3], 1, KEYS[1], 'WEIGHTS', ARGV[3])
+redis.call('ZADD', KEYS[2], ARGV[1], ARGV[1])
+if ARGV[2] ~= '' then
+	redis.call('PEXPIREAT', KEYS[2], ARGV[2])
+	redis.call('PEXPIREAT', KEYS[3], ARGV[2])
+end
+return 1
+`
+
+// AddLeaderboardToSnapshotList add leaderboard to the list of leaderboards with rank snapshots
+func (r *Redis) AddLeaderboardToSnapshotList(ctx context.Context, leaderboard string) error {
+	return r.writeCommand(ctx, leaderboard, "SADD", SnapshotSet, leaderboard)
+}
+
+// GetSnapshotLeaderboards return leaderboards registered with rank snapshots
+func (r *Redis) GetSnapshotLeaderboards(ctx context.Context) ([]string, error) {
+	leaderboards, err := r.Client.SMembers(ctx, SnapshotSet)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	return leaderboards, nil
+}
+
+// RemoveLeaderboardFromSnapshotList remove leaderboard from the list of leaderboards with rank snapshots
+func (r *Redis) RemoveLeaderboardFromSnapshotList(ctx context.Context, leaderboard string) error {
+	err := r.Client.SRem(ctx, SnapshotSet, leaderboard)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
+// AddRankSnapshot copy the current scores of leaderboard multiplied by weight into a snapshot taken at takenAt, removing
+// snapshots taken before removeBefore unless it is zero. Snapshots expire at expireAt unless it is zero
+func (r *Redis) AddRankSnapshot(ctx context.Context, leaderboard string, weight float64, takenAt, removeBefore, expireAt time.Time) error {
+	expireAtArg := ""
+	if !expireAt.IsZero() {
+		expireAtArg = formatSnapshotTime(expireAt)
+	}
+
+	keys := []string{leaderboard, snapshotsKey(leaderboard), snapshotKey(leaderboard, formatSnapshotTime(takenAt))}
+	args := []interface{}{formatSnapshotTime(takenAt), expireAtArg, strconv.FormatFloat(weight, 'g', -1, 64)}
+	if !removeBefore.IsZero() {
+		expired, err := r.Client.ZRangeByScore(ctx, snapshotsKey(leaderboard), "-inf", "("+formatSnapshotTime(removeBefore), 0, -1)
+		if err != nil {
+			return NewGeneralError(err.Error())
+		}
+
+		for _, expiredAt := range expired {
+			keys = append(keys, snapshotKey(leaderboard, expiredAt))
+			args = append(args, expiredAt)
+		}
+	}
+
+	_, err := r.Client.Eval(ctx, addRankSnapshotScript, keys, args...)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return nil
+}
+
+// GetRankSnapshotTime return when the newest snapshot of leaderboard taken until at was taken,
+// or zero time if there is none
+func (r *Redis) GetRankSnapshotTime(ctx context.Context, leaderboard string, at time.Time) (time.Time, error) {
+	values, err := r.Client.ZRevRangeByScore(ctx, snapshotsKey(leaderboard), "-inf", formatSnapshotTime(at), 0, 1)
+	if err != nil {
+		return time.Time{}, NewGeneralError(err.Error())
+	}
+
+	if len(values) == 0 {
+		return time.Time{}, nil
+	}
+
+	takenAt, err := strconv.ParseInt(values[0], 10, 64)
+	if err != nil {
+		return time.Time{}, NewGeneralError(err.Error())
+	}
+
+	return time.Unix(0, takenAt*int64(time.Millisecond)), nil
+}
+
+// GetRankSnapshotMembers return the members of the snapshot of leaderboard taken at takenAt from rank start to
+// stop in the order requested, with their ranks when it was taken
+func (r *Redis) GetRankSnapshotMembers(ctx context.Context, leaderboard string, takenAt time.Time, start, stop int, order string) ([]*Member, error) {
+	return r.GetOrderedMembers(ctx, snapshotKey(leaderboard, formatSnapshotTime(takenAt)), start, stop, order)
+}
+
+// GetRankSnapshotMembersByID return members of the snapshot of leaderboard taken at takenAt with their ranks
+// in the order requested when it was taken, or nil for members that were not in it
+func (r *Redis) GetRankSnapshotMembersByID(ctx context.Context, leaderboard string, takenAt time.Time, order string, members ...string) ([]*Member, error) {
+	return r.getMembersByID(ctx, snapshotKey(leaderboard, formatSnapshotTime(takenAt)), order, members...)
+}
+
+func snapshotsKey(leaderboard string) string {
+	return LeaderboardKey(leaderboard, "snapshots")
+}
+
+// snapshotKey return the key of the snapshot of leaderboard taken at takenAt, formatted by formatSnapshotTime
+func snapshotKey(leaderboard, takenAt string) string {
+	return LeaderboardKey(leaderboard, "snapshot:"+takenAt)
+}
+
+func formatSnapshotTime(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10)
+}
@@ -0,0 +1,197 @@
+package database_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+var _ = Describe("Redis Snapshot Database", func() {
+	var ctrl *gomock.Controller
+	var mock *redis.MockRedis
+	var redisDatabase *database.Redis
+	var leaderboard string = "leaderboardTest"
+	var takenAt time.Time = time.Unix(1600000000, 0)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = redis.NewMockRedis(ctrl)
+
+		redisDatabase = &database.Redis{mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("AddLeaderboardToSnapshotList", func() {
+		It("Should return nil if all is OK", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{database.SnapshotSet}), gomock.Eq("SADD"), gomock.Eq(leaderboard)).Return(int64(1), nil)
+
+			err := redisDatabase.AddLeaderboardToSnapshotList(context.Background(), leaderboard)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{database.SnapshotSet}), gomock.Eq("SADD"), gomock.Eq(leaderboard)).Return(nil, fmt.Errorf("redis error"))
+
+			err := redisDatabase.AddLeaderboardToSnapshotList(context.Background(), leaderboard)
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("GetSnapshotLeaderboards", func() {
+		It("Should return leaderboards with rank snapshots if all is OK", func() {
+			mock.EXPECT().SMembers(gomock.Any(), gomock.Eq(database.SnapshotSet)).Return([]string{leaderboard}, nil)
+
+			leaderboards, err := redisDatabase.GetSnapshotLeaderboards(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(leaderboards).To(Equal([]string{leaderboard}))
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().SMembers(gomock.Any(), gomock.Eq(database.SnapshotSet)).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.GetSnapshotLeaderboards(context.Background())
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("RemoveLeaderboardFromSnapshotList", func() {
+		It("Should return nil if all is OK", func() {
+			mock.EXPECT().SRem(gomock.Any(), gomock.Eq(database.SnapshotSet), gomock.Eq(leaderboard)).Return(nil)
+
+			err := redisDatabase.RemoveLeaderboardFromSnapshotList(context.Background(), leaderboard)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().SRem(gomock.Any(), gomock.Eq(database.SnapshotSet), gomock.Eq(leaderboard)).Return(fmt.Errorf("redis error"))
+
+			err := redisDatabase.RemoveLeaderboardFromSnapshotList(context.Background(), leaderboard)
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("AddRankSnapshot", func() {
+		It("Should copy leaderboard scores multiplied by weight into snapshot removing old ones", func() {
+			mock.EXPECT().ZRangeByScore(
+				gomock.Any(), gomock.Eq("{leaderboardTest}:snapshots"), gomock.Eq("-inf"), gomock.Eq("(1599913600000"), gomock.Eq(int64(0)), gomock.Eq(int64(-1)),
+			).Return([]string{"1599827200000"}, nil)
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{
+					leaderboard, "{leaderboardTest}:snapshots", "{leaderboardTest}:snapshot:1600000000000", "{leaderboardTest}:snapshot:1599827200000",
+				}),
+				gomock.Eq("1600000000000"),
+				gomock.Eq("1700000000000"),
+				gomock.Eq("0.5"),
+				gomock.Eq("1599827200000"),
+			).Return(int64(1), nil)
+
+			err := redisDatabase.AddRankSnapshot(context.Background(), leaderboard, 0.5, takenAt, takenAt.Add(-24*time.Hour), time.Unix(1700000000, 0))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should not remove or expire snapshots if times are zero", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{leaderboard, "{leaderboardTest}:snapshots", "{leaderboardTest}:snapshot:1600000000000"}),
+				gomock.Eq("1600000000000"),
+				gomock.Eq(""),
+				gomock.Eq("1"),
+			).Return(int64(1), nil)
+
+			err := redisDatabase.AddRankSnapshot(context.Background(), leaderboard, 1, takenAt, time.Time{}, time.Time{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			err := redisDatabase.AddRankSnapshot(context.Background(), leaderboard, 1, takenAt, time.Time{}, time.Time{})
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("GetRankSnapshotTime", func() {
+		It("Should return when newest snapshot until time was taken", func() {
+			mock.EXPECT().ZRevRangeByScore(
+				gomock.Any(), gomock.Eq("{leaderboardTest}:snapshots"), gomock.Eq("-inf"), gomock.Eq("1600003600000"), gomock.Eq(int64(0)), gomock.Eq(int64(1)),
+			).Return([]string{"1600000000000"}, nil)
+
+			snapshotTime, err := redisDatabase.GetRankSnapshotTime(context.Background(), leaderboard, time.Unix(1600003600, 0))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshotTime.Equal(takenAt)).To(BeTrue())
+		})
+
+		It("Should return zero time if no snapshot was taken until time", func() {
+			mock.EXPECT().ZRevRangeByScore(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{}, nil)
+
+			snapshotTime, err := redisDatabase.GetRankSnapshotTime(context.Background(), leaderboard, takenAt)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshotTime.IsZero()).To(BeTrue())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().ZRevRangeByScore(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.GetRankSnapshotTime(context.Background(), leaderboard, takenAt)
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("GetRankSnapshotMembers", func() {
+		It("Should return snapshot members with their ranks", func() {
+			mock.EXPECT().ZRevRange(gomock.Any(), gomock.Eq("{leaderboardTest}:snapshot:1600000000000"), gomock.Eq(int64(0)), gomock.Eq(int64(1))).Return([]*redis.Member{
+				{Member: "member1", Score: 20},
+				{Member: "member2", Score: 10},
+			}, nil)
+
+			members, err := redisDatabase.GetRankSnapshotMembers(context.Background(), leaderboard, takenAt, 0, 1, "desc")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(members).To(Equal([]*database.Member{
+				{Member: "member1", Score: 20, Rank: 0},
+				{Member: "member2", Score: 10, Rank: 1},
+			}))
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().ZRange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.GetRankSnapshotMembers(context.Background(), leaderboard, takenAt, 0, 1, "asc")
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("GetRankSnapshotMembersByID", func() {
+		It("Should return members of snapshot with their ranks", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{"{leaderboardTest}:snapshot:1600000000000"}),
+				gomock.Eq("desc"), gomock.Eq("member1"), gomock.Eq("member2"),
+			).Return([]interface{}{"20", int64(3), nil, nil}, nil)
+
+			members, err := redisDatabase.GetRankSnapshotMembersByID(context.Background(), leaderboard, takenAt, "desc", "member1", "member2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(members).To(Equal([]*database.Member{{Member: "member1", Score: 20, Rank: 3}, nil}))
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.GetRankSnapshotMembersByID(context.Background(), leaderboard, takenAt, "asc", "member1")
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+})
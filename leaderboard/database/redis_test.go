@@ -62,6 +62,24 @@ var _ = Describe("Redis Database", func() {
 		})
 	})
 
+	Describe("GetLeaderboardSettings", func() {
+		It("Should return leaderboard settings if all is OK", func() {
+			mock.EXPECT().HGetAll(gomock.Any(), gomock.Eq("{leaderboardTest}:settings")).Return(map[string]string{"decayHalfLife": "3600"}, nil)
+
+			settings, err := redisDatabase.GetLeaderboardSettings(context.Background(), leaderboard)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(settings).To(Equal(map[string]string{"decayHalfLife": "3600"}))
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().HGetAll(gomock.Any(), gomock.Eq("{leaderboardTest}:settings")).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.GetLeaderboardSettings(context.Background(), leaderboard)
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
 	Describe("GetMembers", func() {
 		var members = []string{"member1", "member2"}
 		Describe("When order is asc", func() {
@@ -401,6 +419,28 @@ var _ = Describe("Redis Database", func() {
 		})
 	})
 
+	Describe("GetMembersByID", func() {
+		It("Should return members with their scores and ranks in a single call", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{leaderboard}),
+				gomock.Eq("asc"), gomock.Eq("member1"), gomock.Eq("member2"),
+			).Return([]interface{}{nil, nil, "1.5", int64(0)}, nil)
+
+			members, err := redisDatabase.GetMembersByID(context.Background(), leaderboard, "asc", "member1", "member2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(members).To(Equal([]*database.Member{nil, {Member: "member2", Score: 1.5, Rank: 0}}))
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.GetMembersByID(context.Background(), leaderboard, "desc", "member1")
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
 	Describe("GetMemberIDsWithScoreInsideRange", func() {
 		var min string = "-inf"
 		var max string = "10"
@@ -586,6 +626,49 @@ var _ = Describe("Redis Database", func() {
 		})
 	})
 
+	Describe("GetResetProgress", func() {
+		leaderboardReset := "leaderboardTest:reset"
+		startedAt := time.Unix(time.Now().Unix(), 0)
+
+		It("Should return reset progress if redis return OK", func() {
+			mock.EXPECT().HGetAll(gomock.Any(), gomock.Eq(leaderboardReset)).Return(map[string]string{
+				"target":     "leaderboardTest2",
+				"strategy":   "compress",
+				"status":     "running",
+				"processed":  "10",
+				"total":      "20",
+				"startedAt":  fmt.Sprint(startedAt.Unix()),
+				"finishedAt": "0",
+			}, nil)
+
+			progress, err := redisDatabase.GetResetProgress(context.Background(), leaderboard)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(progress).To(Equal(&database.ResetProgress{
+				Target:    "leaderboardTest2",
+				Strategy:  "compress",
+				Status:    "running",
+				Processed: 10,
+				Total:     20,
+				StartedAt: startedAt,
+			}))
+		})
+
+		It("Should return ResetProgressNotFoundError if redis return empty hash", func() {
+			mock.EXPECT().HGetAll(gomock.Any(), gomock.Eq(leaderboardReset)).Return(map[string]string{}, nil)
+
+			_, err := redisDatabase.GetResetProgress(context.Background(), leaderboard)
+			Expect(err).To(Equal(database.NewResetProgressNotFoundError(leaderboard)))
+		})
+
+		It("Should return error if redis returns in error", func() {
+			mock.EXPECT().HGetAll(gomock.Any(), gomock.Eq(leaderboardReset)).Return(nil, fmt.Errorf("New redis error"))
+
+			_, err := redisDatabase.GetResetProgress(context.Background(), leaderboard)
+			Expect(err).To(Equal(database.NewGeneralError("New redis error")))
+		})
+	})
+
 	Describe("GetTotalMembers", func() {
 		var countMembers int = 10
 
@@ -631,16 +714,85 @@ var _ = Describe("Redis Database", func() {
 		})
 	})
 
+	Describe("ImportMembers", func() {
+		It("Should set batches into target in a pipeline incrementing members versions of leaderboard", func() {
+			mock.EXPECT().EvalPipelined(gomock.Any(), gomock.Any(),
+				gomock.Eq([]string{"{leaderboardTest}:import:tmp", "{leaderboardTest}:versions", leaderboard}),
+				gomock.Eq([]interface{}{"1", member}),
+				gomock.Eq([]interface{}{"2", "member2"}),
+			).Return([]interface{}{[]interface{}{"5"}, nil}, []error{nil, redis.NewGeneralError("New redis error")})
+
+			batch := []*database.Member{{Member: member, Score: 1}}
+			errs := redisDatabase.ImportMembers(context.Background(), leaderboard, "{leaderboardTest}:import:tmp",
+				batch,
+				[]*database.Member{{Member: "member2", Score: 2}},
+			)
+			Expect(errs).To(Equal([]error{nil, database.NewGeneralError(redis.NewGeneralError("New redis error").Error())}))
+			Expect(*batch[0].PreviousScore).To(Equal(float64(5)))
+		})
+	})
+
+	Describe("IncrementMemberScore", func() {
+		It("Should fill member with its new version and score and its previous score", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{leaderboard, "{leaderboardTest}:versions"}),
+				gomock.Eq("10"),
+				gomock.Eq(member),
+				gomock.Eq("0"),
+				gomock.Eq(""),
+				gomock.Eq(""),
+				gomock.Eq("0"),
+			).Return([]interface{}{int64(3), "25", "15"}, nil)
+
+			databaseMember := &database.Member{Member: member}
+			err := redisDatabase.IncrementMemberScore(context.Background(), leaderboard, databaseMember, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(databaseMember.Version).To(Equal(int64(3)))
+			Expect(databaseMember.Score).To(Equal(float64(25)))
+			Expect(*databaseMember.PreviousScore).To(Equal(float64(15)))
+		})
+
+		It("Should not fill previous score of member that did not exist", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]interface{}{int64(1), "10", ""}, nil)
+
+			databaseMember := &database.Member{Member: member}
+			err := redisDatabase.IncrementMemberScore(context.Background(), leaderboard, databaseMember, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(databaseMember.PreviousScore).To(BeNil())
+		})
+
+		It("Should return GeneralError if redis return an unexpected result", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(1), nil)
+
+			err := redisDatabase.IncrementMemberScore(context.Background(), leaderboard, &database.Member{Member: member}, 10)
+			Expect(err).To(Equal(database.NewGeneralError("unexpected increment result 1")))
+		})
+
+		It("Should return ScoreChangedError if the guarded score of member changed", func() {
+			previousScore := float64(15)
+			mock.EXPECT().Eval(
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq("15"), gomock.Eq("0"),
+			).Return([]interface{}{int64(-1)}, nil)
+
+			databaseMember := &database.Member{Member: member, Guard: &database.ScoreGuard{Score: &previousScore}}
+			err := redisDatabase.IncrementMemberScore(context.Background(), leaderboard, databaseMember, 10)
+			Expect(err).To(Equal(database.NewScoreChangedError(leaderboard)))
+		})
+	})
+
 	Describe("RemoveMembers", func() {
 		It("Should return nil if no error occur", func() {
-			mock.EXPECT().ZRem(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq("member2")).Return(nil)
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{leaderboard}), gomock.Eq("ZREM"), gomock.Eq(member), gomock.Eq("member2")).Return(int64(2), nil)
 
 			err := redisDatabase.RemoveMembers(context.Background(), leaderboard, member, "member2")
 			Expect(err).NotTo(HaveOccurred())
 		})
 
 		It("Should return error if an error happened", func() {
-			mock.EXPECT().ZRem(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq("member2")).Return(redis.NewGeneralError("New redis error"))
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{leaderboard}), gomock.Eq("ZREM"), gomock.Eq(member), gomock.Eq("member2")).Return(nil, redis.NewGeneralError("New redis error"))
 
 			err := redisDatabase.RemoveMembers(context.Background(), leaderboard, member, "member2")
 			Expect(err).To(Equal(database.NewGeneralError(redis.NewGeneralError("New redis error").Error())))
@@ -649,69 +801,219 @@ var _ = Describe("Redis Database", func() {
 
 	Describe("RemoveLeaderboard", func() {
 		It("Should return nil if no error happended", func() {
-			mock.EXPECT().Del(gomock.Any(), gomock.Eq(leaderboard)).Return(nil)
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{
+				leaderboard, "{leaderboardTest}:versions", "{leaderboardTest}:shadow",
+				"{leaderboardTest}:shadow:versions", "{leaderboardTest}:blocked:scores",
+			})).Return(int64(5), nil)
+			mock.EXPECT().Del(gomock.Any(), gomock.Eq("leaderboardTest:created")).Return(nil)
+			mock.EXPECT().ZRem(gomock.Any(), gomock.Eq(database.ExpiringLeaderboardsSet), gomock.Eq(leaderboard)).Return(nil)
 
 			err := redisDatabase.RemoveLeaderboard(context.Background(), leaderboard)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
 		It("Should return error if an error happened", func() {
-			mock.EXPECT().Del(gomock.Any(), gomock.Eq(leaderboard)).Return(redis.NewGeneralError("New redis error"))
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, redis.NewGeneralError("New redis error"))
 
 			err := redisDatabase.RemoveLeaderboard(context.Background(), leaderboard)
 			Expect(err).To(Equal(database.NewGeneralError(redis.NewGeneralError("New redis error").Error())))
 		})
 	})
 
+	Describe("RenameLeaderboard", func() {
+		newLeaderboard := "leaderboardTest2"
+
+		It("Should return nil if no error happended", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{leaderboard, newLeaderboard})).Return(int64(1), nil)
+
+			err := redisDatabase.RenameLeaderboard(context.Background(), leaderboard, newLeaderboard)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return error if leaderboard does not exist", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{leaderboard, newLeaderboard})).Return(int64(0), nil)
+
+			err := redisDatabase.RenameLeaderboard(context.Background(), leaderboard, newLeaderboard)
+			Expect(err).To(Equal(database.NewGeneralError(redis.NewKeyNotFoundError(leaderboard).Error())))
+		})
+
+		It("Should return error if an error happened", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{leaderboard, newLeaderboard})).Return(nil, fmt.Errorf("New redis error"))
+
+			err := redisDatabase.RenameLeaderboard(context.Background(), leaderboard, newLeaderboard)
+			Expect(err).To(Equal(database.NewGeneralError("New redis error")))
+		})
+	})
+
+	Describe("ScanLeaderboards", func() {
+		It("Should call fn with leaderboards found by their members versions", func() {
+			mock.EXPECT().Scan(gomock.Any(), gomock.Eq("*:versions"), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, match string, count int64, fn func(keys []string) error) error {
+					return fn([]string{"{leaderboard1}:versions", "{leaderboard1}:shadow:versions", "{ladder}:season1:versions", "leaderboard2:versions"})
+				},
+			)
+
+			var leaderboards []string
+			err := redisDatabase.ScanLeaderboards(context.Background(), func(page []string) error {
+				leaderboards = append(leaderboards, page...)
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaderboards).To(Equal([]string{"leaderboard1", "{ladder}:season1", "leaderboard2"}))
+		})
+
+		It("Should return GeneralError if fn return in error", func() {
+			mock.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, match string, count int64, fn func(keys []string) error) error {
+					return fn([]string{"{leaderboard1}:versions"})
+				},
+			)
+
+			err := redisDatabase.ScanLeaderboards(context.Background(), func(page []string) error {
+				return fmt.Errorf("fn error")
+			})
+			Expect(err).To(Equal(database.NewGeneralError("fn error")))
+		})
+	})
+
 	Describe("SetLeaderboardExpiration", func() {
 		It("Should return nil if all is ok", func() {
 			expireTime := time.Unix(123456, 0)
-			mock.EXPECT().ExpireAt(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(expireTime)).Return(nil)
+			mock.EXPECT().Eval(
+				gomock.Any(), gomock.Any(), gomock.Eq([]string{leaderboard, "{leaderboardTest}:versions"}), gomock.Eq("123456"),
+			).Return(int64(1), nil)
 
 			err := redisDatabase.SetLeaderboardExpiration(context.Background(), leaderboard, expireTime)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("Should return GeneralError if leaderboard does not exist", func() {
+			expireTime := time.Unix(123456, 0)
+			mock.EXPECT().Eval(
+				gomock.Any(), gomock.Any(), gomock.Eq([]string{leaderboard, "{leaderboardTest}:versions"}), gomock.Eq("123456"),
+			).Return(int64(0), nil)
+
+			err := redisDatabase.SetLeaderboardExpiration(context.Background(), leaderboard, expireTime)
+			Expect(err).To(Equal(database.NewGeneralError(redis.NewKeyNotFoundError(leaderboard).Error())))
+		})
+
 		It("Should return GeneralError if redis return in error", func() {
 			expireTime := time.Unix(123456, 0)
-			mock.EXPECT().ExpireAt(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(expireTime)).Return(fmt.Errorf("New redis error"))
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("New redis error"))
 
 			err := redisDatabase.SetLeaderboardExpiration(context.Background(), leaderboard, expireTime)
 			Expect(err).To(Equal(database.NewGeneralError("New redis error")))
 		})
 	})
 
-	Describe("SetMembersScore", func() {
-		redisMembers := []*redis.Member{
-			{
-				Member: member,
-				Score:  score,
-			},
-			{
-				Member: "member2",
-				Score:  2.0,
-			},
-		}
+	Describe("SetLeaderboardSettings", func() {
+		settings := map[string]string{"decayHalfLife": "3600"}
 
-		databaseMembers := []*database.Member{
-			{
-				Member: member,
-				Score:  score,
-			},
-			{
-				Member: "member2",
-				Score:  2.0,
-			},
-		}
 		It("Should return nil if all is ok", func() {
-			mock.EXPECT().ZAdd(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(redisMembers[0]), gomock.Eq(redisMembers[1])).Return(nil)
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"{leaderboardTest}:settings"}), gomock.Eq("HSET"), gomock.Eq("decayHalfLife"), gomock.Eq("3600")).Return(int64(1), nil)
+
+			err := redisDatabase.SetLeaderboardSettings(context.Background(), leaderboard, settings)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"{leaderboardTest}:settings"}), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			err := redisDatabase.SetLeaderboardSettings(context.Background(), leaderboard, settings)
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("SetMembersScore", func() {
+		It("Should return nil and fill members versions if all is ok", func() {
+			databaseMembers := []*database.Member{
+				{
+					Member: member,
+					Score:  score,
+				},
+				{
+					Member: "member2",
+					Score:  2.0,
+				},
+			}
+
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{leaderboard, "{leaderboardTest}:versions"}),
+				gomock.Eq(""),
+				gomock.Eq(fmt.Sprint(score)),
+				gomock.Eq(member),
+				gomock.Eq("0"),
+				gomock.Eq(""),
+				gomock.Eq("0"),
+				gomock.Eq("2"),
+				gomock.Eq("member2"),
+				gomock.Eq("0"),
+				gomock.Eq(""),
+				gomock.Eq("0"),
+			).Return([]interface{}{int64(1), int64(5)}, nil)
+
+			err := redisDatabase.SetMembers(context.Background(), leaderboard, databaseMembers)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(databaseMembers[0].Version).To(Equal(int64(1)))
+			Expect(databaseMembers[1].Version).To(Equal(int64(5)))
+		})
+
+		It("Should fill members previous scores if they existed", func() {
+			databaseMembers := []*database.Member{
+				{
+					Member: member,
+					Score:  score,
+				},
+				{
+					Member: "member2",
+					Score:  2.0,
+				},
+			}
+
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{leaderboard, "{leaderboardTest}:versions"}),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+			).Return([]interface{}{int64(2), int64(1), "10", ""}, nil)
 
 			err := redisDatabase.SetMembers(context.Background(), leaderboard, databaseMembers)
 			Expect(err).NotTo(HaveOccurred())
+			Expect(*databaseMembers[0].PreviousScore).To(Equal(float64(10)))
+			Expect(databaseMembers[1].PreviousScore).To(BeNil())
 		})
 
 		It("Should return GeneralError if redis return in error", func() {
-			mock.EXPECT().ZAdd(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(redisMembers[0]), gomock.Eq(redisMembers[1])).Return(fmt.Errorf("New redis error"))
+			databaseMembers := []*database.Member{
+				{
+					Member: member,
+					Score:  score,
+				},
+			}
+
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{leaderboard, "{leaderboardTest}:versions"}),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+			).Return(nil, fmt.Errorf("New redis error"))
 
 			err := redisDatabase.SetMembers(context.Background(), leaderboard, databaseMembers)
 			Expect(err).To(Equal(database.NewGeneralError("New redis error")))
@@ -722,17 +1024,6 @@ var _ = Describe("Redis Database", func() {
 		time1 := time.Now().Add(-2 * time.Hour)
 		time2 := time.Now().Add(-12 * time.Hour)
 		leaderboardTTL := fmt.Sprintf("%s:ttl", leaderboard)
-		redisMembers := []*redis.Member{
-			{
-				Member: member,
-				Score:  float64(time1.Unix()),
-			},
-			{
-				Member: "member2",
-				Score:  float64(time2.Unix()),
-			},
-		}
-
 		databaseMembers := []*database.Member{
 			{
 				Member: member,
@@ -744,26 +1035,74 @@ var _ = Describe("Redis Database", func() {
 			},
 		}
 		It("Should return nil if all is ok", func() {
-			mock.EXPECT().ZAdd(gomock.Any(), gomock.Eq(leaderboardTTL), gomock.Eq(redisMembers[0]), gomock.Eq(redisMembers[1])).Return(nil)
+			mock.EXPECT().Eval(
+				gomock.Any(), gomock.Any(), gomock.Eq([]string{leaderboardTTL}), gomock.Eq("ZADD"),
+				gomock.Eq(fmt.Sprint(time1.Unix())), gomock.Eq(member),
+				gomock.Eq(fmt.Sprint(time2.Unix())), gomock.Eq("member2"),
+			).Return(int64(2), nil)
 			mock.EXPECT().SAdd(gomock.Any(), gomock.Eq(database.ExpirationSet), gomock.Eq(leaderboardTTL)).Return(nil)
 
 			err := redisDatabase.SetMembersTTL(context.Background(), leaderboard, databaseMembers)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
-		It("Should return GeneralError if redis ZAdd return in error", func() {
-			mock.EXPECT().ZAdd(gomock.Any(), gomock.Eq(leaderboardTTL), gomock.Eq(redisMembers[0]), gomock.Eq(redisMembers[1])).Return(fmt.Errorf("New redis error"))
+		It("Should return GeneralError if redis return in error on set members TTL", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(), gomock.Any(), gomock.Eq([]string{leaderboardTTL}), gomock.Eq("ZADD"),
+				gomock.Eq(fmt.Sprint(time1.Unix())), gomock.Eq(member),
+				gomock.Eq(fmt.Sprint(time2.Unix())), gomock.Eq("member2"),
+			).Return(nil, fmt.Errorf("New redis error"))
 
 			err := redisDatabase.SetMembersTTL(context.Background(), leaderboard, databaseMembers)
 			Expect(err).To(Equal(database.NewGeneralError("New redis error")))
 		})
 
 		It("Should return GeneralError if redis SAdd return in error", func() {
-			mock.EXPECT().ZAdd(gomock.Any(), gomock.Eq(leaderboardTTL), gomock.Eq(redisMembers[0]), gomock.Eq(redisMembers[1])).Return(nil)
+			mock.EXPECT().Eval(
+				gomock.Any(), gomock.Any(), gomock.Eq([]string{leaderboardTTL}), gomock.Eq("ZADD"),
+				gomock.Eq(fmt.Sprint(time1.Unix())), gomock.Eq(member),
+				gomock.Eq(fmt.Sprint(time2.Unix())), gomock.Eq("member2"),
+			).Return(int64(2), nil)
 			mock.EXPECT().SAdd(gomock.Any(), gomock.Eq(database.ExpirationSet), gomock.Eq(leaderboardTTL)).Return(fmt.Errorf("New redis error"))
 
 			err := redisDatabase.SetMembersTTL(context.Background(), leaderboard, databaseMembers)
 			Expect(err).To(Equal(database.NewGeneralError("New redis error")))
 		})
 	})
+
+	Describe("SetResetProgress", func() {
+		leaderboardReset := "leaderboardTest:reset"
+		startedAt := time.Now()
+		progress := &database.ResetProgress{
+			Target:    "leaderboardTest2",
+			Strategy:  "topK",
+			Status:    "done",
+			Processed: 5,
+			Total:     5,
+			StartedAt: startedAt,
+		}
+		fields := map[string]string{
+			"target":     "leaderboardTest2",
+			"strategy":   "topK",
+			"status":     "done",
+			"processed":  "5",
+			"total":      "5",
+			"startedAt":  fmt.Sprint(startedAt.Unix()),
+			"finishedAt": "0",
+		}
+
+		It("Should return nil if all is ok", func() {
+			mock.EXPECT().HSet(gomock.Any(), gomock.Eq(leaderboardReset), gomock.Eq(fields)).Return(nil)
+
+			err := redisDatabase.SetResetProgress(context.Background(), leaderboard, progress)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().HSet(gomock.Any(), gomock.Eq(leaderboardReset), gomock.Eq(fields)).Return(fmt.Errorf("New redis error"))
+
+			err := redisDatabase.SetResetProgress(context.Background(), leaderboard, progress)
+			Expect(err).To(Equal(database.NewGeneralError("New redis error")))
+		})
+	})
 })
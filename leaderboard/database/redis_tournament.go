@@ -0,0 +1,137 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+// AddLeaderboardParticipants add members to the participants of a leaderboard, the participants are
+// kept in an OrderedSet scored by join time with key being leaderboard name and suffix ":participants"
+func (r *Redis) AddLeaderboardParticipants(ctx context.Context, leaderboard string, joinedAt time.Time, members ...string) error {
+	participantsKey := fmt.Sprintf("%s:participants", leaderboard)
+	args := make([]interface{}, 0, 2*len(members))
+	for _, member := range members {
+		args = append(args, strconv.FormatInt(joinedAt.Unix(), 10), member)
+	}
+
+	return r.writeCommand(ctx, leaderboard, "ZADD", participantsKey, args...)
+}
+
+// GetLeaderboardNonParticipants return which of the members did not join the leaderboard
+func (r *Redis) GetLeaderboardNonParticipants(ctx context.Context, leaderboard string, members ...string) ([]string, error) {
+	participantsKey := fmt.Sprintf("%s:participants", leaderboard)
+	nonParticipants := []string{}
+	for _, member := range members {
+		_, err := r.Client.ZScore(ctx, participantsKey, member)
+		if err != nil {
+			if _, ok := err.(*redis.MemberNotFoundError); ok {
+				nonParticipants = append(nonParticipants, member)
+				continue
+			}
+			return nil, NewGeneralError(err.Error())
+		}
+	}
+
+	return nonParticipants, nil
+}
+
+// GetTournament return tournament configuration
+func (r *Redis) GetTournament(ctx context.Context, tournament string) (*Tournament, error) {
+	tournamentKey := fmt.Sprintf("%s:tournament", tournament)
+	fields, err := r.Client.HGetAll(ctx, tournamentKey)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	if len(fields) == 0 {
+		return nil, NewTournamentNotFoundError(tournament)
+	}
+
+	config := &Tournament{
+		Order: fields["order"],
+	}
+
+	for field, value := range map[string]*time.Time{
+		"startAt":     &config.StartAt,
+		"endAt":       &config.EndAt,
+		"finalizedAt": &config.FinalizedAt,
+	} {
+		timestamp, err := strconv.ParseInt(fields[field], 10, 64)
+		if err != nil {
+			return nil, NewGeneralError(err.Error())
+		}
+		if timestamp > 0 {
+			*value = time.Unix(timestamp, 0)
+		}
+	}
+
+	err = json.Unmarshal([]byte(fields["prizes"]), &config.Prizes)
+	if err != nil {
+		return nil, NewGeneralError(err.Error())
+	}
+
+	return config, nil
+}
+
+// createTournamentScript sets the hash KEYS[1] to the fields and values ARGV unless it exists, returning 1
+// if it was set or 0 otherwise
+const createTournamentScript = `
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return 0
+end
+redis.call('HSET', KEYS[1], unpack(ARGV))
+return 1
+`
+
+// CreateTournament persist tournament configuration as SetTournament does unless tournament exists, returning
+// false if it did
+func (r *Redis) CreateTournament(ctx context.Context, tournament string, config *Tournament) (bool, error) {
+	args, err := tournamentArgs(config)
+	if err != nil {
+		return false, NewGeneralError(err.Error())
+	}
+
+	result, err := r.evalWrite(ctx, tournament, createTournamentScript, "result == 1", []string{fmt.Sprintf("%s:tournament", tournament)}, args...)
+	if err != nil {
+		return false, NewGeneralError(err.Error())
+	}
+
+	return result == int64(1), nil
+}
+
+// SetTournament persist tournament configuration in a hash with key being tournament name and suffix ":tournament"
+func (r *Redis) SetTournament(ctx context.Context, tournament string, config *Tournament) error {
+	tournamentKey := fmt.Sprintf("%s:tournament", tournament)
+	args, err := tournamentArgs(config)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	return r.writeCommand(ctx, tournament, "HSET", tournamentKey, args...)
+}
+
+// tournamentArgs return the fields and values of the hash of config as arguments of HSET
+func tournamentArgs(config *Tournament) ([]interface{}, error) {
+	prizes, err := json.Marshal(config.Prizes)
+	if err != nil {
+		return nil, err
+	}
+
+	var finalizedAt int64
+	if !config.FinalizedAt.IsZero() {
+		finalizedAt = config.FinalizedAt.Unix()
+	}
+
+	return hashArgs(map[string]string{
+		"startAt":     strconv.FormatInt(config.StartAt.Unix(), 10),
+		"endAt":       strconv.FormatInt(config.EndAt.Unix(), 10),
+		"finalizedAt": strconv.FormatInt(finalizedAt, 10),
+		"order":       config.Order,
+		"prizes":      string(prizes),
+	}), nil
+}
@@ -0,0 +1,179 @@
+package database_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+var _ = Describe("Redis Tournament Database", func() {
+	var ctrl *gomock.Controller
+	var mock *redis.MockRedis
+	var redisDatabase *database.Redis
+	var tournament string = "tournamentTest"
+	var tournamentKey string = "tournamentTest:tournament"
+	var participantsKey string = "tournamentTest:participants"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = redis.NewMockRedis(ctrl)
+
+		redisDatabase = &database.Redis{mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("AddLeaderboardParticipants", func() {
+		It("Should return nil if all is OK", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(), gomock.Any(), gomock.Eq([]string{participantsKey}), gomock.Eq("ZADD"),
+				gomock.Eq("1600000000"), gomock.Eq("member1"),
+				gomock.Eq("1600000000"), gomock.Eq("member2"),
+			).Return(int64(2), nil)
+
+			err := redisDatabase.AddLeaderboardParticipants(context.Background(), tournament, time.Unix(1600000000, 0), "member1", "member2")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{participantsKey}), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			err := redisDatabase.AddLeaderboardParticipants(context.Background(), tournament, time.Unix(1600000000, 0), "member1")
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("GetLeaderboardNonParticipants", func() {
+		It("Should return members that did not join", func() {
+			mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(participantsKey), gomock.Eq("member1")).Return(float64(1600000000), nil)
+			mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(participantsKey), gomock.Eq("member2")).Return(float64(-1), redis.NewMemberNotFoundError(participantsKey, "member2"))
+
+			nonParticipants, err := redisDatabase.GetLeaderboardNonParticipants(context.Background(), tournament, "member1", "member2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nonParticipants).To(Equal([]string{"member2"}))
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().ZScore(gomock.Any(), gomock.Eq(participantsKey), gomock.Eq("member1")).Return(float64(-1), fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.GetLeaderboardNonParticipants(context.Background(), tournament, "member1")
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("GetTournament", func() {
+		It("Should return tournament if all is OK", func() {
+			mock.EXPECT().HGetAll(gomock.Any(), gomock.Eq(tournamentKey)).Return(map[string]string{
+				"startAt":     "1600000000",
+				"endAt":       "1600003600",
+				"finalizedAt": "0",
+				"order":       "desc",
+				"prizes":      `[{"fromRank":1,"toRank":1,"rewardID":"gold"}]`,
+			}, nil)
+
+			config, err := redisDatabase.GetTournament(context.Background(), tournament)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(config).To(Equal(&database.Tournament{
+				StartAt: time.Unix(1600000000, 0),
+				EndAt:   time.Unix(1600003600, 0),
+				Order:   "desc",
+				Prizes: []*database.TournamentPrize{
+					{FromRank: 1, ToRank: 1, RewardID: "gold"},
+				},
+			}))
+		})
+
+		It("Should return TournamentNotFoundError if tournament does not exist", func() {
+			mock.EXPECT().HGetAll(gomock.Any(), gomock.Eq(tournamentKey)).Return(map[string]string{}, nil)
+
+			_, err := redisDatabase.GetTournament(context.Background(), tournament)
+			Expect(err).To(Equal(database.NewTournamentNotFoundError(tournament)))
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().HGetAll(gomock.Any(), gomock.Eq(tournamentKey)).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.GetTournament(context.Background(), tournament)
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("CreateTournament", func() {
+		It("Should return true if tournament was created", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(), gomock.Any(), gomock.Eq([]string{tournamentKey}),
+				gomock.Eq("endAt"), gomock.Eq("1600003600"),
+				gomock.Eq("finalizedAt"), gomock.Eq("0"),
+				gomock.Eq("order"), gomock.Eq("desc"),
+				gomock.Eq("prizes"), gomock.Eq(`[{"fromRank":1,"toRank":3,"rewardID":"gold"}]`),
+				gomock.Eq("startAt"), gomock.Eq("1600000000"),
+			).Return(int64(1), nil)
+
+			created, err := redisDatabase.CreateTournament(context.Background(), tournament, &database.Tournament{
+				StartAt: time.Unix(1600000000, 0),
+				EndAt:   time.Unix(1600003600, 0),
+				Order:   "desc",
+				Prizes: []*database.TournamentPrize{
+					{FromRank: 1, ToRank: 3, RewardID: "gold"},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created).To(BeTrue())
+		})
+
+		It("Should return false if tournament exists", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{tournamentKey}), gomock.Any()).Return(int64(0), nil)
+
+			created, err := redisDatabase.CreateTournament(context.Background(), tournament, &database.Tournament{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created).To(BeFalse())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{tournamentKey}), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			_, err := redisDatabase.CreateTournament(context.Background(), tournament, &database.Tournament{})
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+
+	Describe("SetTournament", func() {
+		It("Should return nil if all is OK", func() {
+			mock.EXPECT().Eval(
+				gomock.Any(), gomock.Any(), gomock.Eq([]string{tournamentKey}), gomock.Eq("HSET"),
+				gomock.Eq("endAt"), gomock.Eq("1600003600"),
+				gomock.Eq("finalizedAt"), gomock.Eq("1600007200"),
+				gomock.Eq("order"), gomock.Eq("desc"),
+				gomock.Eq("prizes"), gomock.Eq(`[{"fromRank":1,"toRank":3,"rewardID":"gold"}]`),
+				gomock.Eq("startAt"), gomock.Eq("1600000000"),
+			).Return(int64(5), nil)
+
+			err := redisDatabase.SetTournament(context.Background(), tournament, &database.Tournament{
+				StartAt:     time.Unix(1600000000, 0),
+				EndAt:       time.Unix(1600003600, 0),
+				FinalizedAt: time.Unix(1600007200, 0),
+				Order:       "desc",
+				Prizes: []*database.TournamentPrize{
+					{FromRank: 1, ToRank: 3, RewardID: "gold"},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{tournamentKey}), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+			err := redisDatabase.SetTournament(context.Background(), tournament, &database.Tournament{})
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+})
@@ -0,0 +1,209 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// Every write of a member score increments the member version kept in the hash "{leaderboard}:versions",
+// so a conditional write can detect that the member was written since it was read. The hash tag keeps it
+// in the slot of leaderboard, so scripts can write both on redis cluster
+
+// setMembersScript applies ZADD of ARGV[2..] score and member pairs to KEYS[1] incrementing
+// each member version in KEYS[2]. Each pair is followed by the member increase, added to the total
+// increases KEYS[3] expiring at ARGV[1] as addIncrease does, and the expected score and increase cap
+// checked by scoreChanged. It returns {-1} without writing if scoreChanged holds for a member, otherwise
+// the new versions in the same order followed by the previous scores, empty for members that did not exist
+const setMembersScript = addIncreaseFunction + scoreChangedFunction + `
+for i = 2, #ARGV, 5 do
+	if scoreChanged(KEYS[1], KEYS[3], ARGV[i + 1], ARGV[i + 3], ARGV[i + 2], ARGV[i + 4]) then
+		return {-1}
+	end
+end
+local versions = {}
+local previousScores = {}
+for i = 2, #ARGV, 5 do
+	previousScores[#previousScores + 1] = redis.call('ZSCORE', KEYS[1], ARGV[i + 1]) or ''
+	redis.call('ZADD', KEYS[1], ARGV[i], ARGV[i + 1])
+	versions[#versions + 1] = redis.call('HINCRBY', KEYS[2], ARGV[i + 1], 1)
+	addIncrease(KEYS[3], ARGV[i + 1], ARGV[i + 2], ARGV[1])
+end
+for i = 1, #previousScores do
+	versions[#versions + 1] = previousScores[i]
+end
+return versions
+`
+
+// importMembersScript sets the scores ARGV of members in pairs of score and member into KEYS[1],
+// incrementing each member version in KEYS[2]. It returns the previous scores of members in KEYS[3], the
+// leaderboard KEYS[1] is or replaces, empty for members that were not in it
+const importMembersScript = `
+local previousScores = {}
+for i = 1, #ARGV, 2 do
+	previousScores[#previousScores + 1] = redis.call('ZSCORE', KEYS[3], ARGV[i + 1]) or ''
+	redis.call('HINCRBY', KEYS[2], ARGV[i + 1], 1)
+end
+redis.call('ZADD', KEYS[1], unpack(ARGV))
+return previousScores
+`
+
+// incrementMemberScoreScript applies ZINCRBY of ARGV[1] to member ARGV[2] of KEYS[1] incrementing
+// its version in KEYS[2] and adding increase ARGV[3] to the total increases KEYS[3] expiring at ARGV[4].
+// It returns {-1} without writing if scoreChanged holds for expected score ARGV[5] and increase cap ARGV[6],
+// otherwise {version, score, previousScore} with the new version and score and the previous score, empty if
+// member did not exist
+const incrementMemberScoreScript = addIncreaseFunction + scoreChangedFunction + `
+if scoreChanged(KEYS[1], KEYS[3], ARGV[2], ARGV[5], ARGV[3], ARGV[6]) then
+	return {-1}
+end
+local previousScore = redis.call('ZSCORE', KEYS[1], ARGV[2]) or ''
+local score = redis.call('ZINCRBY', KEYS[1], ARGV[1], ARGV[2])
+addIncrease(KEYS[3], ARGV[2], ARGV[3], ARGV[4])
+return {redis.call('HINCRBY', KEYS[2], ARGV[2], 1), score, previousScore}
+`
+
+// conditionMatchesFunction defines conditionMatches, used by conditional write scripts to check that member
+// score in leaderboard is within tolerance of expectedScore and its version in hash versions is expectedVersion,
+// empty expectedScore or expectedVersion are not checked. It returns if they match and the member version
+const conditionMatchesFunction = `
+local function conditionMatches(leaderboard, versions, member, expectedScore, tolerance, expectedVersion)
+	local version = tonumber(redis.call('HGET', versions, member) or '0')
+	if expectedVersion ~= '' and version ~= tonumber(expectedVersion) then
+		return false, version
+	end
+	if expectedScore ~= '' then
+		local score = redis.call('ZSCORE', leaderboard, member)
+		if not score or math.abs(tonumber(score) - tonumber(expectedScore)) >= tonumber(tolerance) then
+			return false, version
+		end
+	end
+	return true, version
+end
+`
+
+// setMemberIfMatchScript applies ZADD of score ARGV[1] to member ARGV[2] of KEYS[1] only if conditionMatches
+// holds for expected score ARGV[3] within ARGV[4] and version ARGV[5] in KEYS[2], adding increase ARGV[6] to
+// the total increases KEYS[3] expiring at ARGV[7] when applied.
+// It returns {1, version, previousScore} with the new version and the previous score, empty if member
+// did not exist, when applied, {0, version} when the condition does not match or {-1} without writing
+// if scoreChanged holds for expected score ARGV[8] and increase cap ARGV[9]
+const setMemberIfMatchScript = addIncreaseFunction + scoreChangedFunction + conditionMatchesFunction + `
+local matches, version = conditionMatches(KEYS[1], KEYS[2], ARGV[2], ARGV[3], ARGV[4], ARGV[5])
+if not matches then
+	return {0, version}
+end
+if scoreChanged(KEYS[1], KEYS[3], ARGV[2], ARGV[8], ARGV[6], ARGV[9]) then
+	return {-1}
+end
+local score = redis.call('ZSCORE', KEYS[1], ARGV[2])
+redis.call('ZADD', KEYS[1], ARGV[1], ARGV[2])
+addIncrease(KEYS[3], ARGV[2], ARGV[6], ARGV[7])
+return {1, redis.call('HINCRBY', KEYS[2], ARGV[2], 1), score or ''}
+`
+
+func versionsKey(leaderboard string) string {
+	return LeaderboardKey(leaderboard, "versions")
+}
+
+func formatScore(score float64) string {
+	return strconv.FormatFloat(score, 'f', -1, 64)
+}
+
+// SetMemberIfMatch set member score only if its stored score and version match condition, filling
+// member Version with its new version and PreviousScore. It returns ConditionFailedError if they do not match
+// and ScoreChangedError if member Guard or Increase cap do not hold
+func (r *Redis) SetMemberIfMatch(ctx context.Context, leaderboard string, member *Member, condition *Condition) error {
+	keys, increasesExpireAt := withIncreasesKey(leaderboard, []string{leaderboard, versionsKey(leaderboard)}, member)
+	guardScore, maxIncrease := formatGuard(member)
+	expectedScore, tolerance, expectedVersion := formatCondition(condition)
+	result, err := r.evalWrite(
+		ctx, leaderboard, setMemberIfMatchScript, "result[1] == 1", keys,
+		formatScore(member.Score), member.Member, expectedScore, tolerance, expectedVersion,
+		formatIncrease(member), increasesExpireAt, guardScore, maxIncrease,
+	)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	values, ok := result.([]interface{})
+	if ok && len(values) == 1 && fmt.Sprint(values[0]) == "-1" {
+		return NewScoreChangedError(leaderboard)
+	}
+	if !ok || len(values) < 2 {
+		return NewGeneralError(fmt.Sprintf("unexpected conditional write result %v", result))
+	}
+
+	version, err := strconv.ParseInt(fmt.Sprint(values[1]), 10, 64)
+	if err != nil {
+		return NewGeneralError(err.Error())
+	}
+
+	if fmt.Sprint(values[0]) != "1" {
+		return NewConditionFailedError(leaderboard, member.Member, version)
+	}
+
+	member.Version = version
+	if len(values) > 2 {
+		err = setMembersPreviousScores([]*Member{member}, values[2:])
+		if err != nil {
+			return NewGeneralError(err.Error())
+		}
+	}
+	return nil
+}
+
+func setMembersVersions(databaseMembers []*Member, versions []interface{}) error {
+	for i, version := range versions {
+		if i >= len(databaseMembers) {
+			break
+		}
+
+		value, err := strconv.ParseInt(fmt.Sprint(version), 10, 64)
+		if err != nil {
+			return err
+		}
+		databaseMembers[i].Version = value
+	}
+
+	return nil
+}
+
+// setMembersPreviousScores fill databaseMembers PreviousScore with previousScores, leaving it nil
+// for empty ones of members that did not exist
+func setMembersPreviousScores(databaseMembers []*Member, previousScores []interface{}) error {
+	for i, previousScore := range previousScores {
+		if i >= len(databaseMembers) {
+			break
+		}
+
+		value := fmt.Sprint(previousScore)
+		if value == "" {
+			continue
+		}
+
+		score, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		databaseMembers[i].PreviousScore = &score
+	}
+
+	return nil
+}
+
+// formatCondition return the expected score, tolerance and expected version of condition as conditionMatches
+// takes them, empty for fields that are not set
+func formatCondition(condition *Condition) (string, string, string) {
+	expectedScore := ""
+	if condition.Score != nil {
+		expectedScore = formatScore(*condition.Score)
+	}
+
+	expectedVersion := ""
+	if condition.Version != nil {
+		expectedVersion = strconv.FormatInt(*condition.Version, 10)
+	}
+
+	return expectedScore, formatScore(condition.Tolerance), expectedVersion
+}
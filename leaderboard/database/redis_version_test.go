@@ -0,0 +1,175 @@
+package database_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+var _ = Describe("Redis Version Database", func() {
+	var ctrl *gomock.Controller
+	var mock *redis.MockRedis
+	var redisDatabase *database.Redis
+	var leaderboard string = "leaderboardTest"
+	var versionsKey string = "{leaderboardTest}:versions"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = redis.NewMockRedis(ctrl)
+
+		redisDatabase = &database.Redis{mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("SetMemberIfMatch", func() {
+		expectedScore := float64(100)
+		expectedVersion := int64(3)
+
+		It("Should fill member version if condition matches", func() {
+			member := &database.Member{Member: "member1", Score: 150}
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{leaderboard, versionsKey}),
+				gomock.Eq("150"),
+				gomock.Eq("member1"),
+				gomock.Eq("100"),
+				gomock.Eq("0.5"),
+				gomock.Eq("3"),
+				gomock.Eq("0"),
+				gomock.Eq(""),
+				gomock.Eq(""),
+				gomock.Eq("0"),
+			).Return([]interface{}{int64(1), int64(4)}, nil)
+
+			err := redisDatabase.SetMemberIfMatch(context.Background(), leaderboard, member, &database.Condition{
+				Score:     &expectedScore,
+				Tolerance: 0.5,
+				Version:   &expectedVersion,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Version).To(Equal(int64(4)))
+		})
+
+		It("Should not send fields of condition that are not set", func() {
+			member := &database.Member{Member: "member1", Score: 150}
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{leaderboard, versionsKey}),
+				gomock.Eq("150"),
+				gomock.Eq("member1"),
+				gomock.Eq(""),
+				gomock.Eq("0"),
+				gomock.Eq("3"),
+				gomock.Eq("0"),
+				gomock.Eq(""),
+				gomock.Eq(""),
+				gomock.Eq("0"),
+			).Return([]interface{}{int64(1), int64(4)}, nil)
+
+			err := redisDatabase.SetMemberIfMatch(context.Background(), leaderboard, member, &database.Condition{Version: &expectedVersion})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should count the increase of member if it is set", func() {
+			member := &database.Member{Member: "member1", Score: 150, Increase: &database.Increase{Value: 50, Window: time.Hour}}
+			windowStart := time.Now().Truncate(time.Hour)
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{leaderboard, versionsKey, fmt.Sprintf("{%s}:increases:%d", leaderboard, windowStart.Unix())}),
+				gomock.Eq("150"),
+				gomock.Eq("member1"),
+				gomock.Eq(""),
+				gomock.Eq("0"),
+				gomock.Eq("3"),
+				gomock.Eq("50"),
+				gomock.Eq(fmt.Sprint(windowStart.Add(time.Hour).UnixNano()/int64(time.Millisecond))),
+				gomock.Eq(""),
+				gomock.Eq("0"),
+			).Return([]interface{}{int64(1), int64(4)}, nil)
+
+			err := redisDatabase.SetMemberIfMatch(context.Background(), leaderboard, member, &database.Condition{Version: &expectedVersion})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return ConditionFailedError if condition does not match", func() {
+			member := &database.Member{Member: "member1", Score: 150}
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{leaderboard, versionsKey}),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+			).Return([]interface{}{int64(0), int64(5)}, nil)
+
+			err := redisDatabase.SetMemberIfMatch(context.Background(), leaderboard, member, &database.Condition{Version: &expectedVersion})
+			Expect(err).To(Equal(database.NewConditionFailedError(leaderboard, "member1", 5)))
+			Expect(member.Version).To(Equal(int64(0)))
+		})
+
+		It("Should return ScoreChangedError if the guarded score of member changed", func() {
+			member := &database.Member{
+				Member:   "member1",
+				Score:    150,
+				Increase: &database.Increase{Value: 50, Window: time.Hour, Max: 80},
+				Guard:    &database.ScoreGuard{},
+			}
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq("50"),
+				gomock.Any(),
+				gomock.Eq("-"),
+				gomock.Eq("80"),
+			).Return([]interface{}{int64(-1)}, nil)
+
+			err := redisDatabase.SetMemberIfMatch(context.Background(), leaderboard, member, &database.Condition{Version: &expectedVersion})
+			Expect(err).To(Equal(database.NewScoreChangedError(leaderboard)))
+		})
+
+		It("Should return GeneralError if redis return in error", func() {
+			member := &database.Member{Member: "member1", Score: 150}
+			mock.EXPECT().Eval(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Eq([]string{leaderboard, versionsKey}),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+			).Return(nil, fmt.Errorf("redis error"))
+
+			err := redisDatabase.SetMemberIfMatch(context.Background(), leaderboard, member, &database.Condition{Version: &expectedVersion})
+			Expect(err).To(Equal(database.NewGeneralError("redis error")))
+		})
+	})
+})
@@ -0,0 +1,9 @@
+package database
+
+import "context"
+
+// Snapshot interface standardize rank snapshot database calls
+type Snapshot interface {
+	GetSnapshotLeaderboards(ctx context.Context) ([]string, error)
+	RemoveLeaderboardFromSnapshotList(ctx context.Context, leaderboard string) error
+}
@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: leaderboard/database/snapshot.go
+
+// Package database is a generated GoMock package.
+package database
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSnapshot is a mock of Snapshot interface.
+type MockSnapshot struct {
+	ctrl     *gomock.Controller
+	recorder *MockSnapshotMockRecorder
+}
+
+// MockSnapshotMockRecorder is the mock recorder for MockSnapshot.
+type MockSnapshotMockRecorder struct {
+	mock *MockSnapshot
+}
+
+// NewMockSnapshot creates a new mock instance.
+func NewMockSnapshot(ctrl *gomock.Controller) *MockSnapshot {
+	mock := &MockSnapshot{ctrl: ctrl}
+	mock.recorder = &MockSnapshotMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSnapshot) EXPECT() *MockSnapshotMockRecorder {
+	return m.recorder
+}
+
+// GetSnapshotLeaderboards mocks base method.
+func (m *MockSnapshot) GetSnapshotLeaderboards(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshotLeaderboards", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshotLeaderboards indicates an expected call of GetSnapshotLeaderboards.
+func (mr *MockSnapshotMockRecorder) GetSnapshotLeaderboards(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotLeaderboards", reflect.TypeOf((*MockSnapshot)(nil).GetSnapshotLeaderboards), ctx)
+}
+
+// RemoveLeaderboardFromSnapshotList mocks base method.
+func (m *MockSnapshot) RemoveLeaderboardFromSnapshotList(ctx context.Context, leaderboard string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveLeaderboardFromSnapshotList", ctx, leaderboard)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveLeaderboardFromSnapshotList indicates an expected call of RemoveLeaderboardFromSnapshotList.
+func (mr *MockSnapshotMockRecorder) RemoveLeaderboardFromSnapshotList(ctx, leaderboard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLeaderboardFromSnapshotList", reflect.TypeOf((*MockSnapshot)(nil).RemoveLeaderboardFromSnapshotList), ctx, leaderboard)
+}
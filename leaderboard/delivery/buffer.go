@@ -0,0 +1,164 @@
+package delivery
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Buffer delivers items in background, so writes do not wait for them to be delivered. Up to size items are
+// buffered, when the buffer is full Add blocks until there is room or ctx ends, so a write is not acknowledged
+// while its items are not buffered. Batches that fail to be delivered are retried every retry interval until
+// they are, which makes delivery at-least-once while the process runs. Items still buffered when the process
+// stops are lost, so Close must be called to flush them
+type Buffer struct {
+	deliver       func(ctx context.Context, batch []interface{}) error
+	buffer        chan interface{}
+	batchSize     int
+	retryInterval time.Duration
+	onError       func(error)
+
+	mutex   sync.RWMutex
+	closed  bool
+	closing chan struct{}
+	adding  sync.WaitGroup
+	drain   chan struct{}
+	done    chan struct{}
+	ctx     context.Context
+	cancel  context.CancelFunc
+}
+
+// NewBuffer create a Buffer that calls deliver with batches of up to batchSize items, calling onError with
+// its errors. It starts delivering items right away
+func NewBuffer(size, batchSize int, retryInterval time.Duration, deliver func(ctx context.Context, batch []interface{}) error, onError func(error)) *Buffer {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	if onError == nil {
+		onError = func(error) {}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	buffer := &Buffer{
+		deliver:       deliver,
+		buffer:        make(chan interface{}, size),
+		batchSize:     batchSize,
+		retryInterval: retryInterval,
+		onError:       onError,
+		closing:       make(chan struct{}),
+		drain:         make(chan struct{}),
+		done:          make(chan struct{}),
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+
+	go buffer.run()
+
+	return buffer
+}
+
+// Add buffer items to be delivered. It returns ClosedError if buffer is closed
+func (b *Buffer) Add(ctx context.Context, items ...interface{}) error {
+	b.mutex.RLock()
+	if b.closed {
+		b.mutex.RUnlock()
+		return NewClosedError()
+	}
+	b.adding.Add(1)
+	b.mutex.RUnlock()
+	defer b.adding.Done()
+
+	for _, item := range items {
+		select {
+		case b.buffer <- item:
+		case <-b.closing:
+			return NewClosedError()
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// Close stop accepting items and wait until buffered ones are delivered. If ctx ends first the items
+// not delivered yet are dropped and the error of ctx is returned
+func (b *Buffer) Close(ctx context.Context) error {
+	b.mutex.Lock()
+	if !b.closed {
+		b.closed = true
+		close(b.closing)
+		go func() {
+			b.adding.Wait()
+			close(b.drain)
+		}()
+	}
+	b.mutex.Unlock()
+
+	select {
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	}
+}
+
+func (b *Buffer) run() {
+	defer close(b.done)
+
+	for {
+		batch := b.nextBatch()
+		if len(batch) == 0 {
+			return
+		}
+
+		if !b.deliverBatch(batch) {
+			return
+		}
+	}
+}
+
+// nextBatch wait for buffered items returning up to batchSize of them, or none if buffer was closed
+// and all of them were taken
+func (b *Buffer) nextBatch() []interface{} {
+	batch := make([]interface{}, 0, b.batchSize)
+
+	select {
+	case item := <-b.buffer:
+		batch = append(batch, item)
+	case <-b.drain:
+	}
+
+	for len(batch) < b.batchSize {
+		select {
+		case item := <-b.buffer:
+			batch = append(batch, item)
+		default:
+			return batch
+		}
+	}
+
+	return batch
+}
+
+// deliverBatch deliver batch until it succeeds, returning false if buffer was closed before
+func (b *Buffer) deliverBatch(batch []interface{}) bool {
+	for {
+		err := b.deliver(b.ctx, batch)
+		if err == nil {
+			return true
+		}
+
+		if b.ctx.Err() != nil {
+			return false
+		}
+		b.onError(err)
+
+		select {
+		case <-time.After(b.retryInterval):
+		case <-b.ctx.Done():
+			return false
+		}
+	}
+}
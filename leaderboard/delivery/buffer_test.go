@@ -0,0 +1,77 @@
+package delivery_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/delivery"
+)
+
+var _ = Describe("Buffer", func() {
+	It("Should deliver buffered items in batches retrying them until they are delivered", func() {
+		var mutex sync.Mutex
+		var batches [][]interface{}
+		var errors []error
+		failures := 1
+		buffer := delivery.NewBuffer(10, 2, time.Millisecond, func(ctx context.Context, batch []interface{}) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			if failures > 0 {
+				failures--
+				return fmt.Errorf("deliver error")
+			}
+			batches = append(batches, batch)
+			return nil
+		}, func(err error) {
+			errors = append(errors, err)
+		})
+
+		err := buffer.Add(context.Background(), "item1", "item2", "item3")
+		Expect(err).NotTo(HaveOccurred())
+
+		err = buffer.Close(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(errors).To(Equal([]error{fmt.Errorf("deliver error")}))
+
+		delivered := []interface{}{}
+		for _, batch := range batches {
+			Expect(len(batch)).To(BeNumerically("<=", 2))
+			delivered = append(delivered, batch...)
+		}
+		Expect(delivered).To(Equal([]interface{}{"item1", "item2", "item3"}))
+	})
+
+	It("Should block while buffer is full until ctx ends", func() {
+		delivered := make(chan struct{})
+		buffer := delivery.NewBuffer(1, 1, time.Millisecond, func(ctx context.Context, batch []interface{}) error {
+			<-delivered
+			return nil
+		}, nil)
+		err := buffer.Add(context.Background(), "item1", "item2")
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		err = buffer.Add(ctx, "item3")
+		Expect(err).To(Equal(context.DeadlineExceeded))
+
+		close(delivered)
+		err = buffer.Close(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should return ClosedError if buffer is closed", func() {
+		buffer := delivery.NewBuffer(10, 10, time.Millisecond, func(ctx context.Context, batch []interface{}) error {
+			return nil
+		}, nil)
+		err := buffer.Close(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+
+		err = buffer.Add(context.Background(), "item1")
+		Expect(err).To(Equal(delivery.NewClosedError()))
+	})
+})
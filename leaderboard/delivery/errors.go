@@ -0,0 +1,31 @@
+package delivery
+
+import "fmt"
+
+// ClosedError is an error when items are added to a closed buffer
+type ClosedError struct{}
+
+func (ce *ClosedError) Error() string {
+	return "delivery buffer is closed"
+}
+
+// NewClosedError create a new ClosedError
+func NewClosedError() *ClosedError {
+	return &ClosedError{}
+}
+
+// StatusError is an error when a webhook answers a request with a status that is not 2xx
+type StatusError struct {
+	StatusCode int
+}
+
+func (se *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d", se.StatusCode)
+}
+
+// NewStatusError create a new StatusError
+func NewStatusError(statusCode int) *StatusError {
+	return &StatusError{
+		StatusCode: statusCode,
+	}
+}
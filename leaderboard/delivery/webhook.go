@@ -0,0 +1,123 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Headers of webhook requests with the signature of their payload
+const (
+	SignatureHeader = "X-Podium-Signature"
+	TimestampHeader = "X-Podium-Timestamp"
+)
+
+// Sign return the hex encoded HMAC-SHA256 with secret of the timestamp header, a dot and payload, sent in
+// the signature header so receivers can verify requests and reject old ones by their timestamp
+func Sign(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Webhook posts JSON payloads to an HTTP endpoint, signed with Secret as described in Sign when it is set.
+// Requests that fail or are not answered with a 2xx status are retried up to MaxRetries times, waiting
+// RetryBackoff before the first retry and doubling it on each one
+type Webhook struct {
+	URL          string
+	Secret       string
+	Client       *http.Client
+	MaxRetries   int
+	RetryBackoff time.Duration
+}
+
+// Attempt is a request made to post a payload to a webhook
+type Attempt struct {
+	// Number of the attempt, starting at 1
+	Number int
+	// StatusCode the webhook answered with, zero if the request failed
+	StatusCode int
+	// Err of the attempt, nil if the webhook received the payload
+	Err error
+	// Last tells if no other attempt follows this one
+	Last bool
+}
+
+// NewWebhook create a new Webhook whose requests timeout after timeout
+func NewWebhook(url, secret string, timeout time.Duration, maxRetries int, retryBackoff time.Duration) *Webhook {
+	return &Webhook{
+		URL:          url,
+		Secret:       secret,
+		Client:       &http.Client{Timeout: timeout},
+		MaxRetries:   maxRetries,
+		RetryBackoff: retryBackoff,
+	}
+}
+
+// Post post payload to the webhook retrying it on failures, calling onAttempt, when it is not nil, after each
+// attempt. It returns the error returned by onAttempt, which stops retries, the error of the last attempt or
+// the error of ctx if it ends before a retry
+func (w *Webhook) Post(ctx context.Context, payload []byte, onAttempt func(attempt *Attempt) error) error {
+	backoff := w.RetryBackoff
+	for number := 1; ; number++ {
+		statusCode, err := w.post(ctx, payload)
+
+		attempt := &Attempt{
+			Number:     number,
+			StatusCode: statusCode,
+			Err:        err,
+			Last:       err == nil || number > w.MaxRetries,
+		}
+		if onAttempt != nil {
+			callbackErr := onAttempt(attempt)
+			if callbackErr != nil {
+				return callbackErr
+			}
+		}
+
+		if attempt.Last {
+			return err
+		}
+
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// post send payload to the webhook returning the status it answered with, zero if the request failed
+func (w *Webhook) post(ctx context.Context, payload []byte) (int, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	if w.Secret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		request.Header.Set(TimestampHeader, timestamp)
+		request.Header.Set(SignatureHeader, Sign(w.Secret, timestamp, payload))
+	}
+
+	response, err := w.Client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return response.StatusCode, NewStatusError(response.StatusCode)
+	}
+
+	return response.StatusCode, nil
+}
@@ -0,0 +1,94 @@
+package delivery_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/delivery"
+)
+
+var _ = Describe("Webhook", func() {
+	var server *httptest.Server
+	var requests int32
+	var failures int32
+	var headers http.Header
+	var received []byte
+
+	payload := []byte(`{"events":[]}`)
+
+	BeforeEach(func() {
+		requests = 0
+		failures = 0
+		received = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			if atomic.AddInt32(&requests, 1) <= atomic.LoadInt32(&failures) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			body, err := ioutil.ReadAll(r.Body)
+			Expect(err).NotTo(HaveOccurred())
+			headers = r.Header
+			received = body
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("Should post payload signed with secret", func() {
+		webhook := delivery.NewWebhook(server.URL, "secret", time.Second, 0, time.Millisecond)
+
+		err := webhook.Post(context.Background(), payload, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(received).To(Equal(payload))
+		Expect(headers.Get("Content-Type")).To(Equal("application/json"))
+		Expect(headers.Get(delivery.TimestampHeader)).NotTo(BeEmpty())
+		Expect(headers.Get(delivery.SignatureHeader)).To(Equal(delivery.Sign("secret", headers.Get(delivery.TimestampHeader), payload)))
+	})
+
+	It("Should not sign payload without secret", func() {
+		webhook := delivery.NewWebhook(server.URL, "", time.Second, 0, time.Millisecond)
+
+		err := webhook.Post(context.Background(), payload, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(headers.Get(delivery.SignatureHeader)).To(BeEmpty())
+	})
+
+	It("Should retry failed requests calling onAttempt after each one", func() {
+		failures = 3
+		webhook := delivery.NewWebhook(server.URL, "secret", time.Second, 2, time.Millisecond)
+
+		attempts := []delivery.Attempt{}
+		err := webhook.Post(context.Background(), payload, func(attempt *delivery.Attempt) error {
+			attempts = append(attempts, *attempt)
+			return nil
+		})
+		Expect(err).To(Equal(delivery.NewStatusError(http.StatusServiceUnavailable)))
+		Expect(attempts).To(Equal([]delivery.Attempt{
+			{Number: 1, StatusCode: http.StatusServiceUnavailable, Err: delivery.NewStatusError(http.StatusServiceUnavailable)},
+			{Number: 2, StatusCode: http.StatusServiceUnavailable, Err: delivery.NewStatusError(http.StatusServiceUnavailable)},
+			{Number: 3, StatusCode: http.StatusServiceUnavailable, Err: delivery.NewStatusError(http.StatusServiceUnavailable), Last: true},
+		}))
+	})
+
+	It("Should stop retrying when onAttempt returns an error", func() {
+		failures = 3
+		webhook := delivery.NewWebhook(server.URL, "secret", time.Second, 2, time.Millisecond)
+
+		err := webhook.Post(context.Background(), payload, func(attempt *delivery.Attempt) error {
+			return fmt.Errorf("store error")
+		})
+		Expect(err).To(MatchError("store error"))
+		Expect(requests).To(Equal(int32(1)))
+	})
+})
@@ -0,0 +1,51 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/delivery"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// BufferedSink sends events to another sink in background with a delivery.Buffer, so writes do not wait for
+// them to be published. When the buffer is full Send blocks until there is room or ctx ends, so a write is
+// not acknowledged while its events are not buffered. Events the sink fails to publish are retried until
+// they are, and events still buffered when the process stops are lost, so Close must be called to flush them
+type BufferedSink struct {
+	buffer *delivery.Buffer
+}
+
+// NewBufferedSink create a BufferedSink that sends batches of up to batchSize events to sink, calling onError
+// with errors of sink. It starts sending events right away
+func NewBufferedSink(sink EventSink, size, batchSize int, retryInterval time.Duration, onError func(error)) *BufferedSink {
+	return &BufferedSink{
+		buffer: delivery.NewBuffer(size, batchSize, retryInterval, func(ctx context.Context, batch []interface{}) error {
+			events := make([]*model.ScoreEvent, 0, len(batch))
+			for _, event := range batch {
+				events = append(events, event.(*model.ScoreEvent))
+			}
+			return sink.Send(ctx, events)
+		}, onError),
+	}
+}
+
+// Send buffer events to be published. It returns SinkClosedError if sink is closed
+func (b *BufferedSink) Send(ctx context.Context, events []*model.ScoreEvent) error {
+	items := make([]interface{}, 0, len(events))
+	for _, event := range events {
+		items = append(items, event)
+	}
+
+	err := b.buffer.Add(ctx, items...)
+	if _, ok := err.(*delivery.ClosedError); ok {
+		return NewSinkClosedError()
+	}
+	return err
+}
+
+// Close stop accepting events and wait until buffered ones are published. If ctx ends first the events
+// not published yet are dropped and the error of ctx is returned
+func (b *BufferedSink) Close(ctx context.Context) error {
+	return b.buffer.Close(ctx)
+}
@@ -0,0 +1,108 @@
+package events_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/events"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+var _ = Describe("Buffered Sink", func() {
+	var ctrl *gomock.Controller
+	var mock *events.MockEventSink
+
+	scoreEvents := []*model.ScoreEvent{
+		{ID: "event1", Leaderboard: "leaderboardTest", PublicID: "member1"},
+		{ID: "event2", Leaderboard: "leaderboardTest", PublicID: "member2"},
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = events.NewMockEventSink(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should publish buffered events in batches", func() {
+		mock.EXPECT().Send(gomock.Any(), gomock.Eq(scoreEvents[:1])).Return(nil)
+		mock.EXPECT().Send(gomock.Any(), gomock.Eq(scoreEvents[1:])).Return(nil)
+
+		bufferedSink := events.NewBufferedSink(mock, 10, 1, time.Millisecond, nil)
+		err := bufferedSink.Send(context.Background(), scoreEvents)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = bufferedSink.Close(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should retry events until they are published", func() {
+		var errors []error
+		var mutex sync.Mutex
+		mock.EXPECT().Send(gomock.Any(), gomock.Eq(scoreEvents[:1])).Return(fmt.Errorf("sink error")).Times(2)
+		mock.EXPECT().Send(gomock.Any(), gomock.Eq(scoreEvents[:1])).Return(nil)
+
+		bufferedSink := events.NewBufferedSink(mock, 10, 10, time.Millisecond, func(err error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			errors = append(errors, err)
+		})
+
+		err := bufferedSink.Send(context.Background(), scoreEvents[:1])
+		Expect(err).NotTo(HaveOccurred())
+
+		err = bufferedSink.Close(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(errors).To(Equal([]error{fmt.Errorf("sink error"), fmt.Errorf("sink error")}))
+	})
+
+	It("Should block while buffer is full until ctx ends", func() {
+		published := make(chan struct{})
+		mock.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, scoreEvents []*model.ScoreEvent) error {
+			<-published
+			return nil
+		}).AnyTimes()
+
+		bufferedSink := events.NewBufferedSink(mock, 1, 1, time.Millisecond, nil)
+		err := bufferedSink.Send(context.Background(), scoreEvents)
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		err = bufferedSink.Send(ctx, scoreEvents[:1])
+		Expect(err).To(Equal(context.DeadlineExceeded))
+
+		close(published)
+		err = bufferedSink.Close(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should return SinkClosedError if sink is closed", func() {
+		bufferedSink := events.NewBufferedSink(mock, 10, 10, time.Millisecond, nil)
+		err := bufferedSink.Close(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+
+		err = bufferedSink.Send(context.Background(), scoreEvents)
+		Expect(err).To(Equal(events.NewSinkClosedError()))
+	})
+
+	It("Should drop events not published when ctx of Close ends", func() {
+		mock.EXPECT().Send(gomock.Any(), gomock.Any()).Return(fmt.Errorf("sink error")).MinTimes(1)
+
+		bufferedSink := events.NewBufferedSink(mock, 10, 10, time.Millisecond, nil)
+		err := bufferedSink.Send(context.Background(), scoreEvents)
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		err = bufferedSink.Close(ctx)
+		Expect(err).To(Equal(context.DeadlineExceeded))
+	})
+})
@@ -0,0 +1,51 @@
+package events
+
+import "fmt"
+
+// GeneralError is an error of an event sink that is not handled
+type GeneralError struct {
+	sink string
+	msg  string
+}
+
+func (ge *GeneralError) Error() string {
+	return fmt.Sprintf("%s sink error: %s", ge.sink, ge.msg)
+}
+
+// NewGeneralError create a new GeneralError
+func NewGeneralError(sink, msg string) *GeneralError {
+	return &GeneralError{
+		sink: sink,
+		msg:  msg,
+	}
+}
+
+// SinkClosedError is an error when events are sent to a closed buffered sink
+type SinkClosedError struct{}
+
+func (sce *SinkClosedError) Error() string {
+	return "event sink is closed"
+}
+
+// NewSinkClosedError create a new SinkClosedError
+func NewSinkClosedError() *SinkClosedError {
+	return &SinkClosedError{}
+}
+
+// StreamTrimmedError is an error when the entry events are read after was trimmed from the stream
+type StreamTrimmedError struct {
+	Stream  string
+	AfterID string
+}
+
+func (ste *StreamTrimmedError) Error() string {
+	return fmt.Sprintf("entry %s of stream %s was trimmed, events after it may have been lost", ste.AfterID, ste.Stream)
+}
+
+// NewStreamTrimmedError create a new StreamTrimmedError
+func NewStreamTrimmedError(stream, afterID string) *StreamTrimmedError {
+	return &StreamTrimmedError{
+		Stream:  stream,
+		AfterID: afterID,
+	}
+}
@@ -0,0 +1,64 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const fileSinkName = "file"
+
+// FileSink appends events to a local file as JSON lines, syncing the file after each batch
+type FileSink struct {
+	mutex sync.Mutex
+	file  *os.File
+}
+
+// NewFileSink create a FileSink appending to the file in path, creating it if it does not exist
+func NewFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, NewGeneralError(fileSinkName, err.Error())
+	}
+
+	return &FileSink{file: file}, nil
+}
+
+// Send append events to the file
+func (f *FileSink) Send(ctx context.Context, events []*model.ScoreEvent) error {
+	var lines bytes.Buffer
+	encoder := json.NewEncoder(&lines)
+	for _, event := range events {
+		err := encoder.Encode(event)
+		if err != nil {
+			return NewGeneralError(fileSinkName, err.Error())
+		}
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	_, err := f.file.Write(lines.Bytes())
+	if err != nil {
+		return NewGeneralError(fileSinkName, err.Error())
+	}
+
+	err = f.file.Sync()
+	if err != nil {
+		return NewGeneralError(fileSinkName, err.Error())
+	}
+
+	return nil
+}
+
+// Close close the file
+func (f *FileSink) Close() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.file.Close()
+}
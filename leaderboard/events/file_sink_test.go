@@ -0,0 +1,52 @@
+package events_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/events"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+var _ = Describe("File Sink", func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "podium-events")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("Should append events to the file as JSON lines", func() {
+		path := filepath.Join(dir, "events.jsonl")
+		fileSink, err := events.NewFileSink(path)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = fileSink.Send(context.Background(), []*model.ScoreEvent{{ID: "event1", OldRank: -1, NewRank: 1}})
+		Expect(err).NotTo(HaveOccurred())
+		err = fileSink.Send(context.Background(), []*model.ScoreEvent{{ID: "event2", OldRank: 1, NewRank: -1}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fileSink.Close()).To(Succeed())
+
+		content, err := ioutil.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal(
+			`{"id":"event1","leaderboard":"","publicID":"","oldScore":null,"newScore":null,"oldRank":-1,"newRank":1,"reason":"","caller":"","changedAt":0}` + "\n" +
+				`{"id":"event2","leaderboard":"","publicID":"","oldScore":null,"newScore":null,"oldRank":1,"newRank":-1,"reason":"","caller":"","changedAt":0}` + "\n",
+		))
+	})
+
+	It("Should return GeneralError if file can not be opened", func() {
+		_, err := events.NewFileSink(filepath.Join(dir, "missing", "events.jsonl"))
+		Expect(err).To(HaveOccurred())
+		Expect(err).To(BeAssignableToTypeOf(&events.GeneralError{}))
+	})
+})
@@ -0,0 +1,126 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// readEventsScript return up to ARGV[2] entries of stream KEYS[1] from ID ARGV[1], or -1 if ARGV[3], the ID of
+// the entry read before them, is not empty and was trimmed, since entries after it could have been trimmed too
+const readEventsScript = `
+if ARGV[3] ~= '' and redis.call('XLEN', KEYS[1]) > 0 and #redis.call('XRANGE', KEYS[1], '-', ARGV[3], 'COUNT', 1) == 0 then
+	return -1
+end
+return redis.call('XRANGE', KEYS[1], ARGV[1], '+', 'COUNT', ARGV[2])
+`
+
+// StreamEvent is an event read from a redis stream with the ID of its entry
+type StreamEvent struct {
+	StreamID string
+	Event    *model.ScoreEvent
+}
+
+// RedisStreamReader reads events appended to a redis stream by RedisStreamSink, in the order they were appended
+type RedisStreamReader struct {
+	Client redis.Client
+	Stream string
+}
+
+// NewRedisStreamReader create a new RedisStreamReader
+func NewRedisStreamReader(client redis.Client, stream string) *RedisStreamReader {
+	return &RedisStreamReader{
+		Client: client,
+		Stream: stream,
+	}
+}
+
+// Read return up to count events appended after the entry with ID afterID, or from the first entry of the
+// stream if it is empty. It returns StreamTrimmedError if the entry with afterID was trimmed from the stream
+// by RedisStreamSink.MaxLen, as events appended after it could have been trimmed before being read
+func (r *RedisStreamReader) Read(ctx context.Context, afterID string, count int) ([]*StreamEvent, error) {
+	start := "-"
+	if afterID != "" {
+		var err error
+		start, err = nextStreamID(afterID)
+		if err != nil {
+			return nil, NewGeneralError(redisStreamSinkName, err.Error())
+		}
+	}
+
+	result, err := r.Client.Eval(ctx, readEventsScript, []string{r.Stream}, start, strconv.Itoa(count), afterID)
+	if err != nil {
+		return nil, NewGeneralError(redisStreamSinkName, err.Error())
+	}
+
+	if result == int64(-1) {
+		return nil, NewStreamTrimmedError(r.Stream, afterID)
+	}
+
+	entries, _ := result.([]interface{})
+	streamEvents := make([]*StreamEvent, 0, len(entries))
+	for _, entry := range entries {
+		streamEvent, err := parseStreamEntry(entry)
+		if err != nil {
+			return nil, NewGeneralError(redisStreamSinkName, err.Error())
+		}
+		streamEvents = append(streamEvents, streamEvent)
+	}
+
+	return streamEvents, nil
+}
+
+// parseStreamEntry parse an entry returned by XRANGE, an array of its ID and an array of fields and values
+func parseStreamEntry(entry interface{}) (*StreamEvent, error) {
+	values, ok := entry.([]interface{})
+	if !ok || len(values) != 2 {
+		return nil, fmt.Errorf("invalid stream entry %v", entry)
+	}
+
+	streamID := fmt.Sprint(values[0])
+	fields, _ := values[1].([]interface{})
+	for i := 0; i+1 < len(fields); i += 2 {
+		if fmt.Sprint(fields[i]) != "event" {
+			continue
+		}
+
+		event := &model.ScoreEvent{}
+		err := json.Unmarshal([]byte(fmt.Sprint(fields[i+1])), event)
+		if err != nil {
+			return nil, fmt.Errorf("invalid event of stream entry %s: %s", streamID, err.Error())
+		}
+
+		return &StreamEvent{StreamID: streamID, Event: event}, nil
+	}
+
+	return nil, fmt.Errorf("stream entry %s has no event", streamID)
+}
+
+// nextStreamID return the smallest stream ID greater than streamID, as exclusive ranges need redis 6.2
+func nextStreamID(streamID string) (string, error) {
+	parts := strings.SplitN(streamID, "-", 2)
+	milliseconds, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid stream ID %s", streamID)
+	}
+
+	if len(parts) == 1 {
+		return fmt.Sprintf("%d-1", milliseconds), nil
+	}
+
+	sequence, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid stream ID %s", streamID)
+	}
+
+	if sequence == ^uint64(0) {
+		return fmt.Sprintf("%d-0", milliseconds+1), nil
+	}
+
+	return fmt.Sprintf("%d-%d", milliseconds, sequence+1), nil
+}
@@ -0,0 +1,87 @@
+package events_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+	"github.com/topfreegames/podium/leaderboard/v2/events"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+var _ = Describe("Redis Stream Reader", func() {
+	var ctrl *gomock.Controller
+	var mock *redis.MockRedis
+	var redisStreamReader *events.RedisStreamReader
+
+	newScore := int64(10)
+	scoreEvent := &model.ScoreEvent{ID: "event1", Leaderboard: "leaderboardTest", PublicID: "member1", NewScore: &newScore, OldRank: -1, NewRank: 1}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = redis.NewMockRedis(ctrl)
+
+		redisStreamReader = events.NewRedisStreamReader(mock, "podium:events")
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should read events from the first entry of the stream", func() {
+		payload, err := json.Marshal(scoreEvent)
+		Expect(err).NotTo(HaveOccurred())
+
+		mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"podium:events"}), gomock.Eq("-"), gomock.Eq("10"), gomock.Eq("")).
+			Return([]interface{}{
+				[]interface{}{"1600000000000-0", []interface{}{"event", string(payload)}},
+			}, nil)
+
+		streamEvents, err := redisStreamReader.Read(context.Background(), "", 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(streamEvents).To(Equal([]*events.StreamEvent{{StreamID: "1600000000000-0", Event: scoreEvent}}))
+	})
+
+	It("Should read events after the entry with afterID", func() {
+		mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"podium:events"}), gomock.Eq("1600000000000-3"), gomock.Eq("10"), gomock.Eq("1600000000000-2")).
+			Return(nil, nil)
+
+		streamEvents, err := redisStreamReader.Read(context.Background(), "1600000000000-2", 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(streamEvents).To(BeEmpty())
+	})
+
+	It("Should return StreamTrimmedError if the entry with afterID was trimmed", func() {
+		mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"podium:events"}), gomock.Eq("1600000000000-3"), gomock.Eq("10"), gomock.Eq("1600000000000-2")).
+			Return(int64(-1), nil)
+
+		_, err := redisStreamReader.Read(context.Background(), "1600000000000-2", 10)
+		Expect(err).To(Equal(events.NewStreamTrimmedError("podium:events", "1600000000000-2")))
+	})
+
+	It("Should return GeneralError if an entry has no event", func() {
+		mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]interface{}{
+				[]interface{}{"1600000000000-0", []interface{}{"other", "value"}},
+			}, nil)
+
+		_, err := redisStreamReader.Read(context.Background(), "", 10)
+		Expect(err).To(Equal(events.NewGeneralError("redis stream", "stream entry 1600000000000-0 has no event")))
+	})
+
+	It("Should return GeneralError if afterID is invalid", func() {
+		_, err := redisStreamReader.Read(context.Background(), "invalid", 10)
+		Expect(err).To(Equal(events.NewGeneralError("redis stream", "invalid stream ID invalid")))
+	})
+
+	It("Should return GeneralError if redis return in error", func() {
+		mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+		_, err := redisStreamReader.Read(context.Background(), "", 10)
+		Expect(err).To(Equal(events.NewGeneralError("redis stream", "redis error")))
+	})
+})
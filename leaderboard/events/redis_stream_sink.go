@@ -0,0 +1,67 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const redisStreamSinkName = "redis stream"
+
+// appendEventsScript applies XADD of each ARGV after the first to stream KEYS[1] in field "event",
+// trimming the stream to about ARGV[1] entries when it is not zero
+const appendEventsScript = `
+for i = 2, #ARGV do
+	if ARGV[1] ~= '0' then
+		redis.call('XADD', KEYS[1], 'MAXLEN', '~', ARGV[1], '*', 'event', ARGV[i])
+	else
+		redis.call('XADD', KEYS[1], '*', 'event', ARGV[i])
+	end
+end
+return #ARGV - 1
+`
+
+// RedisStreamSink appends events to a redis stream as JSON in field "event". Consumers read them
+// with XREAD or consumer groups
+type RedisStreamSink struct {
+	Client redis.Client
+	Stream string
+	// MaxLen is about how many events the stream keeps, zero keeps all of them
+	MaxLen int64
+}
+
+// NewRedisStreamSink create a new RedisStreamSink
+func NewRedisStreamSink(client redis.Client, stream string, maxLen int64) *RedisStreamSink {
+	return &RedisStreamSink{
+		Client: client,
+		Stream: stream,
+		MaxLen: maxLen,
+	}
+}
+
+// Send append events to the stream in a single script, so they are all appended or none is
+func (r *RedisStreamSink) Send(ctx context.Context, events []*model.ScoreEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	args := make([]interface{}, 0, len(events)+1)
+	args = append(args, strconv.FormatInt(r.MaxLen, 10))
+	for _, event := range events {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return NewGeneralError(redisStreamSinkName, err.Error())
+		}
+		args = append(args, string(payload))
+	}
+
+	_, err := r.Client.Eval(ctx, appendEventsScript, []string{r.Stream}, args...)
+	if err != nil {
+		return NewGeneralError(redisStreamSinkName, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package events_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+	"github.com/topfreegames/podium/leaderboard/v2/events"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+var _ = Describe("Redis Stream Sink", func() {
+	var ctrl *gomock.Controller
+	var mock *redis.MockRedis
+	var redisStreamSink *events.RedisStreamSink
+
+	newScore := int64(10)
+	scoreEvents := []*model.ScoreEvent{
+		{ID: "event1", Leaderboard: "leaderboardTest", PublicID: "member1", NewScore: &newScore, OldRank: -1, NewRank: 1},
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = redis.NewMockRedis(ctrl)
+
+		redisStreamSink = events.NewRedisStreamSink(mock, "podium:events", 1000)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should append events to the stream as JSON", func() {
+		payload, err := json.Marshal(scoreEvents[0])
+		Expect(err).NotTo(HaveOccurred())
+
+		mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"podium:events"}), gomock.Eq("1000"), gomock.Eq(string(payload))).Return(int64(1), nil)
+
+		err = redisStreamSink.Send(context.Background(), scoreEvents)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should not call redis if there are no events", func() {
+		err := redisStreamSink.Send(context.Background(), []*model.ScoreEvent{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should return GeneralError if redis return in error", func() {
+		mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+		err := redisStreamSink.Send(context.Background(), scoreEvents)
+		Expect(err).To(Equal(events.NewGeneralError("redis stream", "redis error")))
+	})
+})
@@ -0,0 +1,14 @@
+package events
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// EventSink publishes score events of leaderboard writes to downstream systems. Send must return
+// only after events are published, so a failed Send can be retried without losing them, and events
+// can be published more than once, so consumers must deduplicate them by ID
+type EventSink interface {
+	Send(ctx context.Context, events []*model.ScoreEvent) error
+}
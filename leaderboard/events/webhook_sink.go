@@ -0,0 +1,45 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/delivery"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const webhookSinkName = "webhook"
+
+// WebhookSink posts events to an HTTP endpoint as a JSON object with field "events" with a delivery.Webhook,
+// which retries requests that fail or are not answered with a 2xx status. Requests are not signed
+type WebhookSink struct {
+	*delivery.Webhook
+}
+
+type webhookPayload struct {
+	Events []*model.ScoreEvent `json:"events"`
+}
+
+// NewWebhookSink create a new WebhookSink whose requests timeout after timeout
+func NewWebhookSink(url string, timeout time.Duration, maxRetries int, retryBackoff time.Duration) *WebhookSink {
+	return &WebhookSink{delivery.NewWebhook(url, "", timeout, maxRetries, retryBackoff)}
+}
+
+// Send post events to the webhook retrying it on failures
+func (w *WebhookSink) Send(ctx context.Context, events []*model.ScoreEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(&webhookPayload{Events: events})
+	if err != nil {
+		return NewGeneralError(webhookSinkName, err.Error())
+	}
+
+	err = w.Post(ctx, payload, nil)
+	if err != nil {
+		return NewGeneralError(webhookSinkName, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package events_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/events"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+var _ = Describe("Webhook Sink", func() {
+	var server *httptest.Server
+	var requests int32
+	var failures int32
+	var received []*model.ScoreEvent
+
+	scoreEvents := []*model.ScoreEvent{
+		{ID: "event1", Leaderboard: "leaderboardTest", PublicID: "member1", OldRank: -1, NewRank: 1},
+	}
+
+	BeforeEach(func() {
+		requests = 0
+		failures = 0
+		received = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			if atomic.AddInt32(&requests, 1) <= atomic.LoadInt32(&failures) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			var payload struct {
+				Events []*model.ScoreEvent `json:"events"`
+			}
+			Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+			Expect(json.NewDecoder(r.Body).Decode(&payload)).To(Succeed())
+			received = payload.Events
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("Should post events to the webhook", func() {
+		webhookSink := events.NewWebhookSink(server.URL, time.Second, 0, time.Millisecond)
+
+		err := webhookSink.Send(context.Background(), scoreEvents)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(received).To(Equal(scoreEvents))
+	})
+
+	It("Should retry failed requests", func() {
+		failures = 2
+		webhookSink := events.NewWebhookSink(server.URL, time.Second, 2, time.Millisecond)
+
+		err := webhookSink.Send(context.Background(), scoreEvents)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requests).To(Equal(int32(3)))
+		Expect(received).To(Equal(scoreEvents))
+	})
+
+	It("Should return GeneralError if all retries fail", func() {
+		failures = 3
+		webhookSink := events.NewWebhookSink(server.URL, time.Second, 2, time.Millisecond)
+
+		err := webhookSink.Send(context.Background(), scoreEvents)
+		Expect(err).To(Equal(events.NewGeneralError("webhook", "unexpected status 503")))
+		Expect(requests).To(Equal(int32(3)))
+	})
+})
@@ -11,12 +11,24 @@ package leaderboard_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/topfreegames/podium/leaderboard/v2/database"
 	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+	"github.com/topfreegames/podium/leaderboard/v2/delivery"
+	"github.com/topfreegames/podium/leaderboard/v2/events"
+	"github.com/topfreegames/podium/leaderboard/v2/lifecycle"
+	"github.com/topfreegames/podium/leaderboard/v2/milestones"
 	"github.com/topfreegames/podium/leaderboard/v2/testing"
 
 	. "github.com/onsi/ginkgo"
@@ -66,10 +78,10 @@ var _ = Describe("Leaderboard integration tests", func() {
 	Describe("setting member scores", func() {
 		It("should set scores and return ranks", func() {
 			dayvson, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID,
-				"dayvson", 481516, false, "")
+				"dayvson", 481516, false, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 			arthur, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID,
-				"arthur", 1000, false, "")
+				"arthur", 1000, false, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(err).NotTo(HaveOccurred())
 			Expect(dayvson.Rank).To(Equal(1))
@@ -79,7 +91,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should set score expiration if expiry field is passed", func() {
 			ttl := "100"
 			_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID,
-				"denix", 481516, false, ttl)
+				"denix", 481516, false, ttl, nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 			redisLBExpirationKey := fmt.Sprintf("%s:ttl", testLeaderboardID)
 			err = redisDatabase.Exists(context.Background(), redisLBExpirationKey)
@@ -99,17 +111,17 @@ var _ = Describe("Leaderboard integration tests", func() {
 
 		It("should set scores and return previous ranks", func() {
 			member1, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member1",
-				481516, true, "")
+				481516, true, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 			member2, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member2",
-				1000, false, "")
+				1000, false, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(member1.Rank).To(Equal(1))
 			Expect(member1.PreviousRank).To(Equal(-1))
 			Expect(member2.Rank).To(Equal(2))
 			Expect(member2.PreviousRank).To(Equal(0))
 			nmember1, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member1",
-				1, true, "")
+				1, true, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(nmember1.Rank).To(Equal(2))
 			Expect(nmember1.PreviousRank).To(Equal(1))
@@ -117,7 +129,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 
 		It("should fail if invalid connection to Redis", func() {
 			_, err := faultyLeaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "dayvson",
-				481516, false, "")
+				481516, false, "", nil, nil)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("connection refused"))
 		})
@@ -129,7 +141,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 				{Score: 481516, PublicID: "dayvson"},
 				{Score: 1000, PublicID: "arthur"},
 			}
-			err := leaderboards.SetMembersScore(NewEmptyCtx(), testLeaderboardID, members, false, "")
+			err := leaderboards.SetMembersScore(NewEmptyCtx(), testLeaderboardID, members, false, "", nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(members[0].PublicID).To(Equal("dayvson"))
 			Expect(members[0].Rank).To(Equal(1))
@@ -145,7 +157,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 				{Score: 481516, PublicID: "denix1"},
 				{Score: 481516, PublicID: "denix2"},
 			}
-			err := leaderboards.SetMembersScore(NewEmptyCtx(), testLeaderboardID, members, false, ttl)
+			err := leaderboards.SetMembersScore(NewEmptyCtx(), testLeaderboardID, members, false, ttl, nil)
 			Expect(err).NotTo(HaveOccurred())
 			redisLBExpirationKey := fmt.Sprintf("%s:ttl", testLeaderboardID)
 			err = redisDatabase.Exists(context.Background(), redisLBExpirationKey)
@@ -170,7 +182,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 				{Score: 481516, PublicID: "member1"},
 				{Score: 1000, PublicID: "member2"},
 			}
-			err := leaderboards.SetMembersScore(NewEmptyCtx(), testLeaderboardID, members, true, "")
+			err := leaderboards.SetMembersScore(NewEmptyCtx(), testLeaderboardID, members, true, "", nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(members[0].Rank).To(Equal(1))
 			Expect(members[0].PreviousRank).To(Equal(-1))
@@ -180,7 +192,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 				{Score: 1, PublicID: "member1"},
 				{Score: 500, PublicID: "member2"},
 			}
-			err = leaderboards.SetMembersScore(NewEmptyCtx(), testLeaderboardID, members, true, "")
+			err = leaderboards.SetMembersScore(NewEmptyCtx(), testLeaderboardID, members, true, "", nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(members[0].Rank).To(Equal(2))
 			Expect(members[0].PreviousRank).To(Equal(1))
@@ -189,7 +201,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		})
 
 		It("should fail if invalid connection to Redis", func() {
-			err := faultyLeaderboards.SetMembersScore(NewEmptyCtx(), testLeaderboardID, []*model.Member{}, false, "")
+			err := faultyLeaderboards.SetMembersScore(NewEmptyCtx(), testLeaderboardID, []*model.Member{}, false, "", nil)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("connection refused"))
 		})
@@ -199,10 +211,10 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should increment member score and return ranks", func() {
 			lbID := uuid.NewV4().String()
 
-			_, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "dayvson", 1000, false, "")
+			_, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "dayvson", 1000, false, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 
-			member, err := leaderboards.IncrementMemberScore(NewEmptyCtx(), lbID, "dayvson", 10, "")
+			member, err := leaderboards.IncrementMemberScore(NewEmptyCtx(), lbID, "dayvson", 10, "", nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(member.Score).To(Equal(int64(1010)))
 			Expect(member.PublicID).To(Equal("dayvson"))
@@ -215,7 +227,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should increment member score when leaderboard does not exist and return ranks", func() {
 			lbID := uuid.NewV4().String()
 
-			member, err := leaderboards.IncrementMemberScore(NewEmptyCtx(), lbID, "dayvson", 10, "")
+			member, err := leaderboards.IncrementMemberScore(NewEmptyCtx(), lbID, "dayvson", 10, "", nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(member.Score).To(Equal(int64(10)))
 			Expect(member.PublicID).To(Equal("dayvson"))
@@ -226,7 +238,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		})
 
 		It("should fail if invalid connection to Redis", func() {
-			_, err := faultyLeaderboards.IncrementMemberScore(NewEmptyCtx(), testLeaderboardID, "dayvson", 16, "")
+			_, err := faultyLeaderboards.IncrementMemberScore(NewEmptyCtx(), testLeaderboardID, "dayvson", 16, "", nil)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("connection refused"))
 		})
@@ -235,7 +247,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 	Describe("getting number of members", func() {
 		It("should retrieve the number of members in a leaderboard", func() {
 			for i := 0; i < 10; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 			count, err := leaderboards.TotalMembers(NewEmptyCtx(), testLeaderboardID)
@@ -254,7 +266,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should remove member", func() {
 			for i := 0; i < 10; i++ {
 				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i),
-					int64(1234*i), false, "")
+					int64(1234*i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 			Expect(leaderboards.TotalMembers(NewEmptyCtx(), testLeaderboardID)).To(Equal(10))
@@ -267,7 +279,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should remove many members", func() {
 			for i := 0; i < 10; i++ {
 				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i),
-					int64(1234*i), false, "")
+					int64(1234*i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 			Expect(leaderboards.TotalMembers(NewEmptyCtx(), testLeaderboardID)).To(Equal(10))
@@ -292,7 +304,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should return total number of pages", func() {
 			for i := 0; i < 101; i++ {
 				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i),
-					int64(1234*i), false, "")
+					int64(1234*i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 			Expect(leaderboards.TotalPages(NewEmptyCtx(), testLeaderboardID, 25)).To(Equal(5))
@@ -308,13 +320,13 @@ var _ = Describe("Leaderboard integration tests", func() {
 	Describe("getting member details for a given leaderboard", func() {
 		It("should return member details", func() {
 			lbID := uuid.NewV4().String()
-			dayvson, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "dayvson", 12345, false, "")
+			dayvson, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "dayvson", 12345, false, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
-			felipe, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "felipe", 12344, false, "")
+			felipe, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "felipe", 12344, false, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(dayvson.Rank).To(Equal(1))
 			Expect(felipe.Rank).To(Equal(2))
-			leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "felipe", 12346, false, "")
+			leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "felipe", 12346, false, "", nil, nil)
 			felipe, err = leaderboards.GetMember(NewEmptyCtx(), lbID, "felipe", "desc", false)
 			Expect(err).NotTo(HaveOccurred())
 			dayvson, err = leaderboards.GetMember(NewEmptyCtx(), lbID, "dayvson", "desc", false)
@@ -325,13 +337,13 @@ var _ = Describe("Leaderboard integration tests", func() {
 
 		It("should return member details including score expiration", func() {
 			lbID := uuid.NewV4().String()
-			dayvson, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "dayvson", 12345, false, "10")
+			dayvson, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "dayvson", 12345, false, "10", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
-			felipe, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "felipe", 12344, false, "")
+			felipe, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "felipe", 12344, false, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(dayvson.Rank).To(Equal(1))
 			Expect(felipe.Rank).To(Equal(2))
-			leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "felipe", 12346, false, "")
+			leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "felipe", 12346, false, "", nil, nil)
 			felipe, err = leaderboards.GetMember(NewEmptyCtx(), lbID, "felipe", "desc", true)
 			Expect(err).NotTo(HaveOccurred())
 			dayvson, err = leaderboards.GetMember(NewEmptyCtx(), lbID, "dayvson", "desc", true)
@@ -375,7 +387,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should get members around specific member", func() {
 			pageSize := 25
 			for i := 0; i < 101; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 			members, err := leaderboards.GetAroundMe(NewEmptyCtx(), testLeaderboardID, pageSize, "member_20", "desc", false)
@@ -390,7 +402,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should always return page size members when page size is less than total members", func() {
 			pageSize := 3
 			for i := 0; i < 5; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -406,7 +418,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should get members around specific member in reverse order", func() {
 			pageSize := 20
 			for i := 0; i < 101; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 			members, err := leaderboards.GetAroundMe(NewEmptyCtx(), testLeaderboardID, pageSize, "member_20", "asc", false)
@@ -421,7 +433,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should get members around specific member if repeated scores", func() {
 			pageSize := 25
 			for i := 0; i < 101; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 			members, err := leaderboards.GetAroundMe(NewEmptyCtx(), testLeaderboardID, pageSize, "member_20", "desc", false)
@@ -436,7 +448,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should get PageSize members around specific member even if member in ranking top", func() {
 			pageSize := 25
 			for i := 1; i <= 100; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(100-i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(100-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 			members, err := leaderboards.GetAroundMe(NewEmptyCtx(), testLeaderboardID, pageSize, "member_2", "desc", false)
@@ -451,7 +463,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should get PageSize members around specific member even if member in ranking bottom", func() {
 			pageSize := 25
 			for i := 1; i <= 100; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(100-i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(100-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 			members, err := leaderboards.GetAroundMe(NewEmptyCtx(), testLeaderboardID, pageSize, "member_99", "desc", false)
@@ -465,7 +477,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 
 		It("should get PageSize members when interval larger than total members", func() {
 			for i := 1; i <= 10; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(100-i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(100-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 			members, err := leaderboards.GetAroundMe(NewEmptyCtx(), testLeaderboardID, 25, "member_2", "desc", false)
@@ -488,7 +500,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should get members around specific score", func() {
 			pageSize := 25
 			for i := 0; i < 101; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 			members, err := leaderboards.GetAroundScore(NewEmptyCtx(), testLeaderboardID, pageSize, 1234*20, "desc")
@@ -503,7 +515,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should always return page size members when page size is less than total members", func() {
 			pageSize := 3
 			for i := 0; i < 5; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -519,7 +531,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should get members around specific score reverse order", func() {
 			pageSize := 20
 			for i := 0; i < 101; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 			members, err := leaderboards.GetAroundScore(NewEmptyCtx(), testLeaderboardID, pageSize, 1234*20, "asc")
@@ -534,7 +546,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should get last members if score <= 0", func() {
 			pageSize := 25
 			for i := 0; i < 101; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 			members, err := leaderboards.GetAroundScore(NewEmptyCtx(), testLeaderboardID, pageSize, -50, "desc")
@@ -549,7 +561,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should get top members if score > max score in leaderboard", func() {
 			pageSize := 25
 			for i := 0; i < 101; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 			members, err := leaderboards.GetAroundScore(NewEmptyCtx(), testLeaderboardID, pageSize, 1234*200, "desc")
@@ -571,19 +583,19 @@ var _ = Describe("Leaderboard integration tests", func() {
 	Describe("getting member ranking", func() {
 		It("should return specific member ranking", func() {
 			for i := 0; i < 101; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
-			leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_6", 1000, false, "")
+			leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_6", 1000, false, "", nil, nil)
 			Expect(leaderboards.GetRank(NewEmptyCtx(), testLeaderboardID, "member_6", "desc")).To(Equal(100))
 		})
 
 		It("should return specific member ranking if asc order", func() {
 			for i := 0; i < 101; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), int64(1234*i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
-			leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_6", 1000, false, "")
+			leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_6", 1000, false, "", nil, nil)
 			Expect(leaderboards.GetRank(NewEmptyCtx(), testLeaderboardID, "member_6", "asc")).To(Equal(2))
 		})
 
@@ -606,7 +618,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should get specific number of leaders", func() {
 			pageSize := 25
 			for i := 0; i < 1000; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i+1), int64(1234*i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i+1), int64(1234*i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 			members, err := leaderboards.GetLeaders(NewEmptyCtx(), testLeaderboardID, pageSize, 1, "desc")
@@ -624,7 +636,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should get specific number of leaders in reverse order", func() {
 			pageSize := 25
 			for i := 0; i < 1000; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i+1), int64(1234*i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i+1), int64(1234*i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 			members, err := leaderboards.GetLeaders(NewEmptyCtx(), testLeaderboardID, pageSize, 1, "asc")
@@ -642,7 +654,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should get leaders if repeated scores", func() {
 			pageSize := 25
 			for i := 0; i < 101; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 			members, err := leaderboards.GetLeaders(NewEmptyCtx(), testLeaderboardID, pageSize, 1, "desc")
@@ -657,7 +669,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should get leaders for negative pages get page 1", func() {
 			pageSize := 25
 			for i := 0; i < 101; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 			members, err := leaderboards.GetLeaders(NewEmptyCtx(), testLeaderboardID, pageSize, -1, "desc")
@@ -671,7 +683,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 
 		It("should get empty leaders for pages greater than total pages", func() {
 			for i := 0; i < 101; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), testLeaderboardID, "member_"+strconv.Itoa(i), 100, false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 			members, err := leaderboards.GetLeaders(NewEmptyCtx(), testLeaderboardID, 25, 99999, "desc")
@@ -690,14 +702,14 @@ var _ = Describe("Leaderboard integration tests", func() {
 	Describe("expiration of leaderboards", func() {
 		It("should fail if invalid leaderboard", func() {
 			leaderboardID := "leaderboard_from20201039to20201011"
-			_, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "dayvson", 12345, false, "")
+			_, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "dayvson", 12345, false, "", nil, nil)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("day out of range"))
 		})
 
 		It("should add yearly expiration if leaderboard supports it", func() {
 			leaderboardID := fmt.Sprintf("test-leaderboard-year%d", time.Now().UTC().Year())
-			_, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "dayvson", 12345, false, "")
+			_, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "dayvson", 12345, false, "", nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			result, err := redisDatabase.TTL(context.Background(), leaderboardID)
@@ -714,7 +726,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 			leaderboardID := uuid.NewV4().String()
 			members := []*model.Member{}
 			for i := 0; i < 100; i++ {
-				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), int64((100-i)*100), false, "")
+				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), int64((100-i)*100), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 				members = append(members, member)
 			}
@@ -738,7 +750,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 
 			members := []*model.Member{}
 			for i := 0; i < 100; i++ {
-				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), int64((100-i)*100), false, "")
+				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), int64((100-i)*100), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 				members = append(members, member)
 			}
@@ -762,7 +774,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 
 			members := []*model.Member{}
 			for i := 0; i < 100; i++ {
-				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), int64((100-i)*100), false, "")
+				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), int64((100-i)*100), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 				members = append(members, member)
 			}
@@ -786,7 +798,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 
 			members := []*model.Member{}
 			for i := 0; i < 10; i++ {
-				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), int64((100-i)*100), false, "")
+				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), int64((100-i)*100), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 				members = append(members, member)
 			}
@@ -810,7 +822,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 
 			members := []*model.Member{}
 			for i := 0; i < 2; i++ {
-				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), int64((100-i)*100), false, "")
+				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), int64((100-i)*100), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 				members = append(members, member)
 			}
@@ -830,7 +842,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 
 			members := []*model.Member{}
 			for i := 0; i < 100; i++ {
-				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), 100, false, "")
+				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), 100, false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 				members = append(members, member)
 			}
@@ -870,7 +882,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 
 			expMembers := []*model.Member{}
 			for i := 0; i < 100; i++ {
-				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), int64(100-i), false, "")
+				member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), int64(100-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 				expMembers = append(expMembers, member)
 			}
@@ -897,7 +909,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 			leaderboardID := uuid.NewV4().String()
 
 			for i := 0; i < 10; i++ {
-				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), int64(100-i), false, "")
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, fmt.Sprintf("friend-%d", i), int64(100-i), false, "", nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -920,7 +932,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should return all member details", func() {
 			lbID := uuid.NewV4().String()
 			for i := 0; i < 100; i++ {
-				leaderboards.SetMemberScore(NewEmptyCtx(), lbID, fmt.Sprintf("member-%d", i), int64(100-i), false, "")
+				leaderboards.SetMemberScore(NewEmptyCtx(), lbID, fmt.Sprintf("member-%d", i), int64(100-i), false, "", nil, nil)
 			}
 
 			members, err := leaderboards.GetMembers(NewEmptyCtx(), lbID, []string{"member-10", "member-30", "member-20"}, "desc", false)
@@ -943,7 +955,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 		It("should return all member details using reverse rank", func() {
 			lbID := uuid.NewV4().String()
 			for i := 0; i < 100; i++ {
-				leaderboards.SetMemberScore(NewEmptyCtx(), lbID, fmt.Sprintf("member-%d", i), int64(100-i), false, "")
+				leaderboards.SetMemberScore(NewEmptyCtx(), lbID, fmt.Sprintf("member-%d", i), int64(100-i), false, "", nil, nil)
 			}
 
 			members, err := leaderboards.GetMembers(NewEmptyCtx(), lbID, []string{"member-10", "member-30", "member-20"}, "asc", false)
@@ -970,7 +982,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 				if i%30 == 0 {
 					ttl = "15"
 				}
-				leaderboards.SetMemberScore(NewEmptyCtx(), lbID, fmt.Sprintf("member-%d", i), int64(100-i), false, ttl)
+				leaderboards.SetMemberScore(NewEmptyCtx(), lbID, fmt.Sprintf("member-%d", i), int64(100-i), false, ttl, nil, nil)
 			}
 
 			members, err := leaderboards.GetMembers(NewEmptyCtx(), lbID, []string{"member-10", "member-30", "member-20"}, "desc", true)
@@ -1005,7 +1017,7 @@ var _ = Describe("Leaderboard integration tests", func() {
 			lbID := uuid.NewV4().String()
 
 			for i := 0; i < 10; i++ {
-				leaderboards.SetMemberScore(NewEmptyCtx(), lbID, fmt.Sprintf("member-%d", i), int64(100-i), false, "")
+				leaderboards.SetMemberScore(NewEmptyCtx(), lbID, fmt.Sprintf("member-%d", i), int64(100-i), false, "", nil, nil)
 			}
 
 			members, err := leaderboards.GetMembers(NewEmptyCtx(), lbID, []string{"member-0", "invalid-member"}, "desc", false)
@@ -1025,4 +1037,1387 @@ var _ = Describe("Leaderboard integration tests", func() {
 		})
 	})
 
+	Describe("resetting leaderboards", func() {
+		It("should compress scores toward base", func() {
+			lbID := uuid.NewV4().String()
+
+			for i := 0; i < 10; i++ {
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, fmt.Sprintf("member-%d", i), int64(1000+100*i), false, "", nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			progress, err := leaderboards.ResetLeaderboard(NewEmptyCtx(), lbID, &model.ResetOptions{
+				Strategy:  model.ResetStrategyCompress,
+				Base:      1000,
+				Factor:    0.5,
+				ChunkSize: 3,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progress.Status).To(Equal(model.ResetStatusDone))
+			Expect(progress.Processed).To(Equal(10))
+
+			member, err := leaderboards.GetMember(NewEmptyCtx(), lbID, "member-9", "desc", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(1450)))
+			Expect(member.Rank).To(Equal(1))
+
+			savedProgress, err := leaderboards.GetResetProgress(NewEmptyCtx(), lbID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(savedProgress).To(Equal(progress))
+		})
+
+		It("should increment versions and record ledgers of members reset", func() {
+			lbID := uuid.NewV4().String()
+
+			_, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "member-1", 2000, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = leaderboards.ResetLeaderboard(NewEmptyCtx(), lbID, &model.ResetOptions{
+				Strategy: model.ResetStrategyCompress,
+				Base:     1000,
+				Factor:   0.5,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			version := int64(2)
+			member, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "member-1", 10, false, "", nil, &model.ScoreCondition{ExpectedVersion: &version})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Version).To(Equal(int64(3)))
+
+			entries, err := leaderboards.GetMemberLedger(NewEmptyCtx(), lbID, "member-1", 0, 10, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(3))
+			Expect(*entries[1].OldScore).To(Equal(int64(2000)))
+			Expect(*entries[1].NewScore).To(Equal(int64(1500)))
+		})
+
+		It("should carry top K members into next season leaderboard", func() {
+			lbID := uuid.NewV4().String()
+			nextSeason := uuid.NewV4().String()
+
+			for i := 0; i < 10; i++ {
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), lbID, fmt.Sprintf("member-%d", i), int64(100-i), false, "", nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			_, err := leaderboards.ResetLeaderboard(NewEmptyCtx(), lbID, &model.ResetOptions{
+				Strategy: model.ResetStrategyTopK,
+				Target:   nextSeason,
+				TopK:     3,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			count, err := leaderboards.TotalMembers(NewEmptyCtx(), nextSeason)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(3))
+
+			count, err = leaderboards.TotalMembers(NewEmptyCtx(), lbID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(10))
+		})
+
+		It("should fail with faulty redis", func() {
+			lbID := uuid.NewV4().String()
+			_, err := faultyLeaderboards.ResetLeaderboard(NewEmptyCtx(), lbID, &model.ResetOptions{Strategy: model.ResetStrategyZero})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("connection refused"))
+		})
+	})
+
+	Describe("decaying leaderboards", func() {
+		It("should return scores decayed by half-life", func() {
+			lbID := uuid.NewV4().String()
+
+			settings, err := leaderboards.UpdateLeaderboardSettings(NewEmptyCtx(), lbID, &model.LeaderboardSettings{DecayHalfLife: 3600})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(settings.DecayHalfLife).To(Equal(int64(3600)))
+
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "member-1", 100, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = leaderboards.IncrementMemberScore(NewEmptyCtx(), lbID, "member-1", 10, "", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			member, err := leaderboards.GetMember(NewEmptyCtx(), lbID, "member-1", "desc", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(110)))
+
+			// moving landmark one half-life back makes every stored score worth half
+			err = redisDatabase.SetLeaderboardSettings(NewEmptyCtx(), lbID, map[string]string{
+				"decayLandmark": strconv.FormatInt(settings.DecayLandmark-3600, 10),
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "member-2", 100, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			members, err := leaderboards.GetLeaders(NewEmptyCtx(), lbID, 10, 1, "desc")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(members).To(HaveLen(2))
+			Expect(members[0].PublicID).To(Equal("member-2"))
+			Expect(members[0].Score).To(Equal(int64(100)))
+			Expect(members[1].PublicID).To(Equal("member-1"))
+			Expect(members[1].Score).To(Equal(int64(55)))
+		})
+
+		It("should keep decayed scores when renormalizing", func() {
+			lbID := uuid.NewV4().String()
+
+			settings, err := leaderboards.UpdateLeaderboardSettings(NewEmptyCtx(), lbID, &model.LeaderboardSettings{DecayHalfLife: 60})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), lbID, "member-1", 1000000, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = redisDatabase.SetLeaderboardSettings(NewEmptyCtx(), lbID, map[string]string{
+				"decayLandmark": strconv.FormatInt(settings.DecayLandmark-600, 10),
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			before, err := leaderboards.GetMember(NewEmptyCtx(), lbID, "member-1", "desc", false)
+			Expect(err).NotTo(HaveOccurred())
+
+			renormalized, err := leaderboards.RenormalizeLeaderboard(NewEmptyCtx(), lbID, 5)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(renormalized).To(BeTrue())
+
+			after, err := leaderboards.GetMember(NewEmptyCtx(), lbID, "member-1", "desc", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(before.Score).To(Equal(int64(977)))
+			Expect(after.Score).To(Equal(before.Score))
+
+			decaying, err := redisDatabase.GetDecayLeaderboards(NewEmptyCtx())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decaying).To(ContainElement(lbID))
+		})
+
+		It("should fail with faulty redis", func() {
+			lbID := uuid.NewV4().String()
+			_, err := faultyLeaderboards.GetLeaderboardSettings(NewEmptyCtx(), lbID)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("connection refused"))
+		})
+	})
+
+	Describe("leagues", func() {
+		It("should fill divisions and move members between tiers at season end", func() {
+			leagueID := uuid.NewV4().String()
+
+			_, err := leaderboards.CreateLeague(NewEmptyCtx(), &model.League{
+				ID:              leagueID,
+				Tiers:           2,
+				DivisionSize:    3,
+				PromotionCount:  1,
+				RelegationCount: 1,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			for i := 0; i < 3; i++ {
+				division, err := leaderboards.JoinLeague(NewEmptyCtx(), leagueID, fmt.Sprintf("top-%d", i), 1)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(division.Division).To(Equal(1))
+				_, err = leaderboards.SetMemberScore(NewEmptyCtx(), division.Leaderboard, fmt.Sprintf("top-%d", i), int64(100-i), false, "", nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			for i := 0; i < 4; i++ {
+				division, err := leaderboards.JoinLeague(NewEmptyCtx(), leagueID, fmt.Sprintf("bottom-%d", i), 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(division.Tier).To(Equal(2))
+				Expect(division.Division).To(Equal(i/3 + 1))
+				_, err = leaderboards.SetMemberScore(NewEmptyCtx(), division.Leaderboard, fmt.Sprintf("bottom-%d", i), int64(100-i), false, "", nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			division, err := leaderboards.JoinLeague(NewEmptyCtx(), leagueID, "bottom-0", 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(division.Division).To(Equal(1))
+
+			division, err = leaderboards.GetLeagueDivision(NewEmptyCtx(), leagueID, "bottom-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(division.Members).To(HaveLen(3))
+			Expect(division.Members[1].PublicID).To(Equal("bottom-1"))
+			Expect(division.Members[1].Rank).To(Equal(2))
+
+			result, err := leaderboards.EndLeagueSeason(NewEmptyCtx(), leagueID, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Season).To(Equal(2))
+			Expect(result.Promoted).To(Equal(2))
+			Expect(result.Relegated).To(Equal(1))
+			Expect(result.Stayed).To(Equal(4))
+
+			retried, err := leaderboards.EndLeagueSeason(NewEmptyCtx(), leagueID, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retried).To(Equal(result))
+
+			league, err := leaderboards.GetLeague(NewEmptyCtx(), leagueID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(league.Season).To(Equal(2))
+
+			division, err = leaderboards.GetLeagueDivision(NewEmptyCtx(), leagueID, "bottom-0")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(division.Season).To(Equal(2))
+			Expect(division.Tier).To(Equal(1))
+
+			division, err = leaderboards.GetLeagueDivision(NewEmptyCtx(), leagueID, "top-2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(division.Tier).To(Equal(2))
+			for _, member := range division.Members {
+				Expect(member.Score).To(Equal(int64(0)))
+			}
+		})
+
+		It("should fail with faulty redis", func() {
+			_, err := faultyLeaderboards.GetLeague(NewEmptyCtx(), uuid.NewV4().String())
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("connection refused"))
+		})
+	})
+
+	Describe("tournaments", func() {
+		It("should accept scores of participants inside the window and resolve prizes when finalized", func() {
+			tournamentID := uuid.NewV4().String()
+			now := time.Now().Unix()
+
+			_, err := leaderboards.CreateTournament(NewEmptyCtx(), &model.Tournament{
+				ID:      tournamentID,
+				StartAt: now - 60,
+				EndAt:   now + 2,
+				Prizes: []*model.TournamentPrize{
+					{FromRank: 1, ToRank: 1, RewardID: "gold"},
+					{FromRank: 2, ToRank: 2, RewardID: "silver"},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), tournamentID, "member1", 10, false, "", nil, nil)
+			Expect(err).To(Equal(service.NewMemberNotParticipantError(tournamentID, "member1")))
+
+			for i, member := range []string{"member1", "member2", "member3"} {
+				_, err = leaderboards.JoinTournament(NewEmptyCtx(), tournamentID, member)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = leaderboards.SetMemberScore(NewEmptyCtx(), tournamentID, member, int64(10*(i+1)), false, "", nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			_, err = leaderboards.FinalizeTournament(NewEmptyCtx(), tournamentID)
+			Expect(err).To(Equal(service.NewTournamentNotEndedError(tournamentID)))
+
+			time.Sleep(time.Until(time.Unix(now+2, 0)))
+
+			_, err = leaderboards.IncrementMemberScore(NewEmptyCtx(), tournamentID, "member1", 100, "", nil)
+			Expect(err).To(Equal(service.NewLeaderboardClosedError(tournamentID)))
+
+			result, err := leaderboards.FinalizeTournament(NewEmptyCtx(), tournamentID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Tournament.Status).To(Equal(model.TournamentFinalized))
+			Expect(result.Winners).To(Equal([]*model.TournamentWinner{
+				{PublicID: "member3", Score: 30, Rank: 1, RewardID: "gold"},
+				{PublicID: "member2", Score: 20, Rank: 2, RewardID: "silver"},
+			}))
+
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), tournamentID, "member1", 100, false, "", nil, nil)
+			Expect(err).To(Equal(service.NewLeaderboardFrozenError(tournamentID)))
+		})
+
+		It("should fail with faulty redis", func() {
+			_, err := faultyLeaderboards.GetTournament(NewEmptyCtx(), uuid.NewV4().String())
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("connection refused"))
+		})
+	})
+
+	Describe("freezing leaderboards", func() {
+		It("should reject writes and removals while frozen and keep reads working", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			_, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 10, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			settings, err := leaderboards.FreezeLeaderboard(NewEmptyCtx(), leaderboardID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(settings.Frozen).To(BeTrue())
+
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 20, false, "", nil, nil)
+			Expect(err).To(Equal(service.NewLeaderboardFrozenError(leaderboardID)))
+
+			_, err = leaderboards.IncrementMemberScore(NewEmptyCtx(), leaderboardID, "member1", 5, "", nil)
+			Expect(err).To(Equal(service.NewLeaderboardFrozenError(leaderboardID)))
+
+			err = leaderboards.RemoveMember(NewEmptyCtx(), leaderboardID, "member1")
+			Expect(err).To(Equal(service.NewLeaderboardFrozenError(leaderboardID)))
+
+			err = leaderboards.RemoveLeaderboard(NewEmptyCtx(), leaderboardID)
+			Expect(err).To(Equal(service.NewLeaderboardFrozenError(leaderboardID)))
+
+			member, err := leaderboards.GetMember(NewEmptyCtx(), leaderboardID, "member1", "desc", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(10)))
+
+			_, err = leaderboards.UnfreezeLeaderboard(NewEmptyCtx(), leaderboardID)
+			Expect(err).NotTo(HaveOccurred())
+
+			member, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 20, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(20)))
+		})
+	})
+
+	Describe("idempotent writes", func() {
+		It("should apply a retried write only once and return its first result", func() {
+			leaderboardID := uuid.NewV4().String()
+			idempotency := &model.IdempotencyKey{Key: uuid.NewV4().String(), Window: time.Minute}
+
+			member, err := leaderboards.IncrementMemberScore(NewEmptyCtx(), leaderboardID, "member1", 10, "", idempotency)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(10)))
+
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member2", 50, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			retried, err := leaderboards.IncrementMemberScore(NewEmptyCtx(), leaderboardID, "member1", 10, "", idempotency)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retried).To(Equal(member))
+
+			member, err = leaderboards.GetMember(NewEmptyCtx(), leaderboardID, "member1", "desc", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(10)))
+			Expect(member.Rank).To(Equal(2))
+
+			members := []*model.Member{{PublicID: "member3", Score: 30}}
+			idempotency = &model.IdempotencyKey{Key: uuid.NewV4().String(), Window: time.Minute}
+			err = leaderboards.SetMembersScore(NewEmptyCtx(), leaderboardID, members, false, "", idempotency)
+			Expect(err).NotTo(HaveOccurred())
+
+			retriedMembers := []*model.Member{{PublicID: "member3", Score: 30}}
+			err = leaderboards.SetMembersScore(NewEmptyCtx(), leaderboardID, retriedMembers, false, "", idempotency)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retriedMembers).To(Equal(members))
+		})
+
+		It("should return the previous rank and expiration of the first write to a retry", func() {
+			leaderboardID := uuid.NewV4().String()
+			idempotency := &model.IdempotencyKey{Key: uuid.NewV4().String(), Window: time.Minute}
+
+			_, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 50, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member2", 100, true, "100", idempotency, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.PreviousRank).To(Equal(-1))
+			Expect(member.Rank).To(Equal(1))
+			Expect(member.ExpireAt).NotTo(BeZero())
+
+			retried, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member2", 100, true, "100", idempotency, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retried).To(Equal(member))
+		})
+
+		It("should reject an idempotency key reused by a different write", func() {
+			leaderboardID := uuid.NewV4().String()
+			idempotency := &model.IdempotencyKey{Key: uuid.NewV4().String(), Window: time.Minute}
+
+			_, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 10, false, "", idempotency, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 20, false, "", idempotency, nil)
+			Expect(err).To(Equal(service.NewIdempotencyKeyReusedError(leaderboardID, idempotency.Key)))
+
+			_, err = leaderboards.IncrementMemberScore(NewEmptyCtx(), leaderboardID, "member1", 10, "", idempotency)
+			Expect(err).To(Equal(service.NewIdempotencyKeyReusedError(leaderboardID, idempotency.Key)))
+
+			member, err := leaderboards.GetMember(NewEmptyCtx(), leaderboardID, "member1", "desc", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(10)))
+		})
+	})
+
+	Describe("conditional writes", func() {
+		It("should write score only if member has the expected score and version", func() {
+			leaderboardID := uuid.NewV4().String()
+			neverWritten := int64(0)
+
+			member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 10, false, "", nil, &model.ScoreCondition{ExpectedVersion: &neverWritten})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Version).To(Equal(int64(1)))
+
+			_, err = leaderboards.IncrementMemberScore(NewEmptyCtx(), leaderboardID, "member1", 5, "", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			staleVersion := int64(1)
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 100, false, "", nil, &model.ScoreCondition{ExpectedVersion: &staleVersion})
+			Expect(err).To(Equal(service.NewScoreConditionFailedError(leaderboardID, "member1")))
+
+			staleScore := int64(10)
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 100, false, "", nil, &model.ScoreCondition{ExpectedScore: &staleScore})
+			Expect(err).To(Equal(service.NewScoreConditionFailedError(leaderboardID, "member1")))
+
+			currentScore := int64(15)
+			currentVersion := int64(2)
+			member, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 100, false, "", nil, &model.ScoreCondition{
+				ExpectedScore:   &currentScore,
+				ExpectedVersion: &currentVersion,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(100)))
+			Expect(member.Version).To(Equal(int64(3)))
+
+			member, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 50, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Version).To(Equal(int64(4)))
+		})
+
+		It("should return the first write to retries of an idempotent conditional write", func() {
+			leaderboardID := uuid.NewV4().String()
+			neverWritten := int64(0)
+			condition := &model.ScoreCondition{ExpectedVersion: &neverWritten}
+			idempotency := &model.IdempotencyKey{Key: "request1", Window: time.Hour}
+
+			member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 10, false, "100", idempotency, condition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Version).To(Equal(int64(1)))
+			Expect(member.ExpireAt).To(BeNumerically("~", time.Now().Add(100*time.Second).Unix(), 5))
+
+			retry, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 10, false, "100", idempotency, condition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retry).To(Equal(member))
+
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 10, false, "100", &model.IdempotencyKey{Key: "request2", Window: time.Hour}, condition)
+			Expect(err).To(Equal(service.NewScoreConditionFailedError(leaderboardID, "member1")))
+
+			member, err = leaderboards.GetMember(NewEmptyCtx(), leaderboardID, "member1", "desc", true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(10)))
+			Expect(member.ExpireAt).To(BeNumerically("~", time.Now().Add(100*time.Second).Unix(), 5))
+		})
+
+		It("should keep versions written before keys were hash tagged after migrating them", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			err := redisDatabase.HSet(NewEmptyCtx(), fmt.Sprintf("%s:versions", leaderboardID), map[string]string{"member1": "5", "member2": "2"})
+			Expect(err).NotTo(HaveOccurred())
+
+			member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 10, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Version).To(Equal(int64(1)))
+
+			migrated, err := redisDatabase.MigrateKeys(NewEmptyCtx())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migrated).To(BeNumerically(">=", 1))
+
+			err = redisDatabase.Exists(NewEmptyCtx(), fmt.Sprintf("%s:versions", leaderboardID))
+			Expect(err).To(HaveOccurred())
+
+			member, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 20, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Version).To(Equal(int64(7)))
+
+			member, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member2", 20, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Version).To(Equal(int64(3)))
+		})
+
+		It("should keep settings written before keys were hash tagged after migrating them", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			_, err := leaderboards.UpdateLeaderboardSettings(NewEmptyCtx(), leaderboardID, &model.LeaderboardSettings{HistoryEnabled: true})
+			Expect(err).NotTo(HaveOccurred())
+			err = redisDatabase.Rename(NewEmptyCtx(), fmt.Sprintf("{%s}:settings", leaderboardID), fmt.Sprintf("%s:settings", leaderboardID))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = redisDatabase.MigrateKeys(NewEmptyCtx())
+			Expect(err).NotTo(HaveOccurred())
+
+			settings, err := leaderboards.GetLeaderboardSettings(NewEmptyCtx(), leaderboardID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(settings.HistoryEnabled).To(BeTrue())
+		})
+
+		It("should keep ledgers written before keys were hash tagged after migrating them", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			_, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 10, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			err = redisDatabase.Rename(NewEmptyCtx(), fmt.Sprintf("{%s}:ledger:member1", leaderboardID), fmt.Sprintf("%s:ledger:member1", leaderboardID))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 20, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = redisDatabase.MigrateKeys(NewEmptyCtx())
+			Expect(err).NotTo(HaveOccurred())
+
+			err = redisDatabase.Exists(NewEmptyCtx(), fmt.Sprintf("%s:ledger:member1", leaderboardID))
+			Expect(err).To(HaveOccurred())
+
+			entries, err := leaderboards.GetMemberLedger(NewEmptyCtx(), leaderboardID, "member1", 0, 10, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(2))
+			Expect(*entries[0].NewScore).To(Equal(int64(10)))
+			Expect(*entries[1].NewScore).To(Equal(int64(20)))
+		})
+	})
+
+	Describe("score rules", func() {
+		It("should reject scores that break leaderboard rules and keep them for review", func() {
+			leaderboardID := uuid.NewV4().String()
+			maxScore := int64(1000)
+
+			_, err := leaderboards.UpdateLeaderboardSettings(NewEmptyCtx(), leaderboardID, &model.LeaderboardSettings{
+				MaxScore:      &maxScore,
+				MaxIncrement:  100,
+				MonotonicOnly: true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 80, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(80)))
+
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 5000, false, "", nil, nil)
+			Expect(err).To(Equal(service.NewScoreRejectedError(leaderboardID, "member1", model.ScoreRuleMaxScore)))
+
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 40, false, "", nil, nil)
+			Expect(err).To(Equal(service.NewScoreRejectedError(leaderboardID, "member1", model.ScoreRuleMonotonicOnly)))
+
+			_, err = leaderboards.IncrementMemberScore(NewEmptyCtx(), leaderboardID, "member1", 150, "", nil)
+			Expect(err).To(Equal(service.NewScoreRejectedError(leaderboardID, "member1", model.ScoreRuleMaxIncrement)))
+
+			member, err = leaderboards.IncrementMemberScore(NewEmptyCtx(), leaderboardID, "member1", 50, "", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(130)))
+
+			rejectedScores, err := leaderboards.GetRejectedScores(NewEmptyCtx(), leaderboardID, 10, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rejectedScores).To(HaveLen(3))
+			rules := []string{}
+			for _, rejectedScore := range rejectedScores {
+				Expect(rejectedScore.PublicID).To(Equal("member1"))
+				Expect(rejectedScore.PreviousScore).To(Equal(int64(80)))
+				rules = append(rules, rejectedScore.Rule)
+			}
+			Expect(rules).To(ConsistOf(model.ScoreRuleMaxScore, model.ScoreRuleMonotonicOnly, model.ScoreRuleMaxIncrement))
+		})
+
+		It("should limit total increase of member in a window", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			_, err := leaderboards.UpdateLeaderboardSettings(NewEmptyCtx(), leaderboardID, &model.LeaderboardSettings{
+				MaxIncrease:       100,
+				MaxIncreaseWindow: 3600,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = leaderboards.IncrementMemberScore(NewEmptyCtx(), leaderboardID, "member1", 60, "", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = leaderboards.IncrementMemberScore(NewEmptyCtx(), leaderboardID, "member1", 60, "", nil)
+			Expect(err).To(Equal(service.NewScoreRejectedError(leaderboardID, "member1", model.ScoreRuleMaxIncrease)))
+
+			member, err := leaderboards.IncrementMemberScore(NewEmptyCtx(), leaderboardID, "member1", 40, "", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(100)))
+		})
+
+		It("should count increases only of writes applied", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			_, err := leaderboards.UpdateLeaderboardSettings(NewEmptyCtx(), leaderboardID, &model.LeaderboardSettings{
+				MaxIncrease:       100,
+				MaxIncreaseWindow: 3600,
+				MaxScore:          &[]int64{1000}[0],
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			idempotency := &model.IdempotencyKey{Key: uuid.NewV4().String(), Window: time.Minute}
+			for i := 0; i < 2; i++ {
+				_, err = leaderboards.IncrementMemberScore(NewEmptyCtx(), leaderboardID, "member1", 60, "", idempotency)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			wrongVersion := int64(5)
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 100, false, "", nil, &model.ScoreCondition{ExpectedVersion: &wrongVersion})
+			Expect(err).To(Equal(service.NewScoreConditionFailedError(leaderboardID, "member1")))
+
+			err = leaderboards.SetMembersScore(NewEmptyCtx(), leaderboardID, []*model.Member{
+				{PublicID: "member1", Score: 100},
+				{PublicID: "member2", Score: 2000},
+			}, false, "", nil)
+			Expect(err).To(Equal(service.NewScoreRejectedError(leaderboardID, "member2", model.ScoreRuleMaxScore)))
+
+			member, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 100, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(100)))
+		})
+	})
+
+	Describe("signed writes", func() {
+		It("should reject nonces already used in the leaderboard", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			err := leaderboards.UseNonce(NewEmptyCtx(), leaderboardID, "nonce1", time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = leaderboards.UseNonce(NewEmptyCtx(), leaderboardID, "nonce1", time.Minute)
+			Expect(err).To(Equal(service.NewNonceAlreadyUsedError(leaderboardID, "nonce1")))
+
+			err = leaderboards.UseNonce(NewEmptyCtx(), leaderboardID, "nonce2", time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should keep signature game in leaderboard settings", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			_, err := leaderboards.UpdateLeaderboardSettings(NewEmptyCtx(), leaderboardID, &model.LeaderboardSettings{SignatureGame: "game1"})
+			Expect(err).NotTo(HaveOccurred())
+
+			settings, err := leaderboards.GetLeaderboardSettings(NewEmptyCtx(), leaderboardID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(settings.SignatureGame).To(Equal("game1"))
+		})
+	})
+
+	Describe("rate limits", func() {
+		It("should limit writes to members and leaderboards", func() {
+			leaderboardID := uuid.NewV4().String()
+			limits := &model.RateLimits{
+				Member:      &model.RateLimit{Rate: 0.1, Burst: 2},
+				Leaderboard: &model.RateLimit{Rate: 0.1, Burst: 3},
+			}
+
+			for i := 0; i < 2; i++ {
+				err := leaderboards.TakeWriteTokens(NewEmptyCtx(), "caller1", map[string][]string{leaderboardID: {"member1"}}, limits)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			err := leaderboards.TakeWriteTokens(NewEmptyCtx(), "caller1", map[string][]string{leaderboardID: {"member1"}}, limits)
+			Expect(err).To(BeAssignableToTypeOf(&service.RateLimitExceededError{}))
+			Expect(err.(*service.RateLimitExceededError).RetryAfter()).To(BeNumerically("~", 10*time.Second, time.Second))
+
+			err = leaderboards.TakeWriteTokens(NewEmptyCtx(), "caller1", map[string][]string{leaderboardID: {"member2"}}, limits)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = leaderboards.TakeWriteTokens(NewEmptyCtx(), "caller1", map[string][]string{leaderboardID: {"member3"}}, limits)
+			Expect(err).To(BeAssignableToTypeOf(&service.RateLimitExceededError{}))
+		})
+
+		It("should refill tokens with time", func() {
+			caller := uuid.NewV4().String()
+			limits := &model.RateLimits{Caller: &model.RateLimit{Rate: 20, Burst: 1}}
+
+			err := leaderboards.TakeWriteTokens(NewEmptyCtx(), caller, map[string][]string{}, limits)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = leaderboards.TakeWriteTokens(NewEmptyCtx(), caller, map[string][]string{}, limits)
+			Expect(err).To(BeAssignableToTypeOf(&service.RateLimitExceededError{}))
+
+			time.Sleep(100 * time.Millisecond)
+			err = leaderboards.TakeWriteTokens(NewEmptyCtx(), caller, map[string][]string{}, limits)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("blocklist", func() {
+		It("should reject writes of blocked members and exclude them from results", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			for i, member := range []string{"cheater", "member1", "member2"} {
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, member, int64(300-i*100), false, "", nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			err := leaderboards.BlockMember(NewEmptyCtx(), leaderboardID, "cheater", model.BlockModeReject)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "cheater", 500, false, "", nil, nil)
+			Expect(err).To(Equal(service.NewMemberBlockedError(leaderboardID, "cheater")))
+
+			members, err := leaderboards.GetLeaders(NewEmptyCtx(), leaderboardID, 10, 1, "desc")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(members).To(HaveLen(2))
+			Expect(members[0].PublicID).To(Equal("member1"))
+			Expect(members[0].Rank).To(Equal(1))
+
+			_, err = leaderboards.GetMember(NewEmptyCtx(), leaderboardID, "cheater", "desc", false)
+			Expect(err).To(Equal(service.NewMemberNotFoundError(leaderboardID, "cheater")))
+
+			err = leaderboards.UnblockMember(NewEmptyCtx(), leaderboardID, "cheater")
+			Expect(err).NotTo(HaveOccurred())
+
+			member, err := leaderboards.GetMember(NewEmptyCtx(), leaderboardID, "cheater", "desc", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(300)))
+			Expect(member.Rank).To(Equal(1))
+		})
+
+		It("should accept writes of shadow banned members only they see", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			for i, member := range []string{"member1", "member2", "cheater"} {
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, member, int64(300-i*100), false, "", nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			err := leaderboards.BlockMember(NewEmptyCtx(), leaderboardID, "cheater", model.BlockModeShadow)
+			Expect(err).NotTo(HaveOccurred())
+
+			member, err := leaderboards.IncrementMemberScore(NewEmptyCtx(), leaderboardID, "cheater", 1000, "", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(1100)))
+			Expect(member.Rank).To(Equal(1))
+
+			member, err = leaderboards.GetMember(NewEmptyCtx(), leaderboardID, "cheater", "desc", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(1100)))
+			Expect(member.Rank).To(Equal(1))
+
+			members, err := leaderboards.GetTopPercentage(NewEmptyCtx(), leaderboardID, 10, 100, 10, "desc")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(members).To(HaveLen(2))
+			Expect(members[0].PublicID).To(Equal("member1"))
+
+			members, err = leaderboards.GetAroundMe(NewEmptyCtx(), leaderboardID, 10, "member2", "desc", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(members).To(HaveLen(2))
+		})
+
+		It("should exclude members blocked in every leaderboard until they are unblocked", func() {
+			leaderboardID := uuid.NewV4().String()
+			cheater := uuid.NewV4().String()
+
+			for i, member := range []string{cheater, "member1", "member2"} {
+				_, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, member, int64(300-i*100), false, "", nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			err := leaderboards.BlockMember(NewEmptyCtx(), "", cheater, model.BlockModeShadow)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = leaderboards.ApplyGlobalBlock(NewEmptyCtx(), cheater)
+			Expect(err).NotTo(HaveOccurred())
+
+			members, err := leaderboards.GetLeaders(NewEmptyCtx(), leaderboardID, 10, 1, "desc")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(members).To(HaveLen(2))
+			Expect(members[0].PublicID).To(Equal("member1"))
+			Expect(members[0].Rank).To(Equal(1))
+
+			member, err := leaderboards.GetMember(NewEmptyCtx(), leaderboardID, cheater, "desc", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(300)))
+
+			err = leaderboards.UnblockMember(NewEmptyCtx(), "", cheater)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = leaderboards.ApplyGlobalBlock(NewEmptyCtx(), cheater)
+			Expect(err).NotTo(HaveOccurred())
+
+			member, err = leaderboards.GetMember(NewEmptyCtx(), leaderboardID, cheater, "desc", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Rank).To(Equal(1))
+
+			members, err = leaderboards.GetLeaders(NewEmptyCtx(), leaderboardID, 10, 1, "desc")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(members).To(HaveLen(3))
+		})
+		It("should hide scores of members blocked in reject mode out of the shadow leaderboard", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			_, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "cheater", 300, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = leaderboards.BlockMember(NewEmptyCtx(), leaderboardID, "cheater", model.BlockModeReject)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = redisDatabase.ZScore(NewEmptyCtx(), database.ShadowLeaderboard(leaderboardID), "cheater")
+			Expect(err).To(HaveOccurred())
+
+			err = leaderboards.BlockMember(NewEmptyCtx(), leaderboardID, "cheater", model.BlockModeShadow)
+			Expect(err).NotTo(HaveOccurred())
+
+			member, err := leaderboards.GetMember(NewEmptyCtx(), leaderboardID, "cheater", "desc", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(300)))
+		})
+
+		It("should apply blocks in every leaderboard when queued blocks are applied", func() {
+			leaderboardIDs := []string{uuid.NewV4().String(), uuid.NewV4().String()}
+			cheater := uuid.NewV4().String()
+
+			for _, leaderboardID := range leaderboardIDs {
+				for i, member := range []string{cheater, "member1"} {
+					_, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, member, int64(200-i*100), false, "", nil, nil)
+					Expect(err).NotTo(HaveOccurred())
+				}
+			}
+
+			err := leaderboards.BlockMember(NewEmptyCtx(), leaderboardIDs[1], cheater, model.BlockModeShadow)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = leaderboards.BlockMember(NewEmptyCtx(), "", cheater, model.BlockModeReject)
+			Expect(err).NotTo(HaveOccurred())
+
+			count, err := leaderboards.TotalMembers(NewEmptyCtx(), leaderboardIDs[0])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(2))
+
+			blocks, err := redisDatabase.GetQueuedGlobalBlocks(NewEmptyCtx())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(blocks).To(ContainElement(&database.QueuedGlobalBlock{Member: cheater, Times: 1}))
+
+			err = leaderboards.ApplyGlobalBlock(NewEmptyCtx(), cheater)
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, leaderboardID := range leaderboardIDs {
+				count, err := leaderboards.TotalMembers(NewEmptyCtx(), leaderboardID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(count).To(Equal(1))
+			}
+
+			err = leaderboards.UnblockMember(NewEmptyCtx(), "", cheater)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = leaderboards.ApplyGlobalBlock(NewEmptyCtx(), cheater)
+			Expect(err).NotTo(HaveOccurred())
+
+			removed, err := redisDatabase.RemoveQueuedGlobalBlock(NewEmptyCtx(), &database.QueuedGlobalBlock{Member: cheater, Times: 1})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(BeFalse())
+
+			removed, err = redisDatabase.RemoveQueuedGlobalBlock(NewEmptyCtx(), &database.QueuedGlobalBlock{Member: cheater, Times: 2})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(BeTrue())
+
+			count, err = leaderboards.TotalMembers(NewEmptyCtx(), leaderboardIDs[0])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(2))
+
+			count, err = leaderboards.TotalMembers(NewEmptyCtx(), leaderboardIDs[1])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(1))
+
+			member, err := leaderboards.GetMember(NewEmptyCtx(), leaderboardIDs[1], cheater, "desc", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(200)))
+		})
+
+		It("should keep scores written after members are unblocked in every leaderboard when blocks are applied", func() {
+			leaderboardID := uuid.NewV4().String()
+			cheater := uuid.NewV4().String()
+
+			_, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, cheater, 100, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = leaderboards.BlockMember(NewEmptyCtx(), "", cheater, model.BlockModeReject)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = leaderboards.ApplyGlobalBlock(NewEmptyCtx(), cheater)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = leaderboards.UnblockMember(NewEmptyCtx(), "", cheater)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, cheater, 50, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = leaderboards.ApplyGlobalBlock(NewEmptyCtx(), cheater)
+			Expect(err).NotTo(HaveOccurred())
+
+			member, err := leaderboards.GetMember(NewEmptyCtx(), leaderboardID, cheater, "desc", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(50)))
+		})
+
+		It("should keep blocks written before keys were hash tagged after migrating them", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			err := redisDatabase.ZAdd(NewEmptyCtx(), leaderboardID, &redis.Member{Member: "member1", Score: 100})
+			Expect(err).NotTo(HaveOccurred())
+			err = redisDatabase.ZAdd(NewEmptyCtx(), fmt.Sprintf("%s:shadow", leaderboardID),
+				&redis.Member{Member: "cheater", Score: 500},
+				&redis.Member{Member: "shadowed", Score: 300},
+				&redis.Member{Member: "unblocked", Score: 50},
+			)
+			Expect(err).NotTo(HaveOccurred())
+			err = redisDatabase.HSet(NewEmptyCtx(), fmt.Sprintf("%s:blocked", leaderboardID), map[string]string{"cheater": "reject", "shadowed": "shadow"})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = redisDatabase.MigrateKeys(NewEmptyCtx())
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "cheater", 600, false, "", nil, nil)
+			Expect(err).To(Equal(service.NewMemberBlockedError(leaderboardID, "cheater")))
+
+			member, err := leaderboards.GetMember(NewEmptyCtx(), leaderboardID, "shadowed", "desc", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(300)))
+			Expect(member.Rank).To(Equal(1))
+
+			members, err := leaderboards.GetLeaders(NewEmptyCtx(), leaderboardID, 10, 1, "desc")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(members).To(HaveLen(2))
+			Expect(members[1].PublicID).To(Equal("unblocked"))
+
+			err = leaderboards.UnblockMember(NewEmptyCtx(), leaderboardID, "cheater")
+			Expect(err).NotTo(HaveOccurred())
+
+			member, err = leaderboards.GetMember(NewEmptyCtx(), leaderboardID, "cheater", "desc", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.Score).To(Equal(int64(500)))
+		})
+	})
+
+	Describe("ledger", func() {
+		It("should record score changes and rollback members to their scores before a time", func() {
+			leaderboardID := uuid.NewV4().String()
+			ctx := service.WithScoreChangeOrigin(NewEmptyCtx(), "match", "game-server")
+
+			_, err := leaderboards.SetMemberScore(ctx, leaderboardID, "member1", 100, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = leaderboards.SetMemberScore(ctx, leaderboardID, "member2", 50, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			since := time.Now().Add(time.Second).Unix()
+			time.Sleep(time.Until(time.Unix(since, 0)))
+
+			_, err = leaderboards.IncrementMemberScore(ctx, leaderboardID, "member1", 900, "", nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = leaderboards.SetMemberScore(ctx, leaderboardID, "member3", 700, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			entries, err := leaderboards.GetMemberLedger(NewEmptyCtx(), leaderboardID, "member1", 0, 10, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(2))
+			Expect(entries[0].OldScore).To(BeNil())
+			Expect(*entries[0].NewScore).To(Equal(int64(100)))
+			Expect(*entries[1].OldScore).To(Equal(int64(100)))
+			Expect(*entries[1].NewScore).To(Equal(int64(1000)))
+			Expect(entries[1].Delta).To(Equal(int64(900)))
+			Expect(entries[1].Reason).To(Equal("match"))
+			Expect(entries[1].Caller).To(Equal("game-server"))
+
+			entry, err := leaderboards.RollbackMember(NewEmptyCtx(), leaderboardID, "member1", since)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*entry.NewScore).To(Equal(int64(100)))
+			Expect(entry.Reason).To(Equal(model.LedgerReasonRollback))
+
+			_, err = leaderboards.RollbackMember(NewEmptyCtx(), leaderboardID, "member3", since)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = leaderboards.RollbackMember(NewEmptyCtx(), leaderboardID, "member2", since)
+			Expect(err).To(Equal(service.NewScoreChangesNotFoundError(leaderboardID, "member2", since)))
+
+			members, err := leaderboards.GetLeaders(NewEmptyCtx(), leaderboardID, 10, 1, "desc")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(members).To(HaveLen(2))
+			Expect(members[0].PublicID).To(Equal("member1"))
+			Expect(members[0].Score).To(Equal(int64(100)))
+			Expect(members[1].PublicID).To(Equal("member2"))
+
+			entries, err = leaderboards.GetMemberLedger(NewEmptyCtx(), leaderboardID, "member1", since, 10, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(2))
+			Expect(entries[1].Delta).To(Equal(int64(-900)))
+		})
+
+		It("should record increments with the scores of the write and keep only the most recent entries", func() {
+			leaderboardID := uuid.NewV4().String()
+			_, err := leaderboards.UpdateLeaderboardSettings(NewEmptyCtx(), leaderboardID, &model.LeaderboardSettings{LedgerMaxEntries: 2})
+			Expect(err).NotTo(HaveOccurred())
+
+			for i := 0; i < 3; i++ {
+				_, err = leaderboards.IncrementMemberScore(NewEmptyCtx(), leaderboardID, "member1", 10, "", nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			entries, err := leaderboards.GetMemberLedger(NewEmptyCtx(), leaderboardID, "member1", 0, 10, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(2))
+			Expect(*entries[0].OldScore).To(Equal(int64(10)))
+			Expect(*entries[0].NewScore).To(Equal(int64(20)))
+			Expect(*entries[1].OldScore).To(Equal(int64(20)))
+			Expect(*entries[1].NewScore).To(Equal(int64(30)))
+		})
+	})
+
+	Describe("member history", func() {
+		It("should sample score and rank of members only while history is enabled", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			_, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 10, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = leaderboards.UpdateLeaderboardSettings(NewEmptyCtx(), leaderboardID, &model.LeaderboardSettings{HistoryEnabled: true})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 100, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member2", 200, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = leaderboards.IncrementMemberScore(NewEmptyCtx(), leaderboardID, "member1", 150, "", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			samples, err := leaderboards.GetMemberHistory(NewEmptyCtx(), leaderboardID, "member1", 0, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(samples).To(HaveLen(2))
+			Expect(samples[0].Score).To(Equal(int64(100)))
+			Expect(samples[0].Rank).To(Equal(1))
+			Expect(samples[1].Score).To(Equal(int64(250)))
+			Expect(samples[1].Rank).To(Equal(1))
+
+			samples, err = leaderboards.GetMemberHistory(NewEmptyCtx(), leaderboardID, "member1", 0, time.Now().Add(-time.Hour).Unix())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(samples).To(BeEmpty())
+		})
+
+		It("should keep only the last sample of each interval", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			_, err := leaderboards.UpdateLeaderboardSettings(NewEmptyCtx(), leaderboardID, &model.LeaderboardSettings{HistoryEnabled: true, HistoryInterval: 3600})
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, score := range []int64{10, 20, 30} {
+				_, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", score, false, "", nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			samples, err := leaderboards.GetMemberHistory(NewEmptyCtx(), leaderboardID, "member1", 0, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(samples).To(HaveLen(1))
+			Expect(samples[0].Score).To(Equal(int64(30)))
+		})
+
+		It("should keep histories written before keys were hash tagged after migrating them", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			_, err := leaderboards.UpdateLeaderboardSettings(NewEmptyCtx(), leaderboardID, &model.LeaderboardSettings{HistoryEnabled: true})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 10, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			err = redisDatabase.Rename(NewEmptyCtx(), fmt.Sprintf("{%s}:history:member1", leaderboardID), fmt.Sprintf("%s:history:member1", leaderboardID))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 20, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = redisDatabase.MigrateKeys(NewEmptyCtx())
+			Expect(err).NotTo(HaveOccurred())
+
+			samples, err := leaderboards.GetMemberHistory(NewEmptyCtx(), leaderboardID, "member1", 0, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(samples).To(HaveLen(2))
+			Expect(samples[0].Score).To(Equal(int64(10)))
+			Expect(samples[1].Score).To(Equal(int64(20)))
+		})
+	})
+
+	Describe("rank movers", func() {
+		It("should return members that climbed and fell the most since the last snapshot", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			_, err := leaderboards.UpdateLeaderboardSettings(NewEmptyCtx(), leaderboardID, &model.LeaderboardSettings{SnapshotInterval: 3600})
+			Expect(err).NotTo(HaveOccurred())
+
+			for i, member := range []string{"member1", "member2", "member3"} {
+				_, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, member, int64(300-100*i), false, "", nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			taken, err := leaderboards.TakeRankSnapshot(NewEmptyCtx(), leaderboardID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(taken).To(BeTrue())
+
+			taken, err = leaderboards.TakeRankSnapshot(NewEmptyCtx(), leaderboardID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(taken).To(BeFalse())
+
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member3", 1000, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member4", 500, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			movers, err := leaderboards.GetRankMovers(NewEmptyCtx(), leaderboardID, time.Now().Unix()+1, 0, 10, "desc")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(movers.Climbers).To(Equal([]*model.RankMove{
+				{PublicID: "member3", PreviousRank: 3, Rank: 1, RankDelta: 2},
+			}))
+			Expect(movers.Fallers).To(Equal([]*model.RankMove{
+				{PublicID: "member1", PreviousRank: 1, Rank: 3, RankDelta: -2},
+				{PublicID: "member2", PreviousRank: 2, Rank: 4, RankDelta: -2},
+			}))
+		})
+
+		It("should fail if no snapshot was taken", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			_, err := leaderboards.GetRankMovers(NewEmptyCtx(), leaderboardID, time.Now().Unix(), 0, 10, "desc")
+			Expect(err).To(Equal(service.NewRankSnapshotNotFoundError(leaderboardID, time.Now().Unix())))
+		})
+	})
+
+	Describe("leaderboard diff", func() {
+		It("should emit members that entered, changed and left since the last snapshot", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			_, err := leaderboards.UpdateLeaderboardSettings(NewEmptyCtx(), leaderboardID, &model.LeaderboardSettings{SnapshotInterval: 3600})
+			Expect(err).NotTo(HaveOccurred())
+
+			for i, member := range []string{"member1", "member2", "member3"} {
+				_, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, member, int64(300-100*i), false, "", nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			_, err = leaderboards.TakeRankSnapshot(NewEmptyCtx(), leaderboardID)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member3", 150, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member4", 50, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			err = leaderboards.RemoveMember(NewEmptyCtx(), leaderboardID, "member2")
+			Expect(err).NotTo(HaveOccurred())
+
+			diffs := []*model.MemberDiff{}
+			err = leaderboards.DiffLeaderboard(NewEmptyCtx(), leaderboardID, time.Now().Unix()+1, 0, "desc", func(diff *model.MemberDiff) error {
+				diffs = append(diffs, diff)
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(diffs).To(HaveLen(3))
+			Expect(diffs[0].PublicID).To(Equal("member3"))
+			Expect(diffs[0].Change).To(Equal(model.MemberDiffChanged))
+			Expect(diffs[0].ScoreDelta).To(Equal(int64(50)))
+			Expect(diffs[0].RankDelta).To(Equal(1))
+			Expect(diffs[1].PublicID).To(Equal("member4"))
+			Expect(diffs[1].Change).To(Equal(model.MemberDiffEntered))
+			Expect(diffs[2].PublicID).To(Equal("member2"))
+			Expect(diffs[2].Change).To(Equal(model.MemberDiffLeft))
+			Expect(*diffs[2].PreviousScore).To(Equal(int64(200)))
+		})
+	})
+
+	Describe("exports", func() {
+		It("should export members and expirations as they were when the export started", func() {
+			leaderboardID := uuid.NewV4().String()
+
+			err := leaderboards.SetMembersScore(NewEmptyCtx(), leaderboardID, []*model.Member{
+				{PublicID: "member1", Score: 300},
+				{PublicID: "member2", Score: 200},
+				{PublicID: "member3", Score: 100},
+			}, false, "100", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			exported := []*model.Member{}
+			err = leaderboards.ExportLeaderboard(NewEmptyCtx(), leaderboardID, "desc", 1, func(members []*model.Member) error {
+				if len(exported) == 0 {
+					_, err := leaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member4", 1000, false, "", nil, nil)
+					Expect(err).NotTo(HaveOccurred())
+					err = leaderboards.RemoveMember(NewEmptyCtx(), leaderboardID, "member2")
+					Expect(err).NotTo(HaveOccurred())
+				}
+				exported = append(exported, members...)
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exported).To(HaveLen(3))
+			for i, member := range exported {
+				Expect(member.PublicID).To(Equal(fmt.Sprintf("member%d", i+1)))
+				Expect(member.Rank).To(Equal(i + 1))
+				Expect(member.ExpireAt).To(BeNumerically(">", time.Now().Unix()))
+			}
+
+			copies := []string{}
+			err = redisDatabase.Client.Scan(NewEmptyCtx(), fmt.Sprintf("*%s*:export:*", leaderboardID), 100, func(keys []string) error {
+				copies = append(copies, keys...)
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(copies).To(BeEmpty())
+		})
+	})
+
+	Describe("score events", func() {
+		It("should publish score and rank changes of writes to the event sink", func() {
+			leaderboardID := uuid.NewV4().String()
+			dir, err := ioutil.TempDir("", "podium-events")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.RemoveAll(dir)
+
+			fileSink, err := events.NewFileSink(filepath.Join(dir, "events.jsonl"))
+			Expect(err).NotTo(HaveOccurred())
+			eventLeaderboards := service.NewService(redisDatabase)
+			eventLeaderboards.EventSink = fileSink
+
+			_, err = eventLeaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 100, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = eventLeaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member2", 50, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = eventLeaderboards.IncrementMemberScore(NewEmptyCtx(), leaderboardID, "member2", 100, "", nil)
+			Expect(err).NotTo(HaveOccurred())
+			err = eventLeaderboards.RemoveMember(NewEmptyCtx(), leaderboardID, "member1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fileSink.Close()).To(Succeed())
+
+			content, err := ioutil.ReadFile(filepath.Join(dir, "events.jsonl"))
+			Expect(err).NotTo(HaveOccurred())
+			scoreEvents := []*model.ScoreEvent{}
+			for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+				scoreEvent := &model.ScoreEvent{}
+				Expect(json.Unmarshal([]byte(line), scoreEvent)).To(Succeed())
+				Expect(scoreEvent.Leaderboard).To(Equal(leaderboardID))
+				scoreEvents = append(scoreEvents, scoreEvent)
+			}
+
+			Expect(scoreEvents).To(HaveLen(4))
+			Expect(scoreEvents[0].PublicID).To(Equal("member1"))
+			Expect(scoreEvents[0].OldScore).To(BeNil())
+			Expect(*scoreEvents[0].NewScore).To(Equal(int64(100)))
+			Expect(scoreEvents[0].OldRank).To(Equal(-1))
+			Expect(scoreEvents[0].NewRank).To(Equal(1))
+			Expect(scoreEvents[1].PublicID).To(Equal("member2"))
+			Expect(scoreEvents[1].NewRank).To(Equal(2))
+			Expect(*scoreEvents[2].OldScore).To(Equal(int64(50)))
+			Expect(*scoreEvents[2].NewScore).To(Equal(int64(150)))
+			Expect(scoreEvents[2].OldRank).To(Equal(2))
+			Expect(scoreEvents[2].NewRank).To(Equal(1))
+			Expect(scoreEvents[3].PublicID).To(Equal("member1"))
+			Expect(*scoreEvents[3].OldScore).To(Equal(int64(100)))
+			Expect(scoreEvents[3].NewScore).To(BeNil())
+			Expect(scoreEvents[3].OldRank).To(Equal(2))
+			Expect(scoreEvents[3].NewRank).To(Equal(-1))
+		})
+	})
+
+	Describe("milestones", func() {
+		It("should deliver signed milestones reached by writes to the webhook", func() {
+			leaderboardID := uuid.NewV4().String()
+			received := make(chan []*model.Milestone, 10)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				body, err := ioutil.ReadAll(r.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r.Header.Get(delivery.SignatureHeader)).To(Equal(delivery.Sign("secret", r.Header.Get(delivery.TimestampHeader), body)))
+
+				var payload struct {
+					Milestones []*model.Milestone `json:"milestones"`
+				}
+				Expect(json.Unmarshal(body, &payload)).To(Succeed())
+				received <- payload.Milestones
+			}))
+			defer server.Close()
+
+			milestoneLeaderboards := service.NewService(redisDatabase)
+			milestoneLeaderboards.MilestoneNotifier = milestones.NewWebhookNotifier(server.URL, "secret", time.Second, 0, time.Millisecond)
+			_, err := milestoneLeaderboards.UpdateLeaderboardSettings(NewEmptyCtx(), leaderboardID, &model.LeaderboardSettings{
+				Milestones: []*model.MilestoneRule{
+					{ID: "top1", Type: model.MilestoneRankAtMost, Rank: 1},
+					{ID: "passLeader", Type: model.MilestonePassedMember, Member: "member1"},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = milestoneLeaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 100, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			reached := <-received
+			Expect(reached).To(HaveLen(1))
+			Expect(reached[0].RuleID).To(Equal("top1"))
+			Expect(reached[0].PublicID).To(Equal("member1"))
+			Expect(reached[0].PreviousRank).To(Equal(-1))
+
+			_, err = milestoneLeaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member2", 50, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = milestoneLeaderboards.IncrementMemberScore(NewEmptyCtx(), leaderboardID, "member2", 100, "", nil)
+			Expect(err).NotTo(HaveOccurred())
+			reached = <-received
+			Expect(reached).To(HaveLen(2))
+			Expect(reached[0].RuleID).To(Equal("top1"))
+			Expect(reached[0].PublicID).To(Equal("member2"))
+			Expect(reached[0].PreviousRank).To(Equal(2))
+			Expect(reached[0].Rank).To(Equal(1))
+			Expect(reached[1].RuleID).To(Equal("passLeader"))
+			Expect(reached[1].PassedMember).To(Equal("member1"))
+			Expect(received).To(BeEmpty())
+		})
+	})
+
+	Describe("lifecycle", func() {
+		BeforeEach(func() {
+			redisDatabase.Del(NewEmptyCtx(), database.WebhookDeliveriesKey)
+			redisDatabase.Del(NewEmptyCtx(), database.WebhookDeadLettersKey)
+		})
+
+		AfterEach(func() {
+			redisDatabase.Del(NewEmptyCtx(), database.WebhookDeliveriesKey)
+			redisDatabase.Del(NewEmptyCtx(), database.WebhookDeadLettersKey)
+		})
+
+		It("should deliver signed lifecycle events to the webhook recording deliveries", func() {
+			leaderboardID := uuid.NewV4().String()
+			var failing int32
+			received := make(chan []*model.LifecycleEvent, 10)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				if atomic.LoadInt32(&failing) == 1 {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+
+				body, err := ioutil.ReadAll(r.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r.Header.Get(delivery.SignatureHeader)).To(Equal(delivery.Sign("secret", r.Header.Get(delivery.TimestampHeader), body)))
+
+				var payload struct {
+					Events []*model.LifecycleEvent `json:"events"`
+				}
+				Expect(json.Unmarshal(body, &payload)).To(Succeed())
+				received <- payload.Events
+			}))
+			defer server.Close()
+
+			lifecycleLeaderboards := service.NewService(redisDatabase)
+			lifecycleLeaderboards.LifecycleNotifier = lifecycle.NewWebhookNotifier(server.URL, "secret", nil, time.Second, 1, time.Millisecond, redisDatabase, nil)
+
+			_, err := lifecycleLeaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 100, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			events := <-received
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].Type).To(Equal(model.LifecycleLeaderboardCreated))
+			Expect(events[0].Leaderboard).To(Equal(leaderboardID))
+
+			_, err = lifecycleLeaderboards.IncrementMemberScore(NewEmptyCtx(), leaderboardID, "member1", 10, "", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(received).To(BeEmpty())
+
+			_, err = lifecycleLeaderboards.FreezeLeaderboard(NewEmptyCtx(), leaderboardID)
+			Expect(err).NotTo(HaveOccurred())
+			events = <-received
+			Expect(events[0].Type).To(Equal(model.LifecycleLeaderboardFrozen))
+
+			_, err = lifecycleLeaderboards.UnfreezeLeaderboard(NewEmptyCtx(), leaderboardID)
+			Expect(err).NotTo(HaveOccurred())
+
+			atomic.StoreInt32(&failing, 1)
+			err = lifecycleLeaderboards.RemoveLeaderboard(NewEmptyCtx(), leaderboardID)
+			Expect(err).NotTo(HaveOccurred())
+
+			deliveries, err := lifecycleLeaderboards.GetWebhookDeliveries(NewEmptyCtx(), 10, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deliveries).To(HaveLen(4))
+			Expect(deliveries[0].Attempt).To(Equal(2))
+			Expect(deliveries[0].StatusCode).To(Equal(http.StatusInternalServerError))
+			Expect(deliveries[0].DeadLettered).To(BeTrue())
+			Expect(deliveries[1].ID).To(Equal(deliveries[0].ID))
+			Expect(deliveries[1].DeadLettered).To(BeFalse())
+			Expect(deliveries[2].StatusCode).To(Equal(http.StatusOK))
+
+			deadLetters, err := redisDatabase.ZCard(NewEmptyCtx(), database.WebhookDeadLettersKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deadLetters).To(Equal(int64(1)))
+
+			atomic.StoreInt32(&failing, 0)
+			_, err = lifecycleLeaderboards.SetMemberScore(NewEmptyCtx(), leaderboardID, "member1", 100, false, "", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			events = <-received
+			Expect(events[0].Type).To(Equal(model.LifecycleLeaderboardCreated))
+		})
+	})
 })
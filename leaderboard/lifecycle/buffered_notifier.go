@@ -0,0 +1,51 @@
+package lifecycle
+
+import (
+	"context"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/delivery"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// BufferedNotifier sends lifecycle events to another notifier in background with a delivery.Buffer, so
+// operations do not wait for them to be delivered. When the buffer is full Notify blocks until there is room
+// or ctx ends. Events the notifier fails to deliver are retried until they are, and events still buffered
+// when the process stops are lost, so Close must be called to flush them
+type BufferedNotifier struct {
+	buffer *delivery.Buffer
+}
+
+// NewBufferedNotifier create a BufferedNotifier that sends batches of up to batchSize events to notifier,
+// calling onError with errors of notifier. It starts sending events right away
+func NewBufferedNotifier(notifier Notifier, size, batchSize int, retryInterval time.Duration, onError func(error)) *BufferedNotifier {
+	return &BufferedNotifier{
+		buffer: delivery.NewBuffer(size, batchSize, retryInterval, func(ctx context.Context, batch []interface{}) error {
+			events := make([]*model.LifecycleEvent, 0, len(batch))
+			for _, event := range batch {
+				events = append(events, event.(*model.LifecycleEvent))
+			}
+			return notifier.Notify(ctx, events)
+		}, onError),
+	}
+}
+
+// Notify buffer events to be delivered. It returns NotifierClosedError if notifier is closed
+func (b *BufferedNotifier) Notify(ctx context.Context, events []*model.LifecycleEvent) error {
+	items := make([]interface{}, 0, len(events))
+	for _, event := range events {
+		items = append(items, event)
+	}
+
+	err := b.buffer.Add(ctx, items...)
+	if _, ok := err.(*delivery.ClosedError); ok {
+		return NewNotifierClosedError()
+	}
+	return err
+}
+
+// Close stop accepting events and wait until buffered ones are delivered. If ctx ends first the
+// events not delivered yet are dropped and the error of ctx is returned
+func (b *BufferedNotifier) Close(ctx context.Context) error {
+	return b.buffer.Close(ctx)
+}
@@ -0,0 +1,74 @@
+package lifecycle_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/lifecycle"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+var _ = Describe("Buffered Notifier", func() {
+	var ctrl *gomock.Controller
+	var mock *lifecycle.MockNotifier
+
+	events := []*model.LifecycleEvent{
+		{ID: "event1", Type: model.LifecycleLeaderboardCreated, Leaderboard: "leaderboardTest"},
+		{ID: "event2", Type: model.LifecycleLeaderboardRemoved, Leaderboard: "leaderboardTest"},
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = lifecycle.NewMockNotifier(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should deliver buffered events in batches", func() {
+		mock.EXPECT().Notify(gomock.Any(), gomock.Eq(events[:1])).Return(nil)
+		mock.EXPECT().Notify(gomock.Any(), gomock.Eq(events[1:])).Return(nil)
+
+		bufferedNotifier := lifecycle.NewBufferedNotifier(mock, 10, 1, time.Millisecond, nil)
+		err := bufferedNotifier.Notify(context.Background(), events)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = bufferedNotifier.Close(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should retry events until they are delivered", func() {
+		var errors []error
+		var mutex sync.Mutex
+		mock.EXPECT().Notify(gomock.Any(), gomock.Eq(events[:1])).Return(fmt.Errorf("notifier error")).Times(2)
+		mock.EXPECT().Notify(gomock.Any(), gomock.Eq(events[:1])).Return(nil)
+
+		bufferedNotifier := lifecycle.NewBufferedNotifier(mock, 10, 10, time.Millisecond, func(err error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			errors = append(errors, err)
+		})
+
+		err := bufferedNotifier.Notify(context.Background(), events[:1])
+		Expect(err).NotTo(HaveOccurred())
+
+		err = bufferedNotifier.Close(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(errors).To(Equal([]error{fmt.Errorf("notifier error"), fmt.Errorf("notifier error")}))
+	})
+
+	It("Should return NotifierClosedError if notifier is closed", func() {
+		bufferedNotifier := lifecycle.NewBufferedNotifier(mock, 10, 10, time.Millisecond, nil)
+		err := bufferedNotifier.Close(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+
+		err = bufferedNotifier.Notify(context.Background(), events)
+		Expect(err).To(Equal(lifecycle.NewNotifierClosedError()))
+	})
+})
@@ -0,0 +1,33 @@
+package lifecycle
+
+import "fmt"
+
+// GeneralError is an error of a lifecycle notifier that is not handled
+type GeneralError struct {
+	notifier string
+	msg      string
+}
+
+func (ge *GeneralError) Error() string {
+	return fmt.Sprintf("%s notifier error: %s", ge.notifier, ge.msg)
+}
+
+// NewGeneralError create a new GeneralError
+func NewGeneralError(notifier, msg string) *GeneralError {
+	return &GeneralError{
+		notifier: notifier,
+		msg:      msg,
+	}
+}
+
+// NotifierClosedError is an error when lifecycle events are sent to a closed buffered notifier
+type NotifierClosedError struct{}
+
+func (nce *NotifierClosedError) Error() string {
+	return "lifecycle notifier is closed"
+}
+
+// NewNotifierClosedError create a new NotifierClosedError
+func NewNotifierClosedError() *NotifierClosedError {
+	return &NotifierClosedError{}
+}
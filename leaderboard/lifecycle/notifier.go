@@ -0,0 +1,21 @@
+package lifecycle
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// Notifier delivers lifecycle events of leaderboards to downstream systems. Notify must return only after
+// events are delivered, so a failed Notify can be retried without losing them, and events can be delivered
+// more than once, so receivers must deduplicate them by ID
+type Notifier interface {
+	Notify(ctx context.Context, events []*model.LifecycleEvent) error
+}
+
+// DeliveryStore keeps the attempts to deliver lifecycle events to the webhook and the events it failed to receive
+type DeliveryStore interface {
+	AddWebhookDeadLetter(ctx context.Context, deadLetter *database.WebhookDeadLetter) error
+	AddWebhookDelivery(ctx context.Context, delivery *database.WebhookDelivery) error
+}
@@ -0,0 +1,122 @@
+package lifecycle
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/delivery"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const webhookNotifierName = "webhook"
+
+// WebhookNotifier posts lifecycle events to an HTTP endpoint as a JSON object with field "events" with a
+// delivery.Webhook, which signs requests with its Secret as described in delivery.Sign and retries requests
+// that fail or are not answered with a 2xx status. Only events of the types in Events are posted, or all of
+// them if it is empty. Every attempt is recorded in Store, and events of the last failed attempt are moved to
+// its dead-letter store instead of failing Notify, so one unreachable webhook does not hold the following events
+type WebhookNotifier struct {
+	*delivery.Webhook
+	Events []string
+	Store  DeliveryStore
+	// OnStoreError is called with errors recording attempts, which don't fail the delivery
+	OnStoreError func(error)
+}
+
+type webhookPayload struct {
+	Events []*model.LifecycleEvent `json:"events"`
+}
+
+// NewWebhookNotifier create a new WebhookNotifier whose requests timeout after timeout
+func NewWebhookNotifier(url, secret string, events []string, timeout time.Duration, maxRetries int, retryBackoff time.Duration, store DeliveryStore, onStoreError func(error)) *WebhookNotifier {
+	if onStoreError == nil {
+		onStoreError = func(error) {}
+	}
+
+	return &WebhookNotifier{
+		Webhook:      delivery.NewWebhook(url, secret, timeout, maxRetries, retryBackoff),
+		Events:       events,
+		Store:        store,
+		OnStoreError: onStoreError,
+	}
+}
+
+// Notify post events to the webhook retrying it on failures, it only fails if ctx ends or events could not
+// be moved to the dead-letter store
+func (w *WebhookNotifier) Notify(ctx context.Context, events []*model.LifecycleEvent) error {
+	events = w.filter(events)
+	if len(events) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(&webhookPayload{Events: events})
+	if err != nil {
+		return NewGeneralError(webhookNotifierName, err.Error())
+	}
+
+	eventIDs := make([]string, 0, len(events))
+	for _, event := range events {
+		eventIDs = append(eventIDs, event.ID)
+	}
+
+	deliveryID := uuid.NewV4().String()
+	deadLettered := false
+	err = w.Post(ctx, payload, func(attempt *delivery.Attempt) error {
+		webhookDelivery := &database.WebhookDelivery{
+			ID:           deliveryID,
+			URL:          w.URL,
+			EventIDs:     eventIDs,
+			Attempt:      attempt.Number,
+			StatusCode:   attempt.StatusCode,
+			DeadLettered: attempt.Err != nil && attempt.Last,
+			AttemptedAt:  time.Now(),
+		}
+		if attempt.Err != nil {
+			webhookDelivery.Error = attempt.Err.Error()
+		}
+
+		if webhookDelivery.DeadLettered {
+			deadLetterErr := w.Store.AddWebhookDeadLetter(ctx, &database.WebhookDeadLetter{
+				DeliveryID:     deliveryID,
+				URL:            w.URL,
+				Payload:        string(payload),
+				Error:          webhookDelivery.Error,
+				DeadLetteredAt: webhookDelivery.AttemptedAt,
+			})
+			if deadLetterErr != nil {
+				return deadLetterErr
+			}
+			deadLettered = true
+		}
+
+		if storeErr := w.Store.AddWebhookDelivery(ctx, webhookDelivery); storeErr != nil {
+			w.OnStoreError(NewGeneralError(webhookNotifierName, storeErr.Error()))
+		}
+		return nil
+	})
+	if err != nil && !deadLettered {
+		return NewGeneralError(webhookNotifierName, err.Error())
+	}
+	return nil
+}
+
+func (w *WebhookNotifier) filter(events []*model.LifecycleEvent) []*model.LifecycleEvent {
+	if len(w.Events) == 0 {
+		return events
+	}
+
+	filtered := make([]*model.LifecycleEvent, 0, len(events))
+	for _, event := range events {
+		for _, eventType := range w.Events {
+			if event.Type == eventType {
+				filtered = append(filtered, event)
+				break
+			}
+		}
+	}
+
+	return filtered
+}
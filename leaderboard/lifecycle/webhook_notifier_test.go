@@ -0,0 +1,161 @@
+package lifecycle_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/delivery"
+	"github.com/topfreegames/podium/leaderboard/v2/lifecycle"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+var _ = Describe("Webhook Notifier", func() {
+	var ctrl *gomock.Controller
+	var store *lifecycle.MockDeliveryStore
+	var server *httptest.Server
+	var requests int32
+	var failures int32
+	var received []*model.LifecycleEvent
+
+	events := []*model.LifecycleEvent{
+		{ID: "event1", Type: model.LifecycleLeaderboardCreated, Leaderboard: "leaderboardTest", OccurredAt: 1600000000},
+		{ID: "event2", Type: model.LifecycleLeaderboardRemoved, Leaderboard: "leaderboardTest", OccurredAt: 1600000001},
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		store = lifecycle.NewMockDeliveryStore(ctrl)
+
+		requests = 0
+		failures = 0
+		received = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			if atomic.AddInt32(&requests, 1) <= atomic.LoadInt32(&failures) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			body, err := ioutil.ReadAll(r.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.Header.Get(delivery.TimestampHeader)).NotTo(BeEmpty())
+			Expect(r.Header.Get(delivery.SignatureHeader)).To(Equal(delivery.Sign("secret", r.Header.Get(delivery.TimestampHeader), body)))
+
+			var payload struct {
+				Events []*model.LifecycleEvent `json:"events"`
+			}
+			Expect(json.Unmarshal(body, &payload)).To(Succeed())
+			received = payload.Events
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+		ctrl.Finish()
+	})
+
+	It("Should post signed events to the webhook recording the delivery", func() {
+		store.EXPECT().AddWebhookDelivery(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, delivery *database.WebhookDelivery) error {
+				Expect(delivery.ID).NotTo(BeEmpty())
+				Expect(delivery.URL).To(Equal(server.URL))
+				Expect(delivery.EventIDs).To(Equal([]string{"event1", "event2"}))
+				Expect(delivery.Attempt).To(Equal(1))
+				Expect(delivery.StatusCode).To(Equal(http.StatusOK))
+				Expect(delivery.Error).To(BeEmpty())
+				Expect(delivery.DeadLettered).To(BeFalse())
+				return nil
+			},
+		)
+		webhookNotifier := lifecycle.NewWebhookNotifier(server.URL, "secret", nil, time.Second, 0, time.Millisecond, store, nil)
+
+		err := webhookNotifier.Notify(context.Background(), events)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(received).To(Equal(events))
+	})
+
+	It("Should post only events of the configured types", func() {
+		store.EXPECT().AddWebhookDelivery(gomock.Any(), gomock.Any()).Return(nil)
+		webhookNotifier := lifecycle.NewWebhookNotifier(server.URL, "secret", []string{model.LifecycleLeaderboardRemoved}, time.Second, 0, time.Millisecond, store, nil)
+
+		err := webhookNotifier.Notify(context.Background(), events)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(received).To(Equal(events[1:]))
+
+		err = webhookNotifier.Notify(context.Background(), events[:1])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requests).To(Equal(int32(1)))
+	})
+
+	It("Should retry failed requests recording every attempt", func() {
+		failures = 2
+		attempts := []*database.WebhookDelivery{}
+		store.EXPECT().AddWebhookDelivery(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, delivery *database.WebhookDelivery) error {
+				attempts = append(attempts, delivery)
+				return nil
+			},
+		).Times(3)
+		webhookNotifier := lifecycle.NewWebhookNotifier(server.URL, "secret", nil, time.Second, 2, time.Millisecond, store, nil)
+
+		err := webhookNotifier.Notify(context.Background(), events)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requests).To(Equal(int32(3)))
+		Expect(received).To(Equal(events))
+		Expect(attempts[0].Attempt).To(Equal(1))
+		Expect(attempts[0].StatusCode).To(Equal(http.StatusServiceUnavailable))
+		Expect(attempts[0].Error).To(Equal("unexpected status 503"))
+		Expect(attempts[2].Attempt).To(Equal(3))
+		Expect(attempts[2].ID).To(Equal(attempts[0].ID))
+		Expect(attempts[2].Error).To(BeEmpty())
+	})
+
+	It("Should move events to the dead-letter store if all retries fail", func() {
+		failures = 2
+		store.EXPECT().AddWebhookDelivery(gomock.Any(), gomock.Any()).Return(nil)
+		store.EXPECT().AddWebhookDeadLetter(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, deadLetter *database.WebhookDeadLetter) error {
+				Expect(deadLetter.URL).To(Equal(server.URL))
+				Expect(deadLetter.Error).To(Equal("unexpected status 503"))
+
+				var payload struct {
+					Events []*model.LifecycleEvent `json:"events"`
+				}
+				Expect(json.Unmarshal([]byte(deadLetter.Payload), &payload)).To(Succeed())
+				Expect(payload.Events).To(Equal(events))
+				return nil
+			},
+		)
+		store.EXPECT().AddWebhookDelivery(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, delivery *database.WebhookDelivery) error {
+				Expect(delivery.Attempt).To(Equal(2))
+				Expect(delivery.DeadLettered).To(BeTrue())
+				return nil
+			},
+		)
+		webhookNotifier := lifecycle.NewWebhookNotifier(server.URL, "secret", nil, time.Second, 1, time.Millisecond, store, nil)
+
+		err := webhookNotifier.Notify(context.Background(), events)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requests).To(Equal(int32(2)))
+	})
+
+	It("Should return GeneralError if events can't be moved to the dead-letter store", func() {
+		failures = 1
+		store.EXPECT().AddWebhookDeadLetter(gomock.Any(), gomock.Any()).Return(fmt.Errorf("store error"))
+		webhookNotifier := lifecycle.NewWebhookNotifier(server.URL, "secret", nil, time.Second, 0, time.Millisecond, store, nil)
+
+		err := webhookNotifier.Notify(context.Background(), events)
+		Expect(err).To(Equal(lifecycle.NewGeneralError("webhook", "store error")))
+	})
+})
@@ -0,0 +1,51 @@
+package milestones
+
+import (
+	"context"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/delivery"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// BufferedNotifier sends milestones to another notifier in background with a delivery.Buffer, so writes do
+// not wait for them to be delivered. When the buffer is full Notify blocks until there is room or ctx ends.
+// Milestones the notifier fails to deliver are retried until they are, and milestones still buffered when
+// the process stops are lost, so Close must be called to flush them
+type BufferedNotifier struct {
+	buffer *delivery.Buffer
+}
+
+// NewBufferedNotifier create a BufferedNotifier that sends batches of up to batchSize milestones to notifier,
+// calling onError with errors of notifier. It starts sending milestones right away
+func NewBufferedNotifier(notifier Notifier, size, batchSize int, retryInterval time.Duration, onError func(error)) *BufferedNotifier {
+	return &BufferedNotifier{
+		buffer: delivery.NewBuffer(size, batchSize, retryInterval, func(ctx context.Context, batch []interface{}) error {
+			milestones := make([]*model.Milestone, 0, len(batch))
+			for _, milestone := range batch {
+				milestones = append(milestones, milestone.(*model.Milestone))
+			}
+			return notifier.Notify(ctx, milestones)
+		}, onError),
+	}
+}
+
+// Notify buffer milestones to be delivered. It returns NotifierClosedError if notifier is closed
+func (b *BufferedNotifier) Notify(ctx context.Context, milestones []*model.Milestone) error {
+	items := make([]interface{}, 0, len(milestones))
+	for _, milestone := range milestones {
+		items = append(items, milestone)
+	}
+
+	err := b.buffer.Add(ctx, items...)
+	if _, ok := err.(*delivery.ClosedError); ok {
+		return NewNotifierClosedError()
+	}
+	return err
+}
+
+// Close stop accepting milestones and wait until buffered ones are delivered. If ctx ends first the
+// milestones not delivered yet are dropped and the error of ctx is returned
+func (b *BufferedNotifier) Close(ctx context.Context) error {
+	return b.buffer.Close(ctx)
+}
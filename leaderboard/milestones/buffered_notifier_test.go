@@ -0,0 +1,74 @@
+package milestones_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/milestones"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+var _ = Describe("Buffered Notifier", func() {
+	var ctrl *gomock.Controller
+	var mock *milestones.MockNotifier
+
+	reached := []*model.Milestone{
+		{ID: "milestone1", Leaderboard: "leaderboardTest", RuleID: "top100", PublicID: "member1"},
+		{ID: "milestone2", Leaderboard: "leaderboardTest", RuleID: "top100", PublicID: "member2"},
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = milestones.NewMockNotifier(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should deliver buffered milestones in batches", func() {
+		mock.EXPECT().Notify(gomock.Any(), gomock.Eq(reached[:1])).Return(nil)
+		mock.EXPECT().Notify(gomock.Any(), gomock.Eq(reached[1:])).Return(nil)
+
+		bufferedNotifier := milestones.NewBufferedNotifier(mock, 10, 1, time.Millisecond, nil)
+		err := bufferedNotifier.Notify(context.Background(), reached)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = bufferedNotifier.Close(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should retry milestones until they are delivered", func() {
+		var errors []error
+		var mutex sync.Mutex
+		mock.EXPECT().Notify(gomock.Any(), gomock.Eq(reached[:1])).Return(fmt.Errorf("notifier error")).Times(2)
+		mock.EXPECT().Notify(gomock.Any(), gomock.Eq(reached[:1])).Return(nil)
+
+		bufferedNotifier := milestones.NewBufferedNotifier(mock, 10, 10, time.Millisecond, func(err error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			errors = append(errors, err)
+		})
+
+		err := bufferedNotifier.Notify(context.Background(), reached[:1])
+		Expect(err).NotTo(HaveOccurred())
+
+		err = bufferedNotifier.Close(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(errors).To(Equal([]error{fmt.Errorf("notifier error"), fmt.Errorf("notifier error")}))
+	})
+
+	It("Should return NotifierClosedError if notifier is closed", func() {
+		bufferedNotifier := milestones.NewBufferedNotifier(mock, 10, 10, time.Millisecond, nil)
+		err := bufferedNotifier.Close(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+
+		err = bufferedNotifier.Notify(context.Background(), reached)
+		Expect(err).To(Equal(milestones.NewNotifierClosedError()))
+	})
+})
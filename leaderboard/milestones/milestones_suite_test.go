@@ -0,0 +1,13 @@
+package milestones_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestMilestones(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Milestones Suite")
+}
@@ -0,0 +1,14 @@
+package milestones
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// Notifier delivers milestones reached by members to downstream systems. Notify must return only after
+// milestones are delivered, so a failed Notify can be retried without losing them, and milestones can be
+// delivered more than once, so receivers must deduplicate them by ID
+type Notifier interface {
+	Notify(ctx context.Context, milestones []*model.Milestone) error
+}
@@ -0,0 +1,46 @@
+package milestones
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/delivery"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const webhookNotifierName = "webhook"
+
+// WebhookNotifier posts milestones to an HTTP endpoint as a JSON object with field "milestones" with a
+// delivery.Webhook, which signs requests with its Secret as described in delivery.Sign and retries requests
+// that fail or are not answered with a 2xx status
+type WebhookNotifier struct {
+	*delivery.Webhook
+}
+
+type webhookPayload struct {
+	Milestones []*model.Milestone `json:"milestones"`
+}
+
+// NewWebhookNotifier create a new WebhookNotifier whose requests timeout after timeout
+func NewWebhookNotifier(url, secret string, timeout time.Duration, maxRetries int, retryBackoff time.Duration) *WebhookNotifier {
+	return &WebhookNotifier{delivery.NewWebhook(url, secret, timeout, maxRetries, retryBackoff)}
+}
+
+// Notify post milestones to the webhook retrying it on failures
+func (w *WebhookNotifier) Notify(ctx context.Context, milestones []*model.Milestone) error {
+	if len(milestones) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(&webhookPayload{Milestones: milestones})
+	if err != nil {
+		return NewGeneralError(webhookNotifierName, err.Error())
+	}
+
+	err = w.Post(ctx, payload, nil)
+	if err != nil {
+		return NewGeneralError(webhookNotifierName, err.Error())
+	}
+	return nil
+}
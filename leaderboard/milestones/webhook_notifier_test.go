@@ -0,0 +1,84 @@
+package milestones_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/delivery"
+	"github.com/topfreegames/podium/leaderboard/v2/milestones"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+var _ = Describe("Webhook Notifier", func() {
+	var server *httptest.Server
+	var requests int32
+	var failures int32
+	var received []*model.Milestone
+
+	reached := []*model.Milestone{
+		{ID: "milestone1", Leaderboard: "leaderboardTest", RuleID: "top100", Type: model.MilestoneRankAtMost, PublicID: "member1", PreviousRank: 101, Rank: 100},
+	}
+
+	BeforeEach(func() {
+		requests = 0
+		failures = 0
+		received = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			if atomic.AddInt32(&requests, 1) <= atomic.LoadInt32(&failures) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			body, err := ioutil.ReadAll(r.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+			Expect(r.Header.Get(delivery.TimestampHeader)).NotTo(BeEmpty())
+			Expect(r.Header.Get(delivery.SignatureHeader)).To(Equal(delivery.Sign("secret", r.Header.Get(delivery.TimestampHeader), body)))
+
+			var payload struct {
+				Milestones []*model.Milestone `json:"milestones"`
+			}
+			Expect(json.Unmarshal(body, &payload)).To(Succeed())
+			received = payload.Milestones
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("Should post signed milestones to the webhook", func() {
+		webhookNotifier := milestones.NewWebhookNotifier(server.URL, "secret", time.Second, 0, time.Millisecond)
+
+		err := webhookNotifier.Notify(context.Background(), reached)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(received).To(Equal(reached))
+	})
+
+	It("Should retry failed requests", func() {
+		failures = 2
+		webhookNotifier := milestones.NewWebhookNotifier(server.URL, "secret", time.Second, 2, time.Millisecond)
+
+		err := webhookNotifier.Notify(context.Background(), reached)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requests).To(Equal(int32(3)))
+		Expect(received).To(Equal(reached))
+	})
+
+	It("Should return GeneralError if all retries fail", func() {
+		failures = 3
+		webhookNotifier := milestones.NewWebhookNotifier(server.URL, "secret", time.Second, 2, time.Millisecond)
+
+		err := webhookNotifier.Notify(context.Background(), reached)
+		Expect(err).To(Equal(milestones.NewGeneralError("webhook", "unexpected status 503")))
+		Expect(requests).To(Equal(int32(3)))
+	})
+})
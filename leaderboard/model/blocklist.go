@@ -0,0 +1,10 @@
+package model
+
+// Modes members can be blocked with
+const (
+	// BlockModeReject rejects writes of blocked members
+	BlockModeReject = "reject"
+	// BlockModeShadow accepts writes of blocked members but stores them apart from the leaderboard,
+	// so only the blocked members see their own scores
+	BlockModeShadow = "shadow"
+)
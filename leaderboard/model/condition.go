@@ -0,0 +1,10 @@
+package model
+
+// ScoreCondition makes a score write apply only if the member still has the expected score and version,
+// nil fields are not checked
+type ScoreCondition struct {
+	ExpectedScore *int64 `json:"expectedScore"`
+	// ExpectedVersion is the version of the member, incremented by every write of its score.
+	// A member that never had a score has version zero
+	ExpectedVersion *int64 `json:"expectedVersion"`
+}
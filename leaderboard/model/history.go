@@ -0,0 +1,8 @@
+package model
+
+// HistorySample is the score and rank of a member sampled when its score was written
+type HistorySample struct {
+	Score     int64 `json:"score"`
+	Rank      int   `json:"rank"`
+	SampledAt int64 `json:"sampledAt"`
+}
@@ -0,0 +1,10 @@
+package model
+
+import "time"
+
+// IdempotencyKey identifies a score write so that retries of it are applied only once
+type IdempotencyKey struct {
+	Key string `json:"key"`
+	// Window is how long the write result is kept to be returned to retries
+	Window time.Duration `json:"window"`
+}
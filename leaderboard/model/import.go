@@ -0,0 +1,24 @@
+package model
+
+// ImportFailure is a member of an imported chunk that could not be imported and why
+type ImportFailure struct {
+	PublicID string `json:"publicID"`
+	Reason   string `json:"reason"`
+}
+
+// ImportChunk acknowledges a chunk of members imported, numbered from zero in the order chunks were sent
+type ImportChunk struct {
+	Chunk    int              `json:"chunk"`
+	Imported int              `json:"imported"`
+	Failures []*ImportFailure `json:"failures"`
+}
+
+// ImportResult is the outcome of importing members into a leaderboard in chunks. Replaced tells if the
+// imported members replaced the leaderboard, which only happens when replacing was requested and no member failed
+type ImportResult struct {
+	Leaderboard string         `json:"leaderboard"`
+	Chunks      []*ImportChunk `json:"chunks"`
+	Imported    int            `json:"imported"`
+	Failed      int            `json:"failed"`
+	Replaced    bool           `json:"replaced"`
+}
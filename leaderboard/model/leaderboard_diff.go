@@ -0,0 +1,25 @@
+package model
+
+const (
+	// MemberDiffEntered is the change of members that entered the leaderboard
+	MemberDiffEntered = "entered"
+	// MemberDiffLeft is the change of members that left the leaderboard
+	MemberDiffLeft = "left"
+	// MemberDiffChanged is the change of members whose score or rank changed
+	MemberDiffChanged = "changed"
+)
+
+// MemberDiff represents how a member changed between two points in time of a leaderboard
+type MemberDiff struct {
+	PublicID string `json:"publicID"`
+	Change   string `json:"change"`
+	// PreviousScore and PreviousRank are nil and zero for members that entered the leaderboard
+	PreviousScore *int64 `json:"previousScore"`
+	PreviousRank  int    `json:"previousRank"`
+	// Score and Rank are nil and zero for members that left the leaderboard
+	Score *int64 `json:"score"`
+	Rank  int    `json:"rank"`
+	// ScoreDelta and RankDelta are only set for changed members, RankDelta is positive if the member climbed
+	ScoreDelta int64 `json:"scoreDelta"`
+	RankDelta  int   `json:"rankDelta"`
+}
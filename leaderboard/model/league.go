@@ -0,0 +1,37 @@
+package model
+
+// League holds the configuration of a leagues system, where members compete inside divisions
+// of a tier and are promoted or relegated between tiers at the end of each season
+type League struct {
+	ID string `json:"id"`
+	// Season is the number of the current season, starting at 1
+	Season int `json:"season"`
+	// Tiers is the number of tiers, tier 1 being the highest
+	Tiers int `json:"tiers"`
+	// DivisionSize is the maximum number of members in a division
+	DivisionSize int `json:"divisionSize"`
+	// PromotionCount is the number of first members of each division promoted at season end
+	PromotionCount int `json:"promotionCount"`
+	// RelegationCount is the number of last members of each division relegated at season end
+	RelegationCount int    `json:"relegationCount"`
+	Order           string `json:"order"`
+}
+
+// LeagueDivision maps a division of a league season to the leaderboard holding its standings
+type LeagueDivision struct {
+	League      string    `json:"league"`
+	Season      int       `json:"season"`
+	Tier        int       `json:"tier"`
+	Division    int       `json:"division"`
+	Leaderboard string    `json:"leaderboard"`
+	Members     []*Member `json:"members"`
+}
+
+// LeagueSeasonResult summarizes members moves when a league season ends
+type LeagueSeasonResult struct {
+	League    string `json:"league"`
+	Season    int    `json:"season"`
+	Promoted  int    `json:"promoted"`
+	Relegated int    `json:"relegated"`
+	Stayed    int    `json:"stayed"`
+}
@@ -0,0 +1,18 @@
+package model
+
+// LedgerReasonRollback is the reason of ledger entries of rollbacks made without one
+const LedgerReasonRollback = "rollback"
+
+// LedgerEntry is a change of a member score recorded in the ledger of a leaderboard
+type LedgerEntry struct {
+	Leaderboard string `json:"leaderboard"`
+	PublicID    string `json:"publicID"`
+	// OldScore is nil if member did not exist before the change
+	OldScore *int64 `json:"oldScore"`
+	// NewScore is nil if member was removed by the change
+	NewScore  *int64 `json:"newScore"`
+	Delta     int64  `json:"delta"`
+	Reason    string `json:"reason"`
+	Caller    string `json:"caller"`
+	ChangedAt int64  `json:"changedAt"`
+}
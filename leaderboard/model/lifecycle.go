@@ -0,0 +1,42 @@
+package model
+
+// Types of leaderboard lifecycle events
+const (
+	// LifecycleLeaderboardCreated is sent when the first score is written to a leaderboard, or after it was removed
+	LifecycleLeaderboardCreated = "leaderboard.created"
+	// LifecycleLeaderboardExpired is sent when a leaderboard with an expiration in its name expires
+	LifecycleLeaderboardExpired = "leaderboard.expired"
+	// LifecycleLeaderboardRemoved is sent when a leaderboard is removed
+	LifecycleLeaderboardRemoved = "leaderboard.removed"
+	// LifecycleLeaderboardFrozen is sent when a leaderboard is frozen
+	LifecycleLeaderboardFrozen = "leaderboard.frozen"
+	// LifecycleSeasonEnded is sent when the season of a league ends
+	LifecycleSeasonEnded = "league.seasonEnded"
+)
+
+// LifecycleEvent is a change in the lifecycle of a leaderboard, or of a league, delivered to the lifecycle notifier
+type LifecycleEvent struct {
+	// ID identifies the event, as it can be delivered more than once
+	ID          string `json:"id"`
+	Type        string `json:"type"`
+	Leaderboard string `json:"leaderboard,omitempty"`
+	// League and Season are the league and the season that ended of LifecycleSeasonEnded events
+	League     string `json:"league,omitempty"`
+	Season     int    `json:"season,omitempty"`
+	OccurredAt int64  `json:"occurredAt"`
+}
+
+// WebhookDelivery is an attempt to deliver lifecycle events to the lifecycle webhook
+type WebhookDelivery struct {
+	// ID identifies the delivery, every attempt to deliver the same events has the same ID
+	ID       string   `json:"id"`
+	URL      string   `json:"url"`
+	EventIDs []string `json:"eventIDs"`
+	Attempt  int      `json:"attempt"`
+	// StatusCode is the status the webhook answered with, zero if the request failed before
+	StatusCode int    `json:"statusCode"`
+	Error      string `json:"error,omitempty"`
+	// DeadLettered tells if the attempt was the last one and its events were moved to the dead-letter store
+	DeadLettered bool  `json:"deadLettered"`
+	AttemptedAt  int64 `json:"attemptedAt"`
+}
@@ -7,4 +7,5 @@ type Member struct {
 	Rank         int    `json:"rank"`
 	PreviousRank int    `json:"previousRank"`
 	ExpireAt     int    `json:"expireAt"`
+	Version      int64  `json:"version"`
 }
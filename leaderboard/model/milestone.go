@@ -0,0 +1,37 @@
+package model
+
+// Types of milestone rules of leaderboard settings
+const (
+	// MilestoneRankAtMost is reached when a member rank crosses into Rank or better
+	MilestoneRankAtMost = "rankAtMost"
+	// MilestonePassedMember is reached when a member overtakes Member
+	MilestonePassedMember = "passedMember"
+)
+
+// MilestoneRule is a rank milestone of a leaderboard that members reach by writes of their scores
+type MilestoneRule struct {
+	// ID identifies the rule in the milestones it matches
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	// Rank is the rank, in descending order, MilestoneRankAtMost rules are reached at
+	Rank int `json:"rank,omitempty"`
+	// Member is the public ID of the member MilestonePassedMember rules are reached by overtaking
+	Member string `json:"member,omitempty"`
+}
+
+// Milestone is a milestone rule of a leaderboard reached by a member, delivered to the milestone notifier
+type Milestone struct {
+	// ID identifies the milestone, as it can be delivered more than once
+	ID          string `json:"id"`
+	Leaderboard string `json:"leaderboard"`
+	RuleID      string `json:"ruleID"`
+	Type        string `json:"type"`
+	PublicID    string `json:"publicID"`
+	Score       int64  `json:"score"`
+	// PreviousRank and Rank are ranks in descending order, PreviousRank is -1 if member was not in the leaderboard
+	PreviousRank int `json:"previousRank"`
+	Rank         int `json:"rank"`
+	// PassedMember is the member overtaken in MilestonePassedMember milestones
+	PassedMember string `json:"passedMember,omitempty"`
+	ReachedAt    int64  `json:"reachedAt"`
+}
@@ -0,0 +1,19 @@
+package model
+
+// RankMove represents how a member rank changed between two points in time
+type RankMove struct {
+	PublicID     string `json:"publicID"`
+	PreviousRank int    `json:"previousRank"`
+	Rank         int    `json:"rank"`
+	// RankDelta is how many positions the member climbed, negative if it fell
+	RankDelta int `json:"rankDelta"`
+}
+
+// RankMovers represents the members whose ranks changed the most between two points in time
+type RankMovers struct {
+	// From and To are unix timestamps of the rank snapshots compared, To is zero when compared to the present
+	From     int64       `json:"from"`
+	To       int64       `json:"to"`
+	Climbers []*RankMove `json:"climbers"`
+	Fallers  []*RankMove `json:"fallers"`
+}
@@ -0,0 +1,17 @@
+package model
+
+// RateLimit is a token bucket that holds up to Burst tokens and is refilled with Rate tokens per second
+type RateLimit struct {
+	Rate  float64 `json:"rate"`
+	Burst int64   `json:"burst"`
+}
+
+// RateLimits are the rate limits of score writes, nil limits are not applied
+type RateLimits struct {
+	// Member limits writes to the score of each member of a leaderboard
+	Member *RateLimit `json:"member"`
+	// Leaderboard limits writes to each leaderboard
+	Leaderboard *RateLimit `json:"leaderboard"`
+	// Caller limits writes of each caller
+	Caller *RateLimit `json:"caller"`
+}
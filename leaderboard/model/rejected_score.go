@@ -0,0 +1,20 @@
+package model
+
+// Rules of leaderboard settings that reject scores
+const (
+	ScoreRuleMinScore      = "minScore"
+	ScoreRuleMaxScore      = "maxScore"
+	ScoreRuleMaxIncrement  = "maxIncrement"
+	ScoreRuleMaxIncrease   = "maxIncrease"
+	ScoreRuleMonotonicOnly = "monotonicOnly"
+)
+
+// RejectedScore is a score submission rejected by a rule of the leaderboard settings, kept for review
+type RejectedScore struct {
+	PublicID string `json:"publicID"`
+	// Score is the score the member would have if the submission was accepted
+	Score         int64  `json:"score"`
+	PreviousScore int64  `json:"previousScore"`
+	Rule          string `json:"rule"`
+	RejectedAt    int64  `json:"rejectedAt"`
+}
@@ -0,0 +1,48 @@
+package model
+
+// ResetStrategy defines how members scores are carried over when a leaderboard is reset
+type ResetStrategy string
+
+const (
+	// ResetStrategyZero keeps all members with score equals zero
+	ResetStrategyZero ResetStrategy = "zero"
+	// ResetStrategyDelete removes all members
+	ResetStrategyDelete ResetStrategy = "delete"
+	// ResetStrategyCompress moves all scores toward Base using new = base + (old - base) * factor
+	ResetStrategyCompress ResetStrategy = "compress"
+	// ResetStrategyTopK keeps only the first TopK members with their scores
+	ResetStrategyTopK ResetStrategy = "topK"
+)
+
+const (
+	// ResetStatusRunning is the status of a reset still being applied
+	ResetStatusRunning = "running"
+	// ResetStatusDone is the status of a reset applied successfully
+	ResetStatusDone = "done"
+	// ResetStatusFailed is the status of a reset that stopped with an error
+	ResetStatusFailed = "failed"
+)
+
+// ResetOptions holds the parameters used to reset a leaderboard
+type ResetOptions struct {
+	Strategy ResetStrategy `json:"strategy"`
+	// Target is the leaderboard that will receive the result, if empty the leaderboard itself is reset
+	Target    string  `json:"target"`
+	Base      int64   `json:"base"`
+	Factor    float64 `json:"factor"`
+	TopK      int     `json:"topK"`
+	Order     string  `json:"order"`
+	ChunkSize int     `json:"chunkSize"`
+}
+
+// ResetProgress maps the execution state of a leaderboard reset
+type ResetProgress struct {
+	Leaderboard string        `json:"leaderboard"`
+	Target      string        `json:"target"`
+	Strategy    ResetStrategy `json:"strategy"`
+	Status      string        `json:"status"`
+	Processed   int           `json:"processed"`
+	Total       int           `json:"total"`
+	StartedAt   int64         `json:"startedAt"`
+	FinishedAt  int64         `json:"finishedAt"`
+}
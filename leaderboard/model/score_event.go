@@ -0,0 +1,19 @@
+package model
+
+// ScoreEvent is a change of a member score and rank made by a write, published to the event sink
+type ScoreEvent struct {
+	// ID identifies the event, as it can be published more than once
+	ID          string `json:"id"`
+	Leaderboard string `json:"leaderboard"`
+	PublicID    string `json:"publicID"`
+	// OldScore is nil if member did not exist before the change
+	OldScore *int64 `json:"oldScore"`
+	// NewScore is nil if member was removed by the change
+	NewScore *int64 `json:"newScore"`
+	// OldRank and NewRank are ranks in descending order, -1 if member was not in the leaderboard
+	OldRank   int    `json:"oldRank"`
+	NewRank   int    `json:"newRank"`
+	Reason    string `json:"reason"`
+	Caller    string `json:"caller"`
+	ChangedAt int64  `json:"changedAt"`
+}
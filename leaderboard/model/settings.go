@@ -0,0 +1,44 @@
+package model
+
+// LeaderboardSettings holds configurations that change how a single leaderboard behaves
+type LeaderboardSettings struct {
+	// DecayHalfLife is the time in seconds a score takes to lose half of its value, zero disables decay
+	DecayHalfLife int64 `json:"decayHalfLife"`
+	// DecayLandmark is the unix timestamp stored scores are relative to, it is managed by the service
+	DecayLandmark int64 `json:"decayLandmark"`
+	// WriteStartAt and WriteEndAt are unix timestamps limiting when scores can be written, zero means no limit
+	WriteStartAt int64 `json:"writeStartAt"`
+	WriteEndAt   int64 `json:"writeEndAt"`
+	// ParticipantsOnly rejects scores of members that did not join the leaderboard
+	ParticipantsOnly bool `json:"participantsOnly"`
+	// Frozen rejects all writes to the leaderboard
+	Frozen bool `json:"frozen"`
+	// MinScore and MaxScore reject scores outside of them, nil means no limit
+	MinScore *int64 `json:"minScore"`
+	MaxScore *int64 `json:"maxScore"`
+	// MaxIncrement rejects writes that increase a member score by more than it, zero means no limit
+	MaxIncrement int64 `json:"maxIncrement"`
+	// MaxIncrease rejects writes that increase a member score by more than it in total within
+	// MaxIncreaseWindow seconds, zero means no limit
+	MaxIncrease       int64 `json:"maxIncrease"`
+	MaxIncreaseWindow int64 `json:"maxIncreaseWindow"`
+	// MonotonicOnly rejects writes that decrease a member score
+	MonotonicOnly bool `json:"monotonicOnly"`
+	// SignatureGame is the game whose secret must sign writes to the leaderboard, empty accepts unsigned writes
+	SignatureGame string `json:"signatureGame"`
+	// HistoryEnabled samples the score and rank of members on every write of their scores
+	HistoryEnabled bool `json:"historyEnabled"`
+	// HistoryInterval keeps only the last sample of each member in each interval of seconds, zero keeps all of them
+	HistoryInterval int64 `json:"historyInterval"`
+	// SnapshotInterval is the time in seconds between rank snapshots of the leaderboard, zero disables them
+	SnapshotInterval int64 `json:"snapshotInterval"`
+	// SnapshotRetention is the time in seconds rank snapshots are kept, zero keeps them while the leaderboard exists
+	SnapshotRetention int64 `json:"snapshotRetention"`
+	// LedgerRetention is the time in seconds score changes are kept in member ledgers, zero keeps them while
+	// the leaderboard exists
+	LedgerRetention int64 `json:"ledgerRetention"`
+	// LedgerMaxEntries is how many of the last score changes each member ledger keeps, zero keeps all of them
+	LedgerMaxEntries int64 `json:"ledgerMaxEntries"`
+	// Milestones are rank milestones members reach by writes of their scores
+	Milestones []*MilestoneRule `json:"milestones"`
+}
@@ -0,0 +1,45 @@
+package model
+
+// Tournament statuses, derived from the write window and finalization
+const (
+	TournamentScheduled = "scheduled"
+	TournamentRunning   = "running"
+	TournamentEnded     = "ended"
+	TournamentFinalized = "finalized"
+)
+
+// Tournament holds the configuration of a tournament, a leaderboard with the same ID that only
+// accepts scores of members that joined it between StartAt and EndAt
+type Tournament struct {
+	ID string `json:"id"`
+	// StartAt and EndAt are unix timestamps of the window scores can be written
+	StartAt int64  `json:"startAt"`
+	EndAt   int64  `json:"endAt"`
+	Order   string `json:"order"`
+	// Prizes maps rank ranges to the rewards given when the tournament is finalized
+	Prizes []*TournamentPrize `json:"prizes"`
+	Status string             `json:"status"`
+	// FinalizedAt is the unix timestamp the tournament was finalized, zero if it was not
+	FinalizedAt int64 `json:"finalizedAt"`
+}
+
+// TournamentPrize is a reward given to members finishing between FromRank and ToRank, both inclusive
+type TournamentPrize struct {
+	FromRank int    `json:"fromRank"`
+	ToRank   int    `json:"toRank"`
+	RewardID string `json:"rewardID"`
+}
+
+// TournamentWinner is a member that finished a tournament inside a prize rank range
+type TournamentWinner struct {
+	PublicID string `json:"publicID"`
+	Score    int64  `json:"score"`
+	Rank     int    `json:"rank"`
+	RewardID string `json:"rewardID"`
+}
+
+// TournamentResult holds a finalized tournament and the winners of its prizes
+type TournamentResult struct {
+	Tournament *Tournament         `json:"tournament"`
+	Winners    []*TournamentWinner `json:"winners"`
+}
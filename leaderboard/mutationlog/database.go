@@ -0,0 +1,315 @@
+package mutationlog
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+)
+
+const outboxName = "outbox"
+
+// Database is a database.Database adding its writes to the outboxes of the slots they write, in the same
+// script as the write, for a Shipper to append them to a Log
+type Database struct {
+	database.Database
+}
+
+// NewDatabase create a new Database writing to db
+func NewDatabase(db database.Database) *Database {
+	return &Database{
+		Database: db,
+	}
+}
+
+// ExpirationDatabase is a database.Expiration adding the members it expires to the outbox of their
+// leaderboard, like Database
+type ExpirationDatabase struct {
+	database.Expiration
+}
+
+// NewExpirationDatabase create a new ExpirationDatabase expiring members of db
+func NewExpirationDatabase(db database.Expiration) *ExpirationDatabase {
+	return &ExpirationDatabase{
+		Expiration: db,
+	}
+}
+
+// withMutation return a copy of ctx whose write adds mutation, applied now, to its outbox
+func withMutation(ctx context.Context, mutation *Mutation) (context.Context, error) {
+	mutation.At = toMilliseconds(time.Now())
+	payload, err := json.Marshal(mutation)
+	if err != nil {
+		return nil, NewGeneralError(outboxName, err.Error())
+	}
+
+	return database.WithMutation(ctx, string(payload)), nil
+}
+
+// ExpireMembers remove members from leaderboard and log it
+func (e *ExpirationDatabase) ExpireMembers(ctx context.Context, leaderboard string, members []string) error {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpExpireMembers, Leaderboard: leaderboard, MemberIDs: members})
+	if err != nil {
+		return err
+	}
+
+	return e.Expiration.ExpireMembers(ctx, leaderboard, members)
+}
+
+// AddLeaderboardParticipants add members to leaderboard participants and log it
+func (d *Database) AddLeaderboardParticipants(ctx context.Context, leaderboard string, joinedAt time.Time, members ...string) error {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpAddLeaderboardParticipants, Leaderboard: leaderboard, JoinedAt: toMilliseconds(joinedAt), MemberIDs: members})
+	if err != nil {
+		return err
+	}
+
+	return d.Database.AddLeaderboardParticipants(ctx, leaderboard, joinedAt, members...)
+}
+
+// AddLeaderboardToDecayList add leaderboard to the decay list and log it
+func (d *Database) AddLeaderboardToDecayList(ctx context.Context, leaderboard string) error {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpAddLeaderboardToDecayList, Leaderboard: leaderboard})
+	if err != nil {
+		return err
+	}
+
+	return d.Database.AddLeaderboardToDecayList(ctx, leaderboard)
+}
+
+// AddLeaderboardToSnapshotList add leaderboard to the snapshot list and log it
+func (d *Database) AddLeaderboardToSnapshotList(ctx context.Context, leaderboard string) error {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpAddLeaderboardToSnapshotList, Leaderboard: leaderboard})
+	if err != nil {
+		return err
+	}
+
+	return d.Database.AddLeaderboardToSnapshotList(ctx, leaderboard)
+}
+
+// BlockMembers block members in leaderboard and log it
+func (d *Database) BlockMembers(ctx context.Context, leaderboard, mode string, members ...string) error {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpBlockMembers, Leaderboard: leaderboard, Mode: mode, MemberIDs: members})
+	if err != nil {
+		return err
+	}
+
+	return d.Database.BlockMembers(ctx, leaderboard, mode, members...)
+}
+
+// CreateTournament create tournament config and log it as set, unless tournament existed
+func (d *Database) CreateTournament(ctx context.Context, tournament string, config *database.Tournament) (bool, error) {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpSetTournament, Leaderboard: tournament, Tournament: config})
+	if err != nil {
+		return false, err
+	}
+
+	return d.Database.CreateTournament(ctx, tournament, config)
+}
+
+// EndLeagueSeason end season of league and log it, unless it was not the current season
+func (d *Database) EndLeagueSeason(ctx context.Context, league string, season int, result *database.LeagueSeasonResult) (bool, error) {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpEndLeagueSeason, Leaderboard: league, Season: season, SeasonResult: result})
+	if err != nil {
+		return false, err
+	}
+
+	return d.Database.EndLeagueSeason(ctx, league, season, result)
+}
+
+// ImportMembers set the score of batches of members into target one at a time, logging each batch set as a
+// write of its members in target
+func (d *Database) ImportMembers(ctx context.Context, leaderboard, target string, batches ...[]*database.Member) []error {
+	errs := make([]error, len(batches))
+	for i, batch := range batches {
+		batchCtx, err := withMutation(ctx, &Mutation{Op: OpSetMembers, Leaderboard: target, Members: fromDatabaseMembers(batch)})
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+
+		errs[i] = d.Database.ImportMembers(batchCtx, leaderboard, target, batch)[0]
+	}
+	return errs
+}
+
+// IncrementMemberScore increment member score and log it
+func (d *Database) IncrementMemberScore(ctx context.Context, leaderboard string, databaseMember *database.Member, increment float64) error {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpIncrementMemberScore, Leaderboard: leaderboard, MemberIDs: []string{databaseMember.Member}, Increment: increment})
+	if err != nil {
+		return err
+	}
+
+	return d.Database.IncrementMemberScore(ctx, leaderboard, databaseMember, increment)
+}
+
+// IncrementMemberScoreIdempotent increment member score and log it, unless idempotency key was already used
+func (d *Database) IncrementMemberScoreIdempotent(ctx context.Context, leaderboard string, databaseMember *database.Member, increment float64, idempotency *database.Idempotency) (bool, error) {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpIncrementMemberScore, Leaderboard: leaderboard, MemberIDs: []string{databaseMember.Member}, Increment: increment})
+	if err != nil {
+		return false, err
+	}
+
+	return d.Database.IncrementMemberScoreIdempotent(ctx, leaderboard, databaseMember, increment, idempotency)
+}
+
+// JoinLeagueDivisions place members in divisions of league and log it
+func (d *Database) JoinLeagueDivisions(ctx context.Context, league string, season, tier, divisionSize int, members ...string) ([]*database.LeagueDivision, error) {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpJoinLeagueDivisions, Leaderboard: league, Season: season, Tier: tier, DivisionSize: divisionSize, MemberIDs: members})
+	if err != nil {
+		return nil, err
+	}
+
+	return d.Database.JoinLeagueDivisions(ctx, league, season, tier, divisionSize, members...)
+}
+
+// RemoveLeaderboard remove leaderboard and log it
+func (d *Database) RemoveLeaderboard(ctx context.Context, leaderboard string) error {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpRemoveLeaderboard, Leaderboard: leaderboard})
+	if err != nil {
+		return err
+	}
+
+	return d.Database.RemoveLeaderboard(ctx, leaderboard)
+}
+
+// RemoveMembers remove members from leaderboard and log it
+func (d *Database) RemoveMembers(ctx context.Context, leaderboard string, members ...string) error {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpRemoveMembers, Leaderboard: leaderboard, MemberIDs: members})
+	if err != nil {
+		return err
+	}
+
+	return d.Database.RemoveMembers(ctx, leaderboard, members...)
+}
+
+// RenameLeaderboard rename leaderboard to newLeaderboard and log it
+func (d *Database) RenameLeaderboard(ctx context.Context, leaderboard, newLeaderboard string) error {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpRenameLeaderboard, Leaderboard: leaderboard, NewLeaderboard: newLeaderboard})
+	if err != nil {
+		return err
+	}
+
+	return d.Database.RenameLeaderboard(ctx, leaderboard, newLeaderboard)
+}
+
+// ScaleLeaderboard scale leaderboard scores by factor and log it
+func (d *Database) ScaleLeaderboard(ctx context.Context, leaderboard string, factor float64, settings map[string]string) error {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpScaleLeaderboard, Leaderboard: leaderboard, Factor: factor, Settings: settings})
+	if err != nil {
+		return err
+	}
+
+	return d.Database.ScaleLeaderboard(ctx, leaderboard, factor, settings)
+}
+
+// SetLeaderboardExpiration set leaderboard expiration and log it
+func (d *Database) SetLeaderboardExpiration(ctx context.Context, leaderboard string, expireAt time.Time) error {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpSetLeaderboardExpiration, Leaderboard: leaderboard, ExpireAt: toMilliseconds(expireAt)})
+	if err != nil {
+		return err
+	}
+
+	return d.Database.SetLeaderboardExpiration(ctx, leaderboard, expireAt)
+}
+
+// SetLeaderboardSettings set leaderboard settings and log it
+func (d *Database) SetLeaderboardSettings(ctx context.Context, leaderboard string, settings map[string]string) error {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpSetLeaderboardSettings, Leaderboard: leaderboard, Settings: settings})
+	if err != nil {
+		return err
+	}
+
+	return d.Database.SetLeaderboardSettings(ctx, leaderboard, settings)
+}
+
+// SetLeague set league config and log it
+func (d *Database) SetLeague(ctx context.Context, league string, config *database.League) error {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpSetLeague, Leaderboard: league, League: config})
+	if err != nil {
+		return err
+	}
+
+	return d.Database.SetLeague(ctx, league, config)
+}
+
+// SetMemberIfMatch set member score if it matches condition and log it as a write of member
+func (d *Database) SetMemberIfMatch(ctx context.Context, leaderboard string, member *database.Member, condition *database.Condition) error {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpSetMembers, Leaderboard: leaderboard, Members: fromDatabaseMembers([]*database.Member{member})})
+	if err != nil {
+		return err
+	}
+
+	return d.Database.SetMemberIfMatch(ctx, leaderboard, member, condition)
+}
+
+// SetMemberIfMatchIdempotent set member score if it matches condition and log it as a write of member,
+// unless idempotency key was already used
+func (d *Database) SetMemberIfMatchIdempotent(ctx context.Context, leaderboard string, databaseMember *database.Member, condition *database.Condition, idempotency *database.Idempotency) (bool, error) {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpSetMembers, Leaderboard: leaderboard, Members: fromDatabaseMembers([]*database.Member{databaseMember})})
+	if err != nil {
+		return false, err
+	}
+
+	return d.Database.SetMemberIfMatchIdempotent(ctx, leaderboard, databaseMember, condition, idempotency)
+}
+
+// SetMembers set members scores and log it
+func (d *Database) SetMembers(ctx context.Context, leaderboard string, databaseMembers []*database.Member) error {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpSetMembers, Leaderboard: leaderboard, Members: fromDatabaseMembers(databaseMembers)})
+	if err != nil {
+		return err
+	}
+
+	return d.Database.SetMembers(ctx, leaderboard, databaseMembers)
+}
+
+// SetMembersIdempotent set members scores and log it, unless idempotency key was already used
+func (d *Database) SetMembersIdempotent(ctx context.Context, leaderboard string, databaseMembers []*database.Member, idempotency *database.Idempotency) (bool, error) {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpSetMembers, Leaderboard: leaderboard, Members: fromDatabaseMembers(databaseMembers)})
+	if err != nil {
+		return false, err
+	}
+
+	return d.Database.SetMembersIdempotent(ctx, leaderboard, databaseMembers, idempotency)
+}
+
+// SetMembersTTL set members expiration and log it
+func (d *Database) SetMembersTTL(ctx context.Context, leaderboard string, databaseMembers []*database.Member) error {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpSetMembersTTL, Leaderboard: leaderboard, Members: fromDatabaseMembers(databaseMembers)})
+	if err != nil {
+		return err
+	}
+
+	return d.Database.SetMembersTTL(ctx, leaderboard, databaseMembers)
+}
+
+// SetTournament set tournament config and log it
+func (d *Database) SetTournament(ctx context.Context, tournament string, config *database.Tournament) error {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpSetTournament, Leaderboard: tournament, Tournament: config})
+	if err != nil {
+		return err
+	}
+
+	return d.Database.SetTournament(ctx, tournament, config)
+}
+
+// TrimLeaderboard keep the first size members of leaderboard in order and log it
+func (d *Database) TrimLeaderboard(ctx context.Context, leaderboard string, size int, order string) error {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpTrimLeaderboard, Leaderboard: leaderboard, Size: size, Order: order})
+	if err != nil {
+		return err
+	}
+
+	return d.Database.TrimLeaderboard(ctx, leaderboard, size, order)
+}
+
+// UnblockMembers unblock members in leaderboard and log it
+func (d *Database) UnblockMembers(ctx context.Context, leaderboard string, members ...string) error {
+	ctx, err := withMutation(ctx, &Mutation{Op: OpUnblockMembers, Leaderboard: leaderboard, MemberIDs: members})
+	if err != nil {
+		return err
+	}
+
+	return d.Database.UnblockMembers(ctx, leaderboard, members...)
+}
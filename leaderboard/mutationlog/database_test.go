@@ -0,0 +1,152 @@
+package mutationlog_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+	"github.com/topfreegames/podium/leaderboard/v2/mutationlog"
+)
+
+var _ = Describe("Mutation Log Database", func() {
+	var ctrl *gomock.Controller
+	var mockDatabase *database.MockDatabase
+	var mockRedis *redis.MockRedis
+	var mockLog *mutationlog.MockLog
+	var db *mutationlog.Database
+
+	leaderboard := "leaderboardTest"
+	outbox := "{leaderboardTest}:mutations"
+	members := []*database.Member{{Member: "member1", Score: 10}}
+
+	// expectWrite expect a write adding to outbox the mutation passed to fn, which outbox already had
+	expectWrite := func(fn func(mutation *mutationlog.Mutation, args []interface{}), err error) *gomock.Call {
+		return mockRedis.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+				Expect(keys[0]).To(Equal(outbox))
+
+				mutation := &mutationlog.Mutation{}
+				Expect(json.Unmarshal([]byte(args[0].(string)), mutation)).To(Succeed())
+				fn(mutation, args[2:])
+				return []interface{}{int64(0), []interface{}{int64(1)}}, err
+			},
+		)
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockDatabase = database.NewMockDatabase(ctrl)
+		mockRedis = redis.NewMockRedis(ctrl)
+		mockLog = mutationlog.NewMockLog(ctrl)
+
+		db = mutationlog.NewDatabase(&database.Redis{Client: mockRedis})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should add writes to the outbox of their leaderboard in the write script", func() {
+		expectWrite(func(mutation *mutationlog.Mutation, args []interface{}) {
+			Expect(mutation.Op).To(Equal(mutationlog.OpSetMembers))
+			Expect(mutation.Leaderboard).To(Equal(leaderboard))
+			Expect(mutation.Members).To(Equal([]*mutationlog.Member{{ID: "member1", Score: 10}}))
+			Expect(mutation.At).To(BeNumerically("~", time.Now().UnixNano()/int64(time.Millisecond), 1000))
+		}, nil)
+
+		err := db.SetMembers(context.Background(), leaderboard, members)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should add each batch imported as a write of its members", func() {
+		gomock.InOrder(
+			expectWrite(func(mutation *mutationlog.Mutation, args []interface{}) {
+				Expect(mutation.Op).To(Equal(mutationlog.OpSetMembers))
+				Expect(mutation.Members).To(Equal([]*mutationlog.Member{{ID: "member1", Score: 10}}))
+				Expect(args).To(Equal([]interface{}{"10", "member1"}))
+			}, nil),
+			expectWrite(func(mutation *mutationlog.Mutation, args []interface{}) {
+				Expect(mutation.Members).To(Equal([]*mutationlog.Member{{ID: "member2", Score: 20}}))
+			}, fmt.Errorf("redis error")),
+		)
+
+		errs := db.ImportMembers(context.Background(), leaderboard, leaderboard, members, []*database.Member{{Member: "member2", Score: 20}})
+		Expect(errs).To(Equal([]error{nil, database.NewGeneralError("redis error")}))
+	})
+
+	It("Should add members expired to the outbox of their leaderboard", func() {
+		expectWrite(func(mutation *mutationlog.Mutation, args []interface{}) {
+			Expect(mutation.Op).To(Equal(mutationlog.OpExpireMembers))
+			Expect(mutation.MemberIDs).To(Equal([]string{"member1"}))
+			Expect(args).To(Equal([]interface{}{"ZREM", "member1"}))
+		}, nil)
+		mockRedis.EXPECT().ZRem(gomock.Any(), gomock.Eq("leaderboardTest:ttl"), gomock.Eq("member1")).Return(nil)
+
+		expiration := mutationlog.NewExpirationDatabase(&database.Redis{Client: mockRedis})
+		err := expiration.ExpireMembers(context.Background(), leaderboard, []string{"member1"})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should replay mutations applied until a time", func() {
+		mockLog.EXPECT().Replay(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(*mutationlog.Mutation) error) error {
+			for _, mutation := range []*mutationlog.Mutation{
+				{Op: mutationlog.OpSetMembers, Leaderboard: leaderboard, Members: []*mutationlog.Member{{ID: "member1", Score: 10}}, At: 1600000000000},
+				{Op: mutationlog.OpIncrementMemberScore, Leaderboard: leaderboard, MemberIDs: []string{"member1"}, Increment: 5, At: 1600000001000},
+				{Op: mutationlog.OpRemoveLeaderboard, Leaderboard: leaderboard, At: 1600000002000},
+			} {
+				if err := fn(mutation); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		gomock.InOrder(
+			mockDatabase.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq([]*database.Member{{Member: "member1", Score: 10}})).Return(nil),
+			mockDatabase.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(&database.Member{Member: "member1"}), gomock.Eq(float64(5))).Return(nil),
+		)
+
+		applied, err := mutationlog.Replay(context.Background(), mockLog, mockDatabase, time.Unix(1600000001, 0))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(applied).To(Equal(2))
+	})
+
+	It("Should replay mutations appended again once", func() {
+		mockLog.EXPECT().Replay(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(*mutationlog.Mutation) error) error {
+			for _, mutation := range []*mutationlog.Mutation{
+				{Op: mutationlog.OpRemoveMembers, Leaderboard: leaderboard, MemberIDs: []string{"member1"}, Outbox: outbox, StreamID: "1600000000000-0"},
+				{Op: mutationlog.OpRemoveMembers, Leaderboard: leaderboard, MemberIDs: []string{"member2"}, Outbox: outbox, StreamID: "1600000000000-1"},
+				{Op: mutationlog.OpRemoveMembers, Leaderboard: leaderboard, MemberIDs: []string{"member2"}, Outbox: outbox, StreamID: "1600000000000-1"},
+				{Op: mutationlog.OpRemoveMembers, Leaderboard: "other", MemberIDs: []string{"member3"}, Outbox: "{other}:mutations", StreamID: "1599999999999-0"},
+			} {
+				if err := fn(mutation); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		gomock.InOrder(
+			mockDatabase.EXPECT().RemoveMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1")).Return(nil),
+			mockDatabase.EXPECT().RemoveMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member2")).Return(nil),
+			mockDatabase.EXPECT().RemoveMembers(gomock.Any(), gomock.Eq("other"), gomock.Eq("member3")).Return(nil),
+		)
+
+		applied, err := mutationlog.Replay(context.Background(), mockLog, mockDatabase, time.Time{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(applied).To(Equal(3))
+	})
+
+	It("Should stop replaying at a mutation that could not be applied", func() {
+		mockLog.EXPECT().Replay(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(*mutationlog.Mutation) error) error {
+			return fn(&mutationlog.Mutation{Op: "unknown", Leaderboard: leaderboard, At: 1600000000000})
+		})
+
+		_, err := mutationlog.Replay(context.Background(), mockLog, mockDatabase, time.Time{})
+		Expect(err).To(MatchError("could not apply mutation unknown of leaderboardTest at 1600000000000: invalid mutation op unknown"))
+	})
+})
@@ -0,0 +1,21 @@
+package mutationlog
+
+import "fmt"
+
+// GeneralError is an error of a mutation log that is not handled
+type GeneralError struct {
+	log string
+	msg string
+}
+
+func (ge *GeneralError) Error() string {
+	return fmt.Sprintf("%s mutation log error: %s", ge.log, ge.msg)
+}
+
+// NewGeneralError create a new GeneralError
+func NewGeneralError(log, msg string) *GeneralError {
+	return &GeneralError{
+		log: log,
+		msg: msg,
+	}
+}
@@ -0,0 +1,126 @@
+// Package mutationlog keeps a durable append-only log of the writes to leaderboards, so they can be
+// rebuilt by replaying it if redis loses data.
+//
+// Database wraps a database.Database giving each write its mutation with database.WithMutation, so redis
+// adds it to the outbox of the slot written in the same script as the write. Mutations of a slot are then
+// in the order they were applied, and a write is never failed after it is applied because it could not be
+// logged. A Shipper appends the mutations of all outboxes to the log in batches and removes them from the
+// outboxes. Writes are logged with their arguments, like the increment of IncrementMemberScore, and
+// replayed in the order they were appended. Writes that only keep auxiliary data, like ledgers, history
+// samples, rank snapshots and idempotency keys, are not logged.
+package mutationlog
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+)
+
+// Log is a durable append-only log of mutations
+type Log interface {
+	// Append must return only after mutations are durably stored
+	Append(ctx context.Context, mutations ...*Mutation) error
+	// Replay call fn with each mutation in the order they were appended, stopping at the first error
+	Replay(ctx context.Context, fn func(*Mutation) error) error
+	Close() error
+}
+
+// Options is a struct to create a new log. Type is segments, for files in Dir of about SegmentSize bytes,
+// or redis, for redis stream Stream
+type Options struct {
+	Type        string
+	Dir         string
+	SegmentSize int64
+	Redis       database.RedisOptions
+	Stream      string
+}
+
+// New create the log described by options
+func New(options Options) (Log, error) {
+	switch options.Type {
+	case "segments":
+		return NewSegmentLog(options.Dir, options.SegmentSize)
+	case "redis":
+		return NewRedisStreamLog(database.NewRedisDatabase(options.Redis).Client, options.Stream), nil
+	default:
+		return nil, ValidateType(options.Type)
+	}
+}
+
+// ValidateType return an error if logType is not a type of log New creates
+func ValidateType(logType string) error {
+	switch logType {
+	case "segments", "redis":
+		return nil
+	default:
+		return fmt.Errorf("invalid mutation log type %s, it must be segments or redis", logType)
+	}
+}
+
+// Replay apply the mutations of log to db that were applied until until, or all of them if it is zero,
+// returning how many were applied. db should be empty, as replayed increments add to existing scores.
+// Mutations appended again after a failed ship, with a stream ID not after the last one of their outbox,
+// are skipped
+func Replay(ctx context.Context, log Log, db database.Database, until time.Time) (int, error) {
+	applied := 0
+	lastStreamIDs := map[string]string{}
+	err := log.Replay(ctx, func(mutation *Mutation) error {
+		if mutation.Outbox != "" {
+			lastStreamID, ok := lastStreamIDs[mutation.Outbox]
+			if ok && compareStreamIDs(mutation.StreamID, lastStreamID) <= 0 {
+				return nil
+			}
+			lastStreamIDs[mutation.Outbox] = mutation.StreamID
+		}
+
+		if !until.IsZero() && mutation.At > toMilliseconds(until) {
+			return nil
+		}
+
+		err := mutation.Apply(ctx, db)
+		if err != nil {
+			return fmt.Errorf("could not apply mutation %s of %s at %d: %s", mutation.Op, mutation.Leaderboard, mutation.At, err.Error())
+		}
+		applied++
+		return nil
+	})
+
+	return applied, err
+}
+
+// compareStreamIDs compare the redis stream IDs a and b, like 1600000000000-0, returning -1, 0 or 1
+func compareStreamIDs(a, b string) int {
+	aTime, aSequence := splitStreamID(a)
+	bTime, bSequence := splitStreamID(b)
+	switch {
+	case aTime != bTime:
+		return compareUint64(aTime, bTime)
+	default:
+		return compareUint64(aSequence, bSequence)
+	}
+}
+
+func splitStreamID(streamID string) (uint64, uint64) {
+	parts := strings.SplitN(streamID, "-", 2)
+	milliseconds, _ := strconv.ParseUint(parts[0], 10, 64)
+	if len(parts) == 1 {
+		return milliseconds, 0
+	}
+	sequence, _ := strconv.ParseUint(parts[1], 10, 64)
+	return milliseconds, sequence
+}
+
+func compareUint64(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
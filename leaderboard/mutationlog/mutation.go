@@ -0,0 +1,168 @@
+package mutationlog
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+)
+
+// Operations of mutations, named after the database methods they replay
+const (
+	OpAddLeaderboardParticipants   = "addLeaderboardParticipants"
+	OpAddLeaderboardToDecayList    = "addLeaderboardToDecayList"
+	OpAddLeaderboardToSnapshotList = "addLeaderboardToSnapshotList"
+	OpBlockMembers                 = "blockMembers"
+	OpEndLeagueSeason              = "endLeagueSeason"
+	OpExpireMembers                = "expireMembers"
+	OpIncrementMemberScore         = "incrementMemberScore"
+	OpJoinLeagueDivisions          = "joinLeagueDivisions"
+	OpRemoveLeaderboard            = "removeLeaderboard"
+	OpRemoveMembers                = "removeMembers"
+	OpRenameLeaderboard            = "renameLeaderboard"
+	OpScaleLeaderboard             = "scaleLeaderboard"
+	OpSetLeaderboardExpiration     = "setLeaderboardExpiration"
+	OpSetLeaderboardSettings       = "setLeaderboardSettings"
+	OpSetLeague                    = "setLeague"
+	OpSetMembers                   = "setMembers"
+	OpSetMembersTTL                = "setMembersTTL"
+	OpSetTournament                = "setTournament"
+	OpTrimLeaderboard              = "trimLeaderboard"
+	OpUnblockMembers               = "unblockMembers"
+)
+
+// Mutation is a write to the database, with the arguments of the database method of Op. Leaderboard is
+// the league or tournament of their operations
+type Mutation struct {
+	Op          string `json:"op"`
+	Leaderboard string `json:"leaderboard"`
+	// At is the unix timestamp in milliseconds the mutation was applied
+	At int64 `json:"at"`
+	// Outbox and StreamID are the outbox the mutation was shipped from and the ID of its entry there,
+	// so a mutation appended again after a failed ship is replayed once
+	Outbox   string `json:"outbox,omitempty"`
+	StreamID string `json:"streamID,omitempty"`
+
+	Members        []*Member                    `json:"members,omitempty"`
+	MemberIDs      []string                     `json:"memberIDs,omitempty"`
+	Increment      float64                      `json:"increment,omitempty"`
+	Factor         float64                      `json:"factor,omitempty"`
+	Settings       map[string]string            `json:"settings,omitempty"`
+	ExpireAt       int64                        `json:"expireAt,omitempty"`
+	NewLeaderboard string                       `json:"newLeaderboard,omitempty"`
+	Mode           string                       `json:"mode,omitempty"`
+	JoinedAt       int64                        `json:"joinedAt,omitempty"`
+	Season         int                          `json:"season,omitempty"`
+	Tier           int                          `json:"tier,omitempty"`
+	DivisionSize   int                          `json:"divisionSize,omitempty"`
+	Size           int                          `json:"size,omitempty"`
+	Order          string                       `json:"order,omitempty"`
+	League         *database.League             `json:"league,omitempty"`
+	SeasonResult   *database.LeagueSeasonResult `json:"seasonResult,omitempty"`
+	Tournament     *database.Tournament         `json:"tournament,omitempty"`
+}
+
+// Member is a member written by a mutation, TTL is the unix timestamp in milliseconds it expires
+type Member struct {
+	ID    string  `json:"id"`
+	Score float64 `json:"score,omitempty"`
+	TTL   int64   `json:"ttl,omitempty"`
+}
+
+// Apply replay mutation on db
+func (m *Mutation) Apply(ctx context.Context, db database.Database) error {
+	switch m.Op {
+	case OpAddLeaderboardParticipants:
+		return db.AddLeaderboardParticipants(ctx, m.Leaderboard, fromMilliseconds(m.JoinedAt), m.MemberIDs...)
+	case OpAddLeaderboardToDecayList:
+		return db.AddLeaderboardToDecayList(ctx, m.Leaderboard)
+	case OpAddLeaderboardToSnapshotList:
+		return db.AddLeaderboardToSnapshotList(ctx, m.Leaderboard)
+	case OpBlockMembers:
+		return db.BlockMembers(ctx, m.Leaderboard, m.Mode, m.MemberIDs...)
+	case OpEndLeagueSeason:
+		_, err := db.EndLeagueSeason(ctx, m.Leaderboard, m.Season, m.SeasonResult)
+		return err
+	case OpExpireMembers:
+		expiration, ok := db.(database.Expiration)
+		if !ok {
+			return fmt.Errorf("invalid mutation %s for a database that does not expire members", m.Op)
+		}
+		return expiration.ExpireMembers(ctx, m.Leaderboard, m.MemberIDs)
+	case OpIncrementMemberScore:
+		if len(m.MemberIDs) != 1 {
+			return fmt.Errorf("invalid mutation %s with %d members", m.Op, len(m.MemberIDs))
+		}
+		return db.IncrementMemberScore(ctx, m.Leaderboard, &database.Member{Member: m.MemberIDs[0]}, m.Increment)
+	case OpJoinLeagueDivisions:
+		_, err := db.JoinLeagueDivisions(ctx, m.Leaderboard, m.Season, m.Tier, m.DivisionSize, m.MemberIDs...)
+		return err
+	case OpRemoveLeaderboard:
+		return db.RemoveLeaderboard(ctx, m.Leaderboard)
+	case OpRemoveMembers:
+		return db.RemoveMembers(ctx, m.Leaderboard, m.MemberIDs...)
+	case OpRenameLeaderboard:
+		return db.RenameLeaderboard(ctx, m.Leaderboard, m.NewLeaderboard)
+	case OpScaleLeaderboard:
+		return db.ScaleLeaderboard(ctx, m.Leaderboard, m.Factor, m.Settings)
+	case OpSetLeaderboardExpiration:
+		return db.SetLeaderboardExpiration(ctx, m.Leaderboard, fromMilliseconds(m.ExpireAt))
+	case OpSetLeaderboardSettings:
+		return db.SetLeaderboardSettings(ctx, m.Leaderboard, m.Settings)
+	case OpSetLeague:
+		return db.SetLeague(ctx, m.Leaderboard, m.League)
+	case OpSetMembers:
+		return db.SetMembers(ctx, m.Leaderboard, toDatabaseMembers(m.Members))
+	case OpSetMembersTTL:
+		return db.SetMembersTTL(ctx, m.Leaderboard, toDatabaseMembers(m.Members))
+	case OpSetTournament:
+		return db.SetTournament(ctx, m.Leaderboard, m.Tournament)
+	case OpTrimLeaderboard:
+		return db.TrimLeaderboard(ctx, m.Leaderboard, m.Size, m.Order)
+	case OpUnblockMembers:
+		return db.UnblockMembers(ctx, m.Leaderboard, m.MemberIDs...)
+	default:
+		return fmt.Errorf("invalid mutation op %s", m.Op)
+	}
+}
+
+func fromDatabaseMembers(databaseMembers []*database.Member) []*Member {
+	members := make([]*Member, 0, len(databaseMembers))
+	for _, databaseMember := range databaseMembers {
+		members = append(members, &Member{
+			ID:    databaseMember.Member,
+			Score: databaseMember.Score,
+			TTL:   toMilliseconds(databaseMember.TTL),
+		})
+	}
+	return members
+}
+
+func toDatabaseMembers(members []*Member) []*database.Member {
+	databaseMembers := make([]*database.Member, 0, len(members))
+	for _, member := range members {
+		databaseMembers = append(databaseMembers, &database.Member{
+			Member: member.ID,
+			Score:  member.Score,
+			TTL:    fromMilliseconds(member.TTL),
+		})
+	}
+	return databaseMembers
+}
+
+// toMilliseconds return the unix timestamp in milliseconds of t, zero if it is zero
+func toMilliseconds(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+// fromMilliseconds return the time of unix timestamp in milliseconds, the zero time if it is zero
+func fromMilliseconds(milliseconds int64) time.Time {
+	if milliseconds == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, milliseconds*int64(time.Millisecond))
+}
@@ -0,0 +1,13 @@
+package mutationlog_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestMutationLog(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Mutation Log Suite")
+}
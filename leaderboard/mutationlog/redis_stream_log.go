@@ -0,0 +1,136 @@
+package mutationlog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+)
+
+const redisStreamLogName = "redis stream"
+
+// replayPageSize is how many entries of the stream are read at once on replays
+const replayPageSize = 1000
+
+// appendMutationsScript applies XADD of each of ARGV to stream KEYS[1] in field "mutation"
+const appendMutationsScript = `
+for _, mutation in ipairs(ARGV) do
+	redis.call('XADD', KEYS[1], '*', 'mutation', mutation)
+end
+return #ARGV
+`
+
+// readMutationsScript return up to ARGV[2] entries of stream KEYS[1] from ID ARGV[1]
+const readMutationsScript = `
+return redis.call('XRANGE', KEYS[1], ARGV[1], '+', 'COUNT', ARGV[2])
+`
+
+// RedisStreamLog appends mutations to a redis stream as JSON in field "mutation". The stream is never
+// trimmed, so it should be kept in a redis with persistence apart from the leaderboards, like one with
+// appendfsync always
+type RedisStreamLog struct {
+	Client redis.Client
+	Stream string
+}
+
+// NewRedisStreamLog create a new RedisStreamLog
+func NewRedisStreamLog(client redis.Client, stream string) *RedisStreamLog {
+	return &RedisStreamLog{
+		Client: client,
+		Stream: stream,
+	}
+}
+
+// Append append mutations to the stream in a single script
+func (r *RedisStreamLog) Append(ctx context.Context, mutations ...*Mutation) error {
+	if len(mutations) == 0 {
+		return nil
+	}
+
+	payloads := make([]interface{}, 0, len(mutations))
+	for _, mutation := range mutations {
+		payload, err := json.Marshal(mutation)
+		if err != nil {
+			return NewGeneralError(redisStreamLogName, err.Error())
+		}
+		payloads = append(payloads, string(payload))
+	}
+
+	_, err := r.Client.Eval(ctx, appendMutationsScript, []string{r.Stream}, payloads...)
+	if err != nil {
+		return NewGeneralError(redisStreamLogName, err.Error())
+	}
+
+	return nil
+}
+
+// Replay read the stream in pages calling fn with its mutations
+func (r *RedisStreamLog) Replay(ctx context.Context, fn func(*Mutation) error) error {
+	start := "-"
+	lastID := ""
+	for {
+		result, err := r.Client.Eval(ctx, readMutationsScript, []string{r.Stream}, start, strconv.Itoa(replayPageSize))
+		if err != nil {
+			return NewGeneralError(redisStreamLogName, err.Error())
+		}
+
+		entries, _ := result.([]interface{})
+		read := 0
+		for _, entry := range entries {
+			streamID, mutation, err := parseStreamEntry(entry)
+			if err != nil {
+				return NewGeneralError(redisStreamLogName, err.Error())
+			}
+
+			// pages start at the last entry of the previous one
+			if streamID == lastID {
+				continue
+			}
+			lastID = streamID
+			read++
+
+			err = fn(mutation)
+			if err != nil {
+				return err
+			}
+		}
+
+		if read == 0 {
+			return nil
+		}
+		start = lastID
+	}
+}
+
+// Close does nothing, the stream needs no cleanup
+func (r *RedisStreamLog) Close() error {
+	return nil
+}
+
+// parseStreamEntry parse an entry returned by XRANGE, an array of its ID and an array of fields and values
+func parseStreamEntry(entry interface{}) (string, *Mutation, error) {
+	values, ok := entry.([]interface{})
+	if !ok || len(values) != 2 {
+		return "", nil, fmt.Errorf("invalid stream entry %v", entry)
+	}
+
+	streamID := fmt.Sprint(values[0])
+	fields, _ := values[1].([]interface{})
+	for i := 0; i+1 < len(fields); i += 2 {
+		if fmt.Sprint(fields[i]) != "mutation" {
+			continue
+		}
+
+		mutation := &Mutation{}
+		err := json.Unmarshal([]byte(fmt.Sprint(fields[i+1])), mutation)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid mutation of stream entry %s: %s", streamID, err.Error())
+		}
+
+		return streamID, mutation, nil
+	}
+
+	return "", nil, fmt.Errorf("stream entry %s has no mutation", streamID)
+}
@@ -0,0 +1,75 @@
+package mutationlog_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+	"github.com/topfreegames/podium/leaderboard/v2/mutationlog"
+)
+
+var _ = Describe("Redis Stream Log", func() {
+	var ctrl *gomock.Controller
+	var mock *redis.MockRedis
+	var redisStreamLog *mutationlog.RedisStreamLog
+
+	mutation := &mutationlog.Mutation{Op: mutationlog.OpRemoveLeaderboard, Leaderboard: "leaderboardTest", At: 1600000000000}
+
+	entry := func(streamID string) []interface{} {
+		payload, err := json.Marshal(mutation)
+		Expect(err).NotTo(HaveOccurred())
+		return []interface{}{streamID, []interface{}{"mutation", string(payload)}}
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = redis.NewMockRedis(ctrl)
+
+		redisStreamLog = mutationlog.NewRedisStreamLog(mock, "podium:mutations")
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should append mutations to the stream as JSON in a single call", func() {
+		payload, err := json.Marshal(mutation)
+		Expect(err).NotTo(HaveOccurred())
+
+		mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"podium:mutations"}), gomock.Eq(string(payload)), gomock.Eq(string(payload))).Return(int64(2), nil)
+
+		err = redisStreamLog.Append(context.Background(), mutation, mutation)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should replay the stream in pages starting at the last entry read", func() {
+		gomock.InOrder(
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"podium:mutations"}), gomock.Eq("-"), gomock.Eq("1000")).
+				Return([]interface{}{entry("1600000000000-0"), entry("1600000000000-1")}, nil),
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"podium:mutations"}), gomock.Eq("1600000000000-1"), gomock.Eq("1000")).
+				Return([]interface{}{entry("1600000000000-1"), entry("1600000000001-0")}, nil),
+			mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Eq([]string{"podium:mutations"}), gomock.Eq("1600000000001-0"), gomock.Eq("1000")).
+				Return([]interface{}{entry("1600000000001-0")}, nil),
+		)
+
+		replayed := 0
+		err := redisStreamLog.Replay(context.Background(), func(replayedMutation *mutationlog.Mutation) error {
+			Expect(replayedMutation).To(Equal(mutation))
+			replayed++
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(replayed).To(Equal(3))
+	})
+
+	It("Should return GeneralError if redis return in error", func() {
+		mock.EXPECT().Eval(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("redis error"))
+
+		err := redisStreamLog.Append(context.Background(), mutation)
+		Expect(err).To(Equal(mutationlog.NewGeneralError("redis stream", "redis error")))
+	})
+})
@@ -0,0 +1,230 @@
+package mutationlog
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+const segmentLogName = "segments"
+
+// SegmentLog appends mutations as JSON lines to segment files in a local directory, named by their
+// sequence like 0000000001.log, starting a new segment when the current one reaches about SegmentSize
+// bytes. Each append is synced to disk once before it returns
+type SegmentLog struct {
+	Dir         string
+	SegmentSize int64
+
+	mutex    sync.Mutex
+	file     *os.File
+	sequence int
+	size     int64
+}
+
+// NewSegmentLog create a SegmentLog in dir, creating it if it does not exist, appending to its last
+// segment. A line partially written to the last segment by a crash is truncated
+func NewSegmentLog(dir string, segmentSize int64) (*SegmentLog, error) {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, NewGeneralError(segmentLogName, err.Error())
+	}
+
+	s := &SegmentLog{
+		Dir:         dir,
+		SegmentSize: segmentSize,
+	}
+
+	segments, err := s.segments()
+	if err != nil {
+		return nil, NewGeneralError(segmentLogName, err.Error())
+	}
+
+	sequence := 1
+	if len(segments) > 0 {
+		sequence = segments[len(segments)-1]
+	}
+
+	err = s.open(sequence)
+	if err != nil {
+		return nil, NewGeneralError(segmentLogName, err.Error())
+	}
+
+	return s, nil
+}
+
+// Append append mutations to the current segment, syncing them to disk once
+func (s *SegmentLog) Append(ctx context.Context, mutations ...*Mutation) error {
+	lines := make([][]byte, 0, len(mutations))
+	for _, mutation := range mutations {
+		line, err := json.Marshal(mutation)
+		if err != nil {
+			return NewGeneralError(segmentLogName, err.Error())
+		}
+		lines = append(lines, append(line, '\n'))
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.file == nil {
+		return NewGeneralError(segmentLogName, "log is closed")
+	}
+
+	for _, line := range lines {
+		if s.size > 0 && s.size+int64(len(line)) > s.SegmentSize {
+			err := s.file.Sync()
+			if err != nil {
+				return NewGeneralError(segmentLogName, err.Error())
+			}
+
+			err = s.file.Close()
+			if err != nil {
+				return NewGeneralError(segmentLogName, err.Error())
+			}
+
+			err = s.open(s.sequence + 1)
+			if err != nil {
+				return NewGeneralError(segmentLogName, err.Error())
+			}
+		}
+
+		_, err := s.file.Write(line)
+		if err != nil {
+			return NewGeneralError(segmentLogName, err.Error())
+		}
+		s.size += int64(len(line))
+	}
+
+	err := s.file.Sync()
+	if err != nil {
+		return NewGeneralError(segmentLogName, err.Error())
+	}
+
+	return nil
+}
+
+// Replay read the segments in order calling fn with their mutations
+func (s *SegmentLog) Replay(ctx context.Context, fn func(*Mutation) error) error {
+	segments, err := s.segments()
+	if err != nil {
+		return NewGeneralError(segmentLogName, err.Error())
+	}
+
+	for _, sequence := range segments {
+		err = s.replaySegment(ctx, sequence, fn)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close close the current segment
+func (s *SegmentLog) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+func (s *SegmentLog) replaySegment(ctx context.Context, sequence int, fn func(*Mutation) error) error {
+	file, err := os.Open(s.segmentPath(sequence))
+	if err != nil {
+		return NewGeneralError(segmentLogName, err.Error())
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			// a line without newline is being appended
+			return nil
+		}
+		if err != nil {
+			return NewGeneralError(segmentLogName, err.Error())
+		}
+
+		if ctx.Err() != nil {
+			return NewGeneralError(segmentLogName, ctx.Err().Error())
+		}
+
+		mutation := &Mutation{}
+		err = json.Unmarshal(line, mutation)
+		if err != nil {
+			return NewGeneralError(segmentLogName, fmt.Sprintf("invalid mutation in segment %d: %s", sequence, err.Error()))
+		}
+
+		err = fn(mutation)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// open open segment sequence for appending, truncating a partially written last line
+func (s *SegmentLog) open(sequence int) error {
+	path := s.segmentPath(sequence)
+	data, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	size := int64(bytes.LastIndexByte(data, '\n') + 1)
+	if size < int64(len(data)) {
+		err = os.Truncate(path, size)
+		if err != nil {
+			return err
+		}
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	s.file = file
+	s.sequence = sequence
+	s.size = size
+	return nil
+}
+
+// segments return the sequences of the segments in the directory in order
+func (s *SegmentLog) segments() ([]int, error) {
+	paths, err := filepath.Glob(filepath.Join(s.Dir, "*.log"))
+	if err != nil {
+		return nil, err
+	}
+
+	segments := make([]int, 0, len(paths))
+	for _, path := range paths {
+		var sequence int
+		_, err = fmt.Sscanf(filepath.Base(path), "%d.log", &sequence)
+		if err != nil {
+			continue
+		}
+		segments = append(segments, sequence)
+	}
+	sort.Ints(segments)
+
+	return segments, nil
+}
+
+func (s *SegmentLog) segmentPath(sequence int) string {
+	return filepath.Join(s.Dir, fmt.Sprintf("%010d.log", sequence))
+}
@@ -0,0 +1,119 @@
+package mutationlog_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/mutationlog"
+)
+
+var _ = Describe("Segment Log", func() {
+	var dir string
+
+	replay := func(log mutationlog.Log) []*mutationlog.Mutation {
+		mutations := []*mutationlog.Mutation{}
+		err := log.Replay(context.Background(), func(mutation *mutationlog.Mutation) error {
+			mutations = append(mutations, mutation)
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return mutations
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "podium-mutations")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("Should replay mutations in the order they were appended across segments", func() {
+		segmentLog, err := mutationlog.NewSegmentLog(dir, 100)
+		Expect(err).NotTo(HaveOccurred())
+		defer segmentLog.Close()
+
+		for _, member := range []string{"member1", "member2", "member3"} {
+			err = segmentLog.Append(context.Background(), &mutationlog.Mutation{
+				Op:          mutationlog.OpRemoveMembers,
+				Leaderboard: "leaderboardTest",
+				MemberIDs:   []string{member},
+				At:          1600000000000,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		segments, err := filepath.Glob(filepath.Join(dir, "*.log"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(segments).To(HaveLen(3))
+		Expect(filepath.Base(segments[0])).To(Equal("0000000001.log"))
+
+		mutations := replay(segmentLog)
+		Expect(mutations).To(HaveLen(3))
+		Expect(mutations[0].MemberIDs).To(Equal([]string{"member1"}))
+		Expect(mutations[2].MemberIDs).To(Equal([]string{"member3"}))
+	})
+
+	It("Should append a batch of mutations across segments", func() {
+		segmentLog, err := mutationlog.NewSegmentLog(dir, 100)
+		Expect(err).NotTo(HaveOccurred())
+		defer segmentLog.Close()
+
+		err = segmentLog.Append(
+			context.Background(),
+			&mutationlog.Mutation{Op: mutationlog.OpRemoveLeaderboard, Leaderboard: "leaderboard1", Outbox: "{leaderboard1}:mutations", StreamID: "1600000000000-0"},
+			&mutationlog.Mutation{Op: mutationlog.OpRemoveLeaderboard, Leaderboard: "leaderboard2", Outbox: "{leaderboard2}:mutations", StreamID: "1600000000000-0"},
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		segments, err := filepath.Glob(filepath.Join(dir, "*.log"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(segments).To(HaveLen(2))
+
+		mutations := replay(segmentLog)
+		Expect(mutations).To(HaveLen(2))
+		Expect(mutations[0].Leaderboard).To(Equal("leaderboard1"))
+		Expect(mutations[0].StreamID).To(Equal("1600000000000-0"))
+		Expect(mutations[1].Leaderboard).To(Equal("leaderboard2"))
+	})
+
+	It("Should truncate a partially written mutation when reopened", func() {
+		segmentLog, err := mutationlog.NewSegmentLog(dir, 1024*1024)
+		Expect(err).NotTo(HaveOccurred())
+		err = segmentLog.Append(context.Background(), &mutationlog.Mutation{Op: mutationlog.OpRemoveLeaderboard, Leaderboard: "leaderboard1"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(segmentLog.Close()).To(Succeed())
+
+		file, err := os.OpenFile(filepath.Join(dir, "0000000001.log"), os.O_APPEND|os.O_WRONLY, 0644)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = file.Write([]byte(`{"op":"removeLead`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(file.Close()).To(Succeed())
+
+		segmentLog, err = mutationlog.NewSegmentLog(dir, 1024*1024)
+		Expect(err).NotTo(HaveOccurred())
+		defer segmentLog.Close()
+		err = segmentLog.Append(context.Background(), &mutationlog.Mutation{Op: mutationlog.OpRemoveLeaderboard, Leaderboard: "leaderboard2"})
+		Expect(err).NotTo(HaveOccurred())
+
+		mutations := replay(segmentLog)
+		Expect(mutations).To(HaveLen(2))
+		Expect(mutations[0].Leaderboard).To(Equal("leaderboard1"))
+		Expect(mutations[1].Leaderboard).To(Equal("leaderboard2"))
+	})
+
+	It("Should return GeneralError if log is closed", func() {
+		segmentLog, err := mutationlog.NewSegmentLog(dir, 1024*1024)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(segmentLog.Close()).To(Succeed())
+
+		err = segmentLog.Append(context.Background(), &mutationlog.Mutation{Op: mutationlog.OpRemoveLeaderboard, Leaderboard: "leaderboard1"})
+		Expect(err).To(Equal(mutationlog.NewGeneralError("segments", "log is closed")))
+	})
+})
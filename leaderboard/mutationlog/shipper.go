@@ -0,0 +1,95 @@
+package mutationlog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+)
+
+// Shipper appends the mutations added to the outboxes of Mutations to Log, and removes them from the
+// outboxes once they are durably stored. A single Shipper should run at a time, so the log has one writer
+type Shipper struct {
+	Mutations database.Mutations
+	Log       Log
+	BatchSize int
+	// IdleTime is how long an outbox must not be written to before it's not listed anymore once empty
+	IdleTime time.Duration
+}
+
+// NewShipper create a new Shipper appending up to batchSize mutations of each outbox at once
+func NewShipper(mutations database.Mutations, log Log, batchSize int, idleTime time.Duration) *Shipper {
+	return &Shipper{
+		Mutations: mutations,
+		Log:       log,
+		BatchSize: batchSize,
+		IdleTime:  idleTime,
+	}
+}
+
+// Ship append up to BatchSize of the first mutations of each outbox to the log in a single append,
+// ordered by their stream IDs, and remove them from the outboxes, returning how many were shipped. Outboxes
+// are read in the order their mutations were added, so mutations of a slot are appended in the order they
+// were applied. Mutations appended whose removal fails are appended again on the next ship and skipped on
+// replays. Empty outboxes idle for IdleTime are not listed anymore
+func (s *Shipper) Ship(ctx context.Context) (int, error) {
+	outboxes, err := s.Mutations.GetMutationOutboxes(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	mutations := []*Mutation{}
+	streamIDs := map[string][]string{}
+	for _, outbox := range outboxes {
+		outboxMutations, err := s.Mutations.GetMutations(ctx, outbox, s.BatchSize)
+		if err != nil {
+			return 0, err
+		}
+
+		if len(outboxMutations) == 0 {
+			_, err = s.Mutations.RemoveIdleMutationOutbox(ctx, outbox, time.Now().Add(-s.IdleTime))
+			if err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		for _, outboxMutation := range outboxMutations {
+			mutation := &Mutation{}
+			err = json.Unmarshal([]byte(outboxMutation.Mutation), mutation)
+			if err != nil {
+				return 0, NewGeneralError(outboxName, fmt.Sprintf("invalid mutation %s of %s: %s", outboxMutation.StreamID, outbox, err.Error()))
+			}
+			mutation.Outbox = outbox
+			mutation.StreamID = outboxMutation.StreamID
+
+			mutations = append(mutations, mutation)
+			streamIDs[outbox] = append(streamIDs[outbox], outboxMutation.StreamID)
+		}
+	}
+
+	if len(mutations) == 0 {
+		return 0, nil
+	}
+
+	sort.SliceStable(mutations, func(i, j int) bool {
+		return compareStreamIDs(mutations[i].StreamID, mutations[j].StreamID) < 0
+	})
+
+	err = s.Log.Append(ctx, mutations...)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, outbox := range outboxes {
+		err = s.Mutations.RemoveMutations(ctx, outbox, streamIDs[outbox]...)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(mutations), nil
+}
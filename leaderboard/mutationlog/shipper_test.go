@@ -0,0 +1,83 @@
+package mutationlog_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/mutationlog"
+)
+
+var _ = Describe("Shipper", func() {
+	var ctrl *gomock.Controller
+	var mockMutations *database.MockMutations
+	var mockLog *mutationlog.MockLog
+	var shipper *mutationlog.Shipper
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockMutations = database.NewMockMutations(ctrl)
+		mockLog = mutationlog.NewMockLog(ctrl)
+
+		shipper = mutationlog.NewShipper(mockMutations, mockLog, 10, time.Minute)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should append mutations of outboxes in the order of their stream IDs and remove them", func() {
+		mockMutations.EXPECT().GetMutationOutboxes(gomock.Any()).Return([]string{"{leaderboard1}:mutations", "{leaderboard2}:mutations"}, nil)
+		mockMutations.EXPECT().GetMutations(gomock.Any(), gomock.Eq("{leaderboard1}:mutations"), gomock.Eq(10)).Return([]*database.OutboxMutation{
+			{StreamID: "1600000000000-0", Mutation: `{"op":"removeMembers","leaderboard":"leaderboard1","memberIDs":["member1"]}`},
+			{StreamID: "1600000000002-0", Mutation: `{"op":"removeMembers","leaderboard":"leaderboard1","memberIDs":["member2"]}`},
+		}, nil)
+		mockMutations.EXPECT().GetMutations(gomock.Any(), gomock.Eq("{leaderboard2}:mutations"), gomock.Eq(10)).Return([]*database.OutboxMutation{
+			{StreamID: "1600000000001-0", Mutation: `{"op":"removeLeaderboard","leaderboard":"leaderboard2"}`},
+		}, nil)
+		mockLog.EXPECT().Append(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, mutations ...*mutationlog.Mutation) error {
+			Expect(mutations).To(Equal([]*mutationlog.Mutation{
+				{Op: mutationlog.OpRemoveMembers, Leaderboard: "leaderboard1", MemberIDs: []string{"member1"}, Outbox: "{leaderboard1}:mutations", StreamID: "1600000000000-0"},
+				{Op: mutationlog.OpRemoveLeaderboard, Leaderboard: "leaderboard2", Outbox: "{leaderboard2}:mutations", StreamID: "1600000000001-0"},
+				{Op: mutationlog.OpRemoveMembers, Leaderboard: "leaderboard1", MemberIDs: []string{"member2"}, Outbox: "{leaderboard1}:mutations", StreamID: "1600000000002-0"},
+			}))
+			return nil
+		})
+		mockMutations.EXPECT().RemoveMutations(gomock.Any(), gomock.Eq("{leaderboard1}:mutations"), gomock.Eq("1600000000000-0"), gomock.Eq("1600000000002-0")).Return(nil)
+		mockMutations.EXPECT().RemoveMutations(gomock.Any(), gomock.Eq("{leaderboard2}:mutations"), gomock.Eq("1600000000001-0")).Return(nil)
+
+		shipped, err := shipper.Ship(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(shipped).To(Equal(3))
+	})
+
+	It("Should remove empty outboxes that are idle", func() {
+		mockMutations.EXPECT().GetMutationOutboxes(gomock.Any()).Return([]string{"{leaderboard1}:mutations"}, nil)
+		mockMutations.EXPECT().GetMutations(gomock.Any(), gomock.Eq("{leaderboard1}:mutations"), gomock.Eq(10)).Return([]*database.OutboxMutation{}, nil)
+		mockMutations.EXPECT().RemoveIdleMutationOutbox(gomock.Any(), gomock.Eq("{leaderboard1}:mutations"), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, outbox string, idleSince time.Time) (bool, error) {
+				Expect(idleSince).To(BeTemporally("~", time.Now().Add(-time.Minute), time.Second))
+				return true, nil
+			},
+		)
+
+		shipped, err := shipper.Ship(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(shipped).To(Equal(0))
+	})
+
+	It("Should keep mutations in the outboxes if they could not be appended", func() {
+		mockMutations.EXPECT().GetMutationOutboxes(gomock.Any()).Return([]string{"{leaderboard1}:mutations"}, nil)
+		mockMutations.EXPECT().GetMutations(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.OutboxMutation{
+			{StreamID: "1600000000000-0", Mutation: `{"op":"removeLeaderboard","leaderboard":"leaderboard1"}`},
+		}, nil)
+		mockLog.EXPECT().Append(gomock.Any(), gomock.Any()).Return(fmt.Errorf("disk full"))
+
+		_, err := shipper.Ship(context.Background())
+		Expect(err).To(MatchError("disk full"))
+	})
+})
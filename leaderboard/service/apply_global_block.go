@@ -0,0 +1,32 @@
+package service
+
+import "context"
+
+const applyGlobalBlockServiceLabel = "apply global block"
+
+// ApplyGlobalBlock move scores of member out of every leaderboard if it is blocked in every leaderboard, or
+// back to them if it is not, except from leaderboards it is blocked in itself. Leaderboards are found by
+// scanning every leaderboard, so it is called by the worker for members queued by BlockMember and
+// UnblockMember
+func (s *Service) ApplyGlobalBlock(ctx context.Context, member string) error {
+	blockedMembers, err := s.Database.GetBlockedMembers(ctx, "", member)
+	if err != nil {
+		return NewGeneralError(applyGlobalBlockServiceLabel, err.Error())
+	}
+	mode := blockedMembers[0].GlobalMode
+
+	err = s.Database.ScanLeaderboards(ctx, func(leaderboards []string) error {
+		for _, leaderboard := range leaderboards {
+			err := s.Database.ApplyGlobalBlock(ctx, leaderboard, mode, member)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return NewGeneralError(applyGlobalBlockServiceLabel, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service ApplyGlobalBlock", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should move scores of member blocked in every leaderboard out of them", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(""), gomock.Eq("member")).Return([]*database.BlockedMember{
+			{GlobalMode: model.BlockModeReject},
+		}, nil)
+		mock.EXPECT().ScanLeaderboards(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(leaderboards []string) error) error {
+				return fn([]string{"leaderboard1", "leaderboard2"})
+			},
+		)
+		mock.EXPECT().ApplyGlobalBlock(gomock.Any(), gomock.Eq("leaderboard1"), gomock.Eq(model.BlockModeReject), gomock.Eq("member")).Return(nil)
+		mock.EXPECT().ApplyGlobalBlock(gomock.Any(), gomock.Eq("leaderboard2"), gomock.Eq(model.BlockModeReject), gomock.Eq("member")).Return(nil)
+
+		err := svc.ApplyGlobalBlock(context.Background(), "member")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should bring back scores of member unblocked in every leaderboard", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(""), gomock.Eq("member")).Return([]*database.BlockedMember{{}}, nil)
+		mock.EXPECT().ScanLeaderboards(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(leaderboards []string) error) error {
+				return fn([]string{"leaderboard1"})
+			},
+		)
+		mock.EXPECT().ApplyGlobalBlock(gomock.Any(), gomock.Eq("leaderboard1"), gomock.Eq(""), gomock.Eq("member")).Return(nil)
+
+		err := svc.ApplyGlobalBlock(context.Background(), "member")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should return error if database return in error", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(""), gomock.Eq("member")).Return([]*database.BlockedMember{{}}, nil)
+		mock.EXPECT().ScanLeaderboards(gomock.Any(), gomock.Any()).Return(fmt.Errorf("Database error example"))
+
+		err := svc.ApplyGlobalBlock(context.Background(), "member")
+		Expect(err).To(Equal(service.NewGeneralError("apply global block", "Database error example")))
+	})
+})
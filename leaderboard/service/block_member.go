@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const blockMemberServiceLabel = "block member"
+
+// BlockMember block member in leaderboard with mode, removing it from the leaderboard results. An empty
+// leaderboard blocks member in every leaderboard and queues it for the worker to remove it from each of
+// them with ApplyGlobalBlock, writes of member remove it from the leaderboards they write before that
+func (s *Service) BlockMember(ctx context.Context, leaderboard, member, mode string) error {
+	if mode != model.BlockModeReject && mode != model.BlockModeShadow {
+		return NewInvalidBlockModeError(mode)
+	}
+
+	err := s.Database.BlockMembers(ctx, leaderboard, mode, member)
+	if err != nil {
+		return NewGeneralError(blockMemberServiceLabel, err.Error())
+	}
+
+	if leaderboard == "" {
+		err = s.Database.QueueGlobalBlock(ctx, member)
+		if err != nil {
+			return NewGeneralError(blockMemberServiceLabel, err.Error())
+		}
+	}
+
+	return nil
+}
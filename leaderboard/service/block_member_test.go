@@ -0,0 +1,59 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service BlockMember", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should block member in leaderboard", func() {
+		mock.EXPECT().BlockMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(model.BlockModeShadow), gomock.Eq("member")).Return(nil)
+
+		err := svc.BlockMember(context.Background(), leaderboard, "member", model.BlockModeShadow)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should block member in every leaderboard queueing it to move its scores out of them", func() {
+		mock.EXPECT().BlockMembers(gomock.Any(), gomock.Eq(""), gomock.Eq(model.BlockModeReject), gomock.Eq("member")).Return(nil)
+		mock.EXPECT().QueueGlobalBlock(gomock.Any(), gomock.Eq("member")).Return(nil)
+
+		err := svc.BlockMember(context.Background(), "", "member", model.BlockModeReject)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should return InvalidBlockModeError if mode is unknown", func() {
+		err := svc.BlockMember(context.Background(), leaderboard, "member", "invalid")
+		Expect(err).To(Equal(service.NewInvalidBlockModeError("invalid")))
+	})
+
+	It("Should return error if database return in error", func() {
+		mock.EXPECT().BlockMembers(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("Database error example"))
+
+		err := svc.BlockMember(context.Background(), leaderboard, "member", model.BlockModeShadow)
+		Expect(err).To(Equal(service.NewGeneralError("block member", "Database error example")))
+	})
+})
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// getBlockModes return how each of members is blocked in leaderboard, or an empty string if it is not.
+// A block in leaderboard takes precedence over a block in every leaderboard
+func (s *Service) getBlockModes(ctx context.Context, leaderboard string, members []string) ([]string, error) {
+	blockedMembers, err := s.Database.GetBlockedMembers(ctx, leaderboard, members...)
+	if err != nil {
+		return nil, err
+	}
+
+	modes := make([]string, len(members))
+	for i, blockedMember := range blockedMembers {
+		modes[i] = blockedMember.Mode
+		if modes[i] == "" {
+			modes[i] = blockedMember.GlobalMode
+		}
+	}
+
+	return modes, nil
+}
+
+// partitionBlockedMembers return members that are not blocked in leaderboard and members whose writes
+// are shadowed, or MemberBlockedError if any of members is blocked with BlockModeReject. Scores members
+// blocked in every leaderboard still have in leaderboard, like ones written while they were being
+// blocked, are moved out of it before the write
+func (s *Service) partitionBlockedMembers(ctx context.Context, leaderboard string, members []*model.Member) ([]*model.Member, []*model.Member, error) {
+	memberIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		memberIDs = append(memberIDs, member.PublicID)
+	}
+
+	blockedMembers, err := s.Database.GetBlockedMembers(ctx, leaderboard, memberIDs...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	modes := make([]string, len(members))
+	globalModes := map[string][]string{}
+	for i, blockedMember := range blockedMembers {
+		modes[i] = blockedMember.Mode
+		if modes[i] == "" && blockedMember.GlobalMode != "" {
+			modes[i] = blockedMember.GlobalMode
+			globalModes[modes[i]] = append(globalModes[modes[i]], memberIDs[i])
+		}
+	}
+
+	for mode, globalMembers := range globalModes {
+		err = s.Database.ApplyGlobalBlock(ctx, leaderboard, mode, globalMembers...)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	allowedMembers := make([]*model.Member, 0, len(members))
+	shadowMembers := []*model.Member{}
+	for i, member := range members {
+		switch modes[i] {
+		case model.BlockModeReject:
+			return nil, nil, NewMemberBlockedError(leaderboard, member.PublicID)
+		case model.BlockModeShadow:
+			shadowMembers = append(shadowMembers, member)
+		default:
+			allowedMembers = append(allowedMembers, member)
+		}
+	}
+
+	return allowedMembers, shadowMembers, nil
+}
+
+// persistShadowMembers write scores of members to the shadow leaderboard of leaderboard, filling
+// members with the rank they would have in leaderboard
+func (s *Service) persistShadowMembers(ctx context.Context, leaderboard string, members []*model.Member, settings *model.LeaderboardSettings) error {
+	databaseMembers := make([]*database.Member, 0, len(members))
+	for _, member := range members {
+		databaseMembers = append(databaseMembers, &database.Member{
+			Member: member.PublicID,
+			Score:  toStoredScore(settings, float64(member.Score)),
+		})
+	}
+
+	err := s.Database.SetMembers(ctx, database.ShadowLeaderboard(leaderboard), databaseMembers)
+	if err != nil {
+		return err
+	}
+
+	for i, member := range databaseMembers {
+		members[i].Version = member.Version
+	}
+
+	return s.setShadowMembersValues(ctx, leaderboard, members, settings)
+}
+
+func (s *Service) setShadowMembersValues(ctx context.Context, leaderboard string, members []*model.Member, settings *model.LeaderboardSettings) error {
+	for _, member := range members {
+		databaseMember, err := s.Database.GetShadowMember(ctx, leaderboard, member.PublicID, setMembersOrder)
+		if err != nil {
+			return err
+		}
+		if databaseMember == nil {
+			continue
+		}
+
+		member.Rank = int(databaseMember.Rank + 1)
+		member.Score = toDecayedScore(settings, databaseMember.Score)
+	}
+
+	return nil
+}
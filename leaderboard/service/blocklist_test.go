@@ -0,0 +1,117 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service blocklist", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should reject writes of members blocked with reject mode", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1"), gomock.Eq("member2")).Return([]*database.BlockedMember{
+			{},
+			{Mode: model.BlockModeReject},
+		}, nil)
+
+		err := svc.SetMembersScore(context.Background(), leaderboard, []*model.Member{
+			{PublicID: "member1", Score: 10},
+			{PublicID: "member2", Score: 20},
+		}, false, "", nil)
+		Expect(err).To(Equal(service.NewMemberBlockedError(leaderboard, "member2")))
+	})
+
+	It("Should write scores of members blocked with shadow mode to the shadow leaderboard", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member")).Return([]*database.BlockedMember{
+			{Mode: model.BlockModeShadow},
+		}, nil)
+		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq("{leaderboardTest}:shadow"), gomock.Eq([]*database.Member{
+			{Member: "member", Score: 100},
+		})).Return(nil)
+		mock.EXPECT().GetShadowMember(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member"), gomock.Eq("desc")).Return(&database.Member{
+			Member: "member", Score: 100, Rank: 4,
+		}, nil)
+
+		member, err := svc.SetMemberScore(context.Background(), leaderboard, "member", 100, false, "", nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(member).To(Equal(&model.Member{PublicID: "member", Score: 100, Rank: 5}))
+	})
+
+	It("Should increment scores of members blocked in every leaderboard in the shadow leaderboard", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member")).Return([]*database.BlockedMember{
+			{GlobalMode: model.BlockModeShadow},
+		}, nil)
+		mock.EXPECT().ApplyGlobalBlock(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(model.BlockModeShadow), gomock.Eq("member")).Return(nil)
+		mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq("{leaderboardTest}:shadow"), gomock.Eq(&database.Member{Member: "member"}), gomock.Eq(float64(10))).Return(nil)
+		mock.EXPECT().GetShadowMember(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member"), gomock.Eq("desc")).Return(&database.Member{
+			Member: "member", Score: 110, Rank: 0,
+		}, nil)
+
+		member, err := svc.IncrementMemberScore(context.Background(), leaderboard, "member", 10, "", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(member.Score).To(Equal(int64(110)))
+		Expect(member.Rank).To(Equal(1))
+	})
+
+	It("Should return shadow member to member blocked with shadow mode", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member")).Return([]*database.BlockedMember{
+			{Mode: model.BlockModeShadow},
+		}, nil)
+		mock.EXPECT().GetShadowMember(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member"), gomock.Eq("desc")).Return(&database.Member{
+			Member: "member", Score: 100, Rank: 2,
+		}, nil)
+
+		member, err := svc.GetMember(context.Background(), leaderboard, "member", "desc", false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(member).To(Equal(&model.Member{PublicID: "member", Score: 100, Rank: 3}))
+	})
+
+	It("Should return MemberNotFoundError to member blocked with reject mode", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member")).Return([]*database.BlockedMember{
+			{Mode: model.BlockModeReject},
+		}, nil)
+
+		_, err := svc.GetMember(context.Background(), leaderboard, "member", "desc", false)
+		Expect(err).To(Equal(service.NewMemberNotFoundError(leaderboard, "member")))
+	})
+
+	It("Should return member that is not blocked without changing the blocklist", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member")).Return([]*database.BlockedMember{
+			{},
+		}, nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq("member")).Return([]*database.Member{
+			{Member: "member", Score: 100, Rank: 0},
+		}, nil)
+
+		member, err := svc.GetMember(context.Background(), leaderboard, "member", "desc", false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(member.Score).To(Equal(int64(100)))
+	})
+})
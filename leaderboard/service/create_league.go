@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const createLeagueServiceLabel = "create league"
+
+// CreateLeague validate league configuration and create league starting at season 1
+func (s *Service) CreateLeague(ctx context.Context, league *model.League) (*model.League, error) {
+	err := validateLeague(league)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.getLeague(ctx, league.ID)
+	if err == nil {
+		return nil, NewLeagueAlreadyExistsError(league.ID)
+	}
+	if _, ok := err.(*LeagueNotFoundError); !ok {
+		return nil, NewGeneralError(createLeagueServiceLabel, err.Error())
+	}
+
+	newLeague := *league
+	newLeague.Season = 1
+
+	err = s.setLeague(ctx, &newLeague)
+	if err != nil {
+		return nil, NewGeneralError(createLeagueServiceLabel, err.Error())
+	}
+
+	return &newLeague, nil
+}
+
+func validateLeague(league *model.League) error {
+	if league == nil {
+		return NewInvalidLeagueError("league is required")
+	}
+
+	if league.ID == "" {
+		return NewInvalidLeagueError("id is required")
+	}
+
+	if league.Tiers < 1 {
+		return NewInvalidLeagueError(fmt.Sprintf("tiers %d must be greater than zero", league.Tiers))
+	}
+
+	if league.DivisionSize < 1 {
+		return NewInvalidLeagueError(fmt.Sprintf("divisionSize %d must be greater than zero", league.DivisionSize))
+	}
+
+	if league.PromotionCount < 0 || league.RelegationCount < 0 {
+		return NewInvalidLeagueError("promotionCount and relegationCount must be positive")
+	}
+
+	if league.PromotionCount+league.RelegationCount > league.DivisionSize {
+		return NewInvalidLeagueError(fmt.Sprintf("promotionCount plus relegationCount must not be greater than divisionSize %d", league.DivisionSize))
+	}
+
+	if league.Order == "" {
+		league.Order = "desc"
+	}
+	if league.Order != "asc" && league.Order != "desc" {
+		return NewInvalidLeagueError(fmt.Sprintf("invalid order %s", league.Order))
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service CreateLeague", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var league string = "leagueTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should create league at first season if all is OK", func() {
+		mock.EXPECT().GetLeague(gomock.Any(), gomock.Eq(league)).Return(nil, database.NewLeagueNotFoundError(league))
+		mock.EXPECT().SetLeague(gomock.Any(), gomock.Eq(league), gomock.Eq(&database.League{
+			Season:          1,
+			Tiers:           3,
+			DivisionSize:    50,
+			PromotionCount:  5,
+			RelegationCount: 5,
+			Order:           "desc",
+		})).Return(nil)
+
+		createdLeague, err := svc.CreateLeague(context.Background(), &model.League{
+			ID:              league,
+			Tiers:           3,
+			DivisionSize:    50,
+			PromotionCount:  5,
+			RelegationCount: 5,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(createdLeague).To(Equal(&model.League{
+			ID:              league,
+			Season:          1,
+			Tiers:           3,
+			DivisionSize:    50,
+			PromotionCount:  5,
+			RelegationCount: 5,
+			Order:           "desc",
+		}))
+	})
+
+	It("Should return LeagueAlreadyExistsError if league exists", func() {
+		mock.EXPECT().GetLeague(gomock.Any(), gomock.Eq(league)).Return(&database.League{Season: 2}, nil)
+
+		_, err := svc.CreateLeague(context.Background(), &model.League{ID: league, Tiers: 1, DivisionSize: 50})
+		Expect(err).To(Equal(service.NewLeagueAlreadyExistsError(league)))
+	})
+
+	It("Should return InvalidLeagueError if tiers is not positive", func() {
+		_, err := svc.CreateLeague(context.Background(), &model.League{ID: league, DivisionSize: 50})
+		Expect(err).To(Equal(service.NewInvalidLeagueError("tiers 0 must be greater than zero")))
+	})
+
+	It("Should return InvalidLeagueError if promotions and relegations do not fit a division", func() {
+		_, err := svc.CreateLeague(context.Background(), &model.League{ID: league, Tiers: 2, DivisionSize: 10, PromotionCount: 6, RelegationCount: 5})
+		Expect(err).To(Equal(service.NewInvalidLeagueError("promotionCount plus relegationCount must not be greater than divisionSize 10")))
+	})
+
+	It("Should return InvalidLeagueError if order is invalid", func() {
+		_, err := svc.CreateLeague(context.Background(), &model.League{ID: league, Tiers: 2, DivisionSize: 10, Order: "invalid"})
+		Expect(err).To(Equal(service.NewInvalidLeagueError("invalid order invalid")))
+	})
+
+	It("Should return error if database return in error on GetLeague", func() {
+		mock.EXPECT().GetLeague(gomock.Any(), gomock.Eq(league)).Return(nil, fmt.Errorf("Database error example"))
+
+		_, err := svc.CreateLeague(context.Background(), &model.League{ID: league, Tiers: 1, DivisionSize: 50})
+		Expect(err).To(Equal(service.NewGeneralError("create league", "Database error example")))
+	})
+
+	It("Should return error if database return in error on SetLeague", func() {
+		mock.EXPECT().GetLeague(gomock.Any(), gomock.Eq(league)).Return(nil, database.NewLeagueNotFoundError(league))
+		mock.EXPECT().SetLeague(gomock.Any(), gomock.Eq(league), gomock.Any()).Return(fmt.Errorf("Database error example"))
+
+		_, err := svc.CreateLeague(context.Background(), &model.League{ID: league, Tiers: 1, DivisionSize: 50})
+		Expect(err).To(Equal(service.NewGeneralError("create league", "Database error example")))
+	})
+})
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const createTournamentServiceLabel = "create tournament"
+
+// CreateTournament validate tournament configuration, create it unless it exists and restrict writes of its
+// leaderboard to the tournament window and to members that joined it
+func (s *Service) CreateTournament(ctx context.Context, tournament *model.Tournament) (*model.Tournament, error) {
+	err := validateTournament(tournament)
+	if err != nil {
+		return nil, err
+	}
+
+	newTournament := *tournament
+	newTournament.FinalizedAt = 0
+
+	created, err := s.Database.CreateTournament(ctx, tournament.ID, databaseTournament(&newTournament))
+	if err != nil {
+		return nil, NewGeneralError(createTournamentServiceLabel, err.Error())
+	}
+	if !created {
+		return nil, NewTournamentAlreadyExistsError(tournament.ID)
+	}
+
+	settings := &model.LeaderboardSettings{
+		WriteStartAt:     tournament.StartAt,
+		WriteEndAt:       tournament.EndAt,
+		ParticipantsOnly: true,
+	}
+
+	err = s.Database.SetLeaderboardSettings(ctx, tournament.ID, formatLeaderboardSettingFields(settings, writeStartAtSetting, writeEndAtSetting, participantsOnlySetting))
+	if err != nil {
+		return nil, NewGeneralError(createTournamentServiceLabel, err.Error())
+	}
+
+	newTournament.Status = tournamentStatus(&newTournament, time.Now())
+	return &newTournament, nil
+}
+
+func validateTournament(tournament *model.Tournament) error {
+	if tournament == nil {
+		return NewInvalidTournamentError("tournament is required")
+	}
+
+	if tournament.ID == "" {
+		return NewInvalidTournamentError("id is required")
+	}
+
+	if tournament.StartAt <= 0 {
+		return NewInvalidTournamentError(fmt.Sprintf("startAt %d must be greater than zero", tournament.StartAt))
+	}
+
+	if tournament.EndAt <= tournament.StartAt {
+		return NewInvalidTournamentError(fmt.Sprintf("endAt %d must be greater than startAt %d", tournament.EndAt, tournament.StartAt))
+	}
+
+	if tournament.Order == "" {
+		tournament.Order = "desc"
+	}
+	if tournament.Order != "asc" && tournament.Order != "desc" {
+		return NewInvalidTournamentError(fmt.Sprintf("invalid order %s", tournament.Order))
+	}
+
+	prizes := make([]*model.TournamentPrize, len(tournament.Prizes))
+	copy(prizes, tournament.Prizes)
+	sort.Slice(prizes, func(i, j int) bool {
+		return prizes[i].FromRank < prizes[j].FromRank
+	})
+
+	for i, prize := range prizes {
+		if prize.RewardID == "" {
+			return NewInvalidTournamentError("prize rewardID is required")
+		}
+
+		if prize.FromRank < 1 || prize.ToRank < prize.FromRank {
+			return NewInvalidTournamentError(fmt.Sprintf("prize rank range %d-%d is not valid", prize.FromRank, prize.ToRank))
+		}
+
+		if i > 0 && prize.FromRank <= prizes[i-1].ToRank {
+			return NewInvalidTournamentError(fmt.Sprintf("prize rank range %d-%d overlaps %d-%d", prize.FromRank, prize.ToRank, prizes[i-1].FromRank, prizes[i-1].ToRank))
+		}
+	}
+	tournament.Prizes = prizes
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service CreateTournament", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var tournament string = "tournamentTest"
+	var startAt int64 = time.Now().Unix() + 3600
+	var endAt int64 = startAt + 3600
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should create tournament and restrict leaderboard writes if all is OK", func() {
+		gomock.InOrder(
+			mock.EXPECT().CreateTournament(gomock.Any(), gomock.Eq(tournament), gomock.Eq(&database.Tournament{
+				StartAt: time.Unix(startAt, 0),
+				EndAt:   time.Unix(endAt, 0),
+				Order:   "desc",
+				Prizes: []*database.TournamentPrize{
+					{FromRank: 1, ToRank: 1, RewardID: "gold"},
+					{FromRank: 2, ToRank: 10, RewardID: "silver"},
+				},
+			})).Return(true, nil),
+			mock.EXPECT().SetLeaderboardSettings(gomock.Any(), gomock.Eq(tournament), gomock.Eq(map[string]string{
+				"writeStartAt":     fmt.Sprint(startAt),
+				"writeEndAt":       fmt.Sprint(endAt),
+				"participantsOnly": "true",
+			})).Return(nil),
+		)
+
+		createdTournament, err := svc.CreateTournament(context.Background(), &model.Tournament{
+			ID:      tournament,
+			StartAt: startAt,
+			EndAt:   endAt,
+			Prizes: []*model.TournamentPrize{
+				{FromRank: 2, ToRank: 10, RewardID: "silver"},
+				{FromRank: 1, ToRank: 1, RewardID: "gold"},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(createdTournament).To(Equal(&model.Tournament{
+			ID:      tournament,
+			StartAt: startAt,
+			EndAt:   endAt,
+			Order:   "desc",
+			Prizes: []*model.TournamentPrize{
+				{FromRank: 1, ToRank: 1, RewardID: "gold"},
+				{FromRank: 2, ToRank: 10, RewardID: "silver"},
+			},
+			Status: model.TournamentScheduled,
+		}))
+	})
+
+	It("Should return TournamentAlreadyExistsError without restricting leaderboard writes if tournament exists", func() {
+		mock.EXPECT().CreateTournament(gomock.Any(), gomock.Eq(tournament), gomock.Any()).Return(false, nil)
+
+		_, err := svc.CreateTournament(context.Background(), &model.Tournament{ID: tournament, StartAt: startAt, EndAt: endAt})
+		Expect(err).To(Equal(service.NewTournamentAlreadyExistsError(tournament)))
+	})
+
+	It("Should return InvalidTournamentError if endAt is not after startAt", func() {
+		_, err := svc.CreateTournament(context.Background(), &model.Tournament{ID: tournament, StartAt: endAt, EndAt: startAt})
+		Expect(err).To(Equal(service.NewInvalidTournamentError(fmt.Sprintf("endAt %d must be greater than startAt %d", startAt, endAt))))
+	})
+
+	It("Should return InvalidTournamentError if prize ranges overlap", func() {
+		_, err := svc.CreateTournament(context.Background(), &model.Tournament{
+			ID:      tournament,
+			StartAt: startAt,
+			EndAt:   endAt,
+			Prizes: []*model.TournamentPrize{
+				{FromRank: 1, ToRank: 3, RewardID: "gold"},
+				{FromRank: 3, ToRank: 10, RewardID: "silver"},
+			},
+		})
+		Expect(err).To(Equal(service.NewInvalidTournamentError("prize rank range 3-10 overlaps 1-3")))
+	})
+
+	It("Should return InvalidTournamentError if prize range is not valid", func() {
+		_, err := svc.CreateTournament(context.Background(), &model.Tournament{
+			ID:      tournament,
+			StartAt: startAt,
+			EndAt:   endAt,
+			Prizes:  []*model.TournamentPrize{{FromRank: 5, ToRank: 2, RewardID: "gold"}},
+		})
+		Expect(err).To(Equal(service.NewInvalidTournamentError("prize rank range 5-2 is not valid")))
+	})
+
+	It("Should return error if database return in error on CreateTournament", func() {
+		mock.EXPECT().CreateTournament(gomock.Any(), gomock.Eq(tournament), gomock.Any()).Return(false, fmt.Errorf("Database error example"))
+
+		_, err := svc.CreateTournament(context.Background(), &model.Tournament{ID: tournament, StartAt: startAt, EndAt: endAt})
+		Expect(err).To(Equal(service.NewGeneralError("create tournament", "Database error example")))
+	})
+
+	It("Should return error if database return in error on SetLeaderboardSettings", func() {
+		mock.EXPECT().CreateTournament(gomock.Any(), gomock.Eq(tournament), gomock.Any()).Return(true, nil)
+		mock.EXPECT().SetLeaderboardSettings(gomock.Any(), gomock.Eq(tournament), gomock.Any()).Return(fmt.Errorf("Database error example"))
+
+		_, err := svc.CreateTournament(context.Background(), &model.Tournament{ID: tournament, StartAt: startAt, EndAt: endAt})
+		Expect(err).To(Equal(service.NewGeneralError("create tournament", "Database error example")))
+	})
+})
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"math"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// Decaying leaderboards use forward decay: a score written at time t is stored multiplied by
+// g(t) = 2^((t - landmark) / halfLife), so older contributions keep their stored value while newer
+// ones weight more and ranks never need to be recomputed. The decayed score at time t is the stored
+// score divided by g(t). Stored scores grow exponentially, so they are periodically renormalized
+// moving the landmark to the present.
+
+func hasDecay(settings *model.LeaderboardSettings) bool {
+	return settings.DecayHalfLife > 0
+}
+
+func decayWeight(settings *model.LeaderboardSettings, now time.Time) float64 {
+	if !hasDecay(settings) {
+		return 1
+	}
+
+	return math.Exp2(float64(now.Unix()-settings.DecayLandmark) / float64(settings.DecayHalfLife))
+}
+
+func toStoredScore(settings *model.LeaderboardSettings, score float64) float64 {
+	if !hasDecay(settings) {
+		return score
+	}
+
+	return score * decayWeight(settings, time.Now())
+}
+
+func toDecayedScore(settings *model.LeaderboardSettings, storedScore float64) int64 {
+	if !hasDecay(settings) {
+		return int64(storedScore)
+	}
+
+	return int64(math.Round(storedScore / decayWeight(settings, time.Now())))
+}
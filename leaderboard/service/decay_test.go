@@ -0,0 +1,117 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service score decay", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+	var member string = "member1"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+
+		// one half-life passed since landmark, so stored scores are twice the decayed ones
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"decayHalfLife": "3600",
+			"decayLandmark": fmt.Sprint(time.Now().Unix() - 3600),
+		}, nil).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should store scores weighted by decay on SetMemberScore", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, leaderboard string, databaseMembers []*database.Member) error {
+				Expect(databaseMembers[0].Score).To(BeNumerically("~", 200, 0.1))
+				return nil
+			},
+		)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(true), gomock.Eq(member)).Return([]*database.Member{
+			{Member: member, Score: 200, Rank: 0},
+		}, nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		returnedMember, err := svc.SetMemberScore(context.Background(), leaderboard, member, 100, false, "", nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(returnedMember.Score).To(Equal(int64(100)))
+	})
+
+	It("Should increment scores weighted by decay on IncrementMemberScore", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(&database.Member{Member: member}), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, leaderboard string, databaseMember *database.Member, increment float64) error {
+				Expect(increment).To(BeNumerically("~", 20, 0.1))
+				return nil
+			},
+		)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(true), gomock.Eq(member)).Return([]*database.Member{
+			{Member: member, Score: 120, Rank: 0},
+		}, nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		returnedMember, err := svc.IncrementMemberScore(context.Background(), leaderboard, member, 10, "", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(returnedMember.Score).To(Equal(int64(60)))
+	})
+
+	It("Should return decayed scores on GetLeaders", func() {
+		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(2, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(0), gomock.Eq(1), gomock.Eq("desc")).Return([]*database.Member{
+			{Member: member, Score: 200, Rank: 0},
+			{Member: "member2", Score: 51, Rank: 1},
+		}, nil)
+
+		members, err := svc.GetLeaders(context.Background(), leaderboard, 2, 1, "desc")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(members[0].Score).To(Equal(int64(100)))
+		Expect(members[1].Score).To(Equal(int64(26)))
+	})
+
+	It("Should return decayed score on GetMember", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq(member)).Return([]*database.Member{
+			{Member: member, Score: 200, Rank: 0},
+		}, nil)
+
+		returnedMember, err := svc.GetMember(context.Background(), leaderboard, member, "desc", false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(returnedMember.Score).To(Equal(int64(100)))
+	})
+
+	It("Should search stored score on GetAroundScore", func() {
+		mock.EXPECT().GetMemberIDsWithScoreInsideRange(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("-inf"), gomock.Any(), gomock.Eq(0), gomock.Eq(1)).DoAndReturn(
+			func(ctx context.Context, leaderboard string, min, max string, offset, count int) ([]string, error) {
+				Expect(max).To(HavePrefix("200"))
+				return []string{member}, nil
+			},
+		)
+		mock.EXPECT().GetRank(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq("desc")).Return(0, nil)
+		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(1, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(0), gomock.Eq(0), gomock.Eq("desc")).Return([]*database.Member{
+			{Member: member, Score: 200, Rank: 0},
+		}, nil)
+
+		members, err := svc.GetAroundScore(context.Background(), leaderboard, 1, 100, "desc")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(members[0].Score).To(Equal(int64(100)))
+	})
+})
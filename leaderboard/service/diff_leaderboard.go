@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const diffLeaderboardServiceLabel = "diff leaderboard"
+
+// DiffLeaderboard compare the newest rank snapshot of leaderboard taken until unix timestamp from with the
+// newest taken until unix timestamp to, or the present if to is zero, calling emit with each member that
+// entered, changed and then left, in the order requested. Members whose score and rank did not change are
+// not emitted. Both sides are read a page at a time, so writes made while comparing with the present may be
+// seen only by later pages. It stops at the first error returned by emit
+func (s *Service) DiffLeaderboard(ctx context.Context, leaderboard string, from, to int64, order string, emit func(*model.MemberDiff) error) error {
+	if order != "desc" && order != "asc" {
+		order = "desc"
+	}
+
+	fromTakenAt, err := s.getRankSnapshotTime(ctx, leaderboard, from, diffLeaderboardServiceLabel)
+	if err != nil {
+		return err
+	}
+
+	var getCurrentMembers func(start, stop int) ([]*model.Member, error)
+	var getCurrentMembersByID func(members ...string) ([]*model.Member, error)
+	if to > 0 {
+		toTakenAt, err := s.getRankSnapshotTime(ctx, leaderboard, to, diffLeaderboardServiceLabel)
+		if err != nil {
+			return err
+		}
+
+		getCurrentMembers = func(start, stop int) ([]*model.Member, error) {
+			databaseMembers, err := s.Database.GetRankSnapshotMembers(ctx, leaderboard, toTakenAt, start, stop, order)
+			return convertSnapshotMembersIntoModelMembers(databaseMembers), err
+		}
+		getCurrentMembersByID = func(members ...string) ([]*model.Member, error) {
+			databaseMembers, err := s.Database.GetRankSnapshotMembersByID(ctx, leaderboard, toTakenAt, order, members...)
+			return convertSnapshotMembersIntoModelMembers(databaseMembers), err
+		}
+	} else {
+		settings, err := s.getLeaderboardSettings(ctx, leaderboard)
+		if err != nil {
+			return NewGeneralError(diffLeaderboardServiceLabel, err.Error())
+		}
+
+		getCurrentMembers = func(start, stop int) ([]*model.Member, error) {
+			databaseMembers, err := s.Database.GetOrderedMembers(ctx, leaderboard, start, stop, order)
+			return convertDatabaseMembersIntoModelMembers(databaseMembers, settings), err
+		}
+		getCurrentMembersByID = func(members ...string) ([]*model.Member, error) {
+			databaseMembers, err := s.Database.GetMembersByID(ctx, leaderboard, order, members...)
+			if err != nil {
+				return nil, err
+			}
+
+			currentMembers := make([]*model.Member, 0, len(databaseMembers))
+			for _, member := range databaseMembers {
+				if member == nil {
+					currentMembers = append(currentMembers, nil)
+					continue
+				}
+				currentMembers = append(currentMembers, convertDatabaseMemberIntoModelMember(member, settings))
+			}
+			return currentMembers, nil
+		}
+	}
+
+	for start := 0; ; start += snapshotPageSize {
+		currentMembers, err := getCurrentMembers(start, start+snapshotPageSize-1)
+		if err != nil {
+			return NewGeneralError(diffLeaderboardServiceLabel, err.Error())
+		}
+		if len(currentMembers) == 0 {
+			break
+		}
+
+		databaseMembers, err := s.Database.GetRankSnapshotMembersByID(ctx, leaderboard, fromTakenAt, order, publicIDsOf(currentMembers)...)
+		if err != nil {
+			return NewGeneralError(diffLeaderboardServiceLabel, err.Error())
+		}
+
+		for i, member := range currentMembers {
+			score := member.Score
+			diff := &model.MemberDiff{
+				PublicID: member.PublicID,
+				Change:   model.MemberDiffEntered,
+				Score:    &score,
+				Rank:     member.Rank,
+			}
+
+			if previousMember := convertSnapshotMemberIntoModelMember(databaseMembers[i]); previousMember != nil {
+				if previousMember.Score == member.Score && previousMember.Rank == member.Rank {
+					continue
+				}
+
+				previousScore := previousMember.Score
+				diff.Change = model.MemberDiffChanged
+				diff.PreviousScore = &previousScore
+				diff.PreviousRank = previousMember.Rank
+				diff.ScoreDelta = member.Score - previousMember.Score
+				diff.RankDelta = previousMember.Rank - member.Rank
+			}
+
+			err = emit(diff)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(currentMembers) < snapshotPageSize {
+			break
+		}
+	}
+
+	for start := 0; ; start += snapshotPageSize {
+		databaseMembers, err := s.Database.GetRankSnapshotMembers(ctx, leaderboard, fromTakenAt, start, start+snapshotPageSize-1, order)
+		if err != nil {
+			return NewGeneralError(diffLeaderboardServiceLabel, err.Error())
+		}
+		if len(databaseMembers) == 0 {
+			break
+		}
+		previousMembers := convertSnapshotMembersIntoModelMembers(databaseMembers)
+
+		currentMembers, err := getCurrentMembersByID(publicIDsOf(previousMembers)...)
+		if err != nil {
+			return NewGeneralError(diffLeaderboardServiceLabel, err.Error())
+		}
+
+		for i, member := range previousMembers {
+			if currentMembers[i] != nil {
+				continue
+			}
+
+			previousScore := member.Score
+			err = emit(&model.MemberDiff{
+				PublicID:      member.PublicID,
+				Change:        model.MemberDiffLeft,
+				PreviousScore: &previousScore,
+				PreviousRank:  member.Rank,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(previousMembers) < snapshotPageSize {
+			break
+		}
+	}
+
+	return nil
+}
+
+func publicIDsOf(members []*model.Member) []string {
+	publicIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		publicIDs = append(publicIDs, member.PublicID)
+	}
+
+	return publicIDs
+}
@@ -0,0 +1,165 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service DiffLeaderboard", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+	var fromTakenAt time.Time = time.Unix(1600000000, 0)
+	var toTakenAt time.Time = time.Unix(1600086400, 0)
+	var diffs []*model.MemberDiff
+	var emit func(*model.MemberDiff) error
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+
+		diffs = []*model.MemberDiff{}
+		emit = func(diff *model.MemberDiff) error {
+			diffs = append(diffs, diff)
+			return nil
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	score := func(score int64) *int64 {
+		return &score
+	}
+
+	It("Should emit members that entered, changed and left since snapshot", func() {
+		mock.EXPECT().GetRankSnapshotTime(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(fromTakenAt)).Return(fromTakenAt, nil)
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(0), gomock.Eq(999), gomock.Eq("desc")).Return([]*database.Member{
+			{Member: "member3", Score: 500, Rank: 0},
+			{Member: "member1", Score: 300, Rank: 1},
+			{Member: "member4", Score: 50, Rank: 2},
+		}, nil)
+		mock.EXPECT().GetRankSnapshotMembersByID(
+			gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(fromTakenAt), gomock.Eq("desc"), gomock.Eq("member3"), gomock.Eq("member1"), gomock.Eq("member4"),
+		).Return([]*database.Member{
+			{Member: "member3", Score: 100, Rank: 2},
+			{Member: "member1", Score: 300.4, Rank: 0},
+			nil,
+		}, nil)
+		mock.EXPECT().GetRankSnapshotMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(fromTakenAt), gomock.Eq(0), gomock.Eq(999), gomock.Eq("desc")).Return([]*database.Member{
+			{Member: "member1", Score: 300.4, Rank: 0},
+			{Member: "member2", Score: 200, Rank: 1},
+			{Member: "member3", Score: 100, Rank: 2},
+		}, nil)
+		mock.EXPECT().GetMembersByID(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq("member1"), gomock.Eq("member2"), gomock.Eq("member3")).Return([]*database.Member{
+			{Member: "member1", Score: 300, Rank: 1},
+			nil,
+			{Member: "member3", Score: 500, Rank: 0},
+		}, nil)
+
+		err := svc.DiffLeaderboard(context.Background(), leaderboard, fromTakenAt.Unix(), 0, "desc", emit)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diffs).To(Equal([]*model.MemberDiff{
+			{PublicID: "member3", Change: model.MemberDiffChanged, PreviousScore: score(100), PreviousRank: 3, Score: score(500), Rank: 1, ScoreDelta: 400, RankDelta: 2},
+			{PublicID: "member1", Change: model.MemberDiffChanged, PreviousScore: score(300), PreviousRank: 1, Score: score(300), Rank: 2, ScoreDelta: 0, RankDelta: -1},
+			{PublicID: "member4", Change: model.MemberDiffEntered, Score: score(50), Rank: 3},
+			{PublicID: "member2", Change: model.MemberDiffLeft, PreviousScore: score(200), PreviousRank: 2},
+		}))
+	})
+
+	It("Should compare two snapshots and skip members that did not change", func() {
+		mock.EXPECT().GetRankSnapshotTime(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(fromTakenAt)).Return(fromTakenAt, nil)
+		mock.EXPECT().GetRankSnapshotTime(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(toTakenAt)).Return(toTakenAt, nil)
+		mock.EXPECT().GetRankSnapshotMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(toTakenAt), gomock.Eq(0), gomock.Eq(999), gomock.Eq("asc")).Return([]*database.Member{
+			{Member: "member1", Score: 100, Rank: 0},
+			{Member: "member2", Score: 250, Rank: 1},
+		}, nil)
+		mock.EXPECT().GetRankSnapshotMembersByID(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(fromTakenAt), gomock.Eq("asc"), gomock.Eq("member1"), gomock.Eq("member2")).Return([]*database.Member{
+			{Member: "member1", Score: 100, Rank: 0},
+			{Member: "member2", Score: 200, Rank: 1},
+		}, nil)
+		mock.EXPECT().GetRankSnapshotMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(fromTakenAt), gomock.Eq(0), gomock.Eq(999), gomock.Eq("asc")).Return([]*database.Member{
+			{Member: "member1", Score: 100, Rank: 0},
+			{Member: "member2", Score: 200, Rank: 1},
+		}, nil)
+		mock.EXPECT().GetRankSnapshotMembersByID(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(toTakenAt), gomock.Eq("asc"), gomock.Eq("member1"), gomock.Eq("member2")).Return([]*database.Member{
+			{Member: "member1", Score: 100, Rank: 0},
+			{Member: "member2", Score: 250, Rank: 1},
+		}, nil)
+
+		err := svc.DiffLeaderboard(context.Background(), leaderboard, fromTakenAt.Unix(), toTakenAt.Unix(), "asc", emit)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diffs).To(Equal([]*model.MemberDiff{
+			{PublicID: "member2", Change: model.MemberDiffChanged, PreviousScore: score(200), PreviousRank: 2, Score: score(250), Rank: 2, ScoreDelta: 50},
+		}))
+	})
+
+	It("Should read members a page at a time", func() {
+		page := make([]*database.Member, 0, 1000)
+		for i := 0; i < 1000; i++ {
+			page = append(page, &database.Member{Member: fmt.Sprintf("member%d", i), Score: float64(2000 - i), Rank: int64(i)})
+		}
+
+		mock.EXPECT().GetRankSnapshotTime(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(fromTakenAt, nil)
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(0), gomock.Eq(999), gomock.Eq("desc")).Return(page, nil)
+		mock.EXPECT().GetRankSnapshotMembersByID(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(fromTakenAt), gomock.Eq("desc"), gomock.Any()).Return(page, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(1000), gomock.Eq(1999), gomock.Eq("desc")).Return([]*database.Member{}, nil)
+		mock.EXPECT().GetRankSnapshotMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(fromTakenAt), gomock.Eq(0), gomock.Eq(999), gomock.Eq("desc")).Return(page, nil)
+		mock.EXPECT().GetMembersByID(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Any()).Return(page, nil)
+		mock.EXPECT().GetRankSnapshotMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(fromTakenAt), gomock.Eq(1000), gomock.Eq(1999), gomock.Eq("desc")).Return([]*database.Member{}, nil)
+
+		err := svc.DiffLeaderboard(context.Background(), leaderboard, fromTakenAt.Unix(), 0, "desc", emit)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diffs).To(BeEmpty())
+	})
+
+	It("Should stop at the first error returned by emit", func() {
+		mock.EXPECT().GetRankSnapshotTime(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(fromTakenAt, nil)
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.Member{
+			{Member: "member1", Score: 100, Rank: 0},
+			{Member: "member2", Score: 50, Rank: 1},
+		}, nil)
+		mock.EXPECT().GetRankSnapshotMembersByID(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.Member{nil, nil}, nil)
+
+		emitErr := fmt.Errorf("stream closed")
+		calls := 0
+		err := svc.DiffLeaderboard(context.Background(), leaderboard, fromTakenAt.Unix(), 0, "desc", func(diff *model.MemberDiff) error {
+			calls++
+			return emitErr
+		})
+		Expect(err).To(Equal(emitErr))
+		Expect(calls).To(Equal(1))
+	})
+
+	It("Should return RankSnapshotNotFoundError if no snapshot was taken until from", func() {
+		mock.EXPECT().GetRankSnapshotTime(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(time.Time{}, nil)
+
+		err := svc.DiffLeaderboard(context.Background(), leaderboard, 1600000000, 0, "desc", emit)
+		Expect(err).To(Equal(service.NewRankSnapshotNotFoundError(leaderboard, 1600000000)))
+	})
+
+	It("Should return error if database return in error on GetOrderedMembers", func() {
+		mock.EXPECT().GetRankSnapshotTime(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(fromTakenAt, nil)
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("Database error example"))
+
+		err := svc.DiffLeaderboard(context.Background(), leaderboard, 1600000000, 0, "desc", emit)
+		Expect(err).To(Equal(service.NewGeneralError("diff leaderboard", "Database error example")))
+	})
+})
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const endLeagueSeasonServiceLabel = "end league season"
+
+// EndLeagueSeason promote the first PromotionCount members and relegate the last RelegationCount members
+// of each division, place all members in divisions of the next season and make it the current season.
+// Members of the highest tier are never promoted and members of the lowest tier are never relegated.
+// Leaderboards of ended seasons are kept, so their standings can still be read. The ended season is sent to
+// the lifecycle notifier.
+// season is the season to end, 0 for the current one. A season is ended only once, ending a season that
+// already ended returns the result stored when it did, so a retried call does not promote members twice
+func (s *Service) EndLeagueSeason(ctx context.Context, league string, season int) (*model.LeagueSeasonResult, error) {
+	config, err := s.getLeague(ctx, league)
+	if err != nil {
+		if _, ok := err.(*LeagueNotFoundError); ok {
+			return nil, err
+		}
+		return nil, NewGeneralError(endLeagueSeasonServiceLabel, err.Error())
+	}
+
+	if season == 0 {
+		season = config.Season
+	}
+	if season > config.Season || season < 0 {
+		return nil, NewInvalidLeagueError(fmt.Sprintf("season %d is not the current season %d or an ended one", season, config.Season))
+	}
+	if season < config.Season {
+		return s.getLeagueSeasonResult(ctx, league, season)
+	}
+
+	divisionCounts, err := s.Database.GetLeagueDivisionCounts(ctx, league, config.Season)
+	if err != nil {
+		return nil, NewGeneralError(endLeagueSeasonServiceLabel, err.Error())
+	}
+
+	result := &model.LeagueSeasonResult{
+		League: league,
+		Season: config.Season + 1,
+	}
+
+	nextTiers := make([][]string, config.Tiers+1)
+	for tier := 1; tier <= config.Tiers; tier++ {
+		for division := 1; division <= divisionCounts[tier]; division++ {
+			leaderboard := database.LeagueDivisionLeaderboard(league, config.Season, tier, division)
+			members, err := s.Database.GetOrderedMembers(ctx, leaderboard, 0, -1, config.Order)
+			if err != nil {
+				return nil, NewGeneralError(endLeagueSeasonServiceLabel, err.Error())
+			}
+
+			for i, member := range members {
+				nextTier := tier
+				switch {
+				case i < config.PromotionCount && tier > 1:
+					nextTier = tier - 1
+					result.Promoted++
+				case i >= len(members)-config.RelegationCount && i >= config.PromotionCount && tier < config.Tiers:
+					nextTier = tier + 1
+					result.Relegated++
+				default:
+					result.Stayed++
+				}
+
+				nextTiers[nextTier] = append(nextTiers[nextTier], member.Member)
+			}
+		}
+	}
+
+	for tier := 1; tier <= config.Tiers; tier++ {
+		if len(nextTiers[tier]) == 0 {
+			continue
+		}
+
+		_, err = s.Database.JoinLeagueDivisions(ctx, league, result.Season, tier, config.DivisionSize, nextTiers[tier]...)
+		if err != nil {
+			return nil, NewGeneralError(endLeagueSeasonServiceLabel, err.Error())
+		}
+	}
+
+	ended, err := s.Database.EndLeagueSeason(ctx, league, config.Season, &database.LeagueSeasonResult{
+		Promoted:  result.Promoted,
+		Relegated: result.Relegated,
+		Stayed:    result.Stayed,
+	})
+	if err != nil {
+		return nil, NewGeneralError(endLeagueSeasonServiceLabel, err.Error())
+	}
+
+	if !ended {
+		return s.getLeagueSeasonResult(ctx, league, config.Season)
+	}
+
+	s.afterCommit(ctx, AfterCommitLifecycle, s.notifyLifecycle(ctx, &model.LifecycleEvent{
+		Type:   model.LifecycleSeasonEnded,
+		League: league,
+		Season: result.Season - 1,
+	}))
+
+	return result, nil
+}
+
+func (s *Service) getLeagueSeasonResult(ctx context.Context, league string, season int) (*model.LeagueSeasonResult, error) {
+	databaseResult, err := s.Database.GetLeagueSeasonResult(ctx, league, season)
+	if err != nil {
+		return nil, NewGeneralError(endLeagueSeasonServiceLabel, err.Error())
+	}
+
+	if databaseResult == nil {
+		return nil, NewGeneralError(endLeagueSeasonServiceLabel, fmt.Sprintf("result of season %d of league %s not found", season, league))
+	}
+
+	return &model.LeagueSeasonResult{
+		League:    league,
+		Season:    season + 1,
+		Promoted:  databaseResult.Promoted,
+		Relegated: databaseResult.Relegated,
+		Stayed:    databaseResult.Stayed,
+	}, nil
+}
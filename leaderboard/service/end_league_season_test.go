@@ -0,0 +1,170 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/lifecycle"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service EndLeagueSeason", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var league string = "leagueTest"
+	var databaseLeague *database.League
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+
+		databaseLeague = &database.League{
+			Season:          1,
+			Tiers:           2,
+			DivisionSize:    3,
+			PromotionCount:  1,
+			RelegationCount: 1,
+			Order:           "desc",
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should promote, relegate and start next season if all is OK", func() {
+		mock.EXPECT().GetLeague(gomock.Any(), gomock.Eq(league)).Return(databaseLeague, nil)
+		mock.EXPECT().GetLeagueDivisionCounts(gomock.Any(), gomock.Eq(league), gomock.Eq(1)).Return(map[int]int{1: 1, 2: 2}, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq("{leagueTest}:s1:t1:d1"), gomock.Eq(0), gomock.Eq(-1), gomock.Eq("desc")).Return([]*database.Member{
+			{Member: "a", Score: 30},
+			{Member: "b", Score: 20},
+			{Member: "c", Score: 10},
+		}, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq("{leagueTest}:s1:t2:d1"), gomock.Eq(0), gomock.Eq(-1), gomock.Eq("desc")).Return([]*database.Member{
+			{Member: "d", Score: 30},
+			{Member: "e", Score: 20},
+			{Member: "f", Score: 10},
+		}, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq("{leagueTest}:s1:t2:d2"), gomock.Eq(0), gomock.Eq(-1), gomock.Eq("desc")).Return([]*database.Member{
+			{Member: "g", Score: 30},
+		}, nil)
+		mock.EXPECT().JoinLeagueDivisions(gomock.Any(), gomock.Eq(league), gomock.Eq(2), gomock.Eq(1), gomock.Eq(3), gomock.Eq("a"), gomock.Eq("b"), gomock.Eq("d"), gomock.Eq("g")).Return(nil, nil)
+		mock.EXPECT().JoinLeagueDivisions(gomock.Any(), gomock.Eq(league), gomock.Eq(2), gomock.Eq(2), gomock.Eq(3), gomock.Eq("c"), gomock.Eq("e"), gomock.Eq("f")).Return(nil, nil)
+		mock.EXPECT().EndLeagueSeason(gomock.Any(), gomock.Eq(league), gomock.Eq(1), gomock.Eq(&database.LeagueSeasonResult{
+			Promoted:  2,
+			Relegated: 1,
+			Stayed:    4,
+		})).Return(true, nil)
+
+		result, err := svc.EndLeagueSeason(context.Background(), league, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(&model.LeagueSeasonResult{
+			League:    league,
+			Season:    2,
+			Promoted:  2,
+			Relegated: 1,
+			Stayed:    4,
+		}))
+	})
+
+	It("Should report error of the lifecycle notifier after the season ended and return the result", func() {
+		notifier := lifecycle.NewMockNotifier(ctrl)
+		svc.LifecycleNotifier = notifier
+		var steps []string
+		svc.OnAfterCommitError = func(ctx context.Context, step string, err error) {
+			steps = append(steps, step)
+		}
+
+		mock.EXPECT().GetLeague(gomock.Any(), gomock.Eq(league)).Return(databaseLeague, nil)
+		mock.EXPECT().GetLeagueDivisionCounts(gomock.Any(), gomock.Eq(league), gomock.Eq(1)).Return(map[int]int{}, nil)
+		mock.EXPECT().EndLeagueSeason(gomock.Any(), gomock.Eq(league), gomock.Eq(1), gomock.Any()).Return(true, nil)
+		notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).Return(fmt.Errorf("notifier error"))
+
+		result, err := svc.EndLeagueSeason(context.Background(), league, 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Season).To(Equal(2))
+		Expect(steps).To(Equal([]string{service.AfterCommitLifecycle}))
+	})
+
+	It("Should return LeagueNotFoundError if league does not exist", func() {
+		mock.EXPECT().GetLeague(gomock.Any(), gomock.Eq(league)).Return(nil, database.NewLeagueNotFoundError(league))
+
+		_, err := svc.EndLeagueSeason(context.Background(), league, 0)
+		Expect(err).To(Equal(service.NewLeagueNotFoundError(league)))
+	})
+
+	It("Should return error if database return in error on GetLeagueDivisionCounts", func() {
+		mock.EXPECT().GetLeague(gomock.Any(), gomock.Eq(league)).Return(databaseLeague, nil)
+		mock.EXPECT().GetLeagueDivisionCounts(gomock.Any(), gomock.Eq(league), gomock.Eq(1)).Return(nil, fmt.Errorf("Database error example"))
+
+		_, err := svc.EndLeagueSeason(context.Background(), league, 0)
+		Expect(err).To(Equal(service.NewGeneralError("end league season", "Database error example")))
+	})
+
+	It("Should return error if database return in error on JoinLeagueDivisions", func() {
+		mock.EXPECT().GetLeague(gomock.Any(), gomock.Eq(league)).Return(databaseLeague, nil)
+		mock.EXPECT().GetLeagueDivisionCounts(gomock.Any(), gomock.Eq(league), gomock.Eq(1)).Return(map[int]int{1: 1}, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq("{leagueTest}:s1:t1:d1"), gomock.Eq(0), gomock.Eq(-1), gomock.Eq("desc")).Return([]*database.Member{
+			{Member: "a", Score: 30},
+		}, nil)
+		mock.EXPECT().JoinLeagueDivisions(gomock.Any(), gomock.Eq(league), gomock.Eq(2), gomock.Eq(1), gomock.Eq(3), gomock.Eq("a")).Return(nil, fmt.Errorf("Database error example"))
+
+		_, err := svc.EndLeagueSeason(context.Background(), league, 0)
+		Expect(err).To(Equal(service.NewGeneralError("end league season", "Database error example")))
+	})
+
+	It("Should return stored result if season already ended", func() {
+		mock.EXPECT().GetLeague(gomock.Any(), gomock.Eq(league)).Return(databaseLeague, nil)
+		databaseLeague.Season = 2
+		mock.EXPECT().GetLeagueSeasonResult(gomock.Any(), gomock.Eq(league), gomock.Eq(1)).Return(&database.LeagueSeasonResult{
+			Promoted:  2,
+			Relegated: 1,
+			Stayed:    4,
+		}, nil)
+
+		result, err := svc.EndLeagueSeason(context.Background(), league, 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(&model.LeagueSeasonResult{
+			League:    league,
+			Season:    2,
+			Promoted:  2,
+			Relegated: 1,
+			Stayed:    4,
+		}))
+	})
+
+	It("Should return stored result if season was ended concurrently", func() {
+		mock.EXPECT().GetLeague(gomock.Any(), gomock.Eq(league)).Return(databaseLeague, nil)
+		mock.EXPECT().GetLeagueDivisionCounts(gomock.Any(), gomock.Eq(league), gomock.Eq(1)).Return(map[int]int{1: 1}, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq("{leagueTest}:s1:t1:d1"), gomock.Eq(0), gomock.Eq(-1), gomock.Eq("desc")).Return([]*database.Member{
+			{Member: "a", Score: 30},
+		}, nil)
+		mock.EXPECT().JoinLeagueDivisions(gomock.Any(), gomock.Eq(league), gomock.Eq(2), gomock.Eq(1), gomock.Eq(3), gomock.Eq("a")).Return(nil, nil)
+		mock.EXPECT().EndLeagueSeason(gomock.Any(), gomock.Eq(league), gomock.Eq(1), gomock.Any()).Return(false, nil)
+		mock.EXPECT().GetLeagueSeasonResult(gomock.Any(), gomock.Eq(league), gomock.Eq(1)).Return(&database.LeagueSeasonResult{Stayed: 1}, nil)
+
+		result, err := svc.EndLeagueSeason(context.Background(), league, 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(&model.LeagueSeasonResult{
+			League: league,
+			Season: 2,
+			Stayed: 1,
+		}))
+	})
+
+	It("Should return InvalidLeagueError if season did not start", func() {
+		mock.EXPECT().GetLeague(gomock.Any(), gomock.Eq(league)).Return(databaseLeague, nil)
+
+		_, err := svc.EndLeagueSeason(context.Background(), league, 2)
+		Expect(err).To(BeAssignableToTypeOf(&service.InvalidLeagueError{}))
+	})
+})
@@ -1,6 +1,9 @@
 package service
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // GeneralError is an error threw when a not handled error was found
 type GeneralError struct {
@@ -87,3 +90,468 @@ func NewPercentageError(percentage int) *PercentageError {
 		percentage: percentage,
 	}
 }
+
+// InvalidResetOptionsError is an error threw when a leaderboard reset is requested with invalid options
+type InvalidResetOptionsError struct {
+	msg string
+}
+
+func (iroe *InvalidResetOptionsError) Error() string {
+	return fmt.Sprintf("invalid reset options: %s", iroe.msg)
+}
+
+// NewInvalidResetOptionsError create a new InvalidResetOptionsError
+func NewInvalidResetOptionsError(msg string) *InvalidResetOptionsError {
+	return &InvalidResetOptionsError{
+		msg: msg,
+	}
+}
+
+// ResetProgressNotFoundError is an error threw when a leaderboard was never reset
+type ResetProgressNotFoundError struct {
+	leaderboard string
+}
+
+func (rpnfe *ResetProgressNotFoundError) Error() string {
+	return fmt.Sprintf("Could not find reset progress for leaderboard %s.", rpnfe.leaderboard)
+}
+
+// NewResetProgressNotFoundError create a new ResetProgressNotFoundError
+func NewResetProgressNotFoundError(leaderboard string) *ResetProgressNotFoundError {
+	return &ResetProgressNotFoundError{
+		leaderboard: leaderboard,
+	}
+}
+
+// InvalidLeaderboardSettingsError is an error threw when leaderboard settings are not valid
+type InvalidLeaderboardSettingsError struct {
+	msg string
+}
+
+func (ilse *InvalidLeaderboardSettingsError) Error() string {
+	return fmt.Sprintf("invalid leaderboard settings: %s", ilse.msg)
+}
+
+// NewInvalidLeaderboardSettingsError create a new InvalidLeaderboardSettingsError
+func NewInvalidLeaderboardSettingsError(msg string) *InvalidLeaderboardSettingsError {
+	return &InvalidLeaderboardSettingsError{
+		msg: msg,
+	}
+}
+
+// LeaderboardWithoutDecayError is an error threw when a decay operation is applied to a leaderboard without decay
+type LeaderboardWithoutDecayError struct {
+	leaderboard string
+}
+
+func (lwde *LeaderboardWithoutDecayError) Error() string {
+	return fmt.Sprintf("leaderboard %s does not have score decay", lwde.leaderboard)
+}
+
+// NewLeaderboardWithoutDecayError create a new LeaderboardWithoutDecayError
+func NewLeaderboardWithoutDecayError(leaderboard string) *LeaderboardWithoutDecayError {
+	return &LeaderboardWithoutDecayError{
+		leaderboard: leaderboard,
+	}
+}
+
+// InvalidLeagueError is an error threw when league configuration or parameters are not valid
+type InvalidLeagueError struct {
+	msg string
+}
+
+func (ile *InvalidLeagueError) Error() string {
+	return fmt.Sprintf("invalid league: %s", ile.msg)
+}
+
+// NewInvalidLeagueError create a new InvalidLeagueError
+func NewInvalidLeagueError(msg string) *InvalidLeagueError {
+	return &InvalidLeagueError{
+		msg: msg,
+	}
+}
+
+// LeagueAlreadyExistsError is an error threw when creating a league that already exists
+type LeagueAlreadyExistsError struct {
+	league string
+}
+
+func (laee *LeagueAlreadyExistsError) Error() string {
+	return fmt.Sprintf("league %s already exists", laee.league)
+}
+
+// NewLeagueAlreadyExistsError create a new LeagueAlreadyExistsError
+func NewLeagueAlreadyExistsError(league string) *LeagueAlreadyExistsError {
+	return &LeagueAlreadyExistsError{
+		league: league,
+	}
+}
+
+// LeagueNotFoundError is an error threw when league was never created
+type LeagueNotFoundError struct {
+	league string
+}
+
+func (lnfe *LeagueNotFoundError) Error() string {
+	return fmt.Sprintf("league %s not found", lnfe.league)
+}
+
+// NewLeagueNotFoundError create a new LeagueNotFoundError
+func NewLeagueNotFoundError(league string) *LeagueNotFoundError {
+	return &LeagueNotFoundError{
+		league: league,
+	}
+}
+
+// LeagueMemberNotFoundError is an error threw when member did not join the current league season
+type LeagueMemberNotFoundError struct {
+	league string
+	member string
+}
+
+func (lmnfe *LeagueMemberNotFoundError) Error() string {
+	return fmt.Sprintf("member %s not found in league %s", lmnfe.member, lmnfe.league)
+}
+
+// NewLeagueMemberNotFoundError create a new LeagueMemberNotFoundError
+func NewLeagueMemberNotFoundError(league, member string) *LeagueMemberNotFoundError {
+	return &LeagueMemberNotFoundError{
+		league: league,
+		member: member,
+	}
+}
+
+// LeaderboardClosedError is an error threw when writing to a leaderboard outside its write window
+type LeaderboardClosedError struct {
+	leaderboard string
+}
+
+func (lce *LeaderboardClosedError) Error() string {
+	return fmt.Sprintf("leaderboard %s is not accepting writes at this time", lce.leaderboard)
+}
+
+// NewLeaderboardClosedError create a new LeaderboardClosedError
+func NewLeaderboardClosedError(leaderboard string) *LeaderboardClosedError {
+	return &LeaderboardClosedError{
+		leaderboard: leaderboard,
+	}
+}
+
+// ImportNotAllowedError is an error threw when importing into a leaderboard whose settings need each write
+// to be checked against the scores members had, like score rules and milestones
+type ImportNotAllowedError struct {
+	leaderboard string
+	reason      string
+}
+
+func (inae *ImportNotAllowedError) Error() string {
+	return fmt.Sprintf("leaderboard %s does not accept imports: %s", inae.leaderboard, inae.reason)
+}
+
+// NewImportNotAllowedError create a new ImportNotAllowedError
+func NewImportNotAllowedError(leaderboard, reason string) *ImportNotAllowedError {
+	return &ImportNotAllowedError{
+		leaderboard: leaderboard,
+		reason:      reason,
+	}
+}
+
+// LeaderboardFrozenError is an error threw when writing to a frozen leaderboard
+type LeaderboardFrozenError struct {
+	leaderboard string
+}
+
+func (lfe *LeaderboardFrozenError) Error() string {
+	return fmt.Sprintf("leaderboard %s is frozen", lfe.leaderboard)
+}
+
+// NewLeaderboardFrozenError create a new LeaderboardFrozenError
+func NewLeaderboardFrozenError(leaderboard string) *LeaderboardFrozenError {
+	return &LeaderboardFrozenError{
+		leaderboard: leaderboard,
+	}
+}
+
+// MemberNotParticipantError is an error threw when writing scores of a member that did not join the leaderboard
+type MemberNotParticipantError struct {
+	leaderboard string
+	member      string
+}
+
+func (mnpe *MemberNotParticipantError) Error() string {
+	return fmt.Sprintf("member %s did not join leaderboard %s", mnpe.member, mnpe.leaderboard)
+}
+
+// NewMemberNotParticipantError create a new MemberNotParticipantError
+func NewMemberNotParticipantError(leaderboard, member string) *MemberNotParticipantError {
+	return &MemberNotParticipantError{
+		leaderboard: leaderboard,
+		member:      member,
+	}
+}
+
+// InvalidTournamentError is an error threw when tournament configuration is not valid
+type InvalidTournamentError struct {
+	msg string
+}
+
+func (ite *InvalidTournamentError) Error() string {
+	return fmt.Sprintf("invalid tournament: %s", ite.msg)
+}
+
+// NewInvalidTournamentError create a new InvalidTournamentError
+func NewInvalidTournamentError(msg string) *InvalidTournamentError {
+	return &InvalidTournamentError{
+		msg: msg,
+	}
+}
+
+// TournamentAlreadyExistsError is an error threw when creating a tournament that already exists
+type TournamentAlreadyExistsError struct {
+	tournament string
+}
+
+func (taee *TournamentAlreadyExistsError) Error() string {
+	return fmt.Sprintf("tournament %s already exists", taee.tournament)
+}
+
+// NewTournamentAlreadyExistsError create a new TournamentAlreadyExistsError
+func NewTournamentAlreadyExistsError(tournament string) *TournamentAlreadyExistsError {
+	return &TournamentAlreadyExistsError{
+		tournament: tournament,
+	}
+}
+
+// TournamentNotFoundError is an error threw when tournament was never created
+type TournamentNotFoundError struct {
+	tournament string
+}
+
+func (tnfe *TournamentNotFoundError) Error() string {
+	return fmt.Sprintf("tournament %s not found", tnfe.tournament)
+}
+
+// NewTournamentNotFoundError create a new TournamentNotFoundError
+func NewTournamentNotFoundError(tournament string) *TournamentNotFoundError {
+	return &TournamentNotFoundError{
+		tournament: tournament,
+	}
+}
+
+// TournamentNotEndedError is an error threw when finalizing a tournament before its end
+type TournamentNotEndedError struct {
+	tournament string
+}
+
+func (tnee *TournamentNotEndedError) Error() string {
+	return fmt.Sprintf("tournament %s has not ended", tnee.tournament)
+}
+
+// NewTournamentNotEndedError create a new TournamentNotEndedError
+func NewTournamentNotEndedError(tournament string) *TournamentNotEndedError {
+	return &TournamentNotEndedError{
+		tournament: tournament,
+	}
+}
+
+// ScoreConditionFailedError is an error threw when a conditional write finds a different member score or version
+type ScoreConditionFailedError struct {
+	leaderboard string
+	member      string
+}
+
+func (scfe *ScoreConditionFailedError) Error() string {
+	return fmt.Sprintf("member %s of leaderboard %s does not have the expected score or version", scfe.member, scfe.leaderboard)
+}
+
+// NewScoreConditionFailedError create a new ScoreConditionFailedError
+func NewScoreConditionFailedError(leaderboard, member string) *ScoreConditionFailedError {
+	return &ScoreConditionFailedError{
+		leaderboard: leaderboard,
+		member:      member,
+	}
+}
+
+// ScoreChangedError is an error threw when scores checked against the rules of the leaderboard settings kept
+// changing before they were written
+type ScoreChangedError struct {
+	leaderboard string
+}
+
+func (sce *ScoreChangedError) Error() string {
+	return fmt.Sprintf("scores of leaderboard %s changed while they were checked against its rules, try again", sce.leaderboard)
+}
+
+// NewScoreChangedError create a new ScoreChangedError
+func NewScoreChangedError(leaderboard string) *ScoreChangedError {
+	return &ScoreChangedError{
+		leaderboard: leaderboard,
+	}
+}
+
+// ScoreRejectedError is an error threw when a score breaks a rule of the leaderboard settings
+type ScoreRejectedError struct {
+	leaderboard string
+	member      string
+	rule        string
+}
+
+func (sre *ScoreRejectedError) Error() string {
+	return fmt.Sprintf("score of member %s rejected by rule %s of leaderboard %s", sre.member, sre.rule, sre.leaderboard)
+}
+
+// NewScoreRejectedError create a new ScoreRejectedError
+func NewScoreRejectedError(leaderboard, member, rule string) *ScoreRejectedError {
+	return &ScoreRejectedError{
+		leaderboard: leaderboard,
+		member:      member,
+		rule:        rule,
+	}
+}
+
+// NonceAlreadyUsedError is an error threw when a nonce of a signed write was already used in the leaderboard
+type NonceAlreadyUsedError struct {
+	leaderboard string
+	nonce       string
+}
+
+func (naue *NonceAlreadyUsedError) Error() string {
+	return fmt.Sprintf("nonce %s was already used in leaderboard %s", naue.nonce, naue.leaderboard)
+}
+
+// NewNonceAlreadyUsedError create a new NonceAlreadyUsedError
+func NewNonceAlreadyUsedError(leaderboard, nonce string) *NonceAlreadyUsedError {
+	return &NonceAlreadyUsedError{
+		leaderboard: leaderboard,
+		nonce:       nonce,
+	}
+}
+
+// IdempotencyKeyReusedError is an error threw when an idempotency key is reused by a write with a different payload
+type IdempotencyKeyReusedError struct {
+	leaderboard string
+	key         string
+}
+
+func (ikre *IdempotencyKeyReusedError) Error() string {
+	return fmt.Sprintf("idempotency key %s was already used in leaderboard %s by a different write", ikre.key, ikre.leaderboard)
+}
+
+// NewIdempotencyKeyReusedError create a new IdempotencyKeyReusedError
+func NewIdempotencyKeyReusedError(leaderboard, key string) *IdempotencyKeyReusedError {
+	return &IdempotencyKeyReusedError{
+		leaderboard: leaderboard,
+		key:         key,
+	}
+}
+
+// RateLimitExceededError is an error threw when writes exceed a rate limit
+type RateLimitExceededError struct {
+	limited    string
+	retryAfter time.Duration
+}
+
+func (rlee *RateLimitExceededError) Error() string {
+	return fmt.Sprintf("rate limit of %s exceeded, retry after %s", rlee.limited, rlee.retryAfter)
+}
+
+// RetryAfter return how long until the rate limit accepts writes again
+func (rlee *RateLimitExceededError) RetryAfter() time.Duration {
+	return rlee.retryAfter
+}
+
+// NewRateLimitExceededError create a new RateLimitExceededError
+func NewRateLimitExceededError(limited string, retryAfter time.Duration) *RateLimitExceededError {
+	return &RateLimitExceededError{
+		limited:    limited,
+		retryAfter: retryAfter,
+	}
+}
+
+// MemberBlockedError is an error threw when a member blocked in the leaderboard writes a score
+type MemberBlockedError struct {
+	leaderboard string
+	member      string
+}
+
+func (mbe *MemberBlockedError) Error() string {
+	return fmt.Sprintf("member %s is blocked in leaderboard %s", mbe.member, mbe.leaderboard)
+}
+
+// NewMemberBlockedError create a new MemberBlockedError
+func NewMemberBlockedError(leaderboard, member string) *MemberBlockedError {
+	return &MemberBlockedError{
+		leaderboard: leaderboard,
+		member:      member,
+	}
+}
+
+// InvalidBlockModeError is an error threw when a member is blocked with an unknown mode
+type InvalidBlockModeError struct {
+	mode string
+}
+
+func (ibme *InvalidBlockModeError) Error() string {
+	return fmt.Sprintf("invalid block mode %s, it must be reject or shadow", ibme.mode)
+}
+
+// NewInvalidBlockModeError create a new InvalidBlockModeError
+func NewInvalidBlockModeError(mode string) *InvalidBlockModeError {
+	return &InvalidBlockModeError{
+		mode: mode,
+	}
+}
+
+// ScoreChangesNotFoundError is an error threw when a member score did not change since a time
+type ScoreChangesNotFoundError struct {
+	leaderboard string
+	member      string
+	since       int64
+}
+
+func (scnfe *ScoreChangesNotFoundError) Error() string {
+	return fmt.Sprintf("member %s score did not change in leaderboard %s since %d", scnfe.member, scnfe.leaderboard, scnfe.since)
+}
+
+// NewScoreChangesNotFoundError create a new ScoreChangesNotFoundError
+func NewScoreChangesNotFoundError(leaderboard, member string, since int64) *ScoreChangesNotFoundError {
+	return &ScoreChangesNotFoundError{
+		leaderboard: leaderboard,
+		member:      member,
+		since:       since,
+	}
+}
+
+// LeaderboardWithoutSnapshotsError is an error threw when a rank snapshot is taken of a leaderboard without snapshots
+type LeaderboardWithoutSnapshotsError struct {
+	leaderboard string
+}
+
+func (lwse *LeaderboardWithoutSnapshotsError) Error() string {
+	return fmt.Sprintf("leaderboard %s does not have rank snapshots", lwse.leaderboard)
+}
+
+// NewLeaderboardWithoutSnapshotsError create a new LeaderboardWithoutSnapshotsError
+func NewLeaderboardWithoutSnapshotsError(leaderboard string) *LeaderboardWithoutSnapshotsError {
+	return &LeaderboardWithoutSnapshotsError{
+		leaderboard: leaderboard,
+	}
+}
+
+// RankSnapshotNotFoundError is an error threw when no rank snapshot of a leaderboard was taken until a time
+type RankSnapshotNotFoundError struct {
+	leaderboard string
+	at          int64
+}
+
+func (rsnfe *RankSnapshotNotFoundError) Error() string {
+	return fmt.Sprintf("no rank snapshot of leaderboard %s was taken until %d", rsnfe.leaderboard, rsnfe.at)
+}
+
+// NewRankSnapshotNotFoundError create a new RankSnapshotNotFoundError
+func NewRankSnapshotNotFoundError(leaderboard string, at int64) *RankSnapshotNotFoundError {
+	return &RankSnapshotNotFoundError{
+		leaderboard: leaderboard,
+		at:          at,
+	}
+}
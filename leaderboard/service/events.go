@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// Every change of a member score recorded in the ledger is also published to the event sink of the
+// service, when it has one, with the ranks member had before and after the write. Ranks before the
+// write are read right before it, so concurrent writes to the same leaderboard can make them stale.
+
+const eventRankOrder = "desc"
+
+// getEventRanks return the ranks of members in leaderboard, -1 for members not in it, or nil when
+// there is no event sink to publish them to
+func (s *Service) getEventRanks(ctx context.Context, leaderboard string, members ...string) (map[string]int, error) {
+	if s.EventSink == nil {
+		return nil, nil
+	}
+
+	return s.getRanks(ctx, leaderboard, members...)
+}
+
+// getRanks return the ranks of members in leaderboard in descending order, -1 for members not in it
+func (s *Service) getRanks(ctx context.Context, leaderboard string, members ...string) (map[string]int, error) {
+	if len(members) == 0 {
+		return nil, nil
+	}
+
+	databaseMembers, err := s.Database.GetMembers(ctx, leaderboard, eventRankOrder, false, members...)
+	if err != nil {
+		return nil, err
+	}
+
+	ranks := make(map[string]int, len(members))
+	for i, member := range members {
+		ranks[member] = -1
+		if i < len(databaseMembers) && databaseMembers[i] != nil {
+			ranks[member] = int(databaseMembers[i].Rank + 1)
+		}
+	}
+
+	return ranks, nil
+}
+
+// publishScoreChanges send score changes of leaderboard to the event sink with the origin of ctx,
+// previousRanks are the ranks returned by getEventRanks before the changes
+func (s *Service) publishScoreChanges(ctx context.Context, leaderboard string, changes []*database.LedgerEntry, previousRanks map[string]int) error {
+	if s.EventSink == nil || len(changes) == 0 {
+		return nil
+	}
+
+	members := make([]string, 0, len(changes))
+	for _, change := range changes {
+		members = append(members, change.Member)
+	}
+
+	ranks, err := s.getEventRanks(ctx, leaderboard, members...)
+	if err != nil {
+		return err
+	}
+
+	origin := getScoreChangeOrigin(ctx)
+	changedAt := time.Now().Unix()
+	events := make([]*model.ScoreEvent, 0, len(changes))
+	for _, change := range changes {
+		events = append(events, &model.ScoreEvent{
+			ID:          uuid.NewV4().String(),
+			Leaderboard: leaderboard,
+			PublicID:    change.Member,
+			OldScore:    change.OldScore,
+			NewScore:    change.NewScore,
+			OldRank:     getRank(previousRanks, change.Member),
+			NewRank:     getRank(ranks, change.Member),
+			Reason:      origin.reason,
+			Caller:      origin.caller,
+			ChangedAt:   changedAt,
+		})
+	}
+
+	return s.EventSink.Send(ctx, events)
+}
+
+func getRank(ranks map[string]int, member string) int {
+	rank, ok := ranks[member]
+	if !ok {
+		return -1
+	}
+
+	return rank
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const exportLeaderboardServiceLabel = "export leaderboard"
+
+// defaultExportChunkSize is how many members are emitted at once when chunkSize is not positive
+const defaultExportChunkSize = 1000
+
+// exportCopyExpiration is how long an export copy outlives an export that could not remove it
+const exportCopyExpiration = 24 * time.Hour
+
+// ExportLeaderboard call emit with chunks of up to chunkSize members of leaderboard, with their score, rank and
+// expiration, in the order requested. Members and their expirations are read from copies taken when the export
+// starts, so writes during the export do not skip or repeat members, and the copies are removed when it ends.
+// It stops at the first error returned by emit
+func (s *Service) ExportLeaderboard(ctx context.Context, leaderboard, order string, chunkSize int, emit func([]*model.Member) error) error {
+	if order != "desc" && order != "asc" {
+		order = "desc"
+	}
+	if chunkSize <= 0 {
+		chunkSize = defaultExportChunkSize
+	}
+
+	settings, err := s.getLeaderboardSettings(ctx, leaderboard)
+	if err != nil {
+		return NewGeneralError(exportLeaderboardServiceLabel, err.Error())
+	}
+
+	export := uuid.NewV4().String()
+	total, err := s.Database.CreateExportCopy(ctx, leaderboard, export, time.Now().Add(exportCopyExpiration))
+	if err != nil {
+		return NewGeneralError(exportLeaderboardServiceLabel, err.Error())
+	}
+	defer s.Database.RemoveExportCopy(context.Background(), leaderboard, export)
+
+	for start := 0; start < total; start += chunkSize {
+		databaseMembers, err := s.Database.GetExportMembers(ctx, leaderboard, export, start, start+chunkSize-1, order)
+		if err != nil {
+			return NewGeneralError(exportLeaderboardServiceLabel, err.Error())
+		}
+
+		members := convertDatabaseMembersIntoModelMembers(databaseMembers, settings)
+		for i, member := range databaseMembers {
+			if (member.TTL != time.Time{}) {
+				members[i].ExpireAt = int(member.TTL.Unix())
+			}
+		}
+
+		err = emit(members)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service ExportLeaderboard", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+	var chunks [][]*model.Member
+	var emit func([]*model.Member) error
+
+	isExport := gomock.AssignableToTypeOf("")
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+
+		chunks = [][]*model.Member{}
+		emit = func(members []*model.Member) error {
+			chunks = append(chunks, members)
+			return nil
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should emit members of a copy of leaderboard in chunks and remove the copy", func() {
+		var export string
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().CreateExportCopy(gomock.Any(), gomock.Eq(leaderboard), isExport, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, leaderboard, copy string, expireAt time.Time) (int, error) {
+				Expect(copy).NotTo(BeEmpty())
+				Expect(expireAt).To(BeTemporally("~", time.Now().Add(24*time.Hour), time.Minute))
+				export = copy
+				return 3, nil
+			})
+		gomock.InOrder(
+			mock.EXPECT().GetExportMembers(gomock.Any(), gomock.Eq(leaderboard), isExport, gomock.Eq(0), gomock.Eq(1), gomock.Eq("asc")).Return([]*database.Member{
+				{Member: "member1", Score: 10, Rank: 0, TTL: time.Unix(1600000000, 0)},
+				{Member: "member2", Score: 20, Rank: 1},
+			}, nil),
+			mock.EXPECT().GetExportMembers(gomock.Any(), gomock.Eq(leaderboard), isExport, gomock.Eq(2), gomock.Eq(3), gomock.Eq("asc")).Return([]*database.Member{
+				{Member: "member3", Score: 30, Rank: 2},
+			}, nil),
+			mock.EXPECT().RemoveExportCopy(gomock.Any(), gomock.Eq(leaderboard), isExport).DoAndReturn(func(ctx context.Context, leaderboard, copy string) error {
+				Expect(copy).To(Equal(export))
+				return nil
+			}),
+		)
+
+		err := svc.ExportLeaderboard(context.Background(), leaderboard, "asc", 2, emit)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(chunks).To(Equal([][]*model.Member{
+			{
+				{PublicID: "member1", Score: 10, Rank: 1, ExpireAt: 1600000000},
+				{PublicID: "member2", Score: 20, Rank: 2},
+			},
+			{
+				{PublicID: "member3", Score: 30, Rank: 3},
+			},
+		}))
+	})
+
+	It("Should not emit members if leaderboard is empty", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().CreateExportCopy(gomock.Any(), gomock.Eq(leaderboard), isExport, gomock.Any()).Return(0, nil)
+		mock.EXPECT().RemoveExportCopy(gomock.Any(), gomock.Eq(leaderboard), isExport).Return(nil)
+
+		err := svc.ExportLeaderboard(context.Background(), leaderboard, "desc", 0, emit)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(chunks).To(BeEmpty())
+	})
+
+	It("Should stop at the first error returned by emit and remove the copy", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().CreateExportCopy(gomock.Any(), gomock.Eq(leaderboard), isExport, gomock.Any()).Return(2, nil)
+		mock.EXPECT().RemoveExportCopy(gomock.Any(), gomock.Eq(leaderboard), isExport).Return(nil)
+		mock.EXPECT().GetExportMembers(gomock.Any(), gomock.Eq(leaderboard), isExport, gomock.Eq(0), gomock.Eq(0), gomock.Eq("desc")).Return([]*database.Member{
+			{Member: "member1", Score: 10, Rank: 0},
+		}, nil)
+
+		err := svc.ExportLeaderboard(context.Background(), leaderboard, "invalid", 1, func([]*model.Member) error {
+			return fmt.Errorf("stream closed")
+		})
+		Expect(err).To(MatchError("stream closed"))
+	})
+
+	It("Should return GeneralError if database return in error", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().CreateExportCopy(gomock.Any(), gomock.Eq(leaderboard), isExport, gomock.Any()).Return(0, fmt.Errorf("database error"))
+
+		err := svc.ExportLeaderboard(context.Background(), leaderboard, "desc", 0, emit)
+		Expect(err).To(Equal(service.NewGeneralError("export leaderboard", "database error")))
+	})
+})
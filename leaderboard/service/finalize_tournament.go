@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const finalizeTournamentServiceLabel = "finalize tournament"
+
+// FinalizeTournament freeze the leaderboard of an ended tournament like FreezeLeaderboard and resolve its
+// prize table into the list of winners. Finalizing an already finalized tournament return the same winners
+func (s *Service) FinalizeTournament(ctx context.Context, tournament string) (*model.TournamentResult, error) {
+	config, err := s.getTournament(ctx, tournament)
+	if err != nil {
+		if _, ok := err.(*TournamentNotFoundError); ok {
+			return nil, err
+		}
+		return nil, NewGeneralError(finalizeTournamentServiceLabel, err.Error())
+	}
+
+	if config.Status == model.TournamentScheduled || config.Status == model.TournamentRunning {
+		return nil, NewTournamentNotEndedError(tournament)
+	}
+
+	settings, err := s.FreezeLeaderboard(ctx, tournament)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.FinalizedAt == 0 {
+		config.FinalizedAt = time.Now().Unix()
+		err = s.setTournament(ctx, config)
+		if err != nil {
+			return nil, NewGeneralError(finalizeTournamentServiceLabel, err.Error())
+		}
+		config.Status = model.TournamentFinalized
+	}
+
+	winners, err := s.getTournamentWinners(ctx, config, settings)
+	if err != nil {
+		return nil, NewGeneralError(finalizeTournamentServiceLabel, err.Error())
+	}
+
+	return &model.TournamentResult{
+		Tournament: config,
+		Winners:    winners,
+	}, nil
+}
+
+func (s *Service) getTournamentWinners(ctx context.Context, tournament *model.Tournament, settings *model.LeaderboardSettings) ([]*model.TournamentWinner, error) {
+	winners := []*model.TournamentWinner{}
+
+	lastRank := 0
+	for _, prize := range tournament.Prizes {
+		if prize.ToRank > lastRank {
+			lastRank = prize.ToRank
+		}
+	}
+	if lastRank == 0 {
+		return winners, nil
+	}
+
+	databaseMembers, err := s.Database.GetOrderedMembers(ctx, tournament.ID, 0, lastRank-1, tournament.Order)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, member := range convertDatabaseMembersIntoModelMembers(databaseMembers, settings) {
+		for _, prize := range tournament.Prizes {
+			if member.Rank >= prize.FromRank && member.Rank <= prize.ToRank {
+				winners = append(winners, &model.TournamentWinner{
+					PublicID: member.PublicID,
+					Score:    member.Score,
+					Rank:     member.Rank,
+					RewardID: prize.RewardID,
+				})
+				break
+			}
+		}
+	}
+
+	return winners, nil
+}
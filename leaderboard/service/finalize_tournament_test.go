@@ -0,0 +1,117 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service FinalizeTournament", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var tournament string = "tournamentTest"
+	var endedTournament *database.Tournament
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+
+		endedTournament = &database.Tournament{
+			StartAt: time.Unix(1600000000, 0),
+			EndAt:   time.Unix(1600003600, 0),
+			Order:   "desc",
+			Prizes: []*database.TournamentPrize{
+				{FromRank: 1, ToRank: 1, RewardID: "gold"},
+				{FromRank: 2, ToRank: 3, RewardID: "silver"},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should freeze leaderboard and resolve prizes into winners if all is OK", func() {
+		mock.EXPECT().GetTournament(gomock.Any(), gomock.Eq(tournament)).Return(endedTournament, nil)
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(tournament)).Return(map[string]string{
+			"participantsOnly": "true",
+		}, nil)
+		mock.EXPECT().SetLeaderboardSettings(gomock.Any(), gomock.Eq(tournament), gomock.Eq(map[string]string{
+			"frozen": "true",
+		})).Return(nil)
+		mock.EXPECT().SetTournament(gomock.Any(), gomock.Eq(tournament), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, tournament string, config *database.Tournament) error {
+				Expect(config.FinalizedAt.IsZero()).To(BeFalse())
+				return nil
+			},
+		)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(tournament), gomock.Eq(0), gomock.Eq(2), gomock.Eq("desc")).Return([]*database.Member{
+			{Member: "member1", Score: 30, Rank: 0},
+			{Member: "member2", Score: 20, Rank: 1},
+		}, nil)
+
+		result, err := svc.FinalizeTournament(context.Background(), tournament)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Tournament.Status).To(Equal(model.TournamentFinalized))
+		Expect(result.Winners).To(Equal([]*model.TournamentWinner{
+			{PublicID: "member1", Score: 30, Rank: 1, RewardID: "gold"},
+			{PublicID: "member2", Score: 20, Rank: 2, RewardID: "silver"},
+		}))
+	})
+
+	It("Should return same winners without writing if tournament was finalized", func() {
+		endedTournament.FinalizedAt = time.Unix(1600007200, 0)
+		mock.EXPECT().GetTournament(gomock.Any(), gomock.Eq(tournament)).Return(endedTournament, nil)
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(tournament)).Return(map[string]string{
+			"frozen": "true",
+		}, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(tournament), gomock.Eq(0), gomock.Eq(2), gomock.Eq("desc")).Return([]*database.Member{
+			{Member: "member1", Score: 30, Rank: 0},
+		}, nil)
+
+		result, err := svc.FinalizeTournament(context.Background(), tournament)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Tournament.FinalizedAt).To(Equal(int64(1600007200)))
+		Expect(result.Winners).To(Equal([]*model.TournamentWinner{
+			{PublicID: "member1", Score: 30, Rank: 1, RewardID: "gold"},
+		}))
+	})
+
+	It("Should return TournamentNotEndedError if tournament is running", func() {
+		endedTournament.EndAt = time.Now().Add(time.Hour)
+		mock.EXPECT().GetTournament(gomock.Any(), gomock.Eq(tournament)).Return(endedTournament, nil)
+
+		_, err := svc.FinalizeTournament(context.Background(), tournament)
+		Expect(err).To(Equal(service.NewTournamentNotEndedError(tournament)))
+	})
+
+	It("Should return TournamentNotFoundError if tournament does not exist", func() {
+		mock.EXPECT().GetTournament(gomock.Any(), gomock.Eq(tournament)).Return(nil, database.NewTournamentNotFoundError(tournament))
+
+		_, err := svc.FinalizeTournament(context.Background(), tournament)
+		Expect(err).To(Equal(service.NewTournamentNotFoundError(tournament)))
+	})
+
+	It("Should return error if database return in error on GetOrderedMembers", func() {
+		endedTournament.FinalizedAt = time.Unix(1600007200, 0)
+		mock.EXPECT().GetTournament(gomock.Any(), gomock.Eq(tournament)).Return(endedTournament, nil)
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(tournament)).Return(map[string]string{
+			"frozen": "true",
+		}, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(tournament), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("Database error example"))
+
+		_, err := svc.FinalizeTournament(context.Background(), tournament)
+		Expect(err).To(Equal(service.NewGeneralError("finalize tournament", "Database error example")))
+	})
+})
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const freezeLeaderboardServiceLabel = "freeze leaderboard"
+
+// FreezeLeaderboard reject every write to leaderboard until it is unfrozen, reads keep working. Freezing
+// a leaderboard that was not frozen is sent to the lifecycle notifier
+func (s *Service) FreezeLeaderboard(ctx context.Context, leaderboard string) (*model.LeaderboardSettings, error) {
+	settings, changed, err := s.setLeaderboardFrozen(ctx, leaderboard, true)
+	if err != nil {
+		return nil, NewGeneralError(freezeLeaderboardServiceLabel, err.Error())
+	}
+
+	if changed {
+		err = s.notifyLifecycle(ctx, &model.LifecycleEvent{
+			Type:        model.LifecycleLeaderboardFrozen,
+			Leaderboard: leaderboard,
+		})
+		if err != nil {
+			return nil, NewGeneralError(freezeLeaderboardServiceLabel, err.Error())
+		}
+	}
+
+	return settings, nil
+}
+
+// setLeaderboardFrozen set if leaderboard is frozen, returning false if it already was
+func (s *Service) setLeaderboardFrozen(ctx context.Context, leaderboard string, frozen bool) (*model.LeaderboardSettings, bool, error) {
+	settings, err := s.getLeaderboardSettings(ctx, leaderboard)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if settings.Frozen == frozen {
+		return settings, false, nil
+	}
+
+	settings.Frozen = frozen
+	err = s.Database.SetLeaderboardSettings(ctx, leaderboard, formatLeaderboardSettingFields(settings, frozenSetting))
+	if err != nil {
+		return nil, false, err
+	}
+
+	return settings, true, nil
+}
@@ -0,0 +1,68 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service FreezeLeaderboard", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should freeze leaderboard writing only the frozen setting", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"decayHalfLife": "3600",
+			"decayLandmark": "1600000000",
+		}, nil)
+		mock.EXPECT().SetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(map[string]string{
+			"frozen": "true",
+		})).Return(nil)
+
+		settings, err := svc.FreezeLeaderboard(context.Background(), leaderboard)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(settings).To(Equal(&model.LeaderboardSettings{
+			DecayHalfLife: 3600,
+			DecayLandmark: 1600000000,
+			Frozen:        true,
+		}))
+	})
+
+	It("Should not write settings if leaderboard is already frozen", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"frozen": "true",
+		}, nil)
+
+		settings, err := svc.FreezeLeaderboard(context.Background(), leaderboard)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(settings.Frozen).To(BeTrue())
+	})
+
+	It("Should return error if database return in error on SetLeaderboardSettings", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().SetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(fmt.Errorf("Database error example"))
+
+		_, err := svc.FreezeLeaderboard(context.Background(), leaderboard)
+		Expect(err).To(Equal(service.NewGeneralError("freeze leaderboard", "Database error example")))
+	})
+})
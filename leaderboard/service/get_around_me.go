@@ -25,12 +25,17 @@ func (s *Service) GetAroundMe(ctx context.Context, leaderboard string, pageSize
 		return nil, NewGeneralError(getAroundMeServiceLabel, err.Error())
 	}
 
+	settings, err := s.getLeaderboardSettings(ctx, leaderboard)
+	if err != nil {
+		return nil, NewGeneralError(getAroundMeServiceLabel, err.Error())
+	}
+
 	databaseMembers, err := s.Database.GetOrderedMembers(ctx, leaderboard, indexes.Start, indexes.Stop, order)
 	if err != nil {
 		return nil, NewGeneralError(getAroundMeServiceLabel, err.Error())
 	}
 
-	members := convertDatabaseMembersIntoModelMembers(databaseMembers)
+	members := convertDatabaseMembersIntoModelMembers(databaseMembers, settings)
 
 	return members, nil
 }
@@ -15,7 +15,12 @@ const (
 
 // GetAroundScore find members around an score
 func (s *Service) GetAroundScore(ctx context.Context, leaderboard string, pageSize int, score int64, order string) ([]*model.Member, error) {
-	member, err := s.getMemberIDWithClosestScore(ctx, leaderboard, score)
+	settings, err := s.getLeaderboardSettings(ctx, leaderboard)
+	if err != nil {
+		return nil, NewGeneralError(getAroundScoreServiceLabel, err.Error())
+	}
+
+	member, err := s.getMemberIDWithClosestScore(ctx, leaderboard, score, settings)
 	if err != nil {
 		return nil, err
 	}
@@ -35,13 +40,18 @@ func (s *Service) GetAroundScore(ctx context.Context, leaderboard string, pageSi
 		return nil, NewGeneralError(getAroundScoreServiceLabel, err.Error())
 	}
 
-	members := convertDatabaseMembersIntoModelMembers(databaseMembers)
+	members := convertDatabaseMembersIntoModelMembers(databaseMembers, settings)
 
 	return members, nil
 }
 
-func (s *Service) getMemberIDWithClosestScore(ctx context.Context, leaderboard string, score int64) (string, error) {
-	memberSlice, err := s.Database.GetMemberIDsWithScoreInsideRange(ctx, leaderboard, "-inf", strconv.FormatInt(score, 10), 0, 1)
+func (s *Service) getMemberIDWithClosestScore(ctx context.Context, leaderboard string, score int64, settings *model.LeaderboardSettings) (string, error) {
+	maxScore := strconv.FormatInt(score, 10)
+	if hasDecay(settings) {
+		maxScore = strconv.FormatFloat(toStoredScore(settings, float64(score)), 'f', -1, 64)
+	}
+
+	memberSlice, err := s.Database.GetMemberIDsWithScoreInsideRange(ctx, leaderboard, "-inf", maxScore, 0, 1)
 	if err != nil {
 		return "", NewGeneralError(getAroundScoreServiceLabel, err.Error())
 	}
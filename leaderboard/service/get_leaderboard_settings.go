@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const getLeaderboardSettingsServiceLabel = "get leaderboard settings"
+
+// GetLeaderboardSettings return leaderboard settings, leaderboards never configured have default settings
+func (s *Service) GetLeaderboardSettings(ctx context.Context, leaderboard string) (*model.LeaderboardSettings, error) {
+	settings, err := s.getLeaderboardSettings(ctx, leaderboard)
+	if err != nil {
+		return nil, NewGeneralError(getLeaderboardSettingsServiceLabel, err.Error())
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,61 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service GetLeaderboardSettings", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should return leaderboard settings if all is OK", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"decayHalfLife": "3600",
+			"decayLandmark": "1600000000",
+		}, nil)
+
+		settings, err := svc.GetLeaderboardSettings(context.Background(), leaderboard)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(settings).To(Equal(&model.LeaderboardSettings{
+			DecayHalfLife: 3600,
+			DecayLandmark: 1600000000,
+		}))
+	})
+
+	It("Should return default settings if leaderboard was never configured", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+
+		settings, err := svc.GetLeaderboardSettings(context.Background(), leaderboard)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(settings).To(Equal(&model.LeaderboardSettings{}))
+	})
+
+	It("Should return error if database return in error", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(nil, fmt.Errorf("Database error example"))
+
+		_, err := svc.GetLeaderboardSettings(context.Background(), leaderboard)
+		Expect(err).To(Equal(service.NewGeneralError("get leaderboard settings", "Database error example")))
+	})
+})
@@ -22,12 +22,17 @@ func (s *Service) GetLeaders(ctx context.Context, leaderboard string, pageSize,
 
 	index := getIndexesByPage(pageSize, page)
 
+	settings, err := s.getLeaderboardSettings(ctx, leaderboard)
+	if err != nil {
+		return nil, NewGeneralError(getLeadersServiceLabel, err.Error())
+	}
+
 	databaseMembers, err := s.Database.GetOrderedMembers(ctx, leaderboard, index.Start, index.Stop, order)
 	if err != nil {
 		return nil, NewGeneralError(getLeadersServiceLabel, err.Error())
 	}
 
-	members := convertDatabaseMembersIntoModelMembers(databaseMembers)
+	members := convertDatabaseMembersIntoModelMembers(databaseMembers, settings)
 	return members, nil
 }
 
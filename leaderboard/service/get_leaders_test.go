@@ -31,7 +31,9 @@ var _ = Describe("Service GetLeaders", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mock = database.NewMockDatabase(ctrl)
 
-		svc = &service.Service{mock}
+		svc = &service.Service{Database: mock}
+
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Any()).Return(map[string]string{}, nil).AnyTimes()
 	})
 
 	AfterEach(func() {
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const getLeagueServiceLabel = "get league"
+
+// GetLeague return league configuration and current season
+func (s *Service) GetLeague(ctx context.Context, league string) (*model.League, error) {
+	config, err := s.getLeague(ctx, league)
+	if err != nil {
+		if _, ok := err.(*LeagueNotFoundError); ok {
+			return nil, err
+		}
+		return nil, NewGeneralError(getLeagueServiceLabel, err.Error())
+	}
+
+	return config, nil
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const getLeagueDivisionServiceLabel = "get league division"
+
+// GetLeagueDivision return the division of member in the current league season with its standings
+func (s *Service) GetLeagueDivision(ctx context.Context, league, member string) (*model.LeagueDivision, error) {
+	config, err := s.getLeague(ctx, league)
+	if err != nil {
+		if _, ok := err.(*LeagueNotFoundError); ok {
+			return nil, err
+		}
+		return nil, NewGeneralError(getLeagueDivisionServiceLabel, err.Error())
+	}
+
+	databaseDivision, err := s.Database.GetLeagueMemberDivision(ctx, league, config.Season, member)
+	if err != nil {
+		if _, ok := err.(*database.LeagueMemberNotFoundError); ok {
+			return nil, NewLeagueMemberNotFoundError(league, member)
+		}
+		return nil, NewGeneralError(getLeagueDivisionServiceLabel, err.Error())
+	}
+
+	division := newLeagueDivision(config, databaseDivision)
+
+	settings, err := s.getLeaderboardSettings(ctx, division.Leaderboard)
+	if err != nil {
+		return nil, NewGeneralError(getLeagueDivisionServiceLabel, err.Error())
+	}
+
+	databaseMembers, err := s.Database.GetOrderedMembers(ctx, division.Leaderboard, 0, -1, config.Order)
+	if err != nil {
+		return nil, NewGeneralError(getLeagueDivisionServiceLabel, err.Error())
+	}
+
+	division.Members = convertDatabaseMembersIntoModelMembers(databaseMembers, settings)
+	return division, nil
+}
@@ -0,0 +1,89 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service GetLeagueDivision", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var league string = "leagueTest"
+	var member string = "member"
+	var divisionLeaderboard string = "{leagueTest}:s2:t3:d4"
+	var databaseLeague *database.League = &database.League{
+		Season:       2,
+		Tiers:        3,
+		DivisionSize: 50,
+		Order:        "desc",
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should return member division with standings if all is OK", func() {
+		mock.EXPECT().GetLeague(gomock.Any(), gomock.Eq(league)).Return(databaseLeague, nil)
+		mock.EXPECT().GetLeagueMemberDivision(gomock.Any(), gomock.Eq(league), gomock.Eq(2), gomock.Eq(member)).Return(&database.LeagueDivision{Tier: 3, Division: 4}, nil)
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(divisionLeaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(divisionLeaderboard), gomock.Eq(0), gomock.Eq(-1), gomock.Eq("desc")).Return([]*database.Member{
+			{Member: "member2", Score: 20, Rank: 0},
+			{Member: member, Score: 10, Rank: 1},
+		}, nil)
+
+		division, err := svc.GetLeagueDivision(context.Background(), league, member)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(division).To(Equal(&model.LeagueDivision{
+			League:      league,
+			Season:      2,
+			Tier:        3,
+			Division:    4,
+			Leaderboard: divisionLeaderboard,
+			Members: []*model.Member{
+				{PublicID: "member2", Score: 20, Rank: 1},
+				{PublicID: member, Score: 10, Rank: 2},
+			},
+		}))
+	})
+
+	It("Should return LeagueMemberNotFoundError if member did not join season", func() {
+		mock.EXPECT().GetLeague(gomock.Any(), gomock.Eq(league)).Return(databaseLeague, nil)
+		mock.EXPECT().GetLeagueMemberDivision(gomock.Any(), gomock.Eq(league), gomock.Eq(2), gomock.Eq(member)).Return(nil, database.NewLeagueMemberNotFoundError(league, 2, member))
+
+		_, err := svc.GetLeagueDivision(context.Background(), league, member)
+		Expect(err).To(Equal(service.NewLeagueMemberNotFoundError(league, member)))
+	})
+
+	It("Should return LeagueNotFoundError if league does not exist", func() {
+		mock.EXPECT().GetLeague(gomock.Any(), gomock.Eq(league)).Return(nil, database.NewLeagueNotFoundError(league))
+
+		_, err := svc.GetLeagueDivision(context.Background(), league, member)
+		Expect(err).To(Equal(service.NewLeagueNotFoundError(league)))
+	})
+
+	It("Should return error if database return in error on GetOrderedMembers", func() {
+		mock.EXPECT().GetLeague(gomock.Any(), gomock.Eq(league)).Return(databaseLeague, nil)
+		mock.EXPECT().GetLeagueMemberDivision(gomock.Any(), gomock.Eq(league), gomock.Eq(2), gomock.Eq(member)).Return(&database.LeagueDivision{Tier: 3, Division: 4}, nil)
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(divisionLeaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(divisionLeaderboard), gomock.Eq(0), gomock.Eq(-1), gomock.Eq("desc")).Return(nil, fmt.Errorf("Database error example"))
+
+		_, err := svc.GetLeagueDivision(context.Background(), league, member)
+		Expect(err).To(Equal(service.NewGeneralError("get league division", "Database error example")))
+	})
+})
@@ -0,0 +1,69 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service GetLeague", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var league string = "leagueTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should return league if all is OK", func() {
+		mock.EXPECT().GetLeague(gomock.Any(), gomock.Eq(league)).Return(&database.League{
+			Season:          2,
+			Tiers:           3,
+			DivisionSize:    50,
+			PromotionCount:  5,
+			RelegationCount: 10,
+			Order:           "desc",
+		}, nil)
+
+		returnedLeague, err := svc.GetLeague(context.Background(), league)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(returnedLeague).To(Equal(&model.League{
+			ID:              league,
+			Season:          2,
+			Tiers:           3,
+			DivisionSize:    50,
+			PromotionCount:  5,
+			RelegationCount: 10,
+			Order:           "desc",
+		}))
+	})
+
+	It("Should return LeagueNotFoundError if league does not exist", func() {
+		mock.EXPECT().GetLeague(gomock.Any(), gomock.Eq(league)).Return(nil, database.NewLeagueNotFoundError(league))
+
+		_, err := svc.GetLeague(context.Background(), league)
+		Expect(err).To(Equal(service.NewLeagueNotFoundError(league)))
+	})
+
+	It("Should return error if database return in error", func() {
+		mock.EXPECT().GetLeague(gomock.Any(), gomock.Eq(league)).Return(nil, fmt.Errorf("Database error example"))
+
+		_, err := svc.GetLeague(context.Background(), league)
+		Expect(err).To(Equal(service.NewGeneralError("get league", "Database error example")))
+	})
+})
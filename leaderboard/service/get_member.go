@@ -9,8 +9,26 @@ import (
 
 const getMemberServiceLabel = "get member"
 
-// GetMember return a member info
+// GetMember return a member info. Members blocked in shadow mode get their shadow score,
+// ranked as if it was in the leaderboard
 func (s *Service) GetMember(ctx context.Context, leaderboard, member string, order string, includeTTL bool) (*model.Member, error) {
+	settings, err := s.getLeaderboardSettings(ctx, leaderboard)
+	if err != nil {
+		return nil, NewGeneralError(getMemberServiceLabel, err.Error())
+	}
+
+	modes, err := s.getBlockModes(ctx, leaderboard, []string{member})
+	if err != nil {
+		return nil, NewGeneralError(getMemberServiceLabel, err.Error())
+	}
+
+	switch modes[0] {
+	case model.BlockModeReject:
+		return nil, NewMemberNotFoundError(leaderboard, member)
+	case model.BlockModeShadow:
+		return s.getShadowMember(ctx, leaderboard, member, order, settings)
+	}
+
 	databaseMembers, err := s.Database.GetMembers(ctx, leaderboard, order, includeTTL, member)
 	if err != nil {
 		return nil, NewGeneralError(getMemberServiceLabel, err.Error())
@@ -27,8 +45,21 @@ func (s *Service) GetMember(ctx context.Context, leaderboard, member string, ord
 
 	return &model.Member{
 		PublicID: databaseMembers[0].Member,
-		Score:    int64(databaseMembers[0].Score),
+		Score:    toDecayedScore(settings, databaseMembers[0].Score),
 		Rank:     int(databaseMembers[0].Rank) + 1,
 		ExpireAt: int(ttl),
 	}, nil
 }
+
+func (s *Service) getShadowMember(ctx context.Context, leaderboard, member, order string, settings *model.LeaderboardSettings) (*model.Member, error) {
+	databaseMember, err := s.Database.GetShadowMember(ctx, leaderboard, member, order)
+	if err != nil {
+		return nil, NewGeneralError(getMemberServiceLabel, err.Error())
+	}
+
+	if databaseMember == nil {
+		return nil, NewMemberNotFoundError(leaderboard, member)
+	}
+
+	return convertDatabaseMemberIntoModelMember(databaseMember, settings), nil
+}
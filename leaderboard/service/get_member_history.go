@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const getMemberHistoryServiceLabel = "get member history"
+
+// GetMemberHistory return the score and rank of member sampled on writes to leaderboard from unix timestamp
+// from to unix timestamp to, the oldest first. A zero to does not limit the newest sample. Samples are only
+// taken while leaderboard settings have history enabled
+func (s *Service) GetMemberHistory(ctx context.Context, leaderboard, member string, from, to int64) ([]*model.HistorySample, error) {
+	var toTime time.Time
+	if to > 0 {
+		toTime = time.Unix(to, 0)
+	}
+
+	databaseSamples, err := s.Database.GetHistorySamples(ctx, leaderboard, member, time.Unix(from, 0), toTime)
+	if err != nil {
+		return nil, NewGeneralError(getMemberHistoryServiceLabel, err.Error())
+	}
+
+	samples := make([]*model.HistorySample, 0, len(databaseSamples))
+	for _, sample := range databaseSamples {
+		samples = append(samples, &model.HistorySample{
+			Score:     sample.Score,
+			Rank:      sample.Rank,
+			SampledAt: sample.SampledAt.Unix(),
+		})
+	}
+
+	return samples, nil
+}
@@ -0,0 +1,61 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service GetMemberHistory", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+	var member string = "member1"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should return samples taken inside time range", func() {
+		mock.EXPECT().GetHistorySamples(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(time.Unix(1600000000, 0)), gomock.Eq(time.Unix(1600003600, 0))).Return([]*database.HistorySample{
+			{Member: member, Score: 100, Rank: 3, SampledAt: time.Unix(1600000100, 0)},
+		}, nil)
+
+		samples, err := svc.GetMemberHistory(context.Background(), leaderboard, member, 1600000000, 1600003600)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(samples).To(Equal([]*model.HistorySample{
+			{Score: 100, Rank: 3, SampledAt: 1600000100},
+		}))
+	})
+
+	It("Should not limit newest sample if to is zero", func() {
+		mock.EXPECT().GetHistorySamples(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(time.Unix(0, 0)), gomock.Eq(time.Time{})).Return([]*database.HistorySample{}, nil)
+
+		samples, err := svc.GetMemberHistory(context.Background(), leaderboard, member, 0, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(samples).To(BeEmpty())
+	})
+
+	It("Should return error if database return in error", func() {
+		mock.EXPECT().GetHistorySamples(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("Database error example"))
+
+		_, err := svc.GetMemberHistory(context.Background(), leaderboard, member, 0, 0)
+		Expect(err).To(Equal(service.NewGeneralError("get member history", "Database error example")))
+	})
+})
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const getMemberLedgerServiceLabel = "get member ledger"
+
+// GetMemberLedger return a page of the changes of member score in leaderboard made at unix timestamp since
+// or after, the oldest first
+func (s *Service) GetMemberLedger(ctx context.Context, leaderboard, member string, since int64, pageSize, page int) ([]*model.LedgerEntry, error) {
+	if page < 1 {
+		page = 1
+	}
+	index := getIndexesByPage(pageSize, page)
+
+	databaseEntries, err := s.Database.GetLedgerEntries(ctx, leaderboard, member, time.Unix(since, 0), index.Start, pageSize)
+	if err != nil {
+		return nil, NewGeneralError(getMemberLedgerServiceLabel, err.Error())
+	}
+
+	entries := make([]*model.LedgerEntry, 0, len(databaseEntries))
+	for _, entry := range databaseEntries {
+		entries = append(entries, convertDatabaseLedgerEntryIntoModelLedgerEntry(leaderboard, entry))
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,62 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service GetMemberLedger", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+	var member string = "member1"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should return ledger entries of page", func() {
+		newScore := int64(20)
+		mock.EXPECT().GetLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(time.Unix(1600000000, 0)), gomock.Eq(10), gomock.Eq(10)).Return([]*database.LedgerEntry{
+			{Member: member, NewScore: &newScore, Delta: 20, Reason: "match", Caller: "game-server", ChangedAt: time.Unix(1600000100, 0)},
+		}, nil)
+
+		entries, err := svc.GetMemberLedger(context.Background(), leaderboard, member, 1600000000, 10, 2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(Equal([]*model.LedgerEntry{
+			{Leaderboard: leaderboard, PublicID: member, NewScore: &newScore, Delta: 20, Reason: "match", Caller: "game-server", ChangedAt: 1600000100},
+		}))
+	})
+
+	It("Should return first page if page is lower than one", func() {
+		mock.EXPECT().GetLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Any(), gomock.Eq(0), gomock.Eq(10)).Return([]*database.LedgerEntry{}, nil)
+
+		entries, err := svc.GetMemberLedger(context.Background(), leaderboard, member, 0, 10, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(BeEmpty())
+	})
+
+	It("Should return error if database return in error", func() {
+		mock.EXPECT().GetLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("Database error example"))
+
+		_, err := svc.GetMemberLedger(context.Background(), leaderboard, member, 0, 10, 1)
+		Expect(err).To(Equal(service.NewGeneralError("get member ledger", "Database error example")))
+	})
+})
@@ -27,7 +27,9 @@ var _ = Describe("Service GetMember", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mock = database.NewMockDatabase(ctrl)
 
-		svc = &service.Service{mock}
+		svc = &service.Service{Database: mock}
+
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Any()).Return(map[string]string{}, nil).AnyTimes()
 	})
 
 	AfterEach(func() {
@@ -35,6 +37,7 @@ var _ = Describe("Service GetMember", func() {
 	})
 
 	It("Should return member if all is OK", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 		membersDatabaseReturn := []*database.Member{
 			&database.Member{
 				Member: "member1",
@@ -60,6 +63,7 @@ var _ = Describe("Service GetMember", func() {
 	})
 
 	It("Should return member with Expire zero if database return empty time TTL", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 		membersDatabaseReturn := []*database.Member{
 			&database.Member{
 				Member: "member1",
@@ -85,6 +89,7 @@ var _ = Describe("Service GetMember", func() {
 	})
 
 	It("Should return member not found database return nil member", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 		membersDatabaseReturn := []*database.Member{
 			nil,
 		}
@@ -97,6 +102,7 @@ var _ = Describe("Service GetMember", func() {
 	})
 
 	It("Should return error if database return in error", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(order), gomock.Eq(includeTTL), gomock.Eq(member)).Return(nil, fmt.Errorf("Database error example"))
 
 		_, err := svc.GetMember(context.Background(), leaderboard, member, order, includeTTL)
@@ -12,6 +12,11 @@ const getMembersServiceLabel = "get members"
 
 // GetMembers return member informations that is
 func (s *Service) GetMembers(ctx context.Context, leaderboard string, members []string, order string, includeTTL bool) ([]*model.Member, error) {
+	settings, err := s.getLeaderboardSettings(ctx, leaderboard)
+	if err != nil {
+		return nil, NewGeneralError(getMembersServiceLabel, err.Error())
+	}
+
 	databaseMembers, err := s.Database.GetMembers(ctx, leaderboard, order, includeTTL, members...)
 	if err != nil {
 		return nil, NewGeneralError(getMembersServiceLabel, err.Error())
@@ -29,7 +34,7 @@ func (s *Service) GetMembers(ctx context.Context, leaderboard string, members []
 		}
 		newMember := &model.Member{
 			PublicID: member.Member,
-			Score:    int64(member.Score),
+			Score:    toDecayedScore(settings, member.Score),
 			Rank:     int(member.Rank) + 1,
 			ExpireAt: int(ttl),
 		}
@@ -26,7 +26,9 @@ var _ = Describe("Service GetMembersByRange", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mock = database.NewMockDatabase(ctrl)
 
-		svc = &service.Service{mock}
+		svc = &service.Service{Database: mock}
+
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Any()).Return(map[string]string{}, nil).AnyTimes()
 	})
 
 	AfterEach(func() {
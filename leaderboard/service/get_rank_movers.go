@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const getRankMoversServiceLabel = "get rank movers"
+
+// GetRankMovers return up to limit members that climbed and fell the most positions in leaderboard between
+// the newest rank snapshot taken until unix timestamp from and the newest taken until unix timestamp to, or
+// the present if to is zero, ties ordered by current rank. Members that were not in both of them are not compared
+func (s *Service) GetRankMovers(ctx context.Context, leaderboard string, from, to int64, limit int, order string) (*model.RankMovers, error) {
+	if order != "desc" && order != "asc" {
+		order = "desc"
+	}
+
+	fromTakenAt, err := s.getRankSnapshotTime(ctx, leaderboard, from, getRankMoversServiceLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	movers := &model.RankMovers{From: fromTakenAt.Unix(), Climbers: []*model.RankMove{}, Fallers: []*model.RankMove{}}
+	getCurrentMembers := func(start, stop int) ([]*database.Member, error) {
+		return s.Database.GetOrderedMembers(ctx, leaderboard, start, stop, order)
+	}
+	if to > 0 {
+		toTakenAt, err := s.getRankSnapshotTime(ctx, leaderboard, to, getRankMoversServiceLabel)
+		if err != nil {
+			return nil, err
+		}
+		movers.To = toTakenAt.Unix()
+
+		getCurrentMembers = func(start, stop int) ([]*database.Member, error) {
+			return s.Database.GetRankSnapshotMembers(ctx, leaderboard, toTakenAt, start, stop, order)
+		}
+	}
+
+	for start := 0; ; start += snapshotPageSize {
+		currentMembers, err := getCurrentMembers(start, start+snapshotPageSize-1)
+		if err != nil {
+			return nil, NewGeneralError(getRankMoversServiceLabel, err.Error())
+		}
+		if len(currentMembers) == 0 {
+			break
+		}
+
+		publicIDs := make([]string, 0, len(currentMembers))
+		for _, member := range currentMembers {
+			publicIDs = append(publicIDs, member.Member)
+		}
+
+		previousMembers, err := s.Database.GetRankSnapshotMembersByID(ctx, leaderboard, fromTakenAt, order, publicIDs...)
+		if err != nil {
+			return nil, NewGeneralError(getRankMoversServiceLabel, err.Error())
+		}
+
+		for i, member := range currentMembers {
+			if previousMembers[i] == nil {
+				continue
+			}
+
+			previousRank := int(previousMembers[i].Rank + 1)
+			move := &model.RankMove{
+				PublicID:     member.Member,
+				PreviousRank: previousRank,
+				Rank:         int(member.Rank + 1),
+				RankDelta:    previousRank - int(member.Rank+1),
+			}
+			switch {
+			case move.RankDelta > 0:
+				movers.Climbers = addRankMove(movers.Climbers, move, limit)
+			case move.RankDelta < 0:
+				movers.Fallers = addRankMove(movers.Fallers, move, limit)
+			}
+		}
+
+		if len(currentMembers) < snapshotPageSize {
+			break
+		}
+	}
+
+	return movers, nil
+}
+
+// addRankMove insert move in moves, kept ordered by the positions moved, at most limit of them. Moves are
+// added by current rank, so ties stay ordered by it
+func addRankMove(moves []*model.RankMove, move *model.RankMove, limit int) []*model.RankMove {
+	index := sort.Search(len(moves), func(i int) bool {
+		return rankDistance(moves[i]) < rankDistance(move)
+	})
+	if index >= limit {
+		return moves
+	}
+
+	if len(moves) < limit {
+		moves = append(moves, nil)
+	}
+	copy(moves[index+1:], moves[index:])
+	moves[index] = move
+	return moves
+}
+
+func rankDistance(move *model.RankMove) int {
+	if move.RankDelta < 0 {
+		return -move.RankDelta
+	}
+	return move.RankDelta
+}
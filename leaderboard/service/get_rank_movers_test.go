@@ -0,0 +1,146 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service GetRankMovers", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+	var fromTakenAt time.Time = time.Unix(1600000000, 0)
+	var toTakenAt time.Time = time.Unix(1600086400, 0)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should return members that climbed and fell the most since snapshot", func() {
+		mock.EXPECT().GetRankSnapshotTime(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(time.Unix(1600000100, 0))).Return(fromTakenAt, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(0), gomock.Eq(999), gomock.Eq("desc")).Return([]*database.Member{
+			{Member: "member4", Score: 900, Rank: 0},
+			{Member: "member5", Score: 800, Rank: 1},
+			{Member: "member3", Score: 700, Rank: 2},
+			{Member: "member1", Score: 400, Rank: 3},
+			{Member: "member2", Score: 300, Rank: 4},
+		}, nil)
+		mock.EXPECT().GetRankSnapshotMembersByID(
+			gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(fromTakenAt), gomock.Eq("desc"),
+			gomock.Eq("member4"), gomock.Eq("member5"), gomock.Eq("member3"), gomock.Eq("member1"), gomock.Eq("member2"),
+		).Return([]*database.Member{
+			{Member: "member4", Score: 100, Rank: 3},
+			nil,
+			{Member: "member3", Score: 200, Rank: 2},
+			{Member: "member1", Score: 400, Rank: 0},
+			{Member: "member2", Score: 300, Rank: 1},
+		}, nil)
+
+		movers, err := svc.GetRankMovers(context.Background(), leaderboard, 1600000100, 0, 10, "desc")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(movers).To(Equal(&model.RankMovers{
+			From: 1600000000,
+			To:   0,
+			Climbers: []*model.RankMove{
+				{PublicID: "member4", PreviousRank: 4, Rank: 1, RankDelta: 3},
+			},
+			Fallers: []*model.RankMove{
+				{PublicID: "member1", PreviousRank: 1, Rank: 4, RankDelta: -3},
+				{PublicID: "member2", PreviousRank: 2, Rank: 5, RankDelta: -3},
+			},
+		}))
+	})
+
+	It("Should compare two snapshots and limit members returned", func() {
+		mock.EXPECT().GetRankSnapshotTime(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(fromTakenAt)).Return(fromTakenAt, nil)
+		mock.EXPECT().GetRankSnapshotTime(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(toTakenAt)).Return(toTakenAt, nil)
+		mock.EXPECT().GetRankSnapshotMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(toTakenAt), gomock.Eq(0), gomock.Eq(999), gomock.Eq("desc")).Return([]*database.Member{
+			{Member: "member3", Score: 500, Rank: 0},
+			{Member: "member4", Score: 450, Rank: 1},
+			{Member: "member1", Score: 400, Rank: 2},
+			{Member: "member2", Score: 300, Rank: 3},
+		}, nil)
+		mock.EXPECT().GetRankSnapshotMembersByID(
+			gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(fromTakenAt), gomock.Eq("desc"),
+			gomock.Eq("member3"), gomock.Eq("member4"), gomock.Eq("member1"), gomock.Eq("member2"),
+		).Return([]*database.Member{
+			{Member: "member3", Score: 200, Rank: 2},
+			{Member: "member4", Score: 100, Rank: 3},
+			{Member: "member1", Score: 400, Rank: 0},
+			{Member: "member2", Score: 300, Rank: 1},
+		}, nil)
+
+		movers, err := svc.GetRankMovers(context.Background(), leaderboard, fromTakenAt.Unix(), toTakenAt.Unix(), 1, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(movers.From).To(Equal(fromTakenAt.Unix()))
+		Expect(movers.To).To(Equal(toTakenAt.Unix()))
+		Expect(movers.Climbers).To(Equal([]*model.RankMove{
+			{PublicID: "member3", PreviousRank: 3, Rank: 1, RankDelta: 2},
+		}))
+		Expect(movers.Fallers).To(Equal([]*model.RankMove{
+			{PublicID: "member1", PreviousRank: 1, Rank: 3, RankDelta: -2},
+		}))
+	})
+
+	It("Should compare members with the snapshot a page at a time", func() {
+		firstPage := make([]*database.Member, 0, 1000)
+		previousMembers := make([]*database.Member, 0, 1000)
+		for i := 0; i < 1000; i++ {
+			firstPage = append(firstPage, &database.Member{Member: fmt.Sprintf("member%d", i), Score: float64(2000 - i), Rank: int64(i)})
+			previousMembers = append(previousMembers, &database.Member{Member: fmt.Sprintf("member%d", i), Score: float64(2000 - i), Rank: int64(i)})
+		}
+		previousMembers[999].Rank = 1000
+
+		mock.EXPECT().GetRankSnapshotTime(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(fromTakenAt, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(0), gomock.Eq(999), gomock.Eq("desc")).Return(firstPage, nil)
+		mock.EXPECT().GetRankSnapshotMembersByID(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(fromTakenAt), gomock.Eq("desc"), gomock.Any()).Return(previousMembers, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(1000), gomock.Eq(1999), gomock.Eq("desc")).Return([]*database.Member{
+			{Member: "member1000", Score: 500, Rank: 1000},
+		}, nil)
+		mock.EXPECT().GetRankSnapshotMembersByID(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(fromTakenAt), gomock.Eq("desc"), gomock.Eq("member1000")).Return([]*database.Member{
+			{Member: "member1000", Score: 1000, Rank: 999},
+		}, nil)
+
+		movers, err := svc.GetRankMovers(context.Background(), leaderboard, 1600000000, 0, 10, "desc")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(movers.Climbers).To(Equal([]*model.RankMove{
+			{PublicID: "member999", PreviousRank: 1001, Rank: 1000, RankDelta: 1},
+		}))
+		Expect(movers.Fallers).To(Equal([]*model.RankMove{
+			{PublicID: "member1000", PreviousRank: 1000, Rank: 1001, RankDelta: -1},
+		}))
+	})
+
+	It("Should return RankSnapshotNotFoundError if no snapshot was taken until from", func() {
+		mock.EXPECT().GetRankSnapshotTime(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(time.Time{}, nil)
+
+		_, err := svc.GetRankMovers(context.Background(), leaderboard, 1600000000, 0, 10, "desc")
+		Expect(err).To(Equal(service.NewRankSnapshotNotFoundError(leaderboard, 1600000000)))
+	})
+
+	It("Should return error if database return in error on GetRankSnapshotMembersByID", func() {
+		mock.EXPECT().GetRankSnapshotTime(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(fromTakenAt, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.Member{{Member: "member1"}}, nil)
+		mock.EXPECT().GetRankSnapshotMembersByID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("Database error example"))
+
+		_, err := svc.GetRankMovers(context.Background(), leaderboard, 1600000000, 0, 10, "desc")
+		Expect(err).To(Equal(service.NewGeneralError("get rank movers", "Database error example")))
+	})
+})
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const getRejectedScoresServiceLabel = "get rejected scores"
+
+// GetRejectedScores return a page of the scores rejected by leaderboard rules, the last rejected first
+func (s *Service) GetRejectedScores(ctx context.Context, leaderboard string, pageSize, page int) ([]*model.RejectedScore, error) {
+	if page < 1 {
+		page = 1
+	}
+	index := getIndexesByPage(pageSize, page)
+
+	databaseRejectedScores, err := s.Database.GetRejectedScores(ctx, leaderboard, index.Start, index.Stop)
+	if err != nil {
+		return nil, NewGeneralError(getRejectedScoresServiceLabel, err.Error())
+	}
+
+	rejectedScores := make([]*model.RejectedScore, 0, len(databaseRejectedScores))
+	for _, rejectedScore := range databaseRejectedScores {
+		rejectedScores = append(rejectedScores, &model.RejectedScore{
+			PublicID:      rejectedScore.Member,
+			Score:         rejectedScore.Score,
+			PreviousScore: rejectedScore.PreviousScore,
+			Rule:          rejectedScore.Rule,
+			RejectedAt:    rejectedScore.RejectedAt.Unix(),
+		})
+	}
+
+	return rejectedScores, nil
+}
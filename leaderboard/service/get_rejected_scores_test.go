@@ -0,0 +1,61 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service GetRejectedScores", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should return rejected scores of page", func() {
+		rejectedAt := time.Unix(1600000000, 0)
+		mock.EXPECT().GetRejectedScores(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(10), gomock.Eq(19)).Return([]*database.RejectedScore{
+			{Member: "member1", Score: 500, PreviousScore: 100, Rule: model.ScoreRuleMaxIncrement, RejectedAt: rejectedAt},
+		}, nil)
+
+		rejectedScores, err := svc.GetRejectedScores(context.Background(), leaderboard, 10, 2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rejectedScores).To(Equal([]*model.RejectedScore{
+			{PublicID: "member1", Score: 500, PreviousScore: 100, Rule: model.ScoreRuleMaxIncrement, RejectedAt: 1600000000},
+		}))
+	})
+
+	It("Should return first page if page is lower than one", func() {
+		mock.EXPECT().GetRejectedScores(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(0), gomock.Eq(9)).Return([]*database.RejectedScore{}, nil)
+
+		rejectedScores, err := svc.GetRejectedScores(context.Background(), leaderboard, 10, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rejectedScores).To(BeEmpty())
+	})
+
+	It("Should return error if database return in error", func() {
+		mock.EXPECT().GetRejectedScores(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("Database error example"))
+
+		_, err := svc.GetRejectedScores(context.Background(), leaderboard, 10, 1)
+		Expect(err).To(Equal(service.NewGeneralError("get rejected scores", "Database error example")))
+	})
+})
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const getResetProgressServiceLabel = "get reset progress"
+
+// GetResetProgress return the progress of the last reset applied to leaderboard
+func (s *Service) GetResetProgress(ctx context.Context, leaderboard string) (*model.ResetProgress, error) {
+	databaseProgress, err := s.Database.GetResetProgress(ctx, leaderboard)
+	if err != nil {
+		if _, ok := err.(*database.ResetProgressNotFoundError); ok {
+			return nil, NewResetProgressNotFoundError(leaderboard)
+		}
+		return nil, NewGeneralError(getResetProgressServiceLabel, err.Error())
+	}
+
+	progress := &model.ResetProgress{
+		Leaderboard: leaderboard,
+		Target:      databaseProgress.Target,
+		Strategy:    model.ResetStrategy(databaseProgress.Strategy),
+		Status:      databaseProgress.Status,
+		Processed:   int(databaseProgress.Processed),
+		Total:       int(databaseProgress.Total),
+		StartedAt:   databaseProgress.StartedAt.Unix(),
+	}
+
+	if !databaseProgress.FinishedAt.IsZero() {
+		progress.FinishedAt = databaseProgress.FinishedAt.Unix()
+	}
+
+	return progress, nil
+}
@@ -0,0 +1,72 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service GetResetProgress", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboard"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should return reset progress if all is OK", func() {
+		startedAt := time.Unix(time.Now().Unix(), 0)
+
+		mock.EXPECT().GetResetProgress(gomock.Any(), gomock.Eq(leaderboard)).Return(&database.ResetProgress{
+			Target:    "leaderboard2",
+			Strategy:  "zero",
+			Status:    "running",
+			Processed: 1000,
+			Total:     5000,
+			StartedAt: startedAt,
+		}, nil)
+
+		progress, err := svc.GetResetProgress(context.Background(), leaderboard)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(progress).To(Equal(&model.ResetProgress{
+			Leaderboard: leaderboard,
+			Target:      "leaderboard2",
+			Strategy:    model.ResetStrategyZero,
+			Status:      model.ResetStatusRunning,
+			Processed:   1000,
+			Total:       5000,
+			StartedAt:   startedAt.Unix(),
+		}))
+	})
+
+	It("Should return ResetProgressNotFoundError if leaderboard was never reset", func() {
+		mock.EXPECT().GetResetProgress(gomock.Any(), gomock.Eq(leaderboard)).Return(nil, database.NewResetProgressNotFoundError(leaderboard))
+
+		_, err := svc.GetResetProgress(context.Background(), leaderboard)
+		Expect(err).To(Equal(service.NewResetProgressNotFoundError(leaderboard)))
+	})
+
+	It("Should return error if database return in error", func() {
+		mock.EXPECT().GetResetProgress(gomock.Any(), gomock.Eq(leaderboard)).Return(nil, database.NewGeneralError("unknown error"))
+
+		_, err := svc.GetResetProgress(context.Background(), leaderboard)
+		Expect(err).To(Equal(service.NewGeneralError("get reset progress", database.NewGeneralError("unknown error").Error())))
+	})
+})
@@ -35,11 +35,16 @@ func (s *Service) GetTopPercentage(ctx context.Context, leaderboardID string, pa
 		numberMembersToReturn = maxMembers
 	}
 
+	settings, err := s.getLeaderboardSettings(ctx, leaderboardID)
+	if err != nil {
+		return nil, NewGeneralError(getTopPercentageServiceLabel, err.Error())
+	}
+
 	databaseMembers, err := s.Database.GetOrderedMembers(ctx, leaderboardID, 0, numberMembersToReturn-1, order)
 	if err != nil {
 		return nil, NewGeneralError(getTopPercentageServiceLabel, err.Error())
 	}
 
-	members := convertDatabaseMembersIntoModelMembers(databaseMembers)
+	members := convertDatabaseMembersIntoModelMembers(databaseMembers, settings)
 	return members, nil
 }
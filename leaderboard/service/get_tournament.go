@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const getTournamentServiceLabel = "get tournament"
+
+// GetTournament return tournament configuration and status
+func (s *Service) GetTournament(ctx context.Context, tournament string) (*model.Tournament, error) {
+	config, err := s.getTournament(ctx, tournament)
+	if err != nil {
+		if _, ok := err.(*TournamentNotFoundError); ok {
+			return nil, err
+		}
+		return nil, NewGeneralError(getTournamentServiceLabel, err.Error())
+	}
+
+	return config, nil
+}
@@ -0,0 +1,93 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service GetTournament", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var tournament string = "tournamentTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should return running tournament if now is inside its window", func() {
+		startAt := time.Now().Unix() - 60
+		endAt := time.Now().Unix() + 60
+		mock.EXPECT().GetTournament(gomock.Any(), gomock.Eq(tournament)).Return(&database.Tournament{
+			StartAt: time.Unix(startAt, 0),
+			EndAt:   time.Unix(endAt, 0),
+			Order:   "desc",
+			Prizes:  []*database.TournamentPrize{{FromRank: 1, ToRank: 1, RewardID: "gold"}},
+		}, nil)
+
+		config, err := svc.GetTournament(context.Background(), tournament)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config).To(Equal(&model.Tournament{
+			ID:      tournament,
+			StartAt: startAt,
+			EndAt:   endAt,
+			Order:   "desc",
+			Prizes:  []*model.TournamentPrize{{FromRank: 1, ToRank: 1, RewardID: "gold"}},
+			Status:  model.TournamentRunning,
+		}))
+	})
+
+	It("Should return ended tournament if now is after its window", func() {
+		mock.EXPECT().GetTournament(gomock.Any(), gomock.Eq(tournament)).Return(&database.Tournament{
+			StartAt: time.Unix(1600000000, 0),
+			EndAt:   time.Unix(1600003600, 0),
+		}, nil)
+
+		config, err := svc.GetTournament(context.Background(), tournament)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Status).To(Equal(model.TournamentEnded))
+	})
+
+	It("Should return finalized tournament if it was finalized", func() {
+		mock.EXPECT().GetTournament(gomock.Any(), gomock.Eq(tournament)).Return(&database.Tournament{
+			StartAt:     time.Unix(1600000000, 0),
+			EndAt:       time.Unix(1600003600, 0),
+			FinalizedAt: time.Unix(1600007200, 0),
+		}, nil)
+
+		config, err := svc.GetTournament(context.Background(), tournament)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Status).To(Equal(model.TournamentFinalized))
+		Expect(config.FinalizedAt).To(Equal(int64(1600007200)))
+	})
+
+	It("Should return TournamentNotFoundError if tournament does not exist", func() {
+		mock.EXPECT().GetTournament(gomock.Any(), gomock.Eq(tournament)).Return(nil, database.NewTournamentNotFoundError(tournament))
+
+		_, err := svc.GetTournament(context.Background(), tournament)
+		Expect(err).To(Equal(service.NewTournamentNotFoundError(tournament)))
+	})
+
+	It("Should return error if database return in error", func() {
+		mock.EXPECT().GetTournament(gomock.Any(), gomock.Eq(tournament)).Return(nil, fmt.Errorf("Database error example"))
+
+		_, err := svc.GetTournament(context.Background(), tournament)
+		Expect(err).To(Equal(service.NewGeneralError("get tournament", "Database error example")))
+	})
+})
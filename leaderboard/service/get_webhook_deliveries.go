@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const getWebhookDeliveriesServiceLabel = "get webhook deliveries"
+
+// GetWebhookDeliveries return a page of the attempts to deliver lifecycle events to the webhook, the last attempted first
+func (s *Service) GetWebhookDeliveries(ctx context.Context, pageSize, page int) ([]*model.WebhookDelivery, error) {
+	if page < 1 {
+		page = 1
+	}
+	index := getIndexesByPage(pageSize, page)
+
+	databaseDeliveries, err := s.Database.GetWebhookDeliveries(ctx, index.Start, index.Stop)
+	if err != nil {
+		return nil, NewGeneralError(getWebhookDeliveriesServiceLabel, err.Error())
+	}
+
+	deliveries := make([]*model.WebhookDelivery, 0, len(databaseDeliveries))
+	for _, delivery := range databaseDeliveries {
+		deliveries = append(deliveries, &model.WebhookDelivery{
+			ID:           delivery.ID,
+			URL:          delivery.URL,
+			EventIDs:     delivery.EventIDs,
+			Attempt:      delivery.Attempt,
+			StatusCode:   delivery.StatusCode,
+			Error:        delivery.Error,
+			DeadLettered: delivery.DeadLettered,
+			AttemptedAt:  delivery.AttemptedAt.Unix(),
+		})
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,51 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service GetWebhookDeliveries", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should return webhook deliveries of page", func() {
+		attemptedAt := time.Unix(1600000000, 0)
+		mock.EXPECT().GetWebhookDeliveries(gomock.Any(), gomock.Eq(10), gomock.Eq(19)).Return([]*database.WebhookDelivery{
+			{ID: "delivery1", URL: "http://localhost", EventIDs: []string{"event1"}, Attempt: 2, StatusCode: 500, Error: "unexpected status 500", AttemptedAt: attemptedAt},
+		}, nil)
+
+		deliveries, err := svc.GetWebhookDeliveries(context.Background(), 10, 2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deliveries).To(Equal([]*model.WebhookDelivery{
+			{ID: "delivery1", URL: "http://localhost", EventIDs: []string{"event1"}, Attempt: 2, StatusCode: 500, Error: "unexpected status 500", AttemptedAt: 1600000000},
+		}))
+	})
+
+	It("Should return error if database return in error", func() {
+		mock.EXPECT().GetWebhookDeliveries(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("Database error example"))
+
+		_, err := svc.GetWebhookDeliveries(context.Background(), 10, 1)
+		Expect(err).To(Equal(service.NewGeneralError("get webhook deliveries", "Database error example")))
+	})
+})
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// recordMembersHistory sample score and rank of members written to leaderboard when its history is enabled
+func (s *Service) recordMembersHistory(ctx context.Context, leaderboard string, members []*model.Member, settings *model.LeaderboardSettings) error {
+	if !settings.HistoryEnabled || len(members) == 0 {
+		return nil
+	}
+
+	expireAt, expired, err := getLeaderboardExpireAt(leaderboard)
+	if err != nil || expired {
+		return err
+	}
+
+	sampledAt := time.Now()
+	samples := make([]*database.HistorySample, 0, len(members))
+	for _, member := range members {
+		samples = append(samples, &database.HistorySample{
+			Member:    member.PublicID,
+			Score:     member.Score,
+			Rank:      member.Rank,
+			SampledAt: sampledAt,
+		})
+	}
+
+	interval := time.Duration(settings.HistoryInterval) * time.Second
+	return s.Database.AddHistorySamples(ctx, leaderboard, samples, interval, expireAt)
+}
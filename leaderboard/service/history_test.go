@@ -0,0 +1,83 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service member history", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should sample score and rank of members written if history is enabled", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"historyEnabled":  "true",
+			"historyInterval": "60",
+		}, nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(true), gomock.Eq("member1"), gomock.Eq("member2")).Return([]*database.Member{
+			{Member: "member1", Score: 500, Rank: 1},
+			{Member: "member2", Score: 800, Rank: 0},
+		}, nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		mock.EXPECT().AddHistorySamples(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Eq(time.Minute), gomock.Eq(time.Time{})).
+			DoAndReturn(func(ctx context.Context, leaderboard string, samples []*database.HistorySample, interval time.Duration, expireAt time.Time) error {
+				Expect(samples).To(HaveLen(2))
+				Expect(samples[0].Member).To(Equal("member1"))
+				Expect(samples[0].Score).To(Equal(int64(500)))
+				Expect(samples[0].Rank).To(Equal(2))
+				Expect(samples[0].SampledAt).To(BeTemporally("~", time.Now(), time.Second))
+				Expect(samples[1].Member).To(Equal("member2"))
+				Expect(samples[1].Rank).To(Equal(1))
+				return nil
+			})
+
+		members := []*model.Member{{PublicID: "member1", Score: 500}, {PublicID: "member2", Score: 800}}
+		err := svc.SetMembersScore(context.Background(), leaderboard, members, false, "", nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should report error of database AddHistorySamples after the write and return the member", func() {
+		var steps []string
+		svc.OnAfterCommitError = func(ctx context.Context, step string, err error) {
+			steps = append(steps, step)
+		}
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"historyEnabled": "true",
+		}, nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(&database.Member{Member: "member"}), gomock.Any()).Return(nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(true), gomock.Eq("member")).Return([]*database.Member{
+			{Member: "member", Score: 10, Rank: 0},
+		}, nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		mock.EXPECT().AddHistorySamples(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Eq(time.Duration(0)), gomock.Any()).Return(fmt.Errorf("New database error"))
+
+		_, err := svc.IncrementMemberScore(context.Background(), leaderboard, "member", 10, "", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(steps).To(Equal([]string{service.AfterCommitHistory}))
+	})
+})
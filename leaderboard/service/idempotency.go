@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// Writes with an idempotency key are applied by the database together with the creation of the key, which
+// stores the members values of the write, so a retry either finds the key and gets the values of the first
+// write or applies the write. The key also stores a fingerprint of the write payload, so reusing a key for
+// a different write is rejected instead of returning the result of another write.
+
+// getIdempotency return the database idempotency of a write named operation of members with scoreTTL,
+// nil if idempotency is not set
+func getIdempotency(idempotency *model.IdempotencyKey, operation, scoreTTL string, members []*model.Member) *database.Idempotency {
+	if idempotency == nil {
+		return nil
+	}
+
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\n%s\n", operation, scoreTTL)
+	for _, member := range members {
+		fmt.Fprintf(hash, "%s\n%d\n", member.PublicID, member.Score)
+	}
+
+	return &database.Idempotency{
+		Key:         idempotency.Key,
+		Fingerprint: hex.EncodeToString(hash.Sum(nil)),
+		Window:      idempotency.Window,
+	}
+}
+
+// conditionalOperation return operation with the expected score and version of condition, so reusing a key
+// for the same write with another condition is rejected, operation if condition is not set
+func conditionalOperation(operation string, condition *model.ScoreCondition) string {
+	if condition == nil {
+		return operation
+	}
+
+	expectedScore, expectedVersion := "", ""
+	if condition.ExpectedScore != nil {
+		expectedScore = fmt.Sprint(*condition.ExpectedScore)
+	}
+	if condition.ExpectedVersion != nil {
+		expectedVersion = fmt.Sprint(*condition.ExpectedVersion)
+	}
+
+	return fmt.Sprintf("%s if score %s version %s", operation, expectedScore, expectedVersion)
+}
+
+// setIdempotentMembersValues fill members with the values of the idempotent write of databaseMembers,
+// the previous ranks only if prevRank is set
+func setIdempotentMembersValues(members []*model.Member, databaseMembers []*database.Member, prevRank bool, settings *model.LeaderboardSettings) {
+	for i, databaseMember := range databaseMembers {
+		members[i].Score = toDecayedScore(settings, databaseMember.Score)
+		members[i].Rank = int(databaseMember.Rank + 1)
+		members[i].Version = databaseMember.Version
+		members[i].ExpireAt = 0
+		if !databaseMember.TTL.IsZero() {
+			members[i].ExpireAt = int(databaseMember.TTL.Unix())
+		}
+
+		if prevRank {
+			members[i].PreviousRank = -1
+			if databaseMember.PreviousRank >= 0 {
+				members[i].PreviousRank = int(databaseMember.PreviousRank + 1)
+			}
+		}
+	}
+}
+
+// getIdempotentWrite fill members with the values of the write with idempotency if it was already applied,
+// returning true, so retries of writes are not checked against the score rules of settings again. Without
+// score rules the write itself finds the values
+func (s *Service) getIdempotentWrite(ctx context.Context, leaderboard string, members []*model.Member, idempotency *database.Idempotency, prevRank bool, settings *model.LeaderboardSettings) (bool, error) {
+	if idempotency == nil || !hasScoreRules(settings) {
+		return false, nil
+	}
+
+	databaseMembers := make([]*database.Member, 0, len(members))
+	for _, member := range members {
+		databaseMembers = append(databaseMembers, &database.Member{Member: member.PublicID})
+	}
+
+	found, err := s.Database.GetIdempotentWrite(ctx, leaderboard, databaseMembers, idempotency)
+	if err != nil || !found {
+		return false, err
+	}
+
+	setIdempotentMembersValues(members, databaseMembers, prevRank, settings)
+	return true, nil
+}
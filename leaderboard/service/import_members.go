@@ -0,0 +1,310 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/expiration"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const importMembersServiceLabel = "import members"
+
+// importBatchSize is how many members of a chunk are written by each script of its pipeline
+const importBatchSize = 1000
+
+// ImportMembers write the scores of the chunks of members returned by next until it returns io.EOF,
+// acknowledging each chunk with how many members were imported and why the others failed. Chunks are
+// written in pipelined batches incrementing members versions, stored as the decay of leaderboard expects, and
+// go through the write window, participants and blocklist of SetMembersScore, recording the score changes
+// in the ledger and publishing them to the event sink. Leaderboards with score rules or milestones, whose
+// writes are checked against the scores members had, do not accept imports. When replace is set members
+// are written into a temporary leaderboard in the slot of leaderboard that replaces it at once after the
+// last chunk if no member failed, otherwise it is discarded, and score changes are only recorded once it
+// replaced leaderboard
+func (s *Service) ImportMembers(ctx context.Context, leaderboard string, replace bool, next func() ([]*model.Member, error)) (*model.ImportResult, error) {
+	_, err := expiration.GetExpireAt(leaderboard)
+	if err != nil {
+		if _, ok := err.(*expiration.LeaderboardExpiredError); ok {
+			return nil, NewLeaderboardExpiredError(leaderboard)
+		}
+		return nil, NewGeneralError(importMembersServiceLabel, err.Error())
+	}
+
+	settings, err := s.getLeaderboardSettings(ctx, leaderboard)
+	if err != nil {
+		return nil, NewGeneralError(importMembersServiceLabel, err.Error())
+	}
+
+	err = ensureImportable(leaderboard, settings, s.hasMilestones(settings))
+	if err != nil {
+		return nil, err
+	}
+
+	target := leaderboard
+	if replace {
+		target = database.LeaderboardKey(leaderboard, "import:tmp")
+		err = s.Database.RemoveLeaderboard(ctx, target)
+		if err != nil {
+			return nil, NewGeneralError(importMembersServiceLabel, err.Error())
+		}
+	}
+
+	result := &model.ImportResult{
+		Leaderboard: leaderboard,
+		Chunks:      []*model.ImportChunk{},
+	}
+	replaced := &importedChanges{previousRanks: map[string]int{}}
+	for {
+		members, err := next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if replace {
+				s.Database.RemoveLeaderboard(ctx, target)
+			}
+			return nil, err
+		}
+
+		chunk, changes := s.importChunk(ctx, leaderboard, target, settings, len(result.Chunks), members)
+		result.Chunks = append(result.Chunks, chunk)
+		result.Imported += chunk.Imported
+		result.Failed += len(chunk.Failures)
+
+		if replace {
+			replaced.add(changes)
+			continue
+		}
+		s.recordImportedChanges(ctx, leaderboard, settings, changes)
+	}
+
+	if replace {
+		if result.Failed > 0 {
+			err = s.Database.RemoveLeaderboard(ctx, target)
+			if err != nil {
+				return nil, NewGeneralError(importMembersServiceLabel, err.Error())
+			}
+			return result, nil
+		}
+
+		err = s.replaceLeaderboard(ctx, target, leaderboard, result.Imported)
+		if err != nil {
+			return nil, NewGeneralError(importMembersServiceLabel, err.Error())
+		}
+		result.Replaced = true
+		s.recordImportedChanges(ctx, leaderboard, settings, replaced)
+	}
+
+	if result.Imported == 0 {
+		return result, nil
+	}
+
+	err = s.persistLeaderboardExpirationTime(ctx, leaderboard)
+	if err != nil {
+		if _, ok := err.(*expiration.LeaderboardExpiredError); ok {
+			return nil, NewLeaderboardExpiredError(leaderboard)
+		}
+		return nil, NewGeneralError(importMembersServiceLabel, err.Error())
+	}
+
+	err = s.notifyLeaderboardCreated(ctx, leaderboard)
+	if err != nil {
+		return nil, NewGeneralError(importMembersServiceLabel, err.Error())
+	}
+
+	return result, nil
+}
+
+// ensureImportable return an error if leaderboard settings reject writing scores at this time or check writes
+// against the scores members had, which imports do not read
+func ensureImportable(leaderboard string, settings *model.LeaderboardSettings, milestones bool) error {
+	err := ensureOpen(leaderboard, settings)
+	if err != nil {
+		return err
+	}
+
+	if hasScoreRules(settings) {
+		return NewImportNotAllowedError(leaderboard, "it has score rules")
+	}
+	if milestones {
+		return NewImportNotAllowedError(leaderboard, "it has milestones")
+	}
+
+	return nil
+}
+
+// importedChanges are the score changes of members imported and their ranks before the import
+type importedChanges struct {
+	changes       []*database.LedgerEntry
+	previousRanks map[string]int
+}
+
+func (i *importedChanges) add(other *importedChanges) {
+	i.changes = append(i.changes, other.changes...)
+	for member, rank := range other.previousRanks {
+		if _, ok := i.previousRanks[member]; !ok {
+			i.previousRanks[member] = rank
+		}
+	}
+}
+
+// recordImportedChanges record the score changes of members imported in the ledger and publish them to the
+// event sink, reporting errors to OnAfterCommitError as the members were already imported
+func (s *Service) recordImportedChanges(ctx context.Context, leaderboard string, settings *model.LeaderboardSettings, imported *importedChanges) {
+	s.afterCommit(ctx, AfterCommitLedger, s.recordScoreChanges(ctx, leaderboard, settings, imported.changes))
+
+	s.afterCommit(ctx, AfterCommitEvents, s.publishScoreChanges(ctx, leaderboard, imported.changes, imported.previousRanks))
+}
+
+// importChunk write members into target in batches of importBatchSize with their stored scores, returning the
+// score changes of the members written to leaderboard. Members without publicID, members that did not join
+// leaderboard when it only accepts participants, members blocked with BlockModeReject and every member of
+// the batches redis failed to write fail. Scores of members blocked in shadow mode are written to the shadow
+// leaderboard
+func (s *Service) importChunk(ctx context.Context, leaderboard, target string, settings *model.LeaderboardSettings, index int, members []*model.Member) (*model.ImportChunk, *importedChanges) {
+	chunk := &model.ImportChunk{
+		Chunk:    index,
+		Failures: []*model.ImportFailure{},
+	}
+	imported := &importedChanges{}
+
+	valid := make([]*model.Member, 0, len(members))
+	for _, member := range members {
+		if member.PublicID == "" {
+			chunk.Failures = append(chunk.Failures, &model.ImportFailure{Reason: "publicID is required"})
+			continue
+		}
+		valid = append(valid, member)
+	}
+
+	allowed, err := s.filterImportedMembers(ctx, leaderboard, settings, chunk, valid)
+	if err != nil {
+		failImportedMembers(chunk, valid, err)
+		return chunk, imported
+	}
+	if len(allowed) == 0 {
+		return chunk, imported
+	}
+
+	memberIDs := make([]string, 0, len(allowed))
+	for _, member := range allowed {
+		memberIDs = append(memberIDs, member.PublicID)
+	}
+	imported.previousRanks, err = s.getEventRanks(ctx, leaderboard, memberIDs...)
+	if err != nil {
+		failImportedMembers(chunk, allowed, err)
+		return chunk, imported
+	}
+
+	scores := make(map[string]int64, len(allowed))
+	batches := [][]*database.Member{}
+	batch := []*database.Member{}
+	for _, member := range allowed {
+		scores[member.PublicID] = member.Score
+		batch = append(batch, &database.Member{Member: member.PublicID, Score: toStoredScore(settings, float64(member.Score))})
+		if len(batch) == importBatchSize {
+			batches = append(batches, batch)
+			batch = []*database.Member{}
+		}
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	errs := s.Database.ImportMembers(ctx, leaderboard, target, batches...)
+	for i, err := range errs {
+		if err != nil {
+			for _, member := range batches[i] {
+				chunk.Failures = append(chunk.Failures, &model.ImportFailure{PublicID: member.Member, Reason: err.Error()})
+			}
+			continue
+		}
+
+		chunk.Imported += len(batches[i])
+		for _, member := range batches[i] {
+			newScore := scores[member.Member]
+			imported.changes = append(imported.changes, newScoreChange(member.Member, toLedgerScore(settings, member.PreviousScore), &newScore))
+		}
+	}
+
+	return chunk, imported
+}
+
+// filterImportedMembers return members that can be imported into leaderboard, adding the others to the failures
+// of chunk and writing the scores of members blocked in shadow mode to the shadow leaderboard, which count
+// as imported
+func (s *Service) filterImportedMembers(ctx context.Context, leaderboard string, settings *model.LeaderboardSettings, chunk *model.ImportChunk, members []*model.Member) ([]*model.Member, error) {
+	if len(members) == 0 {
+		return members, nil
+	}
+
+	memberIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		memberIDs = append(memberIDs, member.PublicID)
+	}
+
+	nonParticipants := map[string]bool{}
+	if settings.ParticipantsOnly {
+		ids, err := s.Database.GetLeaderboardNonParticipants(ctx, leaderboard, memberIDs...)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			nonParticipants[id] = true
+		}
+	}
+
+	modes, err := s.getBlockModes(ctx, leaderboard, memberIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := make([]*model.Member, 0, len(members))
+	shadowMembers := []*model.Member{}
+	for i, member := range members {
+		switch {
+		case nonParticipants[member.PublicID]:
+			chunk.Failures = append(chunk.Failures, &model.ImportFailure{
+				PublicID: member.PublicID,
+				Reason:   NewMemberNotParticipantError(leaderboard, member.PublicID).Error(),
+			})
+		case modes[i] == model.BlockModeReject:
+			chunk.Failures = append(chunk.Failures, &model.ImportFailure{
+				PublicID: member.PublicID,
+				Reason:   NewMemberBlockedError(leaderboard, member.PublicID).Error(),
+			})
+		case modes[i] == model.BlockModeShadow:
+			shadowMembers = append(shadowMembers, member)
+		default:
+			allowed = append(allowed, member)
+		}
+	}
+
+	if len(shadowMembers) > 0 {
+		err = s.persistShadowMembers(ctx, leaderboard, shadowMembers, settings)
+		if err != nil {
+			failImportedMembers(chunk, shadowMembers, err)
+		} else {
+			chunk.Imported += len(shadowMembers)
+		}
+	}
+
+	return allowed, nil
+}
+
+func failImportedMembers(chunk *model.ImportChunk, members []*model.Member, err error) {
+	for _, member := range members {
+		chunk.Failures = append(chunk.Failures, &model.ImportFailure{PublicID: member.PublicID, Reason: err.Error()})
+	}
+}
+
+// replaceLeaderboard move the members imported into temporary to leaderboard, removing leaderboard if none were
+func (s *Service) replaceLeaderboard(ctx context.Context, temporary, leaderboard string, imported int) error {
+	if imported == 0 {
+		return s.Database.RemoveLeaderboard(ctx, leaderboard)
+	}
+
+	return s.Database.RenameLeaderboard(ctx, temporary, leaderboard)
+}
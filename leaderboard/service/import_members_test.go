@@ -0,0 +1,255 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service ImportMembers", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboard"
+	var temporaryLeaderboard string = "{leaderboard}:import:tmp"
+
+	chunks := func(chunks ...[]*model.Member) func() ([]*model.Member, error) {
+		return func() ([]*model.Member, error) {
+			if len(chunks) == 0 {
+				return nil, io.EOF
+			}
+			chunk := chunks[0]
+			chunks = chunks[1:]
+			return chunk, nil
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should import chunks into leaderboard acknowledging each one", func() {
+		gomock.InOrder(
+			mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil),
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1"), gomock.Eq("member2")).Return([]*database.BlockedMember{{}, {}}, nil),
+			mock.EXPECT().ImportMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(leaderboard), gomock.Eq([]*database.Member{
+				{Member: "member1", Score: 10},
+				{Member: "member2", Score: 20},
+			})).Return([]error{nil}),
+			mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil),
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member3")).Return([]*database.BlockedMember{{}}, nil),
+			mock.EXPECT().ImportMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(leaderboard), gomock.Eq([]*database.Member{
+				{Member: "member3", Score: 30},
+			})).Return([]error{nil}),
+			mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil),
+		)
+
+		result, err := svc.ImportMembers(context.Background(), leaderboard, false, chunks(
+			[]*model.Member{{PublicID: "member1", Score: 10}, {PublicID: "member2", Score: 20}},
+			[]*model.Member{{PublicID: "member3", Score: 30}, {PublicID: "", Score: 40}},
+		))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(&model.ImportResult{
+			Leaderboard: leaderboard,
+			Chunks: []*model.ImportChunk{
+				{Chunk: 0, Imported: 2, Failures: []*model.ImportFailure{}},
+				{Chunk: 1, Imported: 1, Failures: []*model.ImportFailure{{Reason: "publicID is required"}}},
+			},
+			Imported: 3,
+			Failed:   1,
+		}))
+	})
+
+	It("Should split chunks in batches and fail members of batches not written", func() {
+		members := make([]*model.Member, 1500)
+		for i := range members {
+			members[i] = &model.Member{PublicID: fmt.Sprintf("member%d", i), Score: int64(i)}
+		}
+
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().ImportMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(leaderboard), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, leaderboard, target string, batches ...[]*database.Member) []error {
+				Expect(batches).To(HaveLen(2))
+				Expect(batches[0]).To(HaveLen(1000))
+				Expect(batches[1]).To(HaveLen(500))
+				return []error{nil, fmt.Errorf("redis error")}
+			})
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, leaderboard string, entries []*database.LedgerEntry, removeBefore, expireAt time.Time, maxEntries int) error {
+				Expect(entries).To(HaveLen(1000))
+				return nil
+			})
+
+		result, err := svc.ImportMembers(context.Background(), leaderboard, false, chunks(members))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Imported).To(Equal(1000))
+		Expect(result.Failed).To(Equal(500))
+		Expect(result.Chunks[0].Failures[0]).To(Equal(&model.ImportFailure{PublicID: "member1000", Reason: "redis error"}))
+	})
+
+	It("Should import scores weighted by the decay of leaderboard", func() {
+		// one half-life passed since landmark, so stored scores are twice the imported ones
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"decayHalfLife": "3600",
+			"decayLandmark": fmt.Sprint(time.Now().Unix() - 3600),
+		}, nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().ImportMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(leaderboard), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, leaderboard, target string, batches ...[]*database.Member) []error {
+				Expect(batches[0][0].Score).To(BeNumerically("~", 20, 0.1))
+				return []error{nil}
+			})
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, leaderboard string, entries []*database.LedgerEntry, removeBefore, expireAt time.Time, maxEntries int) error {
+				Expect(*entries[0].NewScore).To(Equal(int64(10)))
+				return nil
+			})
+
+		result, err := svc.ImportMembers(context.Background(), leaderboard, false, chunks(
+			[]*model.Member{{PublicID: "member1", Score: 10}},
+		))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Imported).To(Equal(1))
+	})
+
+	It("Should replace leaderboard with the members imported", func() {
+		gomock.InOrder(
+			mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil),
+			mock.EXPECT().RemoveLeaderboard(gomock.Any(), gomock.Eq(temporaryLeaderboard)).Return(nil),
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return([]*database.BlockedMember{}, nil),
+			mock.EXPECT().ImportMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(temporaryLeaderboard), gomock.Eq([]*database.Member{
+				{Member: "member1", Score: 10},
+			})).Return([]error{nil}),
+			mock.EXPECT().RenameLeaderboard(gomock.Any(), gomock.Eq(temporaryLeaderboard), gomock.Eq(leaderboard)).Return(nil),
+			mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil),
+		)
+
+		result, err := svc.ImportMembers(context.Background(), leaderboard, true, chunks(
+			[]*model.Member{{PublicID: "member1", Score: 10}},
+		))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Imported).To(Equal(1))
+		Expect(result.Replaced).To(BeTrue())
+	})
+
+	It("Should discard members imported to replace leaderboard if any failed", func() {
+		gomock.InOrder(
+			mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil),
+			mock.EXPECT().RemoveLeaderboard(gomock.Any(), gomock.Eq(temporaryLeaderboard)).Return(nil),
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return([]*database.BlockedMember{}, nil),
+			mock.EXPECT().ImportMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(temporaryLeaderboard), gomock.Any()).Return([]error{nil}),
+			mock.EXPECT().RemoveLeaderboard(gomock.Any(), gomock.Eq(temporaryLeaderboard)).Return(nil),
+		)
+
+		result, err := svc.ImportMembers(context.Background(), leaderboard, true, chunks(
+			[]*model.Member{{PublicID: "member1", Score: 10}, {PublicID: "", Score: 20}},
+		))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Imported).To(Equal(1))
+		Expect(result.Failed).To(Equal(1))
+		Expect(result.Replaced).To(BeFalse())
+	})
+
+	It("Should discard members imported to replace leaderboard if reading chunks fails", func() {
+		gomock.InOrder(
+			mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil),
+			mock.EXPECT().RemoveLeaderboard(gomock.Any(), gomock.Eq(temporaryLeaderboard)).Return(nil),
+			mock.EXPECT().RemoveLeaderboard(gomock.Any(), gomock.Eq(temporaryLeaderboard)).Return(nil),
+		)
+
+		_, err := svc.ImportMembers(context.Background(), leaderboard, true, func() ([]*model.Member, error) {
+			return nil, fmt.Errorf("stream error")
+		})
+		Expect(err).To(MatchError("stream error"))
+	})
+
+	It("Should record score changes of members imported with their previous scores", func() {
+		gomock.InOrder(
+			mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil),
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return([]*database.BlockedMember{}, nil),
+			mock.EXPECT().ImportMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(leaderboard), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, leaderboard, target string, batches ...[]*database.Member) []error {
+					previousScore := float64(5)
+					batches[0][0].PreviousScore = &previousScore
+					return []error{nil}
+				}),
+			mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, leaderboard string, entries []*database.LedgerEntry, removeBefore, expireAt time.Time, maxEntries int) error {
+					Expect(entries).To(HaveLen(2))
+					Expect(entries[0].Member).To(Equal("member1"))
+					Expect(*entries[0].OldScore).To(Equal(int64(5)))
+					Expect(*entries[0].NewScore).To(Equal(int64(10)))
+					Expect(entries[1].OldScore).To(BeNil())
+					return nil
+				}),
+		)
+
+		result, err := svc.ImportMembers(context.Background(), leaderboard, false, chunks(
+			[]*model.Member{{PublicID: "member1", Score: 10}, {PublicID: "member2", Score: 20}},
+		))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Imported).To(Equal(2))
+	})
+
+	It("Should fail members blocked or not participating in leaderboard", func() {
+		gomock.InOrder(
+			mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{"participantsOnly": "true"}, nil),
+			mock.EXPECT().GetLeaderboardNonParticipants(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1"), gomock.Eq("member2"), gomock.Eq("member3")).Return([]string{"member2"}, nil),
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1"), gomock.Eq("member2"), gomock.Eq("member3")).Return([]*database.BlockedMember{
+				{}, {}, {GlobalMode: model.BlockModeReject},
+			}, nil),
+			mock.EXPECT().ImportMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(leaderboard), gomock.Eq([]*database.Member{
+				{Member: "member1", Score: 10},
+			})).Return([]error{nil}),
+			mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil),
+		)
+
+		result, err := svc.ImportMembers(context.Background(), leaderboard, false, chunks(
+			[]*model.Member{{PublicID: "member1", Score: 10}, {PublicID: "member2", Score: 20}, {PublicID: "member3", Score: 30}},
+		))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Imported).To(Equal(1))
+		Expect(result.Chunks[0].Failures).To(Equal([]*model.ImportFailure{
+			{PublicID: "member2", Reason: service.NewMemberNotParticipantError(leaderboard, "member2").Error()},
+			{PublicID: "member3", Reason: service.NewMemberBlockedError(leaderboard, "member3").Error()},
+		}))
+	})
+
+	It("Should return ImportNotAllowedError if leaderboard has score rules", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{"monotonicOnly": "true"}, nil)
+
+		_, err := svc.ImportMembers(context.Background(), leaderboard, false, chunks())
+		Expect(err).To(Equal(service.NewImportNotAllowedError(leaderboard, "it has score rules")))
+	})
+
+	It("Should return LeaderboardFrozenError if leaderboard is frozen", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{"frozen": "true"}, nil)
+
+		_, err := svc.ImportMembers(context.Background(), leaderboard, false, chunks())
+		Expect(err).To(Equal(service.NewLeaderboardFrozenError(leaderboard)))
+	})
+
+	It("Should return GeneralError if database return in error", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(nil, fmt.Errorf("database error"))
+
+		_, err := svc.ImportMembers(context.Background(), leaderboard, false, chunks())
+		Expect(err).To(Equal(service.NewGeneralError("import members", "database error")))
+	})
+})
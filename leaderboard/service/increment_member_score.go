@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"time"
 
+	"github.com/topfreegames/podium/leaderboard/v2/database"
 	"github.com/topfreegames/podium/leaderboard/v2/expiration"
 	"github.com/topfreegames/podium/leaderboard/v2/model"
 )
@@ -11,25 +13,102 @@ const incrementMemberScoreServiceLabel = "increment member score"
 
 const incrementMemberOrder = "desc"
 
-// IncrementMemberScore return member informations that had you score incremented
-func (s *Service) IncrementMemberScore(ctx context.Context, leaderboard string, member string, increment int, scoreTTL string) (*model.Member, error) {
+// IncrementMemberScore return member informations that had you score incremented. When idempotency is set
+// a retry of the same increment returns the member informations of the first one without incrementing again,
+// and a different write with the same key fails with IdempotencyKeyReusedError.
+// Members blocked in shadow mode have their score incremented in the shadow leaderboard. The score change is
+// recorded in the ledger with the origin of ctx and published to the event sink, and milestone rules reached
+// by the member are sent to the milestone notifier
+func (s *Service) IncrementMemberScore(ctx context.Context, leaderboard string, member string, increment int, scoreTTL string, idempotency *model.IdempotencyKey) (*model.Member, error) {
 	modelMember := &model.Member{
 		PublicID: member,
 		Score:    int64(increment),
 	}
 
-	err := s.incrementMember(ctx, leaderboard, member, increment)
+	settings, err := s.getLeaderboardSettings(ctx, leaderboard)
 	if err != nil {
 		return nil, NewGeneralError(incrementMemberScoreServiceLabel, err.Error())
 	}
 
+	err = s.ensureWritable(ctx, leaderboard, settings, member)
+	if err != nil {
+		if isWriteRejectedError(err) {
+			return nil, err
+		}
+		return nil, NewGeneralError(incrementMemberScoreServiceLabel, err.Error())
+	}
+
+	_, shadowMembers, err := s.partitionBlockedMembers(ctx, leaderboard, []*model.Member{modelMember})
+	if err != nil {
+		if isWriteRejectedError(err) {
+			return nil, err
+		}
+		return nil, NewGeneralError(incrementMemberScoreServiceLabel, err.Error())
+	}
+
+	if len(shadowMembers) > 0 {
+		err = s.Database.IncrementMemberScore(ctx, database.ShadowLeaderboard(leaderboard), &database.Member{Member: member}, toStoredScore(settings, float64(increment)))
+		if err != nil {
+			return nil, NewGeneralError(incrementMemberScoreServiceLabel, err.Error())
+		}
+
+		err = s.setShadowMembersValues(ctx, leaderboard, shadowMembers, settings)
+		if err != nil {
+			return nil, NewGeneralError(incrementMemberScoreServiceLabel, err.Error())
+		}
+		return modelMember, nil
+	}
+
 	members := []*model.Member{modelMember}
+	databaseIdempotency := getIdempotency(idempotency, incrementMemberScoreServiceLabel, scoreTTL, members)
+	duplicate, err := s.getIdempotentWrite(ctx, leaderboard, members, databaseIdempotency, false, settings)
+	if err != nil {
+		if _, ok := err.(*database.IdempotencyKeyReusedError); ok {
+			return nil, NewIdempotencyKeyReusedError(leaderboard, idempotency.Key)
+		}
+		return nil, NewGeneralError(incrementMemberScoreServiceLabel, err.Error())
+	}
+	if duplicate {
+		return modelMember, nil
+	}
+
+	previousRanks, err := s.getPreviousRanks(ctx, leaderboard, settings, member)
+	if err != nil {
+		return nil, NewGeneralError(incrementMemberScoreServiceLabel, err.Error())
+	}
+
+	expireAt, err := getScoreExpireAt(scoreTTL)
+	if err != nil {
+		return nil, NewGeneralError(incrementMemberScoreServiceLabel, err.Error())
+	}
 
-	err = s.setMembersValues(ctx, leaderboard, members, incrementMemberOrder)
+	var change *database.LedgerEntry
+	err = s.writeAllowedScores(ctx, leaderboard, settings, members, true, func(checked *checkedScores) error {
+		var err error
+		change, duplicate, err = s.incrementMember(ctx, leaderboard, modelMember, increment, settings, checked, databaseIdempotency, expireAt)
+		return err
+	})
 	if err != nil {
+		if isWriteRejectedError(err) {
+			return nil, err
+		}
+		if _, ok := err.(*database.IdempotencyKeyReusedError); ok {
+			return nil, NewIdempotencyKeyReusedError(leaderboard, idempotency.Key)
+		}
 		return nil, NewGeneralError(incrementMemberScoreServiceLabel, err.Error())
 	}
 
+	if duplicate {
+		return modelMember, nil
+	}
+
+	if idempotency == nil {
+		err = s.setMembersValues(ctx, leaderboard, members, incrementMemberOrder, settings)
+		if err != nil {
+			return nil, NewGeneralError(incrementMemberScoreServiceLabel, err.Error())
+		}
+	}
+
 	err = s.persistLeaderboardExpirationTime(ctx, leaderboard)
 	if err != nil {
 		if _, ok := err.(*expiration.LeaderboardExpiredError); ok {
@@ -38,16 +117,59 @@ func (s *Service) IncrementMemberScore(ctx context.Context, leaderboard string,
 		return nil, NewGeneralError(incrementMemberScoreServiceLabel, err.Error())
 	}
 
+	err = s.notifyLeaderboardCreated(ctx, leaderboard)
+	if err != nil {
+		return nil, NewGeneralError(incrementMemberScoreServiceLabel, err.Error())
+	}
+
 	if scoreTTL != "" {
-		err = s.persistMembersTTL(ctx, leaderboard, members, scoreTTL)
+		err = s.persistMembersTTL(ctx, leaderboard, members, expireAt)
 		if err != nil {
 			return nil, NewGeneralError(incrementMemberScoreServiceLabel, err.Error())
 		}
 	}
 
+	changes := []*database.LedgerEntry{change}
+	s.afterCommit(ctx, AfterCommitLedger, s.recordScoreChanges(ctx, leaderboard, settings, changes))
+
+	s.afterCommit(ctx, AfterCommitHistory, s.recordMembersHistory(ctx, leaderboard, members, settings))
+
+	s.afterCommit(ctx, AfterCommitEvents, s.publishScoreChanges(ctx, leaderboard, changes, previousRanks))
+
+	s.afterCommit(ctx, AfterCommitMilestones, s.notifyMilestones(ctx, leaderboard, settings, modelMember, previousRanks))
+
 	return modelMember, nil
 }
 
-func (s *Service) incrementMember(ctx context.Context, leaderboard, member string, increment int) error {
-	return s.Database.IncrementMemberScore(ctx, leaderboard, member, float64(increment))
+// incrementMember increment the score of member, guarded by the score checked by ensureScoresAllowed and
+// counting its increase, filling it with the values of the write if idempotency is set. It returns the score change to record in
+// the ledger, with the scores the write itself found, and if the write is a duplicate of an idempotent one.
+// Members that did not exist are incremented from zero, so it is recorded as their old score
+func (s *Service) incrementMember(ctx context.Context, leaderboard string, member *model.Member, increment int, settings *model.LeaderboardSettings, checked *checkedScores, idempotency *database.Idempotency, expireAt time.Time) (*database.LedgerEntry, bool, error) {
+	storedIncrement := toStoredScore(settings, float64(increment))
+	databaseMember := &database.Member{Member: member.PublicID}
+	setMembersGuards([]*database.Member{databaseMember}, checked, settings)
+
+	duplicate := false
+	if idempotency == nil {
+		err := s.Database.IncrementMemberScore(ctx, leaderboard, databaseMember, storedIncrement)
+		if err != nil {
+			return nil, false, err
+		}
+	} else {
+		databaseMember.TTL = expireAt
+		var err error
+		duplicate, err = s.Database.IncrementMemberScoreIdempotent(ctx, leaderboard, databaseMember, storedIncrement, idempotency)
+		if err != nil {
+			return nil, false, err
+		}
+
+		setIdempotentMembersValues([]*model.Member{member}, []*database.Member{databaseMember}, false, settings)
+	}
+
+	oldScore := int64(0)
+	if databaseMember.PreviousScore != nil {
+		oldScore = *toLedgerScore(settings, databaseMember.PreviousScore)
+	}
+	return newScoreChange(member.PublicID, &oldScore, toLedgerScore(settings, &databaseMember.Score)), duplicate, nil
 }
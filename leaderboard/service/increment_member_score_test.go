@@ -36,7 +36,9 @@ var _ = Describe("Service IncrementMemberScore", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mock = database.NewMockDatabase(ctrl)
 
-		svc = &service.Service{mock}
+		svc = &service.Service{Database: mock}
+
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Any()).Return(map[string]string{}, nil).AnyTimes()
 	})
 
 	AfterEach(func() {
@@ -44,6 +46,7 @@ var _ = Describe("Service IncrementMemberScore", func() {
 	})
 
 	It("Should increment member score if all is ok", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 		expectedMember := &model.Member{
 			PublicID:     "member1",
 			Score:        2,
@@ -51,7 +54,7 @@ var _ = Describe("Service IncrementMemberScore", func() {
 			Rank:         2,
 		}
 
-		mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(float64(score))).Return(nil)
+		mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(&database.Member{Member: member}), gomock.Eq(float64(score))).Return(nil)
 
 		mock.EXPECT().GetMembers(
 			gomock.Any(),
@@ -60,15 +63,42 @@ var _ = Describe("Service IncrementMemberScore", func() {
 			gomock.Eq(true),
 			gomock.Eq(member),
 		).Return(databaseMembersReturned, nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
-		member, err := svc.IncrementMemberScore(context.Background(), leaderboard, member, score, scoreTTL)
+		member, err := svc.IncrementMemberScore(context.Background(), leaderboard, member, score, scoreTTL, nil)
 		Expect(err).NotTo(HaveOccurred())
 
 		Expect(member).To(Equal(expectedMember))
 	})
 
+	It("Should record in the ledger the scores found by the increment", func() {
+		previousScore := float64(5)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Eq(float64(10))).
+			DoAndReturn(func(ctx context.Context, leaderboard string, databaseMember *database.Member, increment float64) error {
+				databaseMember.Score = 15
+				databaseMember.PreviousScore = &previousScore
+				return nil
+			})
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(true), gomock.Eq(member)).Return([]*database.Member{
+			{Member: member, Score: 40, Rank: 0},
+		}, nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, leaderboard string, entries []*database.LedgerEntry, removeBefore, expireAt time.Time, maxEntries int) error {
+				Expect(entries).To(HaveLen(1))
+				Expect(*entries[0].OldScore).To(Equal(int64(5)))
+				Expect(*entries[0].NewScore).To(Equal(int64(15)))
+				Expect(entries[0].Delta).To(Equal(int64(10)))
+				return nil
+			})
+
+		_, err := svc.IncrementMemberScore(context.Background(), leaderboard, member, 10, scoreTTL, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
 	Describe("When scoreTTL is empty", func() {
 		It("Should IncrementMember without filling expire ordered set", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 			expectedMember := &model.Member{
 				PublicID:     "member1",
 				Score:        2,
@@ -76,7 +106,7 @@ var _ = Describe("Service IncrementMemberScore", func() {
 				Rank:         2,
 			}
 
-			mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(float64(score))).Return(nil)
+			mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(&database.Member{Member: member}), gomock.Eq(float64(score))).Return(nil)
 			mock.EXPECT().GetMembers(
 				gomock.Any(),
 				gomock.Eq(leaderboard),
@@ -84,8 +114,9 @@ var _ = Describe("Service IncrementMemberScore", func() {
 				gomock.Eq(true),
 				gomock.Eq(member),
 			).Return(databaseMembersReturned, nil)
+			mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
-			member, err := svc.IncrementMemberScore(context.Background(), leaderboard, member, score, scoreTTL)
+			member, err := svc.IncrementMemberScore(context.Background(), leaderboard, member, score, scoreTTL, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(member).To(Equal(expectedMember))
@@ -96,7 +127,8 @@ var _ = Describe("Service IncrementMemberScore", func() {
 		scoreTTL := "100"
 
 		It("Should IncrementMember filling expire ordered set", func() {
-			mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(float64(score))).Return(nil)
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+			mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(&database.Member{Member: member}), gomock.Eq(float64(score))).Return(nil)
 			mock.EXPECT().GetMembers(
 				gomock.Any(),
 				gomock.Eq(leaderboard),
@@ -106,8 +138,9 @@ var _ = Describe("Service IncrementMemberScore", func() {
 			).Return(databaseMembersReturned, nil)
 
 			mock.EXPECT().SetMembersTTL(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Times(1).Return(nil)
+			mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
-			member, err := svc.IncrementMemberScore(context.Background(), leaderboard, member, score, scoreTTL)
+			member, err := svc.IncrementMemberScore(context.Background(), leaderboard, member, score, scoreTTL, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(member.ExpireAt).To(BeNumerically("~", time.Now().Add(100*time.Second).Unix(), 100))
@@ -117,31 +150,25 @@ var _ = Describe("Service IncrementMemberScore", func() {
 	Describe("When scoreTTL is invalid", func() {
 		scoreTTL := "invalid"
 
-		It("Should return error", func() {
-			mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(float64(score))).Return(nil)
-			mock.EXPECT().GetMembers(
-				gomock.Any(),
-				gomock.Eq(leaderboard),
-				gomock.Eq("desc"),
-				gomock.Eq(true),
-				gomock.Eq(member),
-			).Return(databaseMembersReturned, nil)
-
-			_, err := svc.IncrementMemberScore(context.Background(), leaderboard, member, score, scoreTTL)
+		It("Should return error without writing", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+			_, err := svc.IncrementMemberScore(context.Background(), leaderboard, member, score, scoreTTL, nil)
 			Expect(err).To(MatchError(service.NewGeneralError("increment member score", "strconv.ParseInt: parsing \"invalid\": invalid syntax")))
 
 		})
 	})
 
 	It("Should return error if database SetMembers return in error", func() {
-		mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(float64(score))).Return(fmt.Errorf("New database error"))
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(&database.Member{Member: member}), gomock.Eq(float64(score))).Return(fmt.Errorf("New database error"))
 
-		_, err := svc.IncrementMemberScore(context.Background(), leaderboard, member, score, scoreTTL)
+		_, err := svc.IncrementMemberScore(context.Background(), leaderboard, member, score, scoreTTL, nil)
 		Expect(err).To(MatchError(service.NewGeneralError("increment member score", "New database error")))
 	})
 
 	It("Should return error if database GetMembers return in error", func() {
-		mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(float64(score))).Return(nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(&database.Member{Member: member}), gomock.Eq(float64(score))).Return(nil)
 		mock.EXPECT().GetMembers(
 			gomock.Any(),
 			gomock.Eq(leaderboard),
@@ -150,12 +177,13 @@ var _ = Describe("Service IncrementMemberScore", func() {
 			gomock.Eq(member),
 		).Return(nil, fmt.Errorf("New database error"))
 
-		_, err := svc.IncrementMemberScore(context.Background(), leaderboard, member, score, scoreTTL)
+		_, err := svc.IncrementMemberScore(context.Background(), leaderboard, member, score, scoreTTL, nil)
 		Expect(err).To(MatchError(service.NewGeneralError("increment member score", "New database error")))
 
 	})
 
 	It("Should not call database GetLeaderboardExpiration and SetLeaderboardExpiration if leaderboard isn't formatted to have an expiration", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 		expectedMember := &model.Member{
 			PublicID:     "member1",
 			Score:        2,
@@ -163,7 +191,7 @@ var _ = Describe("Service IncrementMemberScore", func() {
 			Rank:         2,
 		}
 
-		mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(float64(score))).Return(nil)
+		mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(&database.Member{Member: member}), gomock.Eq(float64(score))).Return(nil)
 		mock.EXPECT().GetMembers(
 			gomock.Any(),
 			gomock.Eq(leaderboard),
@@ -171,19 +199,21 @@ var _ = Describe("Service IncrementMemberScore", func() {
 			gomock.Eq(true),
 			gomock.Eq(member),
 		).Return(databaseMembersReturned, nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
-		member, err := svc.IncrementMemberScore(context.Background(), leaderboard, member, score, scoreTTL)
+		member, err := svc.IncrementMemberScore(context.Background(), leaderboard, member, score, scoreTTL, nil)
 		Expect(err).NotTo(HaveOccurred())
 
 		Expect(member).To(Equal(expectedMember))
 	})
 
 	It("Should set leaderboard expiration if GetLeaderboardExpiration return TTLNotFoundError", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 		leaderboardExpiration := fmt.Sprintf("year%d", time.Now().UTC().Year())
 		expireAt, err := expiration.GetExpireAt(leaderboardExpiration)
 		Expect(err).NotTo(HaveOccurred())
 
-		mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboardExpiration), gomock.Eq(member), gomock.Eq(float64(score))).Times(1).Return(nil)
+		mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboardExpiration), gomock.Eq(&database.Member{Member: member}), gomock.Eq(float64(score))).Times(1).Return(nil)
 		mock.EXPECT().GetMembers(
 			gomock.Any(),
 			gomock.Eq(leaderboardExpiration),
@@ -194,18 +224,20 @@ var _ = Describe("Service IncrementMemberScore", func() {
 		mock.EXPECT().GetLeaderboardExpiration(gomock.Any(), gomock.Eq(leaderboardExpiration)).Return(int64(-1), database.NewTTLNotFoundError(leaderboard))
 
 		mock.EXPECT().SetLeaderboardExpiration(gomock.Any(), gomock.Eq(leaderboardExpiration), time.Unix(expireAt, 0)).Return(nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboardExpiration), gomock.Any(), gomock.Any(), gomock.Eq(time.Unix(expireAt, 0)), gomock.Any()).Return(nil)
 
-		_, err = svc.IncrementMemberScore(context.Background(), leaderboardExpiration, member, score, scoreTTL)
+		_, err = svc.IncrementMemberScore(context.Background(), leaderboardExpiration, member, score, scoreTTL, nil)
 		Expect(err).NotTo(HaveOccurred())
 	})
 
 	It("Should return error LeaderboardExpiredError if leaderboard key is formatted and have an expired time", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 		leaderboardExpiration := fmt.Sprintf(
 			"testkey-from%dto%d",
 			time.Now().UTC().Add(time.Duration(-2)*time.Second).Unix(),
 			time.Now().UTC().Add(time.Duration(-1)*time.Second).Unix(),
 		)
-		mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboardExpiration), gomock.Eq(member), gomock.Eq(float64(score))).Times(1).Return(nil)
+		mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboardExpiration), gomock.Eq(&database.Member{Member: member}), gomock.Eq(float64(score))).Times(1).Return(nil)
 		mock.EXPECT().GetMembers(
 			gomock.Any(),
 			gomock.Eq(leaderboardExpiration),
@@ -214,14 +246,15 @@ var _ = Describe("Service IncrementMemberScore", func() {
 			gomock.Eq(member),
 		).Return(databaseMembersReturned, nil)
 
-		_, err := svc.IncrementMemberScore(context.Background(), leaderboardExpiration, member, score, scoreTTL)
+		_, err := svc.IncrementMemberScore(context.Background(), leaderboardExpiration, member, score, scoreTTL, nil)
 		Expect(err).To(MatchError(service.NewLeaderboardExpiredError(leaderboardExpiration)))
 	})
 
 	It("Should return error if database GetLeaderboardExpiration return in error", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 		leaderboardExpiration := fmt.Sprintf("year%d", time.Now().UTC().Year())
 
-		mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboardExpiration), gomock.Eq(member), gomock.Eq(float64(score))).Times(1).Return(nil)
+		mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboardExpiration), gomock.Eq(&database.Member{Member: member}), gomock.Eq(float64(score))).Times(1).Return(nil)
 		mock.EXPECT().GetMembers(
 			gomock.Any(),
 			gomock.Eq(leaderboardExpiration),
@@ -231,16 +264,86 @@ var _ = Describe("Service IncrementMemberScore", func() {
 		).Return(databaseMembersReturned, nil)
 		mock.EXPECT().GetLeaderboardExpiration(gomock.Any(), gomock.Eq(leaderboardExpiration)).Return(int64(-1), fmt.Errorf("New database error"))
 
-		_, err := svc.IncrementMemberScore(context.Background(), leaderboardExpiration, member, score, scoreTTL)
+		_, err := svc.IncrementMemberScore(context.Background(), leaderboardExpiration, member, score, scoreTTL, nil)
 		Expect(err).To(MatchError(service.NewGeneralError("increment member score", "New database error")))
 	})
 
+	Describe("When idempotency key is set", func() {
+		idempotency := &model.IdempotencyKey{Key: "request1", Window: time.Hour}
+
+		fillMember := func(duplicate bool) func(context.Context, string, *database.Member, float64, *database.Idempotency) (bool, error) {
+			return func(ctx context.Context, leaderboard string, databaseMember *database.Member, increment float64, idempotency *database.Idempotency) (bool, error) {
+				databaseMember.Score = 2
+				databaseMember.Rank = 1
+				databaseMember.Version = 2
+				return duplicate, nil
+			}
+		}
+
+		It("Should increment member score with the values of the write", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+			expectedMember := &model.Member{
+				PublicID:     "member1",
+				Score:        2,
+				PreviousRank: 0,
+				Rank:         2,
+				Version:      2,
+			}
+
+			mock.EXPECT().IncrementMemberScoreIdempotent(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(&database.Member{Member: member}), gomock.Eq(float64(score)), gomock.Any()).
+				DoAndReturn(fillMember(false))
+			mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+			member, err := svc.IncrementMemberScore(context.Background(), leaderboard, member, score, scoreTTL, idempotency)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(member).To(Equal(expectedMember))
+		})
+
+		It("Should not increment member score again if idempotency key was already used", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+			expectedMember := &model.Member{
+				PublicID:     "member1",
+				Score:        2,
+				PreviousRank: 0,
+				Rank:         2,
+				Version:      2,
+			}
+
+			mock.EXPECT().IncrementMemberScoreIdempotent(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Eq(float64(score)), gomock.Any()).
+				DoAndReturn(fillMember(true))
+
+			member, err := svc.IncrementMemberScore(context.Background(), leaderboard, member, score, scoreTTL, idempotency)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(member).To(Equal(expectedMember))
+		})
+
+		It("Should return IdempotencyKeyReusedError if idempotency key was used by a different write", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+			mock.EXPECT().IncrementMemberScoreIdempotent(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(false, database.NewIdempotencyKeyReusedError(leaderboard, "request1"))
+
+			_, err := svc.IncrementMemberScore(context.Background(), leaderboard, member, score, scoreTTL, idempotency)
+			Expect(err).To(Equal(service.NewIdempotencyKeyReusedError(leaderboard, "request1")))
+		})
+
+		It("Should return error if database IncrementMemberScoreIdempotent return in error", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+			mock.EXPECT().IncrementMemberScoreIdempotent(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, fmt.Errorf("New database error"))
+
+			_, err := svc.IncrementMemberScore(context.Background(), leaderboard, member, score, scoreTTL, idempotency)
+			Expect(err).To(MatchError(service.NewGeneralError("increment member score", "New database error")))
+		})
+	})
+
 	It("Should return error if database SetLeaderboardExpiration return in error", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 		leaderboardExpiration := fmt.Sprintf("year%d", time.Now().UTC().Year())
 		expireAt, err := expiration.GetExpireAt(leaderboardExpiration)
 		Expect(err).NotTo(HaveOccurred())
 
-		mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboardExpiration), gomock.Eq(member), gomock.Eq(float64(score))).Times(1).Return(nil)
+		mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboardExpiration), gomock.Eq(&database.Member{Member: member}), gomock.Eq(float64(score))).Times(1).Return(nil)
 		mock.EXPECT().GetMembers(
 			gomock.Any(),
 			gomock.Eq(leaderboardExpiration),
@@ -252,7 +355,7 @@ var _ = Describe("Service IncrementMemberScore", func() {
 
 		mock.EXPECT().SetLeaderboardExpiration(gomock.Any(), gomock.Eq(leaderboardExpiration), time.Unix(expireAt, 0)).Return(fmt.Errorf("New database error"))
 
-		_, err = svc.IncrementMemberScore(context.Background(), leaderboardExpiration, member, score, scoreTTL)
+		_, err = svc.IncrementMemberScore(context.Background(), leaderboardExpiration, member, score, scoreTTL, nil)
 		Expect(err).To(MatchError(service.NewGeneralError("increment member score", "New database error")))
 	})
 
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/topfreegames/podium/leaderboard/v2/model"
 )
@@ -10,9 +11,10 @@ import (
 type Leaderboard interface {
 	Healthcheck(ctx context.Context) error
 
-	IncrementMemberScore(ctx context.Context, leaderboard string, member string, increment int, scoreTTL string) (*model.Member, error)
-	SetMemberScore(ctx context.Context, leaderboard, member string, score int64, prevRank bool, scoreTTL string) (*model.Member, error)
-	SetMembersScore(ctx context.Context, leaderboard string, members []*model.Member, prevRank bool, scoreTTL string) error
+	IncrementMemberScore(ctx context.Context, leaderboard string, member string, increment int, scoreTTL string, idempotency *model.IdempotencyKey) (*model.Member, error)
+	SetMemberScore(ctx context.Context, leaderboard, member string, score int64, prevRank bool, scoreTTL string, idempotency *model.IdempotencyKey, condition *model.ScoreCondition) (*model.Member, error)
+	SetMembersScore(ctx context.Context, leaderboard string, members []*model.Member, prevRank bool, scoreTTL string, idempotency *model.IdempotencyKey) error
+	ImportMembers(ctx context.Context, leaderboard string, replace bool, next func() ([]*model.Member, error)) (*model.ImportResult, error)
 
 	RemoveLeaderboard(ctx context.Context, leaderboard string) error
 	RemoveMember(ctx context.Context, leaderboard, member string) error
@@ -32,4 +34,39 @@ type Leaderboard interface {
 	GetAroundMe(ctx context.Context, leaderboard string, pageSize int, member string, order string, getLastIfNotFound bool) ([]*model.Member, error)
 
 	GetAroundScore(ctx context.Context, leaderboard string, pageSize int, score int64, order string) ([]*model.Member, error)
+
+	ResetLeaderboard(ctx context.Context, leaderboard string, options *model.ResetOptions) (*model.ResetProgress, error)
+	GetResetProgress(ctx context.Context, leaderboard string) (*model.ResetProgress, error)
+
+	GetLeaderboardSettings(ctx context.Context, leaderboard string) (*model.LeaderboardSettings, error)
+	UpdateLeaderboardSettings(ctx context.Context, leaderboard string, settings *model.LeaderboardSettings) (*model.LeaderboardSettings, error)
+	RenormalizeLeaderboard(ctx context.Context, leaderboard string, minHalfLives float64) (bool, error)
+	TakeRankSnapshot(ctx context.Context, leaderboard string) (bool, error)
+	GetRankMovers(ctx context.Context, leaderboard string, from, to int64, limit int, order string) (*model.RankMovers, error)
+	DiffLeaderboard(ctx context.Context, leaderboard string, from, to int64, order string, emit func(*model.MemberDiff) error) error
+	ExportLeaderboard(ctx context.Context, leaderboard, order string, chunkSize int, emit func([]*model.Member) error) error
+	FreezeLeaderboard(ctx context.Context, leaderboard string) (*model.LeaderboardSettings, error)
+	UnfreezeLeaderboard(ctx context.Context, leaderboard string) (*model.LeaderboardSettings, error)
+	GetRejectedScores(ctx context.Context, leaderboard string, pageSize, page int) ([]*model.RejectedScore, error)
+	UseNonce(ctx context.Context, leaderboard, nonce string, expiration time.Duration) error
+	TakeWriteTokens(ctx context.Context, caller string, members map[string][]string, limits *model.RateLimits) error
+	BlockMember(ctx context.Context, leaderboard, member, mode string) error
+	UnblockMember(ctx context.Context, leaderboard, member string) error
+	ApplyGlobalBlock(ctx context.Context, member string) error
+	GetMemberLedger(ctx context.Context, leaderboard, member string, since int64, pageSize, page int) ([]*model.LedgerEntry, error)
+	RollbackMember(ctx context.Context, leaderboard, member string, since int64) (*model.LedgerEntry, error)
+	GetMemberHistory(ctx context.Context, leaderboard, member string, from, to int64) ([]*model.HistorySample, error)
+
+	CreateLeague(ctx context.Context, league *model.League) (*model.League, error)
+	GetLeague(ctx context.Context, league string) (*model.League, error)
+	JoinLeague(ctx context.Context, league, member string, tier int) (*model.LeagueDivision, error)
+	GetLeagueDivision(ctx context.Context, league, member string) (*model.LeagueDivision, error)
+	EndLeagueSeason(ctx context.Context, league string, season int) (*model.LeagueSeasonResult, error)
+
+	CreateTournament(ctx context.Context, tournament *model.Tournament) (*model.Tournament, error)
+	GetTournament(ctx context.Context, tournament string) (*model.Tournament, error)
+	JoinTournament(ctx context.Context, tournament, member string) (*model.Tournament, error)
+	FinalizeTournament(ctx context.Context, tournament string) (*model.TournamentResult, error)
+
+	GetWebhookDeliveries(ctx context.Context, pageSize, page int) ([]*model.WebhookDelivery, error)
 }
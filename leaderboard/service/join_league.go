@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const joinLeagueServiceLabel = "join league"
+
+// JoinLeague place member in a division of tier in the current league season, tier zero being the lowest tier.
+// Members that already joined the season keep their division
+func (s *Service) JoinLeague(ctx context.Context, league, member string, tier int) (*model.LeagueDivision, error) {
+	config, err := s.getLeague(ctx, league)
+	if err != nil {
+		if _, ok := err.(*LeagueNotFoundError); ok {
+			return nil, err
+		}
+		return nil, NewGeneralError(joinLeagueServiceLabel, err.Error())
+	}
+
+	if tier == 0 {
+		tier = config.Tiers
+	}
+	if tier < 1 || tier > config.Tiers {
+		return nil, NewInvalidLeagueError(fmt.Sprintf("tier %d must be between 1 and %d", tier, config.Tiers))
+	}
+
+	divisions, err := s.Database.JoinLeagueDivisions(ctx, league, config.Season, tier, config.DivisionSize, member)
+	if err != nil {
+		return nil, NewGeneralError(joinLeagueServiceLabel, err.Error())
+	}
+
+	return newLeagueDivision(config, divisions[0]), nil
+}
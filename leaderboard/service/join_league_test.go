@@ -0,0 +1,89 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service JoinLeague", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var league string = "leagueTest"
+	var member string = "member"
+	var databaseLeague *database.League = &database.League{
+		Season:       2,
+		Tiers:        3,
+		DivisionSize: 50,
+		Order:        "desc",
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should place member in lowest tier if tier is zero", func() {
+		mock.EXPECT().GetLeague(gomock.Any(), gomock.Eq(league)).Return(databaseLeague, nil)
+		mock.EXPECT().JoinLeagueDivisions(gomock.Any(), gomock.Eq(league), gomock.Eq(2), gomock.Eq(3), gomock.Eq(50), gomock.Eq(member)).Return([]*database.LeagueDivision{
+			{Tier: 3, Division: 4},
+		}, nil)
+
+		division, err := svc.JoinLeague(context.Background(), league, member, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(division).To(Equal(&model.LeagueDivision{
+			League:      league,
+			Season:      2,
+			Tier:        3,
+			Division:    4,
+			Leaderboard: "{leagueTest}:s2:t3:d4",
+		}))
+	})
+
+	It("Should place member in requested tier", func() {
+		mock.EXPECT().GetLeague(gomock.Any(), gomock.Eq(league)).Return(databaseLeague, nil)
+		mock.EXPECT().JoinLeagueDivisions(gomock.Any(), gomock.Eq(league), gomock.Eq(2), gomock.Eq(1), gomock.Eq(50), gomock.Eq(member)).Return([]*database.LeagueDivision{
+			{Tier: 1, Division: 1},
+		}, nil)
+
+		division, err := svc.JoinLeague(context.Background(), league, member, 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(division.Leaderboard).To(Equal("{leagueTest}:s2:t1:d1"))
+	})
+
+	It("Should return InvalidLeagueError if tier does not exist", func() {
+		mock.EXPECT().GetLeague(gomock.Any(), gomock.Eq(league)).Return(databaseLeague, nil)
+
+		_, err := svc.JoinLeague(context.Background(), league, member, 4)
+		Expect(err).To(Equal(service.NewInvalidLeagueError("tier 4 must be between 1 and 3")))
+	})
+
+	It("Should return LeagueNotFoundError if league does not exist", func() {
+		mock.EXPECT().GetLeague(gomock.Any(), gomock.Eq(league)).Return(nil, database.NewLeagueNotFoundError(league))
+
+		_, err := svc.JoinLeague(context.Background(), league, member, 0)
+		Expect(err).To(Equal(service.NewLeagueNotFoundError(league)))
+	})
+
+	It("Should return error if database return in error on JoinLeagueDivisions", func() {
+		mock.EXPECT().GetLeague(gomock.Any(), gomock.Eq(league)).Return(databaseLeague, nil)
+		mock.EXPECT().JoinLeagueDivisions(gomock.Any(), gomock.Eq(league), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("Database error example"))
+
+		_, err := svc.JoinLeague(context.Background(), league, member, 0)
+		Expect(err).To(Equal(service.NewGeneralError("join league", "Database error example")))
+	})
+})
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const joinTournamentServiceLabel = "join tournament"
+
+// JoinTournament add member to tournament participants, members can join before the tournament starts
+// but not after it ends
+func (s *Service) JoinTournament(ctx context.Context, tournament, member string) (*model.Tournament, error) {
+	config, err := s.getTournament(ctx, tournament)
+	if err != nil {
+		if _, ok := err.(*TournamentNotFoundError); ok {
+			return nil, err
+		}
+		return nil, NewGeneralError(joinTournamentServiceLabel, err.Error())
+	}
+
+	if config.Status == model.TournamentEnded || config.Status == model.TournamentFinalized {
+		return nil, NewLeaderboardClosedError(tournament)
+	}
+
+	err = s.Database.AddLeaderboardParticipants(ctx, tournament, time.Now(), member)
+	if err != nil {
+		return nil, NewGeneralError(joinTournamentServiceLabel, err.Error())
+	}
+
+	return config, nil
+}
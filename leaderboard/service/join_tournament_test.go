@@ -0,0 +1,74 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service JoinTournament", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var tournament string = "tournamentTest"
+	var member string = "memberTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should add member to participants if tournament did not end", func() {
+		mock.EXPECT().GetTournament(gomock.Any(), gomock.Eq(tournament)).Return(&database.Tournament{
+			StartAt: time.Now().Add(time.Hour),
+			EndAt:   time.Now().Add(2 * time.Hour),
+		}, nil)
+		mock.EXPECT().AddLeaderboardParticipants(gomock.Any(), gomock.Eq(tournament), gomock.Any(), gomock.Eq(member)).Return(nil)
+
+		config, err := svc.JoinTournament(context.Background(), tournament, member)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Status).To(Equal(model.TournamentScheduled))
+	})
+
+	It("Should return LeaderboardClosedError if tournament ended", func() {
+		mock.EXPECT().GetTournament(gomock.Any(), gomock.Eq(tournament)).Return(&database.Tournament{
+			StartAt: time.Unix(1600000000, 0),
+			EndAt:   time.Unix(1600003600, 0),
+		}, nil)
+
+		_, err := svc.JoinTournament(context.Background(), tournament, member)
+		Expect(err).To(Equal(service.NewLeaderboardClosedError(tournament)))
+	})
+
+	It("Should return TournamentNotFoundError if tournament does not exist", func() {
+		mock.EXPECT().GetTournament(gomock.Any(), gomock.Eq(tournament)).Return(nil, database.NewTournamentNotFoundError(tournament))
+
+		_, err := svc.JoinTournament(context.Background(), tournament, member)
+		Expect(err).To(Equal(service.NewTournamentNotFoundError(tournament)))
+	})
+
+	It("Should return error if database return in error on AddLeaderboardParticipants", func() {
+		mock.EXPECT().GetTournament(gomock.Any(), gomock.Eq(tournament)).Return(&database.Tournament{
+			StartAt: time.Now().Add(-time.Hour),
+			EndAt:   time.Now().Add(time.Hour),
+		}, nil)
+		mock.EXPECT().AddLeaderboardParticipants(gomock.Any(), gomock.Eq(tournament), gomock.Any(), gomock.Eq(member)).Return(fmt.Errorf("Database error example"))
+
+		_, err := svc.JoinTournament(context.Background(), tournament, member)
+		Expect(err).To(Equal(service.NewGeneralError("join tournament", "Database error example")))
+	})
+})
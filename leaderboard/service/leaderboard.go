@@ -33,3 +33,21 @@ func (s *Service) persistLeaderboardExpirationTime(ctx context.Context, leaderbo
 
 	return nil
 }
+
+// getLeaderboardExpireAt return when leaderboard expires, zero if it does not, and if it already expired.
+// Data kept about members of leaderboard, like their ledgers, expires together with it
+func getLeaderboardExpireAt(leaderboard string) (time.Time, bool, error) {
+	expireAt, err := expiration.GetExpireAt(leaderboard)
+	if err != nil {
+		if _, ok := err.(*expiration.LeaderboardExpiredError); ok {
+			return time.Time{}, true, nil
+		}
+		return time.Time{}, false, err
+	}
+
+	if expireAt == -1 {
+		return time.Time{}, false, nil
+	}
+
+	return time.Unix(expireAt, 0), false, nil
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// Leagues are stored as a configuration hash plus, for each season, one leaderboard per division
+// named after league, season, tier and division. Members join the last division of a tier until it
+// is full, so divisions of a tier are filled one at a time.
+
+func (s *Service) getLeague(ctx context.Context, league string) (*model.League, error) {
+	databaseLeague, err := s.Database.GetLeague(ctx, league)
+	if err != nil {
+		if _, ok := err.(*database.LeagueNotFoundError); ok {
+			return nil, NewLeagueNotFoundError(league)
+		}
+		return nil, err
+	}
+
+	return &model.League{
+		ID:              league,
+		Season:          databaseLeague.Season,
+		Tiers:           databaseLeague.Tiers,
+		DivisionSize:    databaseLeague.DivisionSize,
+		PromotionCount:  databaseLeague.PromotionCount,
+		RelegationCount: databaseLeague.RelegationCount,
+		Order:           databaseLeague.Order,
+	}, nil
+}
+
+func (s *Service) setLeague(ctx context.Context, league *model.League) error {
+	return s.Database.SetLeague(ctx, league.ID, &database.League{
+		Season:          league.Season,
+		Tiers:           league.Tiers,
+		DivisionSize:    league.DivisionSize,
+		PromotionCount:  league.PromotionCount,
+		RelegationCount: league.RelegationCount,
+		Order:           league.Order,
+	})
+}
+
+func newLeagueDivision(league *model.League, division *database.LeagueDivision) *model.LeagueDivision {
+	return &model.LeagueDivision{
+		League:      league.ID,
+		Season:      league.Season,
+		Tier:        division.Tier,
+		Division:    division.Division,
+		Leaderboard: database.LeagueDivisionLeaderboard(league.ID, league.Season, division.Tier, division.Division),
+	}
+}
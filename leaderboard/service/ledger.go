@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// Every change of a member score made by a write is recorded in the ledger of the leaderboard with the
+// reason and caller of the context. Ledgers expire together with their leaderboard and are trimmed by
+// the ledger retention and max entries of leaderboard settings as changes are recorded. Writes to shadow
+// leaderboards of blocked members are not recorded.
+
+type scoreChangeOriginKey struct{}
+
+type scoreChangeOrigin struct {
+	reason string
+	caller string
+}
+
+// WithScoreChangeOrigin return a copy of ctx in which score changes are recorded in the ledger with reason and caller
+func WithScoreChangeOrigin(ctx context.Context, reason, caller string) context.Context {
+	return context.WithValue(ctx, scoreChangeOriginKey{}, &scoreChangeOrigin{reason: reason, caller: caller})
+}
+
+func getScoreChangeOrigin(ctx context.Context) *scoreChangeOrigin {
+	origin, ok := ctx.Value(scoreChangeOriginKey{}).(*scoreChangeOrigin)
+	if !ok {
+		return &scoreChangeOrigin{}
+	}
+
+	return origin
+}
+
+func newScoreChange(member string, oldScore, newScore *int64) *database.LedgerEntry {
+	change := &database.LedgerEntry{
+		Member:   member,
+		OldScore: oldScore,
+		NewScore: newScore,
+	}
+	if newScore != nil {
+		change.Delta += *newScore
+	}
+	if oldScore != nil {
+		change.Delta -= *oldScore
+	}
+
+	return change
+}
+
+func toLedgerScore(settings *model.LeaderboardSettings, storedScore *float64) *int64 {
+	if storedScore == nil {
+		return nil
+	}
+
+	score := toDecayedScore(settings, *storedScore)
+	return &score
+}
+
+func (s *Service) recordScoreChanges(ctx context.Context, leaderboard string, settings *model.LeaderboardSettings, changes []*database.LedgerEntry) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	expireAt, expired, err := getLeaderboardExpireAt(leaderboard)
+	if err != nil || expired {
+		return err
+	}
+
+	origin := getScoreChangeOrigin(ctx)
+	changedAt := time.Now()
+	for _, change := range changes {
+		change.Reason = origin.reason
+		change.Caller = origin.caller
+		change.ChangedAt = changedAt
+	}
+
+	var removeBefore time.Time
+	if settings.LedgerRetention > 0 {
+		removeBefore = changedAt.Add(-time.Duration(settings.LedgerRetention) * time.Second)
+	}
+
+	return s.Database.AddLedgerEntries(ctx, leaderboard, changes, removeBefore, expireAt, int(settings.LedgerMaxEntries))
+}
+
+func convertDatabaseLedgerEntryIntoModelLedgerEntry(leaderboard string, entry *database.LedgerEntry) *model.LedgerEntry {
+	return &model.LedgerEntry{
+		Leaderboard: leaderboard,
+		PublicID:    entry.Member,
+		OldScore:    entry.OldScore,
+		NewScore:    entry.NewScore,
+		Delta:       entry.Delta,
+		Reason:      entry.Reason,
+		Caller:      entry.Caller,
+		ChangedAt:   entry.ChangedAt.Unix(),
+	}
+}
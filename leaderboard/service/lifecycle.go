@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// Leaderboards are created implicitly by their first write, so while lifecycle events are enabled each write
+// marks its leaderboard as created and sends LifecycleLeaderboardCreated when the mark was not set yet.
+// Leaderboards with an expiration in their name are listed to the expiration worker when marked, which sends
+// LifecycleLeaderboardExpired once they expire. Leaderboards written before lifecycle events were enabled are
+// only notified as created by their next write.
+
+// notifyLifecycle send event to the lifecycle notifier filling its ID and when it occurred
+func (s *Service) notifyLifecycle(ctx context.Context, event *model.LifecycleEvent) error {
+	if s.LifecycleNotifier == nil {
+		return nil
+	}
+
+	event.ID = uuid.NewV4().String()
+	event.OccurredAt = time.Now().Unix()
+	return s.LifecycleNotifier.Notify(ctx, []*model.LifecycleEvent{event})
+}
+
+// notifyLeaderboardCreated mark leaderboard as created sending LifecycleLeaderboardCreated if it was not yet
+func (s *Service) notifyLeaderboardCreated(ctx context.Context, leaderboard string) error {
+	if s.LifecycleNotifier == nil {
+		return nil
+	}
+
+	expireAt, expired, err := getLeaderboardExpireAt(leaderboard)
+	if err != nil {
+		return err
+	}
+	if expired {
+		return nil
+	}
+
+	created, err := s.Database.MarkLeaderboardCreated(ctx, leaderboard, expireAt)
+	if err != nil {
+		return err
+	}
+	if !created {
+		return nil
+	}
+
+	return s.notifyLifecycle(ctx, &model.LifecycleEvent{
+		Type:        model.LifecycleLeaderboardCreated,
+		Leaderboard: leaderboard,
+	})
+}
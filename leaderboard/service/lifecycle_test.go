@@ -0,0 +1,137 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/lifecycle"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service Lifecycle", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var notifier *lifecycle.MockNotifier
+	var svc *service.Service
+
+	var leaderboard string = "leaderboard"
+	var member string = "member1"
+
+	expectWrite := func(created bool) {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(true), gomock.Eq(member)).
+			Return([]*database.Member{{Member: member, Score: 20, Rank: 0}}, nil)
+		mock.EXPECT().MarkLeaderboardCreated(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(time.Time{})).Return(created, nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+		notifier = lifecycle.NewMockNotifier(ctrl)
+
+		svc = &service.Service{Database: mock, LifecycleNotifier: notifier}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should notify a leaderboard is created by its first write", func() {
+		expectWrite(true)
+		notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, events []*model.LifecycleEvent) error {
+				Expect(events).To(HaveLen(1))
+				Expect(events[0].ID).NotTo(BeEmpty())
+				Expect(events[0].Type).To(Equal(model.LifecycleLeaderboardCreated))
+				Expect(events[0].Leaderboard).To(Equal(leaderboard))
+				Expect(events[0].OccurredAt).NotTo(BeZero())
+				return nil
+			},
+		)
+
+		_, err := svc.SetMemberScore(context.Background(), leaderboard, member, 20, false, "", nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should not notify a leaderboard already created", func() {
+		expectWrite(false)
+
+		_, err := svc.SetMemberScore(context.Background(), leaderboard, member, 20, false, "", nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should notify a leaderboard is removed", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().RemoveLeaderboard(gomock.Any(), gomock.Eq(leaderboard)).Return(nil)
+		notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, events []*model.LifecycleEvent) error {
+				Expect(events).To(HaveLen(1))
+				Expect(events[0].Type).To(Equal(model.LifecycleLeaderboardRemoved))
+				Expect(events[0].Leaderboard).To(Equal(leaderboard))
+				return nil
+			},
+		)
+
+		err := svc.RemoveLeaderboard(context.Background(), leaderboard)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should notify a leaderboard is frozen only if it was not frozen", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().SetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(nil)
+		notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, events []*model.LifecycleEvent) error {
+				Expect(events).To(HaveLen(1))
+				Expect(events[0].Type).To(Equal(model.LifecycleLeaderboardFrozen))
+				return nil
+			},
+		)
+
+		_, err := svc.FreezeLeaderboard(context.Background(), leaderboard)
+		Expect(err).NotTo(HaveOccurred())
+
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{"frozen": "true"}, nil)
+
+		_, err = svc.FreezeLeaderboard(context.Background(), leaderboard)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should notify the leaderboard of a finalized tournament is frozen", func() {
+		mock.EXPECT().GetTournament(gomock.Any(), gomock.Eq(leaderboard)).Return(&database.Tournament{
+			StartAt: time.Unix(1600000000, 0),
+			EndAt:   time.Unix(1600003600, 0),
+			Order:   "desc",
+		}, nil)
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{"participantsOnly": "true"}, nil)
+		mock.EXPECT().SetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(nil)
+		notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, events []*model.LifecycleEvent) error {
+				Expect(events).To(HaveLen(1))
+				Expect(events[0].Type).To(Equal(model.LifecycleLeaderboardFrozen))
+				Expect(events[0].Leaderboard).To(Equal(leaderboard))
+				return nil
+			},
+		)
+		mock.EXPECT().SetTournament(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(nil)
+
+		_, err := svc.FinalizeTournament(context.Background(), leaderboard)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should return error if notifier return in error", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().RemoveLeaderboard(gomock.Any(), gomock.Eq(leaderboard)).Return(nil)
+		notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).Return(lifecycle.NewNotifierClosedError())
+
+		err := svc.RemoveLeaderboard(context.Background(), leaderboard)
+		Expect(err).To(Equal(service.NewGeneralError("remove leaderboard", "lifecycle notifier is closed")))
+	})
+})
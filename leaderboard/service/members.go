@@ -9,20 +9,24 @@ import (
 	"github.com/topfreegames/podium/leaderboard/v2/model"
 )
 
-func convertDatabaseMembersIntoModelMembers(databaseMembers []*database.Member) []*model.Member {
+// scoreConditionTolerance is how far a stored score can be from the expected one, as scores
+// returned are rounded to integers
+const scoreConditionTolerance = 0.5
+
+func convertDatabaseMembersIntoModelMembers(databaseMembers []*database.Member, settings *model.LeaderboardSettings) []*model.Member {
 	members := make([]*model.Member, 0, len(databaseMembers))
 	for _, member := range databaseMembers {
-		modelMember := convertDatabaseMemberIntoModelMember(member)
+		modelMember := convertDatabaseMemberIntoModelMember(member, settings)
 		members = append(members, modelMember)
 	}
 
 	return members
 }
 
-func convertDatabaseMemberIntoModelMember(member *database.Member) *model.Member {
+func convertDatabaseMemberIntoModelMember(member *database.Member, settings *model.LeaderboardSettings) *model.Member {
 	return &model.Member{
 		PublicID: member.Member,
-		Score:    int64(member.Score),
+		Score:    toDecayedScore(settings, member.Score),
 		Rank:     int(member.Rank + 1),
 	}
 }
@@ -65,24 +69,93 @@ func (s *Service) setMembersPreviousRank(ctx context.Context, leaderboard string
 	return nil
 }
 
-func (s *Service) persistMembers(ctx context.Context, leaderboard string, members []*model.Member) error {
+// persistMembers write members scores, expiring at expireAt if it is set, guarded by the scores checked by
+// ensureScoresAllowed and counting their increases, returning if the write is a duplicate of an idempotent one and the score changes to record
+// in the ledger. Members of idempotent writes are filled with the values of the write, their previous ranks only
+// if prevRank is set
+func (s *Service) persistMembers(ctx context.Context, leaderboard string, members []*model.Member, settings *model.LeaderboardSettings, checked *checkedScores, idempotency *database.Idempotency, prevRank bool, expireAt time.Time) (bool, []*database.LedgerEntry, error) {
 	databaseMembers := make([]*database.Member, 0, len(members))
 	for _, member := range members {
 		databaseMembers = append(databaseMembers, &database.Member{
 			Member: member.PublicID,
-			Score:  float64(member.Score),
+			Score:  toStoredScore(settings, float64(member.Score)),
 		})
 	}
+	setMembersGuards(databaseMembers, checked, settings)
 
-	err := s.Database.SetMembers(ctx, leaderboard, databaseMembers)
-	if err != nil {
-		return err
+	if idempotency != nil {
+		for _, member := range databaseMembers {
+			member.TTL = expireAt
+		}
+
+		duplicate, err := s.Database.SetMembersIdempotent(ctx, leaderboard, databaseMembers, idempotency)
+		if err != nil {
+			return false, nil, err
+		}
+
+		setIdempotentMembersValues(members, databaseMembers, prevRank, settings)
+		if duplicate {
+			return true, nil, nil
+		}
+	} else {
+		err := s.Database.SetMembers(ctx, leaderboard, databaseMembers)
+		if err != nil {
+			return false, nil, err
+		}
 	}
 
-	return nil
+	changes := make([]*database.LedgerEntry, 0, len(members))
+	for i, member := range databaseMembers {
+		members[i].Version = member.Version
+		newScore := members[i].Score
+		changes = append(changes, newScoreChange(member.Member, toLedgerScore(settings, member.PreviousScore), &newScore))
+	}
+
+	return false, changes, nil
+}
+
+// persistMemberIfMatch write member score if it matches condition, expiring at expireAt if it is set, guarded
+// by the score checked by ensureScoresAllowed and counting its increase, the same way persistMembers does
+func (s *Service) persistMemberIfMatch(ctx context.Context, leaderboard string, member *model.Member, settings *model.LeaderboardSettings, checked *checkedScores, condition *model.ScoreCondition, idempotency *database.Idempotency, prevRank bool, expireAt time.Time) (bool, *database.LedgerEntry, error) {
+	databaseMember := &database.Member{
+		Member: member.PublicID,
+		Score:  toStoredScore(settings, float64(member.Score)),
+	}
+	setMembersGuards([]*database.Member{databaseMember}, checked, settings)
+
+	databaseCondition := &database.Condition{
+		Tolerance: toStoredScore(settings, scoreConditionTolerance),
+		Version:   condition.ExpectedVersion,
+	}
+	if condition.ExpectedScore != nil {
+		expectedScore := toStoredScore(settings, float64(*condition.ExpectedScore))
+		databaseCondition.Score = &expectedScore
+	}
+
+	if idempotency != nil {
+		databaseMember.TTL = expireAt
+		duplicate, err := s.Database.SetMemberIfMatchIdempotent(ctx, leaderboard, databaseMember, databaseCondition, idempotency)
+		if err != nil {
+			return false, nil, err
+		}
+
+		setIdempotentMembersValues([]*model.Member{member}, []*database.Member{databaseMember}, prevRank, settings)
+		if duplicate {
+			return true, nil, nil
+		}
+	} else {
+		err := s.Database.SetMemberIfMatch(ctx, leaderboard, databaseMember, databaseCondition)
+		if err != nil {
+			return false, nil, err
+		}
+	}
+
+	member.Version = databaseMember.Version
+	newScore := member.Score
+	return false, newScoreChange(member.PublicID, toLedgerScore(settings, databaseMember.PreviousScore), &newScore), nil
 }
 
-func (s *Service) setMembersValues(ctx context.Context, leaderboard string, members []*model.Member, order string) error {
+func (s *Service) setMembersValues(ctx context.Context, leaderboard string, members []*model.Member, order string, settings *model.LeaderboardSettings) error {
 	databaseMembers, err := s.getDatabaseMembers(ctx, leaderboard, members, order)
 	if err != nil {
 		return err
@@ -90,20 +163,27 @@ func (s *Service) setMembersValues(ctx context.Context, leaderboard string, memb
 
 	for i, member := range databaseMembers {
 		members[i].Rank = int(member.Rank + 1)
-		members[i].Score = int64(member.Score)
+		members[i].Score = toDecayedScore(settings, member.Score)
 	}
 
 	return nil
 }
 
-func (s *Service) persistMembersTTL(ctx context.Context, leaderboard string, members []*model.Member, scoreTTL string) error {
+// getScoreExpireAt return when scores written with scoreTTL seconds expire, the zero time if scoreTTL is not set
+func getScoreExpireAt(scoreTTL string) (time.Time, error) {
+	if scoreTTL == "" {
+		return time.Time{}, nil
+	}
+
 	ttl, err := strconv.ParseInt(scoreTTL, 10, 64)
 	if err != nil {
-		return err
+		return time.Time{}, err
 	}
 
-	timeToExpire := time.Now().UTC().Add(time.Duration(ttl) * time.Second)
+	return time.Now().UTC().Add(time.Duration(ttl) * time.Second), nil
+}
 
+func (s *Service) persistMembersTTL(ctx context.Context, leaderboard string, members []*model.Member, timeToExpire time.Time) error {
 	databaseMembers := make([]*database.Member, 0, len(members))
 	for _, member := range members {
 		databaseMembers = append(databaseMembers, &database.Member{
@@ -113,7 +193,7 @@ func (s *Service) persistMembersTTL(ctx context.Context, leaderboard string, mem
 		member.ExpireAt = int(timeToExpire.Unix())
 	}
 
-	err = s.Database.SetMembersTTL(ctx, leaderboard, databaseMembers)
+	err := s.Database.SetMembersTTL(ctx, leaderboard, databaseMembers)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// Milestone rules of leaderboard settings are evaluated on writes of a single member score, comparing
+// the ranks member and the members of MilestonePassedMember rules had before the write with the rank
+// computed for its response. Like ranks of score events, ranks before the write are read together right
+// before it, so concurrent writes can make them stale.
+
+func (s *Service) hasMilestones(settings *model.LeaderboardSettings) bool {
+	return s.MilestoneNotifier != nil && len(settings.Milestones) > 0
+}
+
+// getPreviousRanks return the ranks of members in leaderboard before a write, and of the members of
+// MilestonePassedMember rules when there are milestones, or nil when there is no event sink nor milestone
+// rules to use them
+func (s *Service) getPreviousRanks(ctx context.Context, leaderboard string, settings *model.LeaderboardSettings, members ...string) (map[string]int, error) {
+	if s.EventSink == nil && !s.hasMilestones(settings) {
+		return nil, nil
+	}
+
+	if s.hasMilestones(settings) {
+		for _, rule := range settings.Milestones {
+			if rule.Type == model.MilestonePassedMember {
+				members = append(members, rule.Member)
+			}
+		}
+	}
+
+	return s.getRanks(ctx, leaderboard, members...)
+}
+
+// notifyMilestones send to the milestone notifier the milestone rules of leaderboard settings member reached
+// moving to its current rank, previousRanks are the ranks returned by getPreviousRanks before the write
+func (s *Service) notifyMilestones(ctx context.Context, leaderboard string, settings *model.LeaderboardSettings, member *model.Member, previousRanks map[string]int) error {
+	if !s.hasMilestones(settings) || member.Rank < 1 {
+		return nil
+	}
+
+	previousRank := getRank(previousRanks, member.PublicID)
+	reachedAt := time.Now().Unix()
+	reached := []*model.Milestone{}
+	for _, rule := range settings.Milestones {
+		if !reachedMilestone(rule, member, previousRanks) {
+			continue
+		}
+
+		milestone := &model.Milestone{
+			ID:           uuid.NewV4().String(),
+			Leaderboard:  leaderboard,
+			RuleID:       rule.ID,
+			Type:         rule.Type,
+			PublicID:     member.PublicID,
+			Score:        member.Score,
+			PreviousRank: previousRank,
+			Rank:         member.Rank,
+			ReachedAt:    reachedAt,
+		}
+		if rule.Type == model.MilestonePassedMember {
+			milestone.PassedMember = rule.Member
+		}
+		reached = append(reached, milestone)
+	}
+
+	if len(reached) == 0 {
+		return nil
+	}
+
+	return s.MilestoneNotifier.Notify(ctx, reached)
+}
+
+// reachedMilestone return if member reached rule moving to its rank, previousRanks are the ranks of member and
+// of the members of MilestonePassedMember rules before the write. A member that overtook another pushed it
+// one rank down, so it now holds a rank at most the one the passed member had before
+func reachedMilestone(rule *model.MilestoneRule, member *model.Member, previousRanks map[string]int) bool {
+	previousRank := getRank(previousRanks, member.PublicID)
+	previouslyRanked := previousRank > 0
+
+	switch rule.Type {
+	case model.MilestoneRankAtMost:
+		return member.Rank <= rule.Rank && (!previouslyRanked || previousRank > rule.Rank)
+	case model.MilestonePassedMember:
+		passedRank := getRank(previousRanks, rule.Member)
+		if rule.Member == member.PublicID || passedRank < 1 {
+			return false
+		}
+		return member.Rank <= passedRank && (!previouslyRanked || previousRank > passedRank)
+	}
+
+	return false
+}
@@ -0,0 +1,119 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/milestones"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service Milestones", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var notifier *milestones.MockNotifier
+	var svc *service.Service
+
+	var leaderboard string = "leaderboard"
+	var member string = "member1"
+
+	settings := map[string]string{
+		"milestones": `[{"id":"top10","type":"rankAtMost","rank":10},{"id":"top100","type":"rankAtMost","rank":100},{"id":"passRival","type":"passedMember","member":"rival"}]`,
+	}
+
+	expectWrite := func(previousRank, rank, rivalPreviousRank int64) {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq(member), gomock.Eq("rival")).
+			Return([]*database.Member{{Member: member, Score: 10, Rank: previousRank}, {Member: "rival", Score: 15, Rank: rivalPreviousRank}}, nil)
+		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(true), gomock.Eq(member)).
+			Return([]*database.Member{{Member: member, Score: 20, Rank: rank}}, nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+		notifier = milestones.NewMockNotifier(ctrl)
+
+		svc = &service.Service{Database: mock, MilestoneNotifier: notifier}
+
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Any()).Return(settings, nil).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should notify milestones reached by a member crossing a rank and passing a member", func() {
+		expectWrite(100, 99, 99)
+		notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, reached []*model.Milestone) error {
+				Expect(reached).To(HaveLen(2))
+				Expect(reached[0].ID).NotTo(BeEmpty())
+				Expect(reached[0].Leaderboard).To(Equal(leaderboard))
+				Expect(reached[0].RuleID).To(Equal("top100"))
+				Expect(reached[0].Type).To(Equal(model.MilestoneRankAtMost))
+				Expect(reached[0].PublicID).To(Equal(member))
+				Expect(reached[0].Score).To(Equal(int64(20)))
+				Expect(reached[0].PreviousRank).To(Equal(101))
+				Expect(reached[0].Rank).To(Equal(100))
+				Expect(reached[0].ReachedAt).NotTo(BeZero())
+				Expect(reached[1].RuleID).To(Equal("passRival"))
+				Expect(reached[1].PassedMember).To(Equal("rival"))
+				return nil
+			},
+		)
+
+		_, err := svc.SetMemberScore(context.Background(), leaderboard, member, 20, false, "", nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should notify milestones reached by incrementing a member score", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq(member), gomock.Eq("rival")).
+			Return([]*database.Member{nil, {Member: "rival", Score: 30, Rank: 0}}, nil)
+		mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(&database.Member{Member: member}), gomock.Any()).Return(nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(true), gomock.Eq(member)).
+			Return([]*database.Member{{Member: member, Score: 20, Rank: 4}}, nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, reached []*model.Milestone) error {
+				Expect(reached).To(HaveLen(2))
+				Expect(reached[0].RuleID).To(Equal("top10"))
+				Expect(reached[0].PreviousRank).To(Equal(-1))
+				Expect(reached[0].Rank).To(Equal(5))
+				Expect(reached[1].RuleID).To(Equal("top100"))
+				return nil
+			},
+		)
+
+		_, err := svc.IncrementMemberScore(context.Background(), leaderboard, member, 20, "", nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should not notify milestones a member had already reached", func() {
+		expectWrite(50, 40, 60)
+
+		_, err := svc.SetMemberScore(context.Background(), leaderboard, member, 20, false, "", nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should report error of notifier after the write and return the member", func() {
+		var steps []string
+		svc.OnAfterCommitError = func(ctx context.Context, step string, err error) {
+			steps = append(steps, step)
+		}
+		expectWrite(100, 99, 100)
+		notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).Return(fmt.Errorf("notifier error"))
+
+		_, err := svc.SetMemberScore(context.Background(), leaderboard, member, 20, false, "", nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(steps).To(Equal([]string{service.AfterCommitMilestones}))
+	})
+})
@@ -1,12 +1,32 @@
 package service
 
-import "context"
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
 
 const removeLeaderboardServiceLabel = "remove leaderboard"
 
-// RemoveLeaderboard reurn how many members have in a leaderboard
+// RemoveLeaderboard reurn how many members have in a leaderboard. Removals are sent to the lifecycle notifier
 func (s *Service) RemoveLeaderboard(ctx context.Context, leaderboard string) error {
-	err := s.Database.RemoveLeaderboard(ctx, leaderboard)
+	err := s.ensureNotFrozen(ctx, leaderboard)
+	if err != nil {
+		if _, ok := err.(*LeaderboardFrozenError); ok {
+			return err
+		}
+		return NewGeneralError(removeLeaderboardServiceLabel, err.Error())
+	}
+
+	err = s.Database.RemoveLeaderboard(ctx, leaderboard)
+	if err != nil {
+		return NewGeneralError(removeLeaderboardServiceLabel, err.Error())
+	}
+
+	err = s.notifyLifecycle(ctx, &model.LifecycleEvent{
+		Type:        model.LifecycleLeaderboardRemoved,
+		Leaderboard: leaderboard,
+	})
 	if err != nil {
 		return NewGeneralError(removeLeaderboardServiceLabel, err.Error())
 	}
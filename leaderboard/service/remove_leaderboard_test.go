@@ -21,7 +21,7 @@ var _ = Describe("Service RemoveLeaderboard", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mock = database.NewMockDatabase(ctrl)
 
-		svc = &service.Service{mock}
+		svc = &service.Service{Database: mock}
 	})
 
 	AfterEach(func() {
@@ -29,6 +29,7 @@ var _ = Describe("Service RemoveLeaderboard", func() {
 	})
 
 	It("Should return nil if all is OK", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
 		mock.EXPECT().RemoveLeaderboard(gomock.Any(), gomock.Eq(leaderboard)).Return(nil)
 
 		err := svc.RemoveLeaderboard(context.Background(), leaderboard)
@@ -36,6 +37,7 @@ var _ = Describe("Service RemoveLeaderboard", func() {
 	})
 
 	It("Should return error if database return in error", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
 		mock.EXPECT().RemoveLeaderboard(gomock.Any(), gomock.Eq(leaderboard)).Return(database.NewGeneralError("unknown error"))
 
 		err := svc.RemoveLeaderboard(context.Background(), leaderboard)
@@ -48,4 +50,13 @@ var _ = Describe("Service RemoveLeaderboard", func() {
 			),
 		)
 	})
+
+	It("Should return LeaderboardFrozenError if leaderboard is frozen", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"frozen": "true",
+		}, nil)
+
+		err := svc.RemoveLeaderboard(context.Background(), leaderboard)
+		Expect(err).To(Equal(service.NewLeaderboardFrozenError(leaderboard)))
+	})
 })
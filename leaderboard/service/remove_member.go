@@ -4,10 +4,14 @@ import "context"
 
 const removeMemberServiceLabel = "remove member"
 
-// RemoveMember dele specific member from leaderboard
+// RemoveMember dele specific member from leaderboard, recording the removal in the ledger with the origin of ctx
+// and publishing it to the event sink
 func (s *Service) RemoveMember(ctx context.Context, leaderboard, member string) error {
-	err := s.Database.RemoveMembers(ctx, leaderboard, member)
+	err := s.removeMembers(ctx, leaderboard, []string{member})
 	if err != nil {
+		if _, ok := err.(*LeaderboardFrozenError); ok {
+			return err
+		}
 		return NewGeneralError(removeMemberServiceLabel, err.Error())
 	}
 	return nil
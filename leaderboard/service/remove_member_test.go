@@ -23,7 +23,7 @@ var _ = Describe("Service RemoveMember", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mock = database.NewMockDatabase(ctrl)
 
-		svc = &service.Service{mock}
+		svc = &service.Service{Database: mock}
 	})
 
 	AfterEach(func() {
@@ -31,16 +31,32 @@ var _ = Describe("Service RemoveMember", func() {
 	})
 
 	It("Should return nil if all is OK", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq(member)).Return([]*database.Member{
+			{Member: member, Score: 10},
+		}, nil)
 		mock.EXPECT().RemoveMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member)).Return(nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
 		err := svc.RemoveMember(context.Background(), leaderboard, member)
 		Expect(err).NotTo(HaveOccurred())
 	})
 
 	It("Should return error if database return in error", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq(member)).Return([]*database.Member{nil}, nil)
 		mock.EXPECT().RemoveMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member)).Return(fmt.Errorf("unknown error"))
 
 		err := svc.RemoveMember(context.Background(), leaderboard, member)
 		Expect(err).To(Equal(service.NewGeneralError("remove member", "unknown error")))
 	})
+
+	It("Should return LeaderboardFrozenError if leaderboard is frozen", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"frozen": "true",
+		}, nil)
+
+		err := svc.RemoveMember(context.Background(), leaderboard, member)
+		Expect(err).To(Equal(service.NewLeaderboardFrozenError(leaderboard)))
+	})
 })
@@ -1,14 +1,60 @@
 package service
 
-import "context"
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+)
 
 const removeMembersServiceLabel = "remove members"
 
-// RemoveMembers remove members from a certain leaderboard
+const removeMembersOrder = "desc"
+
+// RemoveMembers remove members from a certain leaderboard, recording the removals in the ledger with the origin of ctx
+// and publishing them to the event sink
 func (s *Service) RemoveMembers(ctx context.Context, leaderboard string, members []string) error {
-	err := s.Database.RemoveMembers(ctx, leaderboard, members...)
+	err := s.removeMembers(ctx, leaderboard, members)
 	if err != nil {
+		if _, ok := err.(*LeaderboardFrozenError); ok {
+			return err
+		}
 		return NewGeneralError(removeMembersServiceLabel, err.Error())
 	}
 	return nil
 }
+
+func (s *Service) removeMembers(ctx context.Context, leaderboard string, members []string) error {
+	settings, err := s.getLeaderboardSettings(ctx, leaderboard)
+	if err != nil {
+		return err
+	}
+
+	if settings.Frozen {
+		return NewLeaderboardFrozenError(leaderboard)
+	}
+
+	databaseMembers, err := s.Database.GetMembers(ctx, leaderboard, removeMembersOrder, false, members...)
+	if err != nil {
+		return err
+	}
+
+	err = s.Database.RemoveMembers(ctx, leaderboard, members...)
+	if err != nil {
+		return err
+	}
+
+	changes := make([]*database.LedgerEntry, 0, len(databaseMembers))
+	previousRanks := make(map[string]int, len(databaseMembers))
+	for _, member := range databaseMembers {
+		if member == nil {
+			continue
+		}
+		changes = append(changes, newScoreChange(member.Member, toLedgerScore(settings, &member.Score), nil))
+		previousRanks[member.Member] = int(member.Rank + 1)
+	}
+
+	s.afterCommit(ctx, AfterCommitLedger, s.recordScoreChanges(ctx, leaderboard, settings, changes))
+
+	s.afterCommit(ctx, AfterCommitEvents, s.publishScoreChanges(ctx, leaderboard, changes, previousRanks))
+	return nil
+}
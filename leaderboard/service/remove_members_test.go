@@ -3,11 +3,14 @@ package service_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/events"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
 	"github.com/topfreegames/podium/leaderboard/v2/service"
 )
 
@@ -23,7 +26,7 @@ var _ = Describe("Service RemoveMembers", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mock = database.NewMockDatabase(ctrl)
 
-		svc = &service.Service{mock}
+		svc = &service.Service{Database: mock}
 	})
 
 	AfterEach(func() {
@@ -31,16 +34,101 @@ var _ = Describe("Service RemoveMembers", func() {
 	})
 
 	It("Should return nil if all is OK", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq(members)).Return([]*database.Member{
+			{Member: "member", Score: 10},
+			nil,
+		}, nil)
 		mock.EXPECT().RemoveMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(members)).Return(nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Eq(time.Time{}), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, leaderboard string, entries []*database.LedgerEntry, removeBefore, expireAt time.Time, maxEntries int) error {
+				Expect(entries).To(HaveLen(1))
+				Expect(entries[0].Member).To(Equal("member"))
+				Expect(*entries[0].OldScore).To(Equal(int64(10)))
+				Expect(entries[0].NewScore).To(BeNil())
+				Expect(entries[0].Delta).To(Equal(int64(-10)))
+				Expect(entries[0].Reason).To(Equal("cheating"))
+				Expect(entries[0].Caller).To(Equal("admin"))
+				return nil
+			},
+		)
+
+		ctx := service.WithScoreChangeOrigin(context.Background(), "cheating", "admin")
+		err := svc.RemoveMembers(ctx, leaderboard, members)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should not record members that did not exist", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq(members)).Return([]*database.Member{nil, nil}, nil)
+		mock.EXPECT().RemoveMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(members)).Return(nil)
+
+		err := svc.RemoveMembers(context.Background(), leaderboard, members)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should publish removals to the event sink", func() {
+		eventSink := events.NewMockEventSink(ctrl)
+		svc.EventSink = eventSink
+
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq(members)).Return([]*database.Member{
+			{Member: "member", Score: 10, Rank: 4},
+			nil,
+		}, nil)
+		mock.EXPECT().RemoveMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(members)).Return(nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq("member")).Return([]*database.Member{nil}, nil)
+		eventSink.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, scoreEvents []*model.ScoreEvent) error {
+				Expect(scoreEvents).To(HaveLen(1))
+				Expect(scoreEvents[0].PublicID).To(Equal("member"))
+				Expect(*scoreEvents[0].OldScore).To(Equal(int64(10)))
+				Expect(scoreEvents[0].NewScore).To(BeNil())
+				Expect(scoreEvents[0].OldRank).To(Equal(5))
+				Expect(scoreEvents[0].NewRank).To(Equal(-1))
+				return nil
+			},
+		)
 
 		err := svc.RemoveMembers(context.Background(), leaderboard, members)
 		Expect(err).NotTo(HaveOccurred())
 	})
 
+	It("Should report error recording the ledger after members are removed and return nil", func() {
+		var errs []error
+		svc.OnAfterCommitError = func(ctx context.Context, step string, err error) {
+			Expect(step).To(Equal(service.AfterCommitLedger))
+			errs = append(errs, err)
+		}
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq(members)).Return([]*database.Member{
+			{Member: "member", Score: 10},
+			nil,
+		}, nil)
+		mock.EXPECT().RemoveMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(members)).Return(nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("ledger error"))
+
+		err := svc.RemoveMembers(context.Background(), leaderboard, members)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(errs).To(Equal([]error{fmt.Errorf("ledger error")}))
+	})
+
 	It("Should return error if database return in error", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq(members)).Return([]*database.Member{nil, nil}, nil)
 		mock.EXPECT().RemoveMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(members)).Return(fmt.Errorf("unknown error"))
 
 		err := svc.RemoveMembers(context.Background(), leaderboard, members)
 		Expect(err).To(Equal(service.NewGeneralError("remove members", "unknown error")))
 	})
+
+	It("Should return LeaderboardFrozenError if leaderboard is frozen", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"frozen": "true",
+		}, nil)
+
+		err := svc.RemoveMembers(context.Background(), leaderboard, members)
+		Expect(err).To(Equal(service.NewLeaderboardFrozenError(leaderboard)))
+	})
 })
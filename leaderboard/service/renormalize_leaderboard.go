@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+const renormalizeLeaderboardServiceLabel = "renormalize leaderboard"
+
+// RenormalizeLeaderboard move decay landmark of leaderboard to the present if more than minHalfLives
+// half-lives passed since the last renormalization, scaling down stored scores before they overflow.
+// It returns if leaderboard was renormalized
+func (s *Service) RenormalizeLeaderboard(ctx context.Context, leaderboard string, minHalfLives float64) (bool, error) {
+	settings, err := s.getLeaderboardSettings(ctx, leaderboard)
+	if err != nil {
+		return false, NewGeneralError(renormalizeLeaderboardServiceLabel, err.Error())
+	}
+
+	if !hasDecay(settings) {
+		return false, NewLeaderboardWithoutDecayError(leaderboard)
+	}
+
+	now := time.Now()
+	halfLives := float64(now.Unix()-settings.DecayLandmark) / float64(settings.DecayHalfLife)
+	if halfLives < minHalfLives {
+		return false, nil
+	}
+
+	err = s.Database.ScaleLeaderboard(ctx, leaderboard, 1/decayWeight(settings, now), map[string]string{
+		decayLandmarkSetting: strconv.FormatInt(now.Unix(), 10),
+	})
+	if err != nil {
+		return false, NewGeneralError(renormalizeLeaderboardServiceLabel, err.Error())
+	}
+
+	return true, nil
+}
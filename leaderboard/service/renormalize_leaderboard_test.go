@@ -0,0 +1,82 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service RenormalizeLeaderboard", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should scale leaderboard and move landmark if enough half-lives passed", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"decayHalfLife": "60",
+			"decayLandmark": fmt.Sprint(time.Now().Unix() - 120),
+		}, nil)
+		mock.EXPECT().ScaleLeaderboard(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, leaderboard string, factor float64, settings map[string]string) error {
+				Expect(factor).To(BeNumerically("~", 0.25, 0.01))
+				landmark, err := strconv.ParseInt(settings["decayLandmark"], 10, 64)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(landmark).To(BeNumerically("~", time.Now().Unix(), 1))
+				return nil
+			},
+		)
+
+		renormalized, err := svc.RenormalizeLeaderboard(context.Background(), leaderboard, 2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(renormalized).To(BeTrue())
+	})
+
+	It("Should not scale leaderboard if not enough half-lives passed", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"decayHalfLife": "3600",
+			"decayLandmark": fmt.Sprint(time.Now().Unix() - 3600),
+		}, nil)
+
+		renormalized, err := svc.RenormalizeLeaderboard(context.Background(), leaderboard, 64)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(renormalized).To(BeFalse())
+	})
+
+	It("Should return LeaderboardWithoutDecayError if leaderboard has no decay", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+
+		_, err := svc.RenormalizeLeaderboard(context.Background(), leaderboard, 64)
+		Expect(err).To(Equal(service.NewLeaderboardWithoutDecayError(leaderboard)))
+	})
+
+	It("Should return error if database return in error on ScaleLeaderboard", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"decayHalfLife": "60",
+			"decayLandmark": fmt.Sprint(time.Now().Unix() - 120),
+		}, nil)
+		mock.EXPECT().ScaleLeaderboard(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any()).Return(fmt.Errorf("Database error example"))
+
+		_, err := svc.RenormalizeLeaderboard(context.Background(), leaderboard, 1)
+		Expect(err).To(Equal(service.NewGeneralError("renormalize leaderboard", "Database error example")))
+	})
+})
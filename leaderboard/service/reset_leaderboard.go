@@ -0,0 +1,310 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/expiration"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const resetLeaderboardServiceLabel = "reset leaderboard"
+
+const defaultResetChunkSize = 1000
+
+// ResetLeaderboard apply options strategy to all leaderboard members, writing the result into options target.
+// Members are scanned in chunks and written into a temporary leaderboard that replaces target at once
+// when all chunks were processed, so readers never see a partially reset leaderboard. Their versions in
+// target are incremented as they are written, and once target is replaced their score changes are recorded
+// in its ledger and published to the event sink, members left out by the top K strategy as removals.
+// Progress is saved after each chunk and can be retrieved with GetResetProgress
+func (s *Service) ResetLeaderboard(ctx context.Context, leaderboard string, options *model.ResetOptions) (*model.ResetProgress, error) {
+	err := validateResetOptions(options)
+	if err != nil {
+		return nil, err
+	}
+
+	target := options.Target
+	if target == "" {
+		target = leaderboard
+	}
+
+	_, err = expiration.GetExpireAt(target)
+	if err != nil {
+		if _, ok := err.(*expiration.LeaderboardExpiredError); ok {
+			return nil, NewLeaderboardExpiredError(target)
+		}
+		return nil, NewGeneralError(resetLeaderboardServiceLabel, err.Error())
+	}
+
+	totalMembers, err := s.Database.GetTotalMembers(ctx, leaderboard)
+	if err != nil {
+		return nil, NewGeneralError(resetLeaderboardServiceLabel, err.Error())
+	}
+
+	progress := &model.ResetProgress{
+		Leaderboard: leaderboard,
+		Target:      target,
+		Strategy:    options.Strategy,
+		Status:      model.ResetStatusRunning,
+		Total:       totalMembers,
+		StartedAt:   time.Now().Unix(),
+	}
+
+	err = s.saveResetProgress(ctx, progress)
+	if err != nil {
+		return nil, NewGeneralError(resetLeaderboardServiceLabel, err.Error())
+	}
+
+	err = s.applyReset(ctx, leaderboard, target, options, progress)
+	if err != nil {
+		progress.Status = model.ResetStatusFailed
+		progress.FinishedAt = time.Now().Unix()
+		s.saveResetProgress(ctx, progress)
+		return nil, NewGeneralError(resetLeaderboardServiceLabel, err.Error())
+	}
+
+	progress.Status = model.ResetStatusDone
+	progress.FinishedAt = time.Now().Unix()
+	err = s.saveResetProgress(ctx, progress)
+	if err != nil {
+		return nil, NewGeneralError(resetLeaderboardServiceLabel, err.Error())
+	}
+
+	return progress, nil
+}
+
+func validateResetOptions(options *model.ResetOptions) error {
+	if options == nil {
+		return NewInvalidResetOptionsError("options are required")
+	}
+
+	switch options.Strategy {
+	case model.ResetStrategyZero, model.ResetStrategyDelete:
+	case model.ResetStrategyCompress:
+		if options.Factor < 0 || options.Factor > 1 {
+			return NewInvalidResetOptionsError(fmt.Sprintf("factor %v must be between 0 and 1", options.Factor))
+		}
+	case model.ResetStrategyTopK:
+		if options.TopK < 1 {
+			return NewInvalidResetOptionsError(fmt.Sprintf("topK %d must be greater than zero", options.TopK))
+		}
+	default:
+		return NewInvalidResetOptionsError(fmt.Sprintf("unknown strategy %s", options.Strategy))
+	}
+
+	if options.Order == "" {
+		options.Order = "desc"
+	}
+	if options.Order != "asc" && options.Order != "desc" {
+		return NewInvalidResetOptionsError(fmt.Sprintf("invalid order %s", options.Order))
+	}
+
+	if options.ChunkSize < 0 {
+		return NewInvalidResetOptionsError(fmt.Sprintf("chunkSize %d must be positive", options.ChunkSize))
+	}
+	if options.ChunkSize == 0 {
+		options.ChunkSize = defaultResetChunkSize
+	}
+
+	return nil
+}
+
+func (s *Service) applyReset(ctx context.Context, leaderboard, target string, options *model.ResetOptions, progress *model.ResetProgress) error {
+	if options.Strategy == model.ResetStrategyDelete {
+		progress.Processed = progress.Total
+		return s.Database.RemoveLeaderboard(ctx, target)
+	}
+
+	settings, err := s.getLeaderboardSettings(ctx, leaderboard)
+	if err != nil {
+		return err
+	}
+
+	targetSettings := settings
+	if target != leaderboard {
+		targetSettings, err = s.getLeaderboardSettings(ctx, target)
+		if err != nil {
+			return err
+		}
+	}
+
+	temporaryLeaderboard := database.LeaderboardKey(target, "reset:tmp")
+	err = s.Database.RemoveLeaderboard(ctx, temporaryLeaderboard)
+	if err != nil {
+		return err
+	}
+
+	// members are scanned instead of ranged by rank, so concurrent writes moving members between
+	// ranks do not make the reset skip or repeat them
+	reset := &importedChanges{previousRanks: map[string]int{}}
+	changed := map[string]bool{}
+	var cursor uint64
+	for {
+		var databaseMembers []*database.Member
+		databaseMembers, cursor, err = s.Database.ScanMembers(ctx, leaderboard, cursor, options.ChunkSize)
+		if err != nil {
+			return err
+		}
+
+		if len(databaseMembers) > 0 {
+			chunk, err := s.writeResetChunk(ctx, target, temporaryLeaderboard, settings, targetSettings, options, databaseMembers)
+			if err != nil {
+				return err
+			}
+
+			// a member scanned twice keeps the change of its first scan
+			changes := chunk.changes[:0]
+			for _, change := range chunk.changes {
+				if !changed[change.Member] {
+					changed[change.Member] = true
+					changes = append(changes, change)
+				}
+			}
+			chunk.changes = changes
+			reset.add(chunk)
+
+			progress.Processed = int(math.Min(float64(progress.Processed+len(databaseMembers)), float64(progress.Total)))
+			err = s.saveResetProgress(ctx, progress)
+			if err != nil {
+				return err
+			}
+		}
+
+		if cursor == 0 {
+			break
+		}
+	}
+
+	written, err := s.Database.GetTotalMembers(ctx, temporaryLeaderboard)
+	if err != nil {
+		return err
+	}
+
+	if written == 0 {
+		return s.Database.RemoveLeaderboard(ctx, target)
+	}
+
+	if options.Strategy == model.ResetStrategyTopK {
+		reset.changes, err = s.trimResetChanges(ctx, temporaryLeaderboard, options, reset.changes)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.Database.RenameLeaderboard(ctx, temporaryLeaderboard, target)
+	if err != nil {
+		return err
+	}
+
+	err = s.Database.RemoveLeaderboard(ctx, temporaryLeaderboard)
+	if err != nil {
+		return err
+	}
+
+	err = s.persistLeaderboardExpirationTime(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	s.recordImportedChanges(ctx, target, targetSettings, reset)
+	return nil
+}
+
+// writeResetChunk write the reset scores of databaseMembers of leaderboard, with settings, into temporary,
+// incrementing their versions in target, and return their score changes in target with their ranks in it
+// before the reset
+func (s *Service) writeResetChunk(ctx context.Context, target, temporary string, settings, targetSettings *model.LeaderboardSettings, options *model.ResetOptions, databaseMembers []*database.Member) (*importedChanges, error) {
+	memberIDs := make([]string, 0, len(databaseMembers))
+	for _, member := range databaseMembers {
+		memberIDs = append(memberIDs, member.Member)
+		member.Score = resetScore(member.Score, settings, targetSettings, options)
+	}
+
+	previousRanks, err := s.getEventRanks(ctx, target, memberIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := s.Database.ImportMembers(ctx, target, temporary, databaseMembers)
+	if len(errs) > 0 && errs[0] != nil {
+		return nil, errs[0]
+	}
+
+	if options.Strategy == model.ResetStrategyTopK {
+		err = s.Database.TrimLeaderboard(ctx, temporary, options.TopK, options.Order)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	changes := make([]*database.LedgerEntry, 0, len(databaseMembers))
+	for _, member := range databaseMembers {
+		newScore := toDecayedScore(targetSettings, member.Score)
+		changes = append(changes, newScoreChange(member.Member, toLedgerScore(targetSettings, member.PreviousScore), &newScore))
+	}
+
+	return &importedChanges{changes: changes, previousRanks: previousRanks}, nil
+}
+
+// trimResetChanges return changes of the members kept in temporary after it was trimmed to the top K members,
+// changing the others into removals from target, or dropping them if they were not in it
+func (s *Service) trimResetChanges(ctx context.Context, temporary string, options *model.ResetOptions, changes []*database.LedgerEntry) ([]*database.LedgerEntry, error) {
+	keptMembers, err := s.Database.GetOrderedMembers(ctx, temporary, 0, options.TopK-1, options.Order)
+	if err != nil {
+		return nil, err
+	}
+
+	kept := make(map[string]bool, len(keptMembers))
+	for _, member := range keptMembers {
+		kept[member.Member] = true
+	}
+
+	trimmed := make([]*database.LedgerEntry, 0, len(keptMembers))
+	for _, change := range changes {
+		switch {
+		case kept[change.Member]:
+			trimmed = append(trimmed, change)
+		case change.OldScore != nil:
+			trimmed = append(trimmed, newScoreChange(change.Member, change.OldScore, nil))
+		}
+	}
+
+	return trimmed, nil
+}
+
+// resetScore return the score stored in target, with targetSettings, of a member with storedScore in a
+// leaderboard with settings after options strategy. Scores of decaying leaderboards are reset decayed to
+// now and stored with the decay weight of target, so carried over scores keep their value
+func resetScore(storedScore float64, settings, targetSettings *model.LeaderboardSettings, options *model.ResetOptions) float64 {
+	score := float64(toDecayedScore(settings, storedScore))
+	switch options.Strategy {
+	case model.ResetStrategyZero:
+		score = 0
+	case model.ResetStrategyCompress:
+		base := float64(options.Base)
+		score = math.Round(base + (score-base)*options.Factor)
+	}
+
+	return toStoredScore(targetSettings, score)
+}
+
+func (s *Service) saveResetProgress(ctx context.Context, progress *model.ResetProgress) error {
+	databaseProgress := &database.ResetProgress{
+		Target:    progress.Target,
+		Strategy:  string(progress.Strategy),
+		Status:    progress.Status,
+		Processed: int64(progress.Processed),
+		Total:     int64(progress.Total),
+		StartedAt: time.Unix(progress.StartedAt, 0),
+	}
+
+	if progress.FinishedAt != 0 {
+		databaseProgress.FinishedAt = time.Unix(progress.FinishedAt, 0)
+	}
+
+	return s.Database.SetResetProgress(ctx, progress.Leaderboard, databaseProgress)
+}
@@ -0,0 +1,302 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service ResetLeaderboard", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboard"
+	var temporaryLeaderboard string = "{leaderboard}:reset:tmp"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should compress members scores in chunks and replace leaderboard recording their changes", func() {
+		options := &model.ResetOptions{
+			Strategy:  model.ResetStrategyCompress,
+			Base:      1000,
+			Factor:    0.5,
+			ChunkSize: 2,
+		}
+
+		gomock.InOrder(
+			mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(3, nil),
+			mock.EXPECT().SetResetProgress(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(nil),
+			mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil),
+			mock.EXPECT().RemoveLeaderboard(gomock.Any(), gomock.Eq(temporaryLeaderboard)).Return(nil),
+			mock.EXPECT().ScanMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(uint64(0)), gomock.Eq(2)).Return([]*database.Member{
+				{Member: "member1", Score: 2000},
+				{Member: "member2", Score: 1500},
+			}, uint64(7), nil),
+			mock.EXPECT().ImportMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(temporaryLeaderboard), gomock.Eq([]*database.Member{
+				{Member: "member1", Score: 1500},
+				{Member: "member2", Score: 1250},
+			})).DoAndReturn(withPreviousScores(2000, 1500)),
+			mock.EXPECT().SetResetProgress(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(nil),
+			mock.EXPECT().ScanMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(uint64(7)), gomock.Eq(2)).Return([]*database.Member{
+				{Member: "member3", Score: 501},
+			}, uint64(0), nil),
+			mock.EXPECT().ImportMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(temporaryLeaderboard), gomock.Eq([]*database.Member{
+				{Member: "member3", Score: 751},
+			})).DoAndReturn(withPreviousScores(501)),
+			mock.EXPECT().SetResetProgress(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(nil),
+			mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(temporaryLeaderboard)).Return(3, nil),
+			mock.EXPECT().RenameLeaderboard(gomock.Any(), gomock.Eq(temporaryLeaderboard), gomock.Eq(leaderboard)).Return(nil),
+			mock.EXPECT().RemoveLeaderboard(gomock.Any(), gomock.Eq(temporaryLeaderboard)).Return(nil),
+			mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, leaderboard string, entries []*database.LedgerEntry, removeBefore, expireAt time.Time, maxEntries int) error {
+					Expect(entries).To(HaveLen(3))
+					Expect(entries[0].Member).To(Equal("member1"))
+					Expect(*entries[0].OldScore).To(Equal(int64(2000)))
+					Expect(*entries[0].NewScore).To(Equal(int64(1500)))
+					Expect(entries[0].Delta).To(Equal(int64(-500)))
+					Expect(entries[2].Member).To(Equal("member3"))
+					Expect(entries[2].Delta).To(Equal(int64(250)))
+					return nil
+				}),
+			mock.EXPECT().SetResetProgress(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(nil),
+		)
+
+		progress, err := svc.ResetLeaderboard(context.Background(), leaderboard, options)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(progress.Leaderboard).To(Equal(leaderboard))
+		Expect(progress.Target).To(Equal(leaderboard))
+		Expect(progress.Strategy).To(Equal(model.ResetStrategyCompress))
+		Expect(progress.Status).To(Equal(model.ResetStatusDone))
+		Expect(progress.Processed).To(Equal(3))
+		Expect(progress.Total).To(Equal(3))
+		Expect(progress.FinishedAt).NotTo(BeZero())
+	})
+
+	It("Should carry over scores of decaying leaderboards with the decay weight of target", func() {
+		target := "leaderboard-season2"
+		temporaryTarget := "{leaderboard-season2}:reset:tmp"
+		now := time.Now().Unix()
+		options := &model.ResetOptions{Strategy: model.ResetStrategyTopK, Target: target, TopK: 10}
+
+		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(1, nil)
+		mock.EXPECT().SetResetProgress(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(nil).Times(3)
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"decayHalfLife": "3600",
+			"decayLandmark": fmt.Sprint(now - 3600),
+		}, nil)
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(target)).Return(map[string]string{
+			"decayHalfLife": "3600",
+			"decayLandmark": fmt.Sprint(now - 7200),
+		}, nil)
+		mock.EXPECT().RemoveLeaderboard(gomock.Any(), gomock.Eq(temporaryTarget)).Return(nil).Times(2)
+		mock.EXPECT().ScanMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(uint64(0)), gomock.Eq(1000)).Return([]*database.Member{
+			{Member: "member1", Score: 200},
+		}, uint64(0), nil)
+		mock.EXPECT().ImportMembers(gomock.Any(), gomock.Eq(target), gomock.Eq(temporaryTarget), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, leaderboard, target string, batches ...[]*database.Member) []error {
+				Expect(batches[0][0].Score).To(BeNumerically("~", 400, 1))
+				return []error{nil}
+			})
+		mock.EXPECT().TrimLeaderboard(gomock.Any(), gomock.Eq(temporaryTarget), gomock.Eq(10), gomock.Eq("desc")).Return(nil)
+		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(temporaryTarget)).Return(1, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(temporaryTarget), gomock.Eq(0), gomock.Eq(9), gomock.Eq("desc")).Return([]*database.Member{
+			{Member: "member1", Score: 400},
+		}, nil)
+		mock.EXPECT().RenameLeaderboard(gomock.Any(), gomock.Eq(temporaryTarget), gomock.Eq(target)).Return(nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(target), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, leaderboard string, entries []*database.LedgerEntry, removeBefore, expireAt time.Time, maxEntries int) error {
+				Expect(entries).To(HaveLen(1))
+				Expect(entries[0].OldScore).To(BeNil())
+				Expect(*entries[0].NewScore).To(Equal(int64(100)))
+				return nil
+			})
+
+		_, err := svc.ResetLeaderboard(context.Background(), leaderboard, options)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should not count members scanned twice beyond total", func() {
+		options := &model.ResetOptions{Strategy: model.ResetStrategyZero, ChunkSize: 2}
+
+		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(2, nil)
+		mock.EXPECT().SetResetProgress(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(nil).Times(4)
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().RemoveLeaderboard(gomock.Any(), gomock.Eq(temporaryLeaderboard)).Return(nil).Times(2)
+		mock.EXPECT().ScanMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(uint64(0)), gomock.Eq(2)).Return([]*database.Member{
+			{Member: "member1", Score: 20},
+			{Member: "member2", Score: 10},
+		}, uint64(3), nil)
+		mock.EXPECT().ScanMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(uint64(3)), gomock.Eq(2)).Return([]*database.Member{
+			{Member: "member2", Score: 10},
+		}, uint64(0), nil)
+		mock.EXPECT().ImportMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(temporaryLeaderboard), gomock.Any()).Return([]error{nil}).Times(2)
+		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(temporaryLeaderboard)).Return(2, nil)
+		mock.EXPECT().RenameLeaderboard(gomock.Any(), gomock.Eq(temporaryLeaderboard), gomock.Eq(leaderboard)).Return(nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, leaderboard string, entries []*database.LedgerEntry, removeBefore, expireAt time.Time, maxEntries int) error {
+				Expect(entries).To(HaveLen(2))
+				return nil
+			})
+
+		progress, err := svc.ResetLeaderboard(context.Background(), leaderboard, options)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(progress.Processed).To(Equal(2))
+	})
+
+	It("Should carry only top K members into target leaderboard recording the others as removed", func() {
+		target := "leaderboard-season2"
+		temporaryTarget := "{leaderboard-season2}:reset:tmp"
+		options := &model.ResetOptions{
+			Strategy: model.ResetStrategyTopK,
+			Target:   target,
+			TopK:     2,
+		}
+
+		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(10, nil)
+		mock.EXPECT().SetResetProgress(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(nil).Times(3)
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(target)).Return(map[string]string{}, nil)
+		mock.EXPECT().RemoveLeaderboard(gomock.Any(), gomock.Eq(temporaryTarget)).Return(nil).Times(2)
+		mock.EXPECT().ScanMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(uint64(0)), gomock.Eq(1000)).Return([]*database.Member{
+			{Member: "member1", Score: 20},
+			{Member: "member2", Score: 10},
+			{Member: "member3", Score: 30},
+		}, uint64(0), nil)
+		mock.EXPECT().ImportMembers(gomock.Any(), gomock.Eq(target), gomock.Eq(temporaryTarget), gomock.Eq([]*database.Member{
+			{Member: "member1", Score: 20},
+			{Member: "member2", Score: 10},
+			{Member: "member3", Score: 30},
+		})).DoAndReturn(withPreviousScores(5, 5))
+		mock.EXPECT().TrimLeaderboard(gomock.Any(), gomock.Eq(temporaryTarget), gomock.Eq(2), gomock.Eq("desc")).Return(nil)
+		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(temporaryTarget)).Return(2, nil)
+		mock.EXPECT().GetOrderedMembers(gomock.Any(), gomock.Eq(temporaryTarget), gomock.Eq(0), gomock.Eq(1), gomock.Eq("desc")).Return([]*database.Member{
+			{Member: "member3", Score: 30},
+			{Member: "member1", Score: 20},
+		}, nil)
+		mock.EXPECT().RenameLeaderboard(gomock.Any(), gomock.Eq(temporaryTarget), gomock.Eq(target)).Return(nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(target), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, leaderboard string, entries []*database.LedgerEntry, removeBefore, expireAt time.Time, maxEntries int) error {
+				Expect(entries).To(HaveLen(3))
+				Expect(entries[1].Member).To(Equal("member2"))
+				Expect(*entries[1].OldScore).To(Equal(int64(5)))
+				Expect(entries[1].NewScore).To(BeNil())
+				Expect(entries[1].Delta).To(Equal(int64(-5)))
+				return nil
+			})
+
+		progress, err := svc.ResetLeaderboard(context.Background(), leaderboard, options)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(progress.Target).To(Equal(target))
+		Expect(progress.Processed).To(Equal(3))
+		Expect(progress.Total).To(Equal(10))
+	})
+
+	It("Should remove target leaderboard if strategy is delete", func() {
+		options := &model.ResetOptions{Strategy: model.ResetStrategyDelete}
+
+		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(5, nil)
+		mock.EXPECT().SetResetProgress(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(nil).Times(2)
+		mock.EXPECT().RemoveLeaderboard(gomock.Any(), gomock.Eq(leaderboard)).Return(nil)
+
+		progress, err := svc.ResetLeaderboard(context.Background(), leaderboard, options)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(progress.Status).To(Equal(model.ResetStatusDone))
+		Expect(progress.Processed).To(Equal(5))
+	})
+
+	It("Should remove target leaderboard if leaderboard is empty", func() {
+		options := &model.ResetOptions{Strategy: model.ResetStrategyZero}
+
+		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(0, nil)
+		mock.EXPECT().SetResetProgress(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(nil).Times(2)
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().RemoveLeaderboard(gomock.Any(), gomock.Eq(temporaryLeaderboard)).Return(nil)
+		mock.EXPECT().ScanMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(uint64(0)), gomock.Eq(1000)).Return([]*database.Member{}, uint64(0), nil)
+		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(temporaryLeaderboard)).Return(0, nil)
+		mock.EXPECT().RemoveLeaderboard(gomock.Any(), gomock.Eq(leaderboard)).Return(nil)
+
+		progress, err := svc.ResetLeaderboard(context.Background(), leaderboard, options)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(progress.Processed).To(Equal(0))
+	})
+
+	It("Should return InvalidResetOptionsError if strategy is unknown", func() {
+		_, err := svc.ResetLeaderboard(context.Background(), leaderboard, &model.ResetOptions{Strategy: "invalid"})
+		Expect(err).To(MatchError(service.NewInvalidResetOptionsError("unknown strategy invalid")))
+	})
+
+	It("Should return InvalidResetOptionsError if compress factor is out of range", func() {
+		_, err := svc.ResetLeaderboard(context.Background(), leaderboard, &model.ResetOptions{Strategy: model.ResetStrategyCompress, Factor: 1.5})
+		Expect(err).To(MatchError(service.NewInvalidResetOptionsError("factor 1.5 must be between 0 and 1")))
+	})
+
+	It("Should return InvalidResetOptionsError if topK is not set", func() {
+		_, err := svc.ResetLeaderboard(context.Background(), leaderboard, &model.ResetOptions{Strategy: model.ResetStrategyTopK})
+		Expect(err).To(MatchError(service.NewInvalidResetOptionsError("topK 0 must be greater than zero")))
+	})
+
+	It("Should return LeaderboardExpiredError if target leaderboard is expired", func() {
+		target := fmt.Sprintf(
+			"testkey-from%dto%d",
+			time.Now().UTC().Add(time.Duration(-2)*time.Second).Unix(),
+			time.Now().UTC().Add(time.Duration(-1)*time.Second).Unix(),
+		)
+
+		_, err := svc.ResetLeaderboard(context.Background(), leaderboard, &model.ResetOptions{Strategy: model.ResetStrategyZero, Target: target})
+		Expect(err).To(MatchError(service.NewLeaderboardExpiredError(target)))
+	})
+
+	It("Should save failed progress and return error if database return in error", func() {
+		options := &model.ResetOptions{Strategy: model.ResetStrategyZero}
+
+		mock.EXPECT().GetTotalMembers(gomock.Any(), gomock.Eq(leaderboard)).Return(1, nil)
+		mock.EXPECT().SetResetProgress(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(nil)
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().RemoveLeaderboard(gomock.Any(), gomock.Eq(temporaryLeaderboard)).Return(nil)
+		mock.EXPECT().ScanMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(uint64(0)), gomock.Eq(1000)).Return(nil, uint64(0), database.NewGeneralError("unknown error"))
+		mock.EXPECT().SetResetProgress(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, leaderboard string, progress *database.ResetProgress) error {
+				Expect(progress.Status).To(Equal(model.ResetStatusFailed))
+				return nil
+			},
+		)
+
+		_, err := svc.ResetLeaderboard(context.Background(), leaderboard, options)
+		Expect(err).To(Equal(service.NewGeneralError("reset leaderboard", database.NewGeneralError("unknown error").Error())))
+	})
+})
+
+// withPreviousScores return an ImportMembers mock filling the PreviousScore of the members written with scores
+func withPreviousScores(scores ...float64) func(ctx context.Context, leaderboard, target string, batches ...[]*database.Member) []error {
+	return func(ctx context.Context, leaderboard, target string, batches ...[]*database.Member) []error {
+		for i, member := range batches[0] {
+			if i < len(scores) {
+				score := scores[i]
+				member.PreviousScore = &score
+			}
+		}
+		return []error{nil}
+	}
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const rollbackMemberServiceLabel = "rollback member"
+
+const rollbackMemberOrder = "desc"
+
+// RollbackMember revert the changes of member score in leaderboard made at unix timestamp since or after,
+// restoring the score it had before them or removing it if it did not exist. Frozen leaderboards and score
+// rules are not checked. The rollback is recorded in the ledger with the origin of ctx, with reason
+// rollback if it has none, published to the event sink and returned. It returns ScoreChangesNotFoundError
+// if there are no changes
+func (s *Service) RollbackMember(ctx context.Context, leaderboard, member string, since int64) (*model.LedgerEntry, error) {
+	entries, err := s.Database.GetLedgerEntries(ctx, leaderboard, member, time.Unix(since, 0), 0, 1)
+	if err != nil {
+		return nil, NewGeneralError(rollbackMemberServiceLabel, err.Error())
+	}
+
+	if len(entries) == 0 {
+		return nil, NewScoreChangesNotFoundError(leaderboard, member, since)
+	}
+
+	settings, err := s.getLeaderboardSettings(ctx, leaderboard)
+	if err != nil {
+		return nil, NewGeneralError(rollbackMemberServiceLabel, err.Error())
+	}
+
+	previousRanks, err := s.getEventRanks(ctx, leaderboard, member)
+	if err != nil {
+		return nil, NewGeneralError(rollbackMemberServiceLabel, err.Error())
+	}
+
+	change, err := s.restoreMemberScore(ctx, leaderboard, member, entries[0].OldScore, settings)
+	if err != nil {
+		return nil, NewGeneralError(rollbackMemberServiceLabel, err.Error())
+	}
+
+	if getScoreChangeOrigin(ctx).reason == "" {
+		ctx = WithScoreChangeOrigin(ctx, model.LedgerReasonRollback, getScoreChangeOrigin(ctx).caller)
+	}
+
+	s.afterCommit(ctx, AfterCommitLedger, s.recordScoreChanges(ctx, leaderboard, settings, []*database.LedgerEntry{change}))
+
+	s.afterCommit(ctx, AfterCommitEvents, s.publishScoreChanges(ctx, leaderboard, []*database.LedgerEntry{change}, previousRanks))
+
+	return convertDatabaseLedgerEntryIntoModelLedgerEntry(leaderboard, change), nil
+}
+
+func (s *Service) restoreMemberScore(ctx context.Context, leaderboard, member string, score *int64, settings *model.LeaderboardSettings) (*database.LedgerEntry, error) {
+	if score != nil {
+		databaseMember := &database.Member{
+			Member: member,
+			Score:  toStoredScore(settings, float64(*score)),
+		}
+
+		err := s.Database.SetMembers(ctx, leaderboard, []*database.Member{databaseMember})
+		if err != nil {
+			return nil, err
+		}
+
+		return newScoreChange(member, toLedgerScore(settings, databaseMember.PreviousScore), score), nil
+	}
+
+	databaseMembers, err := s.Database.GetMembers(ctx, leaderboard, rollbackMemberOrder, false, member)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(databaseMembers) == 0 || databaseMembers[0] == nil {
+		return newScoreChange(member, nil, nil), nil
+	}
+
+	err = s.Database.RemoveMembers(ctx, leaderboard, member)
+	if err != nil {
+		return nil, err
+	}
+
+	return newScoreChange(member, toLedgerScore(settings, &databaseMembers[0].Score), nil), nil
+}
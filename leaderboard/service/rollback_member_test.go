@@ -0,0 +1,121 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service RollbackMember", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+	var member string = "member1"
+	var since int64 = 1600000000
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should restore score member had before the oldest change since time", func() {
+		oldScore := int64(10)
+		newScore := int64(50)
+		currentScore := float64(80)
+		mock.EXPECT().GetLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Eq(time.Unix(since, 0)), gomock.Eq(0), gomock.Eq(1)).Return([]*database.LedgerEntry{
+			{Member: member, OldScore: &oldScore, NewScore: &newScore, Delta: 40},
+		}, nil)
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq([]*database.Member{{Member: member, Score: 10}})).
+			DoAndReturn(func(ctx context.Context, leaderboard string, databaseMembers []*database.Member) error {
+				databaseMembers[0].PreviousScore = &currentScore
+				return nil
+			})
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		entry, err := svc.RollbackMember(context.Background(), leaderboard, member, since)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entry.PublicID).To(Equal(member))
+		Expect(*entry.OldScore).To(Equal(int64(80)))
+		Expect(*entry.NewScore).To(Equal(int64(10)))
+		Expect(entry.Delta).To(Equal(int64(-70)))
+		Expect(entry.Reason).To(Equal(model.LedgerReasonRollback))
+	})
+
+	It("Should trim ledger by the ledger settings of leaderboard", func() {
+		oldScore := int64(10)
+		newScore := int64(50)
+		mock.EXPECT().GetLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.LedgerEntry{
+			{Member: member, OldScore: &oldScore, NewScore: &newScore, Delta: 40},
+		}, nil)
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"ledgerRetention":  "3600",
+			"ledgerMaxEntries": "100",
+		}, nil)
+		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Eq(time.Time{}), gomock.Eq(100)).
+			DoAndReturn(func(ctx context.Context, leaderboard string, entries []*database.LedgerEntry, removeBefore, expireAt time.Time, maxEntries int) error {
+				Expect(removeBefore).To(BeTemporally("~", time.Now().Add(-time.Hour), time.Second))
+				return nil
+			})
+
+		_, err := svc.RollbackMember(context.Background(), leaderboard, member, since)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should remove member if it did not exist before the oldest change since time", func() {
+		newScore := int64(50)
+		mock.EXPECT().GetLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.LedgerEntry{
+			{Member: member, NewScore: &newScore, Delta: 50},
+		}, nil)
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq(member)).Return([]*database.Member{
+			{Member: member, Score: 50},
+		}, nil)
+		mock.EXPECT().RemoveMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member)).Return(nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		ctx := service.WithScoreChangeOrigin(context.Background(), "cheating", "admin")
+		entry, err := svc.RollbackMember(ctx, leaderboard, member, since)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*entry.OldScore).To(Equal(int64(50)))
+		Expect(entry.NewScore).To(BeNil())
+		Expect(entry.Delta).To(Equal(int64(-50)))
+		Expect(entry.Reason).To(Equal("cheating"))
+		Expect(entry.Caller).To(Equal("admin"))
+	})
+
+	It("Should return ScoreChangesNotFoundError if member score did not change since time", func() {
+		mock.EXPECT().GetLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.LedgerEntry{}, nil)
+
+		_, err := svc.RollbackMember(context.Background(), leaderboard, member, since)
+		Expect(err).To(Equal(service.NewScoreChangesNotFoundError(leaderboard, member, since)))
+	})
+
+	It("Should return error if database return in error", func() {
+		oldScore := int64(10)
+		mock.EXPECT().GetLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(member), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.LedgerEntry{
+			{Member: member, OldScore: &oldScore},
+		}, nil)
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(fmt.Errorf("Database error example"))
+
+		_, err := svc.RollbackMember(context.Background(), leaderboard, member, since)
+		Expect(err).To(Equal(service.NewGeneralError("rollback member", "Database error example")))
+	})
+})
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+func hasScoreRules(settings *model.LeaderboardSettings) bool {
+	return settings.MinScore != nil || settings.MaxScore != nil || settings.MaxIncrement > 0 ||
+		hasMaxIncrease(settings) || settings.MonotonicOnly
+}
+
+// scoreRulesAttempts is how many times writes are checked against score rules and applied before giving up
+// when the scores they were checked against keep changing in between
+const scoreRulesAttempts = 3
+
+// checkedScores are what ensureScoresAllowed checked the writes of members against: the stored scores
+// members had, nil for members that did not exist, and the increases of the writes limited by MaxIncrease
+type checkedScores struct {
+	scores    map[string]*float64
+	increases map[string]int64
+}
+
+// writeAllowedScores check members with ensureScoresAllowed and call write with what they were checked
+// against, for write to guard the scores written with setMembersGuards so they are only applied if members
+// scores and total increases did not change since. Both are retried while write returns ScoreChangedError,
+// returning the service ScoreChangedError after scoreRulesAttempts
+func (s *Service) writeAllowedScores(ctx context.Context, leaderboard string, settings *model.LeaderboardSettings, members []*model.Member, increment bool, write func(checked *checkedScores) error) error {
+	for attempt := 1; ; attempt++ {
+		checked, err := s.ensureScoresAllowed(ctx, leaderboard, settings, members, increment)
+		if err != nil {
+			return err
+		}
+
+		err = write(checked)
+		if _, ok := err.(*database.ScoreChangedError); ok {
+			if attempt < scoreRulesAttempts {
+				continue
+			}
+			return NewScoreChangedError(leaderboard)
+		}
+		return err
+	}
+}
+
+// ensureScoresAllowed return ScoreRejectedError if the score of a member breaks a rule of leaderboard
+// settings, recording every rejected score in the leaderboard review queue. When increment is true
+// members scores are increments of their current scores. It return the scores checked and the increases
+// limited by MaxIncrease of the members, that are only counted by the write of the scores so rejected or
+// duplicated writes do not count them, or nil if leaderboard has no score rules
+func (s *Service) ensureScoresAllowed(ctx context.Context, leaderboard string, settings *model.LeaderboardSettings, members []*model.Member, increment bool) (*checkedScores, error) {
+	if !hasScoreRules(settings) {
+		return nil, nil
+	}
+
+	memberIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		memberIDs = append(memberIDs, member.PublicID)
+	}
+
+	databaseMembers, err := s.Database.GetMembers(ctx, leaderboard, setMembersOrder, false, memberIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	totalIncreases := make([]int64, len(members))
+	if hasMaxIncrease(settings) {
+		totalIncreases, err = s.Database.GetMembersIncreases(ctx, leaderboard, maxIncreaseWindow(settings), memberIDs...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	checked := &checkedScores{
+		scores:    map[string]*float64{},
+		increases: map[string]int64{},
+	}
+	rejectedScores := []*database.RejectedScore{}
+	for i, member := range members {
+		var previousScore int64
+		checked.scores[member.PublicID] = nil
+		if databaseMembers[i] != nil {
+			previousScore = toDecayedScore(settings, databaseMembers[i].Score)
+			storedScore := databaseMembers[i].Score
+			checked.scores[member.PublicID] = &storedScore
+		}
+
+		score := member.Score
+		if increment {
+			score = previousScore + member.Score
+		}
+
+		rule := brokenScoreRule(settings, previousScore, score, totalIncreases[i])
+		if rule != "" {
+			rejectedScores = append(rejectedScores, &database.RejectedScore{
+				Member:        member.PublicID,
+				Score:         score,
+				PreviousScore: previousScore,
+				Rule:          rule,
+				RejectedAt:    time.Now(),
+			})
+			continue
+		}
+
+		if hasMaxIncrease(settings) && score > previousScore {
+			checked.increases[member.PublicID] = score - previousScore
+		}
+	}
+
+	for _, rejectedScore := range rejectedScores {
+		err = s.Database.AddRejectedScore(ctx, leaderboard, rejectedScore)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(rejectedScores) > 0 {
+		return nil, NewScoreRejectedError(leaderboard, rejectedScores[0].Member, rejectedScores[0].Rule)
+	}
+
+	return checked, nil
+}
+
+// brokenScoreRule return the first rule of leaderboard settings broken by changing member score
+// from previousScore to score when member total increase in the current window is totalIncrease,
+// or an empty string
+func brokenScoreRule(settings *model.LeaderboardSettings, previousScore, score, totalIncrease int64) string {
+	increase := score - previousScore
+
+	switch {
+	case settings.MinScore != nil && score < *settings.MinScore:
+		return model.ScoreRuleMinScore
+	case settings.MaxScore != nil && score > *settings.MaxScore:
+		return model.ScoreRuleMaxScore
+	case settings.MonotonicOnly && increase < 0:
+		return model.ScoreRuleMonotonicOnly
+	case settings.MaxIncrement > 0 && increase > settings.MaxIncrement:
+		return model.ScoreRuleMaxIncrement
+	case hasMaxIncrease(settings) && increase > 0 && totalIncrease+increase > settings.MaxIncrease:
+		return model.ScoreRuleMaxIncrease
+	}
+
+	return ""
+}
+
+func hasMaxIncrease(settings *model.LeaderboardSettings) bool {
+	return settings.MaxIncrease > 0 && settings.MaxIncreaseWindow > 0
+}
+
+func maxIncreaseWindow(settings *model.LeaderboardSettings) time.Duration {
+	return time.Duration(settings.MaxIncreaseWindow) * time.Second
+}
+
+// setMembersGuards guard the writes of databaseMembers with their scores in checked, returned by
+// ensureScoresAllowed, and set the Increase capped by MaxIncrease of the ones found in its increases
+func setMembersGuards(databaseMembers []*database.Member, checked *checkedScores, settings *model.LeaderboardSettings) {
+	if checked == nil {
+		return
+	}
+
+	for _, member := range databaseMembers {
+		if score, ok := checked.scores[member.Member]; ok {
+			member.Guard = &database.ScoreGuard{Score: score}
+		}
+		if increase, ok := checked.increases[member.Member]; ok {
+			member.Increase = &database.Increase{
+				Value:  increase,
+				Window: maxIncreaseWindow(settings),
+				Max:    settings.MaxIncrease,
+			}
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service score rules", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should reject score lower than minScore and record it for review", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"minScore": "0",
+		}, nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq("member")).Return([]*database.Member{nil}, nil)
+		mock.EXPECT().AddRejectedScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, leaderboard string, rejectedScore *database.RejectedScore) error {
+				Expect(rejectedScore.Member).To(Equal("member"))
+				Expect(rejectedScore.Score).To(Equal(int64(-10)))
+				Expect(rejectedScore.PreviousScore).To(Equal(int64(0)))
+				Expect(rejectedScore.Rule).To(Equal(model.ScoreRuleMinScore))
+				Expect(rejectedScore.RejectedAt).To(BeTemporally("~", time.Now(), time.Second))
+				return nil
+			})
+
+		_, err := svc.SetMemberScore(context.Background(), leaderboard, "member", -10, false, "", nil, nil)
+		Expect(err).To(Equal(service.NewScoreRejectedError(leaderboard, "member", model.ScoreRuleMinScore)))
+	})
+
+	It("Should reject increment that would exceed maxScore", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"maxScore": "100",
+		}, nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq("member")).Return([]*database.Member{
+			{Member: "member", Score: 95},
+		}, nil)
+		mock.EXPECT().AddRejectedScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(nil)
+
+		_, err := svc.IncrementMemberScore(context.Background(), leaderboard, "member", 10, "", nil)
+		Expect(err).To(Equal(service.NewScoreRejectedError(leaderboard, "member", model.ScoreRuleMaxScore)))
+	})
+
+	It("Should reject score lower than current score if leaderboard is monotonic only", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"monotonicOnly": "true",
+		}, nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq("member1"), gomock.Eq("member2")).Return([]*database.Member{
+			{Member: "member1", Score: 10},
+			{Member: "member2", Score: 50},
+		}, nil)
+		mock.EXPECT().AddRejectedScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, leaderboard string, rejectedScore *database.RejectedScore) error {
+				Expect(rejectedScore.Member).To(Equal("member2"))
+				Expect(rejectedScore.Rule).To(Equal(model.ScoreRuleMonotonicOnly))
+				return nil
+			})
+
+		err := svc.SetMembersScore(context.Background(), leaderboard, []*model.Member{
+			{PublicID: "member1", Score: 20},
+			{PublicID: "member2", Score: 40},
+		}, false, "", nil)
+		Expect(err).To(Equal(service.NewScoreRejectedError(leaderboard, "member2", model.ScoreRuleMonotonicOnly)))
+	})
+
+	It("Should reject increment greater than maxIncrement", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"maxIncrement": "100",
+		}, nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq("member")).Return([]*database.Member{nil}, nil)
+		mock.EXPECT().AddRejectedScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(nil)
+
+		_, err := svc.IncrementMemberScore(context.Background(), leaderboard, "member", 101, "", nil)
+		Expect(err).To(Equal(service.NewScoreRejectedError(leaderboard, "member", model.ScoreRuleMaxIncrement)))
+	})
+
+	It("Should reject score if member total increase in window exceeds maxIncrease", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"maxIncrease":       "1000",
+			"maxIncreaseWindow": "3600",
+		}, nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq("member")).Return([]*database.Member{
+			{Member: "member", Score: 100},
+		}, nil)
+		mock.EXPECT().GetMembersIncreases(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(time.Hour), gomock.Eq("member")).Return([]int64{700}, nil)
+		mock.EXPECT().AddRejectedScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(nil)
+
+		_, err := svc.SetMemberScore(context.Background(), leaderboard, "member", 500, false, "", nil, nil)
+		Expect(err).To(Equal(service.NewScoreRejectedError(leaderboard, "member", model.ScoreRuleMaxIncrease)))
+	})
+
+	It("Should return the stored result of an idempotent retry without checking the rules again", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"maxIncrease":       "1000",
+			"maxIncreaseWindow": "3600",
+		}, nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().GetIdempotentWrite(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, leaderboard string, databaseMembers []*database.Member, idempotency *database.Idempotency) (bool, error) {
+				Expect(idempotency.Key).To(Equal("request1"))
+				databaseMembers[0].Score = 600
+				databaseMembers[0].Version = 2
+				return true, nil
+			})
+
+		member, err := svc.IncrementMemberScore(context.Background(), leaderboard, "member", 500, "", &model.IdempotencyKey{Key: "request1", Window: time.Minute})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(member.Score).To(Equal(int64(600)))
+		Expect(member.Version).To(Equal(int64(2)))
+	})
+
+	It("Should write score that follows all rules", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"minScore":          "0",
+			"maxScore":          "10000",
+			"maxIncrement":      "500",
+			"maxIncrease":       "1000",
+			"maxIncreaseWindow": "3600",
+			"monotonicOnly":     "true",
+		}, nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq("member")).Return([]*database.Member{
+			{Member: "member", Score: 100},
+		}, nil)
+		mock.EXPECT().GetMembersIncreases(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(time.Hour), gomock.Eq("member")).Return([]int64{600}, nil)
+		previousScore := float64(100)
+		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq([]*database.Member{{
+			Member:   "member",
+			Score:    500,
+			Increase: &database.Increase{Value: 400, Window: time.Hour, Max: 1000},
+			Guard:    &database.ScoreGuard{Score: &previousScore},
+		}})).Return(nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(true), gomock.Eq("member")).Return([]*database.Member{
+			{Member: "member", Score: 500, Rank: 0},
+		}, nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		member, err := svc.SetMemberScore(context.Background(), leaderboard, "member", 500, false, "", nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(member.Score).To(Equal(int64(500)))
+	})
+
+	It("Should check score rules again if member score changed before the write", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"monotonicOnly": "true",
+		}, nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		gomock.InOrder(
+			mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq("member")).Return([]*database.Member{
+				{Member: "member", Score: 100},
+			}, nil),
+			mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(database.NewScoreChangedError(leaderboard)),
+			mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq("member")).Return([]*database.Member{
+				{Member: "member", Score: 600},
+			}, nil),
+			mock.EXPECT().AddRejectedScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, leaderboard string, rejectedScore *database.RejectedScore) error {
+					Expect(rejectedScore.PreviousScore).To(Equal(int64(600)))
+					Expect(rejectedScore.Rule).To(Equal(model.ScoreRuleMonotonicOnly))
+					return nil
+				}),
+		)
+
+		_, err := svc.SetMemberScore(context.Background(), leaderboard, "member", 500, false, "", nil, nil)
+		Expect(err).To(Equal(service.NewScoreRejectedError(leaderboard, "member", model.ScoreRuleMonotonicOnly)))
+	})
+
+	It("Should return ScoreChangedError if member score kept changing before the write", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"monotonicOnly": "true",
+		}, nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq("member")).Return([]*database.Member{nil}, nil).Times(3)
+		mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Eq(float64(10))).Return(database.NewScoreChangedError(leaderboard)).Times(3)
+
+		_, err := svc.IncrementMemberScore(context.Background(), leaderboard, "member", 10, "", nil)
+		Expect(err).To(Equal(service.NewScoreChangedError(leaderboard)))
+	})
+
+	It("Should return error if database AddRejectedScore return in error", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"maxScore": "100",
+		}, nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq("member")).Return([]*database.Member{nil}, nil)
+		mock.EXPECT().AddRejectedScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(fmt.Errorf("New database error"))
+
+		_, err := svc.SetMemberScore(context.Background(), leaderboard, "member", 500, false, "", nil, nil)
+		Expect(err).To(Equal(service.NewGeneralError("set member score", "New database error")))
+	})
+})
@@ -1,15 +1,48 @@
 package service
 
 import (
+	"context"
+
 	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/events"
+	"github.com/topfreegames/podium/leaderboard/v2/lifecycle"
+	"github.com/topfreegames/podium/leaderboard/v2/milestones"
 )
 
 // Service holds all dependencies to leaderboard execute your operations
 type Service struct {
 	database.Database
+	// EventSink receives score events of writes, nil disables them
+	EventSink events.EventSink
+	// MilestoneNotifier receives milestones reached by writes, nil disables them
+	MilestoneNotifier milestones.Notifier
+	// LifecycleNotifier receives lifecycle events of leaderboards, nil disables them
+	LifecycleNotifier lifecycle.Notifier
+	// OnAfterCommitError is called with the errors of steps run after a write was applied, like recording it in
+	// the ledger or publishing its score events. They are not returned, so clients don't retry applied writes,
+	// nil ignores them
+	OnAfterCommitError func(ctx context.Context, step string, err error)
 }
 
+// Steps run after a write was applied, reported to OnAfterCommitError when they fail
+const (
+	AfterCommitLedger     = "ledger"
+	AfterCommitHistory    = "history"
+	AfterCommitEvents     = "events"
+	AfterCommitMilestones = "milestones"
+	AfterCommitLifecycle  = "lifecycle"
+)
+
 // NewService instantiate a new Service
 func NewService(database database.Database) *Service {
-	return &Service{database}
+	return &Service{Database: database}
+}
+
+// afterCommit report err of step, run after a write was applied, to OnAfterCommitError unless it is nil
+func (s *Service) afterCommit(ctx context.Context, step string, err error) {
+	if err == nil || s.OnAfterCommitError == nil {
+		return
+	}
+
+	s.OnAfterCommitError(ctx, step, err)
 }
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 
+	"github.com/topfreegames/podium/leaderboard/v2/database"
 	"github.com/topfreegames/podium/leaderboard/v2/expiration"
 	"github.com/topfreegames/podium/leaderboard/v2/model"
 )
@@ -11,8 +12,15 @@ const setMemberScoreServiceLabel = "set member score"
 
 const setMemberOrder = "desc"
 
-// SetMemberScore return member informations that is
-func (s *Service) SetMemberScore(ctx context.Context, leaderboard, member string, score int64, prevRank bool, scoreTTL string) (*model.Member, error) {
+// SetMemberScore return member informations that is. When idempotency is set a retry of the same
+// write returns the member informations of the first one without applying it again, and a different
+// write with the same key fails with IdempotencyKeyReusedError. When condition
+// is set the score is only written if the member still has the expected score and version, otherwise
+// ScoreConditionFailedError is returned without using idempotency. Scores of members blocked in shadow
+// mode are written to the shadow leaderboard without checking condition. The score change is recorded in
+// the ledger with the origin of ctx and published to the event sink, and milestone rules reached by the
+// member are sent to the milestone notifier
+func (s *Service) SetMemberScore(ctx context.Context, leaderboard, member string, score int64, prevRank bool, scoreTTL string, idempotency *model.IdempotencyKey, condition *model.ScoreCondition) (*model.Member, error) {
 	members := []*model.Member{
 		{
 			PublicID: member,
@@ -20,6 +28,48 @@ func (s *Service) SetMemberScore(ctx context.Context, leaderboard, member string
 		},
 	}
 
+	settings, err := s.getLeaderboardSettings(ctx, leaderboard)
+	if err != nil {
+		return nil, NewGeneralError(setMemberScoreServiceLabel, err.Error())
+	}
+
+	err = s.ensureWritable(ctx, leaderboard, settings, member)
+	if err != nil {
+		if isWriteRejectedError(err) {
+			return nil, err
+		}
+		return nil, NewGeneralError(setMemberScoreServiceLabel, err.Error())
+	}
+
+	_, shadowMembers, err := s.partitionBlockedMembers(ctx, leaderboard, members)
+	if err != nil {
+		if isWriteRejectedError(err) {
+			return nil, err
+		}
+		return nil, NewGeneralError(setMemberScoreServiceLabel, err.Error())
+	}
+
+	if len(shadowMembers) > 0 {
+		err = s.persistShadowMembers(ctx, leaderboard, shadowMembers, settings)
+		if err != nil {
+			return nil, NewGeneralError(setMemberScoreServiceLabel, err.Error())
+		}
+		return shadowMembers[0], nil
+	}
+
+	// a retry of an applied conditional write no longer matches its condition, so it needs idempotency too
+	databaseIdempotency := getIdempotency(idempotency, conditionalOperation(setMemberScoreServiceLabel, condition), scoreTTL, members)
+	duplicate, err := s.getIdempotentWrite(ctx, leaderboard, members, databaseIdempotency, prevRank, settings)
+	if err != nil {
+		if _, ok := err.(*database.IdempotencyKeyReusedError); ok {
+			return nil, NewIdempotencyKeyReusedError(leaderboard, idempotency.Key)
+		}
+		return nil, NewGeneralError(setMemberScoreServiceLabel, err.Error())
+	}
+	if duplicate {
+		return members[0], nil
+	}
+
 	if prevRank {
 		err := s.setMembersPreviousRank(ctx, leaderboard, members, setMemberOrder)
 		if err != nil {
@@ -27,16 +77,53 @@ func (s *Service) SetMemberScore(ctx context.Context, leaderboard, member string
 		}
 	}
 
-	err := s.persistMembers(ctx, leaderboard, members)
+	previousRanks, err := s.getPreviousRanks(ctx, leaderboard, settings, member)
 	if err != nil {
 		return nil, NewGeneralError(setMemberScoreServiceLabel, err.Error())
 	}
 
-	err = s.setMembersValues(ctx, leaderboard, members, setMemberOrder)
+	expireAt, err := getScoreExpireAt(scoreTTL)
 	if err != nil {
 		return nil, NewGeneralError(setMemberScoreServiceLabel, err.Error())
 	}
 
+	var changes []*database.LedgerEntry
+	err = s.writeAllowedScores(ctx, leaderboard, settings, members, false, func(checked *checkedScores) error {
+		var err error
+		if condition != nil {
+			var change *database.LedgerEntry
+			duplicate, change, err = s.persistMemberIfMatch(ctx, leaderboard, members[0], settings, checked, condition, databaseIdempotency, prevRank, expireAt)
+			changes = []*database.LedgerEntry{change}
+			return err
+		}
+
+		duplicate, changes, err = s.persistMembers(ctx, leaderboard, members, settings, checked, databaseIdempotency, prevRank, expireAt)
+		return err
+	})
+	if err != nil {
+		if isWriteRejectedError(err) {
+			return nil, err
+		}
+		if _, ok := err.(*database.ConditionFailedError); ok {
+			return nil, NewScoreConditionFailedError(leaderboard, member)
+		}
+		if _, ok := err.(*database.IdempotencyKeyReusedError); ok {
+			return nil, NewIdempotencyKeyReusedError(leaderboard, idempotency.Key)
+		}
+		return nil, NewGeneralError(setMemberScoreServiceLabel, err.Error())
+	}
+
+	if duplicate {
+		return members[0], nil
+	}
+
+	if idempotency == nil {
+		err = s.setMembersValues(ctx, leaderboard, members, setMemberOrder, settings)
+		if err != nil {
+			return nil, NewGeneralError(setMemberScoreServiceLabel, err.Error())
+		}
+	}
+
 	err = s.persistLeaderboardExpirationTime(ctx, leaderboard)
 	if err != nil {
 		if _, ok := err.(*expiration.LeaderboardExpiredError); ok {
@@ -45,12 +132,25 @@ func (s *Service) SetMemberScore(ctx context.Context, leaderboard, member string
 		return nil, NewGeneralError(setMemberScoreServiceLabel, err.Error())
 	}
 
+	err = s.notifyLeaderboardCreated(ctx, leaderboard)
+	if err != nil {
+		return nil, NewGeneralError(setMemberScoreServiceLabel, err.Error())
+	}
+
 	if scoreTTL != "" {
-		err = s.persistMembersTTL(ctx, leaderboard, members, scoreTTL)
+		err = s.persistMembersTTL(ctx, leaderboard, members, expireAt)
 		if err != nil {
 			return nil, NewGeneralError(setMemberScoreServiceLabel, err.Error())
 		}
 	}
 
+	s.afterCommit(ctx, AfterCommitLedger, s.recordScoreChanges(ctx, leaderboard, settings, changes))
+
+	s.afterCommit(ctx, AfterCommitHistory, s.recordMembersHistory(ctx, leaderboard, members, settings))
+
+	s.afterCommit(ctx, AfterCommitEvents, s.publishScoreChanges(ctx, leaderboard, changes, previousRanks))
+
+	s.afterCommit(ctx, AfterCommitMilestones, s.notifyMilestones(ctx, leaderboard, settings, members[0], previousRanks))
+
 	return members[0], nil
 }
@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/events"
 	"github.com/topfreegames/podium/leaderboard/v2/expiration"
 	"github.com/topfreegames/podium/leaderboard/v2/model"
 	"github.com/topfreegames/podium/leaderboard/v2/service"
@@ -56,7 +57,9 @@ var _ = Describe("Service SetMemberScore", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mock = database.NewMockDatabase(ctrl)
 
-		svc = &service.Service{mock}
+		svc = &service.Service{Database: mock}
+
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Any()).Return(map[string]string{}, nil).AnyTimes()
 	})
 
 	AfterEach(func() {
@@ -65,6 +68,7 @@ var _ = Describe("Service SetMemberScore", func() {
 
 	Describe("When previousRank is false", func() {
 		It("Should set Members with previousRank equals zero", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 			expectedMember := &model.Member{
 				PublicID:     "member1",
 				Score:        1,
@@ -80,8 +84,9 @@ var _ = Describe("Service SetMemberScore", func() {
 				gomock.Eq(true),
 				gomock.Eq(databaseMembersToGetRank[0]),
 			).Return(databaseMembersReturned, nil)
+			mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
-			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL)
+			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(member).To(Equal(expectedMember))
@@ -92,6 +97,7 @@ var _ = Describe("Service SetMemberScore", func() {
 		previousRank := true
 
 		It("Should set Members with previousRank", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 			expectedMember := &model.Member{
 				PublicID:     "member1",
 				Score:        1,
@@ -115,14 +121,16 @@ var _ = Describe("Service SetMemberScore", func() {
 				gomock.Eq(true),
 				gomock.Eq(databaseMembersToGetRank[0]),
 			).Return(databaseMembersReturned, nil)
+			mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
-			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL)
+			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(member).To(Equal(expectedMember))
 		})
 
 		It("Should set a non existent member as rank equals to -1", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 			databaseMembersPreviousRankReturned := []*database.Member{nil}
 			expectedMember := &model.Member{
 				PublicID:     "member1",
@@ -147,14 +155,16 @@ var _ = Describe("Service SetMemberScore", func() {
 				gomock.Eq(true),
 				gomock.Eq(databaseMembersToGetRank[0]),
 			).Return(databaseMembersReturned, nil)
+			mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
-			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL)
+			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(member).To(Equal(expectedMember))
 		})
 
 		It("Should return error if GetMembers return in error", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 			mock.EXPECT().GetMembers(
 				gomock.Any(),
 				gomock.Eq(leaderboard),
@@ -163,13 +173,14 @@ var _ = Describe("Service SetMemberScore", func() {
 				gomock.Eq(databaseMembersToGetRank[0]),
 			).Times(1).Return(nil, fmt.Errorf("New database error"))
 
-			_, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL)
+			_, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, nil, nil)
 			Expect(err).To(Equal(service.NewGeneralError("set member score", "New database error")))
 		})
 	})
 
 	Describe("When scoreTTL is empty", func() {
 		It("Should SetMembers without filling expire ordered set", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 			expectedMember := &model.Member{
 				PublicID:     "member1",
 				Score:        1,
@@ -185,8 +196,9 @@ var _ = Describe("Service SetMemberScore", func() {
 				gomock.Eq(true),
 				gomock.Eq(databaseMembersToGetRank[0]),
 			).Return(databaseMembersReturned, nil)
+			mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
-			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL)
+			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(member).To(Equal(expectedMember))
@@ -197,6 +209,7 @@ var _ = Describe("Service SetMemberScore", func() {
 		scoreTTL := "100"
 
 		It("Should SetMembers filling expire ordered set", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 			mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Return(nil)
 			mock.EXPECT().GetMembers(
 				gomock.Any(),
@@ -207,8 +220,9 @@ var _ = Describe("Service SetMemberScore", func() {
 			).Return(databaseMembersReturned, nil)
 
 			mock.EXPECT().SetMembersTTL(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Times(1).Return(nil)
+			mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
-			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL)
+			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(member.ExpireAt).To(BeNumerically("~", time.Now().Add(100*time.Second).Unix(), 100))
@@ -218,8 +232,136 @@ var _ = Describe("Service SetMemberScore", func() {
 	Describe("When scoreTTL is invalid", func() {
 		scoreTTL := "invalid"
 
-		It("Should return error", func() {
-			mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Return(nil)
+		It("Should return error without writing", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+			_, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, nil, nil)
+			Expect(err).To(MatchError(service.NewGeneralError("set member score", "strconv.ParseInt: parsing \"invalid\": invalid syntax")))
+
+		})
+	})
+
+	Describe("When idempotency key is set", func() {
+		idempotency := &model.IdempotencyKey{Key: "request1", Window: time.Hour}
+
+		fillMembers := func(duplicate bool) func(context.Context, string, []*database.Member, *database.Idempotency) (bool, error) {
+			return func(ctx context.Context, leaderboard string, databaseMembers []*database.Member, idempotency *database.Idempotency) (bool, error) {
+				databaseMembers[0].Score = 1
+				databaseMembers[0].Rank = 1
+				databaseMembers[0].Version = 4
+				databaseMembers[0].PreviousRank = 2
+				return duplicate, nil
+			}
+		}
+
+		It("Should set Members with the values of the write", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+			expectedMember := &model.Member{
+				PublicID:     "member1",
+				Score:        1,
+				PreviousRank: 0,
+				Rank:         2,
+				Version:      4,
+			}
+
+			mock.EXPECT().SetMembersIdempotent(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, leaderboard string, databaseMembers []*database.Member, databaseIdempotency *database.Idempotency) (bool, error) {
+					Expect(databaseIdempotency.Key).To(Equal("request1"))
+					Expect(databaseIdempotency.Window).To(Equal(time.Hour))
+					Expect(databaseIdempotency.Fingerprint).NotTo(BeEmpty())
+					return fillMembers(false)(ctx, leaderboard, databaseMembers, databaseIdempotency)
+				})
+			mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, idempotency, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(member).To(Equal(expectedMember))
+		})
+
+		It("Should return the values of the first write if idempotency key was already used", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+			expectedMember := &model.Member{
+				PublicID:     "member1",
+				Score:        1,
+				PreviousRank: 3,
+				Rank:         2,
+				Version:      4,
+			}
+
+			mock.EXPECT().GetMembers(
+				gomock.Any(),
+				gomock.Eq(leaderboard),
+				gomock.Eq("desc"),
+				gomock.Eq(true),
+				gomock.Eq(databaseMembersToGetRank[0]),
+			).Return(databaseMembersPreviousRankReturned, nil)
+			mock.EXPECT().SetMembersIdempotent(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert), gomock.Any()).
+				DoAndReturn(fillMembers(true))
+
+			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, true, scoreTTL, idempotency, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(member).To(Equal(expectedMember))
+		})
+
+		It("Should use the same fingerprint for retries and another for a different write", func() {
+			fingerprints := []string{}
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil).Times(3)
+			mock.EXPECT().SetMembersIdempotent(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, leaderboard string, databaseMembers []*database.Member, databaseIdempotency *database.Idempotency) (bool, error) {
+					fingerprints = append(fingerprints, databaseIdempotency.Fingerprint)
+					return fillMembers(true)(ctx, leaderboard, databaseMembers, databaseIdempotency)
+				}).Times(3)
+
+			for _, memberScore := range []int64{score, score, score + 1} {
+				_, err := svc.SetMemberScore(context.Background(), leaderboard, member, memberScore, previousRank, scoreTTL, idempotency, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			Expect(fingerprints[1]).To(Equal(fingerprints[0]))
+			Expect(fingerprints[2]).NotTo(Equal(fingerprints[0]))
+		})
+
+		It("Should return IdempotencyKeyReusedError if idempotency key was used by a different write", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+			mock.EXPECT().SetMembersIdempotent(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any()).
+				Return(false, database.NewIdempotencyKeyReusedError(leaderboard, "request1"))
+
+			_, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, idempotency, nil)
+			Expect(err).To(Equal(service.NewIdempotencyKeyReusedError(leaderboard, "request1")))
+		})
+
+		It("Should return error if database SetMembersIdempotent return in error", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+			mock.EXPECT().SetMembersIdempotent(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any()).Return(false, fmt.Errorf("New database error"))
+
+			_, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, idempotency, nil)
+			Expect(err).To(MatchError(service.NewGeneralError("set member score", "New database error")))
+		})
+	})
+
+	Describe("When condition is set", func() {
+		expectedScore := int64(2)
+		expectedVersion := int64(3)
+		condition := &model.ScoreCondition{ExpectedScore: &expectedScore, ExpectedVersion: &expectedVersion}
+
+		It("Should set member if it matches condition and return its new version", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+			expectedMember := &model.Member{
+				PublicID: "member1",
+				Score:    1,
+				Rank:     2,
+				Version:  4,
+			}
+
+			mock.EXPECT().SetMemberIfMatch(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(&database.Member{Member: "member1", Score: 1}), gomock.Eq(&database.Condition{
+				Score:     func() *float64 { score := float64(2); return &score }(),
+				Tolerance: 0.5,
+				Version:   &expectedVersion,
+			})).DoAndReturn(func(ctx context.Context, leaderboard string, member *database.Member, condition *database.Condition) error {
+				member.Version = 4
+				return nil
+			})
 			mock.EXPECT().GetMembers(
 				gomock.Any(),
 				gomock.Eq(leaderboard),
@@ -227,21 +369,197 @@ var _ = Describe("Service SetMemberScore", func() {
 				gomock.Eq(true),
 				gomock.Eq(databaseMembersToGetRank[0]),
 			).Return(databaseMembersReturned, nil)
+			mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
-			_, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL)
-			Expect(err).To(MatchError(service.NewGeneralError("set member score", "strconv.ParseInt: parsing \"invalid\": invalid syntax")))
+			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, nil, condition)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(member).To(Equal(expectedMember))
+		})
+
+		It("Should return ScoreConditionFailedError if member does not match condition", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+			mock.EXPECT().SetMemberIfMatch(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any()).Return(database.NewConditionFailedError(leaderboard, member, 5))
 
+			_, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, nil, condition)
+			Expect(err).To(Equal(service.NewScoreConditionFailedError(leaderboard, member)))
 		})
+
+		It("Should set member with idempotency and score expiration if they are set", func() {
+			idempotency := &model.IdempotencyKey{Key: "request1", Window: time.Hour}
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+			mock.EXPECT().SetMemberIfMatchIdempotent(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, leaderboard string, databaseMember *database.Member, condition *database.Condition, databaseIdempotency *database.Idempotency) (bool, error) {
+					Expect(databaseIdempotency.Key).To(Equal("request1"))
+					Expect(databaseMember.TTL.Unix()).To(BeNumerically("~", time.Now().Add(100*time.Second).Unix(), 100))
+					databaseMember.Score = 1
+					databaseMember.Rank = 1
+					databaseMember.Version = 4
+					return false, nil
+				})
+			mock.EXPECT().SetMembersTTL(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(nil)
+			mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, "100", idempotency, condition)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(member.Version).To(Equal(int64(4)))
+			Expect(member.Rank).To(Equal(2))
+		})
+
+		It("Should return the values of the first write if idempotency key was already used", func() {
+			idempotency := &model.IdempotencyKey{Key: "request1", Window: time.Hour}
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+			mock.EXPECT().SetMemberIfMatchIdempotent(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, leaderboard string, databaseMember *database.Member, condition *database.Condition, databaseIdempotency *database.Idempotency) (bool, error) {
+					databaseMember.Score = 1
+					databaseMember.Rank = 1
+					databaseMember.Version = 4
+					return true, nil
+				})
+
+			member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, idempotency, condition)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(member.Version).To(Equal(int64(4)))
+		})
+
+		It("Should return error if database SetMemberIfMatch return in error", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+			mock.EXPECT().SetMemberIfMatch(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any()).Return(fmt.Errorf("New database error"))
+
+			_, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, nil, condition)
+			Expect(err).To(MatchError(service.NewGeneralError("set member score", "New database error")))
+		})
+	})
+
+	It("Should return member version", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).
+			DoAndReturn(func(ctx context.Context, leaderboard string, databaseMembers []*database.Member) error {
+				databaseMembers[0].Version = 7
+				return nil
+			})
+		mock.EXPECT().GetMembers(
+			gomock.Any(),
+			gomock.Eq(leaderboard),
+			gomock.Eq("desc"),
+			gomock.Eq(true),
+			gomock.Eq(databaseMembersToGetRank[0]),
+		).Return(databaseMembersReturned, nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(member.Version).To(Equal(int64(7)))
+	})
+
+	It("Should record score change in the ledger with the origin of ctx", func() {
+		previousScore := float64(5)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).
+			DoAndReturn(func(ctx context.Context, leaderboard string, databaseMembers []*database.Member) error {
+				databaseMembers[0].PreviousScore = &previousScore
+				return nil
+			})
+		mock.EXPECT().GetMembers(
+			gomock.Any(),
+			gomock.Eq(leaderboard),
+			gomock.Eq("desc"),
+			gomock.Eq(true),
+			gomock.Eq(databaseMembersToGetRank[0]),
+		).Return(databaseMembersReturned, nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Eq(time.Time{}), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, leaderboard string, entries []*database.LedgerEntry, removeBefore, expireAt time.Time, maxEntries int) error {
+				Expect(entries).To(HaveLen(1))
+				Expect(entries[0].Member).To(Equal(member))
+				Expect(*entries[0].OldScore).To(Equal(int64(5)))
+				Expect(*entries[0].NewScore).To(Equal(score))
+				Expect(entries[0].Delta).To(Equal(score - 5))
+				Expect(entries[0].Reason).To(Equal("match"))
+				Expect(entries[0].Caller).To(Equal("game-server"))
+				Expect(entries[0].ChangedAt).NotTo(BeZero())
+				return nil
+			})
+
+		ctx := service.WithScoreChangeOrigin(context.Background(), "match", "game-server")
+		_, err := svc.SetMemberScore(ctx, leaderboard, member, score, previousRank, scoreTTL, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should publish score change to the event sink with member ranks before and after it", func() {
+		previousScore := float64(2)
+		eventSink := events.NewMockEventSink(ctrl)
+		svc.EventSink = eventSink
+
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq(member)).
+			Return(databaseMembersPreviousRankReturned, nil)
+		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).
+			DoAndReturn(func(ctx context.Context, leaderboard string, databaseMembers []*database.Member) error {
+				databaseMembers[0].PreviousScore = &previousScore
+				return nil
+			})
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(true), gomock.Eq(member)).
+			Return(databaseMembersReturned, nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Eq(false), gomock.Eq(member)).
+			Return(databaseMembersReturned, nil)
+		eventSink.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, scoreEvents []*model.ScoreEvent) error {
+				Expect(scoreEvents).To(HaveLen(1))
+				Expect(scoreEvents[0].ID).NotTo(BeEmpty())
+				Expect(scoreEvents[0].Leaderboard).To(Equal(leaderboard))
+				Expect(scoreEvents[0].PublicID).To(Equal(member))
+				Expect(*scoreEvents[0].OldScore).To(Equal(int64(2)))
+				Expect(*scoreEvents[0].NewScore).To(Equal(score))
+				Expect(scoreEvents[0].OldRank).To(Equal(1))
+				Expect(scoreEvents[0].NewRank).To(Equal(2))
+				Expect(scoreEvents[0].Reason).To(Equal("match"))
+				Expect(scoreEvents[0].ChangedAt).NotTo(BeZero())
+				return nil
+			},
+		)
+
+		ctx := service.WithScoreChangeOrigin(context.Background(), "match", "game-server")
+		_, err := svc.SetMemberScore(ctx, leaderboard, member, score, previousRank, scoreTTL, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should report error of event sink after the write and return the member", func() {
+		eventSink := events.NewMockEventSink(ctrl)
+		svc.EventSink = eventSink
+		var steps []string
+		var errs []error
+		svc.OnAfterCommitError = func(ctx context.Context, step string, err error) {
+			steps = append(steps, step)
+			errs = append(errs, err)
+		}
+
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("desc"), gomock.Any(), gomock.Eq(member)).
+			Return(databaseMembersReturned, nil).Times(3)
+		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Return(nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		eventSink.EXPECT().Send(gomock.Any(), gomock.Any()).Return(fmt.Errorf("sink error"))
+
+		written, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(written).NotTo(BeNil())
+		Expect(steps).To(Equal([]string{service.AfterCommitEvents}))
+		Expect(errs).To(Equal([]error{fmt.Errorf("sink error")}))
 	})
 
 	It("Should return error if database SetMembers return in error", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Return(fmt.Errorf("New database error"))
 
-		_, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL)
+		_, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, nil, nil)
 		Expect(err).To(MatchError(service.NewGeneralError("set member score", "New database error")))
 	})
 
 	It("Should return error if database GetMembers return in error", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Return(nil)
 		mock.EXPECT().GetMembers(
 			gomock.Any(),
@@ -251,12 +569,13 @@ var _ = Describe("Service SetMemberScore", func() {
 			gomock.Eq(databaseMembersToGetRank[0]),
 		).Return(nil, fmt.Errorf("New database error"))
 
-		_, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL)
+		_, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, nil, nil)
 		Expect(err).To(MatchError(service.NewGeneralError("set member score", "New database error")))
 
 	})
 
 	It("Should not call database GetLeaderboardExpiration and SetLeaderboardExpiration if leaderboard isn't formatted to have an expiration", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 		expectedMember := &model.Member{
 			PublicID:     "member1",
 			Score:        1,
@@ -272,14 +591,16 @@ var _ = Describe("Service SetMemberScore", func() {
 			gomock.Eq(true),
 			gomock.Eq(databaseMembersToGetRank[0]),
 		).Return(databaseMembersReturned, nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
-		member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL)
+		member, err := svc.SetMemberScore(context.Background(), leaderboard, member, score, previousRank, scoreTTL, nil, nil)
 		Expect(err).NotTo(HaveOccurred())
 
 		Expect(member).To(Equal(expectedMember))
 	})
 
 	It("Should set leaderboard expiration if GetLeaderboardExpiration return TTLNotFoundError", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 		leaderboardExpiration := fmt.Sprintf("year%d", time.Now().UTC().Year())
 		expireAt, err := expiration.GetExpireAt(leaderboardExpiration)
 		Expect(err).NotTo(HaveOccurred())
@@ -295,12 +616,14 @@ var _ = Describe("Service SetMemberScore", func() {
 		mock.EXPECT().GetLeaderboardExpiration(gomock.Any(), gomock.Eq(leaderboardExpiration)).Return(int64(-1), database.NewTTLNotFoundError(leaderboard))
 
 		mock.EXPECT().SetLeaderboardExpiration(gomock.Any(), gomock.Eq(leaderboardExpiration), time.Unix(expireAt, 0)).Return(nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboardExpiration), gomock.Any(), gomock.Any(), gomock.Eq(time.Unix(expireAt, 0)), gomock.Any()).Return(nil)
 
-		_, err = svc.SetMemberScore(context.Background(), leaderboardExpiration, member, score, previousRank, scoreTTL)
+		_, err = svc.SetMemberScore(context.Background(), leaderboardExpiration, member, score, previousRank, scoreTTL, nil, nil)
 		Expect(err).NotTo(HaveOccurred())
 	})
 
 	It("Should return error LeaderboardExpiredError if leaderboard was already expired", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 		leaderboardExpiration := fmt.Sprintf(
 			"testkey-from%dto%d",
 			time.Now().UTC().Add(time.Duration(-2)*time.Second).Unix(),
@@ -316,12 +639,13 @@ var _ = Describe("Service SetMemberScore", func() {
 			gomock.Eq(databaseMembersToGetRank[0]),
 		).Return(databaseMembersReturned, nil)
 
-		_, err := svc.SetMemberScore(context.Background(), leaderboardExpiration, member, score, previousRank, scoreTTL)
+		_, err := svc.SetMemberScore(context.Background(), leaderboardExpiration, member, score, previousRank, scoreTTL, nil, nil)
 		Expect(err).To(MatchError(service.NewLeaderboardExpiredError(leaderboardExpiration)))
 
 	})
 
 	It("Should return error if database GetLeaderboardExpiration return in error", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 		leaderboardExpiration := fmt.Sprintf("year%d", time.Now().UTC().Year())
 
 		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboardExpiration), gomock.Eq(databaseMembersToInsert)).Times(1).Return(nil)
@@ -334,11 +658,12 @@ var _ = Describe("Service SetMemberScore", func() {
 		).Return(databaseMembersReturned, nil)
 		mock.EXPECT().GetLeaderboardExpiration(gomock.Any(), gomock.Eq(leaderboardExpiration)).Return(int64(-1), fmt.Errorf("New database error"))
 
-		_, err := svc.SetMemberScore(context.Background(), leaderboardExpiration, member, score, previousRank, scoreTTL)
+		_, err := svc.SetMemberScore(context.Background(), leaderboardExpiration, member, score, previousRank, scoreTTL, nil, nil)
 		Expect(err).To(MatchError(service.NewGeneralError("set member score", "New database error")))
 	})
 
 	It("Should return error if database SetLeaderboardExpiration return in error", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 		leaderboardExpiration := fmt.Sprintf("year%d", time.Now().UTC().Year())
 		expireAt, err := expiration.GetExpireAt(leaderboardExpiration)
 		Expect(err).NotTo(HaveOccurred())
@@ -355,7 +680,7 @@ var _ = Describe("Service SetMemberScore", func() {
 
 		mock.EXPECT().SetLeaderboardExpiration(gomock.Any(), gomock.Eq(leaderboardExpiration), time.Unix(expireAt, 0)).Return(fmt.Errorf("New database error"))
 
-		_, err = svc.SetMemberScore(context.Background(), leaderboardExpiration, member, score, previousRank, scoreTTL)
+		_, err = svc.SetMemberScore(context.Background(), leaderboardExpiration, member, score, previousRank, scoreTTL, nil, nil)
 		Expect(err).To(MatchError(service.NewGeneralError("set member score", "New database error")))
 	})
 
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 
+	"github.com/topfreegames/podium/leaderboard/v2/database"
 	"github.com/topfreegames/podium/leaderboard/v2/expiration"
 	"github.com/topfreegames/podium/leaderboard/v2/model"
 )
@@ -11,8 +12,61 @@ const setMembersScoreServiceLabel = "set members score"
 
 const setMembersOrder = "desc"
 
-// SetMembersScore return member informations that is
-func (s *Service) SetMembersScore(ctx context.Context, leaderboard string, members []*model.Member, prevRank bool, scoreTTL string) error {
+// SetMembersScore return member informations that is. When idempotency is set a retry of the same
+// write fills members with the informations of the first one without applying it again, and a different
+// write with the same key fails with IdempotencyKeyReusedError. Scores of
+// members blocked in shadow mode are written to the shadow leaderboard. Score changes are recorded in the ledger
+// with the origin of ctx and published to the event sink
+func (s *Service) SetMembersScore(ctx context.Context, leaderboard string, members []*model.Member, prevRank bool, scoreTTL string, idempotency *model.IdempotencyKey) error {
+	settings, err := s.getLeaderboardSettings(ctx, leaderboard)
+	if err != nil {
+		return NewGeneralError(setMembersScoreServiceLabel, err.Error())
+	}
+
+	memberIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		memberIDs = append(memberIDs, member.PublicID)
+	}
+
+	err = s.ensureWritable(ctx, leaderboard, settings, memberIDs...)
+	if err != nil {
+		if isWriteRejectedError(err) {
+			return err
+		}
+		return NewGeneralError(setMembersScoreServiceLabel, err.Error())
+	}
+
+	members, shadowMembers, err := s.partitionBlockedMembers(ctx, leaderboard, members)
+	if err != nil {
+		if isWriteRejectedError(err) {
+			return err
+		}
+		return NewGeneralError(setMembersScoreServiceLabel, err.Error())
+	}
+
+	if len(shadowMembers) > 0 {
+		err = s.persistShadowMembers(ctx, leaderboard, shadowMembers, settings)
+		if err != nil {
+			return NewGeneralError(setMembersScoreServiceLabel, err.Error())
+		}
+	}
+
+	if len(members) == 0 {
+		return nil
+	}
+
+	databaseIdempotency := getIdempotency(idempotency, setMembersScoreServiceLabel, scoreTTL, members)
+	duplicate, err := s.getIdempotentWrite(ctx, leaderboard, members, databaseIdempotency, prevRank, settings)
+	if err != nil {
+		if _, ok := err.(*database.IdempotencyKeyReusedError); ok {
+			return NewIdempotencyKeyReusedError(leaderboard, idempotency.Key)
+		}
+		return NewGeneralError(setMembersScoreServiceLabel, err.Error())
+	}
+	if duplicate {
+		return nil
+	}
+
 	if prevRank {
 		err := s.setMembersPreviousRank(ctx, leaderboard, members, setMembersOrder)
 		if err != nil {
@@ -20,16 +74,43 @@ func (s *Service) SetMembersScore(ctx context.Context, leaderboard string, membe
 		}
 	}
 
-	err := s.persistMembers(ctx, leaderboard, members)
+	previousRanks, err := s.getEventRanks(ctx, leaderboard, memberIDs...)
+	if err != nil {
+		return NewGeneralError(setMembersScoreServiceLabel, err.Error())
+	}
+
+	expireAt, err := getScoreExpireAt(scoreTTL)
 	if err != nil {
 		return NewGeneralError(setMembersScoreServiceLabel, err.Error())
 	}
 
-	err = s.setMembersValues(ctx, leaderboard, members, setMembersOrder)
+	var changes []*database.LedgerEntry
+	err = s.writeAllowedScores(ctx, leaderboard, settings, members, false, func(checked *checkedScores) error {
+		var err error
+		duplicate, changes, err = s.persistMembers(ctx, leaderboard, members, settings, checked, databaseIdempotency, prevRank, expireAt)
+		return err
+	})
 	if err != nil {
+		if isWriteRejectedError(err) {
+			return err
+		}
+		if _, ok := err.(*database.IdempotencyKeyReusedError); ok {
+			return NewIdempotencyKeyReusedError(leaderboard, idempotency.Key)
+		}
 		return NewGeneralError(setMembersScoreServiceLabel, err.Error())
 	}
 
+	if duplicate {
+		return nil
+	}
+
+	if idempotency == nil {
+		err = s.setMembersValues(ctx, leaderboard, members, setMembersOrder, settings)
+		if err != nil {
+			return NewGeneralError(setMembersScoreServiceLabel, err.Error())
+		}
+	}
+
 	err = s.persistLeaderboardExpirationTime(ctx, leaderboard)
 	if err != nil {
 		if _, ok := err.(*expiration.LeaderboardExpiredError); ok {
@@ -38,12 +119,23 @@ func (s *Service) SetMembersScore(ctx context.Context, leaderboard string, membe
 		return NewGeneralError(setMembersScoreServiceLabel, err.Error())
 	}
 
+	err = s.notifyLeaderboardCreated(ctx, leaderboard)
+	if err != nil {
+		return NewGeneralError(setMembersScoreServiceLabel, err.Error())
+	}
+
 	if scoreTTL != "" {
-		err = s.persistMembersTTL(ctx, leaderboard, members, scoreTTL)
+		err = s.persistMembersTTL(ctx, leaderboard, members, expireAt)
 		if err != nil {
 			return NewGeneralError(setMembersScoreServiceLabel, err.Error())
 		}
 	}
 
+	s.afterCommit(ctx, AfterCommitLedger, s.recordScoreChanges(ctx, leaderboard, settings, changes))
+
+	s.afterCommit(ctx, AfterCommitHistory, s.recordMembersHistory(ctx, leaderboard, members, settings))
+
+	s.afterCommit(ctx, AfterCommitEvents, s.publishScoreChanges(ctx, leaderboard, changes, previousRanks))
+
 	return nil
 }
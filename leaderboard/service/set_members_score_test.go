@@ -69,7 +69,9 @@ var _ = Describe("Service SetMembersScore", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mock = database.NewMockDatabase(ctrl)
 
-		svc = &service.Service{mock}
+		svc = &service.Service{Database: mock}
+
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Any()).Return(map[string]string{}, nil).AnyTimes()
 	})
 
 	AfterEach(func() {
@@ -78,6 +80,7 @@ var _ = Describe("Service SetMembersScore", func() {
 
 	Describe("When previousRank is false", func() {
 		It("Should set Members with previousRank equals zero", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 			members := []*model.Member{
 				{
 					PublicID: "member1",
@@ -113,8 +116,9 @@ var _ = Describe("Service SetMembersScore", func() {
 				gomock.Eq(databaseMembersToGetRank[0]),
 				gomock.Eq(databaseMembersToGetRank[1]),
 			).Return(databaseMembersReturned, nil)
+			mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
-			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL)
+			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(members).To(Equal(expectedMembers))
@@ -125,6 +129,7 @@ var _ = Describe("Service SetMembersScore", func() {
 		previousRank := true
 
 		It("Should set Members with previousRank", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 			members := []*model.Member{
 				{
 					PublicID: "member1",
@@ -169,14 +174,16 @@ var _ = Describe("Service SetMembersScore", func() {
 				gomock.Eq(databaseMembersToGetRank[0]),
 				gomock.Eq(databaseMembersToGetRank[1]),
 			).Return(databaseMembersReturned, nil)
+			mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
-			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL)
+			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(members).To(Equal(expectedMembers))
 		})
 
 		It("Should set a non existent member as rank equals to -1", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 			databaseMembersPreviousRankReturned := []*database.Member{
 				nil,
 				{
@@ -229,14 +236,16 @@ var _ = Describe("Service SetMembersScore", func() {
 				gomock.Eq(databaseMembersToGetRank[0]),
 				gomock.Eq(databaseMembersToGetRank[1]),
 			).Return(databaseMembersReturned, nil)
+			mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
-			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL)
+			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(members).To(Equal(expectedMembers))
 		})
 
 		It("Should return error if GetMembers return in error", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 			members := []*model.Member{
 				{
 					PublicID: "member1",
@@ -256,13 +265,14 @@ var _ = Describe("Service SetMembersScore", func() {
 				gomock.Eq(databaseMembersToGetRank[0]),
 				gomock.Eq(databaseMembersToGetRank[1]),
 			).Times(1).Return(nil, fmt.Errorf("New database error"))
-			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL)
+			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL, nil)
 			Expect(err).To(Equal(service.NewGeneralError("set members score", "New database error")))
 		})
 	})
 
 	Describe("When scoreTTL is empty", func() {
 		It("Should SetMembers without filling expire ordered set", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 			members := []*model.Member{
 				{
 					PublicID: "member1",
@@ -298,8 +308,9 @@ var _ = Describe("Service SetMembersScore", func() {
 				gomock.Eq(databaseMembersToGetRank[0]),
 				gomock.Eq(databaseMembersToGetRank[1]),
 			).Return(databaseMembersReturned, nil)
+			mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
-			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL)
+			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(members).To(Equal(expectedMembers))
@@ -310,6 +321,7 @@ var _ = Describe("Service SetMembersScore", func() {
 		scoreTTL := "100"
 
 		It("Should SetMembers filling expire ordered set", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 			members := []*model.Member{
 				{
 					PublicID: "member1",
@@ -332,8 +344,9 @@ var _ = Describe("Service SetMembersScore", func() {
 			).Return(databaseMembersReturned, nil)
 
 			mock.EXPECT().SetMembersTTL(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Times(1).Return(nil)
+			mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
-			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL)
+			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(members[0].ExpireAt).To(BeNumerically("~", time.Now().Add(100*time.Second).Unix(), 100))
@@ -344,7 +357,8 @@ var _ = Describe("Service SetMembersScore", func() {
 	Describe("When scoreTTL is invalid", func() {
 		scoreTTL := "invalid"
 
-		It("Should return error", func() {
+		It("Should return error without writing", func() {
+			mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 			members := []*model.Member{
 				{
 					PublicID: "member1",
@@ -356,23 +370,14 @@ var _ = Describe("Service SetMembersScore", func() {
 				},
 			}
 
-			mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Return(nil)
-			mock.EXPECT().GetMembers(
-				gomock.Any(),
-				gomock.Eq(leaderboard),
-				gomock.Eq("desc"),
-				gomock.Eq(true),
-				gomock.Eq(databaseMembersToGetRank[0]),
-				gomock.Eq(databaseMembersToGetRank[1]),
-			).Return(databaseMembersReturned, nil)
-
-			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL)
+			err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL, nil)
 			Expect(err).To(MatchError(service.NewGeneralError("set members score", "strconv.ParseInt: parsing \"invalid\": invalid syntax")))
 
 		})
 	})
 
 	It("Should return error if database SetMembers return in error", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 		members := []*model.Member{
 			{
 				PublicID: "member1",
@@ -386,11 +391,12 @@ var _ = Describe("Service SetMembersScore", func() {
 
 		mock.EXPECT().SetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(databaseMembersToInsert)).Return(fmt.Errorf("New database error"))
 
-		err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL)
+		err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL, nil)
 		Expect(err).To(MatchError(service.NewGeneralError("set members score", "New database error")))
 	})
 
 	It("Should return error if database GetMembers return in error", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 		members := []*model.Member{
 			{
 				PublicID: "member1",
@@ -412,12 +418,13 @@ var _ = Describe("Service SetMembersScore", func() {
 			gomock.Eq(databaseMembersToGetRank[1]),
 		).Return(nil, fmt.Errorf("New database error"))
 
-		err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL)
+		err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL, nil)
 		Expect(err).To(MatchError(service.NewGeneralError("set members score", "New database error")))
 
 	})
 
 	It("Should not call database GetLeaderboardExpiration and SetLeaderboardExpiration if leaderboard isn't formatted to have an expiration", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 		members := []*model.Member{
 			{
 				PublicID: "member1",
@@ -453,14 +460,16 @@ var _ = Describe("Service SetMembersScore", func() {
 			gomock.Eq(databaseMembersToGetRank[0]),
 			gomock.Eq(databaseMembersToGetRank[1]),
 		).Return(databaseMembersReturned, nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
-		err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL)
+		err := svc.SetMembersScore(context.Background(), leaderboard, members, previousRank, scoreTTL, nil)
 		Expect(err).NotTo(HaveOccurred())
 
 		Expect(members).To(Equal(expectedMembers))
 	})
 
 	It("Should set leaderboard expiration if GetLeaderboardExpiration return TTLNotFoundError", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 		leaderboardExpiration := fmt.Sprintf("year%d", time.Now().UTC().Year())
 		expireAt, err := expiration.GetExpireAt(leaderboardExpiration)
 		Expect(err).NotTo(HaveOccurred())
@@ -488,12 +497,14 @@ var _ = Describe("Service SetMembersScore", func() {
 		mock.EXPECT().GetLeaderboardExpiration(gomock.Any(), gomock.Eq(leaderboardExpiration)).Return(int64(-1), database.NewTTLNotFoundError(leaderboard))
 
 		mock.EXPECT().SetLeaderboardExpiration(gomock.Any(), gomock.Eq(leaderboardExpiration), time.Unix(expireAt, 0)).Return(nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboardExpiration), gomock.Any(), gomock.Any(), gomock.Eq(time.Unix(expireAt, 0)), gomock.Any()).Return(nil)
 
-		err = svc.SetMembersScore(context.Background(), leaderboardExpiration, members, previousRank, scoreTTL)
+		err = svc.SetMembersScore(context.Background(), leaderboardExpiration, members, previousRank, scoreTTL, nil)
 		Expect(err).NotTo(HaveOccurred())
 	})
 
 	It("Should return error LeaderboardExpiredError if leaderboard is expired", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 		leaderboardExpiration := fmt.Sprintf(
 			"testkey-from%dto%d",
 			time.Now().UTC().Add(time.Duration(-2)*time.Second).Unix(),
@@ -521,11 +532,12 @@ var _ = Describe("Service SetMembersScore", func() {
 			gomock.Eq(databaseMembersToGetRank[1]),
 		).Return(databaseMembersReturned, nil)
 
-		err := svc.SetMembersScore(context.Background(), leaderboardExpiration, members, previousRank, scoreTTL)
+		err := svc.SetMembersScore(context.Background(), leaderboardExpiration, members, previousRank, scoreTTL, nil)
 		Expect(err).To(MatchError(service.NewLeaderboardExpiredError(leaderboardExpiration)))
 	})
 
 	It("Should return error if database GetLeaderboardExpiration return in error", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 		leaderboardExpiration := fmt.Sprintf("year%d", time.Now().UTC().Year())
 
 		members := []*model.Member{
@@ -550,11 +562,12 @@ var _ = Describe("Service SetMembersScore", func() {
 		).Return(databaseMembersReturned, nil)
 		mock.EXPECT().GetLeaderboardExpiration(gomock.Any(), gomock.Eq(leaderboardExpiration)).Return(int64(-1), fmt.Errorf("New database error"))
 
-		err := svc.SetMembersScore(context.Background(), leaderboardExpiration, members, previousRank, scoreTTL)
+		err := svc.SetMembersScore(context.Background(), leaderboardExpiration, members, previousRank, scoreTTL, nil)
 		Expect(err).To(MatchError(service.NewGeneralError("set members score", "New database error")))
 	})
 
 	It("Should return error if database SetLeaderboardExpiration return in error", func() {
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*database.BlockedMember{}, nil)
 		leaderboardExpiration := fmt.Sprintf("year%d", time.Now().UTC().Year())
 		expireAt, err := expiration.GetExpireAt(leaderboardExpiration)
 		Expect(err).NotTo(HaveOccurred())
@@ -583,7 +596,7 @@ var _ = Describe("Service SetMembersScore", func() {
 
 		mock.EXPECT().SetLeaderboardExpiration(gomock.Any(), gomock.Eq(leaderboardExpiration), time.Unix(expireAt, 0)).Return(fmt.Errorf("New database error"))
 
-		err = svc.SetMembersScore(context.Background(), leaderboardExpiration, members, previousRank, scoreTTL)
+		err = svc.SetMembersScore(context.Background(), leaderboardExpiration, members, previousRank, scoreTTL, nil)
 		Expect(err).To(MatchError(service.NewGeneralError("set members score", "New database error")))
 	})
 
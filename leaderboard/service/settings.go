@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const (
+	decayHalfLifeSetting     = "decayHalfLife"
+	decayLandmarkSetting     = "decayLandmark"
+	writeStartAtSetting      = "writeStartAt"
+	writeEndAtSetting        = "writeEndAt"
+	participantsOnlySetting  = "participantsOnly"
+	frozenSetting            = "frozen"
+	minScoreSetting          = "minScore"
+	maxScoreSetting          = "maxScore"
+	maxIncrementSetting      = "maxIncrement"
+	maxIncreaseSetting       = "maxIncrease"
+	maxIncreaseWindowSetting = "maxIncreaseWindow"
+	monotonicOnlySetting     = "monotonicOnly"
+	signatureGameSetting     = "signatureGame"
+	historyEnabledSetting    = "historyEnabled"
+	historyIntervalSetting   = "historyInterval"
+	snapshotIntervalSetting  = "snapshotInterval"
+	snapshotRetentionSetting = "snapshotRetention"
+	ledgerRetentionSetting   = "ledgerRetention"
+	ledgerMaxEntriesSetting  = "ledgerMaxEntries"
+	milestonesSetting        = "milestones"
+)
+
+func (s *Service) getLeaderboardSettings(ctx context.Context, leaderboard string) (*model.LeaderboardSettings, error) {
+	fields, err := s.Database.GetLeaderboardSettings(ctx, leaderboard)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseLeaderboardSettings(fields)
+}
+
+func parseLeaderboardSettings(fields map[string]string) (*model.LeaderboardSettings, error) {
+	settings := &model.LeaderboardSettings{}
+
+	for field, value := range map[string]*int64{
+		decayHalfLifeSetting:     &settings.DecayHalfLife,
+		decayLandmarkSetting:     &settings.DecayLandmark,
+		writeStartAtSetting:      &settings.WriteStartAt,
+		writeEndAtSetting:        &settings.WriteEndAt,
+		maxIncrementSetting:      &settings.MaxIncrement,
+		maxIncreaseSetting:       &settings.MaxIncrease,
+		maxIncreaseWindowSetting: &settings.MaxIncreaseWindow,
+		historyIntervalSetting:   &settings.HistoryInterval,
+		snapshotIntervalSetting:  &settings.SnapshotInterval,
+		snapshotRetentionSetting: &settings.SnapshotRetention,
+		ledgerRetentionSetting:   &settings.LedgerRetention,
+		ledgerMaxEntriesSetting:  &settings.LedgerMaxEntries,
+	} {
+		if fieldValue, ok := fields[field]; ok {
+			var err error
+			*value, err = strconv.ParseInt(fieldValue, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for field, value := range map[string]*bool{
+		participantsOnlySetting: &settings.ParticipantsOnly,
+		frozenSetting:           &settings.Frozen,
+		monotonicOnlySetting:    &settings.MonotonicOnly,
+		historyEnabledSetting:   &settings.HistoryEnabled,
+	} {
+		if fieldValue, ok := fields[field]; ok {
+			var err error
+			*value, err = strconv.ParseBool(fieldValue)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for field, value := range map[string]**int64{
+		minScoreSetting: &settings.MinScore,
+		maxScoreSetting: &settings.MaxScore,
+	} {
+		if fieldValue, ok := fields[field]; ok && fieldValue != "" {
+			limit, err := strconv.ParseInt(fieldValue, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			*value = &limit
+		}
+	}
+
+	settings.SignatureGame = fields[signatureGameSetting]
+
+	if fieldValue, ok := fields[milestonesSetting]; ok && fieldValue != "" {
+		err := json.Unmarshal([]byte(fieldValue), &settings.Milestones)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return settings, nil
+}
+
+// updatableLeaderboardSettings are the settings replaced by UpdateLeaderboardSettings, the others are
+// managed by the service
+var updatableLeaderboardSettings = []string{
+	decayHalfLifeSetting,
+	minScoreSetting,
+	maxScoreSetting,
+	maxIncrementSetting,
+	maxIncreaseSetting,
+	maxIncreaseWindowSetting,
+	monotonicOnlySetting,
+	signatureGameSetting,
+	historyEnabledSetting,
+	historyIntervalSetting,
+	snapshotIntervalSetting,
+	snapshotRetentionSetting,
+	ledgerRetentionSetting,
+	ledgerMaxEntriesSetting,
+	milestonesSetting,
+}
+
+// formatLeaderboardSettingFields format only fields of settings, so writing them keeps the other fields
+// as they are stored instead of overwriting changes made since settings were read
+func formatLeaderboardSettingFields(settings *model.LeaderboardSettings, fields ...string) map[string]string {
+	formatted := formatLeaderboardSettings(settings)
+	selected := make(map[string]string, len(fields))
+	for _, field := range fields {
+		selected[field] = formatted[field]
+	}
+	return selected
+}
+
+func formatLeaderboardSettings(settings *model.LeaderboardSettings) map[string]string {
+	return map[string]string{
+		decayHalfLifeSetting:     strconv.FormatInt(settings.DecayHalfLife, 10),
+		decayLandmarkSetting:     strconv.FormatInt(settings.DecayLandmark, 10),
+		writeStartAtSetting:      strconv.FormatInt(settings.WriteStartAt, 10),
+		writeEndAtSetting:        strconv.FormatInt(settings.WriteEndAt, 10),
+		participantsOnlySetting:  strconv.FormatBool(settings.ParticipantsOnly),
+		frozenSetting:            strconv.FormatBool(settings.Frozen),
+		minScoreSetting:          formatScoreLimit(settings.MinScore),
+		maxScoreSetting:          formatScoreLimit(settings.MaxScore),
+		maxIncrementSetting:      strconv.FormatInt(settings.MaxIncrement, 10),
+		maxIncreaseSetting:       strconv.FormatInt(settings.MaxIncrease, 10),
+		maxIncreaseWindowSetting: strconv.FormatInt(settings.MaxIncreaseWindow, 10),
+		monotonicOnlySetting:     strconv.FormatBool(settings.MonotonicOnly),
+		signatureGameSetting:     settings.SignatureGame,
+		historyEnabledSetting:    strconv.FormatBool(settings.HistoryEnabled),
+		historyIntervalSetting:   strconv.FormatInt(settings.HistoryInterval, 10),
+		snapshotIntervalSetting:  strconv.FormatInt(settings.SnapshotInterval, 10),
+		snapshotRetentionSetting: strconv.FormatInt(settings.SnapshotRetention, 10),
+		ledgerRetentionSetting:   strconv.FormatInt(settings.LedgerRetention, 10),
+		ledgerMaxEntriesSetting:  strconv.FormatInt(settings.LedgerMaxEntries, 10),
+		milestonesSetting:        formatMilestoneRules(settings.Milestones),
+	}
+}
+
+func formatMilestoneRules(rules []*model.MilestoneRule) string {
+	if len(rules) == 0 {
+		return ""
+	}
+
+	// rules only have strings and ints, so they always marshal
+	value, _ := json.Marshal(rules)
+	return string(value)
+}
+
+func formatScoreLimit(limit *int64) string {
+	if limit == nil {
+		return ""
+	}
+
+	return strconv.FormatInt(*limit, 10)
+}
+
+// ensureWritable return an error if leaderboard settings reject writing scores of members at this time
+func (s *Service) ensureWritable(ctx context.Context, leaderboard string, settings *model.LeaderboardSettings, members ...string) error {
+	err := ensureOpen(leaderboard, settings)
+	if err != nil {
+		return err
+	}
+
+	if !settings.ParticipantsOnly {
+		return nil
+	}
+
+	nonParticipants, err := s.Database.GetLeaderboardNonParticipants(ctx, leaderboard, members...)
+	if err != nil {
+		return err
+	}
+	if len(nonParticipants) > 0 {
+		return NewMemberNotParticipantError(leaderboard, nonParticipants[0])
+	}
+
+	return nil
+}
+
+// ensureOpen return an error if leaderboard settings reject writing any score at this time
+func ensureOpen(leaderboard string, settings *model.LeaderboardSettings) error {
+	if settings.Frozen {
+		return NewLeaderboardFrozenError(leaderboard)
+	}
+
+	now := time.Now().Unix()
+	if (settings.WriteStartAt > 0 && now < settings.WriteStartAt) || (settings.WriteEndAt > 0 && now >= settings.WriteEndAt) {
+		return NewLeaderboardClosedError(leaderboard)
+	}
+
+	return nil
+}
+
+// ensureNotFrozen return an error if leaderboard is frozen
+func (s *Service) ensureNotFrozen(ctx context.Context, leaderboard string) error {
+	settings, err := s.getLeaderboardSettings(ctx, leaderboard)
+	if err != nil {
+		return err
+	}
+
+	if settings.Frozen {
+		return NewLeaderboardFrozenError(leaderboard)
+	}
+
+	return nil
+}
+
+func isWriteRejectedError(err error) bool {
+	switch err.(type) {
+	case *LeaderboardFrozenError, *LeaderboardClosedError, *MemberNotParticipantError, *ScoreRejectedError, *ScoreChangedError, *MemberBlockedError, *ImportNotAllowedError:
+		return true
+	}
+	return false
+}
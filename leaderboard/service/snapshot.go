@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// snapshotPageSize is how many members are compared with a rank snapshot at a time
+const snapshotPageSize = 1000
+
+// getRankSnapshotTime return when the newest rank snapshot of leaderboard taken until unix timestamp at
+// was taken, or RankSnapshotNotFoundError if there is none
+func (s *Service) getRankSnapshotTime(ctx context.Context, leaderboard string, at int64, label string) (time.Time, error) {
+	takenAt, err := s.Database.GetRankSnapshotTime(ctx, leaderboard, time.Unix(at, 0))
+	if err != nil {
+		return time.Time{}, NewGeneralError(label, err.Error())
+	}
+
+	if takenAt.IsZero() {
+		return time.Time{}, NewRankSnapshotNotFoundError(leaderboard, at)
+	}
+
+	return takenAt, nil
+}
+
+// convertSnapshotMembersIntoModelMembers convert members of a rank snapshot, whose scores are already decayed
+// to when it was taken
+func convertSnapshotMembersIntoModelMembers(databaseMembers []*database.Member) []*model.Member {
+	members := make([]*model.Member, 0, len(databaseMembers))
+	for _, member := range databaseMembers {
+		members = append(members, convertSnapshotMemberIntoModelMember(member))
+	}
+
+	return members
+}
+
+// convertSnapshotMemberIntoModelMember convert a member of a rank snapshot, or return nil if it is nil
+func convertSnapshotMemberIntoModelMember(member *database.Member) *model.Member {
+	if member == nil {
+		return nil
+	}
+
+	return &model.Member{
+		PublicID: member.Member,
+		Score:    int64(math.Round(member.Score)),
+		Rank:     int(member.Rank + 1),
+	}
+}
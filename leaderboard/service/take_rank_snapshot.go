@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+const takeRankSnapshotServiceLabel = "take rank snapshot"
+
+// TakeRankSnapshot copy the current ranks and scores of leaderboard into a new snapshot if none was taken in
+// the current snapshot interval of its settings, intervals are aligned to the unix epoch. Scores of decaying
+// leaderboards are kept decayed to when the snapshot was taken. Snapshots older than the snapshot retention
+// are removed. It returns if a snapshot was taken
+func (s *Service) TakeRankSnapshot(ctx context.Context, leaderboard string) (bool, error) {
+	settings, err := s.getLeaderboardSettings(ctx, leaderboard)
+	if err != nil {
+		return false, NewGeneralError(takeRankSnapshotServiceLabel, err.Error())
+	}
+
+	if settings.SnapshotInterval <= 0 {
+		return false, NewLeaderboardWithoutSnapshotsError(leaderboard)
+	}
+
+	expireAt, expired, err := getLeaderboardExpireAt(leaderboard)
+	if err != nil {
+		return false, NewGeneralError(takeRankSnapshotServiceLabel, err.Error())
+	}
+	if expired {
+		return false, nil
+	}
+
+	now := time.Now()
+	lastTakenAt, err := s.Database.GetRankSnapshotTime(ctx, leaderboard, now)
+	if err != nil {
+		return false, NewGeneralError(takeRankSnapshotServiceLabel, err.Error())
+	}
+
+	intervalStart := now.Unix() - now.Unix()%settings.SnapshotInterval
+	if !lastTakenAt.IsZero() && lastTakenAt.Unix() >= intervalStart {
+		return false, nil
+	}
+
+	var removeBefore time.Time
+	if settings.SnapshotRetention > 0 {
+		removeBefore = now.Add(-time.Duration(settings.SnapshotRetention) * time.Second)
+	}
+
+	err = s.Database.AddRankSnapshot(ctx, leaderboard, 1/decayWeight(settings, now), now, removeBefore, expireAt)
+	if err != nil {
+		return false, NewGeneralError(takeRankSnapshotServiceLabel, err.Error())
+	}
+
+	return true, nil
+}
@@ -0,0 +1,110 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service TakeRankSnapshot", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should take snapshot and remove old ones if none was taken in the current interval", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"snapshotInterval":  "3600",
+			"snapshotRetention": "86400",
+		}, nil)
+		mock.EXPECT().GetRankSnapshotTime(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(time.Now().Add(-2*time.Hour), nil)
+		mock.EXPECT().AddRankSnapshot(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(float64(1)), gomock.Any(), gomock.Any(), gomock.Eq(time.Time{})).DoAndReturn(
+			func(ctx context.Context, leaderboard string, weight float64, takenAt, removeBefore, expireAt time.Time) error {
+				Expect(takenAt).To(BeTemporally("~", time.Now(), time.Second))
+				Expect(removeBefore).To(BeTemporally("~", time.Now().Add(-24*time.Hour), time.Second))
+				return nil
+			},
+		)
+
+		taken, err := svc.TakeRankSnapshot(context.Background(), leaderboard)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(taken).To(BeTrue())
+	})
+
+	It("Should keep old snapshots if retention is zero", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"snapshotInterval": "3600",
+		}, nil)
+		mock.EXPECT().GetRankSnapshotTime(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(time.Time{}, nil)
+		mock.EXPECT().AddRankSnapshot(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Eq(time.Time{}), gomock.Eq(time.Time{})).Return(nil)
+
+		taken, err := svc.TakeRankSnapshot(context.Background(), leaderboard)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(taken).To(BeTrue())
+	})
+
+	It("Should not take snapshot if one was taken in the current interval", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"snapshotInterval": "3600",
+		}, nil)
+		mock.EXPECT().GetRankSnapshotTime(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(time.Now(), nil)
+
+		taken, err := svc.TakeRankSnapshot(context.Background(), leaderboard)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(taken).To(BeFalse())
+	})
+
+	It("Should keep scores of decaying leaderboards decayed to when snapshot was taken", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"snapshotInterval": "3600",
+			"decayHalfLife":    "60",
+			"decayLandmark":    fmt.Sprint(time.Now().Unix() - 120),
+		}, nil)
+		mock.EXPECT().GetRankSnapshotTime(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(time.Time{}, nil)
+		mock.EXPECT().AddRankSnapshot(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, leaderboard string, weight float64, takenAt, removeBefore, expireAt time.Time) error {
+				Expect(weight).To(BeNumerically("~", 0.25, 0.01))
+				return nil
+			},
+		)
+
+		_, err := svc.TakeRankSnapshot(context.Background(), leaderboard)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should return LeaderboardWithoutSnapshotsError if leaderboard has no snapshot interval", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+
+		_, err := svc.TakeRankSnapshot(context.Background(), leaderboard)
+		Expect(err).To(Equal(service.NewLeaderboardWithoutSnapshotsError(leaderboard)))
+	})
+
+	It("Should return error if database return in error on AddRankSnapshot", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"snapshotInterval": "3600",
+		}, nil)
+		mock.EXPECT().GetRankSnapshotTime(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(time.Time{}, nil)
+		mock.EXPECT().AddRankSnapshot(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("Database error example"))
+
+		_, err := svc.TakeRankSnapshot(context.Background(), leaderboard)
+		Expect(err).To(Equal(service.NewGeneralError("take rank snapshot", "Database error example")))
+	})
+})
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const takeWriteTokensServiceLabel = "take write tokens"
+
+// TakeWriteTokens take a token from the rate limits of caller and of each leaderboard and its members written,
+// members is a map of leaderboards to the members written to them. It returns RateLimitExceededError
+// if a rate limit does not have a token, tokens already taken from other rate limits are kept
+func (s *Service) TakeWriteTokens(ctx context.Context, caller string, members map[string][]string, limits *model.RateLimits) error {
+	if limits.Caller != nil && caller != "" {
+		retryAfter, err := s.Database.TakeCallerToken(ctx, caller, toDatabaseRateLimit(limits.Caller))
+		if err != nil {
+			return NewGeneralError(takeWriteTokensServiceLabel, err.Error())
+		}
+		if retryAfter > 0 {
+			return NewRateLimitExceededError(fmt.Sprintf("caller %s", caller), retryAfter)
+		}
+	}
+
+	if limits.Leaderboard == nil && limits.Member == nil {
+		return nil
+	}
+
+	leaderboards := make([]string, 0, len(members))
+	for leaderboard := range members {
+		leaderboards = append(leaderboards, leaderboard)
+	}
+	sort.Strings(leaderboards)
+
+	for _, leaderboard := range leaderboards {
+		retryAfter, err := s.Database.TakeLeaderboardTokens(
+			ctx, leaderboard, toDatabaseRateLimit(limits.Leaderboard), members[leaderboard], toDatabaseRateLimit(limits.Member),
+		)
+		if err != nil {
+			return NewGeneralError(takeWriteTokensServiceLabel, err.Error())
+		}
+		if retryAfter > 0 {
+			return NewRateLimitExceededError(fmt.Sprintf("writes to leaderboard %s", leaderboard), retryAfter)
+		}
+	}
+
+	return nil
+}
+
+func toDatabaseRateLimit(limit *model.RateLimit) *database.RateLimit {
+	if limit == nil {
+		return nil
+	}
+
+	return &database.RateLimit{
+		Rate:  limit.Rate,
+		Burst: limit.Burst,
+	}
+}
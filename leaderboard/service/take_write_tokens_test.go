@@ -0,0 +1,96 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service TakeWriteTokens", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	limits := &model.RateLimits{
+		Member:      &model.RateLimit{Rate: 1, Burst: 5},
+		Leaderboard: &model.RateLimit{Rate: 100, Burst: 200},
+		Caller:      &model.RateLimit{Rate: 10, Burst: 20},
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should take tokens of caller and of each leaderboard and its members", func() {
+		mock.EXPECT().TakeCallerToken(gomock.Any(), gomock.Eq("caller1"), gomock.Eq(&database.RateLimit{Rate: 10, Burst: 20})).Return(time.Duration(0), nil)
+		gomock.InOrder(
+			mock.EXPECT().TakeLeaderboardTokens(
+				gomock.Any(), gomock.Eq("leaderboard1"), gomock.Eq(&database.RateLimit{Rate: 100, Burst: 200}),
+				gomock.Eq([]string{"member1", "member2"}), gomock.Eq(&database.RateLimit{Rate: 1, Burst: 5}),
+			).Return(time.Duration(0), nil),
+			mock.EXPECT().TakeLeaderboardTokens(
+				gomock.Any(), gomock.Eq("leaderboard2"), gomock.Any(), gomock.Eq([]string{"member1"}), gomock.Any(),
+			).Return(time.Duration(0), nil),
+		)
+
+		err := svc.TakeWriteTokens(context.Background(), "caller1", map[string][]string{
+			"leaderboard2": {"member1"},
+			"leaderboard1": {"member1", "member2"},
+		}, limits)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should not take tokens of limits that are not set", func() {
+		mock.EXPECT().TakeLeaderboardTokens(
+			gomock.Any(), gomock.Eq("leaderboard1"), gomock.Nil(), gomock.Eq([]string{"member1"}), gomock.Eq(&database.RateLimit{Rate: 1, Burst: 5}),
+		).Return(time.Duration(0), nil)
+
+		err := svc.TakeWriteTokens(context.Background(), "caller1", map[string][]string{
+			"leaderboard1": {"member1"},
+		}, &model.RateLimits{Member: &model.RateLimit{Rate: 1, Burst: 5}})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should return RateLimitExceededError if caller does not have a token", func() {
+		mock.EXPECT().TakeCallerToken(gomock.Any(), gomock.Eq("caller1"), gomock.Any()).Return(300*time.Millisecond, nil)
+
+		err := svc.TakeWriteTokens(context.Background(), "caller1", map[string][]string{
+			"leaderboard1": {"member1"},
+		}, limits)
+		Expect(err).To(Equal(service.NewRateLimitExceededError("caller caller1", 300*time.Millisecond)))
+	})
+
+	It("Should return RateLimitExceededError if leaderboard or members do not have a token", func() {
+		mock.EXPECT().TakeCallerToken(gomock.Any(), gomock.Eq("caller1"), gomock.Any()).Return(time.Duration(0), nil)
+		mock.EXPECT().TakeLeaderboardTokens(gomock.Any(), gomock.Eq("leaderboard1"), gomock.Any(), gomock.Any(), gomock.Any()).Return(time.Second, nil)
+
+		err := svc.TakeWriteTokens(context.Background(), "caller1", map[string][]string{
+			"leaderboard1": {"member1"},
+		}, limits)
+		Expect(err).To(Equal(service.NewRateLimitExceededError("writes to leaderboard leaderboard1", time.Second)))
+		Expect(err.(*service.RateLimitExceededError).RetryAfter()).To(Equal(time.Second))
+	})
+
+	It("Should return error if database return in error", func() {
+		mock.EXPECT().TakeCallerToken(gomock.Any(), gomock.Eq("caller1"), gomock.Any()).Return(time.Duration(0), fmt.Errorf("Database error example"))
+
+		err := svc.TakeWriteTokens(context.Background(), "caller1", map[string][]string{
+			"leaderboard1": {"member1"},
+		}, limits)
+		Expect(err).To(Equal(service.NewGeneralError("take write tokens", "Database error example")))
+	})
+})
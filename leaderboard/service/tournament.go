@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// Tournaments are stored as a configuration hash next to the leaderboard with the same ID. The write
+// window and the participants restriction are kept in the leaderboard settings, so score writes are
+// checked the same way whether or not the leaderboard belongs to a tournament.
+
+func (s *Service) getTournament(ctx context.Context, tournament string) (*model.Tournament, error) {
+	databaseTournament, err := s.Database.GetTournament(ctx, tournament)
+	if err != nil {
+		if _, ok := err.(*database.TournamentNotFoundError); ok {
+			return nil, NewTournamentNotFoundError(tournament)
+		}
+		return nil, err
+	}
+
+	prizes := make([]*model.TournamentPrize, 0, len(databaseTournament.Prizes))
+	for _, prize := range databaseTournament.Prizes {
+		prizes = append(prizes, &model.TournamentPrize{
+			FromRank: prize.FromRank,
+			ToRank:   prize.ToRank,
+			RewardID: prize.RewardID,
+		})
+	}
+
+	config := &model.Tournament{
+		ID:      tournament,
+		StartAt: databaseTournament.StartAt.Unix(),
+		EndAt:   databaseTournament.EndAt.Unix(),
+		Order:   databaseTournament.Order,
+		Prizes:  prizes,
+	}
+	if !databaseTournament.FinalizedAt.IsZero() {
+		config.FinalizedAt = databaseTournament.FinalizedAt.Unix()
+	}
+	config.Status = tournamentStatus(config, time.Now())
+
+	return config, nil
+}
+
+func (s *Service) setTournament(ctx context.Context, tournament *model.Tournament) error {
+	return s.Database.SetTournament(ctx, tournament.ID, databaseTournament(tournament))
+}
+
+func databaseTournament(tournament *model.Tournament) *database.Tournament {
+	prizes := make([]*database.TournamentPrize, 0, len(tournament.Prizes))
+	for _, prize := range tournament.Prizes {
+		prizes = append(prizes, &database.TournamentPrize{
+			FromRank: prize.FromRank,
+			ToRank:   prize.ToRank,
+			RewardID: prize.RewardID,
+		})
+	}
+
+	config := &database.Tournament{
+		StartAt: time.Unix(tournament.StartAt, 0),
+		EndAt:   time.Unix(tournament.EndAt, 0),
+		Order:   tournament.Order,
+		Prizes:  prizes,
+	}
+	if tournament.FinalizedAt > 0 {
+		config.FinalizedAt = time.Unix(tournament.FinalizedAt, 0)
+	}
+
+	return config
+}
+
+func tournamentStatus(tournament *model.Tournament, now time.Time) string {
+	switch {
+	case tournament.FinalizedAt > 0:
+		return model.TournamentFinalized
+	case now.Unix() < tournament.StartAt:
+		return model.TournamentScheduled
+	case now.Unix() < tournament.EndAt:
+		return model.TournamentRunning
+	default:
+		return model.TournamentEnded
+	}
+}
@@ -0,0 +1,37 @@
+package service
+
+import "context"
+
+const unblockMemberServiceLabel = "unblock member"
+
+// UnblockMember unblock member in leaderboard, bringing back its score unless it is still blocked in every
+// leaderboard. An empty leaderboard unblocks member in every leaderboard and queues it for the worker to
+// bring back its score to each of them with ApplyGlobalBlock
+func (s *Service) UnblockMember(ctx context.Context, leaderboard, member string) error {
+	err := s.Database.UnblockMembers(ctx, leaderboard, member)
+	if err != nil {
+		return NewGeneralError(unblockMemberServiceLabel, err.Error())
+	}
+
+	if leaderboard == "" {
+		err = s.Database.QueueGlobalBlock(ctx, member)
+		if err != nil {
+			return NewGeneralError(unblockMemberServiceLabel, err.Error())
+		}
+		return nil
+	}
+
+	blockedMembers, err := s.Database.GetBlockedMembers(ctx, leaderboard, member)
+	if err != nil {
+		return NewGeneralError(unblockMemberServiceLabel, err.Error())
+	}
+
+	if globalMode := blockedMembers[0].GlobalMode; globalMode != "" {
+		err = s.Database.ApplyGlobalBlock(ctx, leaderboard, globalMode, member)
+		if err != nil {
+			return NewGeneralError(unblockMemberServiceLabel, err.Error())
+		}
+	}
+
+	return nil
+}
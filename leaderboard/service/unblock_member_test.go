@@ -0,0 +1,65 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service UnblockMember", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should unblock member in leaderboard", func() {
+		mock.EXPECT().UnblockMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member")).Return(nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member")).Return([]*database.BlockedMember{{}}, nil)
+
+		err := svc.UnblockMember(context.Background(), leaderboard, "member")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should keep score of member unblocked in leaderboard out of it if it is blocked in every leaderboard", func() {
+		mock.EXPECT().UnblockMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member")).Return(nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member")).Return([]*database.BlockedMember{
+			{GlobalMode: "shadow"},
+		}, nil)
+		mock.EXPECT().ApplyGlobalBlock(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("shadow"), gomock.Eq("member")).Return(nil)
+
+		err := svc.UnblockMember(context.Background(), leaderboard, "member")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should unblock member in every leaderboard queueing it to bring back its scores", func() {
+		mock.EXPECT().UnblockMembers(gomock.Any(), gomock.Eq(""), gomock.Eq("member")).Return(nil)
+		mock.EXPECT().QueueGlobalBlock(gomock.Any(), gomock.Eq("member")).Return(nil)
+
+		err := svc.UnblockMember(context.Background(), "", "member")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should return error if database return in error", func() {
+		mock.EXPECT().UnblockMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("Database error example"))
+
+		err := svc.UnblockMember(context.Background(), leaderboard, "member")
+		Expect(err).To(Equal(service.NewGeneralError("unblock member", "Database error example")))
+	})
+})
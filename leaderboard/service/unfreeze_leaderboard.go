@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const unfreezeLeaderboardServiceLabel = "unfreeze leaderboard"
+
+// UnfreezeLeaderboard accept writes to a frozen leaderboard again
+func (s *Service) UnfreezeLeaderboard(ctx context.Context, leaderboard string) (*model.LeaderboardSettings, error) {
+	settings, _, err := s.setLeaderboardFrozen(ctx, leaderboard, false)
+	if err != nil {
+		return nil, NewGeneralError(unfreezeLeaderboardServiceLabel, err.Error())
+	}
+
+	return settings, nil
+}
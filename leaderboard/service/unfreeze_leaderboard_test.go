@@ -0,0 +1,54 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service UnfreezeLeaderboard", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should unfreeze leaderboard if all is OK", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"frozen": "true",
+		}, nil)
+		mock.EXPECT().SetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, leaderboard string, settings map[string]string) error {
+				Expect(settings["frozen"]).To(Equal("false"))
+				return nil
+			},
+		)
+
+		settings, err := svc.UnfreezeLeaderboard(context.Background(), leaderboard)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(settings.Frozen).To(BeFalse())
+	})
+
+	It("Should return error if database return in error on GetLeaderboardSettings", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(nil, fmt.Errorf("Database error example"))
+
+		_, err := svc.UnfreezeLeaderboard(context.Background(), leaderboard)
+		Expect(err).To(Equal(service.NewGeneralError("unfreeze leaderboard", "Database error example")))
+	})
+})
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+const updateLeaderboardSettingsServiceLabel = "update leaderboard settings"
+
+// UpdateLeaderboardSettings replace leaderboard settings and return the settings stored.
+// Settings managed by the service, like the write window of tournaments, are kept and not written,
+// so concurrent changes to them, like freezing the leaderboard, are not overwritten.
+// When decay half-life changes stored scores are renormalized to the present, so scores
+// already decayed are kept and only the decay from now on uses the new half-life.
+// Leaderboards with snapshot interval are listed for the worker to take their rank snapshots.
+// Milestone rules replace the current ones
+func (s *Service) UpdateLeaderboardSettings(ctx context.Context, leaderboard string, settings *model.LeaderboardSettings) (*model.LeaderboardSettings, error) {
+	err := validateLeaderboardSettings(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	currentSettings, err := s.getLeaderboardSettings(ctx, leaderboard)
+	if err != nil {
+		return nil, NewGeneralError(updateLeaderboardSettingsServiceLabel, err.Error())
+	}
+
+	newSettings := *currentSettings
+	newSettings.DecayHalfLife = settings.DecayHalfLife
+	newSettings.MinScore = settings.MinScore
+	newSettings.MaxScore = settings.MaxScore
+	newSettings.MaxIncrement = settings.MaxIncrement
+	newSettings.MaxIncrease = settings.MaxIncrease
+	newSettings.MaxIncreaseWindow = settings.MaxIncreaseWindow
+	newSettings.MonotonicOnly = settings.MonotonicOnly
+	newSettings.SignatureGame = settings.SignatureGame
+	newSettings.HistoryEnabled = settings.HistoryEnabled
+	newSettings.HistoryInterval = settings.HistoryInterval
+	newSettings.SnapshotInterval = settings.SnapshotInterval
+	newSettings.SnapshotRetention = settings.SnapshotRetention
+	newSettings.LedgerRetention = settings.LedgerRetention
+	newSettings.LedgerMaxEntries = settings.LedgerMaxEntries
+	newSettings.Milestones = settings.Milestones
+
+	if newSettings.SnapshotInterval > 0 && currentSettings.SnapshotInterval <= 0 {
+		err = s.Database.AddLeaderboardToSnapshotList(ctx, leaderboard)
+		if err != nil {
+			return nil, NewGeneralError(updateLeaderboardSettingsServiceLabel, err.Error())
+		}
+	}
+
+	if newSettings.DecayHalfLife != currentSettings.DecayHalfLife {
+		now := time.Now()
+		newSettings.DecayLandmark = now.Unix()
+
+		fields := formatLeaderboardSettingFields(&newSettings, append(updatableLeaderboardSettings, decayLandmarkSetting)...)
+		if hasDecay(currentSettings) {
+			err = s.Database.ScaleLeaderboard(ctx, leaderboard, 1/decayWeight(currentSettings, now), fields)
+		} else {
+			err = s.Database.SetLeaderboardSettings(ctx, leaderboard, fields)
+		}
+		if err != nil {
+			return nil, NewGeneralError(updateLeaderboardSettingsServiceLabel, err.Error())
+		}
+
+		if hasDecay(&newSettings) {
+			err = s.Database.AddLeaderboardToDecayList(ctx, leaderboard)
+			if err != nil {
+				return nil, NewGeneralError(updateLeaderboardSettingsServiceLabel, err.Error())
+			}
+		}
+
+		return &newSettings, nil
+	}
+
+	err = s.Database.SetLeaderboardSettings(ctx, leaderboard, formatLeaderboardSettingFields(&newSettings, updatableLeaderboardSettings...))
+	if err != nil {
+		return nil, NewGeneralError(updateLeaderboardSettingsServiceLabel, err.Error())
+	}
+
+	return &newSettings, nil
+}
+
+func validateLeaderboardSettings(settings *model.LeaderboardSettings) error {
+	if settings.DecayHalfLife < 0 {
+		return NewInvalidLeaderboardSettingsError(fmt.Sprintf("decayHalfLife %d must be positive", settings.DecayHalfLife))
+	}
+
+	if settings.MinScore != nil && settings.MaxScore != nil && *settings.MinScore > *settings.MaxScore {
+		return NewInvalidLeaderboardSettingsError(fmt.Sprintf("minScore %d must not be greater than maxScore %d", *settings.MinScore, *settings.MaxScore))
+	}
+
+	if settings.MaxIncrement < 0 {
+		return NewInvalidLeaderboardSettingsError(fmt.Sprintf("maxIncrement %d must be positive", settings.MaxIncrement))
+	}
+
+	if settings.MaxIncrease < 0 || settings.MaxIncreaseWindow < 0 {
+		return NewInvalidLeaderboardSettingsError("maxIncrease and maxIncreaseWindow must be positive")
+	}
+
+	if (settings.MaxIncrease > 0) != (settings.MaxIncreaseWindow > 0) {
+		return NewInvalidLeaderboardSettingsError("maxIncrease and maxIncreaseWindow must be set together")
+	}
+
+	if settings.HistoryInterval < 0 {
+		return NewInvalidLeaderboardSettingsError(fmt.Sprintf("historyInterval %d must be positive", settings.HistoryInterval))
+	}
+
+	if settings.SnapshotInterval < 0 || settings.SnapshotRetention < 0 {
+		return NewInvalidLeaderboardSettingsError("snapshotInterval and snapshotRetention must be positive")
+	}
+
+	if settings.LedgerRetention < 0 || settings.LedgerMaxEntries < 0 {
+		return NewInvalidLeaderboardSettingsError("ledgerRetention and ledgerMaxEntries must be positive")
+	}
+
+	return validateMilestoneRules(settings.Milestones)
+}
+
+func validateMilestoneRules(rules []*model.MilestoneRule) error {
+	ids := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		if rule.ID == "" {
+			return NewInvalidLeaderboardSettingsError("milestones must have an id")
+		}
+		if ids[rule.ID] {
+			return NewInvalidLeaderboardSettingsError(fmt.Sprintf("milestone id %s must be unique", rule.ID))
+		}
+		ids[rule.ID] = true
+
+		switch rule.Type {
+		case model.MilestoneRankAtMost:
+			if rule.Rank < 1 {
+				return NewInvalidLeaderboardSettingsError(fmt.Sprintf("milestone %s rank %d must be positive", rule.ID, rule.Rank))
+			}
+		case model.MilestonePassedMember:
+			if rule.Member == "" {
+				return NewInvalidLeaderboardSettingsError(fmt.Sprintf("milestone %s must have a member", rule.ID))
+			}
+		default:
+			return NewInvalidLeaderboardSettingsError(fmt.Sprintf("milestone %s type %s must be %s or %s", rule.ID, rule.Type, model.MilestoneRankAtMost, model.MilestonePassedMember))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,249 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service UpdateLeaderboardSettings", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should enable decay and add leaderboard to decay list", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().SetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, leaderboard string, settings map[string]string) error {
+				Expect(settings["decayHalfLife"]).To(Equal("3600"))
+				landmark, err := strconv.ParseInt(settings["decayLandmark"], 10, 64)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(landmark).To(BeNumerically("~", time.Now().Unix(), 1))
+				return nil
+			},
+		)
+		mock.EXPECT().AddLeaderboardToDecayList(gomock.Any(), gomock.Eq(leaderboard)).Return(nil)
+
+		settings, err := svc.UpdateLeaderboardSettings(context.Background(), leaderboard, &model.LeaderboardSettings{DecayHalfLife: 3600})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(settings.DecayHalfLife).To(Equal(int64(3600)))
+		Expect(settings.DecayLandmark).To(BeNumerically("~", time.Now().Unix(), 1))
+	})
+
+	It("Should renormalize scores if half-life changes on a decaying leaderboard", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"decayHalfLife": "3600",
+			"decayLandmark": fmt.Sprint(time.Now().Unix() - 3600),
+		}, nil)
+		mock.EXPECT().ScaleLeaderboard(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, leaderboard string, factor float64, settings map[string]string) error {
+				Expect(factor).To(BeNumerically("~", 0.5, 0.01))
+				Expect(settings["decayHalfLife"]).To(Equal("0"))
+				return nil
+			},
+		)
+
+		settings, err := svc.UpdateLeaderboardSettings(context.Background(), leaderboard, &model.LeaderboardSettings{DecayHalfLife: 0})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(settings.DecayHalfLife).To(Equal(int64(0)))
+	})
+
+	It("Should keep landmark if half-life does not change", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"decayHalfLife": "3600",
+			"decayLandmark": "1600000000",
+		}, nil)
+		mock.EXPECT().SetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(map[string]string{
+			"decayHalfLife":     "3600",
+			"minScore":          "",
+			"maxScore":          "",
+			"maxIncrement":      "0",
+			"maxIncrease":       "0",
+			"maxIncreaseWindow": "0",
+			"monotonicOnly":     "false",
+			"signatureGame":     "",
+			"historyEnabled":    "false",
+			"historyInterval":   "0",
+			"snapshotInterval":  "0",
+			"snapshotRetention": "0",
+			"ledgerRetention":   "0",
+			"ledgerMaxEntries":  "0",
+			"milestones":        "",
+		})).Return(nil)
+
+		settings, err := svc.UpdateLeaderboardSettings(context.Background(), leaderboard, &model.LeaderboardSettings{DecayHalfLife: 3600})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(settings).To(Equal(&model.LeaderboardSettings{
+			DecayHalfLife: 3600,
+			DecayLandmark: 1600000000,
+		}))
+	})
+
+	It("Should keep settings managed by the service", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"writeStartAt":     "1600000000",
+			"writeEndAt":       "1600003600",
+			"participantsOnly": "true",
+			"frozen":           "true",
+		}, nil)
+		mock.EXPECT().SetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, leaderboard string, settings map[string]string) error {
+				for _, field := range []string{"writeStartAt", "writeEndAt", "participantsOnly", "frozen", "decayLandmark"} {
+					Expect(settings).NotTo(HaveKey(field))
+				}
+				return nil
+			},
+		)
+
+		settings, err := svc.UpdateLeaderboardSettings(context.Background(), leaderboard, &model.LeaderboardSettings{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(settings).To(Equal(&model.LeaderboardSettings{
+			WriteStartAt:     1600000000,
+			WriteEndAt:       1600003600,
+			ParticipantsOnly: true,
+			Frozen:           true,
+		}))
+	})
+
+	It("Should return InvalidLeaderboardSettingsError if half-life is negative", func() {
+		_, err := svc.UpdateLeaderboardSettings(context.Background(), leaderboard, &model.LeaderboardSettings{DecayHalfLife: -1})
+		Expect(err).To(Equal(service.NewInvalidLeaderboardSettingsError("decayHalfLife -1 must be positive")))
+	})
+
+	It("Should store score rules", func() {
+		minScore, maxScore := int64(0), int64(10000)
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().SetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, leaderboard string, settings map[string]string) error {
+				Expect(settings["minScore"]).To(Equal("0"))
+				Expect(settings["maxScore"]).To(Equal("10000"))
+				Expect(settings["maxIncrement"]).To(Equal("100"))
+				Expect(settings["maxIncrease"]).To(Equal("1000"))
+				Expect(settings["maxIncreaseWindow"]).To(Equal("3600"))
+				Expect(settings["monotonicOnly"]).To(Equal("true"))
+				return nil
+			},
+		)
+
+		settings, err := svc.UpdateLeaderboardSettings(context.Background(), leaderboard, &model.LeaderboardSettings{
+			MinScore:          &minScore,
+			MaxScore:          &maxScore,
+			MaxIncrement:      100,
+			MaxIncrease:       1000,
+			MaxIncreaseWindow: 3600,
+			MonotonicOnly:     true,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*settings.MinScore).To(Equal(int64(0)))
+		Expect(*settings.MaxScore).To(Equal(int64(10000)))
+		Expect(settings.MonotonicOnly).To(BeTrue())
+	})
+
+	It("Should return InvalidLeaderboardSettingsError if minScore is greater than maxScore", func() {
+		minScore, maxScore := int64(100), int64(10)
+		_, err := svc.UpdateLeaderboardSettings(context.Background(), leaderboard, &model.LeaderboardSettings{MinScore: &minScore, MaxScore: &maxScore})
+		Expect(err).To(Equal(service.NewInvalidLeaderboardSettingsError("minScore 100 must not be greater than maxScore 10")))
+	})
+
+	It("Should return InvalidLeaderboardSettingsError if maxIncrease is set without maxIncreaseWindow", func() {
+		_, err := svc.UpdateLeaderboardSettings(context.Background(), leaderboard, &model.LeaderboardSettings{MaxIncrease: 1000})
+		Expect(err).To(Equal(service.NewInvalidLeaderboardSettingsError("maxIncrease and maxIncreaseWindow must be set together")))
+	})
+
+	It("Should return InvalidLeaderboardSettingsError if historyInterval is negative", func() {
+		_, err := svc.UpdateLeaderboardSettings(context.Background(), leaderboard, &model.LeaderboardSettings{HistoryEnabled: true, HistoryInterval: -1})
+		Expect(err).To(Equal(service.NewInvalidLeaderboardSettingsError("historyInterval -1 must be positive")))
+	})
+
+	It("Should list leaderboard for rank snapshots when snapshot interval is set", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().AddLeaderboardToSnapshotList(gomock.Any(), gomock.Eq(leaderboard)).Return(nil)
+		mock.EXPECT().SetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, leaderboard string, settings map[string]string) error {
+				Expect(settings["snapshotInterval"]).To(Equal("86400"))
+				Expect(settings["snapshotRetention"]).To(Equal("604800"))
+				return nil
+			},
+		)
+
+		settings, err := svc.UpdateLeaderboardSettings(context.Background(), leaderboard, &model.LeaderboardSettings{SnapshotInterval: 86400, SnapshotRetention: 604800})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(settings.SnapshotInterval).To(Equal(int64(86400)))
+	})
+
+	It("Should return InvalidLeaderboardSettingsError if snapshotInterval is negative", func() {
+		_, err := svc.UpdateLeaderboardSettings(context.Background(), leaderboard, &model.LeaderboardSettings{SnapshotInterval: -1})
+		Expect(err).To(Equal(service.NewInvalidLeaderboardSettingsError("snapshotInterval and snapshotRetention must be positive")))
+	})
+
+	It("Should return InvalidLeaderboardSettingsError if ledgerMaxEntries is negative", func() {
+		_, err := svc.UpdateLeaderboardSettings(context.Background(), leaderboard, &model.LeaderboardSettings{LedgerMaxEntries: -1})
+		Expect(err).To(Equal(service.NewInvalidLeaderboardSettingsError("ledgerRetention and ledgerMaxEntries must be positive")))
+	})
+
+	It("Should store milestone rules", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().SetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, leaderboard string, settings map[string]string) error {
+				Expect(settings["milestones"]).To(Equal(`[{"id":"top100","type":"rankAtMost","rank":100},{"id":"passRival","type":"passedMember","member":"rival"}]`))
+				return nil
+			},
+		)
+
+		milestones := []*model.MilestoneRule{
+			{ID: "top100", Type: model.MilestoneRankAtMost, Rank: 100},
+			{ID: "passRival", Type: model.MilestonePassedMember, Member: "rival"},
+		}
+		settings, err := svc.UpdateLeaderboardSettings(context.Background(), leaderboard, &model.LeaderboardSettings{Milestones: milestones})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(settings.Milestones).To(Equal(milestones))
+	})
+
+	It("Should return InvalidLeaderboardSettingsError if a milestone rule is invalid", func() {
+		_, err := svc.UpdateLeaderboardSettings(context.Background(), leaderboard, &model.LeaderboardSettings{
+			Milestones: []*model.MilestoneRule{{ID: "top0", Type: model.MilestoneRankAtMost}},
+		})
+		Expect(err).To(Equal(service.NewInvalidLeaderboardSettingsError("milestone top0 rank 0 must be positive")))
+
+		_, err = svc.UpdateLeaderboardSettings(context.Background(), leaderboard, &model.LeaderboardSettings{
+			Milestones: []*model.MilestoneRule{{ID: "top", Type: model.MilestoneRankAtMost, Rank: 1}, {ID: "top", Type: model.MilestoneRankAtMost, Rank: 2}},
+		})
+		Expect(err).To(Equal(service.NewInvalidLeaderboardSettingsError("milestone id top must be unique")))
+	})
+
+	It("Should return error if database return in error on GetLeaderboardSettings", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(nil, fmt.Errorf("Database error example"))
+
+		_, err := svc.UpdateLeaderboardSettings(context.Background(), leaderboard, &model.LeaderboardSettings{DecayHalfLife: 3600})
+		Expect(err).To(Equal(service.NewGeneralError("update leaderboard settings", "Database error example")))
+	})
+
+	It("Should return error if database return in error on SetLeaderboardSettings", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{}, nil)
+		mock.EXPECT().SetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return(fmt.Errorf("Database error example"))
+
+		_, err := svc.UpdateLeaderboardSettings(context.Background(), leaderboard, &model.LeaderboardSettings{DecayHalfLife: 3600})
+		Expect(err).To(Equal(service.NewGeneralError("update leaderboard settings", "Database error example")))
+	})
+})
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+const useNonceServiceLabel = "use nonce"
+
+// UseNonce mark nonce of a signed write as used in leaderboard for expiration, so replays of the
+// write are rejected. It returns NonceAlreadyUsedError if nonce was already used
+func (s *Service) UseNonce(ctx context.Context, leaderboard, nonce string, expiration time.Duration) error {
+	added, err := s.Database.AddNonce(ctx, leaderboard, nonce, expiration)
+	if err != nil {
+		return NewGeneralError(useNonceServiceLabel, err.Error())
+	}
+
+	if !added {
+		return NewNonceAlreadyUsedError(leaderboard, nonce)
+	}
+
+	return nil
+}
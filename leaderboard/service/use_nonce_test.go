@@ -0,0 +1,53 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service UseNonce", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should return nil if nonce was not used", func() {
+		mock.EXPECT().AddNonce(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("nonce1"), gomock.Eq(10*time.Minute)).Return(true, nil)
+
+		err := svc.UseNonce(context.Background(), leaderboard, "nonce1", 10*time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should return NonceAlreadyUsedError if nonce was already used", func() {
+		mock.EXPECT().AddNonce(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("nonce1"), gomock.Eq(10*time.Minute)).Return(false, nil)
+
+		err := svc.UseNonce(context.Background(), leaderboard, "nonce1", 10*time.Minute)
+		Expect(err).To(Equal(service.NewNonceAlreadyUsedError(leaderboard, "nonce1")))
+	})
+
+	It("Should return error if database return in error", func() {
+		mock.EXPECT().AddNonce(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("nonce1"), gomock.Any()).Return(false, fmt.Errorf("Database error example"))
+
+		err := svc.UseNonce(context.Background(), leaderboard, "nonce1", 10*time.Minute)
+		Expect(err).To(Equal(service.NewGeneralError("use nonce", "Database error example")))
+	})
+})
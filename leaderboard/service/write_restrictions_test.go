@@ -0,0 +1,102 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+var _ = Describe("Service write restrictions", func() {
+	var ctrl *gomock.Controller
+	var mock *database.MockDatabase
+	var svc *service.Service
+
+	var leaderboard string = "leaderboardTest"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = database.NewMockDatabase(ctrl)
+
+		svc = &service.Service{Database: mock}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("Should return LeaderboardFrozenError if leaderboard is frozen", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"frozen": "true",
+		}, nil)
+
+		_, err := svc.SetMemberScore(context.Background(), leaderboard, "member", 10, false, "", nil, nil)
+		Expect(err).To(Equal(service.NewLeaderboardFrozenError(leaderboard)))
+	})
+
+	It("Should return LeaderboardClosedError if write window did not start", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"writeStartAt": fmt.Sprint(time.Now().Unix() + 3600),
+		}, nil)
+
+		_, err := svc.IncrementMemberScore(context.Background(), leaderboard, "member", 10, "", nil)
+		Expect(err).To(Equal(service.NewLeaderboardClosedError(leaderboard)))
+	})
+
+	It("Should return LeaderboardClosedError if write window ended", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"writeEndAt": fmt.Sprint(time.Now().Unix() - 1),
+		}, nil)
+
+		err := svc.SetMembersScore(context.Background(), leaderboard, []*model.Member{{PublicID: "member", Score: 10}}, false, "", nil)
+		Expect(err).To(Equal(service.NewLeaderboardClosedError(leaderboard)))
+	})
+
+	It("Should return MemberNotParticipantError if member did not join leaderboard", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"participantsOnly": "true",
+		}, nil)
+		mock.EXPECT().GetLeaderboardNonParticipants(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member1"), gomock.Eq("member2")).Return([]string{"member2"}, nil)
+
+		err := svc.SetMembersScore(context.Background(), leaderboard, []*model.Member{
+			{PublicID: "member1", Score: 10},
+			{PublicID: "member2", Score: 20},
+		}, false, "", nil)
+		Expect(err).To(Equal(service.NewMemberNotParticipantError(leaderboard, "member2")))
+	})
+
+	It("Should write score if member joined leaderboard inside write window", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"writeStartAt":     fmt.Sprint(time.Now().Unix() - 3600),
+			"writeEndAt":       fmt.Sprint(time.Now().Unix() + 3600),
+			"participantsOnly": "true",
+		}, nil)
+		mock.EXPECT().GetBlockedMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any()).Return([]*database.BlockedMember{}, nil)
+		mock.EXPECT().GetLeaderboardNonParticipants(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member")).Return([]string{}, nil)
+		mock.EXPECT().IncrementMemberScore(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq(&database.Member{Member: "member"}), gomock.Eq(float64(10))).Return(nil)
+		mock.EXPECT().GetMembers(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Eq("member")).Return([]*database.Member{
+			{Member: "member", Score: 10, Rank: 0},
+		}, nil)
+		mock.EXPECT().AddLedgerEntries(gomock.Any(), gomock.Eq(leaderboard), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		member, err := svc.IncrementMemberScore(context.Background(), leaderboard, "member", 10, "", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(member.Score).To(Equal(int64(10)))
+	})
+
+	It("Should return error if database GetLeaderboardNonParticipants return in error", func() {
+		mock.EXPECT().GetLeaderboardSettings(gomock.Any(), gomock.Eq(leaderboard)).Return(map[string]string{
+			"participantsOnly": "true",
+		}, nil)
+		mock.EXPECT().GetLeaderboardNonParticipants(gomock.Any(), gomock.Eq(leaderboard), gomock.Eq("member")).Return(nil, fmt.Errorf("Database error example"))
+
+		_, err := svc.SetMemberScore(context.Background(), leaderboard, "member", 10, false, "", nil, nil)
+		Expect(err).To(Equal(service.NewGeneralError("set member score", "Database error example")))
+	})
+})
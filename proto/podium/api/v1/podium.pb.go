@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
 	empty "github.com/golang/protobuf/ptypes/empty"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	math "math"
@@ -181,11 +182,14 @@ type BulkUpsertScoresRequest struct {
 	// -1 if the player didn’t exist in the leaderboard.
 	PrevRank bool `protobuf:"varint,2,opt,name=prev_rank,json=prevRank,proto3" json:"prev_rank,omitempty"`
 	// If set to more than zero, the score of the player will be expired from the leaderboard past scoreTTL seconds.
-	ScoreTTL             int32                                 `protobuf:"varint,3,opt,name=scoreTTL,proto3" json:"scoreTTL,omitempty"`
-	MemberScores         *BulkUpsertScoresRequest_MemberScores `protobuf:"bytes,4,opt,name=member_scores,json=memberScores,proto3" json:"member_scores,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
-	XXX_unrecognized     []byte                                `json:"-"`
-	XXX_sizecache        int32                                 `json:"-"`
+	ScoreTTL     int32                                 `protobuf:"varint,3,opt,name=scoreTTL,proto3" json:"scoreTTL,omitempty"`
+	MemberScores *BulkUpsertScoresRequest_MemberScores `protobuf:"bytes,4,opt,name=member_scores,json=memberScores,proto3" json:"member_scores,omitempty"`
+	// If set, retries of the request with the same key within the idempotency window are applied only once
+	// and return the response of the first one.
+	IdempotencyKey       string   `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *BulkUpsertScoresRequest) Reset()         { *m = BulkUpsertScoresRequest{} }
@@ -241,6 +245,13 @@ func (m *BulkUpsertScoresRequest) GetMemberScores() *BulkUpsertScoresRequest_Mem
 	return nil
 }
 
+func (m *BulkUpsertScoresRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
 // MemberScore allow to provide score information about a single member.
 type BulkUpsertScoresRequest_MemberScore struct {
 	//TODO: use json_name on variables like this to respect .proto naming format.
@@ -397,11 +408,14 @@ type UpsertScoreRequest struct {
 	// If set to true, it will also return the previous rank of the player in the leaderboard.
 	PrevRank bool `protobuf:"varint,3,opt,name=prev_rank,json=prevRank,proto3" json:"prev_rank,omitempty"`
 	// If set to more than zero, the score of the player will be expired from the leaderboard past scoreTTL seconds.
-	ScoreTTL             int32                           `protobuf:"varint,4,opt,name=scoreTTL,proto3" json:"scoreTTL,omitempty"`
-	ScoreChange          *UpsertScoreRequest_ScoreChange `protobuf:"bytes,5,opt,name=score_change,json=scoreChange,proto3" json:"score_change,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
-	XXX_unrecognized     []byte                          `json:"-"`
-	XXX_sizecache        int32                           `json:"-"`
+	ScoreTTL    int32                           `protobuf:"varint,4,opt,name=scoreTTL,proto3" json:"scoreTTL,omitempty"`
+	ScoreChange *UpsertScoreRequest_ScoreChange `protobuf:"bytes,5,opt,name=score_change,json=scoreChange,proto3" json:"score_change,omitempty"`
+	// If set, retries of the request with the same key within the idempotency window are applied only once
+	// and return the response of the first one.
+	IdempotencyKey       string   `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UpsertScoreRequest) Reset()         { *m = UpsertScoreRequest{} }
@@ -464,12 +478,24 @@ func (m *UpsertScoreRequest) GetScoreChange() *UpsertScoreRequest_ScoreChange {
 	return nil
 }
 
+func (m *UpsertScoreRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
 // ScoreChange is the score payload when upserting a score.
 type UpsertScoreRequest_ScoreChange struct {
-	Score                float64  `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Score float64 `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
+	// If set, the score is only written if the member still has this score.
+	ExpectedScore *wrappers.Int64Value `protobuf:"bytes,2,opt,name=expected_score,json=expectedScore,proto3" json:"expected_score,omitempty"`
+	// If set, the score is only written if the member still has this version. Every score write
+	// increments the member version, and a member that never had a score has version 0.
+	ExpectedVersion      *wrappers.Int64Value `protobuf:"bytes,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *UpsertScoreRequest_ScoreChange) Reset()         { *m = UpsertScoreRequest_ScoreChange{} }
@@ -504,6 +530,20 @@ func (m *UpsertScoreRequest_ScoreChange) GetScore() float64 {
 	return 0
 }
 
+func (m *UpsertScoreRequest_ScoreChange) GetExpectedScore() *wrappers.Int64Value {
+	if m != nil {
+		return m.ExpectedScore
+	}
+	return nil
+}
+
+func (m *UpsertScoreRequest_ScoreChange) GetExpectedVersion() *wrappers.Int64Value {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return nil
+}
+
 type TotalMembersRequest struct {
 	LeaderboardId        string   `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -596,11 +636,14 @@ type IncrementScoreRequest struct {
 	// The member identification.
 	MemberPublicId string `protobuf:"bytes,2,opt,name=member_public_id,json=memberPublicId,proto3" json:"member_public_id,omitempty"`
 	// If set to more than zero, the score of the player will be expired from the leaderboard past scoreTTL seconds.
-	ScoreTTL             int32                       `protobuf:"varint,3,opt,name=scoreTTL,proto3" json:"scoreTTL,omitempty"`
-	Body                 *IncrementScoreRequest_Body `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	ScoreTTL int32                       `protobuf:"varint,3,opt,name=scoreTTL,proto3" json:"scoreTTL,omitempty"`
+	Body     *IncrementScoreRequest_Body `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	// If set, retries of the request with the same key within the idempotency window are applied only once
+	// and return the response of the first one.
+	IdempotencyKey       string   `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *IncrementScoreRequest) Reset()         { *m = IncrementScoreRequest{} }
@@ -656,6 +699,13 @@ func (m *IncrementScoreRequest) GetBody() *IncrementScoreRequest_Body {
 	return nil
 }
 
+func (m *IncrementScoreRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
 // Body represents the increment payload.
 type IncrementScoreRequest_Body struct {
 	Increment            float64  `protobuf:"fixed64,1,opt,name=increment,proto3" json:"increment,omitempty"`
@@ -767,7 +817,9 @@ type UpsertScoreResponse struct {
 	// The previous rank of the player in the leaderboard, if requested.
 	PreviousRank int32 `protobuf:"varint,5,opt,name=previous_rank,json=previousRank,proto3" json:"previous_rank,omitempty"`
 	// Unix timestamp of when the member's score will be erased (only if scoreTTL was requested)
-	ExpireAt             int32    `protobuf:"varint,6,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	ExpireAt int32 `protobuf:"varint,6,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	// The version of the member after this write, to be used as expected_version of the next one.
+	Version              int64    `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -840,6 +892,13 @@ func (m *UpsertScoreResponse) GetExpireAt() int32 {
 	return 0
 }
 
+func (m *UpsertScoreResponse) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type IncrementScoreResponse struct {
 	Success  bool    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	PublicID string  `protobuf:"bytes,2,opt,name=publicID,proto3" json:"publicID,omitempty"`
@@ -1741,13 +1800,16 @@ func (m *GetTopPercentageRequest) GetOrder() string {
 }
 
 type UpsertScoreMultiLeaderboardsRequest struct {
-	MemberPublicId       string                                                `protobuf:"bytes,1,opt,name=member_public_id,json=memberPublicId,proto3" json:"member_public_id,omitempty"`
-	ScoreTTL             int32                                                 `protobuf:"varint,2,opt,name=scoreTTL,proto3" json:"scoreTTL,omitempty"`
-	PrevRank             bool                                                  `protobuf:"varint,3,opt,name=prev_rank,json=prevRank,proto3" json:"prev_rank,omitempty"`
-	ScoreMultiChange     *UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange `protobuf:"bytes,4,opt,name=score_multi_change,json=scoreMultiChange,proto3" json:"score_multi_change,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                              `json:"-"`
-	XXX_unrecognized     []byte                                                `json:"-"`
-	XXX_sizecache        int32                                                 `json:"-"`
+	MemberPublicId   string                                                `protobuf:"bytes,1,opt,name=member_public_id,json=memberPublicId,proto3" json:"member_public_id,omitempty"`
+	ScoreTTL         int32                                                 `protobuf:"varint,2,opt,name=scoreTTL,proto3" json:"scoreTTL,omitempty"`
+	PrevRank         bool                                                  `protobuf:"varint,3,opt,name=prev_rank,json=prevRank,proto3" json:"prev_rank,omitempty"`
+	ScoreMultiChange *UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange `protobuf:"bytes,4,opt,name=score_multi_change,json=scoreMultiChange,proto3" json:"score_multi_change,omitempty"`
+	// If set, retries of the request with the same key within the idempotency window are applied only once
+	// and return the response of the first one.
+	IdempotencyKey       string   `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UpsertScoreMultiLeaderboardsRequest) Reset()         { *m = UpsertScoreMultiLeaderboardsRequest{} }
@@ -1803,6 +1865,13 @@ func (m *UpsertScoreMultiLeaderboardsRequest) GetScoreMultiChange() *UpsertScore
 	return nil
 }
 
+func (m *UpsertScoreMultiLeaderboardsRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
 // ScoreMultiChange is the payload to update the score of a member on multiple leaderboards.
 type UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange struct {
 	Score                float64  `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
@@ -2536,315 +2605,4836 @@ func (m *GetTopPercentageResponse) GetMembers() []*Member {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*HealthCheckRequest)(nil), "podium.api.v1.HealthCheckRequest")
-	proto.RegisterType((*HealthCheckResponse)(nil), "podium.api.v1.HealthCheckResponse")
-	proto.RegisterType((*StatusResponse)(nil), "podium.api.v1.StatusResponse")
-	proto.RegisterType((*RemoveLeaderboardRequest)(nil), "podium.api.v1.RemoveLeaderboardRequest")
-	proto.RegisterType((*BulkUpsertScoresRequest)(nil), "podium.api.v1.BulkUpsertScoresRequest")
-	proto.RegisterType((*BulkUpsertScoresRequest_MemberScore)(nil), "podium.api.v1.BulkUpsertScoresRequest.MemberScore")
-	proto.RegisterType((*BulkUpsertScoresRequest_MemberScores)(nil), "podium.api.v1.BulkUpsertScoresRequest.MemberScores")
-	proto.RegisterType((*Member)(nil), "podium.api.v1.Member")
-	proto.RegisterType((*UpsertScoreRequest)(nil), "podium.api.v1.UpsertScoreRequest")
-	proto.RegisterType((*UpsertScoreRequest_ScoreChange)(nil), "podium.api.v1.UpsertScoreRequest.ScoreChange")
-	proto.RegisterType((*TotalMembersRequest)(nil), "podium.api.v1.TotalMembersRequest")
-	proto.RegisterType((*TotalMembersResponse)(nil), "podium.api.v1.TotalMembersResponse")
-	proto.RegisterType((*IncrementScoreRequest)(nil), "podium.api.v1.IncrementScoreRequest")
-	proto.RegisterType((*IncrementScoreRequest_Body)(nil), "podium.api.v1.IncrementScoreRequest.Body")
-	proto.RegisterType((*GetMemberRequest)(nil), "podium.api.v1.GetMemberRequest")
-	proto.RegisterType((*UpsertScoreResponse)(nil), "podium.api.v1.UpsertScoreResponse")
-	proto.RegisterType((*IncrementScoreResponse)(nil), "podium.api.v1.IncrementScoreResponse")
-	proto.RegisterType((*GetMemberResponse)(nil), "podium.api.v1.GetMemberResponse")
-	proto.RegisterType((*GetMembersRequest)(nil), "podium.api.v1.GetMembersRequest")
-	proto.RegisterType((*GetMembersResponse)(nil), "podium.api.v1.GetMembersResponse")
-	proto.RegisterType((*GetMembersResponse_Member)(nil), "podium.api.v1.GetMembersResponse.Member")
-	proto.RegisterType((*RemoveMemberRequest)(nil), "podium.api.v1.RemoveMemberRequest")
-	proto.RegisterType((*RemoveMembersRequest)(nil), "podium.api.v1.RemoveMembersRequest")
-	proto.RegisterType((*RemoveLeaderboardResponse)(nil), "podium.api.v1.RemoveLeaderboardResponse")
-	proto.RegisterType((*RemoveMemberResponse)(nil), "podium.api.v1.RemoveMemberResponse")
-	proto.RegisterType((*RemoveMembersResponse)(nil), "podium.api.v1.RemoveMembersResponse")
-	proto.RegisterType((*GetRankRequest)(nil), "podium.api.v1.GetRankRequest")
-	proto.RegisterType((*GetRankResponse)(nil), "podium.api.v1.GetRankResponse")
-	proto.RegisterType((*GetAroundMemberRequest)(nil), "podium.api.v1.GetAroundMemberRequest")
-	proto.RegisterType((*GetTopMembersRequest)(nil), "podium.api.v1.GetTopMembersRequest")
-	proto.RegisterType((*GetTopPercentageRequest)(nil), "podium.api.v1.GetTopPercentageRequest")
-	proto.RegisterType((*UpsertScoreMultiLeaderboardsRequest)(nil), "podium.api.v1.UpsertScoreMultiLeaderboardsRequest")
-	proto.RegisterType((*UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange)(nil), "podium.api.v1.UpsertScoreMultiLeaderboardsRequest.ScoreMultiChange")
-	proto.RegisterType((*UpsertScoreMultiLeaderboardsResponse)(nil), "podium.api.v1.UpsertScoreMultiLeaderboardsResponse")
-	proto.RegisterType((*UpsertScoreMultiLeaderboardsResponse_Member)(nil), "podium.api.v1.UpsertScoreMultiLeaderboardsResponse.Member")
-	proto.RegisterType((*GetRankMultiLeaderboardsRequest)(nil), "podium.api.v1.GetRankMultiLeaderboardsRequest")
-	proto.RegisterType((*GetRankMultiLeaderboardsResponse)(nil), "podium.api.v1.GetRankMultiLeaderboardsResponse")
-	proto.RegisterType((*GetRankMultiLeaderboardsResponse_Member)(nil), "podium.api.v1.GetRankMultiLeaderboardsResponse.Member")
-	proto.RegisterType((*GetAroundScoreRequest)(nil), "podium.api.v1.GetAroundScoreRequest")
-	proto.RegisterType((*BulkUpsertScoresResponse)(nil), "podium.api.v1.BulkUpsertScoresResponse")
-	proto.RegisterType((*BulkUpsertScoresResponse_Member)(nil), "podium.api.v1.BulkUpsertScoresResponse.Member")
-	proto.RegisterType((*GetAroundMemberResponse)(nil), "podium.api.v1.GetAroundMemberResponse")
-	proto.RegisterType((*GetAroundScoreResponse)(nil), "podium.api.v1.GetAroundScoreResponse")
-	proto.RegisterType((*GetTopMembersResponse)(nil), "podium.api.v1.GetTopMembersResponse")
-	proto.RegisterType((*GetTopPercentageResponse)(nil), "podium.api.v1.GetTopPercentageResponse")
+type ResetLeaderboardRequest struct {
+	// The leaderboard identification.
+	LeaderboardId        string                                `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	ResetOptions         *ResetLeaderboardRequest_ResetOptions `protobuf:"bytes,2,opt,name=reset_options,json=resetOptions,proto3" json:"reset_options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_unrecognized     []byte                                `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
 }
 
-func init() { proto.RegisterFile("proto/podium/api/v1/podium.proto", fileDescriptor_d33144d47ebf9898) }
-
-var fileDescriptor_d33144d47ebf9898 = []byte{
-	// 1864 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcb, 0x6f, 0x1c, 0x49,
-	0x19, 0x57, 0xf5, 0x3c, 0xec, 0xf9, 0xc6, 0xf6, 0x3a, 0xe5, 0xc7, 0x0e, 0xed, 0x47, 0x26, 0x6d,
-	0x3b, 0x9e, 0x04, 0xdc, 0xb3, 0xb6, 0x77, 0x17, 0x64, 0x76, 0xb5, 0xb2, 0x63, 0xd6, 0x31, 0x72,
-	0x8c, 0xd5, 0x36, 0x68, 0x05, 0x48, 0xa3, 0xf6, 0x4c, 0x79, 0xd2, 0xf2, 0x4c, 0x77, 0x6f, 0x77,
-	0x8f, 0x83, 0x63, 0x05, 0xa4, 0x44, 0x10, 0x71, 0x22, 0x08, 0x05, 0x29, 0x27, 0x1e, 0x07, 0x0e,
-	0xdc, 0x01, 0x89, 0x1b, 0x47, 0x0e, 0x70, 0xe1, 0x5f, 0xe0, 0x82, 0xb8, 0xf1, 0x17, 0xa0, 0xae,
-	0xea, 0xe9, 0xe9, 0x77, 0xbb, 0x1d, 0x1b, 0xb4, 0x27, 0x4f, 0x7d, 0x5d, 0x55, 0xdf, 0xaf, 0xbe,
-	0x47, 0x7d, 0xbf, 0xfa, 0x0c, 0x55, 0xdd, 0xd0, 0x2c, 0xad, 0xae, 0x6b, 0x2d, 0xa5, 0xd7, 0xad,
-	0xcb, 0xba, 0x52, 0x3f, 0x5b, 0x75, 0x46, 0x22, 0xfd, 0x84, 0x47, 0x9d, 0x91, 0xac, 0x2b, 0xe2,
-	0xd9, 0x2a, 0x3f, 0xdb, 0xd6, 0xb4, 0x76, 0x87, 0xd0, 0xa9, 0xb2, 0xaa, 0x6a, 0x96, 0x6c, 0x29,
-	0x9a, 0x6a, 0xb2, 0xc9, 0xfc, 0x8c, 0xf3, 0x95, 0x8e, 0x8e, 0x7b, 0x27, 0x75, 0xd2, 0xd5, 0xad,
-	0x73, 0xf6, 0x51, 0x98, 0x04, 0xfc, 0x90, 0xc8, 0x1d, 0xeb, 0xf1, 0x83, 0xc7, 0xa4, 0x79, 0x2a,
-	0x91, 0xcf, 0x7b, 0xc4, 0xb4, 0x84, 0x8f, 0x60, 0xc2, 0x27, 0x35, 0x75, 0x4d, 0x35, 0x09, 0x5e,
-	0x82, 0xb1, 0x27, 0x9a, 0x71, 0xaa, 0xa8, 0xed, 0x86, 0x69, 0x19, 0x8a, 0xda, 0xae, 0xa0, 0x2a,
-	0xaa, 0x95, 0xa4, 0x51, 0x47, 0x7a, 0x48, 0x85, 0x42, 0x1d, 0xc6, 0x0e, 0x2d, 0xd9, 0xea, 0x99,
-	0xee, 0xc2, 0x39, 0x00, 0x62, 0x18, 0x9a, 0xd1, 0x30, 0x64, 0x8b, 0xd0, 0x45, 0x48, 0x2a, 0x51,
-	0x89, 0x24, 0x5b, 0x44, 0xd8, 0x84, 0x8a, 0x44, 0xba, 0xda, 0x19, 0xd9, 0x23, 0x72, 0x8b, 0x18,
-	0xc7, 0x9a, 0x6c, 0xb4, 0x1c, 0x28, 0xb6, 0xce, 0xce, 0x40, 0xda, 0x50, 0x5a, 0x7d, 0x9d, 0x1e,
-	0xe9, 0x6e, 0x4b, 0xf8, 0x0f, 0x07, 0xef, 0x6e, 0xf5, 0x3a, 0xa7, 0xdf, 0xd6, 0x4d, 0x62, 0x58,
-	0x87, 0x4d, 0xcd, 0x20, 0x66, 0xb6, 0x2d, 0xf0, 0x0c, 0x94, 0x74, 0x83, 0x9c, 0x35, 0x0c, 0x59,
-	0x3d, 0xad, 0x70, 0x55, 0x54, 0x1b, 0x96, 0x86, 0x6d, 0x81, 0x24, 0xab, 0xa7, 0x98, 0x87, 0x61,
-	0xd3, 0xde, 0xf4, 0xe8, 0x68, 0xaf, 0x92, 0xab, 0xa2, 0x5a, 0x41, 0x72, 0xc7, 0xf8, 0x33, 0x18,
-	0xed, 0x92, 0xee, 0x31, 0x31, 0x1a, 0x54, 0x64, 0x56, 0xf2, 0x55, 0x54, 0x2b, 0xaf, 0xad, 0x8b,
-	0x3e, 0x2f, 0x89, 0x31, 0xf0, 0xc4, 0x47, 0x74, 0xad, 0x23, 0x1b, 0xe9, 0x7a, 0x46, 0xfc, 0x27,
-	0x50, 0xf6, 0x7c, 0xb5, 0x41, 0xe8, 0xbd, 0xe3, 0x8e, 0xd2, 0xdc, 0xdd, 0x76, 0x8e, 0xe0, 0x8e,
-	0xf1, 0x24, 0x14, 0xa8, 0x76, 0x8a, 0x1c, 0x49, 0x6c, 0xc0, 0x7f, 0x1f, 0x46, 0xbc, 0xdb, 0xe3,
-	0x3d, 0x18, 0x62, 0x0a, 0xcc, 0x0a, 0xaa, 0xe6, 0x6a, 0xe5, 0xb5, 0xb5, 0xec, 0x20, 0xa5, 0xfe,
-	0x16, 0xc2, 0x3e, 0x14, 0x99, 0x3c, 0x3b, 0x32, 0x8c, 0x21, 0x4f, 0x0d, 0xcd, 0x8c, 0x49, 0x7f,
-	0x0b, 0xaf, 0x39, 0xc0, 0x1e, 0xe5, 0x19, 0xfd, 0x57, 0x83, 0x71, 0xc7, 0x0d, 0x4c, 0xb5, 0x3d,
-	0x91, 0xa3, 0x13, 0xc7, 0x98, 0xfc, 0x80, 0x21, 0x0a, 0x78, 0x3a, 0x97, 0xe0, 0xe9, 0x7c, 0xc0,
-	0xd3, 0x07, 0x30, 0x42, 0x7f, 0x37, 0x9a, 0x8f, 0x65, 0xb5, 0x4d, 0x2a, 0x05, 0xea, 0xe8, 0x95,
-	0x80, 0x0d, 0xc3, 0x47, 0x10, 0xe9, 0xe0, 0x01, 0x5d, 0x24, 0x95, 0xcd, 0xc1, 0x80, 0x5f, 0x80,
-	0xb2, 0xe7, 0xdb, 0xc0, 0x56, 0xc8, 0x63, 0x2b, 0x3b, 0x1d, 0x8f, 0x34, 0x4b, 0xee, 0x30, 0x63,
-	0x67, 0x8c, 0x6b, 0xe1, 0x53, 0x98, 0xf4, 0xaf, 0x76, 0x92, 0xb2, 0x02, 0x43, 0x66, 0xaf, 0xd9,
-	0x24, 0xa6, 0x49, 0xd7, 0x0d, 0x4b, 0xfd, 0xa1, 0x8d, 0xa2, 0xa9, 0xf5, 0x54, 0x8b, 0x9a, 0xaf,
-	0x20, 0xb1, 0x81, 0xf0, 0x2f, 0x04, 0x53, 0xbb, 0x6a, 0xd3, 0x20, 0x5d, 0xa2, 0xde, 0xb0, 0x83,
-	0x92, 0xb2, 0xed, 0x63, 0xc8, 0x1f, 0x6b, 0xad, 0x73, 0x27, 0xc9, 0xee, 0x05, 0x6c, 0x1f, 0x09,
-	0x50, 0xdc, 0xd2, 0x5a, 0xe7, 0x12, 0x5d, 0xc6, 0x2f, 0x42, 0xde, 0x1e, 0xe1, 0x59, 0x28, 0x29,
-	0xfd, 0xb9, 0xfd, 0x1b, 0xc9, 0x15, 0x08, 0xaf, 0x11, 0x8c, 0xef, 0x10, 0x8b, 0x99, 0xec, 0xc6,
-	0x8e, 0x39, 0x09, 0x05, 0xcd, 0x68, 0x11, 0x83, 0x9e, 0xb1, 0x24, 0xb1, 0x41, 0x28, 0x00, 0x87,
-	0x07, 0x87, 0x17, 0xfe, 0x84, 0x60, 0xc2, 0x17, 0x5e, 0xa9, 0xbe, 0xf4, 0x66, 0x26, 0x17, 0x97,
-	0x99, 0xb9, 0xa8, 0xcc, 0xcc, 0x0f, 0x32, 0x13, 0x2f, 0xc0, 0xa8, 0x9d, 0x20, 0x8a, 0xd6, 0x33,
-	0x59, 0xd6, 0x14, 0xe8, 0xc7, 0x91, 0xbe, 0x90, 0x66, 0xce, 0x0c, 0x94, 0xc8, 0x0f, 0x74, 0xc5,
-	0x20, 0x0d, 0xd9, 0xaa, 0x14, 0x99, 0xdb, 0x98, 0x60, 0xd3, 0x12, 0xfe, 0x8c, 0x60, 0x3a, 0xe8,
-	0x9c, 0x2f, 0x0c, 0xf8, 0x3f, 0x20, 0xb8, 0xe5, 0x09, 0x87, 0x1b, 0xc4, 0x5d, 0x48, 0xc2, 0x5d,
-	0x4c, 0xc3, 0x3d, 0x14, 0xc0, 0xfd, 0x43, 0x0f, 0xec, 0xac, 0xe5, 0xd0, 0x0d, 0x4e, 0x2e, 0x2e,
-	0x38, 0x73, 0xfe, 0xe0, 0xc4, 0xe3, 0x90, 0x53, 0x5a, 0xac, 0xfa, 0x95, 0x24, 0xfb, 0xa7, 0xf0,
-	0x86, 0x03, 0xec, 0x05, 0x90, 0x6a, 0xb8, 0xad, 0x41, 0x7d, 0xe2, 0x68, 0x7d, 0xaa, 0x05, 0xf2,
-	0x3b, 0xbc, 0x9b, 0x53, 0x9a, 0xdc, 0xaa, 0x64, 0x5b, 0x44, 0xd5, 0xac, 0xc6, 0x89, 0xd6, 0x53,
-	0x5b, 0x95, 0x5c, 0x35, 0x67, 0x5b, 0x5f, 0xd5, 0xac, 0x4f, 0xed, 0x31, 0xff, 0x12, 0x5d, 0x6f,
-	0xcd, 0xf2, 0xdb, 0xbf, 0xe0, 0xb7, 0x3f, 0x55, 0xa1, 0x99, 0x8a, 0xcd, 0xc6, 0xfa, 0x31, 0xd5,
-	0x1f, 0x0b, 0x27, 0x30, 0xc1, 0x48, 0xcf, 0xcd, 0x5e, 0x32, 0xc2, 0xb7, 0x60, 0xd2, 0xab, 0x27,
-	0x6b, 0x18, 0x38, 0x4e, 0xe5, 0x06, 0x4e, 0x7d, 0x04, 0x5f, 0x8a, 0x60, 0x6b, 0xa9, 0xae, 0x9d,
-	0x86, 0xa2, 0x41, 0x64, 0x53, 0x53, 0x9d, 0xbd, 0x9c, 0x91, 0xf0, 0xd0, 0x8f, 0xef, 0x2d, 0x76,
-	0xda, 0x85, 0xa9, 0xc0, 0x49, 0xaf, 0xbc, 0xd5, 0x13, 0x18, 0xdb, 0x21, 0x96, 0x9d, 0x60, 0xff,
-	0xdb, 0xcb, 0x5f, 0xf8, 0x1e, 0xbc, 0xe3, 0x2a, 0x7e, 0xab, 0x6b, 0x26, 0x8a, 0x5f, 0xfd, 0x1d,
-	0xc1, 0xf4, 0x0e, 0xb1, 0x36, 0x0d, 0x3b, 0x15, 0xfe, 0x2f, 0xb5, 0xed, 0x3d, 0x98, 0x6a, 0x13,
-	0xab, 0xd1, 0x91, 0x4d, 0xab, 0xa1, 0x9c, 0x34, 0x06, 0x79, 0xca, 0x0a, 0xdd, 0xad, 0x36, 0xb1,
-	0xf6, 0x64, 0xd3, 0xda, 0x3d, 0xd9, 0x77, 0x12, 0x96, 0x72, 0x35, 0xb9, 0x4d, 0x1a, 0xa6, 0xf2,
-	0x94, 0xf4, 0xf3, 0xcb, 0x16, 0x1c, 0x2a, 0x4f, 0x89, 0xf0, 0x73, 0x04, 0x93, 0x3b, 0xc4, 0x3a,
-	0xd2, 0xf4, 0xab, 0x05, 0xf7, 0x6d, 0x28, 0xd3, 0xcd, 0xd5, 0x9e, 0xbd, 0xda, 0xa1, 0x3b, 0x60,
-	0x8b, 0xf6, 0xa9, 0x24, 0xe6, 0x14, 0x89, 0x98, 0xce, 0xe0, 0x5d, 0x06, 0xe9, 0x80, 0x18, 0x4d,
-	0xa2, 0x5a, 0x72, 0x3b, 0x2b, 0x4f, 0x9a, 0x07, 0xd0, 0xdd, 0xb5, 0x2e, 0x28, 0x57, 0x12, 0x13,
-	0x39, 0x7f, 0xe5, 0x60, 0xc1, 0x43, 0x0d, 0x1e, 0xf5, 0x3a, 0x96, 0xe2, 0xc9, 0x50, 0xd7, 0x34,
-	0x51, 0x2e, 0x44, 0xa9, 0x2c, 0x8c, 0x0b, 0xb0, 0xb0, 0x44, 0x0a, 0xfd, 0x39, 0x60, 0x46, 0x93,
-	0xbb, 0x36, 0x88, 0x3e, 0x59, 0x66, 0x84, 0xed, 0x41, 0x3c, 0x59, 0x8e, 0x83, 0x2c, 0x0e, 0xbe,
-	0x3a, 0x14, 0x7a, 0xdc, 0x0c, 0x48, 0xf8, 0x3d, 0x18, 0x0f, 0xce, 0x8a, 0x26, 0xd3, 0x58, 0x80,
-	0x11, 0x8f, 0xb9, 0x59, 0x9d, 0x29, 0x49, 0x3e, 0x99, 0xf0, 0x37, 0x0e, 0x16, 0x93, 0x81, 0xa5,
-	0xe6, 0xa6, 0x04, 0x45, 0xe7, 0x35, 0xc8, 0x0a, 0xd9, 0x46, 0xa6, 0x73, 0xfb, 0x4b, 0x9b, 0xb3,
-	0x13, 0xff, 0xc7, 0xeb, 0x28, 0x5e, 0xd7, 0xca, 0x8c, 0xf0, 0x22, 0xf8, 0x82, 0x77, 0xbb, 0x32,
-	0x1c, 0x8e, 0xe8, 0x6d, 0xe1, 0x77, 0x08, 0x6e, 0x3b, 0xd7, 0xda, 0x35, 0xc4, 0xe5, 0x32, 0xbc,
-	0xe3, 0x4f, 0xa3, 0x7e, 0x79, 0x1a, 0xf3, 0xe5, 0x91, 0x79, 0x05, 0x7e, 0xfd, 0x82, 0x83, 0x6a,
-	0x3c, 0xd0, 0x54, 0xa7, 0xef, 0x07, 0x9c, 0xfe, 0x61, 0x98, 0xbd, 0x24, 0x6e, 0x1d, 0x74, 0x78,
-	0xcf, 0xf5, 0x77, 0xc8, 0xce, 0x11, 0x37, 0xc7, 0xe0, 0xd2, 0xe7, 0x3c, 0x3e, 0x8e, 0xe6, 0x9b,
-	0x49, 0xb4, 0x45, 0x78, 0x89, 0x60, 0xca, 0xad, 0x13, 0x57, 0x79, 0xe9, 0x45, 0x47, 0xe0, 0x25,
-	0x2e, 0xd3, 0x7c, 0xf0, 0x82, 0xe7, 0xa0, 0x12, 0x6e, 0x49, 0xa4, 0xfa, 0xe1, 0x61, 0x90, 0x46,
-	0x8a, 0xa9, 0x6d, 0x8e, 0x68, 0x32, 0xc9, 0xbf, 0xba, 0x6e, 0xbe, 0x18, 0x4a, 0xb9, 0x7c, 0x5a,
-	0xca, 0x05, 0xbd, 0xd3, 0xa2, 0x05, 0xc6, 0x5f, 0xc4, 0x53, 0x2d, 0x52, 0x0f, 0x5a, 0x64, 0x2a,
-	0x60, 0x91, 0xc0, 0xc1, 0x85, 0xa6, 0x87, 0x2a, 0x5c, 0xf6, 0xb9, 0x96, 0x59, 0xc9, 0x31, 0x8d,
-	0x33, 0x6f, 0xf9, 0xbe, 0x7e, 0x1d, 0x04, 0x2a, 0xe1, 0x7a, 0x7c, 0xed, 0x6a, 0xd6, 0xfe, 0x3d,
-	0x01, 0xc5, 0x03, 0x3a, 0x03, 0x1f, 0x41, 0xd9, 0xd3, 0x3d, 0xc5, 0x77, 0x02, 0x2b, 0xc3, 0xfd,
-	0x56, 0x5e, 0x48, 0x9a, 0xe2, 0x60, 0xfd, 0x04, 0x8a, 0xac, 0xab, 0x8a, 0xa7, 0x45, 0xd6, 0xd1,
-	0x15, 0xfb, 0x1d, 0x5d, 0xf1, 0x1b, 0x5d, 0xdd, 0x3a, 0xe7, 0xe7, 0x02, 0xbb, 0x04, 0x9a, 0xb0,
-	0x2f, 0x10, 0xdc, 0x0a, 0x11, 0x77, 0xbc, 0x1c, 0x58, 0x14, 0xd7, 0x88, 0xe5, 0x6b, 0xe9, 0x13,
-	0x99, 0x22, 0x61, 0xe6, 0xf9, 0x3f, 0xfe, 0xf9, 0x0b, 0x6e, 0xea, 0xfe, 0x44, 0xbd, 0x53, 0xbf,
-	0xf0, 0x5f, 0x17, 0xcf, 0xf0, 0x2f, 0x11, 0x8c, 0x07, 0xb3, 0x0f, 0xdf, 0xbd, 0x5c, 0x17, 0x92,
-	0x5f, 0xbe, 0x64, 0x1a, 0x0b, 0xab, 0x14, 0xc2, 0x97, 0x79, 0x3e, 0x02, 0x42, 0x9d, 0x5d, 0xae,
-	0x1b, 0xfe, 0xa6, 0x2d, 0xfe, 0x35, 0x82, 0xb2, 0x67, 0xaf, 0x90, 0xdb, 0xc2, 0x5d, 0xbd, 0x90,
-	0xdb, 0x22, 0x3a, 0x33, 0xc2, 0x37, 0x29, 0x92, 0x6d, 0xfe, 0xfd, 0x28, 0x24, 0x4e, 0xf0, 0xd4,
-	0x2f, 0x82, 0x95, 0xcf, 0x01, 0xb9, 0xe1, 0x6b, 0x37, 0xe2, 0xe7, 0x08, 0x46, 0xbc, 0xad, 0x3c,
-	0x1c, 0x04, 0x10, 0xd1, 0x25, 0xe4, 0x17, 0x12, 0xe7, 0x38, 0x28, 0xef, 0x51, 0x94, 0x0b, 0xf8,
-	0x4e, 0x02, 0xca, 0x15, 0xda, 0x06, 0xc4, 0xbf, 0x41, 0x30, 0xe6, 0x6f, 0xe4, 0xe0, 0xc5, 0xcb,
-	0x34, 0xe1, 0xf8, 0xa5, 0x94, 0x59, 0x0e, 0x94, 0x2d, 0x0a, 0xe5, 0xa3, 0xb5, 0xab, 0x19, 0x8c,
-	0x36, 0xf9, 0xf0, 0x4f, 0x10, 0x94, 0xdc, 0x4e, 0x01, 0xbe, 0x1d, 0xd7, 0x43, 0xe8, 0x23, 0xab,
-	0xc6, 0x4f, 0x70, 0x40, 0x7d, 0x48, 0x41, 0xbd, 0x87, 0xc5, 0x6c, 0xa0, 0xf0, 0x19, 0xc0, 0xa0,
-	0x63, 0x81, 0xab, 0x09, 0xcd, 0x0c, 0x86, 0xe4, 0x4e, 0x6a, 0xbb, 0x43, 0x58, 0xa0, 0x50, 0xe6,
-	0xf0, 0x4c, 0x02, 0x14, 0xfc, 0x33, 0x04, 0x23, 0xde, 0xb7, 0x70, 0x28, 0x52, 0x22, 0x5a, 0x0f,
-	0xa1, 0x48, 0x89, 0x7a, 0x96, 0xf7, 0x2d, 0x71, 0x3f, 0xab, 0x25, 0x7e, 0x04, 0xa3, 0xbe, 0xc7,
-	0x39, 0x4e, 0xd2, 0xe6, 0xda, 0x63, 0x31, 0x79, 0x92, 0xdf, 0x24, 0xf7, 0x13, 0x4d, 0xf2, 0x63,
-	0x04, 0x43, 0x0e, 0xff, 0xc2, 0x73, 0xd1, 0xbc, 0xac, 0xaf, 0x75, 0x3e, 0xee, 0xb3, 0xa3, 0xef,
-	0xeb, 0x54, 0xdf, 0x07, 0x78, 0x3d, 0x63, 0x88, 0x52, 0x02, 0xf0, 0x2b, 0x44, 0x9f, 0xf8, 0xde,
-	0xfa, 0x8d, 0x97, 0xc2, 0x0a, 0x23, 0x1e, 0xe9, 0xfc, 0xdd, 0xb4, 0x69, 0x0e, 0xbe, 0x8f, 0x29,
-	0xbe, 0xaf, 0xe2, 0x0f, 0x32, 0xe2, 0x93, 0xe9, 0x66, 0xf8, 0x15, 0xa2, 0xdd, 0x0f, 0x4f, 0xed,
-	0x0f, 0x65, 0x78, 0x24, 0x3b, 0xe4, 0x97, 0x52, 0x66, 0xf9, 0x2f, 0x67, 0x7c, 0x2f, 0xfe, 0x72,
-	0xae, 0x5f, 0xd0, 0xbf, 0x2e, 0xa4, 0x9f, 0x22, 0x18, 0xf5, 0x31, 0x85, 0x50, 0xf8, 0x44, 0xb5,
-	0x01, 0xf8, 0xc5, 0xe4, 0x49, 0x0e, 0x9e, 0x15, 0x8a, 0x67, 0x19, 0x2f, 0x45, 0xe1, 0xb1, 0x34,
-	0xbd, 0x7e, 0xe1, 0x69, 0x12, 0x3c, 0xc3, 0x6f, 0xd8, 0xff, 0x06, 0x7c, 0x8c, 0x02, 0xdf, 0x8d,
-	0xd4, 0x14, 0x6a, 0x01, 0x84, 0x2a, 0x58, 0x1c, 0x35, 0x11, 0xde, 0xa7, 0xa0, 0x44, 0xfc, 0x95,
-	0x18, 0x50, 0x2b, 0x4e, 0x43, 0xa0, 0x7e, 0x31, 0xe8, 0x0c, 0x3c, 0xc3, 0x7f, 0x41, 0x30, 0x9b,
-	0xf4, 0xb2, 0xc4, 0x6b, 0xd9, 0x9f, 0xdf, 0xfc, 0xfa, 0x15, 0x9e, 0xae, 0xc2, 0xd7, 0x28, 0xfe,
-	0x35, 0x7e, 0xb6, 0xde, 0x8d, 0xbd, 0xad, 0xcd, 0x8d, 0x88, 0x3e, 0x81, 0x5d, 0x60, 0x2a, 0x71,
-	0x0f, 0x25, 0x2c, 0x5e, 0xfa, 0x45, 0xc5, 0xb0, 0xd7, 0x33, 0xbe, 0xc0, 0x84, 0x45, 0x8a, 0x7b,
-	0x1e, 0x27, 0xe2, 0xde, 0x3a, 0x82, 0xf9, 0xa6, 0xd6, 0x15, 0x2d, 0x4d, 0x3f, 0x31, 0x08, 0x69,
-	0xcb, 0x5d, 0x62, 0xfa, 0x15, 0x6d, 0x95, 0x19, 0x19, 0x3c, 0xb0, 0x29, 0xda, 0x01, 0xfa, 0xae,
-	0xff, 0x3f, 0xf6, 0xbf, 0xe5, 0x72, 0x07, 0x9b, 0x9f, 0xfd, 0x9e, 0x1b, 0x65, 0x93, 0xc4, 0x4d,
-	0x5d, 0x11, 0xbf, 0xb3, 0x7a, 0x5c, 0xa4, 0x84, 0x6e, 0xfd, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xe2, 0xc0, 0x85, 0x49, 0x01, 0x20, 0x00, 0x00,
+func (m *ResetLeaderboardRequest) Reset()         { *m = ResetLeaderboardRequest{} }
+func (m *ResetLeaderboardRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetLeaderboardRequest) ProtoMessage()    {}
+func (*ResetLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{36}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *ResetLeaderboardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetLeaderboardRequest.Unmarshal(m, b)
+}
+func (m *ResetLeaderboardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetLeaderboardRequest.Marshal(b, m, deterministic)
+}
+func (m *ResetLeaderboardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetLeaderboardRequest.Merge(m, src)
+}
+func (m *ResetLeaderboardRequest) XXX_Size() int {
+	return xxx_messageInfo_ResetLeaderboardRequest.Size(m)
+}
+func (m *ResetLeaderboardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetLeaderboardRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ResetLeaderboardRequest proto.InternalMessageInfo
 
-// PodiumClient is the client API for Podium service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type PodiumClient interface {
-	// HealthCheck verifies and returns service health.
-	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
-	// Status allows to clients to know additional information about Podium execution.
-	// Currently only returns error rate of the service.
-	Status(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*StatusResponse, error)
-	// RemoveLeaderboard removes a specified leaderboard.
-	RemoveLeaderboard(ctx context.Context, in *RemoveLeaderboardRequest, opts ...grpc.CallOption) (*RemoveLeaderboardResponse, error)
-	// BulkUpsertScores allows clients to send multiple scores in a single request.
-	BulkUpsertScores(ctx context.Context, in *BulkUpsertScoresRequest, opts ...grpc.CallOption) (*BulkUpsertScoresResponse, error)
-	// UpsertScore submits a single leaderboard score to Podium.
-	UpsertScore(ctx context.Context, in *UpsertScoreRequest, opts ...grpc.CallOption) (*UpsertScoreResponse, error)
-	// TotalMembers returns the number of members on a leaderboard.
-	TotalMembers(ctx context.Context, in *TotalMembersRequest, opts ...grpc.CallOption) (*TotalMembersResponse, error)
-	// IncrementScore increments a member score.
-	IncrementScore(ctx context.Context, in *IncrementScoreRequest, opts ...grpc.CallOption) (*IncrementScoreResponse, error)
-	// GetMember retrieves leaderboard information from a member.
-	GetMember(ctx context.Context, in *GetMemberRequest, opts ...grpc.CallOption) (*GetMemberResponse, error)
-	// GetMembers retrieves information about multiple members of a leaderboard.
-	GetMembers(ctx context.Context, in *GetMembersRequest, opts ...grpc.CallOption) (*GetMembersResponse, error)
-	// RemoveMember removes a member from a leaderboard.
-	RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error)
-	// RemoveMembers allows the removal of multiple members of a leaderboard.
-	RemoveMembers(ctx context.Context, in *RemoveMembersRequest, opts ...grpc.CallOption) (*RemoveMembersResponse, error)
-	// GetRank retrieves ranking information about a member.
-	GetRank(ctx context.Context, in *GetRankRequest, opts ...grpc.CallOption) (*GetRankResponse, error)
-	// GetAroundMember retrieves the closest members to another member on the leaderboard.
-	GetAroundMember(ctx context.Context, in *GetAroundMemberRequest, opts ...grpc.CallOption) (*GetAroundMemberResponse, error)
-	// GetAroundScore retrieves the closest members to a score on the leaderboard.
-	GetAroundScore(ctx context.Context, in *GetAroundScoreRequest, opts ...grpc.CallOption) (*GetAroundScoreResponse, error)
-	// GetTopMembers retrieves the top ranking members of a leaderboard.
-	GetTopMembers(ctx context.Context, in *GetTopMembersRequest, opts ...grpc.CallOption) (*GetTopMembersResponse, error)
-	// GetTopPercentage retrieves a percentage of the top members of the leaderboard.
-	GetTopPercentage(ctx context.Context, in *GetTopPercentageRequest, opts ...grpc.CallOption) (*GetTopPercentageResponse, error)
-	// UpsertScoreMultiLeaderboards sends a member score to multiple leaderboards.
-	UpsertScoreMultiLeaderboards(ctx context.Context, in *UpsertScoreMultiLeaderboardsRequest, opts ...grpc.CallOption) (*UpsertScoreMultiLeaderboardsResponse, error)
-	// GetRankMultiLeaderboards retrieves information about a member in multiple leaderboards.
-	GetRankMultiLeaderboards(ctx context.Context, in *GetRankMultiLeaderboardsRequest, opts ...grpc.CallOption) (*GetRankMultiLeaderboardsResponse, error)
+func (m *ResetLeaderboardRequest) GetLeaderboardId() string {
+	if m != nil {
+		return m.LeaderboardId
+	}
+	return ""
 }
 
-type podiumClient struct {
-	cc *grpc.ClientConn
+func (m *ResetLeaderboardRequest) GetResetOptions() *ResetLeaderboardRequest_ResetOptions {
+	if m != nil {
+		return m.ResetOptions
+	}
+	return nil
 }
 
-func NewPodiumClient(cc *grpc.ClientConn) PodiumClient {
-	return &podiumClient{cc}
+// ResetOptions is the payload describing how scores are carried over.
+type ResetLeaderboardRequest_ResetOptions struct {
+	// One of zero, delete, compress or topK.
+	Strategy string `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// The leaderboard that receives the result, for example next season's leaderboard.
+	// If empty the leaderboard itself is reset.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// Baseline used by compress strategy: new = base + (old - base) * factor.
+	Base float64 `protobuf:"fixed64,3,opt,name=base,proto3" json:"base,omitempty"`
+	// Factor used by compress strategy, between 0 and 1.
+	Factor float64 `protobuf:"fixed64,4,opt,name=factor,proto3" json:"factor,omitempty"`
+	// Number of members carried over by topK strategy.
+	TopK int32 `protobuf:"varint,5,opt,name=top_k,json=topK,proto3" json:"top_k,omitempty"`
+	// If set to asc, top members are the ones with lower scores.
+	Order string `protobuf:"bytes,6,opt,name=order,proto3" json:"order,omitempty"`
+	// Number of members processed at a time. Defaults to 1000.
+	ChunkSize            int32    `protobuf:"varint,7,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *podiumClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
-	out := new(HealthCheckResponse)
-	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/HealthCheck", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ResetLeaderboardRequest_ResetOptions) Reset()         { *m = ResetLeaderboardRequest_ResetOptions{} }
+func (m *ResetLeaderboardRequest_ResetOptions) String() string { return proto.CompactTextString(m) }
+func (*ResetLeaderboardRequest_ResetOptions) ProtoMessage()    {}
+func (*ResetLeaderboardRequest_ResetOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{36, 0}
 }
 
-func (c *podiumClient) Status(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*StatusResponse, error) {
-	out := new(StatusResponse)
-	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/Status", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ResetLeaderboardRequest_ResetOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetLeaderboardRequest_ResetOptions.Unmarshal(m, b)
+}
+func (m *ResetLeaderboardRequest_ResetOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetLeaderboardRequest_ResetOptions.Marshal(b, m, deterministic)
+}
+func (m *ResetLeaderboardRequest_ResetOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetLeaderboardRequest_ResetOptions.Merge(m, src)
+}
+func (m *ResetLeaderboardRequest_ResetOptions) XXX_Size() int {
+	return xxx_messageInfo_ResetLeaderboardRequest_ResetOptions.Size(m)
+}
+func (m *ResetLeaderboardRequest_ResetOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetLeaderboardRequest_ResetOptions.DiscardUnknown(m)
 }
 
-func (c *podiumClient) RemoveLeaderboard(ctx context.Context, in *RemoveLeaderboardRequest, opts ...grpc.CallOption) (*RemoveLeaderboardResponse, error) {
-	out := new(RemoveLeaderboardResponse)
-	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/RemoveLeaderboard", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ResetLeaderboardRequest_ResetOptions proto.InternalMessageInfo
+
+func (m *ResetLeaderboardRequest_ResetOptions) GetStrategy() string {
+	if m != nil {
+		return m.Strategy
 	}
-	return out, nil
+	return ""
 }
 
-func (c *podiumClient) BulkUpsertScores(ctx context.Context, in *BulkUpsertScoresRequest, opts ...grpc.CallOption) (*BulkUpsertScoresResponse, error) {
-	out := new(BulkUpsertScoresResponse)
-	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/BulkUpsertScores", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ResetLeaderboardRequest_ResetOptions) GetTarget() string {
+	if m != nil {
+		return m.Target
 	}
-	return out, nil
+	return ""
 }
 
-func (c *podiumClient) UpsertScore(ctx context.Context, in *UpsertScoreRequest, opts ...grpc.CallOption) (*UpsertScoreResponse, error) {
-	out := new(UpsertScoreResponse)
-	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/UpsertScore", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ResetLeaderboardRequest_ResetOptions) GetBase() float64 {
+	if m != nil {
+		return m.Base
 	}
-	return out, nil
+	return 0
 }
 
-func (c *podiumClient) TotalMembers(ctx context.Context, in *TotalMembersRequest, opts ...grpc.CallOption) (*TotalMembersResponse, error) {
-	out := new(TotalMembersResponse)
-	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/TotalMembers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ResetLeaderboardRequest_ResetOptions) GetFactor() float64 {
+	if m != nil {
+		return m.Factor
 	}
-	return out, nil
+	return 0
 }
 
-func (c *podiumClient) IncrementScore(ctx context.Context, in *IncrementScoreRequest, opts ...grpc.CallOption) (*IncrementScoreResponse, error) {
-	out := new(IncrementScoreResponse)
-	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/IncrementScore", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ResetLeaderboardRequest_ResetOptions) GetTopK() int32 {
+	if m != nil {
+		return m.TopK
 	}
-	return out, nil
+	return 0
 }
 
-func (c *podiumClient) GetMember(ctx context.Context, in *GetMemberRequest, opts ...grpc.CallOption) (*GetMemberResponse, error) {
-	out := new(GetMemberResponse)
-	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetMember", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ResetLeaderboardRequest_ResetOptions) GetOrder() string {
+	if m != nil {
+		return m.Order
 	}
-	return out, nil
+	return ""
 }
 
-func (c *podiumClient) GetMembers(ctx context.Context, in *GetMembersRequest, opts ...grpc.CallOption) (*GetMembersResponse, error) {
-	out := new(GetMembersResponse)
-	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetMembers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ResetLeaderboardRequest_ResetOptions) GetChunkSize() int32 {
+	if m != nil {
+		return m.ChunkSize
 	}
-	return out, nil
+	return 0
+}
+
+type GetResetLeaderboardProgressRequest struct {
+	LeaderboardId        string   `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetResetLeaderboardProgressRequest) Reset()         { *m = GetResetLeaderboardProgressRequest{} }
+func (m *GetResetLeaderboardProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetResetLeaderboardProgressRequest) ProtoMessage()    {}
+func (*GetResetLeaderboardProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{37}
+}
+
+func (m *GetResetLeaderboardProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetResetLeaderboardProgressRequest.Unmarshal(m, b)
+}
+func (m *GetResetLeaderboardProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetResetLeaderboardProgressRequest.Marshal(b, m, deterministic)
+}
+func (m *GetResetLeaderboardProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResetLeaderboardProgressRequest.Merge(m, src)
+}
+func (m *GetResetLeaderboardProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetResetLeaderboardProgressRequest.Size(m)
+}
+func (m *GetResetLeaderboardProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResetLeaderboardProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetResetLeaderboardProgressRequest proto.InternalMessageInfo
+
+func (m *GetResetLeaderboardProgressRequest) GetLeaderboardId() string {
+	if m != nil {
+		return m.LeaderboardId
+	}
+	return ""
+}
+
+// ResetProgress represents the execution state of a leaderboard reset.
+type ResetProgress struct {
+	LeaderboardID string `protobuf:"bytes,1,opt,name=leaderboardID,proto3" json:"leaderboardID,omitempty"`
+	Target        string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Strategy      string `protobuf:"bytes,3,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// One of running, done or failed.
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// Number of members already processed.
+	Processed int32 `protobuf:"varint,5,opt,name=processed,proto3" json:"processed,omitempty"`
+	// Number of members in the leaderboard when the reset started.
+	Total int32 `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
+	// Unix timestamp of when the reset started.
+	StartedAt int64 `protobuf:"varint,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// Unix timestamp of when the reset finished, zero while running.
+	FinishedAt           int64    `protobuf:"varint,8,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetProgress) Reset()         { *m = ResetProgress{} }
+func (m *ResetProgress) String() string { return proto.CompactTextString(m) }
+func (*ResetProgress) ProtoMessage()    {}
+func (*ResetProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{38}
+}
+
+func (m *ResetProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetProgress.Unmarshal(m, b)
+}
+func (m *ResetProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetProgress.Marshal(b, m, deterministic)
+}
+func (m *ResetProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetProgress.Merge(m, src)
+}
+func (m *ResetProgress) XXX_Size() int {
+	return xxx_messageInfo_ResetProgress.Size(m)
+}
+func (m *ResetProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetProgress proto.InternalMessageInfo
+
+func (m *ResetProgress) GetLeaderboardID() string {
+	if m != nil {
+		return m.LeaderboardID
+	}
+	return ""
+}
+
+func (m *ResetProgress) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *ResetProgress) GetStrategy() string {
+	if m != nil {
+		return m.Strategy
+	}
+	return ""
+}
+
+func (m *ResetProgress) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ResetProgress) GetProcessed() int32 {
+	if m != nil {
+		return m.Processed
+	}
+	return 0
+}
+
+func (m *ResetProgress) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ResetProgress) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+func (m *ResetProgress) GetFinishedAt() int64 {
+	if m != nil {
+		return m.FinishedAt
+	}
+	return 0
+}
+
+type ResetLeaderboardResponse struct {
+	Success              bool           `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Progress             *ResetProgress `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ResetLeaderboardResponse) Reset()         { *m = ResetLeaderboardResponse{} }
+func (m *ResetLeaderboardResponse) String() string { return proto.CompactTextString(m) }
+func (*ResetLeaderboardResponse) ProtoMessage()    {}
+func (*ResetLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{39}
+}
+
+func (m *ResetLeaderboardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetLeaderboardResponse.Unmarshal(m, b)
+}
+func (m *ResetLeaderboardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetLeaderboardResponse.Marshal(b, m, deterministic)
+}
+func (m *ResetLeaderboardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetLeaderboardResponse.Merge(m, src)
+}
+func (m *ResetLeaderboardResponse) XXX_Size() int {
+	return xxx_messageInfo_ResetLeaderboardResponse.Size(m)
+}
+func (m *ResetLeaderboardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetLeaderboardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetLeaderboardResponse proto.InternalMessageInfo
+
+func (m *ResetLeaderboardResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *ResetLeaderboardResponse) GetProgress() *ResetProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+type GetResetLeaderboardProgressResponse struct {
+	Success              bool           `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Progress             *ResetProgress `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetResetLeaderboardProgressResponse) Reset()         { *m = GetResetLeaderboardProgressResponse{} }
+func (m *GetResetLeaderboardProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*GetResetLeaderboardProgressResponse) ProtoMessage()    {}
+func (*GetResetLeaderboardProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{40}
+}
+
+func (m *GetResetLeaderboardProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetResetLeaderboardProgressResponse.Unmarshal(m, b)
+}
+func (m *GetResetLeaderboardProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetResetLeaderboardProgressResponse.Marshal(b, m, deterministic)
+}
+func (m *GetResetLeaderboardProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResetLeaderboardProgressResponse.Merge(m, src)
+}
+func (m *GetResetLeaderboardProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_GetResetLeaderboardProgressResponse.Size(m)
+}
+func (m *GetResetLeaderboardProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResetLeaderboardProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetResetLeaderboardProgressResponse proto.InternalMessageInfo
+
+func (m *GetResetLeaderboardProgressResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *GetResetLeaderboardProgressResponse) GetProgress() *ResetProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+type GetLeaderboardSettingsRequest struct {
+	LeaderboardId        string   `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLeaderboardSettingsRequest) Reset()         { *m = GetLeaderboardSettingsRequest{} }
+func (m *GetLeaderboardSettingsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLeaderboardSettingsRequest) ProtoMessage()    {}
+func (*GetLeaderboardSettingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{41}
+}
+
+func (m *GetLeaderboardSettingsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLeaderboardSettingsRequest.Unmarshal(m, b)
+}
+func (m *GetLeaderboardSettingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLeaderboardSettingsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLeaderboardSettingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLeaderboardSettingsRequest.Merge(m, src)
+}
+func (m *GetLeaderboardSettingsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLeaderboardSettingsRequest.Size(m)
+}
+func (m *GetLeaderboardSettingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLeaderboardSettingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLeaderboardSettingsRequest proto.InternalMessageInfo
+
+func (m *GetLeaderboardSettingsRequest) GetLeaderboardId() string {
+	if m != nil {
+		return m.LeaderboardId
+	}
+	return ""
+}
+
+type UpdateLeaderboardSettingsRequest struct {
+	// The leaderboard identification.
+	LeaderboardId        string                                     `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	Settings             *UpdateLeaderboardSettingsRequest_Settings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                   `json:"-"`
+	XXX_unrecognized     []byte                                     `json:"-"`
+	XXX_sizecache        int32                                      `json:"-"`
+}
+
+func (m *UpdateLeaderboardSettingsRequest) Reset()         { *m = UpdateLeaderboardSettingsRequest{} }
+func (m *UpdateLeaderboardSettingsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateLeaderboardSettingsRequest) ProtoMessage()    {}
+func (*UpdateLeaderboardSettingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{42}
+}
+
+func (m *UpdateLeaderboardSettingsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateLeaderboardSettingsRequest.Unmarshal(m, b)
+}
+func (m *UpdateLeaderboardSettingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateLeaderboardSettingsRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateLeaderboardSettingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateLeaderboardSettingsRequest.Merge(m, src)
+}
+func (m *UpdateLeaderboardSettingsRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateLeaderboardSettingsRequest.Size(m)
+}
+func (m *UpdateLeaderboardSettingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateLeaderboardSettingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateLeaderboardSettingsRequest proto.InternalMessageInfo
+
+func (m *UpdateLeaderboardSettingsRequest) GetLeaderboardId() string {
+	if m != nil {
+		return m.LeaderboardId
+	}
+	return ""
+}
+
+func (m *UpdateLeaderboardSettingsRequest) GetSettings() *UpdateLeaderboardSettingsRequest_Settings {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
+// Settings is the payload with the new leaderboard settings.
+type UpdateLeaderboardSettingsRequest_Settings struct {
+	// Seconds for a score to be worth half, zero disables decay.
+	DecayHalfLife int32 `protobuf:"varint,1,opt,name=decay_half_life,json=decayHalfLife,proto3" json:"decay_half_life,omitempty"`
+	// Scores lower than min_score or greater than max_score are rejected, unset means no limit.
+	MinScore *wrappers.Int64Value `protobuf:"bytes,2,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
+	MaxScore *wrappers.Int64Value `protobuf:"bytes,3,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	// Maximum increase of a member score in a single write, zero means no limit.
+	MaxIncrement int64 `protobuf:"varint,4,opt,name=max_increment,json=maxIncrement,proto3" json:"max_increment,omitempty"`
+	// Maximum total increase of a member score in each window of max_increase_window seconds, zero means no limit.
+	MaxIncrease       int64 `protobuf:"varint,5,opt,name=max_increase,json=maxIncrease,proto3" json:"max_increase,omitempty"`
+	MaxIncreaseWindow int64 `protobuf:"varint,6,opt,name=max_increase_window,json=maxIncreaseWindow,proto3" json:"max_increase_window,omitempty"`
+	// Writes that decrease a member score are rejected.
+	MonotonicOnly bool `protobuf:"varint,7,opt,name=monotonic_only,json=monotonicOnly,proto3" json:"monotonic_only,omitempty"`
+	// Writes must be signed with the secret of this game, empty accepts unsigned writes.
+	SignatureGame string `protobuf:"bytes,8,opt,name=signature_game,json=signatureGame,proto3" json:"signature_game,omitempty"`
+	// Samples the score and rank of members on every write of their scores.
+	HistoryEnabled bool `protobuf:"varint,9,opt,name=history_enabled,json=historyEnabled,proto3" json:"history_enabled,omitempty"`
+	// Keeps only the last sample of each member in each interval of seconds, zero keeps all of them.
+	HistoryInterval int64 `protobuf:"varint,10,opt,name=history_interval,json=historyInterval,proto3" json:"history_interval,omitempty"`
+	// Seconds between rank snapshots of the leaderboard, zero disables them.
+	SnapshotInterval int64 `protobuf:"varint,11,opt,name=snapshot_interval,json=snapshotInterval,proto3" json:"snapshot_interval,omitempty"`
+	// Seconds rank snapshots are kept, zero keeps them while the leaderboard exists.
+	SnapshotRetention int64 `protobuf:"varint,12,opt,name=snapshot_retention,json=snapshotRetention,proto3" json:"snapshot_retention,omitempty"`
+	// Rank milestones members reach by writes of their scores, replacing the current ones.
+	Milestones []*MilestoneRule `protobuf:"bytes,13,rep,name=milestones,proto3" json:"milestones,omitempty"`
+	// Seconds ledger entries are kept, zero keeps them while the ledger exists.
+	LedgerRetention int64 `protobuf:"varint,14,opt,name=ledger_retention,json=ledgerRetention,proto3" json:"ledger_retention,omitempty"`
+	// Most recent ledger entries kept for each member, zero keeps all of them.
+	LedgerMaxEntries     int64    `protobuf:"varint,15,opt,name=ledger_max_entries,json=ledgerMaxEntries,proto3" json:"ledger_max_entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateLeaderboardSettingsRequest_Settings) Reset() {
+	*m = UpdateLeaderboardSettingsRequest_Settings{}
+}
+func (m *UpdateLeaderboardSettingsRequest_Settings) String() string {
+	return proto.CompactTextString(m)
+}
+func (*UpdateLeaderboardSettingsRequest_Settings) ProtoMessage() {}
+func (*UpdateLeaderboardSettingsRequest_Settings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{42, 0}
+}
+
+func (m *UpdateLeaderboardSettingsRequest_Settings) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateLeaderboardSettingsRequest_Settings.Unmarshal(m, b)
+}
+func (m *UpdateLeaderboardSettingsRequest_Settings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateLeaderboardSettingsRequest_Settings.Marshal(b, m, deterministic)
+}
+func (m *UpdateLeaderboardSettingsRequest_Settings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateLeaderboardSettingsRequest_Settings.Merge(m, src)
+}
+func (m *UpdateLeaderboardSettingsRequest_Settings) XXX_Size() int {
+	return xxx_messageInfo_UpdateLeaderboardSettingsRequest_Settings.Size(m)
+}
+func (m *UpdateLeaderboardSettingsRequest_Settings) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateLeaderboardSettingsRequest_Settings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateLeaderboardSettingsRequest_Settings proto.InternalMessageInfo
+
+func (m *UpdateLeaderboardSettingsRequest_Settings) GetDecayHalfLife() int32 {
+	if m != nil {
+		return m.DecayHalfLife
+	}
+	return 0
+}
+
+func (m *UpdateLeaderboardSettingsRequest_Settings) GetMinScore() *wrappers.Int64Value {
+	if m != nil {
+		return m.MinScore
+	}
+	return nil
+}
+
+func (m *UpdateLeaderboardSettingsRequest_Settings) GetMaxScore() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxScore
+	}
+	return nil
+}
+
+func (m *UpdateLeaderboardSettingsRequest_Settings) GetMaxIncrement() int64 {
+	if m != nil {
+		return m.MaxIncrement
+	}
+	return 0
+}
+
+func (m *UpdateLeaderboardSettingsRequest_Settings) GetMaxIncrease() int64 {
+	if m != nil {
+		return m.MaxIncrease
+	}
+	return 0
+}
+
+func (m *UpdateLeaderboardSettingsRequest_Settings) GetMaxIncreaseWindow() int64 {
+	if m != nil {
+		return m.MaxIncreaseWindow
+	}
+	return 0
+}
+
+func (m *UpdateLeaderboardSettingsRequest_Settings) GetMonotonicOnly() bool {
+	if m != nil {
+		return m.MonotonicOnly
+	}
+	return false
+}
+
+func (m *UpdateLeaderboardSettingsRequest_Settings) GetSignatureGame() string {
+	if m != nil {
+		return m.SignatureGame
+	}
+	return ""
+}
+
+func (m *UpdateLeaderboardSettingsRequest_Settings) GetHistoryEnabled() bool {
+	if m != nil {
+		return m.HistoryEnabled
+	}
+	return false
+}
+
+func (m *UpdateLeaderboardSettingsRequest_Settings) GetHistoryInterval() int64 {
+	if m != nil {
+		return m.HistoryInterval
+	}
+	return 0
+}
+
+func (m *UpdateLeaderboardSettingsRequest_Settings) GetSnapshotInterval() int64 {
+	if m != nil {
+		return m.SnapshotInterval
+	}
+	return 0
+}
+
+func (m *UpdateLeaderboardSettingsRequest_Settings) GetSnapshotRetention() int64 {
+	if m != nil {
+		return m.SnapshotRetention
+	}
+	return 0
+}
+
+func (m *UpdateLeaderboardSettingsRequest_Settings) GetMilestones() []*MilestoneRule {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
+}
+
+func (m *UpdateLeaderboardSettingsRequest_Settings) GetLedgerRetention() int64 {
+	if m != nil {
+		return m.LedgerRetention
+	}
+	return 0
+}
+
+func (m *UpdateLeaderboardSettingsRequest_Settings) GetLedgerMaxEntries() int64 {
+	if m != nil {
+		return m.LedgerMaxEntries
+	}
+	return 0
+}
+
+// LeaderboardSettings represents the settings of a leaderboard.
+type LeaderboardSettings struct {
+	LeaderboardID string `protobuf:"bytes,1,opt,name=leaderboardID,proto3" json:"leaderboardID,omitempty"`
+	// Seconds for a score to be worth half, zero when decay is disabled.
+	DecayHalfLife int32 `protobuf:"varint,2,opt,name=decay_half_life,json=decayHalfLife,proto3" json:"decay_half_life,omitempty"`
+	// Unix timestamp from which stored scores are decayed.
+	DecayLandmark int64 `protobuf:"varint,3,opt,name=decay_landmark,json=decayLandmark,proto3" json:"decay_landmark,omitempty"`
+	// Unix timestamps of the window scores can be written, zero means no limit.
+	WriteStartAt int64 `protobuf:"varint,4,opt,name=write_start_at,json=writeStartAt,proto3" json:"write_start_at,omitempty"`
+	WriteEndAt   int64 `protobuf:"varint,5,opt,name=write_end_at,json=writeEndAt,proto3" json:"write_end_at,omitempty"`
+	// Only members that joined the leaderboard can have scores written.
+	ParticipantsOnly bool `protobuf:"varint,6,opt,name=participants_only,json=participantsOnly,proto3" json:"participants_only,omitempty"`
+	// All writes to the leaderboard are rejected.
+	Frozen bool `protobuf:"varint,7,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// Scores lower than min_score or greater than max_score are rejected, unset means no limit.
+	MinScore *wrappers.Int64Value `protobuf:"bytes,8,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
+	MaxScore *wrappers.Int64Value `protobuf:"bytes,9,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	// Maximum increase of a member score in a single write, zero means no limit.
+	MaxIncrement int64 `protobuf:"varint,10,opt,name=max_increment,json=maxIncrement,proto3" json:"max_increment,omitempty"`
+	// Maximum total increase of a member score in each window of max_increase_window seconds, zero means no limit.
+	MaxIncrease       int64 `protobuf:"varint,11,opt,name=max_increase,json=maxIncrease,proto3" json:"max_increase,omitempty"`
+	MaxIncreaseWindow int64 `protobuf:"varint,12,opt,name=max_increase_window,json=maxIncreaseWindow,proto3" json:"max_increase_window,omitempty"`
+	// Writes that decrease a member score are rejected.
+	MonotonicOnly bool `protobuf:"varint,13,opt,name=monotonic_only,json=monotonicOnly,proto3" json:"monotonic_only,omitempty"`
+	// Writes must be signed with the secret of this game, empty accepts unsigned writes.
+	SignatureGame string `protobuf:"bytes,14,opt,name=signature_game,json=signatureGame,proto3" json:"signature_game,omitempty"`
+	// The score and rank of members are sampled on every write of their scores.
+	HistoryEnabled bool `protobuf:"varint,15,opt,name=history_enabled,json=historyEnabled,proto3" json:"history_enabled,omitempty"`
+	// Only the last sample of each member in each interval of seconds is kept, zero keeps all of them.
+	HistoryInterval int64 `protobuf:"varint,16,opt,name=history_interval,json=historyInterval,proto3" json:"history_interval,omitempty"`
+	// Seconds between rank snapshots of the leaderboard, zero when they are disabled.
+	SnapshotInterval int64 `protobuf:"varint,17,opt,name=snapshot_interval,json=snapshotInterval,proto3" json:"snapshot_interval,omitempty"`
+	// Seconds rank snapshots are kept, zero keeps them while the leaderboard exists.
+	SnapshotRetention int64 `protobuf:"varint,18,opt,name=snapshot_retention,json=snapshotRetention,proto3" json:"snapshot_retention,omitempty"`
+	// Rank milestones members reach by writes of their scores.
+	Milestones []*MilestoneRule `protobuf:"bytes,19,rep,name=milestones,proto3" json:"milestones,omitempty"`
+	// Seconds ledger entries are kept, zero keeps them while the ledger exists.
+	LedgerRetention int64 `protobuf:"varint,20,opt,name=ledger_retention,json=ledgerRetention,proto3" json:"ledger_retention,omitempty"`
+	// Most recent ledger entries kept for each member, zero keeps all of them.
+	LedgerMaxEntries     int64    `protobuf:"varint,21,opt,name=ledger_max_entries,json=ledgerMaxEntries,proto3" json:"ledger_max_entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaderboardSettings) Reset()         { *m = LeaderboardSettings{} }
+func (m *LeaderboardSettings) String() string { return proto.CompactTextString(m) }
+func (*LeaderboardSettings) ProtoMessage()    {}
+func (*LeaderboardSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{43}
+}
+
+func (m *LeaderboardSettings) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaderboardSettings.Unmarshal(m, b)
+}
+func (m *LeaderboardSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaderboardSettings.Marshal(b, m, deterministic)
+}
+func (m *LeaderboardSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaderboardSettings.Merge(m, src)
+}
+func (m *LeaderboardSettings) XXX_Size() int {
+	return xxx_messageInfo_LeaderboardSettings.Size(m)
+}
+func (m *LeaderboardSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaderboardSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaderboardSettings proto.InternalMessageInfo
+
+func (m *LeaderboardSettings) GetLeaderboardID() string {
+	if m != nil {
+		return m.LeaderboardID
+	}
+	return ""
+}
+
+func (m *LeaderboardSettings) GetDecayHalfLife() int32 {
+	if m != nil {
+		return m.DecayHalfLife
+	}
+	return 0
+}
+
+func (m *LeaderboardSettings) GetDecayLandmark() int64 {
+	if m != nil {
+		return m.DecayLandmark
+	}
+	return 0
+}
+
+func (m *LeaderboardSettings) GetWriteStartAt() int64 {
+	if m != nil {
+		return m.WriteStartAt
+	}
+	return 0
+}
+
+func (m *LeaderboardSettings) GetWriteEndAt() int64 {
+	if m != nil {
+		return m.WriteEndAt
+	}
+	return 0
+}
+
+func (m *LeaderboardSettings) GetParticipantsOnly() bool {
+	if m != nil {
+		return m.ParticipantsOnly
+	}
+	return false
+}
+
+func (m *LeaderboardSettings) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+func (m *LeaderboardSettings) GetMinScore() *wrappers.Int64Value {
+	if m != nil {
+		return m.MinScore
+	}
+	return nil
+}
+
+func (m *LeaderboardSettings) GetMaxScore() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxScore
+	}
+	return nil
+}
+
+func (m *LeaderboardSettings) GetMaxIncrement() int64 {
+	if m != nil {
+		return m.MaxIncrement
+	}
+	return 0
+}
+
+func (m *LeaderboardSettings) GetMaxIncrease() int64 {
+	if m != nil {
+		return m.MaxIncrease
+	}
+	return 0
+}
+
+func (m *LeaderboardSettings) GetMaxIncreaseWindow() int64 {
+	if m != nil {
+		return m.MaxIncreaseWindow
+	}
+	return 0
+}
+
+func (m *LeaderboardSettings) GetMonotonicOnly() bool {
+	if m != nil {
+		return m.MonotonicOnly
+	}
+	return false
+}
+
+func (m *LeaderboardSettings) GetSignatureGame() string {
+	if m != nil {
+		return m.SignatureGame
+	}
+	return ""
+}
+
+func (m *LeaderboardSettings) GetHistoryEnabled() bool {
+	if m != nil {
+		return m.HistoryEnabled
+	}
+	return false
+}
+
+func (m *LeaderboardSettings) GetHistoryInterval() int64 {
+	if m != nil {
+		return m.HistoryInterval
+	}
+	return 0
+}
+
+func (m *LeaderboardSettings) GetSnapshotInterval() int64 {
+	if m != nil {
+		return m.SnapshotInterval
+	}
+	return 0
+}
+
+func (m *LeaderboardSettings) GetSnapshotRetention() int64 {
+	if m != nil {
+		return m.SnapshotRetention
+	}
+	return 0
+}
+
+func (m *LeaderboardSettings) GetMilestones() []*MilestoneRule {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
+}
+
+func (m *LeaderboardSettings) GetLedgerRetention() int64 {
+	if m != nil {
+		return m.LedgerRetention
+	}
+	return 0
+}
+
+func (m *LeaderboardSettings) GetLedgerMaxEntries() int64 {
+	if m != nil {
+		return m.LedgerMaxEntries
+	}
+	return 0
+}
+
+// MilestoneRule is a rank milestone of a leaderboard delivered to the milestones webhook when a member reaches it.
+type MilestoneRule struct {
+	// Identification of the rule in the milestones it matches.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// rankAtMost is reached when a member rank crosses into rank or better,
+	// passedMember is reached when a member overtakes member.
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Rank                 int32    `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	Member               string   `protobuf:"bytes,4,opt,name=member,proto3" json:"member,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MilestoneRule) Reset()         { *m = MilestoneRule{} }
+func (m *MilestoneRule) String() string { return proto.CompactTextString(m) }
+func (*MilestoneRule) ProtoMessage()    {}
+func (*MilestoneRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{44}
+}
+
+func (m *MilestoneRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MilestoneRule.Unmarshal(m, b)
+}
+func (m *MilestoneRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MilestoneRule.Marshal(b, m, deterministic)
+}
+func (m *MilestoneRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MilestoneRule.Merge(m, src)
+}
+func (m *MilestoneRule) XXX_Size() int {
+	return xxx_messageInfo_MilestoneRule.Size(m)
+}
+func (m *MilestoneRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MilestoneRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MilestoneRule proto.InternalMessageInfo
+
+func (m *MilestoneRule) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *MilestoneRule) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *MilestoneRule) GetRank() int32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *MilestoneRule) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+type LeaderboardSettingsResponse struct {
+	Success              bool                 `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Settings             *LeaderboardSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *LeaderboardSettingsResponse) Reset()         { *m = LeaderboardSettingsResponse{} }
+func (m *LeaderboardSettingsResponse) String() string { return proto.CompactTextString(m) }
+func (*LeaderboardSettingsResponse) ProtoMessage()    {}
+func (*LeaderboardSettingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{45}
+}
+
+func (m *LeaderboardSettingsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaderboardSettingsResponse.Unmarshal(m, b)
+}
+func (m *LeaderboardSettingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaderboardSettingsResponse.Marshal(b, m, deterministic)
+}
+func (m *LeaderboardSettingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaderboardSettingsResponse.Merge(m, src)
+}
+func (m *LeaderboardSettingsResponse) XXX_Size() int {
+	return xxx_messageInfo_LeaderboardSettingsResponse.Size(m)
+}
+func (m *LeaderboardSettingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaderboardSettingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaderboardSettingsResponse proto.InternalMessageInfo
+
+func (m *LeaderboardSettingsResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *LeaderboardSettingsResponse) GetSettings() *LeaderboardSettings {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
+type FreezeLeaderboardRequest struct {
+	LeaderboardId        string   `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FreezeLeaderboardRequest) Reset()         { *m = FreezeLeaderboardRequest{} }
+func (m *FreezeLeaderboardRequest) String() string { return proto.CompactTextString(m) }
+func (*FreezeLeaderboardRequest) ProtoMessage()    {}
+func (*FreezeLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{46}
+}
+
+func (m *FreezeLeaderboardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FreezeLeaderboardRequest.Unmarshal(m, b)
+}
+func (m *FreezeLeaderboardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FreezeLeaderboardRequest.Marshal(b, m, deterministic)
+}
+func (m *FreezeLeaderboardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreezeLeaderboardRequest.Merge(m, src)
+}
+func (m *FreezeLeaderboardRequest) XXX_Size() int {
+	return xxx_messageInfo_FreezeLeaderboardRequest.Size(m)
+}
+func (m *FreezeLeaderboardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreezeLeaderboardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreezeLeaderboardRequest proto.InternalMessageInfo
+
+func (m *FreezeLeaderboardRequest) GetLeaderboardId() string {
+	if m != nil {
+		return m.LeaderboardId
+	}
+	return ""
+}
+
+type UnfreezeLeaderboardRequest struct {
+	LeaderboardId        string   `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnfreezeLeaderboardRequest) Reset()         { *m = UnfreezeLeaderboardRequest{} }
+func (m *UnfreezeLeaderboardRequest) String() string { return proto.CompactTextString(m) }
+func (*UnfreezeLeaderboardRequest) ProtoMessage()    {}
+func (*UnfreezeLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{47}
+}
+
+func (m *UnfreezeLeaderboardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnfreezeLeaderboardRequest.Unmarshal(m, b)
+}
+func (m *UnfreezeLeaderboardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnfreezeLeaderboardRequest.Marshal(b, m, deterministic)
+}
+func (m *UnfreezeLeaderboardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnfreezeLeaderboardRequest.Merge(m, src)
+}
+func (m *UnfreezeLeaderboardRequest) XXX_Size() int {
+	return xxx_messageInfo_UnfreezeLeaderboardRequest.Size(m)
+}
+func (m *UnfreezeLeaderboardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnfreezeLeaderboardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnfreezeLeaderboardRequest proto.InternalMessageInfo
+
+func (m *UnfreezeLeaderboardRequest) GetLeaderboardId() string {
+	if m != nil {
+		return m.LeaderboardId
+	}
+	return ""
+}
+
+type GetRejectedScoresRequest struct {
+	LeaderboardId        string   `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	Page                 int32    `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize             int32    `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRejectedScoresRequest) Reset()         { *m = GetRejectedScoresRequest{} }
+func (m *GetRejectedScoresRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRejectedScoresRequest) ProtoMessage()    {}
+func (*GetRejectedScoresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{48}
+}
+
+func (m *GetRejectedScoresRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRejectedScoresRequest.Unmarshal(m, b)
+}
+func (m *GetRejectedScoresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRejectedScoresRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRejectedScoresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRejectedScoresRequest.Merge(m, src)
+}
+func (m *GetRejectedScoresRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRejectedScoresRequest.Size(m)
+}
+func (m *GetRejectedScoresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRejectedScoresRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRejectedScoresRequest proto.InternalMessageInfo
+
+func (m *GetRejectedScoresRequest) GetLeaderboardId() string {
+	if m != nil {
+		return m.LeaderboardId
+	}
+	return ""
+}
+
+func (m *GetRejectedScoresRequest) GetPage() int32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *GetRejectedScoresRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+type GetRejectedScoresResponse struct {
+	Success              bool                                       `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	RejectedScores       []*GetRejectedScoresResponse_RejectedScore `protobuf:"bytes,2,rep,name=rejected_scores,json=rejectedScores,proto3" json:"rejected_scores,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                   `json:"-"`
+	XXX_unrecognized     []byte                                     `json:"-"`
+	XXX_sizecache        int32                                      `json:"-"`
+}
+
+func (m *GetRejectedScoresResponse) Reset()         { *m = GetRejectedScoresResponse{} }
+func (m *GetRejectedScoresResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRejectedScoresResponse) ProtoMessage()    {}
+func (*GetRejectedScoresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{49}
+}
+
+func (m *GetRejectedScoresResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRejectedScoresResponse.Unmarshal(m, b)
+}
+func (m *GetRejectedScoresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRejectedScoresResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRejectedScoresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRejectedScoresResponse.Merge(m, src)
+}
+func (m *GetRejectedScoresResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRejectedScoresResponse.Size(m)
+}
+func (m *GetRejectedScoresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRejectedScoresResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRejectedScoresResponse proto.InternalMessageInfo
+
+func (m *GetRejectedScoresResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *GetRejectedScoresResponse) GetRejectedScores() []*GetRejectedScoresResponse_RejectedScore {
+	if m != nil {
+		return m.RejectedScores
+	}
+	return nil
+}
+
+// RejectedScore represents a score rejected by a rule of the leaderboard.
+type GetRejectedScoresResponse_RejectedScore struct {
+	PublicID string `protobuf:"bytes,1,opt,name=publicID,proto3" json:"publicID,omitempty"`
+	// The rejected score and the member score when it was rejected.
+	Score         int64 `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	PreviousScore int64 `protobuf:"varint,3,opt,name=previous_score,json=previousScore,proto3" json:"previous_score,omitempty"`
+	// The rule that rejected the score: minScore, maxScore, maxIncrement, maxIncrease or monotonicOnly.
+	Rule string `protobuf:"bytes,4,opt,name=rule,proto3" json:"rule,omitempty"`
+	// Unix timestamp of when the score was rejected.
+	RejectedAt           int64    `protobuf:"varint,5,opt,name=rejected_at,json=rejectedAt,proto3" json:"rejected_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRejectedScoresResponse_RejectedScore) Reset() {
+	*m = GetRejectedScoresResponse_RejectedScore{}
+}
+func (m *GetRejectedScoresResponse_RejectedScore) String() string { return proto.CompactTextString(m) }
+func (*GetRejectedScoresResponse_RejectedScore) ProtoMessage()    {}
+func (*GetRejectedScoresResponse_RejectedScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{49, 0}
+}
+
+func (m *GetRejectedScoresResponse_RejectedScore) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRejectedScoresResponse_RejectedScore.Unmarshal(m, b)
+}
+func (m *GetRejectedScoresResponse_RejectedScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRejectedScoresResponse_RejectedScore.Marshal(b, m, deterministic)
+}
+func (m *GetRejectedScoresResponse_RejectedScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRejectedScoresResponse_RejectedScore.Merge(m, src)
+}
+func (m *GetRejectedScoresResponse_RejectedScore) XXX_Size() int {
+	return xxx_messageInfo_GetRejectedScoresResponse_RejectedScore.Size(m)
+}
+func (m *GetRejectedScoresResponse_RejectedScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRejectedScoresResponse_RejectedScore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRejectedScoresResponse_RejectedScore proto.InternalMessageInfo
+
+func (m *GetRejectedScoresResponse_RejectedScore) GetPublicID() string {
+	if m != nil {
+		return m.PublicID
+	}
+	return ""
+}
+
+func (m *GetRejectedScoresResponse_RejectedScore) GetScore() int64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *GetRejectedScoresResponse_RejectedScore) GetPreviousScore() int64 {
+	if m != nil {
+		return m.PreviousScore
+	}
+	return 0
+}
+
+func (m *GetRejectedScoresResponse_RejectedScore) GetRule() string {
+	if m != nil {
+		return m.Rule
+	}
+	return ""
+}
+
+func (m *GetRejectedScoresResponse_RejectedScore) GetRejectedAt() int64 {
+	if m != nil {
+		return m.RejectedAt
+	}
+	return 0
+}
+
+type BlockMemberRequest struct {
+	LeaderboardId  string `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	MemberPublicId string `protobuf:"bytes,2,opt,name=member_public_id,json=memberPublicId,proto3" json:"member_public_id,omitempty"`
+	// How writes of the member are handled: reject or shadow, which accepts them but only the member sees its score.
+	Mode                 string   `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockMemberRequest) Reset()         { *m = BlockMemberRequest{} }
+func (m *BlockMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockMemberRequest) ProtoMessage()    {}
+func (*BlockMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{50}
+}
+
+func (m *BlockMemberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockMemberRequest.Unmarshal(m, b)
+}
+func (m *BlockMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockMemberRequest.Marshal(b, m, deterministic)
+}
+func (m *BlockMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockMemberRequest.Merge(m, src)
+}
+func (m *BlockMemberRequest) XXX_Size() int {
+	return xxx_messageInfo_BlockMemberRequest.Size(m)
+}
+func (m *BlockMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockMemberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockMemberRequest proto.InternalMessageInfo
+
+func (m *BlockMemberRequest) GetLeaderboardId() string {
+	if m != nil {
+		return m.LeaderboardId
+	}
+	return ""
+}
+
+func (m *BlockMemberRequest) GetMemberPublicId() string {
+	if m != nil {
+		return m.MemberPublicId
+	}
+	return ""
+}
+
+func (m *BlockMemberRequest) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+type UnblockMemberRequest struct {
+	LeaderboardId        string   `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	MemberPublicId       string   `protobuf:"bytes,2,opt,name=member_public_id,json=memberPublicId,proto3" json:"member_public_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnblockMemberRequest) Reset()         { *m = UnblockMemberRequest{} }
+func (m *UnblockMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*UnblockMemberRequest) ProtoMessage()    {}
+func (*UnblockMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{51}
+}
+
+func (m *UnblockMemberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnblockMemberRequest.Unmarshal(m, b)
+}
+func (m *UnblockMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnblockMemberRequest.Marshal(b, m, deterministic)
+}
+func (m *UnblockMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnblockMemberRequest.Merge(m, src)
+}
+func (m *UnblockMemberRequest) XXX_Size() int {
+	return xxx_messageInfo_UnblockMemberRequest.Size(m)
+}
+func (m *UnblockMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnblockMemberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnblockMemberRequest proto.InternalMessageInfo
+
+func (m *UnblockMemberRequest) GetLeaderboardId() string {
+	if m != nil {
+		return m.LeaderboardId
+	}
+	return ""
+}
+
+func (m *UnblockMemberRequest) GetMemberPublicId() string {
+	if m != nil {
+		return m.MemberPublicId
+	}
+	return ""
+}
+
+type BlockMemberGloballyRequest struct {
+	MemberPublicId string `protobuf:"bytes,1,opt,name=member_public_id,json=memberPublicId,proto3" json:"member_public_id,omitempty"`
+	// How writes of the member are handled: reject or shadow, which accepts them but only the member sees its score.
+	Mode                 string   `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockMemberGloballyRequest) Reset()         { *m = BlockMemberGloballyRequest{} }
+func (m *BlockMemberGloballyRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockMemberGloballyRequest) ProtoMessage()    {}
+func (*BlockMemberGloballyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{52}
+}
+
+func (m *BlockMemberGloballyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockMemberGloballyRequest.Unmarshal(m, b)
+}
+func (m *BlockMemberGloballyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockMemberGloballyRequest.Marshal(b, m, deterministic)
+}
+func (m *BlockMemberGloballyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockMemberGloballyRequest.Merge(m, src)
+}
+func (m *BlockMemberGloballyRequest) XXX_Size() int {
+	return xxx_messageInfo_BlockMemberGloballyRequest.Size(m)
+}
+func (m *BlockMemberGloballyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockMemberGloballyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockMemberGloballyRequest proto.InternalMessageInfo
+
+func (m *BlockMemberGloballyRequest) GetMemberPublicId() string {
+	if m != nil {
+		return m.MemberPublicId
+	}
+	return ""
+}
+
+func (m *BlockMemberGloballyRequest) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+type UnblockMemberGloballyRequest struct {
+	MemberPublicId       string   `protobuf:"bytes,1,opt,name=member_public_id,json=memberPublicId,proto3" json:"member_public_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnblockMemberGloballyRequest) Reset()         { *m = UnblockMemberGloballyRequest{} }
+func (m *UnblockMemberGloballyRequest) String() string { return proto.CompactTextString(m) }
+func (*UnblockMemberGloballyRequest) ProtoMessage()    {}
+func (*UnblockMemberGloballyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{53}
+}
+
+func (m *UnblockMemberGloballyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnblockMemberGloballyRequest.Unmarshal(m, b)
+}
+func (m *UnblockMemberGloballyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnblockMemberGloballyRequest.Marshal(b, m, deterministic)
+}
+func (m *UnblockMemberGloballyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnblockMemberGloballyRequest.Merge(m, src)
+}
+func (m *UnblockMemberGloballyRequest) XXX_Size() int {
+	return xxx_messageInfo_UnblockMemberGloballyRequest.Size(m)
+}
+func (m *UnblockMemberGloballyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnblockMemberGloballyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnblockMemberGloballyRequest proto.InternalMessageInfo
+
+func (m *UnblockMemberGloballyRequest) GetMemberPublicId() string {
+	if m != nil {
+		return m.MemberPublicId
+	}
+	return ""
+}
+
+type BlocklistResponse struct {
+	// If the request was successfull.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// If the request failed the reason (as a error message) is written here.
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlocklistResponse) Reset()         { *m = BlocklistResponse{} }
+func (m *BlocklistResponse) String() string { return proto.CompactTextString(m) }
+func (*BlocklistResponse) ProtoMessage()    {}
+func (*BlocklistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{54}
+}
+
+func (m *BlocklistResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlocklistResponse.Unmarshal(m, b)
+}
+func (m *BlocklistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlocklistResponse.Marshal(b, m, deterministic)
+}
+func (m *BlocklistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlocklistResponse.Merge(m, src)
+}
+func (m *BlocklistResponse) XXX_Size() int {
+	return xxx_messageInfo_BlocklistResponse.Size(m)
+}
+func (m *BlocklistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlocklistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlocklistResponse proto.InternalMessageInfo
+
+func (m *BlocklistResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *BlocklistResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// LedgerEntry represents a change of a member score recorded in the ledger of a leaderboard.
+type LedgerEntry struct {
+	LeaderboardId string `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	PublicID      string `protobuf:"bytes,2,opt,name=publicID,proto3" json:"publicID,omitempty"`
+	// Member score before and after the change, null if the member did not exist or was removed.
+	OldScore *wrappers.Int64Value `protobuf:"bytes,3,opt,name=old_score,json=oldScore,proto3" json:"old_score,omitempty"`
+	NewScore *wrappers.Int64Value `protobuf:"bytes,4,opt,name=new_score,json=newScore,proto3" json:"new_score,omitempty"`
+	Delta    int64                `protobuf:"varint,5,opt,name=delta,proto3" json:"delta,omitempty"`
+	// The reason and caller of the write, from the X-Podium-Reason and X-Podium-Caller headers.
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Caller string `protobuf:"bytes,7,opt,name=caller,proto3" json:"caller,omitempty"`
+	// Unix timestamp of when the score changed.
+	ChangedAt            int64    `protobuf:"varint,8,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LedgerEntry) Reset()         { *m = LedgerEntry{} }
+func (m *LedgerEntry) String() string { return proto.CompactTextString(m) }
+func (*LedgerEntry) ProtoMessage()    {}
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{55}
+}
+
+func (m *LedgerEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LedgerEntry.Unmarshal(m, b)
+}
+func (m *LedgerEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LedgerEntry.Marshal(b, m, deterministic)
+}
+func (m *LedgerEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LedgerEntry.Merge(m, src)
+}
+func (m *LedgerEntry) XXX_Size() int {
+	return xxx_messageInfo_LedgerEntry.Size(m)
+}
+func (m *LedgerEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LedgerEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LedgerEntry proto.InternalMessageInfo
+
+func (m *LedgerEntry) GetLeaderboardId() string {
+	if m != nil {
+		return m.LeaderboardId
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetPublicID() string {
+	if m != nil {
+		return m.PublicID
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetOldScore() *wrappers.Int64Value {
+	if m != nil {
+		return m.OldScore
+	}
+	return nil
+}
+
+func (m *LedgerEntry) GetNewScore() *wrappers.Int64Value {
+	if m != nil {
+		return m.NewScore
+	}
+	return nil
+}
+
+func (m *LedgerEntry) GetDelta() int64 {
+	if m != nil {
+		return m.Delta
+	}
+	return 0
+}
+
+func (m *LedgerEntry) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetChangedAt() int64 {
+	if m != nil {
+		return m.ChangedAt
+	}
+	return 0
+}
+
+type GetMemberLedgerRequest struct {
+	LeaderboardId  string `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	MemberPublicId string `protobuf:"bytes,2,opt,name=member_public_id,json=memberPublicId,proto3" json:"member_public_id,omitempty"`
+	// Unix timestamp of the oldest change to retrieve, all of them if not set.
+	Since                int64    `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`
+	Page                 int32    `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize             int32    `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMemberLedgerRequest) Reset()         { *m = GetMemberLedgerRequest{} }
+func (m *GetMemberLedgerRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMemberLedgerRequest) ProtoMessage()    {}
+func (*GetMemberLedgerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{56}
+}
+
+func (m *GetMemberLedgerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMemberLedgerRequest.Unmarshal(m, b)
+}
+func (m *GetMemberLedgerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMemberLedgerRequest.Marshal(b, m, deterministic)
+}
+func (m *GetMemberLedgerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMemberLedgerRequest.Merge(m, src)
+}
+func (m *GetMemberLedgerRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMemberLedgerRequest.Size(m)
+}
+func (m *GetMemberLedgerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMemberLedgerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMemberLedgerRequest proto.InternalMessageInfo
+
+func (m *GetMemberLedgerRequest) GetLeaderboardId() string {
+	if m != nil {
+		return m.LeaderboardId
+	}
+	return ""
+}
+
+func (m *GetMemberLedgerRequest) GetMemberPublicId() string {
+	if m != nil {
+		return m.MemberPublicId
+	}
+	return ""
+}
+
+func (m *GetMemberLedgerRequest) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+func (m *GetMemberLedgerRequest) GetPage() int32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *GetMemberLedgerRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+type GetMemberLedgerResponse struct {
+	Success              bool           `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Entries              []*LedgerEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetMemberLedgerResponse) Reset()         { *m = GetMemberLedgerResponse{} }
+func (m *GetMemberLedgerResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMemberLedgerResponse) ProtoMessage()    {}
+func (*GetMemberLedgerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{57}
+}
+
+func (m *GetMemberLedgerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMemberLedgerResponse.Unmarshal(m, b)
+}
+func (m *GetMemberLedgerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMemberLedgerResponse.Marshal(b, m, deterministic)
+}
+func (m *GetMemberLedgerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMemberLedgerResponse.Merge(m, src)
+}
+func (m *GetMemberLedgerResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMemberLedgerResponse.Size(m)
+}
+func (m *GetMemberLedgerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMemberLedgerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMemberLedgerResponse proto.InternalMessageInfo
+
+func (m *GetMemberLedgerResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *GetMemberLedgerResponse) GetEntries() []*LedgerEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type RollbackMemberRequest struct {
+	LeaderboardId  string `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	MemberPublicId string `protobuf:"bytes,2,opt,name=member_public_id,json=memberPublicId,proto3" json:"member_public_id,omitempty"`
+	// Unix timestamp of the oldest change to revert, the member gets back the score it had before it.
+	Since                int64    `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackMemberRequest) Reset()         { *m = RollbackMemberRequest{} }
+func (m *RollbackMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackMemberRequest) ProtoMessage()    {}
+func (*RollbackMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{58}
+}
+
+func (m *RollbackMemberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RollbackMemberRequest.Unmarshal(m, b)
+}
+func (m *RollbackMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RollbackMemberRequest.Marshal(b, m, deterministic)
+}
+func (m *RollbackMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackMemberRequest.Merge(m, src)
+}
+func (m *RollbackMemberRequest) XXX_Size() int {
+	return xxx_messageInfo_RollbackMemberRequest.Size(m)
+}
+func (m *RollbackMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackMemberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackMemberRequest proto.InternalMessageInfo
+
+func (m *RollbackMemberRequest) GetLeaderboardId() string {
+	if m != nil {
+		return m.LeaderboardId
+	}
+	return ""
+}
+
+func (m *RollbackMemberRequest) GetMemberPublicId() string {
+	if m != nil {
+		return m.MemberPublicId
+	}
+	return ""
+}
+
+func (m *RollbackMemberRequest) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+type RollbackMemberResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// The change made by the rollback.
+	Entry                *LedgerEntry `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *RollbackMemberResponse) Reset()         { *m = RollbackMemberResponse{} }
+func (m *RollbackMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*RollbackMemberResponse) ProtoMessage()    {}
+func (*RollbackMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{59}
+}
+
+func (m *RollbackMemberResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RollbackMemberResponse.Unmarshal(m, b)
+}
+func (m *RollbackMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RollbackMemberResponse.Marshal(b, m, deterministic)
+}
+func (m *RollbackMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackMemberResponse.Merge(m, src)
+}
+func (m *RollbackMemberResponse) XXX_Size() int {
+	return xxx_messageInfo_RollbackMemberResponse.Size(m)
+}
+func (m *RollbackMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackMemberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackMemberResponse proto.InternalMessageInfo
+
+func (m *RollbackMemberResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *RollbackMemberResponse) GetEntry() *LedgerEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return nil
+}
+
+// HistorySample represents the score and rank of a member at a point in time.
+type HistorySample struct {
+	Score int64 `protobuf:"varint,1,opt,name=score,proto3" json:"score,omitempty"`
+	Rank  int32 `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// Unix timestamp of when the sample was taken.
+	SampledAt            int64    `protobuf:"varint,3,opt,name=sampled_at,json=sampledAt,proto3" json:"sampled_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HistorySample) Reset()         { *m = HistorySample{} }
+func (m *HistorySample) String() string { return proto.CompactTextString(m) }
+func (*HistorySample) ProtoMessage()    {}
+func (*HistorySample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{60}
+}
+
+func (m *HistorySample) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HistorySample.Unmarshal(m, b)
+}
+func (m *HistorySample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HistorySample.Marshal(b, m, deterministic)
+}
+func (m *HistorySample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistorySample.Merge(m, src)
+}
+func (m *HistorySample) XXX_Size() int {
+	return xxx_messageInfo_HistorySample.Size(m)
+}
+func (m *HistorySample) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistorySample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistorySample proto.InternalMessageInfo
+
+func (m *HistorySample) GetScore() int64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *HistorySample) GetRank() int32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *HistorySample) GetSampledAt() int64 {
+	if m != nil {
+		return m.SampledAt
+	}
+	return 0
+}
+
+type GetMemberHistoryRequest struct {
+	LeaderboardId  string `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	MemberPublicId string `protobuf:"bytes,2,opt,name=member_public_id,json=memberPublicId,proto3" json:"member_public_id,omitempty"`
+	// Unix timestamps limiting when samples were taken, zero means no limit.
+	From                 int64    `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	To                   int64    `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMemberHistoryRequest) Reset()         { *m = GetMemberHistoryRequest{} }
+func (m *GetMemberHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMemberHistoryRequest) ProtoMessage()    {}
+func (*GetMemberHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{61}
+}
+
+func (m *GetMemberHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMemberHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetMemberHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMemberHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetMemberHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMemberHistoryRequest.Merge(m, src)
+}
+func (m *GetMemberHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMemberHistoryRequest.Size(m)
+}
+func (m *GetMemberHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMemberHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMemberHistoryRequest proto.InternalMessageInfo
+
+func (m *GetMemberHistoryRequest) GetLeaderboardId() string {
+	if m != nil {
+		return m.LeaderboardId
+	}
+	return ""
+}
+
+func (m *GetMemberHistoryRequest) GetMemberPublicId() string {
+	if m != nil {
+		return m.MemberPublicId
+	}
+	return ""
+}
+
+func (m *GetMemberHistoryRequest) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *GetMemberHistoryRequest) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+type GetMemberHistoryResponse struct {
+	Success              bool             `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Samples              []*HistorySample `protobuf:"bytes,2,rep,name=samples,proto3" json:"samples,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetMemberHistoryResponse) Reset()         { *m = GetMemberHistoryResponse{} }
+func (m *GetMemberHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMemberHistoryResponse) ProtoMessage()    {}
+func (*GetMemberHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{62}
+}
+
+func (m *GetMemberHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMemberHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetMemberHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMemberHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetMemberHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMemberHistoryResponse.Merge(m, src)
+}
+func (m *GetMemberHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMemberHistoryResponse.Size(m)
+}
+func (m *GetMemberHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMemberHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMemberHistoryResponse proto.InternalMessageInfo
+
+func (m *GetMemberHistoryResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *GetMemberHistoryResponse) GetSamples() []*HistorySample {
+	if m != nil {
+		return m.Samples
+	}
+	return nil
+}
+
+// RankMove represents how the rank of a member changed between two rank snapshots.
+type RankMove struct {
+	PublicID     string `protobuf:"bytes,1,opt,name=publicID,proto3" json:"publicID,omitempty"`
+	PreviousRank int32  `protobuf:"varint,2,opt,name=previous_rank,json=previousRank,proto3" json:"previous_rank,omitempty"`
+	Rank         int32  `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	// Positions the member climbed, negative if it fell.
+	RankDelta            int32    `protobuf:"varint,4,opt,name=rank_delta,json=rankDelta,proto3" json:"rank_delta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RankMove) Reset()         { *m = RankMove{} }
+func (m *RankMove) String() string { return proto.CompactTextString(m) }
+func (*RankMove) ProtoMessage()    {}
+func (*RankMove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{63}
+}
+
+func (m *RankMove) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RankMove.Unmarshal(m, b)
+}
+func (m *RankMove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RankMove.Marshal(b, m, deterministic)
+}
+func (m *RankMove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RankMove.Merge(m, src)
+}
+func (m *RankMove) XXX_Size() int {
+	return xxx_messageInfo_RankMove.Size(m)
+}
+func (m *RankMove) XXX_DiscardUnknown() {
+	xxx_messageInfo_RankMove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RankMove proto.InternalMessageInfo
+
+func (m *RankMove) GetPublicID() string {
+	if m != nil {
+		return m.PublicID
+	}
+	return ""
+}
+
+func (m *RankMove) GetPreviousRank() int32 {
+	if m != nil {
+		return m.PreviousRank
+	}
+	return 0
+}
+
+func (m *RankMove) GetRank() int32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *RankMove) GetRankDelta() int32 {
+	if m != nil {
+		return m.RankDelta
+	}
+	return 0
+}
+
+type GetRankMoversRequest struct {
+	LeaderboardId string `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	// Unix timestamps until which the newest rank snapshots are compared, a zero to compares with now.
+	From int64 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To   int64 `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	// Maximum number of climbers and of fallers returned.
+	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Order                string   `protobuf:"bytes,5,opt,name=order,proto3" json:"order,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRankMoversRequest) Reset()         { *m = GetRankMoversRequest{} }
+func (m *GetRankMoversRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRankMoversRequest) ProtoMessage()    {}
+func (*GetRankMoversRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{64}
+}
+
+func (m *GetRankMoversRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRankMoversRequest.Unmarshal(m, b)
+}
+func (m *GetRankMoversRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRankMoversRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRankMoversRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRankMoversRequest.Merge(m, src)
+}
+func (m *GetRankMoversRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRankMoversRequest.Size(m)
+}
+func (m *GetRankMoversRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRankMoversRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRankMoversRequest proto.InternalMessageInfo
+
+func (m *GetRankMoversRequest) GetLeaderboardId() string {
+	if m != nil {
+		return m.LeaderboardId
+	}
+	return ""
+}
+
+func (m *GetRankMoversRequest) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *GetRankMoversRequest) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+func (m *GetRankMoversRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetRankMoversRequest) GetOrder() string {
+	if m != nil {
+		return m.Order
+	}
+	return ""
+}
+
+type GetRankMoversResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Unix timestamps of when the compared rank snapshots were taken, to is zero when compared with now.
+	From                 int64       `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   int64       `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	Climbers             []*RankMove `protobuf:"bytes,4,rep,name=climbers,proto3" json:"climbers,omitempty"`
+	Fallers              []*RankMove `protobuf:"bytes,5,rep,name=fallers,proto3" json:"fallers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetRankMoversResponse) Reset()         { *m = GetRankMoversResponse{} }
+func (m *GetRankMoversResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRankMoversResponse) ProtoMessage()    {}
+func (*GetRankMoversResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{65}
+}
+
+func (m *GetRankMoversResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRankMoversResponse.Unmarshal(m, b)
+}
+func (m *GetRankMoversResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRankMoversResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRankMoversResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRankMoversResponse.Merge(m, src)
+}
+func (m *GetRankMoversResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRankMoversResponse.Size(m)
+}
+func (m *GetRankMoversResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRankMoversResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRankMoversResponse proto.InternalMessageInfo
+
+func (m *GetRankMoversResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *GetRankMoversResponse) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *GetRankMoversResponse) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+func (m *GetRankMoversResponse) GetClimbers() []*RankMove {
+	if m != nil {
+		return m.Climbers
+	}
+	return nil
+}
+
+func (m *GetRankMoversResponse) GetFallers() []*RankMove {
+	if m != nil {
+		return m.Fallers
+	}
+	return nil
+}
+
+type DiffLeaderboardRequest struct {
+	LeaderboardId string `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	// Unix timestamps until which the newest rank snapshots are compared, a zero to compares with now.
+	From                 int64    `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   int64    `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	Order                string   `protobuf:"bytes,4,opt,name=order,proto3" json:"order,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiffLeaderboardRequest) Reset()         { *m = DiffLeaderboardRequest{} }
+func (m *DiffLeaderboardRequest) String() string { return proto.CompactTextString(m) }
+func (*DiffLeaderboardRequest) ProtoMessage()    {}
+func (*DiffLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{66}
+}
+
+func (m *DiffLeaderboardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffLeaderboardRequest.Unmarshal(m, b)
+}
+func (m *DiffLeaderboardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffLeaderboardRequest.Marshal(b, m, deterministic)
+}
+func (m *DiffLeaderboardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffLeaderboardRequest.Merge(m, src)
+}
+func (m *DiffLeaderboardRequest) XXX_Size() int {
+	return xxx_messageInfo_DiffLeaderboardRequest.Size(m)
+}
+func (m *DiffLeaderboardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffLeaderboardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffLeaderboardRequest proto.InternalMessageInfo
+
+func (m *DiffLeaderboardRequest) GetLeaderboardId() string {
+	if m != nil {
+		return m.LeaderboardId
+	}
+	return ""
+}
+
+func (m *DiffLeaderboardRequest) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *DiffLeaderboardRequest) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+func (m *DiffLeaderboardRequest) GetOrder() string {
+	if m != nil {
+		return m.Order
+	}
+	return ""
+}
+
+// MemberDiff represents how a member changed between two points in time of a leaderboard.
+type MemberDiff struct {
+	PublicID string `protobuf:"bytes,1,opt,name=publicID,proto3" json:"publicID,omitempty"`
+	// One of entered, left or changed.
+	Change string `protobuf:"bytes,2,opt,name=change,proto3" json:"change,omitempty"`
+	// Unset and zero for members that entered the leaderboard.
+	PreviousScore *wrappers.Int64Value `protobuf:"bytes,3,opt,name=previous_score,json=previousScore,proto3" json:"previous_score,omitempty"`
+	PreviousRank  int32                `protobuf:"varint,4,opt,name=previous_rank,json=previousRank,proto3" json:"previous_rank,omitempty"`
+	// Unset and zero for members that left the leaderboard.
+	Score *wrappers.Int64Value `protobuf:"bytes,5,opt,name=score,proto3" json:"score,omitempty"`
+	Rank  int32                `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`
+	// Only set for changed members, rank_delta is positive if the member climbed.
+	ScoreDelta           int64    `protobuf:"varint,7,opt,name=score_delta,json=scoreDelta,proto3" json:"score_delta,omitempty"`
+	RankDelta            int32    `protobuf:"varint,8,opt,name=rank_delta,json=rankDelta,proto3" json:"rank_delta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MemberDiff) Reset()         { *m = MemberDiff{} }
+func (m *MemberDiff) String() string { return proto.CompactTextString(m) }
+func (*MemberDiff) ProtoMessage()    {}
+func (*MemberDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{67}
+}
+
+func (m *MemberDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MemberDiff.Unmarshal(m, b)
+}
+func (m *MemberDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MemberDiff.Marshal(b, m, deterministic)
+}
+func (m *MemberDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemberDiff.Merge(m, src)
+}
+func (m *MemberDiff) XXX_Size() int {
+	return xxx_messageInfo_MemberDiff.Size(m)
+}
+func (m *MemberDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemberDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemberDiff proto.InternalMessageInfo
+
+func (m *MemberDiff) GetPublicID() string {
+	if m != nil {
+		return m.PublicID
+	}
+	return ""
+}
+
+func (m *MemberDiff) GetChange() string {
+	if m != nil {
+		return m.Change
+	}
+	return ""
+}
+
+func (m *MemberDiff) GetPreviousScore() *wrappers.Int64Value {
+	if m != nil {
+		return m.PreviousScore
+	}
+	return nil
+}
+
+func (m *MemberDiff) GetPreviousRank() int32 {
+	if m != nil {
+		return m.PreviousRank
+	}
+	return 0
+}
+
+func (m *MemberDiff) GetScore() *wrappers.Int64Value {
+	if m != nil {
+		return m.Score
+	}
+	return nil
+}
+
+func (m *MemberDiff) GetRank() int32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *MemberDiff) GetScoreDelta() int64 {
+	if m != nil {
+		return m.ScoreDelta
+	}
+	return 0
+}
+
+func (m *MemberDiff) GetRankDelta() int32 {
+	if m != nil {
+		return m.RankDelta
+	}
+	return 0
+}
+
+type WatchTopMembersRequest struct {
+	LeaderboardId string `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	Order         string `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
+	// Number of top members to watch, it defaults to 20.
+	PageSize             int32    `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchTopMembersRequest) Reset()         { *m = WatchTopMembersRequest{} }
+func (m *WatchTopMembersRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchTopMembersRequest) ProtoMessage()    {}
+func (*WatchTopMembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{68}
+}
+
+func (m *WatchTopMembersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchTopMembersRequest.Unmarshal(m, b)
+}
+func (m *WatchTopMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchTopMembersRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchTopMembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchTopMembersRequest.Merge(m, src)
+}
+func (m *WatchTopMembersRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchTopMembersRequest.Size(m)
+}
+func (m *WatchTopMembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchTopMembersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchTopMembersRequest proto.InternalMessageInfo
+
+func (m *WatchTopMembersRequest) GetLeaderboardId() string {
+	if m != nil {
+		return m.LeaderboardId
+	}
+	return ""
+}
+
+func (m *WatchTopMembersRequest) GetOrder() string {
+	if m != nil {
+		return m.Order
+	}
+	return ""
+}
+
+func (m *WatchTopMembersRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+type ImportScoresRequest struct {
+	// The leaderboard identification, only read from the first chunk.
+	LeaderboardId string `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	// If set to true, scores are written to a temporary leaderboard that replaces the leaderboard after the last
+	// chunk if no score failed, otherwise they are discarded. Only read from the first chunk.
+	Replace bool `protobuf:"varint,2,opt,name=replace,proto3" json:"replace,omitempty"`
+	// The scores of this chunk.
+	Members              []*BulkUpsertScoresRequest_MemberScore `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_unrecognized     []byte                                 `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
+}
+
+func (m *ImportScoresRequest) Reset()         { *m = ImportScoresRequest{} }
+func (m *ImportScoresRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportScoresRequest) ProtoMessage()    {}
+func (*ImportScoresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{69}
+}
+
+func (m *ImportScoresRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportScoresRequest.Unmarshal(m, b)
+}
+func (m *ImportScoresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportScoresRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportScoresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportScoresRequest.Merge(m, src)
+}
+func (m *ImportScoresRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportScoresRequest.Size(m)
+}
+func (m *ImportScoresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportScoresRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportScoresRequest proto.InternalMessageInfo
+
+func (m *ImportScoresRequest) GetLeaderboardId() string {
+	if m != nil {
+		return m.LeaderboardId
+	}
+	return ""
+}
+
+func (m *ImportScoresRequest) GetReplace() bool {
+	if m != nil {
+		return m.Replace
+	}
+	return false
+}
+
+func (m *ImportScoresRequest) GetMembers() []*BulkUpsertScoresRequest_MemberScore {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+type ImportScoresResponse struct {
+	// False if any score failed to be imported.
+	Success  bool                          `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Chunks   []*ImportScoresResponse_Chunk `protobuf:"bytes,2,rep,name=chunks,proto3" json:"chunks,omitempty"`
+	Imported int64                         `protobuf:"varint,3,opt,name=imported,proto3" json:"imported,omitempty"`
+	Failed   int64                         `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	// True if the scores imported replaced the leaderboard.
+	Replaced             bool     `protobuf:"varint,5,opt,name=replaced,proto3" json:"replaced,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportScoresResponse) Reset()         { *m = ImportScoresResponse{} }
+func (m *ImportScoresResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportScoresResponse) ProtoMessage()    {}
+func (*ImportScoresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{70}
+}
+
+func (m *ImportScoresResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportScoresResponse.Unmarshal(m, b)
+}
+func (m *ImportScoresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportScoresResponse.Marshal(b, m, deterministic)
+}
+func (m *ImportScoresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportScoresResponse.Merge(m, src)
+}
+func (m *ImportScoresResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportScoresResponse.Size(m)
+}
+func (m *ImportScoresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportScoresResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportScoresResponse proto.InternalMessageInfo
+
+func (m *ImportScoresResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *ImportScoresResponse) GetChunks() []*ImportScoresResponse_Chunk {
+	if m != nil {
+		return m.Chunks
+	}
+	return nil
+}
+
+func (m *ImportScoresResponse) GetImported() int64 {
+	if m != nil {
+		return m.Imported
+	}
+	return 0
+}
+
+func (m *ImportScoresResponse) GetFailed() int64 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *ImportScoresResponse) GetReplaced() bool {
+	if m != nil {
+		return m.Replaced
+	}
+	return false
+}
+
+// Failure is a score of a chunk that could not be imported.
+type ImportScoresResponse_Failure struct {
+	PublicID             string   `protobuf:"bytes,1,opt,name=publicID,proto3" json:"publicID,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportScoresResponse_Failure) Reset()         { *m = ImportScoresResponse_Failure{} }
+func (m *ImportScoresResponse_Failure) String() string { return proto.CompactTextString(m) }
+func (*ImportScoresResponse_Failure) ProtoMessage()    {}
+func (*ImportScoresResponse_Failure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{70, 0}
+}
+
+func (m *ImportScoresResponse_Failure) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportScoresResponse_Failure.Unmarshal(m, b)
+}
+func (m *ImportScoresResponse_Failure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportScoresResponse_Failure.Marshal(b, m, deterministic)
+}
+func (m *ImportScoresResponse_Failure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportScoresResponse_Failure.Merge(m, src)
+}
+func (m *ImportScoresResponse_Failure) XXX_Size() int {
+	return xxx_messageInfo_ImportScoresResponse_Failure.Size(m)
+}
+func (m *ImportScoresResponse_Failure) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportScoresResponse_Failure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportScoresResponse_Failure proto.InternalMessageInfo
+
+func (m *ImportScoresResponse_Failure) GetPublicID() string {
+	if m != nil {
+		return m.PublicID
+	}
+	return ""
+}
+
+func (m *ImportScoresResponse_Failure) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// Chunk acknowledges a chunk, numbered from zero in the order they were sent.
+type ImportScoresResponse_Chunk struct {
+	Chunk                int32                           `protobuf:"varint,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Imported             int32                           `protobuf:"varint,2,opt,name=imported,proto3" json:"imported,omitempty"`
+	Failures             []*ImportScoresResponse_Failure `protobuf:"bytes,3,rep,name=failures,proto3" json:"failures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *ImportScoresResponse_Chunk) Reset()         { *m = ImportScoresResponse_Chunk{} }
+func (m *ImportScoresResponse_Chunk) String() string { return proto.CompactTextString(m) }
+func (*ImportScoresResponse_Chunk) ProtoMessage()    {}
+func (*ImportScoresResponse_Chunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{70, 1}
+}
+
+func (m *ImportScoresResponse_Chunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportScoresResponse_Chunk.Unmarshal(m, b)
+}
+func (m *ImportScoresResponse_Chunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportScoresResponse_Chunk.Marshal(b, m, deterministic)
+}
+func (m *ImportScoresResponse_Chunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportScoresResponse_Chunk.Merge(m, src)
+}
+func (m *ImportScoresResponse_Chunk) XXX_Size() int {
+	return xxx_messageInfo_ImportScoresResponse_Chunk.Size(m)
+}
+func (m *ImportScoresResponse_Chunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportScoresResponse_Chunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportScoresResponse_Chunk proto.InternalMessageInfo
+
+func (m *ImportScoresResponse_Chunk) GetChunk() int32 {
+	if m != nil {
+		return m.Chunk
+	}
+	return 0
+}
+
+func (m *ImportScoresResponse_Chunk) GetImported() int32 {
+	if m != nil {
+		return m.Imported
+	}
+	return 0
+}
+
+func (m *ImportScoresResponse_Chunk) GetFailures() []*ImportScoresResponse_Failure {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
+type ExportLeaderboardRequest struct {
+	LeaderboardId string `protobuf:"bytes,1,opt,name=leaderboard_id,json=leaderboardId,proto3" json:"leaderboard_id,omitempty"`
+	Order         string `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
+	// Number of members of each chunk, it defaults to 1000.
+	ChunkSize            int32    `protobuf:"varint,3,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportLeaderboardRequest) Reset()         { *m = ExportLeaderboardRequest{} }
+func (m *ExportLeaderboardRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportLeaderboardRequest) ProtoMessage()    {}
+func (*ExportLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{71}
+}
+
+func (m *ExportLeaderboardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportLeaderboardRequest.Unmarshal(m, b)
+}
+func (m *ExportLeaderboardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportLeaderboardRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportLeaderboardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportLeaderboardRequest.Merge(m, src)
+}
+func (m *ExportLeaderboardRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportLeaderboardRequest.Size(m)
+}
+func (m *ExportLeaderboardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportLeaderboardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportLeaderboardRequest proto.InternalMessageInfo
+
+func (m *ExportLeaderboardRequest) GetLeaderboardId() string {
+	if m != nil {
+		return m.LeaderboardId
+	}
+	return ""
+}
+
+func (m *ExportLeaderboardRequest) GetOrder() string {
+	if m != nil {
+		return m.Order
+	}
+	return ""
+}
+
+func (m *ExportLeaderboardRequest) GetChunkSize() int32 {
+	if m != nil {
+		return m.ChunkSize
+	}
+	return 0
+}
+
+type ExportLeaderboardResponse struct {
+	Members              []*ExportLeaderboardResponse_Member `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *ExportLeaderboardResponse) Reset()         { *m = ExportLeaderboardResponse{} }
+func (m *ExportLeaderboardResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportLeaderboardResponse) ProtoMessage()    {}
+func (*ExportLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{72}
+}
+
+func (m *ExportLeaderboardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportLeaderboardResponse.Unmarshal(m, b)
+}
+func (m *ExportLeaderboardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportLeaderboardResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportLeaderboardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportLeaderboardResponse.Merge(m, src)
+}
+func (m *ExportLeaderboardResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportLeaderboardResponse.Size(m)
+}
+func (m *ExportLeaderboardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportLeaderboardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportLeaderboardResponse proto.InternalMessageInfo
+
+func (m *ExportLeaderboardResponse) GetMembers() []*ExportLeaderboardResponse_Member {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+type ExportLeaderboardResponse_Member struct {
+	PublicID string  `protobuf:"bytes,1,opt,name=publicID,proto3" json:"publicID,omitempty"`
+	Score    float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Rank     int32   `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	// Unix timestamp in which the score of the member expires, zero if it does not.
+	ExpireAt             int32    `protobuf:"varint,4,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportLeaderboardResponse_Member) Reset()         { *m = ExportLeaderboardResponse_Member{} }
+func (m *ExportLeaderboardResponse_Member) String() string { return proto.CompactTextString(m) }
+func (*ExportLeaderboardResponse_Member) ProtoMessage()    {}
+func (*ExportLeaderboardResponse_Member) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{72, 0}
+}
+
+func (m *ExportLeaderboardResponse_Member) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportLeaderboardResponse_Member.Unmarshal(m, b)
+}
+func (m *ExportLeaderboardResponse_Member) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportLeaderboardResponse_Member.Marshal(b, m, deterministic)
+}
+func (m *ExportLeaderboardResponse_Member) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportLeaderboardResponse_Member.Merge(m, src)
+}
+func (m *ExportLeaderboardResponse_Member) XXX_Size() int {
+	return xxx_messageInfo_ExportLeaderboardResponse_Member.Size(m)
+}
+func (m *ExportLeaderboardResponse_Member) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportLeaderboardResponse_Member.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportLeaderboardResponse_Member proto.InternalMessageInfo
+
+func (m *ExportLeaderboardResponse_Member) GetPublicID() string {
+	if m != nil {
+		return m.PublicID
+	}
+	return ""
+}
+
+func (m *ExportLeaderboardResponse_Member) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *ExportLeaderboardResponse_Member) GetRank() int32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *ExportLeaderboardResponse_Member) GetExpireAt() int32 {
+	if m != nil {
+		return m.ExpireAt
+	}
+	return 0
+}
+
+type CreateLeagueRequest struct {
+	// The league identification.
+	LeagueId             string                      `protobuf:"bytes,1,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
+	League               *CreateLeagueRequest_League `protobuf:"bytes,2,opt,name=league,proto3" json:"league,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *CreateLeagueRequest) Reset()         { *m = CreateLeagueRequest{} }
+func (m *CreateLeagueRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateLeagueRequest) ProtoMessage()    {}
+func (*CreateLeagueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{73}
+}
+
+func (m *CreateLeagueRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateLeagueRequest.Unmarshal(m, b)
+}
+func (m *CreateLeagueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateLeagueRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateLeagueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateLeagueRequest.Merge(m, src)
+}
+func (m *CreateLeagueRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateLeagueRequest.Size(m)
+}
+func (m *CreateLeagueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateLeagueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateLeagueRequest proto.InternalMessageInfo
+
+func (m *CreateLeagueRequest) GetLeagueId() string {
+	if m != nil {
+		return m.LeagueId
+	}
+	return ""
+}
+
+func (m *CreateLeagueRequest) GetLeague() *CreateLeagueRequest_League {
+	if m != nil {
+		return m.League
+	}
+	return nil
+}
+
+// League is the payload with the league configuration.
+type CreateLeagueRequest_League struct {
+	// Number of tiers, tier 1 being the highest.
+	Tiers int32 `protobuf:"varint,1,opt,name=tiers,proto3" json:"tiers,omitempty"`
+	// Maximum number of members in a division.
+	DivisionSize int32 `protobuf:"varint,2,opt,name=division_size,json=divisionSize,proto3" json:"division_size,omitempty"`
+	// Number of first members of each division promoted at season end.
+	PromotionCount int32 `protobuf:"varint,3,opt,name=promotion_count,json=promotionCount,proto3" json:"promotion_count,omitempty"`
+	// Number of last members of each division relegated at season end.
+	RelegationCount int32 `protobuf:"varint,4,opt,name=relegation_count,json=relegationCount,proto3" json:"relegation_count,omitempty"`
+	// Order used to rank division members, asc or desc.
+	Order                string   `protobuf:"bytes,5,opt,name=order,proto3" json:"order,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateLeagueRequest_League) Reset()         { *m = CreateLeagueRequest_League{} }
+func (m *CreateLeagueRequest_League) String() string { return proto.CompactTextString(m) }
+func (*CreateLeagueRequest_League) ProtoMessage()    {}
+func (*CreateLeagueRequest_League) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{73, 0}
+}
+
+func (m *CreateLeagueRequest_League) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateLeagueRequest_League.Unmarshal(m, b)
+}
+func (m *CreateLeagueRequest_League) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateLeagueRequest_League.Marshal(b, m, deterministic)
+}
+func (m *CreateLeagueRequest_League) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateLeagueRequest_League.Merge(m, src)
+}
+func (m *CreateLeagueRequest_League) XXX_Size() int {
+	return xxx_messageInfo_CreateLeagueRequest_League.Size(m)
+}
+func (m *CreateLeagueRequest_League) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateLeagueRequest_League.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateLeagueRequest_League proto.InternalMessageInfo
+
+func (m *CreateLeagueRequest_League) GetTiers() int32 {
+	if m != nil {
+		return m.Tiers
+	}
+	return 0
+}
+
+func (m *CreateLeagueRequest_League) GetDivisionSize() int32 {
+	if m != nil {
+		return m.DivisionSize
+	}
+	return 0
+}
+
+func (m *CreateLeagueRequest_League) GetPromotionCount() int32 {
+	if m != nil {
+		return m.PromotionCount
+	}
+	return 0
+}
+
+func (m *CreateLeagueRequest_League) GetRelegationCount() int32 {
+	if m != nil {
+		return m.RelegationCount
+	}
+	return 0
+}
+
+func (m *CreateLeagueRequest_League) GetOrder() string {
+	if m != nil {
+		return m.Order
+	}
+	return ""
+}
+
+type GetLeagueRequest struct {
+	LeagueId             string   `protobuf:"bytes,1,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLeagueRequest) Reset()         { *m = GetLeagueRequest{} }
+func (m *GetLeagueRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLeagueRequest) ProtoMessage()    {}
+func (*GetLeagueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{74}
+}
+
+func (m *GetLeagueRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLeagueRequest.Unmarshal(m, b)
+}
+func (m *GetLeagueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLeagueRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLeagueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLeagueRequest.Merge(m, src)
+}
+func (m *GetLeagueRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLeagueRequest.Size(m)
+}
+func (m *GetLeagueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLeagueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLeagueRequest proto.InternalMessageInfo
+
+func (m *GetLeagueRequest) GetLeagueId() string {
+	if m != nil {
+		return m.LeagueId
+	}
+	return ""
+}
+
+// League represents the configuration of a leagues system.
+type League struct {
+	LeagueID             string   `protobuf:"bytes,1,opt,name=leagueID,proto3" json:"leagueID,omitempty"`
+	Season               int32    `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"`
+	Tiers                int32    `protobuf:"varint,3,opt,name=tiers,proto3" json:"tiers,omitempty"`
+	DivisionSize         int32    `protobuf:"varint,4,opt,name=division_size,json=divisionSize,proto3" json:"division_size,omitempty"`
+	PromotionCount       int32    `protobuf:"varint,5,opt,name=promotion_count,json=promotionCount,proto3" json:"promotion_count,omitempty"`
+	RelegationCount      int32    `protobuf:"varint,6,opt,name=relegation_count,json=relegationCount,proto3" json:"relegation_count,omitempty"`
+	Order                string   `protobuf:"bytes,7,opt,name=order,proto3" json:"order,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *League) Reset()         { *m = League{} }
+func (m *League) String() string { return proto.CompactTextString(m) }
+func (*League) ProtoMessage()    {}
+func (*League) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{75}
+}
+
+func (m *League) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_League.Unmarshal(m, b)
+}
+func (m *League) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_League.Marshal(b, m, deterministic)
+}
+func (m *League) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_League.Merge(m, src)
+}
+func (m *League) XXX_Size() int {
+	return xxx_messageInfo_League.Size(m)
+}
+func (m *League) XXX_DiscardUnknown() {
+	xxx_messageInfo_League.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_League proto.InternalMessageInfo
+
+func (m *League) GetLeagueID() string {
+	if m != nil {
+		return m.LeagueID
+	}
+	return ""
+}
+
+func (m *League) GetSeason() int32 {
+	if m != nil {
+		return m.Season
+	}
+	return 0
+}
+
+func (m *League) GetTiers() int32 {
+	if m != nil {
+		return m.Tiers
+	}
+	return 0
+}
+
+func (m *League) GetDivisionSize() int32 {
+	if m != nil {
+		return m.DivisionSize
+	}
+	return 0
+}
+
+func (m *League) GetPromotionCount() int32 {
+	if m != nil {
+		return m.PromotionCount
+	}
+	return 0
+}
+
+func (m *League) GetRelegationCount() int32 {
+	if m != nil {
+		return m.RelegationCount
+	}
+	return 0
+}
+
+func (m *League) GetOrder() string {
+	if m != nil {
+		return m.Order
+	}
+	return ""
+}
+
+type LeagueResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	League               *League  `protobuf:"bytes,2,opt,name=league,proto3" json:"league,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeagueResponse) Reset()         { *m = LeagueResponse{} }
+func (m *LeagueResponse) String() string { return proto.CompactTextString(m) }
+func (*LeagueResponse) ProtoMessage()    {}
+func (*LeagueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{76}
+}
+
+func (m *LeagueResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeagueResponse.Unmarshal(m, b)
+}
+func (m *LeagueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeagueResponse.Marshal(b, m, deterministic)
+}
+func (m *LeagueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeagueResponse.Merge(m, src)
+}
+func (m *LeagueResponse) XXX_Size() int {
+	return xxx_messageInfo_LeagueResponse.Size(m)
+}
+func (m *LeagueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeagueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeagueResponse proto.InternalMessageInfo
+
+func (m *LeagueResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *LeagueResponse) GetLeague() *League {
+	if m != nil {
+		return m.League
+	}
+	return nil
+}
+
+type JoinLeagueRequest struct {
+	// The league identification.
+	LeagueId string `protobuf:"bytes,1,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
+	// The member identification.
+	MemberPublicId string `protobuf:"bytes,2,opt,name=member_public_id,json=memberPublicId,proto3" json:"member_public_id,omitempty"`
+	// Tier the member joins, defaults to the lowest tier.
+	Tier                 int32    `protobuf:"varint,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JoinLeagueRequest) Reset()         { *m = JoinLeagueRequest{} }
+func (m *JoinLeagueRequest) String() string { return proto.CompactTextString(m) }
+func (*JoinLeagueRequest) ProtoMessage()    {}
+func (*JoinLeagueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{77}
+}
+
+func (m *JoinLeagueRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JoinLeagueRequest.Unmarshal(m, b)
+}
+func (m *JoinLeagueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JoinLeagueRequest.Marshal(b, m, deterministic)
+}
+func (m *JoinLeagueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinLeagueRequest.Merge(m, src)
+}
+func (m *JoinLeagueRequest) XXX_Size() int {
+	return xxx_messageInfo_JoinLeagueRequest.Size(m)
+}
+func (m *JoinLeagueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinLeagueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinLeagueRequest proto.InternalMessageInfo
+
+func (m *JoinLeagueRequest) GetLeagueId() string {
+	if m != nil {
+		return m.LeagueId
+	}
+	return ""
+}
+
+func (m *JoinLeagueRequest) GetMemberPublicId() string {
+	if m != nil {
+		return m.MemberPublicId
+	}
+	return ""
+}
+
+func (m *JoinLeagueRequest) GetTier() int32 {
+	if m != nil {
+		return m.Tier
+	}
+	return 0
+}
+
+type GetLeagueDivisionRequest struct {
+	LeagueId             string   `protobuf:"bytes,1,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
+	MemberPublicId       string   `protobuf:"bytes,2,opt,name=member_public_id,json=memberPublicId,proto3" json:"member_public_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLeagueDivisionRequest) Reset()         { *m = GetLeagueDivisionRequest{} }
+func (m *GetLeagueDivisionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLeagueDivisionRequest) ProtoMessage()    {}
+func (*GetLeagueDivisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{78}
+}
+
+func (m *GetLeagueDivisionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLeagueDivisionRequest.Unmarshal(m, b)
+}
+func (m *GetLeagueDivisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLeagueDivisionRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLeagueDivisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLeagueDivisionRequest.Merge(m, src)
+}
+func (m *GetLeagueDivisionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLeagueDivisionRequest.Size(m)
+}
+func (m *GetLeagueDivisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLeagueDivisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLeagueDivisionRequest proto.InternalMessageInfo
+
+func (m *GetLeagueDivisionRequest) GetLeagueId() string {
+	if m != nil {
+		return m.LeagueId
+	}
+	return ""
+}
+
+func (m *GetLeagueDivisionRequest) GetMemberPublicId() string {
+	if m != nil {
+		return m.MemberPublicId
+	}
+	return ""
+}
+
+// LeagueDivision represents a division of a league season.
+type LeagueDivision struct {
+	LeagueID string `protobuf:"bytes,1,opt,name=leagueID,proto3" json:"leagueID,omitempty"`
+	Season   int32  `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"`
+	Tier     int32  `protobuf:"varint,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	Division int32  `protobuf:"varint,4,opt,name=division,proto3" json:"division,omitempty"`
+	// Leaderboard holding the division scores.
+	LeaderboardID string `protobuf:"bytes,5,opt,name=leaderboardID,proto3" json:"leaderboardID,omitempty"`
+	// Division standings, only filled when retrieving a member division.
+	Members              []*Member `protobuf:"bytes,6,rep,name=members,proto3" json:"members,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *LeagueDivision) Reset()         { *m = LeagueDivision{} }
+func (m *LeagueDivision) String() string { return proto.CompactTextString(m) }
+func (*LeagueDivision) ProtoMessage()    {}
+func (*LeagueDivision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{79}
+}
+
+func (m *LeagueDivision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeagueDivision.Unmarshal(m, b)
+}
+func (m *LeagueDivision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeagueDivision.Marshal(b, m, deterministic)
+}
+func (m *LeagueDivision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeagueDivision.Merge(m, src)
+}
+func (m *LeagueDivision) XXX_Size() int {
+	return xxx_messageInfo_LeagueDivision.Size(m)
+}
+func (m *LeagueDivision) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeagueDivision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeagueDivision proto.InternalMessageInfo
+
+func (m *LeagueDivision) GetLeagueID() string {
+	if m != nil {
+		return m.LeagueID
+	}
+	return ""
+}
+
+func (m *LeagueDivision) GetSeason() int32 {
+	if m != nil {
+		return m.Season
+	}
+	return 0
+}
+
+func (m *LeagueDivision) GetTier() int32 {
+	if m != nil {
+		return m.Tier
+	}
+	return 0
+}
+
+func (m *LeagueDivision) GetDivision() int32 {
+	if m != nil {
+		return m.Division
+	}
+	return 0
+}
+
+func (m *LeagueDivision) GetLeaderboardID() string {
+	if m != nil {
+		return m.LeaderboardID
+	}
+	return ""
+}
+
+func (m *LeagueDivision) GetMembers() []*Member {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+type LeagueDivisionResponse struct {
+	Success              bool            `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Division             *LeagueDivision `protobuf:"bytes,2,opt,name=division,proto3" json:"division,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *LeagueDivisionResponse) Reset()         { *m = LeagueDivisionResponse{} }
+func (m *LeagueDivisionResponse) String() string { return proto.CompactTextString(m) }
+func (*LeagueDivisionResponse) ProtoMessage()    {}
+func (*LeagueDivisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{80}
+}
+
+func (m *LeagueDivisionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeagueDivisionResponse.Unmarshal(m, b)
+}
+func (m *LeagueDivisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeagueDivisionResponse.Marshal(b, m, deterministic)
+}
+func (m *LeagueDivisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeagueDivisionResponse.Merge(m, src)
+}
+func (m *LeagueDivisionResponse) XXX_Size() int {
+	return xxx_messageInfo_LeagueDivisionResponse.Size(m)
+}
+func (m *LeagueDivisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeagueDivisionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeagueDivisionResponse proto.InternalMessageInfo
+
+func (m *LeagueDivisionResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *LeagueDivisionResponse) GetDivision() *LeagueDivision {
+	if m != nil {
+		return m.Division
+	}
+	return nil
+}
+
+type EndLeagueSeasonRequest struct {
+	LeagueId string `protobuf:"bytes,1,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
+	// Season to end, the current season if not set. Ending a season that already ended
+	// returns the result of when it ended, so retrying a request does not end two seasons.
+	Season               int32    `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EndLeagueSeasonRequest) Reset()         { *m = EndLeagueSeasonRequest{} }
+func (m *EndLeagueSeasonRequest) String() string { return proto.CompactTextString(m) }
+func (*EndLeagueSeasonRequest) ProtoMessage()    {}
+func (*EndLeagueSeasonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{81}
+}
+
+func (m *EndLeagueSeasonRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EndLeagueSeasonRequest.Unmarshal(m, b)
+}
+func (m *EndLeagueSeasonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EndLeagueSeasonRequest.Marshal(b, m, deterministic)
+}
+func (m *EndLeagueSeasonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndLeagueSeasonRequest.Merge(m, src)
+}
+func (m *EndLeagueSeasonRequest) XXX_Size() int {
+	return xxx_messageInfo_EndLeagueSeasonRequest.Size(m)
+}
+func (m *EndLeagueSeasonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndLeagueSeasonRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndLeagueSeasonRequest proto.InternalMessageInfo
+
+func (m *EndLeagueSeasonRequest) GetLeagueId() string {
+	if m != nil {
+		return m.LeagueId
+	}
+	return ""
+}
+
+func (m *EndLeagueSeasonRequest) GetSeason() int32 {
+	if m != nil {
+		return m.Season
+	}
+	return 0
+}
+
+type EndLeagueSeasonResponse struct {
+	Success  bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	LeagueID string `protobuf:"bytes,2,opt,name=leagueID,proto3" json:"leagueID,omitempty"`
+	// The season started.
+	Season               int32    `protobuf:"varint,3,opt,name=season,proto3" json:"season,omitempty"`
+	Promoted             int32    `protobuf:"varint,4,opt,name=promoted,proto3" json:"promoted,omitempty"`
+	Relegated            int32    `protobuf:"varint,5,opt,name=relegated,proto3" json:"relegated,omitempty"`
+	Stayed               int32    `protobuf:"varint,6,opt,name=stayed,proto3" json:"stayed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EndLeagueSeasonResponse) Reset()         { *m = EndLeagueSeasonResponse{} }
+func (m *EndLeagueSeasonResponse) String() string { return proto.CompactTextString(m) }
+func (*EndLeagueSeasonResponse) ProtoMessage()    {}
+func (*EndLeagueSeasonResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{82}
+}
+
+func (m *EndLeagueSeasonResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EndLeagueSeasonResponse.Unmarshal(m, b)
+}
+func (m *EndLeagueSeasonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EndLeagueSeasonResponse.Marshal(b, m, deterministic)
+}
+func (m *EndLeagueSeasonResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndLeagueSeasonResponse.Merge(m, src)
+}
+func (m *EndLeagueSeasonResponse) XXX_Size() int {
+	return xxx_messageInfo_EndLeagueSeasonResponse.Size(m)
+}
+func (m *EndLeagueSeasonResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndLeagueSeasonResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndLeagueSeasonResponse proto.InternalMessageInfo
+
+func (m *EndLeagueSeasonResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *EndLeagueSeasonResponse) GetLeagueID() string {
+	if m != nil {
+		return m.LeagueID
+	}
+	return ""
+}
+
+func (m *EndLeagueSeasonResponse) GetSeason() int32 {
+	if m != nil {
+		return m.Season
+	}
+	return 0
+}
+
+func (m *EndLeagueSeasonResponse) GetPromoted() int32 {
+	if m != nil {
+		return m.Promoted
+	}
+	return 0
+}
+
+func (m *EndLeagueSeasonResponse) GetRelegated() int32 {
+	if m != nil {
+		return m.Relegated
+	}
+	return 0
+}
+
+func (m *EndLeagueSeasonResponse) GetStayed() int32 {
+	if m != nil {
+		return m.Stayed
+	}
+	return 0
+}
+
+// TournamentPrize is a reward given to members finishing between from_rank and to_rank, both inclusive.
+type TournamentPrize struct {
+	FromRank             int32    `protobuf:"varint,1,opt,name=from_rank,json=fromRank,proto3" json:"from_rank,omitempty"`
+	ToRank               int32    `protobuf:"varint,2,opt,name=to_rank,json=toRank,proto3" json:"to_rank,omitempty"`
+	RewardID             string   `protobuf:"bytes,3,opt,name=rewardID,proto3" json:"rewardID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TournamentPrize) Reset()         { *m = TournamentPrize{} }
+func (m *TournamentPrize) String() string { return proto.CompactTextString(m) }
+func (*TournamentPrize) ProtoMessage()    {}
+func (*TournamentPrize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{83}
+}
+
+func (m *TournamentPrize) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TournamentPrize.Unmarshal(m, b)
+}
+func (m *TournamentPrize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TournamentPrize.Marshal(b, m, deterministic)
+}
+func (m *TournamentPrize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TournamentPrize.Merge(m, src)
+}
+func (m *TournamentPrize) XXX_Size() int {
+	return xxx_messageInfo_TournamentPrize.Size(m)
+}
+func (m *TournamentPrize) XXX_DiscardUnknown() {
+	xxx_messageInfo_TournamentPrize.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TournamentPrize proto.InternalMessageInfo
+
+func (m *TournamentPrize) GetFromRank() int32 {
+	if m != nil {
+		return m.FromRank
+	}
+	return 0
+}
+
+func (m *TournamentPrize) GetToRank() int32 {
+	if m != nil {
+		return m.ToRank
+	}
+	return 0
+}
+
+func (m *TournamentPrize) GetRewardID() string {
+	if m != nil {
+		return m.RewardID
+	}
+	return ""
+}
+
+type CreateTournamentRequest struct {
+	// The tournament identification, also used as the leaderboard identification.
+	TournamentId         string                              `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Tournament           *CreateTournamentRequest_Tournament `protobuf:"bytes,2,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *CreateTournamentRequest) Reset()         { *m = CreateTournamentRequest{} }
+func (m *CreateTournamentRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateTournamentRequest) ProtoMessage()    {}
+func (*CreateTournamentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{84}
+}
+
+func (m *CreateTournamentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTournamentRequest.Unmarshal(m, b)
+}
+func (m *CreateTournamentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTournamentRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateTournamentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTournamentRequest.Merge(m, src)
+}
+func (m *CreateTournamentRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateTournamentRequest.Size(m)
+}
+func (m *CreateTournamentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTournamentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTournamentRequest proto.InternalMessageInfo
+
+func (m *CreateTournamentRequest) GetTournamentId() string {
+	if m != nil {
+		return m.TournamentId
+	}
+	return ""
+}
+
+func (m *CreateTournamentRequest) GetTournament() *CreateTournamentRequest_Tournament {
+	if m != nil {
+		return m.Tournament
+	}
+	return nil
+}
+
+// Tournament is the payload with the tournament configuration.
+type CreateTournamentRequest_Tournament struct {
+	// Unix timestamp from which scores are accepted.
+	StartAt int64 `protobuf:"varint,1,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	// Unix timestamp from which scores are rejected.
+	EndAt int64 `protobuf:"varint,2,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	// Order used to rank members, asc or desc.
+	Order string `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
+	// Rewards given to members by rank when the tournament is finalized.
+	Prizes               []*TournamentPrize `protobuf:"bytes,4,rep,name=prizes,proto3" json:"prizes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CreateTournamentRequest_Tournament) Reset()         { *m = CreateTournamentRequest_Tournament{} }
+func (m *CreateTournamentRequest_Tournament) String() string { return proto.CompactTextString(m) }
+func (*CreateTournamentRequest_Tournament) ProtoMessage()    {}
+func (*CreateTournamentRequest_Tournament) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{84, 0}
+}
+
+func (m *CreateTournamentRequest_Tournament) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTournamentRequest_Tournament.Unmarshal(m, b)
+}
+func (m *CreateTournamentRequest_Tournament) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTournamentRequest_Tournament.Marshal(b, m, deterministic)
+}
+func (m *CreateTournamentRequest_Tournament) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTournamentRequest_Tournament.Merge(m, src)
+}
+func (m *CreateTournamentRequest_Tournament) XXX_Size() int {
+	return xxx_messageInfo_CreateTournamentRequest_Tournament.Size(m)
+}
+func (m *CreateTournamentRequest_Tournament) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTournamentRequest_Tournament.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTournamentRequest_Tournament proto.InternalMessageInfo
+
+func (m *CreateTournamentRequest_Tournament) GetStartAt() int64 {
+	if m != nil {
+		return m.StartAt
+	}
+	return 0
+}
+
+func (m *CreateTournamentRequest_Tournament) GetEndAt() int64 {
+	if m != nil {
+		return m.EndAt
+	}
+	return 0
+}
+
+func (m *CreateTournamentRequest_Tournament) GetOrder() string {
+	if m != nil {
+		return m.Order
+	}
+	return ""
+}
+
+func (m *CreateTournamentRequest_Tournament) GetPrizes() []*TournamentPrize {
+	if m != nil {
+		return m.Prizes
+	}
+	return nil
+}
+
+type GetTournamentRequest struct {
+	TournamentId         string   `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTournamentRequest) Reset()         { *m = GetTournamentRequest{} }
+func (m *GetTournamentRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTournamentRequest) ProtoMessage()    {}
+func (*GetTournamentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{85}
+}
+
+func (m *GetTournamentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTournamentRequest.Unmarshal(m, b)
+}
+func (m *GetTournamentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTournamentRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTournamentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTournamentRequest.Merge(m, src)
+}
+func (m *GetTournamentRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTournamentRequest.Size(m)
+}
+func (m *GetTournamentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTournamentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTournamentRequest proto.InternalMessageInfo
+
+func (m *GetTournamentRequest) GetTournamentId() string {
+	if m != nil {
+		return m.TournamentId
+	}
+	return ""
+}
+
+type JoinTournamentRequest struct {
+	TournamentId         string   `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	MemberPublicId       string   `protobuf:"bytes,2,opt,name=member_public_id,json=memberPublicId,proto3" json:"member_public_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JoinTournamentRequest) Reset()         { *m = JoinTournamentRequest{} }
+func (m *JoinTournamentRequest) String() string { return proto.CompactTextString(m) }
+func (*JoinTournamentRequest) ProtoMessage()    {}
+func (*JoinTournamentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{86}
+}
+
+func (m *JoinTournamentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JoinTournamentRequest.Unmarshal(m, b)
+}
+func (m *JoinTournamentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JoinTournamentRequest.Marshal(b, m, deterministic)
+}
+func (m *JoinTournamentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinTournamentRequest.Merge(m, src)
+}
+func (m *JoinTournamentRequest) XXX_Size() int {
+	return xxx_messageInfo_JoinTournamentRequest.Size(m)
+}
+func (m *JoinTournamentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinTournamentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinTournamentRequest proto.InternalMessageInfo
+
+func (m *JoinTournamentRequest) GetTournamentId() string {
+	if m != nil {
+		return m.TournamentId
+	}
+	return ""
+}
+
+func (m *JoinTournamentRequest) GetMemberPublicId() string {
+	if m != nil {
+		return m.MemberPublicId
+	}
+	return ""
+}
+
+type FinalizeTournamentRequest struct {
+	TournamentId         string   `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FinalizeTournamentRequest) Reset()         { *m = FinalizeTournamentRequest{} }
+func (m *FinalizeTournamentRequest) String() string { return proto.CompactTextString(m) }
+func (*FinalizeTournamentRequest) ProtoMessage()    {}
+func (*FinalizeTournamentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{87}
+}
+
+func (m *FinalizeTournamentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalizeTournamentRequest.Unmarshal(m, b)
+}
+func (m *FinalizeTournamentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalizeTournamentRequest.Marshal(b, m, deterministic)
+}
+func (m *FinalizeTournamentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizeTournamentRequest.Merge(m, src)
+}
+func (m *FinalizeTournamentRequest) XXX_Size() int {
+	return xxx_messageInfo_FinalizeTournamentRequest.Size(m)
+}
+func (m *FinalizeTournamentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizeTournamentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizeTournamentRequest proto.InternalMessageInfo
+
+func (m *FinalizeTournamentRequest) GetTournamentId() string {
+	if m != nil {
+		return m.TournamentId
+	}
+	return ""
+}
+
+// Tournament represents the configuration and status of a tournament.
+type Tournament struct {
+	TournamentID string             `protobuf:"bytes,1,opt,name=tournamentID,proto3" json:"tournamentID,omitempty"`
+	StartAt      int64              `protobuf:"varint,2,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt        int64              `protobuf:"varint,3,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	Order        string             `protobuf:"bytes,4,opt,name=order,proto3" json:"order,omitempty"`
+	Prizes       []*TournamentPrize `protobuf:"bytes,5,rep,name=prizes,proto3" json:"prizes,omitempty"`
+	// One of scheduled, running, ended or finalized.
+	Status string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	// Unix timestamp of when the tournament was finalized, zero if it was not.
+	FinalizedAt          int64    `protobuf:"varint,7,opt,name=finalized_at,json=finalizedAt,proto3" json:"finalized_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Tournament) Reset()         { *m = Tournament{} }
+func (m *Tournament) String() string { return proto.CompactTextString(m) }
+func (*Tournament) ProtoMessage()    {}
+func (*Tournament) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{88}
+}
+
+func (m *Tournament) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Tournament.Unmarshal(m, b)
+}
+func (m *Tournament) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Tournament.Marshal(b, m, deterministic)
+}
+func (m *Tournament) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tournament.Merge(m, src)
+}
+func (m *Tournament) XXX_Size() int {
+	return xxx_messageInfo_Tournament.Size(m)
+}
+func (m *Tournament) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tournament.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tournament proto.InternalMessageInfo
+
+func (m *Tournament) GetTournamentID() string {
+	if m != nil {
+		return m.TournamentID
+	}
+	return ""
+}
+
+func (m *Tournament) GetStartAt() int64 {
+	if m != nil {
+		return m.StartAt
+	}
+	return 0
+}
+
+func (m *Tournament) GetEndAt() int64 {
+	if m != nil {
+		return m.EndAt
+	}
+	return 0
+}
+
+func (m *Tournament) GetOrder() string {
+	if m != nil {
+		return m.Order
+	}
+	return ""
+}
+
+func (m *Tournament) GetPrizes() []*TournamentPrize {
+	if m != nil {
+		return m.Prizes
+	}
+	return nil
+}
+
+func (m *Tournament) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Tournament) GetFinalizedAt() int64 {
+	if m != nil {
+		return m.FinalizedAt
+	}
+	return 0
+}
+
+type TournamentResponse struct {
+	Success              bool        `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Tournament           *Tournament `protobuf:"bytes,2,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *TournamentResponse) Reset()         { *m = TournamentResponse{} }
+func (m *TournamentResponse) String() string { return proto.CompactTextString(m) }
+func (*TournamentResponse) ProtoMessage()    {}
+func (*TournamentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{89}
+}
+
+func (m *TournamentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TournamentResponse.Unmarshal(m, b)
+}
+func (m *TournamentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TournamentResponse.Marshal(b, m, deterministic)
+}
+func (m *TournamentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TournamentResponse.Merge(m, src)
+}
+func (m *TournamentResponse) XXX_Size() int {
+	return xxx_messageInfo_TournamentResponse.Size(m)
+}
+func (m *TournamentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TournamentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TournamentResponse proto.InternalMessageInfo
+
+func (m *TournamentResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *TournamentResponse) GetTournament() *Tournament {
+	if m != nil {
+		return m.Tournament
+	}
+	return nil
+}
+
+// TournamentWinner is a member that finished a tournament inside a prize rank range.
+type TournamentWinner struct {
+	PublicID             string   `protobuf:"bytes,1,opt,name=publicID,proto3" json:"publicID,omitempty"`
+	Score                float64  `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Rank                 int32    `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	RewardID             string   `protobuf:"bytes,4,opt,name=rewardID,proto3" json:"rewardID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TournamentWinner) Reset()         { *m = TournamentWinner{} }
+func (m *TournamentWinner) String() string { return proto.CompactTextString(m) }
+func (*TournamentWinner) ProtoMessage()    {}
+func (*TournamentWinner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{90}
+}
+
+func (m *TournamentWinner) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TournamentWinner.Unmarshal(m, b)
+}
+func (m *TournamentWinner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TournamentWinner.Marshal(b, m, deterministic)
+}
+func (m *TournamentWinner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TournamentWinner.Merge(m, src)
+}
+func (m *TournamentWinner) XXX_Size() int {
+	return xxx_messageInfo_TournamentWinner.Size(m)
+}
+func (m *TournamentWinner) XXX_DiscardUnknown() {
+	xxx_messageInfo_TournamentWinner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TournamentWinner proto.InternalMessageInfo
+
+func (m *TournamentWinner) GetPublicID() string {
+	if m != nil {
+		return m.PublicID
+	}
+	return ""
+}
+
+func (m *TournamentWinner) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *TournamentWinner) GetRank() int32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *TournamentWinner) GetRewardID() string {
+	if m != nil {
+		return m.RewardID
+	}
+	return ""
+}
+
+type FinalizeTournamentResponse struct {
+	Success              bool                `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Tournament           *Tournament         `protobuf:"bytes,2,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	Winners              []*TournamentWinner `protobuf:"bytes,3,rep,name=winners,proto3" json:"winners,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *FinalizeTournamentResponse) Reset()         { *m = FinalizeTournamentResponse{} }
+func (m *FinalizeTournamentResponse) String() string { return proto.CompactTextString(m) }
+func (*FinalizeTournamentResponse) ProtoMessage()    {}
+func (*FinalizeTournamentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{91}
+}
+
+func (m *FinalizeTournamentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalizeTournamentResponse.Unmarshal(m, b)
+}
+func (m *FinalizeTournamentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalizeTournamentResponse.Marshal(b, m, deterministic)
+}
+func (m *FinalizeTournamentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizeTournamentResponse.Merge(m, src)
+}
+func (m *FinalizeTournamentResponse) XXX_Size() int {
+	return xxx_messageInfo_FinalizeTournamentResponse.Size(m)
+}
+func (m *FinalizeTournamentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizeTournamentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizeTournamentResponse proto.InternalMessageInfo
+
+func (m *FinalizeTournamentResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *FinalizeTournamentResponse) GetTournament() *Tournament {
+	if m != nil {
+		return m.Tournament
+	}
+	return nil
+}
+
+func (m *FinalizeTournamentResponse) GetWinners() []*TournamentWinner {
+	if m != nil {
+		return m.Winners
+	}
+	return nil
+}
+
+type GetWebhookDeliveriesRequest struct {
+	Page                 int32    `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize             int32    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetWebhookDeliveriesRequest) Reset()         { *m = GetWebhookDeliveriesRequest{} }
+func (m *GetWebhookDeliveriesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetWebhookDeliveriesRequest) ProtoMessage()    {}
+func (*GetWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{92}
+}
+
+func (m *GetWebhookDeliveriesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetWebhookDeliveriesRequest.Unmarshal(m, b)
+}
+func (m *GetWebhookDeliveriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetWebhookDeliveriesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetWebhookDeliveriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWebhookDeliveriesRequest.Merge(m, src)
+}
+func (m *GetWebhookDeliveriesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetWebhookDeliveriesRequest.Size(m)
+}
+func (m *GetWebhookDeliveriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWebhookDeliveriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWebhookDeliveriesRequest proto.InternalMessageInfo
+
+func (m *GetWebhookDeliveriesRequest) GetPage() int32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *GetWebhookDeliveriesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+type GetWebhookDeliveriesResponse struct {
+	Success              bool                                            `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Deliveries           []*GetWebhookDeliveriesResponse_WebhookDelivery `protobuf:"bytes,2,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                        `json:"-"`
+	XXX_unrecognized     []byte                                          `json:"-"`
+	XXX_sizecache        int32                                           `json:"-"`
+}
+
+func (m *GetWebhookDeliveriesResponse) Reset()         { *m = GetWebhookDeliveriesResponse{} }
+func (m *GetWebhookDeliveriesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetWebhookDeliveriesResponse) ProtoMessage()    {}
+func (*GetWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{93}
+}
+
+func (m *GetWebhookDeliveriesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetWebhookDeliveriesResponse.Unmarshal(m, b)
+}
+func (m *GetWebhookDeliveriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetWebhookDeliveriesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetWebhookDeliveriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWebhookDeliveriesResponse.Merge(m, src)
+}
+func (m *GetWebhookDeliveriesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetWebhookDeliveriesResponse.Size(m)
+}
+func (m *GetWebhookDeliveriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWebhookDeliveriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWebhookDeliveriesResponse proto.InternalMessageInfo
+
+func (m *GetWebhookDeliveriesResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *GetWebhookDeliveriesResponse) GetDeliveries() []*GetWebhookDeliveriesResponse_WebhookDelivery {
+	if m != nil {
+		return m.Deliveries
+	}
+	return nil
+}
+
+// WebhookDelivery represents an attempt to deliver lifecycle events to the webhook.
+type GetWebhookDeliveriesResponse_WebhookDelivery struct {
+	// Identification of the delivery, shared by every attempt to deliver the same events.
+	Id       string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url      string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	EventIds []string `protobuf:"bytes,3,rep,name=event_ids,json=eventIds,proto3" json:"event_ids,omitempty"`
+	Attempt  int32    `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// Status the webhook answered with, zero if the request failed before.
+	StatusCode int32  `protobuf:"varint,5,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Error      string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// Whether the attempt was the last one and its events were moved to the dead-letter store.
+	DeadLettered bool `protobuf:"varint,7,opt,name=dead_lettered,json=deadLettered,proto3" json:"dead_lettered,omitempty"`
+	// Unix timestamp of when the attempt was made.
+	AttemptedAt          int64    `protobuf:"varint,8,opt,name=attempted_at,json=attemptedAt,proto3" json:"attempted_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetWebhookDeliveriesResponse_WebhookDelivery) Reset() {
+	*m = GetWebhookDeliveriesResponse_WebhookDelivery{}
+}
+func (m *GetWebhookDeliveriesResponse_WebhookDelivery) String() string {
+	return proto.CompactTextString(m)
+}
+func (*GetWebhookDeliveriesResponse_WebhookDelivery) ProtoMessage() {}
+func (*GetWebhookDeliveriesResponse_WebhookDelivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33144d47ebf9898, []int{93, 0}
+}
+
+func (m *GetWebhookDeliveriesResponse_WebhookDelivery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetWebhookDeliveriesResponse_WebhookDelivery.Unmarshal(m, b)
+}
+func (m *GetWebhookDeliveriesResponse_WebhookDelivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetWebhookDeliveriesResponse_WebhookDelivery.Marshal(b, m, deterministic)
+}
+func (m *GetWebhookDeliveriesResponse_WebhookDelivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWebhookDeliveriesResponse_WebhookDelivery.Merge(m, src)
+}
+func (m *GetWebhookDeliveriesResponse_WebhookDelivery) XXX_Size() int {
+	return xxx_messageInfo_GetWebhookDeliveriesResponse_WebhookDelivery.Size(m)
+}
+func (m *GetWebhookDeliveriesResponse_WebhookDelivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWebhookDeliveriesResponse_WebhookDelivery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWebhookDeliveriesResponse_WebhookDelivery proto.InternalMessageInfo
+
+func (m *GetWebhookDeliveriesResponse_WebhookDelivery) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *GetWebhookDeliveriesResponse_WebhookDelivery) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *GetWebhookDeliveriesResponse_WebhookDelivery) GetEventIds() []string {
+	if m != nil {
+		return m.EventIds
+	}
+	return nil
+}
+
+func (m *GetWebhookDeliveriesResponse_WebhookDelivery) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *GetWebhookDeliveriesResponse_WebhookDelivery) GetStatusCode() int32 {
+	if m != nil {
+		return m.StatusCode
+	}
+	return 0
+}
+
+func (m *GetWebhookDeliveriesResponse_WebhookDelivery) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *GetWebhookDeliveriesResponse_WebhookDelivery) GetDeadLettered() bool {
+	if m != nil {
+		return m.DeadLettered
+	}
+	return false
+}
+
+func (m *GetWebhookDeliveriesResponse_WebhookDelivery) GetAttemptedAt() int64 {
+	if m != nil {
+		return m.AttemptedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*HealthCheckRequest)(nil), "podium.api.v1.HealthCheckRequest")
+	proto.RegisterType((*HealthCheckResponse)(nil), "podium.api.v1.HealthCheckResponse")
+	proto.RegisterType((*StatusResponse)(nil), "podium.api.v1.StatusResponse")
+	proto.RegisterType((*RemoveLeaderboardRequest)(nil), "podium.api.v1.RemoveLeaderboardRequest")
+	proto.RegisterType((*BulkUpsertScoresRequest)(nil), "podium.api.v1.BulkUpsertScoresRequest")
+	proto.RegisterType((*BulkUpsertScoresRequest_MemberScore)(nil), "podium.api.v1.BulkUpsertScoresRequest.MemberScore")
+	proto.RegisterType((*BulkUpsertScoresRequest_MemberScores)(nil), "podium.api.v1.BulkUpsertScoresRequest.MemberScores")
+	proto.RegisterType((*Member)(nil), "podium.api.v1.Member")
+	proto.RegisterType((*UpsertScoreRequest)(nil), "podium.api.v1.UpsertScoreRequest")
+	proto.RegisterType((*UpsertScoreRequest_ScoreChange)(nil), "podium.api.v1.UpsertScoreRequest.ScoreChange")
+	proto.RegisterType((*TotalMembersRequest)(nil), "podium.api.v1.TotalMembersRequest")
+	proto.RegisterType((*TotalMembersResponse)(nil), "podium.api.v1.TotalMembersResponse")
+	proto.RegisterType((*IncrementScoreRequest)(nil), "podium.api.v1.IncrementScoreRequest")
+	proto.RegisterType((*IncrementScoreRequest_Body)(nil), "podium.api.v1.IncrementScoreRequest.Body")
+	proto.RegisterType((*GetMemberRequest)(nil), "podium.api.v1.GetMemberRequest")
+	proto.RegisterType((*UpsertScoreResponse)(nil), "podium.api.v1.UpsertScoreResponse")
+	proto.RegisterType((*IncrementScoreResponse)(nil), "podium.api.v1.IncrementScoreResponse")
+	proto.RegisterType((*GetMemberResponse)(nil), "podium.api.v1.GetMemberResponse")
+	proto.RegisterType((*GetMembersRequest)(nil), "podium.api.v1.GetMembersRequest")
+	proto.RegisterType((*GetMembersResponse)(nil), "podium.api.v1.GetMembersResponse")
+	proto.RegisterType((*GetMembersResponse_Member)(nil), "podium.api.v1.GetMembersResponse.Member")
+	proto.RegisterType((*RemoveMemberRequest)(nil), "podium.api.v1.RemoveMemberRequest")
+	proto.RegisterType((*RemoveMembersRequest)(nil), "podium.api.v1.RemoveMembersRequest")
+	proto.RegisterType((*RemoveLeaderboardResponse)(nil), "podium.api.v1.RemoveLeaderboardResponse")
+	proto.RegisterType((*RemoveMemberResponse)(nil), "podium.api.v1.RemoveMemberResponse")
+	proto.RegisterType((*RemoveMembersResponse)(nil), "podium.api.v1.RemoveMembersResponse")
+	proto.RegisterType((*GetRankRequest)(nil), "podium.api.v1.GetRankRequest")
+	proto.RegisterType((*GetRankResponse)(nil), "podium.api.v1.GetRankResponse")
+	proto.RegisterType((*GetAroundMemberRequest)(nil), "podium.api.v1.GetAroundMemberRequest")
+	proto.RegisterType((*GetTopMembersRequest)(nil), "podium.api.v1.GetTopMembersRequest")
+	proto.RegisterType((*GetTopPercentageRequest)(nil), "podium.api.v1.GetTopPercentageRequest")
+	proto.RegisterType((*UpsertScoreMultiLeaderboardsRequest)(nil), "podium.api.v1.UpsertScoreMultiLeaderboardsRequest")
+	proto.RegisterType((*UpsertScoreMultiLeaderboardsRequest_ScoreMultiChange)(nil), "podium.api.v1.UpsertScoreMultiLeaderboardsRequest.ScoreMultiChange")
+	proto.RegisterType((*UpsertScoreMultiLeaderboardsResponse)(nil), "podium.api.v1.UpsertScoreMultiLeaderboardsResponse")
+	proto.RegisterType((*UpsertScoreMultiLeaderboardsResponse_Member)(nil), "podium.api.v1.UpsertScoreMultiLeaderboardsResponse.Member")
+	proto.RegisterType((*GetRankMultiLeaderboardsRequest)(nil), "podium.api.v1.GetRankMultiLeaderboardsRequest")
+	proto.RegisterType((*GetRankMultiLeaderboardsResponse)(nil), "podium.api.v1.GetRankMultiLeaderboardsResponse")
+	proto.RegisterType((*GetRankMultiLeaderboardsResponse_Member)(nil), "podium.api.v1.GetRankMultiLeaderboardsResponse.Member")
+	proto.RegisterType((*GetAroundScoreRequest)(nil), "podium.api.v1.GetAroundScoreRequest")
+	proto.RegisterType((*BulkUpsertScoresResponse)(nil), "podium.api.v1.BulkUpsertScoresResponse")
+	proto.RegisterType((*BulkUpsertScoresResponse_Member)(nil), "podium.api.v1.BulkUpsertScoresResponse.Member")
+	proto.RegisterType((*GetAroundMemberResponse)(nil), "podium.api.v1.GetAroundMemberResponse")
+	proto.RegisterType((*GetAroundScoreResponse)(nil), "podium.api.v1.GetAroundScoreResponse")
+	proto.RegisterType((*GetTopMembersResponse)(nil), "podium.api.v1.GetTopMembersResponse")
+	proto.RegisterType((*GetTopPercentageResponse)(nil), "podium.api.v1.GetTopPercentageResponse")
+	proto.RegisterType((*ResetLeaderboardRequest)(nil), "podium.api.v1.ResetLeaderboardRequest")
+	proto.RegisterType((*ResetLeaderboardRequest_ResetOptions)(nil), "podium.api.v1.ResetLeaderboardRequest.ResetOptions")
+	proto.RegisterType((*GetResetLeaderboardProgressRequest)(nil), "podium.api.v1.GetResetLeaderboardProgressRequest")
+	proto.RegisterType((*ResetProgress)(nil), "podium.api.v1.ResetProgress")
+	proto.RegisterType((*ResetLeaderboardResponse)(nil), "podium.api.v1.ResetLeaderboardResponse")
+	proto.RegisterType((*GetResetLeaderboardProgressResponse)(nil), "podium.api.v1.GetResetLeaderboardProgressResponse")
+	proto.RegisterType((*GetLeaderboardSettingsRequest)(nil), "podium.api.v1.GetLeaderboardSettingsRequest")
+	proto.RegisterType((*UpdateLeaderboardSettingsRequest)(nil), "podium.api.v1.UpdateLeaderboardSettingsRequest")
+	proto.RegisterType((*UpdateLeaderboardSettingsRequest_Settings)(nil), "podium.api.v1.UpdateLeaderboardSettingsRequest.Settings")
+	proto.RegisterType((*LeaderboardSettings)(nil), "podium.api.v1.LeaderboardSettings")
+	proto.RegisterType((*MilestoneRule)(nil), "podium.api.v1.MilestoneRule")
+	proto.RegisterType((*LeaderboardSettingsResponse)(nil), "podium.api.v1.LeaderboardSettingsResponse")
+	proto.RegisterType((*FreezeLeaderboardRequest)(nil), "podium.api.v1.FreezeLeaderboardRequest")
+	proto.RegisterType((*UnfreezeLeaderboardRequest)(nil), "podium.api.v1.UnfreezeLeaderboardRequest")
+	proto.RegisterType((*GetRejectedScoresRequest)(nil), "podium.api.v1.GetRejectedScoresRequest")
+	proto.RegisterType((*GetRejectedScoresResponse)(nil), "podium.api.v1.GetRejectedScoresResponse")
+	proto.RegisterType((*GetRejectedScoresResponse_RejectedScore)(nil), "podium.api.v1.GetRejectedScoresResponse.RejectedScore")
+	proto.RegisterType((*BlockMemberRequest)(nil), "podium.api.v1.BlockMemberRequest")
+	proto.RegisterType((*UnblockMemberRequest)(nil), "podium.api.v1.UnblockMemberRequest")
+	proto.RegisterType((*BlockMemberGloballyRequest)(nil), "podium.api.v1.BlockMemberGloballyRequest")
+	proto.RegisterType((*UnblockMemberGloballyRequest)(nil), "podium.api.v1.UnblockMemberGloballyRequest")
+	proto.RegisterType((*BlocklistResponse)(nil), "podium.api.v1.BlocklistResponse")
+	proto.RegisterType((*LedgerEntry)(nil), "podium.api.v1.LedgerEntry")
+	proto.RegisterType((*GetMemberLedgerRequest)(nil), "podium.api.v1.GetMemberLedgerRequest")
+	proto.RegisterType((*GetMemberLedgerResponse)(nil), "podium.api.v1.GetMemberLedgerResponse")
+	proto.RegisterType((*RollbackMemberRequest)(nil), "podium.api.v1.RollbackMemberRequest")
+	proto.RegisterType((*RollbackMemberResponse)(nil), "podium.api.v1.RollbackMemberResponse")
+	proto.RegisterType((*HistorySample)(nil), "podium.api.v1.HistorySample")
+	proto.RegisterType((*GetMemberHistoryRequest)(nil), "podium.api.v1.GetMemberHistoryRequest")
+	proto.RegisterType((*GetMemberHistoryResponse)(nil), "podium.api.v1.GetMemberHistoryResponse")
+	proto.RegisterType((*RankMove)(nil), "podium.api.v1.RankMove")
+	proto.RegisterType((*GetRankMoversRequest)(nil), "podium.api.v1.GetRankMoversRequest")
+	proto.RegisterType((*GetRankMoversResponse)(nil), "podium.api.v1.GetRankMoversResponse")
+	proto.RegisterType((*DiffLeaderboardRequest)(nil), "podium.api.v1.DiffLeaderboardRequest")
+	proto.RegisterType((*MemberDiff)(nil), "podium.api.v1.MemberDiff")
+	proto.RegisterType((*WatchTopMembersRequest)(nil), "podium.api.v1.WatchTopMembersRequest")
+	proto.RegisterType((*ImportScoresRequest)(nil), "podium.api.v1.ImportScoresRequest")
+	proto.RegisterType((*ImportScoresResponse)(nil), "podium.api.v1.ImportScoresResponse")
+	proto.RegisterType((*ImportScoresResponse_Failure)(nil), "podium.api.v1.ImportScoresResponse.Failure")
+	proto.RegisterType((*ImportScoresResponse_Chunk)(nil), "podium.api.v1.ImportScoresResponse.Chunk")
+	proto.RegisterType((*ExportLeaderboardRequest)(nil), "podium.api.v1.ExportLeaderboardRequest")
+	proto.RegisterType((*ExportLeaderboardResponse)(nil), "podium.api.v1.ExportLeaderboardResponse")
+	proto.RegisterType((*ExportLeaderboardResponse_Member)(nil), "podium.api.v1.ExportLeaderboardResponse.Member")
+	proto.RegisterType((*CreateLeagueRequest)(nil), "podium.api.v1.CreateLeagueRequest")
+	proto.RegisterType((*CreateLeagueRequest_League)(nil), "podium.api.v1.CreateLeagueRequest.League")
+	proto.RegisterType((*GetLeagueRequest)(nil), "podium.api.v1.GetLeagueRequest")
+	proto.RegisterType((*League)(nil), "podium.api.v1.League")
+	proto.RegisterType((*LeagueResponse)(nil), "podium.api.v1.LeagueResponse")
+	proto.RegisterType((*JoinLeagueRequest)(nil), "podium.api.v1.JoinLeagueRequest")
+	proto.RegisterType((*GetLeagueDivisionRequest)(nil), "podium.api.v1.GetLeagueDivisionRequest")
+	proto.RegisterType((*LeagueDivision)(nil), "podium.api.v1.LeagueDivision")
+	proto.RegisterType((*LeagueDivisionResponse)(nil), "podium.api.v1.LeagueDivisionResponse")
+	proto.RegisterType((*EndLeagueSeasonRequest)(nil), "podium.api.v1.EndLeagueSeasonRequest")
+	proto.RegisterType((*EndLeagueSeasonResponse)(nil), "podium.api.v1.EndLeagueSeasonResponse")
+	proto.RegisterType((*TournamentPrize)(nil), "podium.api.v1.TournamentPrize")
+	proto.RegisterType((*CreateTournamentRequest)(nil), "podium.api.v1.CreateTournamentRequest")
+	proto.RegisterType((*CreateTournamentRequest_Tournament)(nil), "podium.api.v1.CreateTournamentRequest.Tournament")
+	proto.RegisterType((*GetTournamentRequest)(nil), "podium.api.v1.GetTournamentRequest")
+	proto.RegisterType((*JoinTournamentRequest)(nil), "podium.api.v1.JoinTournamentRequest")
+	proto.RegisterType((*FinalizeTournamentRequest)(nil), "podium.api.v1.FinalizeTournamentRequest")
+	proto.RegisterType((*Tournament)(nil), "podium.api.v1.Tournament")
+	proto.RegisterType((*TournamentResponse)(nil), "podium.api.v1.TournamentResponse")
+	proto.RegisterType((*TournamentWinner)(nil), "podium.api.v1.TournamentWinner")
+	proto.RegisterType((*FinalizeTournamentResponse)(nil), "podium.api.v1.FinalizeTournamentResponse")
+	proto.RegisterType((*GetWebhookDeliveriesRequest)(nil), "podium.api.v1.GetWebhookDeliveriesRequest")
+	proto.RegisterType((*GetWebhookDeliveriesResponse)(nil), "podium.api.v1.GetWebhookDeliveriesResponse")
+	proto.RegisterType((*GetWebhookDeliveriesResponse_WebhookDelivery)(nil), "podium.api.v1.GetWebhookDeliveriesResponse.WebhookDelivery")
+}
+
+func init() { proto.RegisterFile("proto/podium/api/v1/podium.proto", fileDescriptor_d33144d47ebf9898) }
+
+var fileDescriptor_d33144d47ebf9898 = []byte{
+	// 5130 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3c, 0x6b, 0x6f, 0x1c, 0xc9,
+	0x71, 0x98, 0x5d, 0xee, 0x72, 0x59, 0x7c, 0xaa, 0x29, 0x52, 0xab, 0xa1, 0x1e, 0xd4, 0x50, 0x0f,
+	0x9e, 0x74, 0xda, 0xd5, 0xe3, 0xac, 0xd3, 0xe9, 0xee, 0x7c, 0xa6, 0xde, 0xf4, 0x49, 0x77, 0xca,
+	0x48, 0xf7, 0xb0, 0x1d, 0x60, 0x33, 0xdc, 0xed, 0x25, 0x27, 0x9a, 0x9d, 0x59, 0xcf, 0xf4, 0x92,
+	0xe2, 0x09, 0xba, 0x20, 0x67, 0x24, 0x07, 0x27, 0x7e, 0xc5, 0x46, 0x82, 0x73, 0x82, 0x20, 0xce,
+	0xc3, 0x79, 0x21, 0x41, 0x0c, 0x04, 0x31, 0x92, 0x00, 0xf9, 0x90, 0xcf, 0x46, 0x02, 0x04, 0x86,
+	0x7f, 0x40, 0x80, 0x7c, 0x09, 0xf2, 0x2d, 0x40, 0x90, 0xaf, 0x41, 0x3f, 0xe6, 0xdd, 0x33, 0x3b,
+	0x4b, 0x89, 0x09, 0xf2, 0x89, 0xdb, 0x35, 0xd5, 0xdd, 0xd5, 0xd5, 0x55, 0xd5, 0xd5, 0x55, 0xd5,
+	0x84, 0xe5, 0xbe, 0xeb, 0x10, 0xa7, 0xd9, 0x77, 0x3a, 0xe6, 0xa0, 0xd7, 0x34, 0xfa, 0x66, 0x73,
+	0xfb, 0xa2, 0x68, 0x35, 0xd8, 0x27, 0x34, 0x2d, 0x5a, 0x46, 0xdf, 0x6c, 0x6c, 0x5f, 0x54, 0x8f,
+	0x6c, 0x3a, 0xce, 0xa6, 0x85, 0x19, 0xaa, 0x61, 0xdb, 0x0e, 0x31, 0x88, 0xe9, 0xd8, 0x1e, 0x47,
+	0x56, 0x97, 0xc4, 0x57, 0xd6, 0xda, 0x18, 0x74, 0x9b, 0xb8, 0xd7, 0x27, 0xbb, 0xe2, 0xe3, 0xb1,
+	0xe4, 0xc7, 0x1d, 0xd7, 0xe8, 0xf7, 0xb1, 0x2b, 0x3a, 0x6b, 0x07, 0x01, 0xdd, 0xc5, 0x86, 0x45,
+	0xb6, 0x6e, 0x6c, 0xe1, 0xf6, 0x63, 0x1d, 0x7f, 0x75, 0x80, 0x3d, 0xa2, 0xbd, 0x01, 0xf3, 0x31,
+	0xa8, 0xd7, 0x77, 0x6c, 0x0f, 0xa3, 0x53, 0x30, 0xb3, 0xe3, 0xb8, 0x8f, 0x4d, 0x7b, 0xb3, 0xe5,
+	0x11, 0xd7, 0xb4, 0x37, 0xeb, 0xca, 0xb2, 0xb2, 0x3a, 0xa1, 0x4f, 0x0b, 0xe8, 0x43, 0x06, 0xd4,
+	0x9a, 0x30, 0xf3, 0x90, 0x18, 0x64, 0xe0, 0x05, 0x1d, 0x8f, 0x02, 0x60, 0xd7, 0x75, 0xdc, 0x96,
+	0x6b, 0x10, 0xcc, 0x3a, 0x29, 0xfa, 0x04, 0x83, 0xe8, 0x06, 0xc1, 0xda, 0x1a, 0xd4, 0x75, 0xdc,
+	0x73, 0xb6, 0xf1, 0x3d, 0x6c, 0x74, 0xb0, 0xbb, 0xe1, 0x18, 0x6e, 0x47, 0x90, 0x42, 0xe7, 0xb4,
+	0x42, 0x68, 0xcb, 0xec, 0xf8, 0x73, 0x46, 0xa0, 0xeb, 0x1d, 0xed, 0x77, 0xca, 0x70, 0xe8, 0xfa,
+	0xc0, 0x7a, 0xfc, 0x5e, 0xdf, 0xc3, 0x2e, 0x79, 0xd8, 0x76, 0x5c, 0xec, 0x8d, 0x36, 0x04, 0x5a,
+	0x82, 0x89, 0xbe, 0x8b, 0xb7, 0x5b, 0xae, 0x61, 0x3f, 0xae, 0x97, 0x96, 0x95, 0xd5, 0x9a, 0x5e,
+	0xa3, 0x00, 0xdd, 0xb0, 0x1f, 0x23, 0x15, 0x6a, 0x1e, 0x1d, 0xf4, 0xd1, 0xa3, 0x7b, 0xf5, 0xf2,
+	0xb2, 0xb2, 0x5a, 0xd1, 0x83, 0x36, 0xfa, 0x10, 0xa6, 0x7b, 0xb8, 0xb7, 0x81, 0xdd, 0x16, 0x03,
+	0x79, 0xf5, 0xb1, 0x65, 0x65, 0x75, 0xf2, 0xd2, 0xe5, 0x46, 0x6c, 0x17, 0x1b, 0x19, 0xe4, 0x35,
+	0xee, 0xb3, 0xbe, 0x02, 0x36, 0xd5, 0x8b, 0xb4, 0xd0, 0x19, 0x98, 0x35, 0x3b, 0xb8, 0xd7, 0x77,
+	0x08, 0xb6, 0xdb, 0xbb, 0xad, 0xc7, 0x78, 0xb7, 0x5e, 0x61, 0xa4, 0xcf, 0x44, 0xc0, 0x6f, 0xe3,
+	0x5d, 0xf5, 0x2d, 0x98, 0x8c, 0x0c, 0x43, 0xa9, 0xed, 0x0f, 0x36, 0x2c, 0xb3, 0xbd, 0x7e, 0x53,
+	0xac, 0x35, 0x68, 0xa3, 0x83, 0x50, 0x61, 0x64, 0xb2, 0x25, 0x2a, 0x3a, 0x6f, 0xa8, 0x3f, 0x0f,
+	0x53, 0x51, 0x3a, 0xd0, 0x3d, 0x18, 0xe7, 0x94, 0x78, 0x75, 0x65, 0xb9, 0xbc, 0x3a, 0x79, 0xe9,
+	0xd2, 0xe8, 0xab, 0xd1, 0xfd, 0x21, 0xb4, 0x77, 0xa0, 0xca, 0xe1, 0xa3, 0x53, 0x86, 0x10, 0x8c,
+	0xb1, 0x1d, 0xe1, 0x5c, 0x67, 0xbf, 0xb5, 0x9f, 0x94, 0x01, 0x45, 0x26, 0x1f, 0x71, 0xa3, 0x57,
+	0x61, 0x4e, 0xec, 0x17, 0x9f, 0x9a, 0x22, 0x96, 0x38, 0x5b, 0x39, 0xfc, 0x01, 0xa7, 0x28, 0x21,
+	0x12, 0xe5, 0x1c, 0x91, 0x18, 0x4b, 0x88, 0xc4, 0x03, 0x98, 0x62, 0xbf, 0x5b, 0xed, 0x2d, 0xc3,
+	0xde, 0xc4, 0x6c, 0xd7, 0x26, 0x2f, 0x9d, 0x4f, 0xf0, 0x30, 0xbd, 0x84, 0x06, 0x6b, 0xdc, 0x60,
+	0x9d, 0xf4, 0x49, 0x2f, 0x6c, 0xc8, 0x44, 0xa1, 0x2a, 0x15, 0x85, 0xbf, 0x52, 0x60, 0x32, 0x32,
+	0x4a, 0xc8, 0x55, 0x25, 0xca, 0xd5, 0xeb, 0x30, 0x83, 0x9f, 0xf4, 0x71, 0x9b, 0xe0, 0x4e, 0x2b,
+	0x64, 0xfa, 0xe4, 0xa5, 0xa5, 0x06, 0x37, 0x18, 0x0d, 0xdf, 0x60, 0x34, 0xd6, 0x6d, 0x72, 0xe5,
+	0x95, 0xf7, 0x0d, 0x6b, 0x80, 0xf5, 0x69, 0xbf, 0x0b, 0x97, 0xb2, 0xdb, 0x30, 0x17, 0x8c, 0xb1,
+	0x8d, 0x5d, 0xcf, 0x74, 0xec, 0x7a, 0x79, 0xf8, 0x28, 0xb3, 0x7e, 0xa7, 0xf7, 0x79, 0x1f, 0x6a,
+	0x6d, 0x1e, 0x39, 0xc4, 0xb0, 0xb8, 0x88, 0x8c, 0xa8, 0xb6, 0xda, 0x6d, 0x38, 0x18, 0xef, 0x2d,
+	0x6c, 0x4e, 0x1d, 0xc6, 0xbd, 0x41, 0xbb, 0x8d, 0x3d, 0x8f, 0xf5, 0xab, 0xe9, 0x7e, 0x93, 0x72,
+	0xa4, 0xed, 0x0c, 0x6c, 0xc2, 0x96, 0x5c, 0xd1, 0x79, 0x43, 0xfb, 0x6e, 0x09, 0x16, 0xd6, 0xed,
+	0xb6, 0x8b, 0x7b, 0xd8, 0xde, 0x67, 0xb1, 0xca, 0x33, 0x26, 0x6f, 0xc2, 0xd8, 0x86, 0xd3, 0xd9,
+	0x15, 0x36, 0xe4, 0xa5, 0x84, 0xc4, 0x48, 0x09, 0x6c, 0x5c, 0x77, 0x3a, 0xbb, 0x3a, 0xeb, 0x56,
+	0xdc, 0x62, 0x9c, 0x84, 0x31, 0xda, 0x0d, 0x1d, 0x81, 0x09, 0xd3, 0x1f, 0xd4, 0xb7, 0xcc, 0x01,
+	0x40, 0xfb, 0x4d, 0x05, 0xe6, 0xee, 0x60, 0xc2, 0x79, 0xbb, 0x6f, 0xfc, 0x38, 0x08, 0x15, 0xc7,
+	0xed, 0x60, 0x97, 0x31, 0x63, 0x42, 0xe7, 0x8d, 0x94, 0x7e, 0xd5, 0x42, 0x2e, 0x69, 0xff, 0xa2,
+	0xc0, 0x7c, 0x4c, 0x7b, 0x86, 0x6e, 0x7a, 0xd4, 0xf0, 0x94, 0xb2, 0x0c, 0x4f, 0x59, 0x66, 0x78,
+	0xc6, 0x42, 0xc3, 0x83, 0x56, 0x60, 0x9a, 0xea, 0xbf, 0xe9, 0x0c, 0x3c, 0x6e, 0x14, 0x2a, 0xec,
+	0xe3, 0x94, 0x0f, 0x64, 0x86, 0x61, 0x09, 0x26, 0xf0, 0x93, 0xbe, 0xe9, 0xe2, 0x96, 0x41, 0x98,
+	0x92, 0x56, 0xf4, 0x1a, 0x07, 0xac, 0x11, 0x4a, 0xa1, 0xaf, 0x2b, 0xe3, 0xcb, 0xca, 0x6a, 0x59,
+	0xf7, 0x9b, 0xda, 0xdf, 0x2b, 0xb0, 0x98, 0xdc, 0xdf, 0xff, 0x2f, 0xcb, 0xd2, 0xfe, 0x46, 0x81,
+	0x03, 0x11, 0x41, 0xd9, 0x47, 0xba, 0x2b, 0x79, 0x74, 0x57, 0x87, 0xd1, 0x3d, 0x9e, 0xa0, 0xfb,
+	0xe3, 0x08, 0xd9, 0xa3, 0x3a, 0x0c, 0x81, 0xd8, 0x96, 0xb2, 0xc4, 0xb6, 0x1c, 0x17, 0x5b, 0x34,
+	0x07, 0x65, 0xb3, 0xc3, 0xfd, 0x83, 0x09, 0x9d, 0xfe, 0xd4, 0xbe, 0x5f, 0x02, 0x14, 0x25, 0x60,
+	0x28, 0xe3, 0xae, 0x87, 0x07, 0x73, 0x89, 0x1d, 0xcc, 0xab, 0x09, 0x13, 0x91, 0x1e, 0x4d, 0x9c,
+	0xc9, 0xc1, 0x71, 0x4c, 0x39, 0x62, 0x3b, 0xa4, 0xd5, 0x75, 0x06, 0x76, 0xa7, 0x5e, 0x5e, 0x2e,
+	0x53, 0xee, 0xdb, 0x0e, 0xb9, 0x4d, 0xdb, 0xea, 0xa7, 0xca, 0x8b, 0x3d, 0xac, 0xe3, 0xfc, 0xaf,
+	0x24, 0xd4, 0x81, 0x4e, 0xe1, 0x78, 0x26, 0xf5, 0x67, 0x7d, 0x99, 0xf2, 0xdb, 0x5a, 0x17, 0xe6,
+	0xb9, 0x5b, 0xb8, 0xbf, 0xe6, 0x47, 0x7b, 0x17, 0x0e, 0x46, 0xe7, 0x19, 0x55, 0x0c, 0xc4, 0xa6,
+	0x96, 0xc2, 0x4d, 0xbd, 0x0f, 0x87, 0x25, 0xfe, 0xec, 0xd0, 0xad, 0x5d, 0x84, 0xaa, 0x8b, 0x0d,
+	0xcf, 0xb1, 0xc5, 0x58, 0xa2, 0xa5, 0xdd, 0x8d, 0xd3, 0xf7, 0x1c, 0x23, 0xad, 0xc3, 0x42, 0x62,
+	0xa5, 0x7b, 0x1e, 0x6a, 0x07, 0x66, 0xee, 0x60, 0x42, 0x15, 0xec, 0x7f, 0xf7, 0x58, 0xd0, 0xbe,
+	0x02, 0xb3, 0xc1, 0xc4, 0xcf, 0x65, 0x66, 0x64, 0x8e, 0xe5, 0x3f, 0x2b, 0xb0, 0x78, 0x07, 0x93,
+	0x35, 0x97, 0xaa, 0xc2, 0xff, 0xc9, 0xa9, 0x77, 0x01, 0x16, 0x36, 0x31, 0x69, 0x59, 0x86, 0x47,
+	0x5a, 0x66, 0xb7, 0x15, 0xea, 0x29, 0x3f, 0x02, 0x0f, 0x6c, 0x62, 0x72, 0xcf, 0xf0, 0xc8, 0x7a,
+	0xf7, 0x1d, 0xa1, 0xb0, 0xcc, 0x49, 0x35, 0x36, 0x71, 0xcb, 0x33, 0x3f, 0xc2, 0xbe, 0x7e, 0x51,
+	0xc0, 0x43, 0xf3, 0x23, 0xac, 0xfd, 0x86, 0x02, 0x07, 0xef, 0x60, 0xf2, 0xc8, 0xe9, 0xef, 0x4d,
+	0xb8, 0x8f, 0xc3, 0x24, 0x1b, 0xdc, 0x1e, 0xd0, 0xde, 0xc2, 0x63, 0x02, 0x0a, 0x7a, 0x87, 0x41,
+	0x32, 0x56, 0x91, 0x4b, 0xd3, 0x36, 0x1c, 0xe2, 0x24, 0x3d, 0xc0, 0x6e, 0x1b, 0xdb, 0xc4, 0xd8,
+	0x1c, 0xd5, 0xd5, 0x3a, 0x06, 0xd0, 0x0f, 0xfa, 0x06, 0x44, 0x05, 0x90, 0x0c, 0xc9, 0xf9, 0xcf,
+	0x12, 0xac, 0x44, 0x9c, 0x86, 0xfb, 0x03, 0x8b, 0x98, 0x11, 0x0d, 0x0d, 0x58, 0x23, 0xdb, 0x42,
+	0x65, 0xa8, 0x23, 0x57, 0x4a, 0x38, 0x72, 0xb9, 0x77, 0x87, 0xaf, 0x02, 0x62, 0x88, 0xad, 0x1e,
+	0x25, 0xc2, 0xbf, 0x25, 0x70, 0x9f, 0xef, 0x46, 0xf6, 0x2d, 0x21, 0x8b, 0xe4, 0x46, 0xf8, 0x55,
+	0xdc, 0x1d, 0xe6, 0xbc, 0x04, 0xa4, 0xb8, 0x67, 0x78, 0x0f, 0xe6, 0x92, 0xc3, 0x65, 0x5c, 0x22,
+	0x34, 0x98, 0x8a, 0xec, 0x0b, 0x3f, 0x90, 0x26, 0xf4, 0x18, 0x4c, 0xfb, 0xa7, 0x12, 0x9c, 0xcc,
+	0x5f, 0xc1, 0x50, 0x25, 0xd6, 0xa1, 0x2a, 0x2e, 0xd6, 0xfc, 0xc4, 0xbb, 0x36, 0x12, 0x83, 0xe2,
+	0x67, 0xa0, 0x18, 0x49, 0xfd, 0xf1, 0x8b, 0x38, 0xe5, 0x5e, 0xac, 0x67, 0x78, 0x12, 0x62, 0x52,
+	0x7e, 0xb3, 0x5e, 0x4b, 0x8b, 0xfe, 0x4d, 0xed, 0x8f, 0x15, 0x38, 0x2e, 0xec, 0xdf, 0x0b, 0x10,
+	0xe0, 0x33, 0x30, 0x1b, 0xd7, 0x37, 0xff, 0x1c, 0x9b, 0x89, 0x29, 0x9c, 0xb7, 0x07, 0x17, 0xfd,
+	0x6b, 0x25, 0x58, 0xce, 0x26, 0x74, 0xe8, 0xa6, 0xbf, 0x93, 0xd8, 0xf4, 0x2b, 0x69, 0x37, 0x27,
+	0x77, 0xe8, 0xe4, 0x86, 0x0f, 0x82, 0xfd, 0x4e, 0xf1, 0x59, 0x62, 0x62, 0xc2, 0xd3, 0xa1, 0x14,
+	0xd9, 0x63, 0xb9, 0x63, 0x9a, 0xe7, 0xdf, 0x68, 0x9f, 0x2a, 0xb0, 0x10, 0x1c, 0x28, 0x7b, 0xb9,
+	0x55, 0xca, 0x25, 0xb0, 0x80, 0xd5, 0x1d, 0x4b, 0x9e, 0x04, 0x25, 0xa8, 0xa7, 0x83, 0x36, 0x43,
+	0xf7, 0xe1, 0x6e, 0xd2, 0xdf, 0x6c, 0x0c, 0x0d, 0x04, 0xc9, 0xbd, 0x4e, 0xf5, 0x3b, 0x2f, 0xda,
+	0xb1, 0x4c, 0xa9, 0xdc, 0xd8, 0x30, 0x95, 0x4b, 0xee, 0x4e, 0x07, 0x0e, 0x05, 0x9b, 0x53, 0xd8,
+	0xb9, 0x6a, 0x26, 0x39, 0xb2, 0x90, 0xe0, 0x48, 0x62, 0xe1, 0x5a, 0x3b, 0xe2, 0x53, 0x14, 0xbd,
+	0xd7, 0x8d, 0x3c, 0xc9, 0x06, 0x2c, 0x24, 0xce, 0xf9, 0x17, 0x3f, 0x07, 0x86, 0x7a, 0xfa, 0xe0,
+	0x7e, 0xf1, 0xd3, 0xfc, 0xac, 0x04, 0x87, 0x74, 0xec, 0x61, 0xb2, 0xe7, 0x70, 0x30, 0x0d, 0xc9,
+	0xba, 0x74, 0x84, 0x96, 0xd3, 0x67, 0xa1, 0xf2, 0x7a, 0x49, 0x1a, 0x92, 0xcd, 0x98, 0x85, 0xc3,
+	0xdf, 0xe5, 0x5d, 0xf5, 0x29, 0x37, 0xd2, 0x52, 0xff, 0x56, 0x81, 0xa9, 0xe8, 0x67, 0x66, 0x03,
+	0x89, 0x6b, 0x10, 0xbc, 0xb9, 0xeb, 0xcb, 0xb2, 0xdf, 0xa6, 0xce, 0x33, 0x31, 0xdc, 0x4d, 0x4c,
+	0x7c, 0xe7, 0x99, 0xb7, 0xa8, 0x34, 0x6f, 0x18, 0x9e, 0x6f, 0x47, 0xd8, 0x6f, 0x8a, 0xdb, 0x35,
+	0xda, 0xc4, 0x71, 0x99, 0x18, 0x2b, 0xba, 0x68, 0xa1, 0x79, 0xa8, 0x10, 0xa7, 0xdf, 0x0a, 0x2e,
+	0xbe, 0xc4, 0xe9, 0xbf, 0x1d, 0xea, 0x7f, 0x35, 0xaa, 0xff, 0x47, 0x01, 0xda, 0x5b, 0x03, 0xfb,
+	0x31, 0x37, 0x00, 0xfc, 0xaa, 0x3b, 0xc1, 0x20, 0xcc, 0x02, 0xbc, 0x0d, 0x1a, 0xb5, 0x9a, 0x89,
+	0x35, 0x3f, 0x70, 0x9d, 0x4d, 0x17, 0x7b, 0xa3, 0x86, 0xdd, 0xfe, 0x4b, 0x81, 0x69, 0x36, 0x94,
+	0xdf, 0xbf, 0xa0, 0x5d, 0xcd, 0x62, 0x49, 0x94, 0x8d, 0xe5, 0x34, 0x1b, 0x3d, 0x96, 0x50, 0x10,
+	0x37, 0x67, 0xd1, 0xa2, 0xb1, 0xab, 0xbe, 0xeb, 0x50, 0x21, 0xc3, 0x1d, 0xc1, 0x9e, 0x10, 0x40,
+	0x79, 0x44, 0x68, 0x60, 0x50, 0x1c, 0xb4, 0xbc, 0x41, 0x79, 0xe4, 0x11, 0xc3, 0xa5, 0x31, 0x4b,
+	0x11, 0x0e, 0x28, 0xeb, 0x13, 0x02, 0xb2, 0x46, 0xa8, 0xbf, 0xdb, 0x35, 0x6d, 0xd3, 0xdb, 0xe2,
+	0xdf, 0x6b, 0xec, 0x3b, 0xf8, 0xa0, 0x35, 0xa2, 0xd9, 0x50, 0x4f, 0x72, 0xb0, 0x80, 0x0e, 0x5c,
+	0x85, 0x5a, 0x5f, 0xf0, 0x49, 0x88, 0xe2, 0x11, 0x99, 0x28, 0x06, 0x7b, 0x11, 0x60, 0x6b, 0xbb,
+	0xb0, 0x92, 0xbb, 0x69, 0xfb, 0x38, 0xf5, 0x6d, 0x38, 0x7a, 0x27, 0x36, 0xeb, 0x43, 0x4c, 0x88,
+	0x69, 0x6f, 0x8e, 0x2a, 0x2a, 0x3f, 0xab, 0xc2, 0xf2, 0x7b, 0xfd, 0x8e, 0x41, 0xf0, 0x73, 0x8f,
+	0x85, 0x1e, 0x41, 0xcd, 0x13, 0x3d, 0xc5, 0x6a, 0xae, 0xa6, 0xbc, 0xc1, 0xfc, 0x99, 0x1a, 0x41,
+	0x3b, 0x18, 0x49, 0xfd, 0xeb, 0x0a, 0xd4, 0x7c, 0x30, 0x3a, 0x0d, 0xb3, 0x1d, 0xdc, 0x36, 0x76,
+	0x5b, 0x5b, 0x86, 0xd5, 0x6d, 0x59, 0x66, 0x97, 0x7b, 0xbd, 0x15, 0x7d, 0x9a, 0x81, 0xef, 0x1a,
+	0x56, 0xf7, 0x9e, 0xd9, 0xc5, 0xe8, 0x2a, 0x4c, 0xf4, 0x4c, 0xbb, 0x78, 0xf4, 0xbc, 0xd6, 0x33,
+	0x6d, 0x1e, 0x38, 0xa7, 0x3d, 0x8d, 0x27, 0xad, 0xd0, 0x97, 0x18, 0xda, 0xd3, 0x78, 0xc2, 0x7b,
+	0xae, 0xc0, 0x34, 0xed, 0x19, 0x46, 0x6c, 0xc7, 0x98, 0x80, 0x4e, 0xf5, 0x8c, 0x27, 0x41, 0xe8,
+	0x10, 0x9d, 0x80, 0xa9, 0x00, 0x89, 0x5a, 0x99, 0x0a, 0xc3, 0x99, 0xf4, 0x71, 0xa8, 0xb1, 0x69,
+	0xc0, 0x7c, 0x14, 0xa5, 0xb5, 0x63, 0xda, 0x1d, 0x67, 0x87, 0x69, 0x4a, 0x59, 0x3f, 0x10, 0xc1,
+	0xfc, 0x80, 0x7d, 0xa0, 0xbb, 0xd3, 0x73, 0x6c, 0x87, 0x38, 0xb6, 0xd9, 0x6e, 0x39, 0xb6, 0xb5,
+	0xcb, 0x34, 0xa7, 0xa6, 0x4f, 0x07, 0xd0, 0x77, 0x6d, 0x6b, 0x97, 0xa2, 0x79, 0xe6, 0xa6, 0x6d,
+	0x90, 0x81, 0x8b, 0x5b, 0x9b, 0x46, 0x0f, 0xfb, 0x3e, 0x6c, 0x00, 0xbd, 0x63, 0xf4, 0xd8, 0x55,
+	0x64, 0xcb, 0xf4, 0x88, 0xe3, 0xee, 0xb6, 0xb0, 0x6d, 0x6c, 0x58, 0xb8, 0x53, 0x9f, 0x60, 0xc3,
+	0xcd, 0x08, 0xf0, 0x2d, 0x0e, 0x45, 0x2f, 0xc1, 0x9c, 0x8f, 0x68, 0xda, 0x04, 0xbb, 0xdb, 0x86,
+	0x55, 0x07, 0x46, 0xa3, 0x3f, 0xc0, 0xba, 0x00, 0xa3, 0x73, 0x70, 0xc0, 0xb3, 0x8d, 0xbe, 0xb7,
+	0xe5, 0x90, 0x10, 0x77, 0x92, 0xe1, 0xce, 0xf9, 0x1f, 0x02, 0xe4, 0xf3, 0x80, 0x02, 0x64, 0x17,
+	0x13, 0x6c, 0xb3, 0xf8, 0xd3, 0x14, 0x5f, 0xbd, 0xff, 0x45, 0xf7, 0x3f, 0xa0, 0x37, 0x00, 0x7a,
+	0xa6, 0x85, 0x3d, 0xe2, 0xd8, 0xd8, 0xab, 0x4f, 0x2f, 0x97, 0x25, 0x4a, 0x74, 0xdf, 0x47, 0xd0,
+	0x07, 0x16, 0xd6, 0x23, 0xf8, 0x74, 0x11, 0x16, 0xee, 0x6c, 0x62, 0x37, 0x32, 0xd5, 0x0c, 0x5f,
+	0x04, 0x87, 0x87, 0x13, 0xbd, 0x0c, 0x48, 0xa0, 0xd2, 0xdd, 0xc1, 0x36, 0x71, 0x4d, 0xec, 0xd5,
+	0x67, 0xf9, 0x2a, 0xf8, 0x97, 0xfb, 0xc6, 0x93, 0x5b, 0x1c, 0xae, 0x7d, 0x36, 0x0e, 0xf3, 0x12,
+	0x39, 0x2f, 0x68, 0x88, 0x25, 0x62, 0x5e, 0x92, 0x89, 0xf9, 0x29, 0x98, 0xe1, 0x78, 0x96, 0x61,
+	0x77, 0x7a, 0x86, 0xcb, 0x7d, 0xb0, 0xb2, 0x40, 0xbb, 0x27, 0x80, 0xe8, 0x24, 0xcc, 0xec, 0xb8,
+	0x26, 0xc1, 0x2d, 0x66, 0x4b, 0x5b, 0x46, 0x20, 0x9a, 0x0c, 0xfa, 0x90, 0x02, 0xd7, 0x08, 0x5a,
+	0x06, 0xde, 0x6e, 0x61, 0xbb, 0xe3, 0x3b, 0x64, 0x65, 0x1d, 0x18, 0xec, 0x96, 0x4d, 0x0d, 0xf0,
+	0x39, 0x38, 0xd0, 0x37, 0x5c, 0x62, 0xb6, 0xcd, 0xbe, 0x61, 0x13, 0x8f, 0x0b, 0x5b, 0x95, 0x49,
+	0xc7, 0x5c, 0xf4, 0x03, 0x93, 0x37, 0x7a, 0x66, 0xba, 0xce, 0x47, 0xd8, 0x16, 0xe2, 0x28, 0x5a,
+	0x71, 0xd5, 0xac, 0xed, 0x59, 0x35, 0x27, 0x9e, 0x4b, 0x35, 0xa1, 0x80, 0x6a, 0x4e, 0x16, 0x56,
+	0xcd, 0xa9, 0xe2, 0xaa, 0x39, 0x5d, 0x4c, 0x35, 0x67, 0x0a, 0xaa, 0xe6, 0x6c, 0x61, 0xd5, 0x9c,
+	0x1b, 0x41, 0x35, 0x0f, 0x8c, 0xa4, 0x9a, 0xa8, 0x98, 0x6a, 0xce, 0xbf, 0x00, 0xd5, 0x3c, 0x38,
+	0x8a, 0x6a, 0x2e, 0x64, 0xa8, 0x66, 0x0b, 0xa6, 0x63, 0xb3, 0xa2, 0x19, 0x28, 0x05, 0x47, 0x5a,
+	0xc9, 0xec, 0x50, 0x0f, 0x90, 0xec, 0xf6, 0xb1, 0x70, 0x82, 0xd8, 0x6f, 0xe9, 0x1d, 0x67, 0x11,
+	0xaa, 0xdc, 0x2d, 0xf6, 0x5d, 0x1f, 0xde, 0xd2, 0x76, 0x60, 0x49, 0x7a, 0xc4, 0x0d, 0x75, 0x07,
+	0x3e, 0x9f, 0x3a, 0x40, 0xb5, 0x04, 0xbb, 0x64, 0xe3, 0x06, 0x7d, 0x68, 0xad, 0xc6, 0x6d, 0x17,
+	0xe3, 0x8f, 0x9e, 0xa3, 0x56, 0xe3, 0x06, 0xa8, 0xef, 0xd9, 0xdd, 0xe7, 0x1c, 0xc4, 0x65, 0x77,
+	0x11, 0x1d, 0xff, 0x62, 0x98, 0x90, 0x1e, 0xd5, 0x97, 0x40, 0x30, 0xd6, 0x0f, 0xe3, 0x87, 0xec,
+	0x77, 0xfc, 0x0a, 0x5d, 0x4e, 0x5c, 0xa1, 0x7f, 0x5c, 0x82, 0xc3, 0x92, 0x49, 0x87, 0xf2, 0xbc,
+	0x05, 0xb3, 0xae, 0xe8, 0xd3, 0x1a, 0x1a, 0xd4, 0x90, 0x0e, 0xde, 0x88, 0x81, 0xf5, 0x19, 0x37,
+	0x86, 0xa5, 0xfe, 0x2e, 0x73, 0xc6, 0x23, 0xa0, 0xe2, 0x37, 0xec, 0xb2, 0x7f, 0xc3, 0x3e, 0x05,
+	0x33, 0xc1, 0x6d, 0x3a, 0xf4, 0x4c, 0xca, 0x7a, 0x70, 0xc7, 0x7e, 0x18, 0x5c, 0xc4, 0x07, 0x16,
+	0x16, 0xe2, 0xc8, 0x7e, 0x53, 0xa7, 0x39, 0x58, 0x5f, 0x68, 0xd4, 0x7d, 0xd0, 0x1a, 0xd1, 0x76,
+	0x01, 0x5d, 0xb7, 0x9c, 0xf6, 0xe3, 0x7d, 0x8e, 0xa8, 0x23, 0x18, 0xeb, 0x39, 0x1d, 0x2c, 0xee,
+	0x0f, 0xec, 0xb7, 0xb6, 0x09, 0x07, 0xdf, 0xb3, 0x37, 0xf6, 0x7f, 0x72, 0xed, 0xcb, 0xa0, 0x46,
+	0xd6, 0x78, 0xc7, 0x72, 0x36, 0x0c, 0xcb, 0xda, 0x1d, 0x3d, 0x24, 0xe7, 0x2f, 0xa2, 0x14, 0x59,
+	0xc4, 0x5d, 0x38, 0x12, 0x5b, 0xc4, 0x9e, 0x47, 0xd7, 0x6e, 0xc1, 0x01, 0x46, 0xa5, 0x65, 0x7a,
+	0xe4, 0x39, 0xb2, 0x3f, 0x7f, 0x56, 0x82, 0xc9, 0x7b, 0xcc, 0xe8, 0x51, 0x8b, 0xb7, 0x5b, 0x94,
+	0x9b, 0x79, 0xe9, 0x98, 0xab, 0x30, 0xe1, 0x58, 0x9d, 0x11, 0x9c, 0x62, 0xc7, 0xea, 0x04, 0x67,
+	0xb6, 0x8d, 0x77, 0x44, 0xcf, 0xb1, 0x02, 0x3d, 0x6d, 0xbc, 0xf3, 0xd0, 0x0f, 0xa3, 0x75, 0xb0,
+	0x45, 0x0c, 0x21, 0xb2, 0xbc, 0x11, 0x59, 0x74, 0x35, 0xba, 0x68, 0x0a, 0x6f, 0x1b, 0x96, 0x85,
+	0x5d, 0xe6, 0x6d, 0x4c, 0xe8, 0xa2, 0xc5, 0xaf, 0xdd, 0x34, 0x4c, 0x1e, 0xb9, 0x32, 0x4e, 0x08,
+	0xc8, 0x1a, 0xd1, 0x7e, 0xc4, 0x73, 0x4a, 0x7c, 0xe7, 0xee, 0x89, 0x63, 0x65, 0xff, 0x72, 0x4a,
+	0x9e, 0x69, 0xb7, 0x7d, 0xdd, 0xe5, 0x8d, 0xc0, 0xd0, 0x8d, 0x65, 0x19, 0xba, 0x64, 0x86, 0xc6,
+	0x84, 0x43, 0x29, 0x8a, 0x87, 0xca, 0xca, 0x2b, 0x30, 0xee, 0x1f, 0x8b, 0xdc, 0xba, 0xa9, 0xa9,
+	0x83, 0x25, 0x10, 0x18, 0xdd, 0x47, 0xd5, 0x3e, 0x86, 0x05, 0xdd, 0xb1, 0xac, 0x0d, 0x63, 0xdf,
+	0xad, 0x83, 0x94, 0x37, 0x5a, 0x07, 0x16, 0x93, 0xf3, 0x0f, 0x5d, 0xe9, 0x05, 0xa8, 0x50, 0xf2,
+	0x77, 0xc5, 0x01, 0x9a, 0xb7, 0x4e, 0x8e, 0xa8, 0x7d, 0x08, 0xd3, 0x77, 0xb9, 0x57, 0xf4, 0xd0,
+	0xe8, 0xf5, 0xad, 0x44, 0x42, 0xa5, 0x9c, 0x8c, 0x72, 0x46, 0x83, 0xce, 0x34, 0x60, 0xc1, 0xfa,
+	0x30, 0xe9, 0x2a, 0x8b, 0x80, 0x05, 0x87, 0xac, 0x11, 0xed, 0x5b, 0x4a, 0x64, 0xaf, 0xc4, 0x1c,
+	0xfb, 0x69, 0x60, 0xbb, 0xae, 0xd3, 0x13, 0x54, 0xb0, 0xdf, 0xd4, 0xb3, 0x21, 0x8e, 0x70, 0xf6,
+	0x4b, 0xc4, 0xd1, 0x2c, 0xa8, 0xa7, 0xe9, 0x19, 0xca, 0xd2, 0x2b, 0x30, 0xce, 0xd7, 0xe4, 0x0b,
+	0x4f, 0xd2, 0x89, 0x8b, 0xb1, 0x4f, 0xf7, 0x91, 0xb5, 0x8f, 0xa1, 0xc6, 0xd2, 0x00, 0xce, 0x76,
+	0xfe, 0x99, 0x97, 0x8a, 0x15, 0x97, 0x24, 0xb1, 0x62, 0x99, 0x03, 0x76, 0x14, 0x80, 0xfe, 0x6d,
+	0x71, 0x3b, 0xc1, 0x35, 0x68, 0x82, 0x42, 0x6e, 0x52, 0x80, 0xf6, 0x4d, 0x9e, 0x5f, 0xf5, 0x69,
+	0x70, 0xf7, 0xe0, 0x83, 0x30, 0x8e, 0x96, 0x52, 0x1c, 0x2d, 0xfb, 0x1c, 0xa5, 0xb2, 0x62, 0x99,
+	0x3d, 0x93, 0x88, 0xd9, 0x79, 0x23, 0x0c, 0x01, 0x56, 0xa2, 0x39, 0xce, 0x7f, 0xe0, 0xf9, 0x86,
+	0x28, 0x3d, 0x43, 0x79, 0x5f, 0x84, 0x86, 0xcb, 0x50, 0x6b, 0x5b, 0x26, 0x8f, 0xe2, 0x8e, 0xb1,
+	0x0d, 0x3a, 0x94, 0x8c, 0x22, 0x89, 0x29, 0xf5, 0x00, 0x11, 0x5d, 0x84, 0xf1, 0x2e, 0x33, 0x91,
+	0x5e, 0xbd, 0x92, 0xdf, 0xc7, 0xc7, 0xd3, 0x76, 0x61, 0xf1, 0xa6, 0xd9, 0xed, 0xee, 0x3d, 0xf2,
+	0x5b, 0x90, 0xa1, 0x9c, 0x75, 0x63, 0x51, 0xd6, 0xfd, 0xa8, 0x04, 0xc0, 0xc5, 0x96, 0x52, 0x90,
+	0x2b, 0x4d, 0xf4, 0x24, 0xe0, 0x29, 0x5b, 0x71, 0x2c, 0xf2, 0x16, 0xad, 0xaa, 0x94, 0xf8, 0x50,
+	0xc3, 0xaa, 0x2a, 0xe3, 0x0e, 0x56, 0xa1, 0xac, 0xc6, 0x45, 0xdf, 0x7c, 0x54, 0x86, 0x8f, 0x9f,
+	0xb0, 0x2d, 0xd5, 0x88, 0x70, 0x1f, 0x07, 0x5e, 0x63, 0x2a, 0xa4, 0x9b, 0x47, 0x43, 0x81, 0x81,
+	0x98, 0x78, 0x27, 0xa4, 0xbf, 0x96, 0x94, 0x7e, 0x17, 0x16, 0x3f, 0x30, 0x48, 0x7b, 0x6b, 0xcf,
+	0xe5, 0x05, 0xf2, 0x12, 0xaa, 0x5c, 0x27, 0xfc, 0x8f, 0x14, 0x98, 0x5f, 0xef, 0xf5, 0x9d, 0x3d,
+	0x56, 0x79, 0xd7, 0x61, 0xdc, 0xc5, 0x7d, 0xcb, 0x68, 0x63, 0x51, 0xe3, 0xed, 0x37, 0xa3, 0x25,
+	0xcf, 0xe5, 0xe7, 0x2f, 0x79, 0xfe, 0xb4, 0x0c, 0x07, 0xe3, 0x64, 0x0e, 0xd5, 0xc3, 0x35, 0x2a,
+	0x55, 0x03, 0xfb, 0xb1, 0x6f, 0x02, 0x53, 0xc5, 0x9f, 0x92, 0xe1, 0x1a, 0x37, 0x68, 0x0f, 0x5d,
+	0x74, 0xa4, 0x42, 0x6b, 0x32, 0x2c, 0xdc, 0x11, 0xf2, 0x1e, 0xb4, 0x79, 0x82, 0xc1, 0xa4, 0x37,
+	0x7a, 0x6e, 0xac, 0x45, 0x8b, 0xf6, 0x11, 0x2c, 0xe0, 0x41, 0xf4, 0x9a, 0x1e, 0xb4, 0xd5, 0x37,
+	0x61, 0xfc, 0xb6, 0x61, 0x5a, 0x83, 0x21, 0x37, 0x8a, 0x0c, 0x37, 0x51, 0xfd, 0x44, 0x81, 0x0a,
+	0x23, 0x90, 0xd5, 0xdc, 0xd2, 0x1f, 0x22, 0x94, 0xca, 0x1b, 0x31, 0x72, 0x45, 0xfd, 0x44, 0x40,
+	0xee, 0x1d, 0xa8, 0x75, 0xf9, 0xd4, 0xfe, 0x7e, 0x9c, 0x2b, 0xc2, 0x0f, 0x41, 0xae, 0x1e, 0x74,
+	0xd6, 0xb6, 0xa1, 0x7e, 0xeb, 0x09, 0xc5, 0xdc, 0xbb, 0x51, 0x91, 0x8b, 0x69, 0x3c, 0xdd, 0x52,
+	0x4e, 0xa6, 0x5b, 0x7e, 0xaa, 0xc0, 0x61, 0xc9, 0xc4, 0x42, 0x0c, 0xd6, 0x93, 0x05, 0xf6, 0xcd,
+	0xc4, 0xea, 0x32, 0xbb, 0xa6, 0x12, 0xab, 0x8f, 0xf7, 0xb3, 0x60, 0x6f, 0x2c, 0x91, 0x32, 0xfd,
+	0x61, 0x09, 0xe6, 0x6f, 0xb8, 0x98, 0x87, 0xd8, 0x37, 0x07, 0x41, 0x3a, 0x7b, 0x09, 0x26, 0x2c,
+	0x06, 0x08, 0x99, 0x58, 0xe3, 0x80, 0xf5, 0x0e, 0x95, 0x6c, 0xfe, 0x5b, 0x78, 0x4c, 0x49, 0xc9,
+	0x96, 0x0c, 0xd8, 0x10, 0x2d, 0xd1, 0x51, 0xfd, 0x4b, 0x05, 0xaa, 0x1c, 0x44, 0x57, 0x42, 0x4c,
+	0xce, 0x38, 0x9e, 0xd8, 0xa1, 0x0d, 0x6a, 0x37, 0x3b, 0xe6, 0xb6, 0xe9, 0x99, 0x8e, 0xcd, 0x37,
+	0x44, 0x9c, 0xf0, 0x3e, 0x90, 0xee, 0x09, 0x0d, 0x6f, 0xf5, 0x5d, 0xa7, 0xe7, 0xd0, 0x90, 0x4e,
+	0x8b, 0x17, 0x81, 0xf3, 0x95, 0xcf, 0x04, 0xe0, 0x1b, 0x14, 0x4a, 0x23, 0x43, 0x2e, 0xb6, 0xf0,
+	0xa6, 0x11, 0xc1, 0xe4, 0xac, 0x98, 0x0d, 0xe1, 0x1c, 0x55, 0x7e, 0x10, 0x37, 0x59, 0xe1, 0x74,
+	0x71, 0x1e, 0x69, 0xff, 0x1a, 0x2e, 0x50, 0x05, 0x1f, 0x7c, 0x33, 0x81, 0xc6, 0x54, 0xcd, 0x0b,
+	0x55, 0xad, 0xa2, 0x8b, 0x56, 0xc8, 0x94, 0x72, 0x2e, 0x53, 0xc6, 0x8a, 0x31, 0xa5, 0x52, 0x98,
+	0x29, 0xd5, 0x21, 0x4c, 0x19, 0x8f, 0x32, 0xe5, 0x4b, 0x30, 0xe3, 0x73, 0x64, 0xa8, 0x35, 0x3c,
+	0x9f, 0x90, 0x99, 0x85, 0x74, 0x98, 0x2a, 0x22, 0x1f, 0x9a, 0x0d, 0x07, 0xbe, 0xe8, 0x98, 0xf6,
+	0x08, 0x42, 0x39, 0x92, 0xdb, 0x4b, 0xd9, 0xe9, 0x2b, 0x09, 0xfd, 0xad, 0x19, 0xcc, 0xcd, 0xe5,
+	0xd3, 0xdd, 0x14, 0xdc, 0x7c, 0xb1, 0xd3, 0x6a, 0x3f, 0x51, 0x60, 0x26, 0x3e, 0xc1, 0x9e, 0x24,
+	0x43, 0x42, 0x3d, 0x1d, 0xc7, 0x17, 0x01, 0x5f, 0xc3, 0xfd, 0x76, 0x3a, 0x7d, 0x50, 0x91, 0xa5,
+	0x0f, 0x22, 0x59, 0xfd, 0x6a, 0xa1, 0xac, 0x7e, 0x0f, 0x16, 0x93, 0xdc, 0x1a, 0x2a, 0x03, 0xaf,
+	0x45, 0xc8, 0xe4, 0x52, 0x70, 0x54, 0x2a, 0x05, 0xc1, 0x90, 0x01, 0xba, 0x76, 0x1f, 0x16, 0x6f,
+	0xd9, 0x1d, 0xfe, 0xf9, 0x21, 0x63, 0x44, 0xa1, 0xdd, 0xc9, 0x60, 0xa2, 0xf6, 0x77, 0x0a, 0x1c,
+	0x4a, 0x8d, 0x57, 0xa4, 0xfc, 0x34, 0xd8, 0xae, 0x52, 0xe6, 0x76, 0x95, 0x63, 0xdb, 0xa5, 0xb2,
+	0x7c, 0x6d, 0xcf, 0x21, 0xe2, 0xa0, 0xae, 0xe8, 0x41, 0x9b, 0x26, 0xbc, 0x85, 0xa2, 0x85, 0x09,
+	0xef, 0x00, 0x20, 0xd2, 0xe4, 0xbb, 0xb8, 0x23, 0x94, 0x52, 0xb4, 0xb4, 0x36, 0xcc, 0x3e, 0x72,
+	0x06, 0xae, 0x6d, 0xf4, 0xb0, 0x4d, 0x1e, 0xb8, 0x54, 0xe5, 0x97, 0x60, 0x82, 0x7a, 0xc6, 0xdc,
+	0xc1, 0xe4, 0x66, 0xb4, 0x46, 0x01, 0xcc, 0xb9, 0x3c, 0x04, 0xe3, 0xc4, 0x89, 0xde, 0x92, 0xaa,
+	0xc4, 0xf1, 0x5f, 0x3c, 0xb9, 0x78, 0x87, 0x0b, 0x85, 0xc8, 0xd1, 0xfb, 0x6d, 0xed, 0x07, 0x25,
+	0x38, 0xc4, 0xcd, 0x78, 0x38, 0x97, 0xcf, 0xf1, 0x15, 0x98, 0x26, 0x01, 0x30, 0xe4, 0xfa, 0x54,
+	0x08, 0x5c, 0xef, 0xa0, 0x9f, 0x03, 0x08, 0xdb, 0x62, 0xb7, 0x2f, 0x4a, 0xcf, 0x89, 0xd4, 0x04,
+	0x8d, 0x08, 0x24, 0x32, 0x88, 0xfa, 0x0d, 0x05, 0x20, 0xfc, 0x84, 0x0e, 0x43, 0x2d, 0x48, 0x4e,
+	0xf1, 0x6b, 0xf7, 0xb8, 0x27, 0xf2, 0x52, 0x0b, 0x50, 0x15, 0x19, 0x29, 0x11, 0x13, 0xc5, 0x2c,
+	0x19, 0x25, 0x2f, 0xb3, 0xba, 0x02, 0xd5, 0x3e, 0xe5, 0xa2, 0x7f, 0x13, 0x3a, 0x96, 0xa0, 0x32,
+	0xc1, 0x6c, 0x5d, 0x60, 0x6b, 0xaf, 0x8b, 0x52, 0xdc, 0xbd, 0xb0, 0x47, 0xeb, 0xc2, 0x02, 0xb5,
+	0x6f, 0x7b, 0x64, 0x6e, 0x71, 0xa3, 0xf3, 0x05, 0x38, 0x7c, 0xdb, 0xb4, 0x0d, 0xcb, 0xfc, 0x68,
+	0x8f, 0x1b, 0xa9, 0xfd, 0x47, 0x9c, 0xeb, 0x1a, 0x44, 0x3f, 0xdf, 0x94, 0x74, 0xb9, 0x19, 0xdb,
+	0x99, 0x52, 0xd6, 0xce, 0x94, 0xa5, 0x3b, 0x33, 0x26, 0xdf, 0x99, 0xca, 0x28, 0x3b, 0x13, 0x29,
+	0x30, 0xa9, 0xc6, 0x0a, 0x4c, 0x4e, 0xc0, 0x54, 0x57, 0x30, 0x23, 0x52, 0x2e, 0x32, 0x19, 0xc0,
+	0xd6, 0x88, 0x66, 0x02, 0x8a, 0xf2, 0xa9, 0x80, 0x49, 0x4b, 0x8b, 0xf9, 0xe1, 0x4c, 0x32, 0xa3,
+	0xe2, 0xac, 0x11, 0x98, 0x0b, 0xbf, 0x7c, 0x60, 0xda, 0xf6, 0x0b, 0xf3, 0xf8, 0xa2, 0x8a, 0x3d,
+	0x96, 0x50, 0xec, 0xbf, 0x50, 0x40, 0x95, 0x49, 0xc4, 0x3e, 0xae, 0x14, 0xbd, 0x06, 0xe3, 0x3b,
+	0x6c, 0x7d, 0xbe, 0xeb, 0x7f, 0x3c, 0xb3, 0x1f, 0xe7, 0x83, 0xee, 0xe3, 0x6b, 0xef, 0xc0, 0xd2,
+	0x1d, 0x4c, 0x3e, 0xc0, 0x1b, 0x5b, 0x8e, 0x43, 0x6f, 0xa9, 0xe6, 0x36, 0x76, 0xcd, 0xf0, 0x96,
+	0xe8, 0x87, 0x42, 0x95, 0xac, 0x50, 0x68, 0x29, 0x71, 0xdd, 0xfc, 0xe5, 0x32, 0x1c, 0x91, 0x0f,
+	0x38, 0x94, 0x01, 0x5f, 0x01, 0xe8, 0x04, 0xf8, 0xe2, 0x4e, 0xf7, 0x7a, 0x3a, 0xe3, 0x93, 0x39,
+	0x74, 0x23, 0xfe, 0x65, 0x57, 0x8f, 0x0c, 0xa7, 0xfe, 0xbb, 0x02, 0xb3, 0x89, 0xef, 0xa9, 0x24,
+	0xe3, 0x1c, 0x94, 0x07, 0xae, 0xe5, 0xbf, 0x4c, 0x19, 0xb8, 0x16, 0x5d, 0x2a, 0xde, 0xe6, 0xba,
+	0xeb, 0xf9, 0x2f, 0x7f, 0x18, 0x80, 0xd6, 0xf8, 0xd6, 0x61, 0xdc, 0x20, 0x04, 0xf7, 0xfa, 0xbe,
+	0xab, 0xeb, 0x37, 0x59, 0x9c, 0x80, 0x69, 0x44, 0xab, 0xed, 0x74, 0xfc, 0x70, 0x31, 0x70, 0xd0,
+	0x0d, 0xa7, 0xc3, 0x5c, 0x72, 0xec, 0xba, 0x4e, 0x50, 0x8f, 0xc6, 0x1a, 0xcc, 0xfb, 0xc4, 0x46,
+	0xa7, 0x65, 0x61, 0x42, 0xb0, 0x8b, 0x3b, 0x22, 0x4b, 0x3f, 0x45, 0x81, 0xf7, 0x04, 0x8c, 0xea,
+	0x98, 0x98, 0x26, 0x1a, 0x3f, 0x9f, 0x0c, 0x60, 0x6b, 0xe4, 0xd2, 0x7f, 0x5f, 0x86, 0xea, 0x03,
+	0xc6, 0x36, 0xf4, 0x08, 0x26, 0x23, 0x2f, 0xd3, 0xd1, 0x89, 0x64, 0x94, 0x30, 0xf5, 0x96, 0x5d,
+	0xd5, 0xf2, 0x50, 0xc4, 0x1e, 0xbe, 0x05, 0x55, 0xfe, 0x62, 0x1d, 0x2d, 0xa6, 0x22, 0x29, 0xb7,
+	0xe8, 0x6b, 0x7a, 0x35, 0xe9, 0x77, 0x24, 0x1e, 0xb8, 0x7f, 0x4d, 0x81, 0x03, 0xa9, 0x27, 0x3f,
+	0xe8, 0x4c, 0xa2, 0x53, 0xd6, 0x23, 0x77, 0x75, 0x75, 0x38, 0x22, 0x9f, 0x48, 0x5b, 0xfa, 0xe4,
+	0xa7, 0xff, 0xf6, 0xbd, 0xd2, 0xc2, 0xd9, 0xf9, 0xa6, 0xd5, 0x7c, 0x1a, 0xbf, 0xba, 0x3e, 0x43,
+	0xbf, 0xa5, 0xc0, 0x5c, 0x32, 0x48, 0x81, 0x4e, 0x17, 0x8b, 0x62, 0xa8, 0x67, 0x0a, 0xd6, 0xf5,
+	0x6a, 0x17, 0x19, 0x09, 0xe7, 0x54, 0x55, 0x42, 0x42, 0x93, 0xa7, 0x37, 0xaf, 0xc5, 0x1f, 0xc4,
+	0xa3, 0x1f, 0x28, 0x30, 0x19, 0x19, 0x2b, 0xb5, 0x6d, 0xe9, 0x87, 0xd0, 0xaa, 0x96, 0x87, 0x22,
+	0x28, 0xf9, 0x22, 0xa3, 0xe4, 0xa6, 0xfa, 0x8a, 0x8c, 0x12, 0xe1, 0x77, 0x36, 0x9f, 0x26, 0x0f,
+	0x3e, 0x41, 0xe4, 0xb5, 0xd8, 0x0b, 0x6d, 0xf4, 0x89, 0x02, 0x53, 0xd1, 0x77, 0xc4, 0x48, 0x4b,
+	0x99, 0x9c, 0xd4, 0x13, 0x65, 0x75, 0x25, 0x17, 0x47, 0x50, 0xf9, 0x12, 0xa3, 0x72, 0x05, 0x9d,
+	0xc8, 0xa1, 0xf2, 0x3c, 0xbb, 0x4c, 0xa1, 0xdf, 0x57, 0x60, 0x26, 0xfe, 0x04, 0x14, 0x9d, 0x2c,
+	0xf2, 0x02, 0x58, 0x3d, 0x35, 0x04, 0x4b, 0x90, 0x72, 0x9d, 0x91, 0xf2, 0xc6, 0xa5, 0xbd, 0x31,
+	0x8c, 0xbf, 0x30, 0xfe, 0x55, 0x05, 0x26, 0x82, 0x00, 0x3f, 0x3a, 0x9e, 0xf5, 0xfa, 0xd0, 0xa7,
+	0x6c, 0x39, 0x1b, 0x41, 0x10, 0x75, 0x85, 0x11, 0x75, 0x01, 0x35, 0x46, 0x23, 0x0a, 0x6d, 0x03,
+	0x04, 0x83, 0x79, 0x68, 0x39, 0xe7, 0x19, 0x24, 0xa7, 0xe4, 0xc4, 0xd0, 0x87, 0x92, 0xda, 0x0a,
+	0x23, 0xe5, 0x28, 0x5a, 0xca, 0x21, 0x05, 0x7d, 0x9b, 0x55, 0x00, 0x87, 0xaf, 0xe8, 0x52, 0x92,
+	0x22, 0x79, 0xb4, 0xa8, 0xae, 0xe4, 0xe2, 0xc4, 0x39, 0x71, 0x76, 0x54, 0x4e, 0xfc, 0x12, 0x4c,
+	0x47, 0xc7, 0xf3, 0x50, 0xde, 0x6c, 0x01, 0x3f, 0x4e, 0xe6, 0x23, 0xc5, 0x59, 0x72, 0x36, 0x97,
+	0x25, 0xbf, 0xa2, 0xc0, 0xb8, 0xc8, 0x3a, 0xa0, 0xa3, 0xf2, 0x87, 0x1a, 0xfe, 0xac, 0xc7, 0xb2,
+	0x3e, 0x8b, 0xf9, 0x5e, 0x67, 0xf3, 0x7d, 0x0e, 0x5d, 0x1e, 0x51, 0x44, 0x99, 0x1f, 0xf3, 0x7b,
+	0x0a, 0xcc, 0x06, 0xa5, 0xf6, 0x62, 0x77, 0x4e, 0xa5, 0x27, 0x94, 0x3c, 0xef, 0x53, 0x4f, 0x0f,
+	0x43, 0x13, 0xf4, 0xbd, 0xc9, 0xe8, 0x7b, 0x15, 0x7d, 0x6e, 0x44, 0xfa, 0x0c, 0x36, 0x18, 0xfa,
+	0x8e, 0x02, 0x33, 0xc1, 0xd0, 0x72, 0x0d, 0x97, 0x3e, 0x17, 0x51, 0x4f, 0x0d, 0xc1, 0x8a, 0x1b,
+	0x67, 0xf4, 0x52, 0xb6, 0x71, 0x6e, 0x3e, 0x65, 0x7f, 0x03, 0x92, 0xbe, 0xae, 0xc0, 0x74, 0xec,
+	0xe9, 0x40, 0x4a, 0x7c, 0x64, 0x0f, 0x08, 0xd5, 0x93, 0xf9, 0x48, 0x82, 0x9e, 0xf3, 0x8c, 0x9e,
+	0x33, 0xe8, 0x94, 0x8c, 0x1e, 0xe2, 0xf4, 0x9b, 0x4f, 0x23, 0xcf, 0x0b, 0x9f, 0xa1, 0xef, 0xf3,
+	0xff, 0x37, 0x10, 0x7b, 0x62, 0x80, 0x4e, 0x4b, 0x67, 0x4a, 0x3d, 0x1e, 0x54, 0xcf, 0x0c, 0xc5,
+	0x13, 0x44, 0xbd, 0xc2, 0x88, 0x6a, 0xa0, 0x97, 0x33, 0x88, 0x3a, 0x2f, 0x9e, 0x12, 0x36, 0x9f,
+	0x86, 0x6f, 0x0a, 0x9f, 0xa1, 0x7f, 0x54, 0xe0, 0x48, 0xde, 0x53, 0x33, 0x74, 0x69, 0xf4, 0x87,
+	0x7b, 0xea, 0xe5, 0x3d, 0xbc, 0x65, 0xd3, 0xae, 0x32, 0xfa, 0x2f, 0xa9, 0x47, 0x9a, 0xbd, 0x4c,
+	0x6b, 0xed, 0x5d, 0x93, 0xbc, 0x30, 0xa4, 0x07, 0x4c, 0x3d, 0xeb, 0xe5, 0x14, 0x6a, 0x14, 0x7e,
+	0x62, 0xc5, 0x69, 0x6f, 0x8e, 0xf8, 0x24, 0x4b, 0x3b, 0xc9, 0xe8, 0x3e, 0x86, 0x72, 0xe9, 0x46,
+	0xf4, 0x7f, 0x4e, 0x24, 0xeb, 0xdd, 0x53, 0x32, 0x90, 0xf1, 0x72, 0x43, 0x3d, 0x33, 0x14, 0x4f,
+	0xd0, 0x72, 0x81, 0xd1, 0x72, 0x56, 0x3b, 0x2c, 0x93, 0x01, 0xf6, 0xe2, 0xe3, 0x5a, 0xfc, 0x09,
+	0x09, 0xfa, 0x13, 0x85, 0x5d, 0x2d, 0xb2, 0x4a, 0xf1, 0xd1, 0x45, 0x09, 0x3b, 0xf2, 0xdf, 0x5a,
+	0xa8, 0x97, 0x46, 0xe9, 0x22, 0x08, 0x3f, 0xc1, 0x08, 0x5f, 0x42, 0xd9, 0x84, 0xa3, 0xcf, 0x78,
+	0xc5, 0x89, 0xac, 0x36, 0xf8, 0xe5, 0xf4, 0x8c, 0xd9, 0xa5, 0xf2, 0xea, 0xd9, 0x02, 0xa5, 0x81,
+	0xe9, 0xcd, 0x95, 0x59, 0x1e, 0x7f, 0xfe, 0x3f, 0x55, 0xe0, 0x70, 0x66, 0x85, 0x3e, 0x6a, 0x8e,
+	0x58, 0xcb, 0x3f, 0x12, 0x81, 0x0d, 0x46, 0xe0, 0xaa, 0x9a, 0x4b, 0xe0, 0xb5, 0xa0, 0xd2, 0x11,
+	0x7d, 0x43, 0x81, 0x03, 0xa9, 0x52, 0xc7, 0x94, 0x4f, 0x9f, 0x55, 0x0c, 0x39, 0x12, 0x69, 0x1a,
+	0x23, 0xed, 0x88, 0x26, 0x75, 0xa9, 0x79, 0xa5, 0x24, 0xfa, 0x2e, 0xfd, 0x97, 0x27, 0xe9, 0xb2,
+	0x49, 0x94, 0xcc, 0xa5, 0x64, 0x97, 0x56, 0xee, 0x65, 0x3b, 0x35, 0x29, 0xb7, 0x06, 0x62, 0x0e,
+	0xf4, 0x3d, 0xfe, 0x6f, 0x3f, 0xe2, 0x45, 0x8b, 0xe8, 0xcc, 0xf0, 0xb2, 0x46, 0xf9, 0xbd, 0x27,
+	0xb3, 0xfe, 0x51, 0x3b, 0xc7, 0xc8, 0x39, 0x85, 0x56, 0xe4, 0x52, 0xcf, 0xfb, 0x9c, 0x17, 0x16,
+	0xe4, 0x9b, 0x0a, 0x4c, 0x46, 0x6a, 0xf1, 0x52, 0xd7, 0x8d, 0x74, 0x2d, 0xa2, 0xba, 0x2c, 0x43,
+	0x89, 0x16, 0xc9, 0x69, 0xaf, 0x31, 0x0a, 0x2e, 0xab, 0x52, 0xe7, 0x8c, 0xd5, 0xe6, 0xe1, 0x8e,
+	0xc4, 0xaa, 0x5d, 0x53, 0xce, 0xa2, 0x6f, 0x29, 0x30, 0x1d, 0xab, 0xdf, 0x4b, 0x9d, 0xb0, 0xb2,
+	0x12, 0xc5, 0x02, 0x34, 0xe5, 0x3a, 0x8c, 0xd9, 0x34, 0xa1, 0x5f, 0x53, 0x60, 0x5e, 0x52, 0xac,
+	0x98, 0x92, 0xa5, 0xec, 0x82, 0xc6, 0x02, 0xc4, 0x9d, 0x66, 0xc4, 0x2d, 0xab, 0x4b, 0x43, 0xb8,
+	0xf3, 0xeb, 0x0a, 0x2c, 0x48, 0xab, 0x1b, 0xd1, 0xb9, 0x3c, 0x2e, 0x8d, 0x4e, 0x50, 0xe8, 0xca,
+	0xe6, 0xb0, 0x46, 0xb8, 0x90, 0xd1, 0xda, 0x37, 0x99, 0x0b, 0x29, 0xa9, 0xe6, 0x53, 0x4f, 0x0f,
+	0x43, 0x7b, 0x4e, 0x17, 0x92, 0x57, 0x99, 0xb3, 0x4b, 0x62, 0xbc, 0x64, 0x2d, 0xe5, 0x42, 0x4a,
+	0x2b, 0xea, 0xd4, 0x53, 0x43, 0xb0, 0xe2, 0x97, 0x44, 0xed, 0xd5, 0x11, 0xc9, 0x73, 0xc5, 0x70,
+	0x74, 0x4f, 0xff, 0x30, 0xfa, 0x7f, 0xa3, 0x44, 0xe9, 0x16, 0xca, 0xe4, 0x4f, 0xbc, 0x6c, 0x4d,
+	0x3d, 0x33, 0x14, 0x4f, 0x50, 0xfa, 0x79, 0x46, 0xe9, 0x55, 0x74, 0x65, 0x44, 0x4a, 0xc5, 0x03,
+	0x05, 0xf4, 0x31, 0x4c, 0xfb, 0x3e, 0x0b, 0xab, 0x95, 0x92, 0x39, 0xbe, 0xa9, 0xca, 0x2e, 0xf5,
+	0x64, 0x3e, 0x52, 0xdc, 0xa4, 0x23, 0xa9, 0x49, 0xef, 0xf1, 0xe9, 0x76, 0x60, 0x36, 0x51, 0xec,
+	0x94, 0x12, 0x35, 0x79, 0x31, 0x94, 0x7a, 0x58, 0x9a, 0x6c, 0xa3, 0xc8, 0xda, 0x32, 0x9b, 0x58,
+	0x45, 0x75, 0xd9, 0xc4, 0x1d, 0xb3, 0xdb, 0xbd, 0xa0, 0xa0, 0x5f, 0x80, 0xd9, 0x44, 0xdd, 0x4e,
+	0x6a, 0x62, 0x79, 0x5d, 0x4f, 0x31, 0xaf, 0xff, 0x82, 0x82, 0xbe, 0x04, 0x53, 0xd1, 0xf2, 0x8c,
+	0xd4, 0x1d, 0x59, 0x52, 0xc1, 0xa3, 0xae, 0xe4, 0xe2, 0xf0, 0xa1, 0x57, 0x15, 0xb4, 0x05, 0x07,
+	0x52, 0xb5, 0x11, 0xa9, 0x23, 0x27, 0xab, 0xe2, 0x43, 0x5d, 0x1d, 0x8e, 0x18, 0x2c, 0x62, 0x07,
+	0xa6, 0xa2, 0x95, 0x09, 0xa9, 0x45, 0x48, 0xca, 0x16, 0x54, 0x79, 0x82, 0x32, 0x75, 0xac, 0x1e,
+	0x6c, 0xf2, 0x44, 0xa0, 0xd7, 0x7c, 0x1a, 0x24, 0x23, 0x9f, 0x5d, 0x13, 0xc9, 0x6c, 0x64, 0xb2,
+	0x10, 0x8b, 0x98, 0xf5, 0xb8, 0xd4, 0x65, 0x2b, 0x3e, 0xe5, 0x11, 0x36, 0xe5, 0x22, 0x92, 0x4e,
+	0x49, 0xcf, 0x26, 0x08, 0x13, 0xe7, 0xa9, 0x30, 0x4a, 0x2a, 0xa7, 0x9e, 0xb2, 0x22, 0xf2, 0xa4,
+	0xae, 0xf6, 0x2a, 0x9b, 0xf5, 0xa2, 0xd6, 0x94, 0xcd, 0x9a, 0x17, 0xcc, 0xf8, 0x21, 0x77, 0x29,
+	0xe2, 0xc3, 0xca, 0x5c, 0x0a, 0x69, 0xee, 0xbd, 0x28, 0x79, 0x5f, 0x60, 0xe4, 0x5d, 0x43, 0x57,
+	0x47, 0x24, 0xaf, 0x19, 0xa4, 0xc9, 0xbf, 0xae, 0xc0, 0x6c, 0x22, 0x23, 0x9c, 0x52, 0x22, 0x79,
+	0x06, 0x5a, 0x3d, 0x3d, 0x0c, 0x4d, 0x10, 0x79, 0x86, 0x11, 0x79, 0x42, 0x3b, 0x2e, 0x25, 0x12,
+	0xdb, 0x9d, 0xf3, 0x22, 0x67, 0xfc, 0x6d, 0x05, 0xe6, 0x92, 0xb9, 0xd1, 0x94, 0xb9, 0xcd, 0x48,
+	0x9e, 0xa6, 0xe2, 0x62, 0xe9, 0x24, 0x4f, 0x70, 0x59, 0x3a, 0xd2, 0x0c, 0x93, 0x34, 0x5e, 0xf3,
+	0x69, 0x2c, 0x17, 0xf8, 0xec, 0x5a, 0x34, 0x83, 0xf3, 0x4c, 0xc4, 0x14, 0x02, 0x80, 0x34, 0xa6,
+	0xb0, 0x07, 0x52, 0xc2, 0x6b, 0x46, 0x0e, 0x29, 0xe8, 0xb7, 0x15, 0x98, 0x89, 0xa7, 0x4b, 0x53,
+	0x67, 0xa4, 0x34, 0x9b, 0x5a, 0x84, 0x82, 0xb7, 0x18, 0x05, 0xaf, 0x69, 0xaf, 0xe6, 0x51, 0x90,
+	0x27, 0xe1, 0x9f, 0x29, 0x80, 0xd2, 0x19, 0x35, 0x94, 0xb4, 0x4c, 0x99, 0x69, 0x58, 0xf5, 0xa5,
+	0x02, 0x98, 0xf1, 0xf8, 0x8b, 0x76, 0x2a, 0x97, 0x58, 0x3f, 0xa1, 0x89, 0x44, 0x39, 0x73, 0x2a,
+	0x25, 0x85, 0xce, 0x16, 0xca, 0x5b, 0x71, 0xf2, 0xce, 0x8d, 0x90, 0xe3, 0x8a, 0x58, 0xa7, 0x1d,
+	0x8e, 0xe3, 0x35, 0xc3, 0x2c, 0xd7, 0xf5, 0x47, 0x70, 0xac, 0xed, 0xf4, 0x1a, 0xc4, 0xe9, 0xd3,
+	0x0b, 0x07, 0x7d, 0xb6, 0xe8, 0xc5, 0x07, 0xbf, 0x3e, 0xc9, 0x13, 0x43, 0x0f, 0x5c, 0x87, 0x38,
+	0x0f, 0x94, 0x2f, 0xc7, 0xff, 0x73, 0xf2, 0x1f, 0x94, 0xca, 0x0f, 0xd6, 0x3e, 0xfc, 0xf3, 0xd2,
+	0x34, 0x47, 0x6a, 0xac, 0xf5, 0xcd, 0xc6, 0xfb, 0x17, 0x37, 0xaa, 0x2c, 0xb9, 0x73, 0xf9, 0x7f,
+	0x06, 0x00, 0x12, 0xb6, 0x31, 0xa3, 0x89, 0x59, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PodiumClient is the client API for Podium service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PodiumClient interface {
+	// HealthCheck verifies and returns service health.
+	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+	// Status allows to clients to know additional information about Podium execution.
+	// Currently only returns error rate of the service.
+	Status(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*StatusResponse, error)
+	// RemoveLeaderboard removes a specified leaderboard.
+	RemoveLeaderboard(ctx context.Context, in *RemoveLeaderboardRequest, opts ...grpc.CallOption) (*RemoveLeaderboardResponse, error)
+	// BulkUpsertScores allows clients to send multiple scores in a single request.
+	BulkUpsertScores(ctx context.Context, in *BulkUpsertScoresRequest, opts ...grpc.CallOption) (*BulkUpsertScoresResponse, error)
+	// UpsertScore submits a single leaderboard score to Podium.
+	UpsertScore(ctx context.Context, in *UpsertScoreRequest, opts ...grpc.CallOption) (*UpsertScoreResponse, error)
+	// TotalMembers returns the number of members on a leaderboard.
+	TotalMembers(ctx context.Context, in *TotalMembersRequest, opts ...grpc.CallOption) (*TotalMembersResponse, error)
+	// IncrementScore increments a member score.
+	IncrementScore(ctx context.Context, in *IncrementScoreRequest, opts ...grpc.CallOption) (*IncrementScoreResponse, error)
+	// GetMember retrieves leaderboard information from a member.
+	GetMember(ctx context.Context, in *GetMemberRequest, opts ...grpc.CallOption) (*GetMemberResponse, error)
+	// GetMembers retrieves information about multiple members of a leaderboard.
+	GetMembers(ctx context.Context, in *GetMembersRequest, opts ...grpc.CallOption) (*GetMembersResponse, error)
+	// RemoveMember removes a member from a leaderboard.
+	RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error)
+	// RemoveMembers allows the removal of multiple members of a leaderboard.
+	RemoveMembers(ctx context.Context, in *RemoveMembersRequest, opts ...grpc.CallOption) (*RemoveMembersResponse, error)
+	// GetRank retrieves ranking information about a member.
+	GetRank(ctx context.Context, in *GetRankRequest, opts ...grpc.CallOption) (*GetRankResponse, error)
+	// GetAroundMember retrieves the closest members to another member on the leaderboard.
+	GetAroundMember(ctx context.Context, in *GetAroundMemberRequest, opts ...grpc.CallOption) (*GetAroundMemberResponse, error)
+	// GetAroundScore retrieves the closest members to a score on the leaderboard.
+	GetAroundScore(ctx context.Context, in *GetAroundScoreRequest, opts ...grpc.CallOption) (*GetAroundScoreResponse, error)
+	// GetTopMembers retrieves the top ranking members of a leaderboard.
+	GetTopMembers(ctx context.Context, in *GetTopMembersRequest, opts ...grpc.CallOption) (*GetTopMembersResponse, error)
+	// GetTopPercentage retrieves a percentage of the top members of the leaderboard.
+	GetTopPercentage(ctx context.Context, in *GetTopPercentageRequest, opts ...grpc.CallOption) (*GetTopPercentageResponse, error)
+	// UpsertScoreMultiLeaderboards sends a member score to multiple leaderboards.
+	UpsertScoreMultiLeaderboards(ctx context.Context, in *UpsertScoreMultiLeaderboardsRequest, opts ...grpc.CallOption) (*UpsertScoreMultiLeaderboardsResponse, error)
+	// GetRankMultiLeaderboards retrieves information about a member in multiple leaderboards.
+	GetRankMultiLeaderboards(ctx context.Context, in *GetRankMultiLeaderboardsRequest, opts ...grpc.CallOption) (*GetRankMultiLeaderboardsResponse, error)
+	// ResetLeaderboard resets the scores of a leaderboard using a season carry-over strategy.
+	ResetLeaderboard(ctx context.Context, in *ResetLeaderboardRequest, opts ...grpc.CallOption) (*ResetLeaderboardResponse, error)
+	// GetResetLeaderboardProgress retrieves the progress of the last reset applied to a leaderboard.
+	GetResetLeaderboardProgress(ctx context.Context, in *GetResetLeaderboardProgressRequest, opts ...grpc.CallOption) (*GetResetLeaderboardProgressResponse, error)
+	// GetLeaderboardSettings retrieves the settings of a leaderboard.
+	GetLeaderboardSettings(ctx context.Context, in *GetLeaderboardSettingsRequest, opts ...grpc.CallOption) (*LeaderboardSettingsResponse, error)
+	// UpdateLeaderboardSettings replaces the settings of a leaderboard.
+	UpdateLeaderboardSettings(ctx context.Context, in *UpdateLeaderboardSettingsRequest, opts ...grpc.CallOption) (*LeaderboardSettingsResponse, error)
+	// FreezeLeaderboard rejects every write to a leaderboard until it is unfrozen, reads keep working.
+	FreezeLeaderboard(ctx context.Context, in *FreezeLeaderboardRequest, opts ...grpc.CallOption) (*LeaderboardSettingsResponse, error)
+	// UnfreezeLeaderboard accepts writes to a frozen leaderboard again.
+	UnfreezeLeaderboard(ctx context.Context, in *UnfreezeLeaderboardRequest, opts ...grpc.CallOption) (*LeaderboardSettingsResponse, error)
+	// GetRejectedScores retrieves the last scores rejected by the rules of a leaderboard, for review.
+	GetRejectedScores(ctx context.Context, in *GetRejectedScoresRequest, opts ...grpc.CallOption) (*GetRejectedScoresResponse, error)
+	// BlockMember blocks a member in a leaderboard, rejecting or shadowing its writes and removing it from the leaderboard results.
+	BlockMember(ctx context.Context, in *BlockMemberRequest, opts ...grpc.CallOption) (*BlocklistResponse, error)
+	// UnblockMember unblocks a member in a leaderboard, bringing back its score.
+	UnblockMember(ctx context.Context, in *UnblockMemberRequest, opts ...grpc.CallOption) (*BlocklistResponse, error)
+	// BlockMemberGlobally blocks a member in every leaderboard.
+	BlockMemberGlobally(ctx context.Context, in *BlockMemberGloballyRequest, opts ...grpc.CallOption) (*BlocklistResponse, error)
+	// UnblockMemberGlobally unblocks a member in every leaderboard.
+	UnblockMemberGlobally(ctx context.Context, in *UnblockMemberGloballyRequest, opts ...grpc.CallOption) (*BlocklistResponse, error)
+	// GetMemberLedger retrieves the changes of a member score in a leaderboard since a given time, the oldest first.
+	GetMemberLedger(ctx context.Context, in *GetMemberLedgerRequest, opts ...grpc.CallOption) (*GetMemberLedgerResponse, error)
+	// RollbackMember reverts the changes of a member score in a leaderboard made since a given time.
+	RollbackMember(ctx context.Context, in *RollbackMemberRequest, opts ...grpc.CallOption) (*RollbackMemberResponse, error)
+	// GetMemberHistory retrieves the score and rank samples of a member in a leaderboard within a time range, the oldest first.
+	GetMemberHistory(ctx context.Context, in *GetMemberHistoryRequest, opts ...grpc.CallOption) (*GetMemberHistoryResponse, error)
+	// GetRankMovers retrieves the members that climbed and fell the most positions between two rank snapshots, or a snapshot and now.
+	GetRankMovers(ctx context.Context, in *GetRankMoversRequest, opts ...grpc.CallOption) (*GetRankMoversResponse, error)
+	// DiffLeaderboard streams the members that entered, changed and left a leaderboard between two rank snapshots, or a snapshot and now.
+	DiffLeaderboard(ctx context.Context, in *DiffLeaderboardRequest, opts ...grpc.CallOption) (Podium_DiffLeaderboardClient, error)
+	// WatchTopMembers streams the top members of a leaderboard, sending them again whenever they change.
+	// It is only served over gRPC.
+	WatchTopMembers(ctx context.Context, in *WatchTopMembersRequest, opts ...grpc.CallOption) (Podium_WatchTopMembersClient, error)
+	// ImportScores loads scores sent in chunks, acknowledging each chunk in the response, and can replace the
+	// leaderboard at once with the scores imported. It is only served over gRPC.
+	ImportScores(ctx context.Context, opts ...grpc.CallOption) (Podium_ImportScoresClient, error)
+	// ExportLeaderboard streams every member of a leaderboard in chunks, read from a copy taken when the export
+	// starts so ranks are consistent across chunks. It is only served over gRPC, HTTP downloads use /export/l/.
+	ExportLeaderboard(ctx context.Context, in *ExportLeaderboardRequest, opts ...grpc.CallOption) (Podium_ExportLeaderboardClient, error)
+	// CreateLeague creates a leagues system of division leaderboards starting at season 1.
+	CreateLeague(ctx context.Context, in *CreateLeagueRequest, opts ...grpc.CallOption) (*LeagueResponse, error)
+	// GetLeague retrieves a league configuration and its current season.
+	GetLeague(ctx context.Context, in *GetLeagueRequest, opts ...grpc.CallOption) (*LeagueResponse, error)
+	// JoinLeague places a member in a division of the current league season.
+	JoinLeague(ctx context.Context, in *JoinLeagueRequest, opts ...grpc.CallOption) (*LeagueDivisionResponse, error)
+	// GetLeagueDivision retrieves the division of a member in the current league season with its standings.
+	GetLeagueDivision(ctx context.Context, in *GetLeagueDivisionRequest, opts ...grpc.CallOption) (*LeagueDivisionResponse, error)
+	// EndLeagueSeason promotes and relegates members of each division and starts the next season.
+	EndLeagueSeason(ctx context.Context, in *EndLeagueSeasonRequest, opts ...grpc.CallOption) (*EndLeagueSeasonResponse, error)
+	// CreateTournament creates a tournament over the leaderboard with the same identification.
+	CreateTournament(ctx context.Context, in *CreateTournamentRequest, opts ...grpc.CallOption) (*TournamentResponse, error)
+	// GetTournament retrieves a tournament configuration and its status.
+	GetTournament(ctx context.Context, in *GetTournamentRequest, opts ...grpc.CallOption) (*TournamentResponse, error)
+	// JoinTournament adds a member to the tournament participants.
+	JoinTournament(ctx context.Context, in *JoinTournamentRequest, opts ...grpc.CallOption) (*TournamentResponse, error)
+	// FinalizeTournament freezes the leaderboard of an ended tournament and resolves its prize table.
+	FinalizeTournament(ctx context.Context, in *FinalizeTournamentRequest, opts ...grpc.CallOption) (*FinalizeTournamentResponse, error)
+	// GetWebhookDeliveries retrieves the last attempts to deliver leaderboard lifecycle events to the lifecycle webhook.
+	GetWebhookDeliveries(ctx context.Context, in *GetWebhookDeliveriesRequest, opts ...grpc.CallOption) (*GetWebhookDeliveriesResponse, error)
+}
+
+type podiumClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPodiumClient(cc *grpc.ClientConn) PodiumClient {
+	return &podiumClient{cc}
+}
+
+func (c *podiumClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+	out := new(HealthCheckResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/HealthCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) Status(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*StatusResponse, error) {
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) RemoveLeaderboard(ctx context.Context, in *RemoveLeaderboardRequest, opts ...grpc.CallOption) (*RemoveLeaderboardResponse, error) {
+	out := new(RemoveLeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/RemoveLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) BulkUpsertScores(ctx context.Context, in *BulkUpsertScoresRequest, opts ...grpc.CallOption) (*BulkUpsertScoresResponse, error) {
+	out := new(BulkUpsertScoresResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/BulkUpsertScores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) UpsertScore(ctx context.Context, in *UpsertScoreRequest, opts ...grpc.CallOption) (*UpsertScoreResponse, error) {
+	out := new(UpsertScoreResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/UpsertScore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) TotalMembers(ctx context.Context, in *TotalMembersRequest, opts ...grpc.CallOption) (*TotalMembersResponse, error) {
+	out := new(TotalMembersResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/TotalMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) IncrementScore(ctx context.Context, in *IncrementScoreRequest, opts ...grpc.CallOption) (*IncrementScoreResponse, error) {
+	out := new(IncrementScoreResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/IncrementScore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetMember(ctx context.Context, in *GetMemberRequest, opts ...grpc.CallOption) (*GetMemberResponse, error) {
+	out := new(GetMemberResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetMembers(ctx context.Context, in *GetMembersRequest, opts ...grpc.CallOption) (*GetMembersResponse, error) {
+	out := new(GetMembersResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
 func (c *podiumClient) RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error) {
@@ -2853,446 +7443,1401 @@ func (c *podiumClient) RemoveMember(ctx context.Context, in *RemoveMemberRequest
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *podiumClient) RemoveMembers(ctx context.Context, in *RemoveMembersRequest, opts ...grpc.CallOption) (*RemoveMembersResponse, error) {
+	out := new(RemoveMembersResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/RemoveMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetRank(ctx context.Context, in *GetRankRequest, opts ...grpc.CallOption) (*GetRankResponse, error) {
+	out := new(GetRankResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetRank", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetAroundMember(ctx context.Context, in *GetAroundMemberRequest, opts ...grpc.CallOption) (*GetAroundMemberResponse, error) {
+	out := new(GetAroundMemberResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetAroundMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetAroundScore(ctx context.Context, in *GetAroundScoreRequest, opts ...grpc.CallOption) (*GetAroundScoreResponse, error) {
+	out := new(GetAroundScoreResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetAroundScore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetTopMembers(ctx context.Context, in *GetTopMembersRequest, opts ...grpc.CallOption) (*GetTopMembersResponse, error) {
+	out := new(GetTopMembersResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetTopMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetTopPercentage(ctx context.Context, in *GetTopPercentageRequest, opts ...grpc.CallOption) (*GetTopPercentageResponse, error) {
+	out := new(GetTopPercentageResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetTopPercentage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) UpsertScoreMultiLeaderboards(ctx context.Context, in *UpsertScoreMultiLeaderboardsRequest, opts ...grpc.CallOption) (*UpsertScoreMultiLeaderboardsResponse, error) {
+	out := new(UpsertScoreMultiLeaderboardsResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/UpsertScoreMultiLeaderboards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetRankMultiLeaderboards(ctx context.Context, in *GetRankMultiLeaderboardsRequest, opts ...grpc.CallOption) (*GetRankMultiLeaderboardsResponse, error) {
+	out := new(GetRankMultiLeaderboardsResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetRankMultiLeaderboards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) ResetLeaderboard(ctx context.Context, in *ResetLeaderboardRequest, opts ...grpc.CallOption) (*ResetLeaderboardResponse, error) {
+	out := new(ResetLeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/ResetLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetResetLeaderboardProgress(ctx context.Context, in *GetResetLeaderboardProgressRequest, opts ...grpc.CallOption) (*GetResetLeaderboardProgressResponse, error) {
+	out := new(GetResetLeaderboardProgressResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetResetLeaderboardProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetLeaderboardSettings(ctx context.Context, in *GetLeaderboardSettingsRequest, opts ...grpc.CallOption) (*LeaderboardSettingsResponse, error) {
+	out := new(LeaderboardSettingsResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetLeaderboardSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) UpdateLeaderboardSettings(ctx context.Context, in *UpdateLeaderboardSettingsRequest, opts ...grpc.CallOption) (*LeaderboardSettingsResponse, error) {
+	out := new(LeaderboardSettingsResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/UpdateLeaderboardSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) FreezeLeaderboard(ctx context.Context, in *FreezeLeaderboardRequest, opts ...grpc.CallOption) (*LeaderboardSettingsResponse, error) {
+	out := new(LeaderboardSettingsResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/FreezeLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) UnfreezeLeaderboard(ctx context.Context, in *UnfreezeLeaderboardRequest, opts ...grpc.CallOption) (*LeaderboardSettingsResponse, error) {
+	out := new(LeaderboardSettingsResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/UnfreezeLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetRejectedScores(ctx context.Context, in *GetRejectedScoresRequest, opts ...grpc.CallOption) (*GetRejectedScoresResponse, error) {
+	out := new(GetRejectedScoresResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetRejectedScores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) BlockMember(ctx context.Context, in *BlockMemberRequest, opts ...grpc.CallOption) (*BlocklistResponse, error) {
+	out := new(BlocklistResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/BlockMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) UnblockMember(ctx context.Context, in *UnblockMemberRequest, opts ...grpc.CallOption) (*BlocklistResponse, error) {
+	out := new(BlocklistResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/UnblockMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) BlockMemberGlobally(ctx context.Context, in *BlockMemberGloballyRequest, opts ...grpc.CallOption) (*BlocklistResponse, error) {
+	out := new(BlocklistResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/BlockMemberGlobally", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) UnblockMemberGlobally(ctx context.Context, in *UnblockMemberGloballyRequest, opts ...grpc.CallOption) (*BlocklistResponse, error) {
+	out := new(BlocklistResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/UnblockMemberGlobally", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetMemberLedger(ctx context.Context, in *GetMemberLedgerRequest, opts ...grpc.CallOption) (*GetMemberLedgerResponse, error) {
+	out := new(GetMemberLedgerResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetMemberLedger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) RollbackMember(ctx context.Context, in *RollbackMemberRequest, opts ...grpc.CallOption) (*RollbackMemberResponse, error) {
+	out := new(RollbackMemberResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/RollbackMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetMemberHistory(ctx context.Context, in *GetMemberHistoryRequest, opts ...grpc.CallOption) (*GetMemberHistoryResponse, error) {
+	out := new(GetMemberHistoryResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetMemberHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetRankMovers(ctx context.Context, in *GetRankMoversRequest, opts ...grpc.CallOption) (*GetRankMoversResponse, error) {
+	out := new(GetRankMoversResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetRankMovers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) DiffLeaderboard(ctx context.Context, in *DiffLeaderboardRequest, opts ...grpc.CallOption) (Podium_DiffLeaderboardClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Podium_serviceDesc.Streams[0], "/podium.api.v1.Podium/DiffLeaderboard", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &podiumDiffLeaderboardClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Podium_DiffLeaderboardClient interface {
+	Recv() (*MemberDiff, error)
+	grpc.ClientStream
+}
+
+type podiumDiffLeaderboardClient struct {
+	grpc.ClientStream
+}
+
+func (x *podiumDiffLeaderboardClient) Recv() (*MemberDiff, error) {
+	m := new(MemberDiff)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *podiumClient) WatchTopMembers(ctx context.Context, in *WatchTopMembersRequest, opts ...grpc.CallOption) (Podium_WatchTopMembersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Podium_serviceDesc.Streams[1], "/podium.api.v1.Podium/WatchTopMembers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &podiumWatchTopMembersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Podium_WatchTopMembersClient interface {
+	Recv() (*GetTopMembersResponse, error)
+	grpc.ClientStream
+}
+
+type podiumWatchTopMembersClient struct {
+	grpc.ClientStream
+}
+
+func (x *podiumWatchTopMembersClient) Recv() (*GetTopMembersResponse, error) {
+	m := new(GetTopMembersResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *podiumClient) ImportScores(ctx context.Context, opts ...grpc.CallOption) (Podium_ImportScoresClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Podium_serviceDesc.Streams[2], "/podium.api.v1.Podium/ImportScores", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &podiumImportScoresClient{stream}
+	return x, nil
+}
+
+type Podium_ImportScoresClient interface {
+	Send(*ImportScoresRequest) error
+	CloseAndRecv() (*ImportScoresResponse, error)
+	grpc.ClientStream
+}
+
+type podiumImportScoresClient struct {
+	grpc.ClientStream
+}
+
+func (x *podiumImportScoresClient) Send(m *ImportScoresRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *podiumImportScoresClient) CloseAndRecv() (*ImportScoresResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportScoresResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *podiumClient) ExportLeaderboard(ctx context.Context, in *ExportLeaderboardRequest, opts ...grpc.CallOption) (Podium_ExportLeaderboardClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Podium_serviceDesc.Streams[3], "/podium.api.v1.Podium/ExportLeaderboard", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &podiumExportLeaderboardClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Podium_ExportLeaderboardClient interface {
+	Recv() (*ExportLeaderboardResponse, error)
+	grpc.ClientStream
+}
+
+type podiumExportLeaderboardClient struct {
+	grpc.ClientStream
+}
+
+func (x *podiumExportLeaderboardClient) Recv() (*ExportLeaderboardResponse, error) {
+	m := new(ExportLeaderboardResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *podiumClient) CreateLeague(ctx context.Context, in *CreateLeagueRequest, opts ...grpc.CallOption) (*LeagueResponse, error) {
+	out := new(LeagueResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/CreateLeague", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetLeague(ctx context.Context, in *GetLeagueRequest, opts ...grpc.CallOption) (*LeagueResponse, error) {
+	out := new(LeagueResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetLeague", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) JoinLeague(ctx context.Context, in *JoinLeagueRequest, opts ...grpc.CallOption) (*LeagueDivisionResponse, error) {
+	out := new(LeagueDivisionResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/JoinLeague", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetLeagueDivision(ctx context.Context, in *GetLeagueDivisionRequest, opts ...grpc.CallOption) (*LeagueDivisionResponse, error) {
+	out := new(LeagueDivisionResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetLeagueDivision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) EndLeagueSeason(ctx context.Context, in *EndLeagueSeasonRequest, opts ...grpc.CallOption) (*EndLeagueSeasonResponse, error) {
+	out := new(EndLeagueSeasonResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/EndLeagueSeason", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) CreateTournament(ctx context.Context, in *CreateTournamentRequest, opts ...grpc.CallOption) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/CreateTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetTournament(ctx context.Context, in *GetTournamentRequest, opts ...grpc.CallOption) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) JoinTournament(ctx context.Context, in *JoinTournamentRequest, opts ...grpc.CallOption) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/JoinTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) FinalizeTournament(ctx context.Context, in *FinalizeTournamentRequest, opts ...grpc.CallOption) (*FinalizeTournamentResponse, error) {
+	out := new(FinalizeTournamentResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/FinalizeTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podiumClient) GetWebhookDeliveries(ctx context.Context, in *GetWebhookDeliveriesRequest, opts ...grpc.CallOption) (*GetWebhookDeliveriesResponse, error) {
+	out := new(GetWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetWebhookDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PodiumServer is the server API for Podium service.
+type PodiumServer interface {
+	// HealthCheck verifies and returns service health.
+	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
+	// Status allows to clients to know additional information about Podium execution.
+	// Currently only returns error rate of the service.
+	Status(context.Context, *empty.Empty) (*StatusResponse, error)
+	// RemoveLeaderboard removes a specified leaderboard.
+	RemoveLeaderboard(context.Context, *RemoveLeaderboardRequest) (*RemoveLeaderboardResponse, error)
+	// BulkUpsertScores allows clients to send multiple scores in a single request.
+	BulkUpsertScores(context.Context, *BulkUpsertScoresRequest) (*BulkUpsertScoresResponse, error)
+	// UpsertScore submits a single leaderboard score to Podium.
+	UpsertScore(context.Context, *UpsertScoreRequest) (*UpsertScoreResponse, error)
+	// TotalMembers returns the number of members on a leaderboard.
+	TotalMembers(context.Context, *TotalMembersRequest) (*TotalMembersResponse, error)
+	// IncrementScore increments a member score.
+	IncrementScore(context.Context, *IncrementScoreRequest) (*IncrementScoreResponse, error)
+	// GetMember retrieves leaderboard information from a member.
+	GetMember(context.Context, *GetMemberRequest) (*GetMemberResponse, error)
+	// GetMembers retrieves information about multiple members of a leaderboard.
+	GetMembers(context.Context, *GetMembersRequest) (*GetMembersResponse, error)
+	// RemoveMember removes a member from a leaderboard.
+	RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error)
+	// RemoveMembers allows the removal of multiple members of a leaderboard.
+	RemoveMembers(context.Context, *RemoveMembersRequest) (*RemoveMembersResponse, error)
+	// GetRank retrieves ranking information about a member.
+	GetRank(context.Context, *GetRankRequest) (*GetRankResponse, error)
+	// GetAroundMember retrieves the closest members to another member on the leaderboard.
+	GetAroundMember(context.Context, *GetAroundMemberRequest) (*GetAroundMemberResponse, error)
+	// GetAroundScore retrieves the closest members to a score on the leaderboard.
+	GetAroundScore(context.Context, *GetAroundScoreRequest) (*GetAroundScoreResponse, error)
+	// GetTopMembers retrieves the top ranking members of a leaderboard.
+	GetTopMembers(context.Context, *GetTopMembersRequest) (*GetTopMembersResponse, error)
+	// GetTopPercentage retrieves a percentage of the top members of the leaderboard.
+	GetTopPercentage(context.Context, *GetTopPercentageRequest) (*GetTopPercentageResponse, error)
+	// UpsertScoreMultiLeaderboards sends a member score to multiple leaderboards.
+	UpsertScoreMultiLeaderboards(context.Context, *UpsertScoreMultiLeaderboardsRequest) (*UpsertScoreMultiLeaderboardsResponse, error)
+	// GetRankMultiLeaderboards retrieves information about a member in multiple leaderboards.
+	GetRankMultiLeaderboards(context.Context, *GetRankMultiLeaderboardsRequest) (*GetRankMultiLeaderboardsResponse, error)
+	// ResetLeaderboard resets the scores of a leaderboard using a season carry-over strategy.
+	ResetLeaderboard(context.Context, *ResetLeaderboardRequest) (*ResetLeaderboardResponse, error)
+	// GetResetLeaderboardProgress retrieves the progress of the last reset applied to a leaderboard.
+	GetResetLeaderboardProgress(context.Context, *GetResetLeaderboardProgressRequest) (*GetResetLeaderboardProgressResponse, error)
+	// GetLeaderboardSettings retrieves the settings of a leaderboard.
+	GetLeaderboardSettings(context.Context, *GetLeaderboardSettingsRequest) (*LeaderboardSettingsResponse, error)
+	// UpdateLeaderboardSettings replaces the settings of a leaderboard.
+	UpdateLeaderboardSettings(context.Context, *UpdateLeaderboardSettingsRequest) (*LeaderboardSettingsResponse, error)
+	// FreezeLeaderboard rejects every write to a leaderboard until it is unfrozen, reads keep working.
+	FreezeLeaderboard(context.Context, *FreezeLeaderboardRequest) (*LeaderboardSettingsResponse, error)
+	// UnfreezeLeaderboard accepts writes to a frozen leaderboard again.
+	UnfreezeLeaderboard(context.Context, *UnfreezeLeaderboardRequest) (*LeaderboardSettingsResponse, error)
+	// GetRejectedScores retrieves the last scores rejected by the rules of a leaderboard, for review.
+	GetRejectedScores(context.Context, *GetRejectedScoresRequest) (*GetRejectedScoresResponse, error)
+	// BlockMember blocks a member in a leaderboard, rejecting or shadowing its writes and removing it from the leaderboard results.
+	BlockMember(context.Context, *BlockMemberRequest) (*BlocklistResponse, error)
+	// UnblockMember unblocks a member in a leaderboard, bringing back its score.
+	UnblockMember(context.Context, *UnblockMemberRequest) (*BlocklistResponse, error)
+	// BlockMemberGlobally blocks a member in every leaderboard.
+	BlockMemberGlobally(context.Context, *BlockMemberGloballyRequest) (*BlocklistResponse, error)
+	// UnblockMemberGlobally unblocks a member in every leaderboard.
+	UnblockMemberGlobally(context.Context, *UnblockMemberGloballyRequest) (*BlocklistResponse, error)
+	// GetMemberLedger retrieves the changes of a member score in a leaderboard since a given time, the oldest first.
+	GetMemberLedger(context.Context, *GetMemberLedgerRequest) (*GetMemberLedgerResponse, error)
+	// RollbackMember reverts the changes of a member score in a leaderboard made since a given time.
+	RollbackMember(context.Context, *RollbackMemberRequest) (*RollbackMemberResponse, error)
+	// GetMemberHistory retrieves the score and rank samples of a member in a leaderboard within a time range, the oldest first.
+	GetMemberHistory(context.Context, *GetMemberHistoryRequest) (*GetMemberHistoryResponse, error)
+	// GetRankMovers retrieves the members that climbed and fell the most positions between two rank snapshots, or a snapshot and now.
+	GetRankMovers(context.Context, *GetRankMoversRequest) (*GetRankMoversResponse, error)
+	// DiffLeaderboard streams the members that entered, changed and left a leaderboard between two rank snapshots, or a snapshot and now.
+	DiffLeaderboard(*DiffLeaderboardRequest, Podium_DiffLeaderboardServer) error
+	// WatchTopMembers streams the top members of a leaderboard, sending them again whenever they change.
+	// It is only served over gRPC.
+	WatchTopMembers(*WatchTopMembersRequest, Podium_WatchTopMembersServer) error
+	// ImportScores loads scores sent in chunks, acknowledging each chunk in the response, and can replace the
+	// leaderboard at once with the scores imported. It is only served over gRPC.
+	ImportScores(Podium_ImportScoresServer) error
+	// ExportLeaderboard streams every member of a leaderboard in chunks, read from a copy taken when the export
+	// starts so ranks are consistent across chunks. It is only served over gRPC, HTTP downloads use /export/l/.
+	ExportLeaderboard(*ExportLeaderboardRequest, Podium_ExportLeaderboardServer) error
+	// CreateLeague creates a leagues system of division leaderboards starting at season 1.
+	CreateLeague(context.Context, *CreateLeagueRequest) (*LeagueResponse, error)
+	// GetLeague retrieves a league configuration and its current season.
+	GetLeague(context.Context, *GetLeagueRequest) (*LeagueResponse, error)
+	// JoinLeague places a member in a division of the current league season.
+	JoinLeague(context.Context, *JoinLeagueRequest) (*LeagueDivisionResponse, error)
+	// GetLeagueDivision retrieves the division of a member in the current league season with its standings.
+	GetLeagueDivision(context.Context, *GetLeagueDivisionRequest) (*LeagueDivisionResponse, error)
+	// EndLeagueSeason promotes and relegates members of each division and starts the next season.
+	EndLeagueSeason(context.Context, *EndLeagueSeasonRequest) (*EndLeagueSeasonResponse, error)
+	// CreateTournament creates a tournament over the leaderboard with the same identification.
+	CreateTournament(context.Context, *CreateTournamentRequest) (*TournamentResponse, error)
+	// GetTournament retrieves a tournament configuration and its status.
+	GetTournament(context.Context, *GetTournamentRequest) (*TournamentResponse, error)
+	// JoinTournament adds a member to the tournament participants.
+	JoinTournament(context.Context, *JoinTournamentRequest) (*TournamentResponse, error)
+	// FinalizeTournament freezes the leaderboard of an ended tournament and resolves its prize table.
+	FinalizeTournament(context.Context, *FinalizeTournamentRequest) (*FinalizeTournamentResponse, error)
+	// GetWebhookDeliveries retrieves the last attempts to deliver leaderboard lifecycle events to the lifecycle webhook.
+	GetWebhookDeliveries(context.Context, *GetWebhookDeliveriesRequest) (*GetWebhookDeliveriesResponse, error)
+}
+
+func RegisterPodiumServer(s *grpc.Server, srv PodiumServer) {
+	s.RegisterService(&_Podium_serviceDesc, srv)
+}
+
+func _Podium_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).HealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/HealthCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).HealthCheck(ctx, req.(*HealthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).Status(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_RemoveLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).RemoveLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/RemoveLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).RemoveLeaderboard(ctx, req.(*RemoveLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_BulkUpsertScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpsertScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).BulkUpsertScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/BulkUpsertScores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).BulkUpsertScores(ctx, req.(*BulkUpsertScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_UpsertScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).UpsertScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/UpsertScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).UpsertScore(ctx, req.(*UpsertScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_TotalMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotalMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).TotalMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/TotalMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).TotalMembers(ctx, req.(*TotalMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_IncrementScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrementScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).IncrementScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/IncrementScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).IncrementScore(ctx, req.(*IncrementScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_GetMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).GetMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/GetMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).GetMember(ctx, req.(*GetMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_GetMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).GetMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/GetMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).GetMembers(ctx, req.(*GetMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *podiumClient) RemoveMembers(ctx context.Context, in *RemoveMembersRequest, opts ...grpc.CallOption) (*RemoveMembersResponse, error) {
-	out := new(RemoveMembersResponse)
-	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/RemoveMembers", in, out, opts...)
-	if err != nil {
+func _Podium_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMemberRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PodiumServer).RemoveMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/RemoveMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).RemoveMember(ctx, req.(*RemoveMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *podiumClient) GetRank(ctx context.Context, in *GetRankRequest, opts ...grpc.CallOption) (*GetRankResponse, error) {
-	out := new(GetRankResponse)
-	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetRank", in, out, opts...)
-	if err != nil {
+func _Podium_RemoveMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMembersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PodiumServer).RemoveMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/RemoveMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).RemoveMembers(ctx, req.(*RemoveMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *podiumClient) GetAroundMember(ctx context.Context, in *GetAroundMemberRequest, opts ...grpc.CallOption) (*GetAroundMemberResponse, error) {
-	out := new(GetAroundMemberResponse)
-	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetAroundMember", in, out, opts...)
-	if err != nil {
+func _Podium_GetRank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRankRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PodiumServer).GetRank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/GetRank",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).GetRank(ctx, req.(*GetRankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *podiumClient) GetAroundScore(ctx context.Context, in *GetAroundScoreRequest, opts ...grpc.CallOption) (*GetAroundScoreResponse, error) {
-	out := new(GetAroundScoreResponse)
-	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetAroundScore", in, out, opts...)
-	if err != nil {
+func _Podium_GetAroundMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAroundMemberRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PodiumServer).GetAroundMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/GetAroundMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).GetAroundMember(ctx, req.(*GetAroundMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *podiumClient) GetTopMembers(ctx context.Context, in *GetTopMembersRequest, opts ...grpc.CallOption) (*GetTopMembersResponse, error) {
-	out := new(GetTopMembersResponse)
-	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetTopMembers", in, out, opts...)
-	if err != nil {
+func _Podium_GetAroundScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAroundScoreRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PodiumServer).GetAroundScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/GetAroundScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).GetAroundScore(ctx, req.(*GetAroundScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *podiumClient) GetTopPercentage(ctx context.Context, in *GetTopPercentageRequest, opts ...grpc.CallOption) (*GetTopPercentageResponse, error) {
-	out := new(GetTopPercentageResponse)
-	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetTopPercentage", in, out, opts...)
-	if err != nil {
+func _Podium_GetTopMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopMembersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PodiumServer).GetTopMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/GetTopMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).GetTopMembers(ctx, req.(*GetTopMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *podiumClient) UpsertScoreMultiLeaderboards(ctx context.Context, in *UpsertScoreMultiLeaderboardsRequest, opts ...grpc.CallOption) (*UpsertScoreMultiLeaderboardsResponse, error) {
-	out := new(UpsertScoreMultiLeaderboardsResponse)
-	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/UpsertScoreMultiLeaderboards", in, out, opts...)
-	if err != nil {
+func _Podium_GetTopPercentage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopPercentageRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PodiumServer).GetTopPercentage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/GetTopPercentage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).GetTopPercentage(ctx, req.(*GetTopPercentageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_UpsertScoreMultiLeaderboards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertScoreMultiLeaderboardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).UpsertScoreMultiLeaderboards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/UpsertScoreMultiLeaderboards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).UpsertScoreMultiLeaderboards(ctx, req.(*UpsertScoreMultiLeaderboardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_GetRankMultiLeaderboards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRankMultiLeaderboardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).GetRankMultiLeaderboards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/GetRankMultiLeaderboards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).GetRankMultiLeaderboards(ctx, req.(*GetRankMultiLeaderboardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_ResetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).ResetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/ResetLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).ResetLeaderboard(ctx, req.(*ResetLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_GetResetLeaderboardProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResetLeaderboardProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).GetResetLeaderboardProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/GetResetLeaderboardProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).GetResetLeaderboardProgress(ctx, req.(*GetResetLeaderboardProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_GetLeaderboardSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderboardSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).GetLeaderboardSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/GetLeaderboardSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).GetLeaderboardSettings(ctx, req.(*GetLeaderboardSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_UpdateLeaderboardSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLeaderboardSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).UpdateLeaderboardSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/UpdateLeaderboardSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).UpdateLeaderboardSettings(ctx, req.(*UpdateLeaderboardSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Podium_FreezeLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).FreezeLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/FreezeLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).FreezeLeaderboard(ctx, req.(*FreezeLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *podiumClient) GetRankMultiLeaderboards(ctx context.Context, in *GetRankMultiLeaderboardsRequest, opts ...grpc.CallOption) (*GetRankMultiLeaderboardsResponse, error) {
-	out := new(GetRankMultiLeaderboardsResponse)
-	err := c.cc.Invoke(ctx, "/podium.api.v1.Podium/GetRankMultiLeaderboards", in, out, opts...)
-	if err != nil {
+func _Podium_UnfreezeLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfreezeLeaderboardRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// PodiumServer is the server API for Podium service.
-type PodiumServer interface {
-	// HealthCheck verifies and returns service health.
-	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
-	// Status allows to clients to know additional information about Podium execution.
-	// Currently only returns error rate of the service.
-	Status(context.Context, *empty.Empty) (*StatusResponse, error)
-	// RemoveLeaderboard removes a specified leaderboard.
-	RemoveLeaderboard(context.Context, *RemoveLeaderboardRequest) (*RemoveLeaderboardResponse, error)
-	// BulkUpsertScores allows clients to send multiple scores in a single request.
-	BulkUpsertScores(context.Context, *BulkUpsertScoresRequest) (*BulkUpsertScoresResponse, error)
-	// UpsertScore submits a single leaderboard score to Podium.
-	UpsertScore(context.Context, *UpsertScoreRequest) (*UpsertScoreResponse, error)
-	// TotalMembers returns the number of members on a leaderboard.
-	TotalMembers(context.Context, *TotalMembersRequest) (*TotalMembersResponse, error)
-	// IncrementScore increments a member score.
-	IncrementScore(context.Context, *IncrementScoreRequest) (*IncrementScoreResponse, error)
-	// GetMember retrieves leaderboard information from a member.
-	GetMember(context.Context, *GetMemberRequest) (*GetMemberResponse, error)
-	// GetMembers retrieves information about multiple members of a leaderboard.
-	GetMembers(context.Context, *GetMembersRequest) (*GetMembersResponse, error)
-	// RemoveMember removes a member from a leaderboard.
-	RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error)
-	// RemoveMembers allows the removal of multiple members of a leaderboard.
-	RemoveMembers(context.Context, *RemoveMembersRequest) (*RemoveMembersResponse, error)
-	// GetRank retrieves ranking information about a member.
-	GetRank(context.Context, *GetRankRequest) (*GetRankResponse, error)
-	// GetAroundMember retrieves the closest members to another member on the leaderboard.
-	GetAroundMember(context.Context, *GetAroundMemberRequest) (*GetAroundMemberResponse, error)
-	// GetAroundScore retrieves the closest members to a score on the leaderboard.
-	GetAroundScore(context.Context, *GetAroundScoreRequest) (*GetAroundScoreResponse, error)
-	// GetTopMembers retrieves the top ranking members of a leaderboard.
-	GetTopMembers(context.Context, *GetTopMembersRequest) (*GetTopMembersResponse, error)
-	// GetTopPercentage retrieves a percentage of the top members of the leaderboard.
-	GetTopPercentage(context.Context, *GetTopPercentageRequest) (*GetTopPercentageResponse, error)
-	// UpsertScoreMultiLeaderboards sends a member score to multiple leaderboards.
-	UpsertScoreMultiLeaderboards(context.Context, *UpsertScoreMultiLeaderboardsRequest) (*UpsertScoreMultiLeaderboardsResponse, error)
-	// GetRankMultiLeaderboards retrieves information about a member in multiple leaderboards.
-	GetRankMultiLeaderboards(context.Context, *GetRankMultiLeaderboardsRequest) (*GetRankMultiLeaderboardsResponse, error)
+	if interceptor == nil {
+		return srv.(PodiumServer).UnfreezeLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/UnfreezeLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).UnfreezeLeaderboard(ctx, req.(*UnfreezeLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterPodiumServer(s *grpc.Server, srv PodiumServer) {
-	s.RegisterService(&_Podium_serviceDesc, srv)
+func _Podium_GetRejectedScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRejectedScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodiumServer).GetRejectedScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/podium.api.v1.Podium/GetRejectedScores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodiumServer).GetRejectedScores(ctx, req.(*GetRejectedScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Podium_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HealthCheckRequest)
+func _Podium_BlockMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PodiumServer).HealthCheck(ctx, in)
+		return srv.(PodiumServer).BlockMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/podium.api.v1.Podium/HealthCheck",
+		FullMethod: "/podium.api.v1.Podium/BlockMember",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PodiumServer).HealthCheck(ctx, req.(*HealthCheckRequest))
+		return srv.(PodiumServer).BlockMember(ctx, req.(*BlockMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Podium_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _Podium_UnblockMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PodiumServer).Status(ctx, in)
+		return srv.(PodiumServer).UnblockMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/podium.api.v1.Podium/Status",
+		FullMethod: "/podium.api.v1.Podium/UnblockMember",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PodiumServer).Status(ctx, req.(*empty.Empty))
+		return srv.(PodiumServer).UnblockMember(ctx, req.(*UnblockMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Podium_RemoveLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveLeaderboardRequest)
+func _Podium_BlockMemberGlobally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockMemberGloballyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PodiumServer).RemoveLeaderboard(ctx, in)
+		return srv.(PodiumServer).BlockMemberGlobally(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/podium.api.v1.Podium/RemoveLeaderboard",
+		FullMethod: "/podium.api.v1.Podium/BlockMemberGlobally",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PodiumServer).RemoveLeaderboard(ctx, req.(*RemoveLeaderboardRequest))
+		return srv.(PodiumServer).BlockMemberGlobally(ctx, req.(*BlockMemberGloballyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Podium_BulkUpsertScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BulkUpsertScoresRequest)
+func _Podium_UnblockMemberGlobally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockMemberGloballyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PodiumServer).BulkUpsertScores(ctx, in)
+		return srv.(PodiumServer).UnblockMemberGlobally(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/podium.api.v1.Podium/BulkUpsertScores",
+		FullMethod: "/podium.api.v1.Podium/UnblockMemberGlobally",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PodiumServer).BulkUpsertScores(ctx, req.(*BulkUpsertScoresRequest))
+		return srv.(PodiumServer).UnblockMemberGlobally(ctx, req.(*UnblockMemberGloballyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Podium_UpsertScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpsertScoreRequest)
+func _Podium_GetMemberLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemberLedgerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PodiumServer).UpsertScore(ctx, in)
+		return srv.(PodiumServer).GetMemberLedger(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/podium.api.v1.Podium/UpsertScore",
+		FullMethod: "/podium.api.v1.Podium/GetMemberLedger",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PodiumServer).UpsertScore(ctx, req.(*UpsertScoreRequest))
+		return srv.(PodiumServer).GetMemberLedger(ctx, req.(*GetMemberLedgerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Podium_TotalMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TotalMembersRequest)
+func _Podium_RollbackMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PodiumServer).TotalMembers(ctx, in)
+		return srv.(PodiumServer).RollbackMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/podium.api.v1.Podium/TotalMembers",
+		FullMethod: "/podium.api.v1.Podium/RollbackMember",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PodiumServer).TotalMembers(ctx, req.(*TotalMembersRequest))
+		return srv.(PodiumServer).RollbackMember(ctx, req.(*RollbackMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Podium_IncrementScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IncrementScoreRequest)
+func _Podium_GetMemberHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemberHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PodiumServer).IncrementScore(ctx, in)
+		return srv.(PodiumServer).GetMemberHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/podium.api.v1.Podium/IncrementScore",
+		FullMethod: "/podium.api.v1.Podium/GetMemberHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PodiumServer).IncrementScore(ctx, req.(*IncrementScoreRequest))
+		return srv.(PodiumServer).GetMemberHistory(ctx, req.(*GetMemberHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Podium_GetMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMemberRequest)
+func _Podium_GetRankMovers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRankMoversRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PodiumServer).GetMember(ctx, in)
+		return srv.(PodiumServer).GetRankMovers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/podium.api.v1.Podium/GetMember",
+		FullMethod: "/podium.api.v1.Podium/GetRankMovers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PodiumServer).GetMember(ctx, req.(*GetMemberRequest))
+		return srv.(PodiumServer).GetRankMovers(ctx, req.(*GetRankMoversRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Podium_GetMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMembersRequest)
+func _Podium_DiffLeaderboard_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DiffLeaderboardRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PodiumServer).DiffLeaderboard(m, &podiumDiffLeaderboardServer{stream})
+}
+
+type Podium_DiffLeaderboardServer interface {
+	Send(*MemberDiff) error
+	grpc.ServerStream
+}
+
+type podiumDiffLeaderboardServer struct {
+	grpc.ServerStream
+}
+
+func (x *podiumDiffLeaderboardServer) Send(m *MemberDiff) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Podium_WatchTopMembers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTopMembersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PodiumServer).WatchTopMembers(m, &podiumWatchTopMembersServer{stream})
+}
+
+type Podium_WatchTopMembersServer interface {
+	Send(*GetTopMembersResponse) error
+	grpc.ServerStream
+}
+
+type podiumWatchTopMembersServer struct {
+	grpc.ServerStream
+}
+
+func (x *podiumWatchTopMembersServer) Send(m *GetTopMembersResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Podium_ImportScores_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PodiumServer).ImportScores(&podiumImportScoresServer{stream})
+}
+
+type Podium_ImportScoresServer interface {
+	SendAndClose(*ImportScoresResponse) error
+	Recv() (*ImportScoresRequest, error)
+	grpc.ServerStream
+}
+
+type podiumImportScoresServer struct {
+	grpc.ServerStream
+}
+
+func (x *podiumImportScoresServer) SendAndClose(m *ImportScoresResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *podiumImportScoresServer) Recv() (*ImportScoresRequest, error) {
+	m := new(ImportScoresRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Podium_ExportLeaderboard_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportLeaderboardRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PodiumServer).ExportLeaderboard(m, &podiumExportLeaderboardServer{stream})
+}
+
+type Podium_ExportLeaderboardServer interface {
+	Send(*ExportLeaderboardResponse) error
+	grpc.ServerStream
+}
+
+type podiumExportLeaderboardServer struct {
+	grpc.ServerStream
+}
+
+func (x *podiumExportLeaderboardServer) Send(m *ExportLeaderboardResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Podium_CreateLeague_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLeagueRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PodiumServer).GetMembers(ctx, in)
+		return srv.(PodiumServer).CreateLeague(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/podium.api.v1.Podium/GetMembers",
+		FullMethod: "/podium.api.v1.Podium/CreateLeague",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PodiumServer).GetMembers(ctx, req.(*GetMembersRequest))
+		return srv.(PodiumServer).CreateLeague(ctx, req.(*CreateLeagueRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Podium_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveMemberRequest)
+func _Podium_GetLeague_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeagueRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PodiumServer).RemoveMember(ctx, in)
+		return srv.(PodiumServer).GetLeague(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/podium.api.v1.Podium/RemoveMember",
+		FullMethod: "/podium.api.v1.Podium/GetLeague",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PodiumServer).RemoveMember(ctx, req.(*RemoveMemberRequest))
+		return srv.(PodiumServer).GetLeague(ctx, req.(*GetLeagueRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Podium_RemoveMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveMembersRequest)
+func _Podium_JoinLeague_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinLeagueRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PodiumServer).RemoveMembers(ctx, in)
+		return srv.(PodiumServer).JoinLeague(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/podium.api.v1.Podium/RemoveMembers",
+		FullMethod: "/podium.api.v1.Podium/JoinLeague",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PodiumServer).RemoveMembers(ctx, req.(*RemoveMembersRequest))
+		return srv.(PodiumServer).JoinLeague(ctx, req.(*JoinLeagueRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Podium_GetRank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRankRequest)
+func _Podium_GetLeagueDivision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeagueDivisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PodiumServer).GetRank(ctx, in)
+		return srv.(PodiumServer).GetLeagueDivision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/podium.api.v1.Podium/GetRank",
+		FullMethod: "/podium.api.v1.Podium/GetLeagueDivision",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PodiumServer).GetRank(ctx, req.(*GetRankRequest))
+		return srv.(PodiumServer).GetLeagueDivision(ctx, req.(*GetLeagueDivisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Podium_GetAroundMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAroundMemberRequest)
+func _Podium_EndLeagueSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndLeagueSeasonRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PodiumServer).GetAroundMember(ctx, in)
+		return srv.(PodiumServer).EndLeagueSeason(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/podium.api.v1.Podium/GetAroundMember",
+		FullMethod: "/podium.api.v1.Podium/EndLeagueSeason",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PodiumServer).GetAroundMember(ctx, req.(*GetAroundMemberRequest))
+		return srv.(PodiumServer).EndLeagueSeason(ctx, req.(*EndLeagueSeasonRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Podium_GetAroundScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAroundScoreRequest)
+func _Podium_CreateTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTournamentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PodiumServer).GetAroundScore(ctx, in)
+		return srv.(PodiumServer).CreateTournament(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/podium.api.v1.Podium/GetAroundScore",
+		FullMethod: "/podium.api.v1.Podium/CreateTournament",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PodiumServer).GetAroundScore(ctx, req.(*GetAroundScoreRequest))
+		return srv.(PodiumServer).CreateTournament(ctx, req.(*CreateTournamentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Podium_GetTopMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTopMembersRequest)
+func _Podium_GetTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTournamentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PodiumServer).GetTopMembers(ctx, in)
+		return srv.(PodiumServer).GetTournament(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/podium.api.v1.Podium/GetTopMembers",
+		FullMethod: "/podium.api.v1.Podium/GetTournament",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PodiumServer).GetTopMembers(ctx, req.(*GetTopMembersRequest))
+		return srv.(PodiumServer).GetTournament(ctx, req.(*GetTournamentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Podium_GetTopPercentage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTopPercentageRequest)
+func _Podium_JoinTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinTournamentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PodiumServer).GetTopPercentage(ctx, in)
+		return srv.(PodiumServer).JoinTournament(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/podium.api.v1.Podium/GetTopPercentage",
+		FullMethod: "/podium.api.v1.Podium/JoinTournament",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PodiumServer).GetTopPercentage(ctx, req.(*GetTopPercentageRequest))
+		return srv.(PodiumServer).JoinTournament(ctx, req.(*JoinTournamentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Podium_UpsertScoreMultiLeaderboards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpsertScoreMultiLeaderboardsRequest)
+func _Podium_FinalizeTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizeTournamentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PodiumServer).UpsertScoreMultiLeaderboards(ctx, in)
+		return srv.(PodiumServer).FinalizeTournament(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/podium.api.v1.Podium/UpsertScoreMultiLeaderboards",
+		FullMethod: "/podium.api.v1.Podium/FinalizeTournament",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PodiumServer).UpsertScoreMultiLeaderboards(ctx, req.(*UpsertScoreMultiLeaderboardsRequest))
+		return srv.(PodiumServer).FinalizeTournament(ctx, req.(*FinalizeTournamentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Podium_GetRankMultiLeaderboards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRankMultiLeaderboardsRequest)
+func _Podium_GetWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookDeliveriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PodiumServer).GetRankMultiLeaderboards(ctx, in)
+		return srv.(PodiumServer).GetWebhookDeliveries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/podium.api.v1.Podium/GetRankMultiLeaderboards",
+		FullMethod: "/podium.api.v1.Podium/GetWebhookDeliveries",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PodiumServer).GetRankMultiLeaderboards(ctx, req.(*GetRankMultiLeaderboardsRequest))
+		return srv.(PodiumServer).GetWebhookDeliveries(ctx, req.(*GetWebhookDeliveriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3373,7 +8918,128 @@ var _Podium_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetRankMultiLeaderboards",
 			Handler:    _Podium_GetRankMultiLeaderboards_Handler,
 		},
+		{
+			MethodName: "ResetLeaderboard",
+			Handler:    _Podium_ResetLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetResetLeaderboardProgress",
+			Handler:    _Podium_GetResetLeaderboardProgress_Handler,
+		},
+		{
+			MethodName: "GetLeaderboardSettings",
+			Handler:    _Podium_GetLeaderboardSettings_Handler,
+		},
+		{
+			MethodName: "UpdateLeaderboardSettings",
+			Handler:    _Podium_UpdateLeaderboardSettings_Handler,
+		},
+		{
+			MethodName: "FreezeLeaderboard",
+			Handler:    _Podium_FreezeLeaderboard_Handler,
+		},
+		{
+			MethodName: "UnfreezeLeaderboard",
+			Handler:    _Podium_UnfreezeLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetRejectedScores",
+			Handler:    _Podium_GetRejectedScores_Handler,
+		},
+		{
+			MethodName: "BlockMember",
+			Handler:    _Podium_BlockMember_Handler,
+		},
+		{
+			MethodName: "UnblockMember",
+			Handler:    _Podium_UnblockMember_Handler,
+		},
+		{
+			MethodName: "BlockMemberGlobally",
+			Handler:    _Podium_BlockMemberGlobally_Handler,
+		},
+		{
+			MethodName: "UnblockMemberGlobally",
+			Handler:    _Podium_UnblockMemberGlobally_Handler,
+		},
+		{
+			MethodName: "GetMemberLedger",
+			Handler:    _Podium_GetMemberLedger_Handler,
+		},
+		{
+			MethodName: "RollbackMember",
+			Handler:    _Podium_RollbackMember_Handler,
+		},
+		{
+			MethodName: "GetMemberHistory",
+			Handler:    _Podium_GetMemberHistory_Handler,
+		},
+		{
+			MethodName: "GetRankMovers",
+			Handler:    _Podium_GetRankMovers_Handler,
+		},
+		{
+			MethodName: "CreateLeague",
+			Handler:    _Podium_CreateLeague_Handler,
+		},
+		{
+			MethodName: "GetLeague",
+			Handler:    _Podium_GetLeague_Handler,
+		},
+		{
+			MethodName: "JoinLeague",
+			Handler:    _Podium_JoinLeague_Handler,
+		},
+		{
+			MethodName: "GetLeagueDivision",
+			Handler:    _Podium_GetLeagueDivision_Handler,
+		},
+		{
+			MethodName: "EndLeagueSeason",
+			Handler:    _Podium_EndLeagueSeason_Handler,
+		},
+		{
+			MethodName: "CreateTournament",
+			Handler:    _Podium_CreateTournament_Handler,
+		},
+		{
+			MethodName: "GetTournament",
+			Handler:    _Podium_GetTournament_Handler,
+		},
+		{
+			MethodName: "JoinTournament",
+			Handler:    _Podium_JoinTournament_Handler,
+		},
+		{
+			MethodName: "FinalizeTournament",
+			Handler:    _Podium_FinalizeTournament_Handler,
+		},
+		{
+			MethodName: "GetWebhookDeliveries",
+			Handler:    _Podium_GetWebhookDeliveries_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DiffLeaderboard",
+			Handler:       _Podium_DiffLeaderboard_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchTopMembers",
+			Handler:       _Podium_WatchTopMembers_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportScores",
+			Handler:       _Podium_ImportScores_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportLeaderboard",
+			Handler:       _Podium_ExportLeaderboard_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/podium/api/v1/podium.proto",
 }
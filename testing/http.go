@@ -49,6 +49,31 @@ func PutJSON(app *api.App, url string, body interface{}) (int, string) {
 	return Put(app, url, string(result))
 }
 
+//PutJSONWithHeaders to server
+func PutJSONWithHeaders(app *api.App, url string, body interface{}, headers map[string]string) (int, string) {
+	result, err := json.Marshal(body)
+	if err != nil {
+		return 510, "Failed to marshal specified body to JSON format"
+	}
+	InitializeTestServer(app)
+	req := getRequest(app, "PUT", url, string(result))
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	return performRequest(req)
+}
+
+//PutJSONWithResponseHeaders to server, returning the response headers too
+func PutJSONWithResponseHeaders(app *api.App, url string, body interface{}) (int, string, http.Header) {
+	result, err := json.Marshal(body)
+	if err != nil {
+		return 510, "Failed to marshal specified body to JSON format", nil
+	}
+	InitializeTestServer(app)
+	req := getRequest(app, "PUT", url, string(result))
+	return performRequestWithHeaders(req)
+}
+
 //Patch to server
 func Patch(app *api.App, url, body string) (int, string) {
 	return doRequest(app, "PATCH", url, body)
@@ -88,6 +113,11 @@ func getRequest(app *api.App, method, url, body string) *http.Request {
 }
 
 func performRequest(req *http.Request) (int, string) {
+	status, body, _ := performRequestWithHeaders(req)
+	return status, body
+}
+
+func performRequestWithHeaders(req *http.Request) (int, string, http.Header) {
 	res, err := client.Do(req)
 	Expect(err).NotTo(HaveOccurred())
 
@@ -97,7 +127,7 @@ func performRequest(req *http.Request) (int, string) {
 	err = res.Body.Close()
 	Expect(err).NotTo(HaveOccurred())
 
-	return res.StatusCode, string(b)
+	return res.StatusCode, string(b), res.Header
 }
 
 // GetRoute returns the endpoint for accessing an url in an app
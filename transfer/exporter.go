@@ -0,0 +1,257 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package transfer
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// ExportCheckpoint is which leaderboards an export wrote
+type ExportCheckpoint struct {
+	// Exported is how many members were written to the file of each leaderboard exported
+	Exported  map[string]int `json:"exported"`
+	UpdatedAt int64          `json:"updatedAt"`
+}
+
+// ExportResult is the result of exporting a leaderboard
+type ExportResult struct {
+	Leaderboard string
+	Path        string
+	Members     int
+	// Resumed is true if the leaderboard was exported before the checkpoint and skipped
+	Resumed bool
+}
+
+// Exporter exports leaderboards to a file each in Dir, read in chunks of ChunkSize members by Workers
+// in parallel. Files are named after their leaderboards, like <leaderboard>.csv or <leaderboard>.jsonl.gz
+type Exporter struct {
+	Leaderboards Leaderboards
+	Dir          string
+	Format       string
+	Gzip         bool
+	Order        string
+	ChunkSize    int
+	Workers      int
+	// CheckpointPath is where the leaderboards already exported are resumed from and saved after each
+	// leaderboard, if set. It is removed once every leaderboard is exported
+	CheckpointPath string
+	// OnChunk is called concurrently by the workers with how many members of leaderboard were written
+	OnChunk func(leaderboard string, members int)
+	// OnLeaderboard is called with each leaderboard exported or skipped
+	OnLeaderboard func(result *ExportResult)
+}
+
+// NewExporter create a new Exporter
+func NewExporter(leaderboards Leaderboards, dir, format string, gzipped bool, chunkSize, workers int) *Exporter {
+	return &Exporter{
+		Leaderboards: leaderboards,
+		Dir:          dir,
+		Format:       format,
+		Gzip:         gzipped,
+		Order:        "desc",
+		ChunkSize:    chunkSize,
+		Workers:      workers,
+	}
+}
+
+// Path return the path of the file leaderboard is exported to
+func (e *Exporter) Path(leaderboard string) string {
+	path := filepath.Join(e.Dir, fmt.Sprintf("%s.%s", leaderboard, e.Format))
+	if e.Gzip {
+		path += ".gz"
+	}
+	return path
+}
+
+type exportOutcome struct {
+	result *ExportResult
+	err    error
+}
+
+// Export export each leaderboard to its file, skipping the ones exported before the checkpoint. Files
+// are written to a temporary file renamed once the leaderboard is exported, so they are never partial. It
+// stops at the first leaderboard that could not be exported
+func (e *Exporter) Export(ctx context.Context, leaderboards []string) ([]*ExportResult, error) {
+	for _, leaderboard := range leaderboards {
+		if leaderboard == "" || strings.ContainsAny(leaderboard, `/\`) {
+			return nil, fmt.Errorf("invalid leaderboard %q, it must be a file name", leaderboard)
+		}
+	}
+	if _, err := NewWriter(ioutil.Discard, e.Format); err != nil {
+		return nil, err
+	}
+
+	checkpoint := &ExportCheckpoint{}
+	if e.CheckpointPath != "" {
+		_, err := loadCheckpoint(e.CheckpointPath, checkpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid checkpoint %s: %s", e.CheckpointPath, err.Error())
+		}
+	}
+	if checkpoint.Exported == nil {
+		checkpoint.Exported = map[string]int{}
+	}
+
+	results := make([]*ExportResult, 0, len(leaderboards))
+	pending := make(chan string, len(leaderboards))
+	for _, leaderboard := range leaderboards {
+		if members, ok := checkpoint.Exported[leaderboard]; ok {
+			result := &ExportResult{Leaderboard: leaderboard, Path: e.Path(leaderboard), Members: members, Resumed: true}
+			results = append(results, result)
+			if e.OnLeaderboard != nil {
+				e.OnLeaderboard(result)
+			}
+			continue
+		}
+		pending <- leaderboard
+	}
+	close(pending)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	outcomes := make(chan *exportOutcome)
+	var wg sync.WaitGroup
+	for w := 0; w < e.workers(); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for leaderboard := range pending {
+				result, err := e.exportLeaderboard(ctx, leaderboard)
+				select {
+				case outcomes <- &exportOutcome{result: result, err: err}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(outcomes)
+	}()
+
+	var err error
+	for outcome := range outcomes {
+		if err != nil {
+			continue
+		}
+		if outcome.err != nil {
+			err = outcome.err
+			cancel()
+			continue
+		}
+
+		results = append(results, outcome.result)
+		if e.CheckpointPath != "" {
+			checkpoint.Exported[outcome.result.Leaderboard] = outcome.result.Members
+			checkpoint.UpdatedAt = time.Now().Unix()
+			err = saveCheckpoint(e.CheckpointPath, checkpoint)
+			if err != nil {
+				cancel()
+				continue
+			}
+		}
+		if e.OnLeaderboard != nil {
+			e.OnLeaderboard(outcome.result)
+		}
+	}
+	if err != nil {
+		return results, err
+	}
+	if ctx.Err() != nil {
+		return results, ctx.Err()
+	}
+
+	if e.CheckpointPath != "" {
+		err = os.Remove(e.CheckpointPath)
+		if err != nil && !os.IsNotExist(err) {
+			return results, err
+		}
+	}
+	return results, nil
+}
+
+func (e *Exporter) workers() int {
+	if e.Workers < 1 {
+		return 1
+	}
+	return e.Workers
+}
+
+// exportLeaderboard write the members of leaderboard to a temporary file and rename it to its path
+func (e *Exporter) exportLeaderboard(ctx context.Context, leaderboard string) (*ExportResult, error) {
+	path := e.Path(leaderboard)
+	file, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path))
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	var output io.Writer = file
+	var gzipWriter *gzip.Writer
+	if e.Gzip {
+		gzipWriter = gzip.NewWriter(file)
+		output = gzipWriter
+	}
+
+	writer, err := NewWriter(output, e.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ExportResult{Leaderboard: leaderboard, Path: path}
+	err = e.Leaderboards.ExportLeaderboard(ctx, leaderboard, e.Order, e.ChunkSize, func(members []*model.Member) error {
+		err := writer.Write(members)
+		if err != nil {
+			return err
+		}
+		result.Members += len(members)
+		if e.OnChunk != nil {
+			e.OnChunk(leaderboard, len(members))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not export leaderboard %s: %s", leaderboard, err.Error())
+	}
+
+	err = writer.Flush()
+	if err == nil && gzipWriter != nil {
+		err = gzipWriter.Close()
+	}
+	if err == nil {
+		err = file.Sync()
+	}
+	if err == nil {
+		err = file.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.Rename(file.Name(), path)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
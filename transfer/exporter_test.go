@@ -0,0 +1,120 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package transfer_test
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/topfreegames/podium/transfer"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Exporter", func() {
+	var dir string
+	var leaderboards *memoryLeaderboards
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "podium-transfer")
+		Expect(err).NotTo(HaveOccurred())
+
+		leaderboards = newMemoryLeaderboards()
+		leaderboards.scores["ladder"] = map[string]int64{"member1": 10, "member2": 20, "member3": 30}
+		leaderboards.scores["arena"] = map[string]int64{"member1": 5}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("should export each leaderboard to its file", func() {
+		exporter := transfer.NewExporter(leaderboards, dir, "csv", false, 2, 2)
+
+		results, err := exporter.Export(context.Background(), []string{"ladder", "arena"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(ConsistOf(
+			&transfer.ExportResult{Leaderboard: "ladder", Path: filepath.Join(dir, "ladder.csv"), Members: 3},
+			&transfer.ExportResult{Leaderboard: "arena", Path: filepath.Join(dir, "arena.csv"), Members: 1},
+		))
+
+		data, err := ioutil.ReadFile(filepath.Join(dir, "ladder.csv"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(Equal("publicID,score,rank,expireAt\nmember3,30,1,0\nmember2,20,2,0\nmember1,10,3,0\n"))
+	})
+
+	It("should gzip files", func() {
+		exporter := transfer.NewExporter(leaderboards, dir, "jsonl", true, 2, 1)
+		exporter.Order = "asc"
+
+		_, err := exporter.Export(context.Background(), []string{"ladder"})
+		Expect(err).NotTo(HaveOccurred())
+
+		file, err := os.Open(filepath.Join(dir, "ladder.jsonl.gz"))
+		Expect(err).NotTo(HaveOccurred())
+		defer file.Close()
+		gzipReader, err := gzip.NewReader(file)
+		Expect(err).NotTo(HaveOccurred())
+		reader, err := transfer.NewReader(gzipReader, "jsonl")
+		Expect(err).NotTo(HaveOccurred())
+
+		record, err := reader.Read()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(record).To(Equal(&transfer.Record{Line: 1, PublicID: "member1", Score: 10}))
+	})
+
+	It("should skip leaderboards exported before the checkpoint and remove it once exported", func() {
+		checkpointPath := filepath.Join(dir, "checkpoint.json")
+		Expect(ioutil.WriteFile(checkpointPath, []byte(`{"exported":{"ladder":3}}`), 0644)).To(Succeed())
+
+		exporter := transfer.NewExporter(leaderboards, dir, "csv", false, 2, 2)
+		exporter.CheckpointPath = checkpointPath
+
+		results, err := exporter.Export(context.Background(), []string{"ladder", "arena"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(Equal([]*transfer.ExportResult{
+			{Leaderboard: "ladder", Path: filepath.Join(dir, "ladder.csv"), Members: 3, Resumed: true},
+			{Leaderboard: "arena", Path: filepath.Join(dir, "arena.csv"), Members: 1},
+		}))
+		Expect(filepath.Join(dir, "ladder.csv")).NotTo(BeAnExistingFile())
+		Expect(checkpointPath).NotTo(BeAnExistingFile())
+	})
+
+	It("should save the checkpoint of the leaderboards exported before one fails", func() {
+		checkpointPath := filepath.Join(dir, "checkpoint.json")
+		leaderboards.failAfter = 1
+
+		exporter := transfer.NewExporter(leaderboards, dir, "csv", false, 2, 1)
+		exporter.CheckpointPath = checkpointPath
+
+		_, err := exporter.Export(context.Background(), []string{"ladder", "arena"})
+		Expect(err).To(MatchError("could not export leaderboard arena: podium unavailable"))
+		Expect(filepath.Join(dir, "arena.csv")).NotTo(BeAnExistingFile())
+
+		data, err := ioutil.ReadFile(checkpointPath)
+		Expect(err).NotTo(HaveOccurred())
+		checkpoint := &transfer.ExportCheckpoint{}
+		Expect(json.Unmarshal(data, checkpoint)).To(Succeed())
+		Expect(checkpoint.Exported).To(Equal(map[string]int{"ladder": 3}))
+	})
+
+	It("should fail if a leaderboard is not a file name", func() {
+		exporter := transfer.NewExporter(leaderboards, dir, "csv", false, 2, 1)
+
+		_, err := exporter.Export(context.Background(), []string{"../ladder"})
+		Expect(err).To(MatchError(`invalid leaderboard "../ladder", it must be a file name`))
+	})
+})
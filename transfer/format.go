@@ -0,0 +1,258 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package transfer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// csvHeader is the header of CSV files, the first two columns are the only ones read when importing
+var csvHeader = []string{"publicID", "score", "rank", "expireAt"}
+
+// maxLineSize is the size of the longest JSON line that can be imported
+const maxLineSize = 1024 * 1024
+
+// Record is a member read from a file being imported
+type Record struct {
+	// Line is the line of the record in JSON Lines files, or its row in CSV files, starting at 1
+	Line     int
+	PublicID string
+	Score    int64
+}
+
+// RecordError is a record that could not be read, it fails without stopping the import
+type RecordError struct {
+	Line   int
+	Reason string
+}
+
+func (e *RecordError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Reason)
+}
+
+// Reader reads the records of a file being imported, returning io.EOF after the last one
+type Reader interface {
+	Read() (*Record, error)
+}
+
+// Writer writes chunks of exported members to a file
+type Writer interface {
+	Write(members []*model.Member) error
+	// Flush write any buffered member to the file
+	Flush() error
+}
+
+// FormatOf return the format of path from its extension, csv or jsonl, ignoring and reporting a .gz extension
+func FormatOf(path string) (string, bool) {
+	gzipped := strings.HasSuffix(path, ".gz")
+	extension := filepath.Ext(strings.TrimSuffix(path, ".gz"))
+	return strings.TrimPrefix(extension, "."), gzipped
+}
+
+// NewReader create a new Reader of records in format, csv or jsonl
+func NewReader(r io.Reader, format string) (Reader, error) {
+	switch format {
+	case "csv":
+		reader := csv.NewReader(r)
+		reader.FieldsPerRecord = -1
+		reader.ReuseRecord = true
+		return &csvReader{reader: reader, publicID: 0, score: 1}, nil
+	case "jsonl":
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+		return &jsonLinesReader{scanner: scanner}, nil
+	default:
+		return nil, fmt.Errorf("invalid format %s, it must be csv or jsonl", format)
+	}
+}
+
+// NewWriter create a new Writer of members in format, csv or jsonl. CSV files start with a
+// publicID,score,rank,expireAt header, written right away so empty leaderboards still have it
+func NewWriter(w io.Writer, format string) (Writer, error) {
+	switch format {
+	case "csv":
+		writer := csv.NewWriter(w)
+		err := writer.Write(csvHeader)
+		if err != nil {
+			return nil, err
+		}
+		return &csvWriter{writer: writer}, nil
+	case "jsonl":
+		return &jsonLinesWriter{writer: bufio.NewWriter(w)}, nil
+	default:
+		return nil, fmt.Errorf("invalid format %s, it must be csv or jsonl", format)
+	}
+}
+
+// csvReader reads publicID and score columns, found by the header if the file has one
+type csvReader struct {
+	reader   *csv.Reader
+	row      int
+	publicID int
+	score    int
+}
+
+func (c *csvReader) Read() (*Record, error) {
+	for {
+		fields, err := c.reader.Read()
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		c.row++
+		if parseErr, ok := err.(*csv.ParseError); ok {
+			return nil, &RecordError{Line: c.row, Reason: parseErr.Err.Error()}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if c.row == 1 && c.readHeader(fields) {
+			continue
+		}
+
+		if len(fields) <= c.publicID || len(fields) <= c.score {
+			return nil, &RecordError{Line: c.row, Reason: "publicID and score are required"}
+		}
+		return newRecord(c.row, fields[c.publicID], fields[c.score])
+	}
+}
+
+// readHeader find the publicID and score columns in fields, returning false if it is not a header
+func (c *csvReader) readHeader(fields []string) bool {
+	publicID, score := -1, -1
+	for i, field := range fields {
+		switch strings.TrimSpace(field) {
+		case "publicID":
+			publicID = i
+		case "score":
+			score = i
+		}
+	}
+	if publicID == -1 || score == -1 {
+		return false
+	}
+
+	c.publicID, c.score = publicID, score
+	return true
+}
+
+// jsonLinesReader reads JSON objects with publicID and score, one per line, skipping blank lines
+type jsonLinesReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func (j *jsonLinesReader) Read() (*Record, error) {
+	for j.scanner.Scan() {
+		j.line++
+		line := bytes.TrimSpace(j.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var member struct {
+			PublicID string      `json:"publicID"`
+			Score    json.Number `json:"score"`
+		}
+		decoder := json.NewDecoder(bytes.NewReader(line))
+		decoder.UseNumber()
+		err := decoder.Decode(&member)
+		if err != nil {
+			return nil, &RecordError{Line: j.line, Reason: err.Error()}
+		}
+
+		return newRecord(j.line, member.PublicID, member.Score.String())
+	}
+
+	if err := j.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// newRecord validate publicID and score of the record at line
+func newRecord(line int, publicID, score string) (*Record, error) {
+	if publicID == "" {
+		return nil, &RecordError{Line: line, Reason: "publicID is required"}
+	}
+
+	value, err := strconv.ParseInt(strings.TrimSpace(score), 10, 64)
+	if err != nil {
+		return nil, &RecordError{Line: line, Reason: fmt.Sprintf("invalid score %q of %s", score, publicID)}
+	}
+
+	return &Record{Line: line, PublicID: publicID, Score: value}, nil
+}
+
+type csvWriter struct {
+	writer *csv.Writer
+}
+
+func (c *csvWriter) Write(members []*model.Member) error {
+	for _, member := range members {
+		err := c.writer.Write([]string{
+			member.PublicID,
+			strconv.FormatInt(member.Score, 10),
+			strconv.Itoa(member.Rank),
+			strconv.Itoa(member.ExpireAt),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *csvWriter) Flush() error {
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+// exportedMember is a member written to JSON Lines files
+type exportedMember struct {
+	PublicID string `json:"publicID"`
+	Score    int64  `json:"score"`
+	Rank     int    `json:"rank"`
+	ExpireAt int    `json:"expireAt"`
+}
+
+type jsonLinesWriter struct {
+	writer *bufio.Writer
+}
+
+func (j *jsonLinesWriter) Write(members []*model.Member) error {
+	encoder := json.NewEncoder(j.writer)
+	for _, member := range members {
+		err := encoder.Encode(&exportedMember{
+			PublicID: member.PublicID,
+			Score:    member.Score,
+			Rank:     member.Rank,
+			ExpireAt: member.ExpireAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (j *jsonLinesWriter) Flush() error {
+	return j.writer.Flush()
+}
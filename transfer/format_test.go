@@ -0,0 +1,121 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package transfer_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/transfer"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Format", func() {
+	readAll := func(reader transfer.Reader) ([]*transfer.Record, []error) {
+		records := []*transfer.Record{}
+		errs := []error{}
+		for {
+			record, err := reader.Read()
+			if err == io.EOF {
+				return records, errs
+			}
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			records = append(records, record)
+		}
+	}
+
+	It("should tell the format of a path by its extension", func() {
+		format, gzipped := transfer.FormatOf("backups/ladder.csv.gz")
+		Expect(format).To(Equal("csv"))
+		Expect(gzipped).To(BeTrue())
+
+		format, gzipped = transfer.FormatOf("ladder.jsonl")
+		Expect(format).To(Equal("jsonl"))
+		Expect(gzipped).To(BeFalse())
+	})
+
+	It("should read CSV columns found by the header", func() {
+		reader, err := transfer.NewReader(strings.NewReader("score,publicID\n10,member1\nten,member2\n20\n30,member3\n"), "csv")
+		Expect(err).NotTo(HaveOccurred())
+
+		records, errs := readAll(reader)
+		Expect(records).To(Equal([]*transfer.Record{
+			{Line: 2, PublicID: "member1", Score: 10},
+			{Line: 5, PublicID: "member3", Score: 30},
+		}))
+		Expect(errs).To(Equal([]error{
+			&transfer.RecordError{Line: 3, Reason: `invalid score "ten" of member2`},
+			&transfer.RecordError{Line: 4, Reason: "publicID and score are required"},
+		}))
+	})
+
+	It("should read CSV without header as publicID and score", func() {
+		reader, err := transfer.NewReader(strings.NewReader("member1,10,1,0\nmember2,-5\n"), "csv")
+		Expect(err).NotTo(HaveOccurred())
+
+		records, errs := readAll(reader)
+		Expect(errs).To(BeEmpty())
+		Expect(records).To(Equal([]*transfer.Record{
+			{Line: 1, PublicID: "member1", Score: 10},
+			{Line: 2, PublicID: "member2", Score: -5},
+		}))
+	})
+
+	It("should read JSON lines skipping blank lines", func() {
+		reader, err := transfer.NewReader(strings.NewReader(`{"publicID":"member1","score":10,"rank":1}`+"\n\n{invalid\n"+`{"score":20}`+"\n"), "jsonl")
+		Expect(err).NotTo(HaveOccurred())
+
+		records, errs := readAll(reader)
+		Expect(records).To(Equal([]*transfer.Record{{Line: 1, PublicID: "member1", Score: 10}}))
+		Expect(errs).To(HaveLen(2))
+		Expect(errs[0].(*transfer.RecordError).Line).To(Equal(3))
+		Expect(errs[1]).To(Equal(&transfer.RecordError{Line: 4, Reason: "publicID is required"}))
+	})
+
+	It("should fail if format is invalid", func() {
+		_, err := transfer.NewReader(strings.NewReader(""), "xml")
+		Expect(err).To(MatchError("invalid format xml, it must be csv or jsonl"))
+
+		_, err = transfer.NewWriter(&bytes.Buffer{}, "xml")
+		Expect(err).To(MatchError("invalid format xml, it must be csv or jsonl"))
+	})
+
+	It("should write members as CSV after the header", func() {
+		var output bytes.Buffer
+		writer, err := transfer.NewWriter(&output, "csv")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(writer.Flush()).To(Succeed())
+		Expect(output.String()).To(Equal("publicID,score,rank,expireAt\n"))
+
+		Expect(writer.Write([]*model.Member{{PublicID: "member1", Score: 10, Rank: 1, ExpireAt: 1600000000}})).To(Succeed())
+		Expect(writer.Flush()).To(Succeed())
+		Expect(output.String()).To(Equal("publicID,score,rank,expireAt\nmember1,10,1,1600000000\n"))
+	})
+
+	It("should write members as JSON lines", func() {
+		var output bytes.Buffer
+		writer, err := transfer.NewWriter(&output, "jsonl")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(writer.Write([]*model.Member{{PublicID: "member1", Score: 10, Rank: 1}, {PublicID: "member2", Score: 5, Rank: 2}})).To(Succeed())
+		Expect(writer.Flush()).To(Succeed())
+		Expect(output.String()).To(Equal(
+			`{"publicID":"member1","score":10,"rank":1,"expireAt":0}` + "\n" +
+				`{"publicID":"member2","score":5,"rank":2,"expireAt":0}` + "\n",
+		))
+	})
+})
@@ -0,0 +1,163 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package transfer
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	api "github.com/topfreegames/podium/proto/podium/api/v1"
+	"google.golang.org/grpc"
+)
+
+// GRPCLeaderboards imports and exports leaderboards through the ImportScores and ExportLeaderboard RPCs
+// of a running podium
+type GRPCLeaderboards struct {
+	Client api.PodiumClient
+}
+
+// NewGRPCLeaderboards create a new GRPCLeaderboards calling client
+func NewGRPCLeaderboards(client api.PodiumClient) *GRPCLeaderboards {
+	return &GRPCLeaderboards{Client: client}
+}
+
+// DialGRPC connect to the gRPC API of podium at address, sending basic auth if username is set
+func DialGRPC(address, username, password string) (*grpc.ClientConn, error) {
+	opts := []grpc.DialOption{grpc.WithInsecure()}
+	if username != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(&basicAuth{username: username, password: password}))
+	}
+	return grpc.Dial(address, opts...)
+}
+
+// ImportMembers send each chunk returned by next as a chunk of a single ImportScores stream
+func (g *GRPCLeaderboards) ImportMembers(ctx context.Context, leaderboard string, replace bool, next func() ([]*model.Member, error)) (*model.ImportResult, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := g.Client.ImportScores(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &api.ImportScoresRequest{LeaderboardId: leaderboard, Replace: replace}
+	for {
+		members, err := next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		request.Members = make([]*api.BulkUpsertScoresRequest_MemberScore, 0, len(members))
+		for _, member := range members {
+			request.Members = append(request.Members, &api.BulkUpsertScoresRequest_MemberScore{
+				PublicID: member.PublicID,
+				Score:    float64(member.Score),
+			})
+		}
+
+		err = stream.Send(request)
+		if err == io.EOF {
+			// the server ended the stream, its error is returned by CloseAndRecv
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		request = &api.ImportScoresRequest{}
+	}
+
+	response, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.ImportResult{
+		Leaderboard: leaderboard,
+		Chunks:      make([]*model.ImportChunk, 0, len(response.Chunks)),
+		Imported:    int(response.Imported),
+		Failed:      int(response.Failed),
+		Replaced:    response.Replaced,
+	}
+	for _, chunk := range response.Chunks {
+		importChunk := &model.ImportChunk{
+			Chunk:    int(chunk.Chunk),
+			Imported: int(chunk.Imported),
+			Failures: make([]*model.ImportFailure, 0, len(chunk.Failures)),
+		}
+		for _, failure := range chunk.Failures {
+			importChunk.Failures = append(importChunk.Failures, &model.ImportFailure{
+				PublicID: failure.PublicID,
+				Reason:   failure.Reason,
+			})
+		}
+		result.Chunks = append(result.Chunks, importChunk)
+	}
+	return result, nil
+}
+
+// ExportLeaderboard call emit with each chunk of an ExportLeaderboard stream
+func (g *GRPCLeaderboards) ExportLeaderboard(ctx context.Context, leaderboard, order string, chunkSize int, emit func([]*model.Member) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := g.Client.ExportLeaderboard(ctx, &api.ExportLeaderboardRequest{
+		LeaderboardId: leaderboard,
+		Order:         order,
+		ChunkSize:     int32(chunkSize),
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		members := make([]*model.Member, 0, len(response.Members))
+		for _, member := range response.Members {
+			members = append(members, &model.Member{
+				PublicID: member.PublicID,
+				Score:    int64(member.Score),
+				Rank:     int(member.Rank),
+				ExpireAt: int(member.ExpireAt),
+			})
+		}
+
+		err = emit(members)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// basicAuth sends the basic auth checked by the podium gRPC server with each call
+type basicAuth struct {
+	username string
+	password string
+}
+
+func (b *basicAuth) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	token := base64.StdEncoding.EncodeToString([]byte(b.username + ":" + b.password))
+	return map[string]string{"authorization": "basic " + token}, nil
+}
+
+func (b *basicAuth) RequireTransportSecurity() bool {
+	return false
+}
@@ -0,0 +1,396 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package transfer
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// ImportCheckpoint is how far the import of a file went
+type ImportCheckpoint struct {
+	Leaderboard string `json:"leaderboard"`
+	// Records is how many records of the file, read in order, were imported or failed
+	Records   int   `json:"records"`
+	Imported  int   `json:"imported"`
+	Failed    int   `json:"failed"`
+	UpdatedAt int64 `json:"updatedAt"`
+}
+
+// Failure is a record that could not be imported
+type Failure struct {
+	Line     int    `json:"line"`
+	PublicID string `json:"publicID,omitempty"`
+	Reason   string `json:"reason"`
+}
+
+// ChunkResult is the result of importing a chunk of records
+type ChunkResult struct {
+	Chunk    int
+	Records  int
+	Imported int
+	Failures []*Failure
+}
+
+// ImportResult is the result of importing a file, including the records imported before the checkpoint
+type ImportResult struct {
+	// Resumed is how many records were skipped as they were handled before the checkpoint
+	Resumed  int
+	Imported int
+	Failed   int
+}
+
+// Importer imports files in chunks of ChunkSize records written by Workers in parallel. The records of each
+// chunk are split among workers by publicID, so every record of a member is written by the same worker in the
+// order it was read and the last record of a member read wins
+type Importer struct {
+	Leaderboards Leaderboards
+	ChunkSize    int
+	Workers      int
+	// DryRun only reads and validates records, reporting the valid ones as imported
+	DryRun bool
+	// CheckpointPath is where the checkpoint is resumed from and saved after each chunk, if set. It is
+	// removed once the file is imported
+	CheckpointPath string
+	// OnChunk is called with each chunk imported, in the order they were read
+	OnChunk func(chunk *ChunkResult)
+}
+
+// NewImporter create a new Importer
+func NewImporter(leaderboards Leaderboards, chunkSize, workers int) *Importer {
+	return &Importer{
+		Leaderboards: leaderboards,
+		ChunkSize:    chunkSize,
+		Workers:      workers,
+	}
+}
+
+// importChunk is a chunk of records read, with the ones that could not be read
+type importChunk struct {
+	index    int
+	records  []*Record
+	failures []*Failure
+}
+
+// importShard is the part of a chunk written by a worker, one of the shards the chunk was split into
+type importShard struct {
+	chunk    int
+	shards   int
+	records  []*Record
+	failures []*Failure
+}
+
+type chunkOutcome struct {
+	result *ChunkResult
+	shards int
+	err    error
+}
+
+// Import import the records read from reader into leaderboard, skipping the ones before the checkpoint.
+// Records that could not be read or imported fail without stopping the import, which only stops at the
+// first chunk that could not be written at all or when reader fails
+func (i *Importer) Import(ctx context.Context, leaderboard string, reader Reader) (*ImportResult, error) {
+	checkpoint := &ImportCheckpoint{Leaderboard: leaderboard}
+	if i.CheckpointPath != "" && !i.DryRun {
+		found, err := loadCheckpoint(i.CheckpointPath, checkpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid checkpoint %s: %s", i.CheckpointPath, err.Error())
+		}
+		if found && checkpoint.Leaderboard != leaderboard {
+			return nil, fmt.Errorf("checkpoint %s is of leaderboard %s", i.CheckpointPath, checkpoint.Leaderboard)
+		}
+	}
+	result := &ImportResult{
+		Resumed:  checkpoint.Records,
+		Imported: checkpoint.Imported,
+		Failed:   checkpoint.Failed,
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	chunks := make(chan *importChunk)
+	readErr := make(chan error, 1)
+	go func() {
+		readErr <- i.readChunks(ctx, reader, checkpoint.Records, chunks)
+		close(chunks)
+	}()
+
+	workerShards := make([]chan *importShard, i.workers())
+	for w := range workerShards {
+		workerShards[w] = make(chan *importShard)
+	}
+	go func() {
+		defer func() {
+			for _, shards := range workerShards {
+				close(shards)
+			}
+		}()
+
+		for chunk := range chunks {
+			for w, shard := range i.splitChunk(chunk) {
+				if shard == nil {
+					continue
+				}
+				select {
+				case workerShards[w] <- shard:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	outcomes := make(chan *chunkOutcome)
+	var wg sync.WaitGroup
+	for _, shards := range workerShards {
+		wg.Add(1)
+		go func(shards <-chan *importShard) {
+			defer wg.Done()
+			for shard := range shards {
+				chunkResult, err := i.importShard(ctx, leaderboard, shard)
+				select {
+				case outcomes <- &chunkOutcome{result: chunkResult, shards: shard.shards, err: err}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(shards)
+	}
+	go func() {
+		wg.Wait()
+		close(outcomes)
+	}()
+
+	// chunks finish out of order once all their shards do, the checkpoint only moves past chunks whose
+	// previous ones finished too
+	var err error
+	shardsLeft := map[int]int{}
+	partial := map[int]*ChunkResult{}
+	finished := map[int]*ChunkResult{}
+	next := 0
+	for outcome := range outcomes {
+		if err != nil {
+			continue
+		}
+		if outcome.err != nil {
+			err = outcome.err
+			cancel()
+			continue
+		}
+
+		index := outcome.result.Chunk
+		if chunkResult, ok := partial[index]; ok {
+			chunkResult.Records += outcome.result.Records
+			chunkResult.Imported += outcome.result.Imported
+			chunkResult.Failures = append(chunkResult.Failures, outcome.result.Failures...)
+		} else {
+			partial[index] = outcome.result
+			shardsLeft[index] = outcome.shards
+		}
+		shardsLeft[index]--
+		if shardsLeft[index] > 0 {
+			continue
+		}
+		sort.SliceStable(partial[index].Failures, func(a, b int) bool {
+			return partial[index].Failures[a].Line < partial[index].Failures[b].Line
+		})
+		finished[index] = partial[index]
+		delete(partial, index)
+		delete(shardsLeft, index)
+
+		for chunkResult, ok := finished[next]; ok; chunkResult, ok = finished[next] {
+			delete(finished, next)
+			next++
+
+			checkpoint.Records += chunkResult.Records
+			checkpoint.Imported += chunkResult.Imported
+			checkpoint.Failed += len(chunkResult.Failures)
+			result.Imported += chunkResult.Imported
+			result.Failed += len(chunkResult.Failures)
+
+			if i.CheckpointPath != "" && !i.DryRun {
+				checkpoint.UpdatedAt = time.Now().Unix()
+				err = saveCheckpoint(i.CheckpointPath, checkpoint)
+				if err != nil {
+					cancel()
+					break
+				}
+			}
+			if i.OnChunk != nil {
+				i.OnChunk(chunkResult)
+			}
+		}
+	}
+	if err != nil {
+		return result, err
+	}
+	if ctx.Err() != nil {
+		return result, ctx.Err()
+	}
+
+	err = <-readErr
+	if err != nil {
+		return result, err
+	}
+
+	if i.CheckpointPath != "" && !i.DryRun {
+		err = os.Remove(i.CheckpointPath)
+		if err != nil && !os.IsNotExist(err) {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+func (i *Importer) workers() int {
+	if i.Workers < 1 {
+		return 1
+	}
+	return i.Workers
+}
+
+// readChunks send chunks of the records read after the first skip ones until reader ends or ctx is done
+func (i *Importer) readChunks(ctx context.Context, reader Reader, skip int, chunks chan<- *importChunk) error {
+	read := 0
+	chunk := &importChunk{}
+	send := func() error {
+		select {
+		case chunks <- chunk:
+			chunk = &importChunk{index: chunk.index + 1}
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		recordErr, failed := err.(*RecordError)
+		if err != nil && !failed {
+			return err
+		}
+
+		read++
+		if read <= skip {
+			continue
+		}
+
+		if failed {
+			chunk.failures = append(chunk.failures, &Failure{Line: recordErr.Line, Reason: recordErr.Reason})
+		} else {
+			chunk.records = append(chunk.records, record)
+		}
+
+		if len(chunk.records)+len(chunk.failures) >= i.ChunkSize {
+			if err := send(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(chunk.records)+len(chunk.failures) > 0 {
+		return send()
+	}
+	return nil
+}
+
+// splitChunk split the records of chunk among workers by the hash of their publicID, keeping the order they
+// were read. Records that could not be read go with the first shard, the whole chunk is in a single one if
+// there is a single worker or it has no records. Workers without records of chunk have a nil shard
+func (i *Importer) splitChunk(chunk *importChunk) []*importShard {
+	shards := make([]*importShard, i.workers())
+	if len(shards) == 1 || len(chunk.records) == 0 {
+		shards[0] = &importShard{chunk: chunk.index, shards: 1, records: chunk.records, failures: chunk.failures}
+		return shards
+	}
+
+	total := 0
+	for _, record := range chunk.records {
+		hash := fnv.New32a()
+		hash.Write([]byte(record.PublicID))
+		w := int(hash.Sum32() % uint32(len(shards)))
+		if shards[w] == nil {
+			shards[w] = &importShard{chunk: chunk.index}
+			total++
+		}
+		shards[w].records = append(shards[w].records, record)
+	}
+
+	first := true
+	for _, shard := range shards {
+		if shard == nil {
+			continue
+		}
+		shard.shards = total
+		if first {
+			shard.failures = chunk.failures
+			first = false
+		}
+	}
+	return shards
+}
+
+// importShard write the records of shard to leaderboard, unless it is a dry run
+func (i *Importer) importShard(ctx context.Context, leaderboard string, shard *importShard) (*ChunkResult, error) {
+	chunkResult := &ChunkResult{
+		Chunk:    shard.chunk,
+		Records:  len(shard.records) + len(shard.failures),
+		Failures: shard.failures,
+	}
+	if i.DryRun || len(shard.records) == 0 {
+		chunkResult.Imported = len(shard.records)
+		return chunkResult, nil
+	}
+
+	members := make([]*model.Member, 0, len(shard.records))
+	lines := make(map[string]int, len(shard.records))
+	for _, record := range shard.records {
+		members = append(members, &model.Member{PublicID: record.PublicID, Score: record.Score})
+		if _, ok := lines[record.PublicID]; !ok {
+			lines[record.PublicID] = record.Line
+		}
+	}
+
+	sent := false
+	importResult, err := i.Leaderboards.ImportMembers(ctx, leaderboard, false, func() ([]*model.Member, error) {
+		if sent {
+			return nil, io.EOF
+		}
+		sent = true
+		return members, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	chunkResult.Imported = importResult.Imported
+	for _, importChunk := range importResult.Chunks {
+		for _, failure := range importChunk.Failures {
+			chunkResult.Failures = append(chunkResult.Failures, &Failure{
+				Line:     lines[failure.PublicID],
+				PublicID: failure.PublicID,
+				Reason:   failure.Reason,
+			})
+		}
+	}
+	return chunkResult, nil
+}
@@ -0,0 +1,155 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package transfer_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/topfreegames/podium/transfer"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Importer", func() {
+	var dir string
+	var leaderboards *memoryLeaderboards
+
+	csvOf := func(members int) transfer.Reader {
+		lines := []string{"publicID,score"}
+		for i := 1; i <= members; i++ {
+			lines = append(lines, fmt.Sprintf("member%d,%d", i, i*10))
+		}
+		reader, err := transfer.NewReader(strings.NewReader(strings.Join(lines, "\n")), "csv")
+		Expect(err).NotTo(HaveOccurred())
+		return reader
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "podium-transfer")
+		Expect(err).NotTo(HaveOccurred())
+
+		leaderboards = newMemoryLeaderboards()
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("should import chunks in parallel reporting failures with their lines", func() {
+		leaderboards.failures["member4"] = "redis error"
+		reader, err := transfer.NewReader(strings.NewReader("publicID,score\nmember1,10\n,20\nmember3,30\nmember4,40\nmember5,50\n"), "csv")
+		Expect(err).NotTo(HaveOccurred())
+
+		var mutex sync.Mutex
+		chunks := []*transfer.ChunkResult{}
+		importer := transfer.NewImporter(leaderboards, 2, 4)
+		importer.OnChunk = func(chunk *transfer.ChunkResult) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			chunks = append(chunks, chunk)
+		}
+
+		result, err := importer.Import(context.Background(), "ladder", reader)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(&transfer.ImportResult{Imported: 3, Failed: 2}))
+		Expect(leaderboards.scores["ladder"]).To(Equal(map[string]int64{"member1": 10, "member3": 30, "member5": 50}))
+
+		Expect(chunks).To(HaveLen(3))
+		for i, chunk := range chunks {
+			Expect(chunk.Chunk).To(Equal(i))
+		}
+		Expect(chunks[0].Failures).To(Equal([]*transfer.Failure{{Line: 3, Reason: "publicID is required"}}))
+		Expect(chunks[1].Failures).To(Equal([]*transfer.Failure{{Line: 5, PublicID: "member4", Reason: "redis error"}}))
+	})
+
+	It("should import the last record read of members repeated across chunks", func() {
+		lines := []string{"publicID,score"}
+		for i := 1; i <= 50; i++ {
+			lines = append(lines, fmt.Sprintf("member%d,%d", i%5, i))
+		}
+		reader, err := transfer.NewReader(strings.NewReader(strings.Join(lines, "\n")), "csv")
+		Expect(err).NotTo(HaveOccurred())
+
+		importer := transfer.NewImporter(leaderboards, 2, 4)
+		result, err := importer.Import(context.Background(), "ladder", reader)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Imported).To(Equal(50))
+		Expect(leaderboards.scores["ladder"]).To(Equal(map[string]int64{
+			"member0": 50, "member1": 46, "member2": 47, "member3": 48, "member4": 49,
+		}))
+	})
+
+	It("should only validate records in a dry run", func() {
+		importer := transfer.NewImporter(nil, 2, 2)
+		importer.DryRun = true
+		importer.CheckpointPath = filepath.Join(dir, "checkpoint.json")
+
+		result, err := importer.Import(context.Background(), "ladder", csvOf(5))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(&transfer.ImportResult{Imported: 5}))
+		Expect(filepath.Join(dir, "checkpoint.json")).NotTo(BeAnExistingFile())
+	})
+
+	It("should resume after the checkpoint and remove it once imported", func() {
+		checkpointPath := filepath.Join(dir, "checkpoint.json")
+		data, err := json.Marshal(&transfer.ImportCheckpoint{Leaderboard: "ladder", Records: 3, Imported: 3})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ioutil.WriteFile(checkpointPath, data, 0644)).To(Succeed())
+
+		importer := transfer.NewImporter(leaderboards, 2, 2)
+		importer.CheckpointPath = checkpointPath
+
+		result, err := importer.Import(context.Background(), "ladder", csvOf(5))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(&transfer.ImportResult{Resumed: 3, Imported: 5}))
+		Expect(leaderboards.scores["ladder"]).To(Equal(map[string]int64{"member4": 40, "member5": 50}))
+		Expect(checkpointPath).NotTo(BeAnExistingFile())
+	})
+
+	It("should save the checkpoint of the chunks imported before a chunk fails", func() {
+		checkpointPath := filepath.Join(dir, "checkpoint.json")
+		leaderboards.failAfter = 2
+
+		importer := transfer.NewImporter(leaderboards, 2, 1)
+		importer.CheckpointPath = checkpointPath
+
+		result, err := importer.Import(context.Background(), "ladder", csvOf(7))
+		Expect(err).To(MatchError("podium unavailable"))
+		Expect(result.Imported).To(Equal(4))
+
+		data, err := ioutil.ReadFile(checkpointPath)
+		Expect(err).NotTo(HaveOccurred())
+		checkpoint := &transfer.ImportCheckpoint{}
+		Expect(json.Unmarshal(data, checkpoint)).To(Succeed())
+		Expect(checkpoint.Leaderboard).To(Equal("ladder"))
+		Expect(checkpoint.Records).To(Equal(4))
+		Expect(checkpoint.Imported).To(Equal(4))
+	})
+
+	It("should fail if the checkpoint is of another leaderboard", func() {
+		checkpointPath := filepath.Join(dir, "checkpoint.json")
+		Expect(ioutil.WriteFile(checkpointPath, []byte(`{"leaderboard":"other","records":3}`), 0644)).To(Succeed())
+
+		importer := transfer.NewImporter(leaderboards, 2, 2)
+		importer.CheckpointPath = checkpointPath
+
+		_, err := importer.Import(context.Background(), "ladder", csvOf(5))
+		Expect(err).To(MatchError(fmt.Sprintf("checkpoint %s is of leaderboard other", checkpointPath)))
+	})
+})
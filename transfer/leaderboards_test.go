@@ -0,0 +1,118 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package transfer_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// memoryLeaderboards is a transfer.Leaderboards keeping scores in memory, failing members in failures
+// with their reason and failing whole calls after calls succeeded, if failAfter is set
+type memoryLeaderboards struct {
+	mutex     sync.Mutex
+	scores    map[string]map[string]int64
+	failures  map[string]string
+	failAfter int
+	calls     int
+}
+
+func newMemoryLeaderboards() *memoryLeaderboards {
+	return &memoryLeaderboards{
+		scores:   map[string]map[string]int64{},
+		failures: map[string]string{},
+	}
+}
+
+func (m *memoryLeaderboards) call() error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.calls++
+	if m.failAfter > 0 && m.calls > m.failAfter {
+		return fmt.Errorf("podium unavailable")
+	}
+	return nil
+}
+
+func (m *memoryLeaderboards) ImportMembers(ctx context.Context, leaderboard string, replace bool, next func() ([]*model.Member, error)) (*model.ImportResult, error) {
+	if err := m.call(); err != nil {
+		return nil, err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.scores[leaderboard] == nil {
+		m.scores[leaderboard] = map[string]int64{}
+	}
+	result := &model.ImportResult{Leaderboard: leaderboard, Chunks: []*model.ImportChunk{}}
+	for {
+		members, err := next()
+		if err == io.EOF {
+			return result, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		chunk := &model.ImportChunk{Chunk: len(result.Chunks), Failures: []*model.ImportFailure{}}
+		for _, member := range members {
+			if reason, ok := m.failures[member.PublicID]; ok {
+				chunk.Failures = append(chunk.Failures, &model.ImportFailure{PublicID: member.PublicID, Reason: reason})
+				continue
+			}
+			m.scores[leaderboard][member.PublicID] = member.Score
+			chunk.Imported++
+		}
+		result.Chunks = append(result.Chunks, chunk)
+		result.Imported += chunk.Imported
+		result.Failed += len(chunk.Failures)
+	}
+}
+
+func (m *memoryLeaderboards) ExportLeaderboard(ctx context.Context, leaderboard, order string, chunkSize int, emit func([]*model.Member) error) error {
+	if err := m.call(); err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	members := []*model.Member{}
+	for publicID, score := range m.scores[leaderboard] {
+		members = append(members, &model.Member{PublicID: publicID, Score: score})
+	}
+	m.mutex.Unlock()
+
+	sort.Slice(members, func(i, j int) bool {
+		if order == "asc" {
+			return members[i].Score < members[j].Score
+		}
+		return members[i].Score > members[j].Score
+	})
+	for i, member := range members {
+		member.Rank = i + 1
+	}
+
+	for start := 0; start < len(members); start += chunkSize {
+		stop := start + chunkSize
+		if stop > len(members) {
+			stop = len(members)
+		}
+		if err := emit(members[start:stop]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+// Package transfer moves leaderboards between podium environments through CSV and JSON Lines files.
+//
+// Files are imported in chunks written by parallel workers and leaderboards are exported to a file each,
+// in parallel too, either straight to redis through the leaderboard service or through the gRPC API of a
+// running podium. Progress can be saved to a checkpoint file after each chunk or leaderboard, so an
+// interrupted transfer resumes where it stopped. Chunks imported after the checkpoint are imported again,
+// which is harmless as imports set scores.
+package transfer
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+)
+
+// Leaderboards imports and exports members of leaderboards, the leaderboard service implements it
+// through redis and GRPCLeaderboards through a running podium
+type Leaderboards interface {
+	ImportMembers(ctx context.Context, leaderboard string, replace bool, next func() ([]*model.Member, error)) (*model.ImportResult, error)
+	ExportLeaderboard(ctx context.Context, leaderboard, order string, chunkSize int, emit func([]*model.Member) error) error
+}
+
+// loadCheckpoint read the checkpoint in path into checkpoint, returning false if there is none
+func loadCheckpoint(path string, checkpoint interface{}) (bool, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, json.Unmarshal(data, checkpoint)
+}
+
+// saveCheckpoint write checkpoint to a temporary file in the directory of path and rename it to path, so
+// an interrupted save does not lose the previous checkpoint
+func saveCheckpoint(path string, checkpoint interface{}) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	file, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
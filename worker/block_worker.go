@@ -0,0 +1,184 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/topfreegames/podium/config"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	lservice "github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+// BlockResult is the struct that represents the result of applying a block in every leaderboard
+type BlockResult struct {
+	Dequeued bool
+	Member   string
+}
+
+func (r *BlockResult) String() string {
+	return fmt.Sprintf("(Dequeued: %t, Member: %s)", r.Dequeued, r.Member)
+}
+
+// BlockWorker is the struct that represents the worker moving scores of members blocked or unblocked in
+// every leaderboard
+type BlockWorker struct {
+	Config             *viper.Viper
+	Database           database.Blocklist
+	Service            lservice.Leaderboard
+	ConfigPath         string
+	BlockCheckInterval time.Duration
+	stop               chan bool
+}
+
+// GetBlockWorker returns a new worker moving scores of members blocked or unblocked in every leaderboard
+func GetBlockWorker(configPath string) (*BlockWorker, error) {
+	worker := &BlockWorker{
+		ConfigPath: configPath,
+	}
+
+	err := worker.loadConfiguration()
+	if err != nil {
+		return nil, err
+	}
+
+	err = worker.configure()
+	if err != nil {
+		return nil, err
+	}
+
+	return worker, nil
+}
+
+func (w *BlockWorker) loadConfiguration() error {
+	config, err := config.GetDefaultConfig(w.ConfigPath)
+	if err != nil {
+		return err
+	}
+	w.Config = config
+	return nil
+}
+
+func (w *BlockWorker) configure() error {
+	w.setConfigurationDefaults()
+	w.BlockCheckInterval = w.Config.GetDuration("worker.blockCheckInterval")
+	w.stop = make(chan bool, 1)
+
+	database := database.NewRedisDatabase(database.RedisOptions{
+		ClusterEnabled: w.Config.GetBool("redis.cluster.enabled"),
+		Addrs:          w.Config.GetStringSlice("redis.addrs"),
+		Host:           w.Config.GetString("redis.host"),
+		Port:           w.Config.GetInt("redis.port"),
+		Password:       w.Config.GetString("redis.password"),
+		DB:             w.Config.GetInt("redis.db"),
+	})
+	w.Database = database
+	w.Service = lservice.NewService(database)
+	return nil
+}
+
+func (w *BlockWorker) setConfigurationDefaults() {
+	w.Config.SetDefault("redis.clusterEnabled", "false")
+	w.Config.SetDefault("redis.addrs", "")
+	w.Config.SetDefault("redis.host", "localhost")
+	w.Config.SetDefault("redis.port", "6379")
+	w.Config.SetDefault("redis.password", "")
+	w.Config.SetDefault("redis.db", 0)
+	w.Config.SetDefault("redis.maxPoolSize", 20)
+	w.Config.SetDefault("worker.blockCheckInterval", "10s")
+}
+
+// Stop finish block worker execution
+func (w *BlockWorker) Stop() {
+	w.stop <- true
+}
+
+// Run execute a new worker
+func (w *BlockWorker) Run(resultsChan chan<- []*BlockResult, errChan chan<- error) {
+	shouldEnd := make(chan bool, 1)
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan,
+		syscall.SIGHUP,
+		syscall.SIGINT,
+		syscall.SIGTERM,
+		syscall.SIGQUIT,
+	)
+
+	go w.runWorker(shouldEnd, resultsChan, errChan)
+
+	select {
+	case <-sigChan:
+		shouldEnd <- true
+	case <-w.stop:
+		shouldEnd <- true
+	}
+
+	signal.Stop(sigChan)
+	close(sigChan)
+	close(shouldEnd)
+	close(w.stop)
+}
+
+func (w *BlockWorker) runWorker(shouldEnd chan bool, resultsChan chan<- []*BlockResult, errChan chan<- error) {
+	ticker := time.NewTicker(w.BlockCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-shouldEnd:
+			return
+		case <-ticker.C:
+			w.applyBlocks(resultsChan, errChan)
+		}
+	}
+}
+
+func (w *BlockWorker) applyBlocks(resultsChan chan<- []*BlockResult, errChan chan<- error) {
+	blocks, err := w.Database.GetQueuedGlobalBlocks(context.Background())
+	if err != nil {
+		errChan <- err
+		return
+	}
+
+	result := []*BlockResult{}
+	for _, block := range blocks {
+		blockResult, err := w.applyBlock(block)
+		if err != nil {
+			errChan <- err
+			return
+		}
+
+		result = append(result, blockResult)
+	}
+	resultsChan <- result
+}
+
+func (w *BlockWorker) applyBlock(block *database.QueuedGlobalBlock) (*BlockResult, error) {
+	err := w.Service.ApplyGlobalBlock(context.Background(), block.Member)
+	if err != nil {
+		return nil, err
+	}
+
+	dequeued, err := w.Database.RemoveQueuedGlobalBlock(context.Background(), block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BlockResult{
+		Dequeued: dequeued,
+		Member:   block.Member,
+	}, nil
+}
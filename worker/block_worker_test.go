@@ -0,0 +1,103 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package worker_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	lservice "github.com/topfreegames/podium/leaderboard/v2/service"
+	"github.com/topfreegames/podium/worker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Block Worker", func() {
+
+	var redisClient *database.Redis
+	var blockWorker *worker.BlockWorker
+	var leaderboards lservice.Leaderboard
+
+	const lbName string = "test-block-leaderboard"
+
+	blockSink := make(chan []*worker.BlockResult)
+	errorSink := make(chan error)
+
+	go func() {
+		for {
+			select {
+			case <-blockSink:
+			case <-errorSink:
+			}
+		}
+	}()
+
+	BeforeEach(func() {
+		var err error
+
+		blockWorker, err = worker.GetBlockWorker("../config/test.yaml")
+		Expect(err).NotTo(HaveOccurred())
+
+		redisClient = database.NewRedisDatabase(database.RedisOptions{
+			ClusterEnabled: blockWorker.Config.GetBool("redis.cluster.enabled"),
+			Addrs:          blockWorker.Config.GetStringSlice("redis.addrs"),
+			Host:           blockWorker.Config.GetString("redis.host"),
+			Port:           blockWorker.Config.GetInt("redis.port"),
+			Password:       blockWorker.Config.GetString("redis.password"),
+			DB:             blockWorker.Config.GetInt("redis.db"),
+		})
+		leaderboards = lservice.NewService(redisClient)
+		redisClient.Del(context.Background(), database.GlobalBlocksSet)
+	})
+
+	AfterEach(func() {
+		redisClient.Del(context.Background(), lbName)
+		redisClient.Del(context.Background(), fmt.Sprintf("{%s}:versions", lbName))
+		redisClient.Del(context.Background(), fmt.Sprintf("{%s}:blocked:scores", lbName))
+		redisClient.Del(context.Background(), database.GlobalBlocksSet)
+	})
+
+	It("should move scores of queued members blocked in every leaderboard", func() {
+		cheater := uuid.NewV4().String()
+
+		_, err := leaderboards.SetMemberScore(context.Background(), lbName, cheater, 481516, false, "", nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		err = leaderboards.BlockMember(context.Background(), "", cheater, model.BlockModeReject)
+		Expect(err).NotTo(HaveOccurred())
+
+		// moving scores scans every leaderboard, so wait for the block to be applied instead of a fixed time
+		results := make(chan []*worker.BlockResult)
+		go blockWorker.Run(results, errorSink)
+		Eventually(results, 30*time.Second).Should(Receive(ContainElement(&worker.BlockResult{Dequeued: true, Member: cheater})))
+		blockWorker.Stop()
+		go func() {
+			for range results {
+			}
+		}()
+
+		count, err := leaderboards.TotalMembers(context.Background(), lbName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(0))
+
+		blocks, err := redisClient.GetQueuedGlobalBlocks(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		for _, block := range blocks {
+			Expect(block.Member).NotTo(Equal(cheater))
+		}
+
+		err = redisClient.UnblockMembers(context.Background(), "", cheater)
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
@@ -0,0 +1,213 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/topfreegames/podium/cdc"
+	"github.com/topfreegames/podium/config"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/events"
+)
+
+// CDCWorker is the struct that represents the worker exporting score events published to the redis stream
+// to files for data warehouses, see package cdc
+type CDCWorker struct {
+	Config           *viper.Viper
+	Exporter         *cdc.Exporter
+	ConfigPath       string
+	CDCCheckInterval time.Duration
+	stop             chan bool
+}
+
+// GetCDCWorker returns a new score events exporter worker
+func GetCDCWorker(configPath string) (*CDCWorker, error) {
+	worker := &CDCWorker{
+		ConfigPath: configPath,
+	}
+
+	err := worker.loadConfiguration()
+	if err != nil {
+		return nil, err
+	}
+
+	err = worker.configure()
+	if err != nil {
+		return nil, err
+	}
+
+	return worker, nil
+}
+
+func (w *CDCWorker) loadConfiguration() error {
+	config, err := config.GetDefaultConfig(w.ConfigPath)
+	if err != nil {
+		return err
+	}
+	w.Config = config
+	return nil
+}
+
+func (w *CDCWorker) configure() error {
+	w.setConfigurationDefaults()
+	w.CDCCheckInterval = w.Config.GetDuration("cdc.checkInterval")
+	w.stop = make(chan bool, 1)
+
+	formats := []cdc.Format{}
+	for _, name := range w.Config.GetStringSlice("cdc.formats") {
+		format, err := cdc.GetFormat(name)
+		if err != nil {
+			return fmt.Errorf("invalid cdc.formats: %s", err.Error())
+		}
+		formats = append(formats, format)
+	}
+	if len(formats) == 0 {
+		return fmt.Errorf("cdc.formats must have at least one format")
+	}
+
+	var target cdc.Target
+	switch w.Config.GetString("cdc.target") {
+	case "local":
+		target = cdc.NewLocalTarget(w.Config.GetString("cdc.local.dir"))
+	case "s3":
+		if w.Config.GetString("cdc.s3.bucket") == "" {
+			return fmt.Errorf("cdc.s3.bucket is required with the s3 target")
+		}
+		s3Target, err := cdc.NewS3Target(
+			w.Config.GetString("cdc.s3.endpoint"),
+			w.Config.GetString("cdc.s3.bucket"),
+			w.Config.GetString("cdc.s3.prefix"),
+			w.Config.GetString("cdc.s3.region"),
+			w.Config.GetString("cdc.s3.accessKey"),
+			w.Config.GetString("cdc.s3.secretKey"),
+			w.Config.GetDuration("cdc.s3.timeout"),
+		)
+		if err != nil {
+			return fmt.Errorf("invalid cdc.s3 configuration: %s", err.Error())
+		}
+		target = s3Target
+	default:
+		return fmt.Errorf("invalid cdc.target %s, it must be local or s3", w.Config.GetString("cdc.target"))
+	}
+
+	database := database.NewRedisDatabase(database.RedisOptions{
+		ClusterEnabled: w.Config.GetBool("redis.cluster.enabled"),
+		Addrs:          w.Config.GetStringSlice("redis.addrs"),
+		Host:           w.Config.GetString("redis.host"),
+		Port:           w.Config.GetInt("redis.port"),
+		Password:       w.Config.GetString("redis.password"),
+		DB:             w.Config.GetInt("redis.db"),
+	})
+	source := events.NewRedisStreamReader(database.Client, w.Config.GetString("events.redis.stream"))
+
+	w.Exporter = cdc.NewExporter(source, target, formats, w.Config.GetInt("cdc.batchSize"))
+	return nil
+}
+
+func (w *CDCWorker) setConfigurationDefaults() {
+	w.Config.SetDefault("redis.clusterEnabled", "false")
+	w.Config.SetDefault("redis.addrs", "")
+	w.Config.SetDefault("redis.host", "localhost")
+	w.Config.SetDefault("redis.port", "6379")
+	w.Config.SetDefault("redis.password", "")
+	w.Config.SetDefault("redis.db", 0)
+	w.Config.SetDefault("redis.maxPoolSize", 20)
+	w.Config.SetDefault("events.redis.stream", "podium:events")
+	w.Config.SetDefault("cdc.checkInterval", "10s")
+	w.Config.SetDefault("cdc.batchSize", 10000)
+	w.Config.SetDefault("cdc.formats", []string{"jsonl"})
+	w.Config.SetDefault("cdc.target", "local")
+	w.Config.SetDefault("cdc.local.dir", "./cdc-data")
+	w.Config.SetDefault("cdc.s3.endpoint", "https://s3.amazonaws.com")
+	w.Config.SetDefault("cdc.s3.bucket", "")
+	w.Config.SetDefault("cdc.s3.prefix", "")
+	w.Config.SetDefault("cdc.s3.region", "us-east-1")
+	w.Config.SetDefault("cdc.s3.accessKey", "")
+	w.Config.SetDefault("cdc.s3.secretKey", "")
+	w.Config.SetDefault("cdc.s3.timeout", "30s")
+}
+
+// Stop finish cdc worker execution
+func (w *CDCWorker) Stop() {
+	w.stop <- true
+}
+
+// Run execute a new worker
+func (w *CDCWorker) Run(resultsChan chan<- []*cdc.BatchResult, errChan chan<- error) {
+	shouldEnd := make(chan bool, 1)
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan,
+		syscall.SIGHUP,
+		syscall.SIGINT,
+		syscall.SIGTERM,
+		syscall.SIGQUIT,
+	)
+
+	go w.runWorker(shouldEnd, resultsChan, errChan)
+
+	select {
+	case <-sigChan:
+		shouldEnd <- true
+	case <-w.stop:
+		shouldEnd <- true
+	}
+
+	signal.Stop(sigChan)
+	close(sigChan)
+	close(shouldEnd)
+	close(w.stop)
+}
+
+func (w *CDCWorker) runWorker(shouldEnd chan bool, resultsChan chan<- []*cdc.BatchResult, errChan chan<- error) {
+	ticker := time.NewTicker(w.CDCCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-shouldEnd:
+			return
+		case <-ticker.C:
+			w.exportEvents(resultsChan, errChan)
+		}
+	}
+}
+
+// exportEvents export batches until there are no new events or a batch fails, which is retried on the next check
+func (w *CDCWorker) exportEvents(resultsChan chan<- []*cdc.BatchResult, errChan chan<- error) {
+	result := []*cdc.BatchResult{}
+	defer func() {
+		if len(result) > 0 {
+			resultsChan <- result
+		}
+	}()
+
+	for {
+		batchResult, err := w.Exporter.ExportBatch(context.Background())
+		if err != nil {
+			errChan <- err
+			return
+		}
+		if batchResult == nil {
+			return
+		}
+		result = append(result, batchResult)
+
+		if batchResult.Events < w.Exporter.BatchSize {
+			return
+		}
+	}
+}
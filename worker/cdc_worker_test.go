@@ -0,0 +1,149 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package worker_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/topfreegames/podium/cdc"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/events"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/worker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CDC Worker", func() {
+
+	var redisClient *database.Redis
+	var cdcWorker *worker.CDCWorker
+	var dir string
+
+	const stream string = "test-cdc-events"
+
+	cdcSink := make(chan []*cdc.BatchResult)
+	errorSink := make(chan error)
+
+	go func() {
+		for {
+			select {
+			case <-cdcSink:
+			case <-errorSink:
+			}
+		}
+	}()
+
+	BeforeEach(func() {
+		var err error
+
+		dir, err = ioutil.TempDir("", "podium-cdc")
+		Expect(err).NotTo(HaveOccurred())
+
+		os.Setenv("PODIUM_CDC_LOCAL_DIR", dir)
+		os.Setenv("PODIUM_EVENTS_REDIS_STREAM", stream)
+		defer os.Unsetenv("PODIUM_CDC_LOCAL_DIR")
+		defer os.Unsetenv("PODIUM_EVENTS_REDIS_STREAM")
+
+		cdcWorker, err = worker.GetCDCWorker("../config/test.yaml")
+		Expect(err).NotTo(HaveOccurred())
+
+		redisClient = database.NewRedisDatabase(database.RedisOptions{
+			ClusterEnabled: cdcWorker.Config.GetBool("redis.cluster.enabled"),
+			Addrs:          cdcWorker.Config.GetStringSlice("redis.addrs"),
+			Host:           cdcWorker.Config.GetString("redis.host"),
+			Port:           cdcWorker.Config.GetInt("redis.port"),
+			Password:       cdcWorker.Config.GetString("redis.password"),
+			DB:             cdcWorker.Config.GetInt("redis.db"),
+		})
+	})
+
+	AfterEach(func() {
+		redisClient.Del(context.Background(), stream)
+		os.RemoveAll(dir)
+	})
+
+	It("should export events of the stream to partitioned files", func() {
+		changedAt := time.Date(2021, 6, 1, 13, 30, 0, 0, time.UTC).Unix()
+		newScore := int64(100)
+		scoreEvents := []*model.ScoreEvent{
+			{ID: "event1", Leaderboard: "lb", PublicID: "member1", NewScore: &newScore, OldRank: -1, NewRank: 1, ChangedAt: changedAt},
+			{ID: "event2", Leaderboard: "lb", PublicID: "member2", NewScore: &newScore, OldRank: -1, NewRank: 2, ChangedAt: changedAt},
+			{ID: "event3", Leaderboard: "lb", PublicID: "member3", NewScore: &newScore, OldRank: -1, NewRank: 3, ChangedAt: changedAt + 3600},
+		}
+		err := events.NewRedisStreamSink(redisClient.Client, stream, 0).Send(context.Background(), scoreEvents)
+		Expect(err).NotTo(HaveOccurred())
+
+		go func() {
+			time.Sleep(time.Duration(2) * time.Second)
+			cdcWorker.Stop()
+		}()
+		cdcWorker.Run(cdcSink, errorSink)
+
+		jsonFiles, err := filepath.Glob(filepath.Join(dir, "dt=2021-06-01", "hour=*", "*.jsonl"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(jsonFiles).To(HaveLen(2))
+		Expect(jsonFiles[0]).To(ContainSubstring("hour=13"))
+		Expect(jsonFiles[1]).To(ContainSubstring("hour=14"))
+
+		parquetFiles, err := filepath.Glob(filepath.Join(dir, "dt=2021-06-01", "hour=*", "*.parquet"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parquetFiles).To(HaveLen(2))
+
+		file, err := os.Open(jsonFiles[0])
+		Expect(err).NotTo(HaveOccurred())
+		defer file.Close()
+		ids := []string{}
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			event := &model.ScoreEvent{}
+			Expect(json.Unmarshal(scanner.Bytes(), event)).To(Succeed())
+			ids = append(ids, event.ID)
+		}
+		Expect(ids).To(Equal([]string{"event1", "event2"}))
+
+		streamEvents, err := events.NewRedisStreamReader(redisClient.Client, stream).Read(context.Background(), "", 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(streamEvents).To(HaveLen(3))
+
+		data, err := cdc.NewLocalTarget(dir).Get(context.Background(), cdc.CheckpointKey)
+		Expect(err).NotTo(HaveOccurred())
+		checkpoint := &cdc.Checkpoint{}
+		Expect(json.Unmarshal(data, checkpoint)).To(Succeed())
+		Expect(checkpoint.StreamID).To(Equal(streamEvents[2].StreamID))
+	})
+
+	It("should fail to export events when the checkpoint was trimmed from the stream", func() {
+		newScore := int64(100)
+		scoreEvents := []*model.ScoreEvent{
+			{ID: "event1", Leaderboard: "lb", PublicID: "member1", NewScore: &newScore, OldRank: -1, NewRank: 1, ChangedAt: time.Now().Unix()},
+		}
+		err := events.NewRedisStreamSink(redisClient.Client, stream, 0).Send(context.Background(), scoreEvents)
+		Expect(err).NotTo(HaveOccurred())
+
+		checkpoint, err := json.Marshal(&cdc.Checkpoint{StreamID: "1-0"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cdc.NewLocalTarget(dir).Put(context.Background(), cdc.CheckpointKey, checkpoint)).To(Succeed())
+
+		_, err = cdcWorker.Exporter.ExportBatch(context.Background())
+		Expect(err).To(Equal(events.NewStreamTrimmedError(stream, "1-0")))
+
+		files, err := filepath.Glob(filepath.Join(dir, "dt=*"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(files).To(BeEmpty())
+	})
+})
@@ -0,0 +1,206 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/topfreegames/podium/config"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/mutationlog"
+	lservice "github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+// DecayResult is the struct that represents the result of a decay renormalization job
+type DecayResult struct {
+	Renormalized bool
+	DeletedSet   bool
+	Set          string
+}
+
+func (r *DecayResult) String() string {
+	return fmt.Sprintf("(Renormalized: %t, DeletedSet: %t, Set: %s)", r.Renormalized, r.DeletedSet, r.Set)
+}
+
+// DecayWorker is the struct that represents the decaying leaderboards renormalizer worker
+type DecayWorker struct {
+	Config                *viper.Viper
+	Database              database.Decay
+	Service               lservice.Leaderboard
+	ConfigPath            string
+	DecayCheckInterval    time.Duration
+	DecayRenormalizeAfter float64
+	stop                  chan bool
+}
+
+// GetDecayWorker returns a new decaying leaderboards renormalizer worker
+func GetDecayWorker(configPath string) (*DecayWorker, error) {
+	worker := &DecayWorker{
+		ConfigPath: configPath,
+	}
+
+	err := worker.loadConfiguration()
+	if err != nil {
+		return nil, err
+	}
+
+	err = worker.configure()
+	if err != nil {
+		return nil, err
+	}
+
+	return worker, nil
+}
+
+func (w *DecayWorker) loadConfiguration() error {
+	config, err := config.GetDefaultConfig(w.ConfigPath)
+	if err != nil {
+		return err
+	}
+	w.Config = config
+	return nil
+}
+
+func (w *DecayWorker) configure() error {
+	w.setConfigurationDefaults()
+	w.DecayCheckInterval = w.Config.GetDuration("worker.decayCheckInterval")
+	w.DecayRenormalizeAfter = w.Config.GetFloat64("worker.decayRenormalizeAfter")
+	w.stop = make(chan bool, 1)
+
+	database := database.NewRedisDatabase(database.RedisOptions{
+		ClusterEnabled: w.Config.GetBool("redis.cluster.enabled"),
+		Addrs:          w.Config.GetStringSlice("redis.addrs"),
+		Host:           w.Config.GetString("redis.host"),
+		Port:           w.Config.GetInt("redis.port"),
+		Password:       w.Config.GetString("redis.password"),
+		DB:             w.Config.GetInt("redis.db"),
+	})
+	w.Database = database
+	w.Service = lservice.NewService(database)
+
+	// renormalizations scale scores, so they must be logged for replays to reach the same scores
+	mutationLogEnabled, err := config.MutationLogEnabled(w.Config)
+	if err != nil {
+		return err
+	}
+	if mutationLogEnabled {
+		w.Service = lservice.NewService(mutationlog.NewDatabase(database))
+	}
+	return nil
+}
+
+func (w *DecayWorker) setConfigurationDefaults() {
+	w.Config.SetDefault("redis.clusterEnabled", "false")
+	w.Config.SetDefault("redis.addrs", "")
+	w.Config.SetDefault("redis.host", "localhost")
+	w.Config.SetDefault("redis.port", "6379")
+	w.Config.SetDefault("redis.password", "")
+	w.Config.SetDefault("redis.db", 0)
+	w.Config.SetDefault("redis.maxPoolSize", 20)
+	w.Config.SetDefault("worker.decayCheckInterval", "60s")
+	w.Config.SetDefault("worker.decayRenormalizeAfter", 64)
+	config.SetMutationLogDefaults(w.Config)
+}
+
+// Stop finish decay worker execution
+func (w *DecayWorker) Stop() {
+	w.stop <- true
+}
+
+// Run execute a new worker
+func (w *DecayWorker) Run(resultsChan chan<- []*DecayResult, errChan chan<- error) {
+	shouldEnd := make(chan bool, 1)
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan,
+		syscall.SIGHUP,
+		syscall.SIGINT,
+		syscall.SIGTERM,
+		syscall.SIGQUIT,
+	)
+
+	go w.runWorker(shouldEnd, resultsChan, errChan)
+
+	select {
+	case <-sigChan:
+		shouldEnd <- true
+	case <-w.stop:
+		shouldEnd <- true
+	}
+
+	signal.Stop(sigChan)
+	close(sigChan)
+	close(shouldEnd)
+	close(w.stop)
+}
+
+func (w *DecayWorker) runWorker(shouldEnd chan bool, resultsChan chan<- []*DecayResult, errChan chan<- error) {
+	ticker := time.NewTicker(w.DecayCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-shouldEnd:
+			return
+		case <-ticker.C:
+			w.renormalizeLeaderboards(resultsChan, errChan)
+		}
+	}
+}
+
+func (w *DecayWorker) renormalizeLeaderboards(resultsChan chan<- []*DecayResult, errChan chan<- error) {
+	leaderboards, err := w.Database.GetDecayLeaderboards(context.Background())
+	if err != nil {
+		errChan <- err
+		return
+	}
+
+	result := []*DecayResult{}
+	for _, leaderboard := range leaderboards {
+		decayResult, err := w.renormalizeLeaderboard(leaderboard)
+		if err != nil {
+			errChan <- err
+			return
+		}
+
+		result = append(result, decayResult)
+	}
+	resultsChan <- result
+}
+
+func (w *DecayWorker) renormalizeLeaderboard(leaderboard string) (*DecayResult, error) {
+	renormalized, err := w.Service.RenormalizeLeaderboard(context.Background(), leaderboard, w.DecayRenormalizeAfter)
+	if err != nil {
+		if _, ok := err.(*lservice.LeaderboardWithoutDecayError); ok {
+			err = w.Database.RemoveLeaderboardFromDecayList(context.Background(), leaderboard)
+			if err != nil {
+				return nil, err
+			}
+
+			return &DecayResult{
+				Renormalized: false,
+				DeletedSet:   true,
+				Set:          leaderboard,
+			}, nil
+		}
+		return nil, err
+	}
+
+	return &DecayResult{
+		Renormalized: renormalized,
+		DeletedSet:   false,
+		Set:          leaderboard,
+	}, nil
+}
@@ -0,0 +1,136 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package worker_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	lservice "github.com/topfreegames/podium/leaderboard/v2/service"
+	"github.com/topfreegames/podium/worker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Decay Worker", func() {
+
+	var redisClient *database.Redis
+	var decayWorker *worker.DecayWorker
+	var leaderboards lservice.Leaderboard
+
+	const lbName string = "test-decay-leaderboard"
+
+	decaySink := make(chan []*worker.DecayResult)
+	errorSink := make(chan error)
+
+	go func() {
+		for {
+			select {
+			case <-decaySink:
+			case <-errorSink:
+			}
+		}
+	}()
+
+	BeforeEach(func() {
+		var err error
+
+		decayWorker, err = worker.GetDecayWorker("../config/test.yaml")
+		Expect(err).NotTo(HaveOccurred())
+
+		redisClient = database.NewRedisDatabase(database.RedisOptions{
+			ClusterEnabled: decayWorker.Config.GetBool("redis.cluster.enabled"),
+			Addrs:          decayWorker.Config.GetStringSlice("redis.addrs"),
+			Host:           decayWorker.Config.GetString("redis.host"),
+			Port:           decayWorker.Config.GetInt("redis.port"),
+			Password:       decayWorker.Config.GetString("redis.password"),
+			DB:             decayWorker.Config.GetInt("redis.db"),
+		})
+		leaderboards = lservice.NewService(redisClient)
+	})
+
+	AfterEach(func() {
+		redisClient.Del(context.Background(), lbName)
+		redisClient.Del(context.Background(), fmt.Sprintf("{%s}:settings", lbName))
+		redisClient.Del(context.Background(), database.DecaySet)
+	})
+
+	It("should renormalize leaderboards after enough half-lives", func() {
+		settings, err := leaderboards.UpdateLeaderboardSettings(context.Background(), lbName, &model.LeaderboardSettings{DecayHalfLife: 60})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = leaderboards.SetMemberScore(context.Background(), lbName, "denix", 481516, false, "", nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		landmark := settings.DecayLandmark - 64*60
+		err = redisClient.SetLeaderboardSettings(context.Background(), lbName, map[string]string{
+			"decayLandmark": strconv.FormatInt(landmark, 10),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		go func() {
+			time.Sleep(time.Duration(2) * time.Second)
+			decayWorker.Stop()
+		}()
+		decayWorker.Run(decaySink, errorSink)
+
+		fields, err := redisClient.GetLeaderboardSettings(context.Background(), lbName)
+		Expect(err).NotTo(HaveOccurred())
+		newLandmark, err := strconv.ParseInt(fields["decayLandmark"], 10, 64)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newLandmark).To(BeNumerically(">", landmark))
+
+		res, err := redisClient.ZRange(context.Background(), lbName, 0, 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res[0].Score).To(BeNumerically("<", 1))
+	})
+
+	It("should not renormalize recently normalized leaderboards", func() {
+		settings, err := leaderboards.UpdateLeaderboardSettings(context.Background(), lbName, &model.LeaderboardSettings{DecayHalfLife: 3600})
+		Expect(err).NotTo(HaveOccurred())
+
+		go func() {
+			time.Sleep(time.Duration(2) * time.Second)
+			decayWorker.Stop()
+		}()
+		decayWorker.Run(decaySink, errorSink)
+
+		fields, err := redisClient.GetLeaderboardSettings(context.Background(), lbName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fields["decayLandmark"]).To(Equal(strconv.FormatInt(settings.DecayLandmark, 10)))
+
+		members, err := redisClient.GetDecayLeaderboards(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(members).To(ContainElement(lbName))
+	})
+
+	It("should remove leaderboards without decay from decay list", func() {
+		_, err := leaderboards.UpdateLeaderboardSettings(context.Background(), lbName, &model.LeaderboardSettings{DecayHalfLife: 3600})
+		Expect(err).NotTo(HaveOccurred())
+		err = redisClient.SetLeaderboardSettings(context.Background(), lbName, map[string]string{
+			"decayHalfLife": "0",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		go func() {
+			time.Sleep(time.Duration(2) * time.Second)
+			decayWorker.Stop()
+		}()
+		decayWorker.Run(decaySink, errorSink)
+
+		members, err := redisClient.GetDecayLeaderboards(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(members).NotTo(ContainElement(lbName))
+	})
+})
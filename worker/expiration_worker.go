@@ -17,19 +17,28 @@ import (
 	"syscall"
 	"time"
 
+	uuid "github.com/satori/go.uuid"
 	"github.com/spf13/viper"
 	"github.com/topfreegames/podium/config"
 	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/lifecycle"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	"github.com/topfreegames/podium/leaderboard/v2/mutationlog"
 )
 
 // ExpirationResult is the struct that represents the result of an expiration job
 type ExpirationResult struct {
 	DeletedMembers int
 	DeletedSet     bool
-	Set            string
+	// Expired tells Set is a leaderboard that expired and was sent to the lifecycle notifier
+	Expired bool
+	Set     string
 }
 
 func (r *ExpirationResult) String() string {
+	if r.Expired {
+		return fmt.Sprintf("(Expired: %t, Set: %s)", r.Expired, r.Set)
+	}
 	return fmt.Sprintf("(DeletedMembers: %d, DeletedSet: %t, Set: %s)", r.DeletedMembers, r.DeletedSet, r.Set)
 }
 
@@ -40,7 +49,14 @@ type ExpirationWorker struct {
 	ConfigPath              string
 	ExpirationCheckInterval time.Duration
 	ExpirationLimitPerRun   int
-	stop                    chan bool
+	// LifecycleNotifier receives expirations of leaderboards, when nil it is created on Run if
+	// lifecycle.webhook.url is set
+	LifecycleNotifier lifecycle.Notifier
+	deliveryStore     lifecycle.DeliveryStore
+	bufferedNotifier  *lifecycle.BufferedNotifier
+	stop              chan bool
+	// done is closed when Run returns so errors reported afterwards are dropped instead of blocking
+	done chan struct{}
 }
 
 // GetExpirationWorker returns a new scores expirer worker
@@ -98,6 +114,11 @@ func (w *ExpirationWorker) configure() error {
 	w.ExpirationCheckInterval = w.Config.GetDuration("worker.expirationCheckInterval")
 	w.ExpirationLimitPerRun = w.Config.GetInt("worker.expirationLimitPerRun")
 	w.stop = make(chan bool, 1)
+	w.done = make(chan struct{})
+
+	if w.Config.GetString("lifecycle.webhook.url") != "" && w.Config.GetString("lifecycle.webhook.secret") == "" {
+		return fmt.Errorf("lifecycle.webhook.secret is required to sign lifecycle events")
+	}
 
 	database := database.NewRedisDatabase(database.RedisOptions{
 		ClusterEnabled: w.Config.GetBool("redis.cluster.enabled"),
@@ -108,6 +129,16 @@ func (w *ExpirationWorker) configure() error {
 		DB:             w.Config.GetInt("redis.db"),
 	})
 	w.Database = database
+	w.deliveryStore = database
+
+	// expired members are removed from leaderboards, so they must be logged for replays to remove them too
+	mutationLogEnabled, err := config.MutationLogEnabled(w.Config)
+	if err != nil {
+		return err
+	}
+	if mutationLogEnabled {
+		w.Database = mutationlog.NewExpirationDatabase(database)
+	}
 	return nil
 }
 
@@ -121,6 +152,14 @@ func (w *ExpirationWorker) setConfigurationDefaults() {
 	w.Config.SetDefault("redis.maxPoolSize", 20)
 	w.Config.SetDefault("worker.expirationCheckInterval", "60s")
 	w.Config.SetDefault("worker.expirationLimitPerRun", "1000")
+	w.Config.SetDefault("lifecycle.bufferSize", 10000)
+	w.Config.SetDefault("lifecycle.batchSize", 100)
+	w.Config.SetDefault("lifecycle.retryInterval", "1s")
+	w.Config.SetDefault("lifecycle.flushTimeout", "5s")
+	w.Config.SetDefault("lifecycle.webhook.timeout", "5s")
+	w.Config.SetDefault("lifecycle.webhook.maxRetries", 3)
+	w.Config.SetDefault("lifecycle.webhook.retryBackoff", "100ms")
+	config.SetMutationLogDefaults(w.Config)
 }
 
 // Stop finish expiration worker execution
@@ -130,6 +169,10 @@ func (w *ExpirationWorker) Stop() {
 
 // Run execute a new worker
 func (w *ExpirationWorker) Run(resultsChan chan<- []*ExpirationResult, errChan chan<- error) {
+	defer close(w.done)
+	w.startLifecycleNotifier(errChan)
+	defer w.closeLifecycleNotifier(errChan)
+
 	shouldEnd := make(chan bool, 1)
 	sigChan := make(chan os.Signal)
 	signal.Notify(sigChan,
@@ -171,22 +214,130 @@ func (w *ExpirationWorker) runWorker(shouldEnd chan bool, resultsChan chan<- []*
 func (w *ExpirationWorker) expireMembers(resultsChan chan<- []*ExpirationResult, errChan chan<- error) {
 	leaderboardExpirations, err := w.Database.GetExpirationLeaderboards(context.Background())
 	if err != nil {
-		errChan <- err
+		w.reportError(errChan, err)
 	}
 
 	result := []*ExpirationResult{}
 	for _, leaderboard := range leaderboardExpirations {
 		expirationResult, err := w.expireMembersFromLeaderboard(leaderboard)
 		if err != nil {
-			errChan <- err
+			w.reportError(errChan, err)
 			return
 		}
 
 		result = append(result, expirationResult)
 	}
+
+	expiredResults, err := w.notifyExpiredLeaderboards()
+	if err != nil {
+		w.reportError(errChan, err)
+		return
+	}
+	result = append(result, expiredResults...)
+
 	resultsChan <- result
 }
 
+// reportError send err to errChan unless Run already returned and nobody is receiving from it anymore
+func (w *ExpirationWorker) reportError(errChan chan<- error, err error) {
+	select {
+	case errChan <- err:
+	case <-w.done:
+	}
+}
+
+// notifyExpiredLeaderboards send to the lifecycle notifier leaderboards listed when created that expired,
+// acknowledging them only after the notifier accepted them so they are sent again by a later run otherwise
+func (w *ExpirationWorker) notifyExpiredLeaderboards() ([]*ExpirationResult, error) {
+	if w.LifecycleNotifier == nil {
+		return nil, nil
+	}
+
+	leaderboards, err := w.Database.GetExpiredLeaderboards(context.Background(), time.Now().UTC(), w.ExpirationLimitPerRun)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(leaderboards) == 0 {
+		return nil, nil
+	}
+
+	occurredAt := time.Now().Unix()
+	events := make([]*model.LifecycleEvent, 0, len(leaderboards))
+	results := make([]*ExpirationResult, 0, len(leaderboards))
+	for _, leaderboard := range leaderboards {
+		events = append(events, &model.LifecycleEvent{
+			ID:          uuid.NewV4().String(),
+			Type:        model.LifecycleLeaderboardExpired,
+			Leaderboard: leaderboard,
+			OccurredAt:  occurredAt,
+		})
+		results = append(results, &ExpirationResult{
+			Expired: true,
+			Set:     leaderboard,
+		})
+	}
+
+	err = w.LifecycleNotifier.Notify(context.Background(), events)
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.Database.AckExpiredLeaderboards(context.Background(), leaderboards...)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// startLifecycleNotifier create the buffered notifier delivering lifecycle events to the webhook configured
+// in lifecycle.webhook.url, sending its errors to errChan
+func (w *ExpirationWorker) startLifecycleNotifier(errChan chan<- error) {
+	url := w.Config.GetString("lifecycle.webhook.url")
+	if w.LifecycleNotifier != nil || url == "" {
+		return
+	}
+
+	onError := func(err error) {
+		w.reportError(errChan, err)
+	}
+
+	notifier := lifecycle.NewWebhookNotifier(
+		url,
+		w.Config.GetString("lifecycle.webhook.secret"),
+		w.Config.GetStringSlice("lifecycle.webhook.events"),
+		w.Config.GetDuration("lifecycle.webhook.timeout"),
+		w.Config.GetInt("lifecycle.webhook.maxRetries"),
+		w.Config.GetDuration("lifecycle.webhook.retryBackoff"),
+		w.deliveryStore,
+		onError,
+	)
+
+	w.bufferedNotifier = lifecycle.NewBufferedNotifier(
+		notifier,
+		w.Config.GetInt("lifecycle.bufferSize"),
+		w.Config.GetInt("lifecycle.batchSize"),
+		w.Config.GetDuration("lifecycle.retryInterval"),
+		onError,
+	)
+	w.LifecycleNotifier = w.bufferedNotifier
+}
+
+// closeLifecycleNotifier wait up to lifecycle.flushTimeout for buffered lifecycle events to be delivered
+func (w *ExpirationWorker) closeLifecycleNotifier(errChan chan<- error) {
+	if w.bufferedNotifier == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), w.Config.GetDuration("lifecycle.flushTimeout"))
+	defer cancel()
+
+	if err := w.bufferedNotifier.Close(ctx); err != nil {
+		w.reportError(errChan, err)
+	}
+}
+
 func (w *ExpirationWorker) expireMembersFromLeaderboard(leaderboard string) (*ExpirationResult, error) {
 	members, err := w.Database.GetMembersToExpire(context.Background(), leaderboard, w.ExpirationLimitPerRun, time.Now().UTC())
 	if err != nil {
@@ -13,11 +13,13 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/topfreegames/podium/config"
 	"github.com/topfreegames/podium/leaderboard/v2/database"
 	"github.com/topfreegames/podium/leaderboard/v2/database/redis"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
 	lservice "github.com/topfreegames/podium/leaderboard/v2/service"
 	"github.com/topfreegames/podium/worker"
 
@@ -25,6 +27,20 @@ import (
 	. "github.com/onsi/gomega"
 )
 
+// recordingNotifier keeps the lifecycle events sent to it, failing with err when set
+type recordingNotifier struct {
+	mutex  sync.Mutex
+	events []*model.LifecycleEvent
+	err    error
+}
+
+func (n *recordingNotifier) Notify(ctx context.Context, events []*model.LifecycleEvent) error {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.events = append(n.events, events...)
+	return n.err
+}
+
 var _ = Describe("Scores Expirer Worker", func() {
 
 	var redisClient *database.Redis
@@ -66,11 +82,57 @@ var _ = Describe("Scores Expirer Worker", func() {
 		redisClient.Del(context.Background(), lbName)
 		redisClient.Del(context.Background(), fmt.Sprintf("%s:ttl", lbName))
 		redisClient.Del(context.Background(), database.ExpirationSet)
+		redisClient.Del(context.Background(), database.ExpiringLeaderboardsSet)
+	})
+
+	It("should notify leaderboards that expired", func() {
+		notifier := &recordingNotifier{}
+		expirationWorker.LifecycleNotifier = notifier
+		expirationWorker.ExpirationCheckInterval = time.Second
+
+		_, err := redisClient.MarkLeaderboardCreated(context.Background(), lbName, time.Now().Add(-time.Second))
+		Expect(err).NotTo(HaveOccurred())
+
+		go func() {
+			time.Sleep(time.Duration(2500) * time.Millisecond)
+			expirationWorker.Stop()
+		}()
+		expirationWorker.Run(expirationSink, errorSink)
+
+		Expect(notifier.events).To(HaveLen(1))
+		Expect(notifier.events[0].ID).NotTo(BeEmpty())
+		Expect(notifier.events[0].Type).To(Equal(model.LifecycleLeaderboardExpired))
+		Expect(notifier.events[0].Leaderboard).To(Equal(lbName))
+
+		leaderboards, err := redisClient.GetExpiredLeaderboards(context.Background(), time.Now(), 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leaderboards).To(BeEmpty())
+	})
+
+	It("should keep expired leaderboards listed when the notifier fails", func() {
+		notifier := &recordingNotifier{err: fmt.Errorf("notifier error")}
+		expirationWorker.LifecycleNotifier = notifier
+		expirationWorker.ExpirationCheckInterval = time.Second
+
+		_, err := redisClient.MarkLeaderboardCreated(context.Background(), lbName, time.Now().Add(-time.Second))
+		Expect(err).NotTo(HaveOccurred())
+
+		go func() {
+			time.Sleep(time.Duration(2500) * time.Millisecond)
+			expirationWorker.Stop()
+		}()
+		expirationWorker.Run(expirationSink, errorSink)
+
+		Expect(len(notifier.events)).To(BeNumerically(">=", 2))
+
+		leaderboards, err := redisClient.GetExpiredLeaderboards(context.Background(), time.Now(), 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leaderboards).To(Equal([]string{lbName}))
 	})
 
 	It("should expire scores and delete set", func() {
 		ttl := "1"
-		_, err := leaderboards.SetMemberScore(context.Background(), lbName, "denix", 481516, false, ttl)
+		_, err := leaderboards.SetMemberScore(context.Background(), lbName, "denix", 481516, false, ttl, nil, nil)
 		Expect(err).NotTo(HaveOccurred())
 		redisLBExpirationKey := fmt.Sprintf("%s:ttl", lbName)
 		err = redisClient.Exists(context.Background(), redisLBExpirationKey)
@@ -109,7 +171,7 @@ var _ = Describe("Scores Expirer Worker", func() {
 
 	It("should not expire scores that are in the future", func() {
 		ttl := "20"
-		_, err := leaderboards.SetMemberScore(context.Background(), lbName, "denix", 481516, false, ttl)
+		_, err := leaderboards.SetMemberScore(context.Background(), lbName, "denix", 481516, false, ttl, nil, nil)
 		Expect(err).NotTo(HaveOccurred())
 		redisLBExpirationKey := fmt.Sprintf("%s:ttl", lbName)
 		err = redisClient.Exists(context.Background(), redisLBExpirationKey)
@@ -149,7 +211,7 @@ var _ = Describe("Scores Expirer Worker", func() {
 	It("should not expire scores that are not inserted with scoreTTL set", func() {
 		ttl := ""
 		redisLBExpirationKey := fmt.Sprintf("%s:ttl", lbName)
-		_, err := leaderboards.SetMemberScore(context.Background(), lbName, "denix", 481516, false, ttl)
+		_, err := leaderboards.SetMemberScore(context.Background(), lbName, "denix", 481516, false, ttl, nil, nil)
 		Expect(err).NotTo(HaveOccurred())
 		err = redisClient.Exists(context.Background(), redisLBExpirationKey)
 		Expect(err).To(MatchError(redis.NewKeyNotFoundError(redisLBExpirationKey)))
@@ -182,9 +244,9 @@ var _ = Describe("Scores Expirer Worker", func() {
 		expirationWorker.ExpirationCheckInterval = time.Duration(4) * time.Second
 
 		ttl := "2"
-		_, err := leaderboards.SetMemberScore(context.Background(), lbName, "denix", 481516, false, ttl)
+		_, err := leaderboards.SetMemberScore(context.Background(), lbName, "denix", 481516, false, ttl, nil, nil)
 		Expect(err).NotTo(HaveOccurred())
-		_, err = leaderboards.SetMemberScore(context.Background(), lbName, "denix2", 481512, false, ttl)
+		_, err = leaderboards.SetMemberScore(context.Background(), lbName, "denix2", 481512, false, ttl, nil, nil)
 		Expect(err).NotTo(HaveOccurred())
 		redisLBExpirationKey := fmt.Sprintf("%s:ttl", lbName)
 		err = redisClient.Exists(context.Background(), redisLBExpirationKey)
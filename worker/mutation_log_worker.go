@@ -0,0 +1,169 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/topfreegames/podium/config"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/mutationlog"
+)
+
+// MutationLogWorker is the struct that represents the worker shipping the writes added to mutation outboxes
+// to the mutation log, see package mutationlog
+type MutationLogWorker struct {
+	Config                   *viper.Viper
+	Shipper                  *mutationlog.Shipper
+	ConfigPath               string
+	MutationLogCheckInterval time.Duration
+	stop                     chan bool
+}
+
+// GetMutationLogWorker returns a new mutation log shipper worker
+func GetMutationLogWorker(configPath string) (*MutationLogWorker, error) {
+	worker := &MutationLogWorker{
+		ConfigPath: configPath,
+	}
+
+	err := worker.loadConfiguration()
+	if err != nil {
+		return nil, err
+	}
+
+	err = worker.configure()
+	if err != nil {
+		return nil, err
+	}
+
+	return worker, nil
+}
+
+func (w *MutationLogWorker) loadConfiguration() error {
+	config, err := config.GetDefaultConfig(w.ConfigPath)
+	if err != nil {
+		return err
+	}
+	w.Config = config
+	return nil
+}
+
+func (w *MutationLogWorker) configure() error {
+	w.setConfigurationDefaults()
+	w.MutationLogCheckInterval = w.Config.GetDuration("mutationLog.checkInterval")
+	w.stop = make(chan bool, 1)
+
+	mutationLog, err := config.NewMutationLog(w.Config)
+	if err != nil {
+		return err
+	}
+	if mutationLog == nil {
+		return fmt.Errorf("mutationLog.type is required to ship mutations")
+	}
+
+	database := database.NewRedisDatabase(database.RedisOptions{
+		ClusterEnabled: w.Config.GetBool("redis.cluster.enabled"),
+		Addrs:          w.Config.GetStringSlice("redis.addrs"),
+		Host:           w.Config.GetString("redis.host"),
+		Port:           w.Config.GetInt("redis.port"),
+		Password:       w.Config.GetString("redis.password"),
+		DB:             w.Config.GetInt("redis.db"),
+	})
+
+	w.Shipper = mutationlog.NewShipper(
+		database, mutationLog, w.Config.GetInt("mutationLog.batchSize"), w.Config.GetDuration("mutationLog.idleTime"),
+	)
+	return nil
+}
+
+func (w *MutationLogWorker) setConfigurationDefaults() {
+	w.Config.SetDefault("redis.clusterEnabled", "false")
+	w.Config.SetDefault("redis.addrs", "")
+	w.Config.SetDefault("redis.host", "localhost")
+	w.Config.SetDefault("redis.port", "6379")
+	w.Config.SetDefault("redis.password", "")
+	w.Config.SetDefault("redis.db", 0)
+	w.Config.SetDefault("redis.maxPoolSize", 20)
+	config.SetMutationLogDefaults(w.Config)
+}
+
+// Stop finish mutation log worker execution
+func (w *MutationLogWorker) Stop() {
+	w.stop <- true
+}
+
+// Run execute a new worker, sending to resultsChan how many mutations each check shipped
+func (w *MutationLogWorker) Run(resultsChan chan<- int, errChan chan<- error) {
+	shouldEnd := make(chan bool, 1)
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan,
+		syscall.SIGHUP,
+		syscall.SIGINT,
+		syscall.SIGTERM,
+		syscall.SIGQUIT,
+	)
+
+	go w.runWorker(shouldEnd, resultsChan, errChan)
+
+	select {
+	case <-sigChan:
+		shouldEnd <- true
+	case <-w.stop:
+		shouldEnd <- true
+	}
+
+	signal.Stop(sigChan)
+	close(sigChan)
+	close(shouldEnd)
+	close(w.stop)
+}
+
+func (w *MutationLogWorker) runWorker(shouldEnd chan bool, resultsChan chan<- int, errChan chan<- error) {
+	ticker := time.NewTicker(w.MutationLogCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-shouldEnd:
+			return
+		case <-ticker.C:
+			w.shipMutations(resultsChan, errChan)
+		}
+	}
+}
+
+// shipMutations ship batches until the outboxes are empty or a ship fails, which is retried on the next check
+func (w *MutationLogWorker) shipMutations(resultsChan chan<- int, errChan chan<- error) {
+	total := 0
+	defer func() {
+		if total > 0 {
+			resultsChan <- total
+		}
+	}()
+
+	for {
+		shipped, err := w.Shipper.Ship(context.Background())
+		if err != nil {
+			errChan <- err
+			return
+		}
+		total += shipped
+
+		if shipped == 0 {
+			return
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package worker_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/mutationlog"
+	"github.com/topfreegames/podium/worker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Mutation Log Worker", func() {
+
+	var redisClient *database.Redis
+	var mutationLogWorker *worker.MutationLogWorker
+	var dir string
+
+	const leaderboard string = "test-mutation-log-leaderboard"
+	const outbox string = "{test-mutation-log-leaderboard}:mutations"
+
+	mutationLogSink := make(chan int)
+	errorSink := make(chan error)
+
+	go func() {
+		for {
+			select {
+			case <-mutationLogSink:
+			case <-errorSink:
+			}
+		}
+	}()
+
+	replay := func() []*mutationlog.Mutation {
+		segmentLog, err := mutationlog.NewSegmentLog(dir, 1024*1024)
+		Expect(err).NotTo(HaveOccurred())
+		defer segmentLog.Close()
+
+		mutations := []*mutationlog.Mutation{}
+		err = segmentLog.Replay(context.Background(), func(mutation *mutationlog.Mutation) error {
+			mutations = append(mutations, mutation)
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return mutations
+	}
+
+	BeforeEach(func() {
+		var err error
+
+		dir, err = ioutil.TempDir("", "podium-mutations")
+		Expect(err).NotTo(HaveOccurred())
+
+		os.Setenv("PODIUM_MUTATIONLOG_TYPE", "segments")
+		os.Setenv("PODIUM_MUTATIONLOG_SEGMENTS_DIR", dir)
+		os.Setenv("PODIUM_MUTATIONLOG_CHECKINTERVAL", "10ms")
+		defer os.Unsetenv("PODIUM_MUTATIONLOG_TYPE")
+		defer os.Unsetenv("PODIUM_MUTATIONLOG_SEGMENTS_DIR")
+		defer os.Unsetenv("PODIUM_MUTATIONLOG_CHECKINTERVAL")
+
+		mutationLogWorker, err = worker.GetMutationLogWorker("../config/test.yaml")
+		Expect(err).NotTo(HaveOccurred())
+
+		redisClient = database.NewRedisDatabase(database.RedisOptions{
+			ClusterEnabled: mutationLogWorker.Config.GetBool("redis.cluster.enabled"),
+			Addrs:          mutationLogWorker.Config.GetStringSlice("redis.addrs"),
+			Host:           mutationLogWorker.Config.GetString("redis.host"),
+			Port:           mutationLogWorker.Config.GetInt("redis.port"),
+			Password:       mutationLogWorker.Config.GetString("redis.password"),
+			DB:             mutationLogWorker.Config.GetInt("redis.db"),
+		})
+	})
+
+	AfterEach(func() {
+		redisClient.RemoveLeaderboard(context.Background(), leaderboard)
+		redisClient.Del(context.Background(), "{test-mutation-log-leaderboard}:idempotency:key")
+		redisClient.Del(context.Background(), outbox)
+		redisClient.ZRem(context.Background(), database.MutationOutboxesSet, outbox)
+		os.RemoveAll(dir)
+	})
+
+	It("should ship writes to the log in the order they were applied", func() {
+		db := mutationlog.NewDatabase(redisClient)
+		idempotency := &database.Idempotency{Key: "key", Fingerprint: "fingerprint", Window: time.Minute}
+
+		err := db.SetMembers(context.Background(), leaderboard, []*database.Member{{Member: "member1", Score: 10}})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = db.IncrementMemberScoreIdempotent(context.Background(), leaderboard, &database.Member{Member: "member1"}, 5, idempotency)
+		Expect(err).NotTo(HaveOccurred())
+		replayed, err := db.IncrementMemberScoreIdempotent(context.Background(), leaderboard, &database.Member{Member: "member1"}, 5, idempotency)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(replayed).To(BeTrue())
+		err = mutationlog.NewExpirationDatabase(redisClient).ExpireMembers(context.Background(), leaderboard, []string{"member1"})
+		Expect(err).NotTo(HaveOccurred())
+
+		go mutationLogWorker.Run(mutationLogSink, errorSink)
+		defer mutationLogWorker.Stop()
+
+		Eventually(func() int {
+			return len(replay())
+		}).Should(Equal(3))
+
+		mutations := replay()
+		Expect(mutations[0].Op).To(Equal(mutationlog.OpSetMembers))
+		Expect(mutations[1].Op).To(Equal(mutationlog.OpIncrementMemberScore))
+		Expect(mutations[2].Op).To(Equal(mutationlog.OpExpireMembers))
+		for _, mutation := range mutations {
+			Expect(mutation.Outbox).To(Equal(outbox))
+		}
+
+		outboxMutations, err := redisClient.GetMutations(context.Background(), outbox, 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(outboxMutations).To(BeEmpty())
+	})
+
+	It("should not ship writes that failed", func() {
+		db := mutationlog.NewDatabase(redisClient)
+
+		err := db.RenameLeaderboard(context.Background(), leaderboard, "{test-mutation-log-leaderboard}:renamed")
+		Expect(err).To(HaveOccurred())
+
+		outboxMutations, err := redisClient.GetMutations(context.Background(), outbox, 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(outboxMutations).To(BeEmpty())
+	})
+})
@@ -0,0 +1,192 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/topfreegames/podium/config"
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	lservice "github.com/topfreegames/podium/leaderboard/v2/service"
+)
+
+// SnapshotResult is the struct that represents the result of a rank snapshot job
+type SnapshotResult struct {
+	Taken      bool
+	DeletedSet bool
+	Set        string
+}
+
+func (r *SnapshotResult) String() string {
+	return fmt.Sprintf("(Taken: %t, DeletedSet: %t, Set: %s)", r.Taken, r.DeletedSet, r.Set)
+}
+
+// SnapshotWorker is the struct that represents the leaderboards rank snapshot taker worker
+type SnapshotWorker struct {
+	Config                *viper.Viper
+	Database              database.Snapshot
+	Service               lservice.Leaderboard
+	ConfigPath            string
+	SnapshotCheckInterval time.Duration
+	stop                  chan bool
+}
+
+// GetSnapshotWorker returns a new leaderboards rank snapshot taker worker
+func GetSnapshotWorker(configPath string) (*SnapshotWorker, error) {
+	worker := &SnapshotWorker{
+		ConfigPath: configPath,
+	}
+
+	err := worker.loadConfiguration()
+	if err != nil {
+		return nil, err
+	}
+
+	err = worker.configure()
+	if err != nil {
+		return nil, err
+	}
+
+	return worker, nil
+}
+
+func (w *SnapshotWorker) loadConfiguration() error {
+	config, err := config.GetDefaultConfig(w.ConfigPath)
+	if err != nil {
+		return err
+	}
+	w.Config = config
+	return nil
+}
+
+func (w *SnapshotWorker) configure() error {
+	w.setConfigurationDefaults()
+	w.SnapshotCheckInterval = w.Config.GetDuration("worker.snapshotCheckInterval")
+	w.stop = make(chan bool, 1)
+
+	database := database.NewRedisDatabase(database.RedisOptions{
+		ClusterEnabled: w.Config.GetBool("redis.cluster.enabled"),
+		Addrs:          w.Config.GetStringSlice("redis.addrs"),
+		Host:           w.Config.GetString("redis.host"),
+		Port:           w.Config.GetInt("redis.port"),
+		Password:       w.Config.GetString("redis.password"),
+		DB:             w.Config.GetInt("redis.db"),
+	})
+	w.Database = database
+	w.Service = lservice.NewService(database)
+	return nil
+}
+
+func (w *SnapshotWorker) setConfigurationDefaults() {
+	w.Config.SetDefault("redis.clusterEnabled", "false")
+	w.Config.SetDefault("redis.addrs", "")
+	w.Config.SetDefault("redis.host", "localhost")
+	w.Config.SetDefault("redis.port", "6379")
+	w.Config.SetDefault("redis.password", "")
+	w.Config.SetDefault("redis.db", 0)
+	w.Config.SetDefault("redis.maxPoolSize", 20)
+	w.Config.SetDefault("worker.snapshotCheckInterval", "60s")
+}
+
+// Stop finish snapshot worker execution
+func (w *SnapshotWorker) Stop() {
+	w.stop <- true
+}
+
+// Run execute a new worker
+func (w *SnapshotWorker) Run(resultsChan chan<- []*SnapshotResult, errChan chan<- error) {
+	shouldEnd := make(chan bool, 1)
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan,
+		syscall.SIGHUP,
+		syscall.SIGINT,
+		syscall.SIGTERM,
+		syscall.SIGQUIT,
+	)
+
+	go w.runWorker(shouldEnd, resultsChan, errChan)
+
+	select {
+	case <-sigChan:
+		shouldEnd <- true
+	case <-w.stop:
+		shouldEnd <- true
+	}
+
+	signal.Stop(sigChan)
+	close(sigChan)
+	close(shouldEnd)
+	close(w.stop)
+}
+
+func (w *SnapshotWorker) runWorker(shouldEnd chan bool, resultsChan chan<- []*SnapshotResult, errChan chan<- error) {
+	ticker := time.NewTicker(w.SnapshotCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-shouldEnd:
+			return
+		case <-ticker.C:
+			w.snapshotLeaderboards(resultsChan, errChan)
+		}
+	}
+}
+
+func (w *SnapshotWorker) snapshotLeaderboards(resultsChan chan<- []*SnapshotResult, errChan chan<- error) {
+	leaderboards, err := w.Database.GetSnapshotLeaderboards(context.Background())
+	if err != nil {
+		errChan <- err
+		return
+	}
+
+	result := []*SnapshotResult{}
+	for _, leaderboard := range leaderboards {
+		snapshotResult, err := w.snapshotLeaderboard(leaderboard)
+		if err != nil {
+			errChan <- err
+			return
+		}
+
+		result = append(result, snapshotResult)
+	}
+	resultsChan <- result
+}
+
+func (w *SnapshotWorker) snapshotLeaderboard(leaderboard string) (*SnapshotResult, error) {
+	taken, err := w.Service.TakeRankSnapshot(context.Background(), leaderboard)
+	if err != nil {
+		if _, ok := err.(*lservice.LeaderboardWithoutSnapshotsError); ok {
+			err = w.Database.RemoveLeaderboardFromSnapshotList(context.Background(), leaderboard)
+			if err != nil {
+				return nil, err
+			}
+
+			return &SnapshotResult{
+				Taken:      false,
+				DeletedSet: true,
+				Set:        leaderboard,
+			}, nil
+		}
+		return nil, err
+	}
+
+	return &SnapshotResult{
+		Taken:      taken,
+		DeletedSet: false,
+		Set:        leaderboard,
+	}, nil
+}
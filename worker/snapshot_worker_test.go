@@ -0,0 +1,115 @@
+// podium
+// https://github.com/topfreegames/podium
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+// Forked from
+// https://github.com/dayvson/go-leaderboard
+// Copyright © 2013 Maxwell Dayvson da Silva
+
+package worker_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/topfreegames/podium/leaderboard/v2/database"
+	"github.com/topfreegames/podium/leaderboard/v2/model"
+	lservice "github.com/topfreegames/podium/leaderboard/v2/service"
+	"github.com/topfreegames/podium/worker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Snapshot Worker", func() {
+
+	var redisClient *database.Redis
+	var snapshotWorker *worker.SnapshotWorker
+	var leaderboards lservice.Leaderboard
+
+	const lbName string = "test-snapshot-leaderboard"
+
+	snapshotSink := make(chan []*worker.SnapshotResult)
+	errorSink := make(chan error)
+
+	go func() {
+		for {
+			select {
+			case <-snapshotSink:
+			case <-errorSink:
+			}
+		}
+	}()
+
+	BeforeEach(func() {
+		var err error
+
+		snapshotWorker, err = worker.GetSnapshotWorker("../config/test.yaml")
+		Expect(err).NotTo(HaveOccurred())
+
+		redisClient = database.NewRedisDatabase(database.RedisOptions{
+			ClusterEnabled: snapshotWorker.Config.GetBool("redis.cluster.enabled"),
+			Addrs:          snapshotWorker.Config.GetStringSlice("redis.addrs"),
+			Host:           snapshotWorker.Config.GetString("redis.host"),
+			Port:           snapshotWorker.Config.GetInt("redis.port"),
+			Password:       snapshotWorker.Config.GetString("redis.password"),
+			DB:             snapshotWorker.Config.GetInt("redis.db"),
+		})
+		leaderboards = lservice.NewService(redisClient)
+	})
+
+	AfterEach(func() {
+		takenAt, err := redisClient.GetRankSnapshotTime(context.Background(), lbName, time.Now())
+		Expect(err).NotTo(HaveOccurred())
+		if !takenAt.IsZero() {
+			redisClient.Del(context.Background(), fmt.Sprintf("{%s}:snapshot:%d", lbName, takenAt.UnixNano()/int64(time.Millisecond)))
+		}
+		redisClient.Del(context.Background(), lbName)
+		redisClient.Del(context.Background(), fmt.Sprintf("{%s}:settings", lbName))
+		redisClient.Del(context.Background(), fmt.Sprintf("{%s}:snapshots", lbName))
+		redisClient.Del(context.Background(), database.SnapshotSet)
+	})
+
+	It("should take rank snapshots of leaderboards with snapshot interval", func() {
+		_, err := leaderboards.UpdateLeaderboardSettings(context.Background(), lbName, &model.LeaderboardSettings{SnapshotInterval: 3600})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = leaderboards.SetMemberScore(context.Background(), lbName, "denix", 481516, false, "", nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		go func() {
+			time.Sleep(time.Duration(2) * time.Second)
+			snapshotWorker.Stop()
+		}()
+		snapshotWorker.Run(snapshotSink, errorSink)
+
+		takenAt, err := redisClient.GetRankSnapshotTime(context.Background(), lbName, time.Now())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(takenAt).To(BeTemporally("~", time.Now(), 3*time.Second))
+
+		members, err := redisClient.GetRankSnapshotMembers(context.Background(), lbName, takenAt, 0, -1, "desc")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(members).To(HaveLen(1))
+		Expect(members[0].Member).To(Equal("denix"))
+	})
+
+	It("should remove leaderboards without snapshot interval from snapshot list", func() {
+		_, err := leaderboards.UpdateLeaderboardSettings(context.Background(), lbName, &model.LeaderboardSettings{SnapshotInterval: 3600})
+		Expect(err).NotTo(HaveOccurred())
+		err = redisClient.SetLeaderboardSettings(context.Background(), lbName, map[string]string{
+			"snapshotInterval": "0",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		go func() {
+			time.Sleep(time.Duration(2) * time.Second)
+			snapshotWorker.Stop()
+		}()
+		snapshotWorker.Run(snapshotSink, errorSink)
+
+		members, err := redisClient.GetSnapshotLeaderboards(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(members).NotTo(ContainElement(lbName))
+	})
+})